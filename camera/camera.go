@@ -0,0 +1,105 @@
+// Package camera captures frames from cameras, such as webcams, through a driver for the platform.
+//
+// The default driver runs ffmpeg, using video4linux on Linux, AVFoundation on macOS and DirectShow on Windows.
+// Apps can use another capture library by setting their own Driver.
+package camera // import "fyne.io/x/fyne/camera"
+
+import (
+	"errors"
+	"image"
+	"os/exec"
+	"runtime"
+	"sync"
+)
+
+// ErrUnsupported is returned where no camera driver is available, such as when ffmpeg is not installed.
+var ErrUnsupported = errors.New("cameras are not supported on this system")
+
+// Device is a camera that frames can be captured from.
+type Device struct {
+	// ID identifies the device to its driver, such as "/dev/video0".
+	ID string
+	// Name is the name of the device to show to users.
+	Name string
+}
+
+// Options configure the frames of a stream.
+type Options struct {
+	// Width and Height are the size of each frame, 640 by 480 by default.
+	// The camera image is scaled and cropped to fill this size.
+	Width, Height int
+	// FPS is the number of frames captured each second, 15 by default.
+	FPS int
+}
+
+// Stream is the video of an open camera.
+type Stream interface {
+	// Read blocks until the next frame is captured, returning it as a new image.
+	Read() (image.Image, error)
+	// Close stops the capture and releases the camera.
+	Close() error
+}
+
+// Driver lists the cameras of a system and opens them.
+type Driver interface {
+	Devices() ([]Device, error)
+	Open(d Device, o Options) (Stream, error)
+}
+
+var (
+	lock    sync.Mutex
+	current Driver = detect(runtime.GOOS, exec.LookPath)
+)
+
+// SetDriver sets the driver used to list and open cameras, replacing the default one.
+func SetDriver(d Driver) {
+	lock.Lock()
+	defer lock.Unlock()
+	current = d
+}
+
+// Devices returns the cameras of the system.
+func Devices() ([]Device, error) {
+	d := driver()
+	if d == nil {
+		return nil, ErrUnsupported
+	}
+	return d.Devices()
+}
+
+// Open starts capturing frames from a camera.
+func Open(d Device, o Options) (Stream, error) {
+	drv := driver()
+	if drv == nil {
+		return nil, ErrUnsupported
+	}
+	if o.Width <= 0 || o.Height <= 0 {
+		o.Width, o.Height = 640, 480
+	}
+	if o.FPS <= 0 {
+		o.FPS = 15
+	}
+	return drv.Open(d, o)
+}
+
+// Supported returns whether there is a driver to capture from cameras.
+func Supported() bool {
+	return driver() != nil
+}
+
+func driver() Driver {
+	lock.Lock()
+	defer lock.Unlock()
+	return current
+}
+
+// detect returns the default driver for a platform, or nil if it has none.
+func detect(goos string, lookPath func(string) (string, error)) Driver {
+	switch goos {
+	case "linux", "darwin", "windows":
+		if path, err := lookPath("ffmpeg"); err == nil {
+			return &ffmpeg{goos: goos, path: path, run: runFFmpeg, start: startFFmpeg}
+		}
+	}
+	return nil
+}
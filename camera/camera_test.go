@@ -0,0 +1,102 @@
+package camera
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	installed := false
+	lookPath := func(name string) (string, error) {
+		if installed && name == "ffmpeg" {
+			return "/usr/bin/ffmpeg", nil
+		}
+		return "", errors.New("not found")
+	}
+
+	assert.Nil(t, detect("linux", lookPath))
+	installed = true
+	assert.Equal(t, "darwin", detect("darwin", lookPath).(*ffmpeg).goos)
+	assert.Nil(t, detect("android", lookPath))
+}
+
+func TestUnsupported(t *testing.T) {
+	previous := driver()
+	SetDriver(nil)
+	defer SetDriver(previous)
+
+	assert.False(t, Supported())
+	_, err := Devices()
+	assert.Equal(t, ErrUnsupported, err)
+	_, err = Open(Device{}, Options{})
+	assert.Equal(t, ErrUnsupported, err)
+}
+
+func TestFFmpeg_Devices(t *testing.T) {
+	root := t.TempDir()
+	for node, files := range map[string][2]string{
+		"video0":  {"Integrated Camera\n", "0\n"},
+		"video1":  {"Integrated Camera\n", "1\n"}, // metadata
+		"video10": {"USB Camera\n", "0\n"},
+		"video2":  {"Virtual Camera\n", "0\n"},
+	} {
+		dir := filepath.Join(root, node)
+		assert.NoError(t, os.Mkdir(dir, 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "name"), []byte(files[0]), 0644))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "index"), []byte(files[1]), 0644))
+	}
+	devices, err := (&ffmpeg{goos: "linux", sysfs: root}).Devices()
+	assert.NoError(t, err)
+	assert.Equal(t, []Device{{"/dev/video0", "Integrated Camera"}, {"/dev/video2", "Virtual Camera"},
+		{"/dev/video10", "USB Camera"}}, devices)
+
+	assert.Equal(t, []Device{{"0", "FaceTime HD Camera"}, {"1", "Capture screen 0"}}, parseAVFoundationDevices(
+		`[AVFoundation indev @ 0x7f8] AVFoundation video devices:
+[AVFoundation indev @ 0x7f8] [0] FaceTime HD Camera
+[AVFoundation indev @ 0x7f8] [1] Capture screen 0
+[AVFoundation indev @ 0x7f8] AVFoundation audio devices:
+[AVFoundation indev @ 0x7f8] [0] MacBook Pro Microphone
+: Input/output error`))
+
+	assert.Equal(t, []Device{{"video=Integrated Webcam", "Integrated Webcam"}}, parseDShowDevices(
+		`[dshow @ 000001] "Integrated Webcam" (video)
+[dshow @ 000001]   Alternative name "@device_pnp_\\?\usb#vid_0c45"
+[dshow @ 000001] "Microphone Array" (audio)
+dummy: Immediate exit requested`))
+}
+
+func TestFFmpeg_Open(t *testing.T) {
+	var started []string
+	frames := append(bytes.Repeat([]byte{0xff, 0, 0, 0xff}, 2), bytes.Repeat([]byte{0, 0xff, 0, 0xff}, 2)...)
+	f := &ffmpeg{goos: "linux", path: "ffmpeg", start: func(name string, args ...string) (io.ReadCloser, error) {
+		started = append([]string{name}, args...)
+		return io.NopCloser(bytes.NewReader(append(frames, 0))), nil
+	}}
+
+	s, err := f.Open(Device{ID: "/dev/video0"}, Options{Width: 2, Height: 1, FPS: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ffmpeg", "-hide_banner", "-loglevel", "error", "-f", "v4l2", "-i", "/dev/video0",
+		"-vf", "scale=2:1:force_original_aspect_ratio=increase,crop=2:1", "-r", "10",
+		"-pix_fmt", "rgba", "-f", "rawvideo", "-"}, started)
+
+	img, err := s.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 2, 1), img.Bounds())
+	r, g, _, _ := img.At(1, 0).RGBA()
+	assert.Equal(t, []uint32{0xffff, 0}, []uint32{r, g})
+	img, err = s.Read()
+	assert.NoError(t, err)
+	r, g, _, _ = img.At(0, 0).RGBA()
+	assert.Equal(t, []uint32{0, 0xffff}, []uint32{r, g})
+
+	_, err = s.Read() // a partial frame
+	assert.Equal(t, io.EOF, err)
+	assert.NoError(t, s.Close())
+}
@@ -0,0 +1,218 @@
+package camera
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	avfoundationDevice = regexp.MustCompile(`\] \[(\d+)\] (.+)$`)
+	dshowDevice        = regexp.MustCompile(`"([^"]+)" \(video\)`)
+)
+
+// ffmpeg captures with the ffmpeg tool, which converts the camera video to raw RGBA frames on its output.
+type ffmpeg struct {
+	goos  string
+	path  string
+	run   func(name string, args ...string) ([]byte, error)
+	start func(name string, args ...string) (io.ReadCloser, error)
+
+	sysfs string // where video4linux devices are listed, for tests
+}
+
+func (f *ffmpeg) Devices() ([]Device, error) {
+	switch f.goos {
+	case "linux":
+		return f.v4l2Devices()
+	case "darwin":
+		out, err := f.run(f.path, "-hide_banner", "-f", "avfoundation", "-list_devices", "true", "-i", "")
+		if len(out) == 0 {
+			return nil, err
+		}
+		return parseAVFoundationDevices(string(out)), nil
+	case "windows":
+		out, err := f.run(f.path, "-hide_banner", "-list_devices", "true", "-f", "dshow", "-i", "dummy")
+		if len(out) == 0 {
+			return nil, err
+		}
+		return parseDShowDevices(string(out)), nil
+	}
+	return nil, ErrUnsupported
+}
+
+func (f *ffmpeg) Open(d Device, o Options) (Stream, error) {
+	out, err := f.start(f.path, f.args(d, o)...)
+	if err != nil {
+		return nil, err
+	}
+	return &rawStream{out: out, width: o.Width, height: o.Height}, nil
+}
+
+// args returns the arguments to capture from a device, ending with the output of raw frames to stdout.
+func (f *ffmpeg) args(d Device, o Options) []string {
+	args := []string{"-hide_banner", "-loglevel", "error"}
+	switch f.goos {
+	case "linux":
+		args = append(args, "-f", "v4l2", "-i", d.ID)
+	case "darwin":
+		// AVFoundation fails for frame rates the camera doesn't offer, and most offer 30
+		args = append(args, "-f", "avfoundation", "-framerate", "30", "-i", d.ID+":none")
+	case "windows":
+		args = append(args, "-f", "dshow", "-i", d.ID)
+	}
+	size := fmt.Sprintf("%d:%d", o.Width, o.Height)
+	return append(args, "-vf", "scale="+size+":force_original_aspect_ratio=increase,crop="+size,
+		"-r", strconv.Itoa(o.FPS), "-pix_fmt", "rgba", "-f", "rawvideo", "-")
+}
+
+// v4l2Devices lists the capture nodes of video4linux devices, skipping their metadata nodes.
+func (f *ffmpeg) v4l2Devices() ([]Device, error) {
+	root := f.sysfs
+	if root == "" {
+		root = "/sys/class/video4linux"
+	}
+	dirs, err := filepath.Glob(filepath.Join(root, "video*"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(dirs, func(i, j int) bool {
+		return videoIndex(dirs[i]) < videoIndex(dirs[j])
+	})
+
+	var devices []Device
+	for _, dir := range dirs {
+		if index, err := os.ReadFile(filepath.Join(dir, "index")); err == nil && strings.TrimSpace(string(index)) != "0" {
+			continue
+		}
+		node := filepath.Base(dir)
+		name := node
+		if data, err := os.ReadFile(filepath.Join(dir, "name")); err == nil {
+			name = strings.TrimSpace(string(data))
+		}
+		devices = append(devices, Device{ID: "/dev/" + node, Name: name})
+	}
+	return devices, nil
+}
+
+func videoIndex(dir string) int {
+	i, _ := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "video"))
+	return i
+}
+
+// parseAVFoundationDevices reads the video devices that ffmpeg lists for AVFoundation.
+func parseAVFoundationDevices(out string) []Device {
+	var devices []Device
+	video := false
+	for _, line := range strings.Split(out, "\n") {
+		switch {
+		case strings.Contains(line, "AVFoundation video devices"):
+			video = true
+		case strings.Contains(line, "AVFoundation audio devices"):
+			video = false
+		case video:
+			if m := avfoundationDevice.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+				devices = append(devices, Device{ID: m[1], Name: m[2]})
+			}
+		}
+	}
+	return devices
+}
+
+// parseDShowDevices reads the video devices that ffmpeg lists for DirectShow.
+func parseDShowDevices(out string) []Device {
+	var devices []Device
+	for _, line := range strings.Split(out, "\n") {
+		if m := dshowDevice.FindStringSubmatch(line); m != nil {
+			devices = append(devices, Device{ID: "video=" + m[1], Name: m[1]})
+		}
+	}
+	return devices
+}
+
+// rawStream reads frames of RGBA pixels of a fixed size.
+type rawStream struct {
+	out           io.ReadCloser
+	width, height int
+}
+
+func (s *rawStream) Close() error {
+	return s.out.Close()
+}
+
+func (s *rawStream) Read() (image.Image, error) {
+	img := image.NewRGBA(image.Rect(0, 0, s.width, s.height))
+	if _, err := io.ReadFull(s.out, img.Pix); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			err = io.EOF
+		}
+		return nil, err
+	}
+	return img, nil
+}
+
+// runFFmpeg runs ffmpeg to list devices, returning the log it writes to stderr.
+func runFFmpeg(name string, args ...string) ([]byte, error) {
+	var log bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = &log
+	err := cmd.Run() // listing devices always exits with an error, as there is no input
+	return log.Bytes(), err
+}
+
+// startFFmpeg starts ffmpeg capturing, returning its output. Closing it stops ffmpeg.
+func startFFmpeg(name string, args ...string) (io.ReadCloser, error) {
+	var log bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = &log
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &process{ReadCloser: out, cmd: cmd, log: &log}, nil
+}
+
+// process is the output of a running ffmpeg.
+type process struct {
+	io.ReadCloser
+	cmd *exec.Cmd
+	log *bytes.Buffer
+
+	once    sync.Once
+	waitErr error
+}
+
+func (p *process) Close() error {
+	_ = p.cmd.Process.Kill()
+	_ = p.wait()
+	return nil
+}
+
+// Read returns the error ffmpeg logged if it stopped, such as when the camera is in use.
+func (p *process) Read(b []byte) (int, error) {
+	n, err := p.ReadCloser.Read(b)
+	if err == io.EOF && p.wait() != nil && p.log.Len() > 0 {
+		err = errors.New(strings.TrimSpace(p.log.String()))
+	}
+	return n, err
+}
+
+func (p *process) wait() error {
+	p.once.Do(func() {
+		p.waitErr = p.cmd.Wait()
+	})
+	return p.waitErr
+}
@@ -0,0 +1,183 @@
+// Package clipboard copies and pastes images and lists of files, which the text clipboard of Fyne does not support.
+//
+// It uses the clipboard tools of each desktop platform: wl-clipboard or xclip on Linux,
+// osascript on macOS and PowerShell on Windows. Supported reports which are available.
+package clipboard // import "fyne.io/x/fyne/clipboard"
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+)
+
+var (
+	// ErrUnsupported is returned where no clipboard tool for images and files is available.
+	ErrUnsupported = errors.New("clipboard images and files are not supported on this system")
+	// ErrEmpty is returned when pasting while the clipboard holds no image, or no files.
+	ErrEmpty = errors.New("the clipboard holds no content of this type")
+)
+
+// Capabilities reports what the clipboard of the system supports.
+type Capabilities struct {
+	Images, Files bool
+}
+
+// backend copies and pastes through the clipboard tool of a platform.
+type backend interface {
+	capabilities() Capabilities
+	copyImage(data []byte) error
+	pasteImage() ([]byte, error)
+	copyFiles(paths []string) error
+	pasteFiles() ([]string, error)
+}
+
+// runner runs a command with the input, returning its output.
+type runner func(input []byte, name string, args ...string) ([]byte, error)
+
+var current = detect(runtime.GOOS, os.Getenv, exec.LookPath, runCommand)
+
+// Supported returns what can be copied and pasted on this system.
+func Supported() Capabilities {
+	if current == nil {
+		return Capabilities{}
+	}
+	return current.capabilities()
+}
+
+// CopyImage puts an image on the clipboard, as a PNG.
+func CopyImage(img image.Image) error {
+	if !Supported().Images {
+		return ErrUnsupported
+	}
+	var data bytes.Buffer
+	if err := png.Encode(&data, img); err != nil {
+		return err
+	}
+	return current.copyImage(data.Bytes())
+}
+
+// PasteImage returns the image on the clipboard, or ErrEmpty if there isn't one.
+func PasteImage() (image.Image, error) {
+	if !Supported().Images {
+		return nil, ErrUnsupported
+	}
+	data, err := current.pasteImage()
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// CopyFiles puts a list of files on the clipboard, to be pasted into a file manager or another app.
+func CopyFiles(files []fyne.URI) error {
+	if !Supported().Files {
+		return ErrUnsupported
+	}
+	paths := make([]string, len(files))
+	for i, u := range files {
+		if u.Scheme() != "file" {
+			return errors.New("only local files can be copied: " + u.String())
+		}
+		paths[i] = u.Path()
+	}
+	return current.copyFiles(paths)
+}
+
+// PasteFiles returns the files on the clipboard, such as copied in a file manager, or ErrEmpty if there are none.
+func PasteFiles() ([]fyne.URI, error) {
+	if !Supported().Files {
+		return nil, ErrUnsupported
+	}
+	paths, err := current.pasteFiles()
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, ErrEmpty
+	}
+	files := make([]fyne.URI, len(paths))
+	for i, p := range paths {
+		files[i] = storage.NewFileURI(p)
+	}
+	return files, nil
+}
+
+// detect chooses the backend for a platform from the tools that are installed.
+func detect(goos string, env func(string) string, lookPath func(string) (string, error), run runner) backend {
+	found := func(name string) bool {
+		_, err := lookPath(name)
+		return err == nil
+	}
+	switch goos {
+	case "darwin":
+		if found("osascript") {
+			return &osascript{run: run}
+		}
+	case "windows":
+		if found("powershell") {
+			return &powershell{run: run}
+		}
+	case "linux", "freebsd", "openbsd", "netbsd":
+		if env("WAYLAND_DISPLAY") != "" && found("wl-copy") && found("wl-paste") {
+			return &unixTool{run: run, wayland: true}
+		}
+		if env("DISPLAY") != "" && found("xclip") {
+			return &unixTool{run: run}
+		}
+	}
+	return nil
+}
+
+// runCommand runs a tool. Copying tools stay in the background to serve the clipboard,
+// so their output is not read, which would wait for them to end.
+func runCommand(input []byte, name string, args ...string) ([]byte, error) {
+	cmd := exec.Command(name, args...)
+	if input != nil {
+		cmd.Stdin = bytes.NewReader(input)
+		return nil, cmd.Run()
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil && stderr.Len() > 0 {
+		return out, errors.New(strings.TrimSpace(stderr.String()))
+	}
+	return out, err
+}
+
+// uriList formats paths as a text/uri-list.
+func uriList(paths []string) []byte {
+	var list strings.Builder
+	for _, p := range paths {
+		list.WriteString((&url.URL{Scheme: "file", Path: p}).String())
+		list.WriteString("\r\n")
+	}
+	return []byte(list.String())
+}
+
+// parseURIList returns the local paths in a text/uri-list.
+func parseURIList(list []byte) []string {
+	var paths []string
+	for _, line := range strings.Split(string(list), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		u, err := url.Parse(line)
+		if err != nil || u.Scheme != "file" {
+			continue
+		}
+		paths = append(paths, u.Path)
+	}
+	return paths
+}
@@ -0,0 +1,138 @@
+package clipboard
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeXclip keeps the clipboard content by type, as xclip would.
+type fakeXclip struct {
+	content map[string][]byte
+}
+
+func (f *fakeXclip) run(input []byte, name string, args ...string) ([]byte, error) {
+	if name != "xclip" {
+		return nil, errors.New("unexpected tool " + name)
+	}
+	target := args[3]
+	if args[4] == "-i" {
+		f.content = map[string][]byte{target: input}
+		return nil, nil
+	}
+	if target == "TARGETS" {
+		var targets []string
+		for t := range f.content {
+			targets = append(targets, t)
+		}
+		return []byte(strings.Join(targets, "\n")), nil
+	}
+	return f.content[target], nil
+}
+
+func useBackend(t *testing.T, b backend) {
+	previous := current
+	current = b
+	t.Cleanup(func() {
+		current = previous
+	})
+}
+
+func TestDetect(t *testing.T) {
+	env := map[string]string{}
+	installed := map[string]bool{}
+	lookPath := func(name string) (string, error) {
+		if installed[name] {
+			return "/usr/bin/" + name, nil
+		}
+		return "", errors.New("not found")
+	}
+	detectFor := func(goos string) backend {
+		return detect(goos, func(key string) string { return env[key] }, lookPath, runCommand)
+	}
+
+	assert.Nil(t, detectFor("linux"))
+	installed["xclip"] = true
+	assert.Nil(t, detectFor("linux"))
+	env["DISPLAY"] = ":0"
+	assert.False(t, detectFor("linux").(*unixTool).wayland)
+	env["WAYLAND_DISPLAY"] = "wayland-0"
+	installed["wl-copy"], installed["wl-paste"] = true, true
+	assert.True(t, detectFor("linux").(*unixTool).wayland)
+
+	assert.Nil(t, detectFor("darwin"))
+	installed["osascript"] = true
+	assert.IsType(t, &osascript{}, detectFor("darwin"))
+	installed["powershell"] = true
+	assert.IsType(t, &powershell{}, detectFor("windows"))
+	assert.Nil(t, detectFor("android"))
+}
+
+func TestUnsupported(t *testing.T) {
+	useBackend(t, nil)
+	assert.Equal(t, Capabilities{}, Supported())
+	assert.Equal(t, ErrUnsupported, CopyImage(image.NewRGBA(image.Rect(0, 0, 1, 1))))
+	_, err := PasteFiles()
+	assert.Equal(t, ErrUnsupported, err)
+}
+
+func TestImage(t *testing.T) {
+	useBackend(t, &unixTool{run: (&fakeXclip{}).run})
+	assert.Equal(t, Capabilities{Images: true, Files: true}, Supported())
+	_, err := PasteImage()
+	assert.Equal(t, ErrEmpty, err)
+
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(1, 1, color.NRGBA{R: 0xff, A: 0xff})
+	assert.NoError(t, CopyImage(img))
+	pasted, err := PasteImage()
+	assert.NoError(t, err)
+	assert.Equal(t, img.Bounds(), pasted.Bounds())
+	assert.Equal(t, color.NRGBA{R: 0xff, A: 0xff}, color.NRGBAModel.Convert(pasted.At(1, 1)))
+
+	_, err = PasteFiles()
+	assert.Equal(t, ErrEmpty, err)
+}
+
+func TestFiles(t *testing.T) {
+	xclip := &fakeXclip{}
+	useBackend(t, &unixTool{run: xclip.run})
+
+	files := []fyne.URI{storage.NewFileURI("/home/me/a b.txt"), storage.NewFileURI("/tmp/c.png")}
+	assert.NoError(t, CopyFiles(files))
+	assert.Equal(t, "file:///home/me/a%20b.txt\r\nfile:///tmp/c.png\r\n", string(xclip.content["text/uri-list"]))
+	pasted, err := PasteFiles()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/home/me/a b.txt", "/tmp/c.png"}, []string{pasted[0].Path(), pasted[1].Path()})
+
+	web, _ := storage.ParseURI("https://fyne.io")
+	assert.Error(t, CopyFiles([]fyne.URI{web}))
+}
+
+func TestParseURIList(t *testing.T) {
+	assert.Equal(t, []string{"/a", "/b c"},
+		parseURIList([]byte("# comment\r\nfile:///a\r\nhttps://fyne.io\r\n\r\nfile:///b%20c\n")))
+}
+
+func TestScripts(t *testing.T) {
+	var commands [][]string
+	record := func(input []byte, name string, args ...string) ([]byte, error) {
+		commands = append(commands, append([]string{name}, args...))
+		return []byte("/Users/me/x.txt\n"), nil
+	}
+
+	assert.NoError(t, (&osascript{run: record}).copyFiles([]string{`/a "b"`, "/c"}))
+	assert.Equal(t, []string{"osascript", "-e", `set the clipboard to {POSIX file "/a \"b\"", POSIX file "/c"}`}, commands[0])
+	paths, err := (&osascript{run: record}).pasteFiles()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/Users/me/x.txt"}, paths)
+
+	assert.NoError(t, (&powershell{run: record}).copyFiles([]string{`C:\it's.txt`}))
+	assert.True(t, strings.HasSuffix(commands[2][4], `Set-Clipboard -LiteralPath 'C:\it''s.txt'`))
+}
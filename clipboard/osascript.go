@@ -0,0 +1,97 @@
+package clipboard
+
+import (
+	"os"
+	"strings"
+)
+
+// osascript uses AppleScript on macOS, passing images through temporary files.
+type osascript struct {
+	run runner
+}
+
+func (o *osascript) capabilities() Capabilities {
+	return Capabilities{Images: true, Files: true}
+}
+
+func (o *osascript) copyFiles(paths []string) error {
+	files := make([]string, len(paths))
+	for i, p := range paths {
+		files[i] = "POSIX file " + appleScriptString(p)
+	}
+	return o.script("set the clipboard to {" + strings.Join(files, ", ") + "}")
+}
+
+func (o *osascript) copyImage(data []byte) error {
+	path, err := writeTemp(data)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+	return o.script("set the clipboard to (read (POSIX file " + appleScriptString(path) + ") as «class PNGf»)")
+}
+
+// pasteFiles returns the first file on the clipboard, as AppleScript can't read the others.
+func (o *osascript) pasteFiles() ([]string, error) {
+	out, err := o.run(nil, "osascript", "-e", `try
+	return POSIX path of (the clipboard as «class furl»)
+on error
+	return ""
+end try`)
+	if err != nil {
+		return nil, err
+	}
+	if path := strings.TrimSpace(string(out)); path != "" {
+		return []string{path}, nil
+	}
+	return nil, nil
+}
+
+func (o *osascript) pasteImage() ([]byte, error) {
+	path, err := writeTemp(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(path)
+	out, err := o.run(nil, "osascript", "-e", `try
+	set img to the clipboard as «class PNGf»
+on error
+	return "empty"
+end try
+set f to open for access POSIX file `+appleScriptString(path)+` with write permission
+write img to f
+close access f`)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(string(out)) == "empty" {
+		return nil, ErrEmpty
+	}
+	return os.ReadFile(path)
+}
+
+func (o *osascript) script(script string) error {
+	_, err := o.run(nil, "osascript", "-e", script)
+	return err
+}
+
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// writeTemp writes data to a new temporary file, returning its path.
+func writeTemp(data []byte) (string, error) {
+	f, err := os.CreateTemp("", "clipboard-*.png")
+	if err != nil {
+		return "", err
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
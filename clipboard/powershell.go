@@ -0,0 +1,78 @@
+package clipboard
+
+import (
+	"os"
+	"strings"
+)
+
+const powershellForms = "Add-Type -AssemblyName System.Windows.Forms,System.Drawing; "
+
+// powershell uses the .NET clipboard through PowerShell on Windows, passing images through temporary files.
+type powershell struct {
+	run runner
+}
+
+func (p *powershell) capabilities() Capabilities {
+	return Capabilities{Images: true, Files: true}
+}
+
+func (p *powershell) copyFiles(paths []string) error {
+	quoted := make([]string, len(paths))
+	for i, path := range paths {
+		quoted[i] = powershellString(path)
+	}
+	_, err := p.script("Set-Clipboard -LiteralPath " + strings.Join(quoted, ","))
+	return err
+}
+
+func (p *powershell) copyImage(data []byte) error {
+	path, err := writeTemp(data)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+	_, err = p.script(powershellForms + "$img = [System.Drawing.Image]::FromFile(" + powershellString(path) + "); " +
+		"[System.Windows.Forms.Clipboard]::SetImage($img); $img.Dispose()")
+	return err
+}
+
+func (p *powershell) pasteFiles() ([]string, error) {
+	out, err := p.script("Get-Clipboard -Format FileDropList | ForEach-Object { $_.FullName }")
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			paths = append(paths, line)
+		}
+	}
+	return paths, nil
+}
+
+func (p *powershell) pasteImage() ([]byte, error) {
+	path, err := writeTemp(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(path)
+	out, err := p.script(powershellForms + "$img = [System.Windows.Forms.Clipboard]::GetImage(); " +
+		"if ($img -eq $null) { 'empty'; exit }; " +
+		"$img.Save(" + powershellString(path) + ", [System.Drawing.Imaging.ImageFormat]::Png)")
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(string(out)) == "empty" {
+		return nil, ErrEmpty
+	}
+	return os.ReadFile(path)
+}
+
+func (p *powershell) script(script string) ([]byte, error) {
+	return p.run(nil, "powershell", "-NoProfile", "-NonInteractive", "-Command",
+		"[Console]::OutputEncoding = [Text.Encoding]::UTF8; "+script)
+}
+
+func powershellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
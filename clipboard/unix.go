@@ -0,0 +1,78 @@
+package clipboard
+
+import (
+	"strings"
+)
+
+const (
+	pngType     = "image/png"
+	uriListType = "text/uri-list"
+)
+
+// unixTool uses wl-clipboard on Wayland or xclip on X11.
+type unixTool struct {
+	run     runner
+	wayland bool
+}
+
+func (u *unixTool) capabilities() Capabilities {
+	return Capabilities{Images: true, Files: true}
+}
+
+func (u *unixTool) copyImage(data []byte) error {
+	return u.copy(pngType, data)
+}
+
+func (u *unixTool) copyFiles(paths []string) error {
+	return u.copy(uriListType, uriList(paths))
+}
+
+func (u *unixTool) pasteImage() ([]byte, error) {
+	return u.paste(pngType)
+}
+
+func (u *unixTool) pasteFiles() ([]string, error) {
+	list, err := u.paste(uriListType)
+	if err != nil {
+		return nil, err
+	}
+	return parseURIList(list), nil
+}
+
+// copy puts data on the clipboard, the tool stays in the background to serve it.
+func (u *unixTool) copy(mime string, data []byte) error {
+	if u.wayland {
+		_, err := u.run(data, "wl-copy", "--type", mime)
+		return err
+	}
+	_, err := u.run(data, "xclip", "-selection", "clipboard", "-t", mime, "-i")
+	return err
+}
+
+// paste returns the clipboard content of a type, or ErrEmpty if it has no content of that type.
+func (u *unixTool) paste(mime string) ([]byte, error) {
+	var types []byte
+	var err error
+	if u.wayland {
+		types, err = u.run(nil, "wl-paste", "--list-types")
+	} else {
+		types, err = u.run(nil, "xclip", "-selection", "clipboard", "-t", "TARGETS", "-o")
+	}
+	if err != nil || !hasLine(types, mime) {
+		return nil, ErrEmpty
+	}
+
+	if u.wayland {
+		return u.run(nil, "wl-paste", "--no-newline", "--type", mime)
+	}
+	return u.run(nil, "xclip", "-selection", "clipboard", "-t", mime, "-o")
+}
+
+func hasLine(text []byte, line string) bool {
+	for _, l := range strings.Split(string(text), "\n") {
+		if strings.TrimSpace(l) == line {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,328 @@
+package container
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*BottomSheet)(nil)
+var _ fyne.Draggable = (*bottomSheetPanel)(nil)
+var _ fyne.Tappable = (*bottomSheetScrim)(nil)
+
+// Snap points of a BottomSheet, as a fraction of the height of the container.
+const (
+	BottomSheetPeek float32 = 0.25
+	BottomSheetHalf float32 = 0.5
+	BottomSheetFull float32 = 1
+)
+
+const (
+	bottomSheetHandleWidth  = 32
+	bottomSheetHandleHeight = 4
+)
+
+// BottomSheet shows a sheet that slides up over its content, dimming the content behind a scrim.
+// The sheet can be dragged between its snap points, and dragged down or tapping the scrim dismisses it.
+type BottomSheet struct {
+	widget.BaseWidget
+
+	Content fyne.CanvasObject
+	Sheet   fyne.CanvasObject
+	// SnapPoints are the heights the sheet rests at, as increasing fractions of the container height.
+	SnapPoints []float32
+
+	OnDismissed func()          `json:"-"`
+	OnSnapped   func(point int) `json:"-"`
+
+	open     bool
+	snap     int
+	fraction float32
+	anim     *fyne.Animation
+}
+
+// NewBottomSheet creates a new closed bottom sheet over content,
+// with peek, half and full height snap points.
+func NewBottomSheet(content, sheet fyne.CanvasObject) *BottomSheet {
+	b := &BottomSheet{Content: content, Sheet: sheet,
+		SnapPoints: []float32{BottomSheetPeek, BottomSheetHalf, BottomSheetFull}}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (b *BottomSheet) CreateRenderer() fyne.WidgetRenderer {
+	b.ExtendBaseWidget(b)
+	scrim := &bottomSheetScrim{sheet: b}
+	scrim.ExtendBaseWidget(scrim)
+	panel := &bottomSheetPanel{sheet: b}
+	panel.ExtendBaseWidget(panel)
+	r := &bottomSheetRenderer{sheet: b, scrim: scrim, panel: panel}
+	r.Refresh()
+	return r
+}
+
+// Dismiss slides the sheet down out of view.
+func (b *BottomSheet) Dismiss() {
+	if !b.open {
+		return
+	}
+	b.open = false
+	b.animateTo(0)
+	if f := b.OnDismissed; f != nil {
+		f()
+	}
+}
+
+// IsOpen returns whether the sheet is shown.
+func (b *BottomSheet) IsOpen() bool {
+	return b.open
+}
+
+// Open slides the sheet up to its first snap point.
+func (b *BottomSheet) Open() {
+	b.SnapTo(0)
+}
+
+// SnapPoint returns the index of the snap point the sheet rests at.
+func (b *BottomSheet) SnapPoint() int {
+	return b.snap
+}
+
+// SnapTo opens the sheet at the snap point with the given index.
+func (b *BottomSheet) SnapTo(point int) {
+	if point < 0 || point >= len(b.SnapPoints) {
+		return
+	}
+	changed := !b.open || point != b.snap
+	b.open = true
+	b.snap = point
+	b.animateTo(b.SnapPoints[point])
+	if f := b.OnSnapped; changed && f != nil {
+		f(point)
+	}
+}
+
+func (b *BottomSheet) animateTo(fraction float32) {
+	if b.anim != nil {
+		b.anim.Stop()
+	}
+	from := b.fraction
+	if b.Size().IsZero() || from == fraction {
+		b.fraction = fraction
+		b.Refresh()
+		return
+	}
+	b.anim = fyne.NewAnimation(canvas.DurationStandard, func(done float32) {
+		b.fraction = from + (fraction-from)*done
+		b.Refresh()
+	})
+	b.anim.Curve = fyne.AnimationEaseOut
+	b.anim.Start()
+}
+
+// dragEnd snaps to the nearest snap point, or dismisses the sheet if it is below half of the lowest.
+func (b *BottomSheet) dragEnd() {
+	if len(b.SnapPoints) == 0 || b.fraction < b.SnapPoints[0]/2 {
+		b.Dismiss()
+		return
+	}
+	nearest := 0
+	for i, p := range b.SnapPoints {
+		if abs32(p-b.fraction) < abs32(b.SnapPoints[nearest]-b.fraction) {
+			nearest = i
+		}
+	}
+	if nearest == b.snap {
+		b.animateTo(b.SnapPoints[nearest])
+		return
+	}
+	b.SnapTo(nearest)
+}
+
+func abs32(f float32) float32 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+type bottomSheetRenderer struct {
+	sheet *BottomSheet
+	scrim *bottomSheetScrim
+	panel *bottomSheetPanel
+}
+
+func (r *bottomSheetRenderer) Destroy() {
+	if r.sheet.anim != nil {
+		r.sheet.anim.Stop()
+	}
+}
+
+func (r *bottomSheetRenderer) Layout(size fyne.Size) {
+	if c := r.sheet.Content; c != nil {
+		c.Resize(size)
+		c.Move(fyne.NewPos(0, 0))
+	}
+	r.scrim.Resize(size)
+	height := size.Height * r.sheet.fraction
+	r.panel.Resize(fyne.NewSize(size.Width, height))
+	r.panel.Move(fyne.NewPos(0, size.Height-height))
+}
+
+func (r *bottomSheetRenderer) MinSize() fyne.Size {
+	if c := r.sheet.Content; c != nil {
+		return c.MinSize()
+	}
+	return fyne.NewSize(0, 0)
+}
+
+func (r *bottomSheetRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.scrim, r.panel}
+	if c := r.sheet.Content; c != nil {
+		objects = append([]fyne.CanvasObject{c}, objects...)
+	}
+	return objects
+}
+
+func (r *bottomSheetRenderer) Refresh() {
+	b := r.sheet
+	if b.fraction > 0 {
+		r.scrim.Show()
+		r.panel.Show()
+	} else {
+		r.scrim.Hide()
+		r.panel.Hide()
+	}
+	r.scrim.Refresh()
+	r.panel.Refresh()
+	r.Layout(b.Size())
+}
+
+// bottomSheetScrim dims the content behind an open sheet and dismisses it when tapped.
+type bottomSheetScrim struct {
+	widget.BaseWidget
+	sheet *BottomSheet
+}
+
+func (s *bottomSheetScrim) CreateRenderer() fyne.WidgetRenderer {
+	bg := canvas.NewRectangle(color.Transparent)
+	r := &bottomSheetScrimRenderer{scrim: s, bg: bg}
+	r.Refresh()
+	return r
+}
+
+func (s *bottomSheetScrim) Tapped(*fyne.PointEvent) {
+	s.sheet.Dismiss()
+}
+
+type bottomSheetScrimRenderer struct {
+	scrim *bottomSheetScrim
+	bg    *canvas.Rectangle
+}
+
+func (r *bottomSheetScrimRenderer) Destroy() {
+}
+
+func (r *bottomSheetScrimRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+}
+
+func (r *bottomSheetScrimRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+func (r *bottomSheetScrimRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg}
+}
+
+func (r *bottomSheetScrimRenderer) Refresh() {
+	// the scrim darkens as the sheet rises, reaching full strength at half height
+	strength := fyne.Min(1, r.scrim.sheet.fraction*2)
+	shadow := color.NRGBAModel.Convert(theme.Color(theme.ColorNameShadow)).(color.NRGBA)
+	shadow.A = uint8(float32(shadow.A) * strength)
+	r.bg.FillColor = shadow
+	r.bg.Refresh()
+}
+
+// bottomSheetPanel is the sheet itself, with a drag handle above the sheet content.
+type bottomSheetPanel struct {
+	widget.BaseWidget
+	sheet *BottomSheet
+}
+
+func (p *bottomSheetPanel) CreateRenderer() fyne.WidgetRenderer {
+	bg := canvas.NewRectangle(theme.Color(theme.ColorNameOverlayBackground))
+	handle := canvas.NewRectangle(theme.Color(theme.ColorNameDisabled))
+	handle.CornerRadius = bottomSheetHandleHeight / 2
+	r := &bottomSheetPanelRenderer{panel: p, bg: bg, handle: handle}
+	r.Refresh()
+	return r
+}
+
+func (p *bottomSheetPanel) Dragged(ev *fyne.DragEvent) {
+	b := p.sheet
+	height := b.Size().Height
+	if height <= 0 {
+		return
+	}
+	if b.anim != nil {
+		b.anim.Stop()
+	}
+	max := float32(1)
+	if len(b.SnapPoints) > 0 {
+		max = b.SnapPoints[len(b.SnapPoints)-1]
+	}
+	b.fraction = fyne.Max(0, fyne.Min(max, b.fraction-ev.Dragged.DY/height))
+	b.Refresh()
+}
+
+func (p *bottomSheetPanel) DragEnd() {
+	p.sheet.dragEnd()
+}
+
+type bottomSheetPanelRenderer struct {
+	panel      *bottomSheetPanel
+	bg, handle *canvas.Rectangle
+}
+
+func (r *bottomSheetPanelRenderer) Destroy() {
+}
+
+func (r *bottomSheetPanelRenderer) Layout(size fyne.Size) {
+	pad := theme.Padding()
+	r.bg.Resize(fyne.NewSize(size.Width, size.Height+r.bg.CornerRadius))
+	r.handle.Resize(fyne.NewSize(bottomSheetHandleWidth, bottomSheetHandleHeight))
+	r.handle.Move(fyne.NewPos((size.Width-bottomSheetHandleWidth)/2, pad*2))
+
+	if s := r.panel.sheet.Sheet; s != nil {
+		top := pad*4 + bottomSheetHandleHeight
+		s.Move(fyne.NewPos(pad, top))
+		s.Resize(fyne.NewSize(size.Width-pad*2, fyne.Max(0, size.Height-top-pad)))
+	}
+}
+
+func (r *bottomSheetPanelRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+func (r *bottomSheetPanelRenderer) Objects() []fyne.CanvasObject {
+	if s := r.panel.sheet.Sheet; s != nil {
+		return []fyne.CanvasObject{r.bg, r.handle, s}
+	}
+	return []fyne.CanvasObject{r.bg, r.handle}
+}
+
+func (r *bottomSheetPanelRenderer) Refresh() {
+	r.bg.FillColor = theme.Color(theme.ColorNameOverlayBackground)
+	r.bg.CornerRadius = theme.InputRadiusSize() * 2
+	r.handle.FillColor = theme.Color(theme.ColorNameDisabled)
+	r.bg.Refresh()
+	r.handle.Refresh()
+	r.Layout(r.panel.Size())
+}
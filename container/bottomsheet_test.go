@@ -0,0 +1,80 @@
+package container
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBottomSheet_Snap(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	b := NewBottomSheet(widget.NewLabel("content"), widget.NewLabel("sheet"))
+	snapped := -1
+	b.OnSnapped = func(point int) {
+		snapped = point
+	}
+	w := test.NewWindow(b)
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 400))
+
+	r := test.WidgetRenderer(b).(*bottomSheetRenderer)
+	assert.False(t, r.panel.Visible())
+
+	b.Open()
+	assert.True(t, b.IsOpen())
+	assert.Equal(t, 0, snapped)
+	time.Sleep(canvas.DurationStandard + 100*time.Millisecond)
+	assert.InDelta(t, b.Size().Height*BottomSheetPeek, r.panel.Size().Height, 0.5)
+
+	b.SnapTo(2)
+	assert.Equal(t, 2, b.SnapPoint())
+	assert.Equal(t, 2, snapped)
+}
+
+func TestBottomSheet_Drag(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	b := NewBottomSheet(widget.NewLabel("content"), widget.NewLabel("sheet"))
+	dismissed := false
+	b.OnDismissed = func() {
+		dismissed = true
+	}
+	w := test.NewWindow(b)
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 400))
+	b.fraction = BottomSheetPeek
+	b.open = true
+
+	panel := test.WidgetRenderer(b).(*bottomSheetRenderer).panel
+	height := b.Size().Height
+	panel.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DY: -height * 0.3}})
+	panel.DragEnd()
+	assert.Equal(t, 1, b.SnapPoint())
+
+	b.fraction = BottomSheetHalf
+	panel.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DY: height * 0.45}})
+	panel.DragEnd()
+	assert.False(t, b.IsOpen())
+	assert.True(t, dismissed)
+}
+
+func TestBottomSheet_ScrimDismisses(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	b := NewBottomSheet(widget.NewLabel("content"), widget.NewLabel("sheet"))
+	w := test.NewWindow(b)
+	defer w.Close()
+
+	b.Open()
+	test.Tap(test.WidgetRenderer(b).(*bottomSheetRenderer).scrim)
+	assert.False(t, b.IsOpen())
+}
@@ -0,0 +1,365 @@
+package container
+
+import (
+	"image/color"
+	"math"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Carousel)(nil)
+var _ fyne.Draggable = (*Carousel)(nil)
+var _ fyne.Layout = (*carouselLayout)(nil)
+var _ fyne.Tappable = (*carouselDot)(nil)
+
+const carouselDotSize = 8
+
+// Carousel shows one page at a time, swiping or dragging moves to the adjacent pages.
+// Indicator dots below the pages show the current page and can be tapped to jump to one.
+// Pages can advance automatically with SetAutoplay, and with Peek the edges of the
+// adjacent pages are visible either side of the current one.
+type Carousel struct {
+	widget.BaseWidget
+
+	Pages []fyne.CanvasObject
+	// HideIndicator removes the page indicator dots.
+	HideIndicator bool
+	// Loop moves from the last page to the first, and back, when navigating past the end.
+	Loop bool
+	// Peek is the width of the adjacent pages shown either side of the current page.
+	Peek float32
+
+	OnPageChanged func(page int) `json:"-"`
+
+	current  int
+	position float32 // the page shown, fractional while dragging or animating
+	dragging bool
+	anim     *fyne.Animation
+
+	autoLock sync.Mutex
+	autoplay time.Duration
+	autoStop chan struct{}
+}
+
+// NewCarousel creates a new carousel showing the given pages.
+func NewCarousel(pages ...fyne.CanvasObject) *Carousel {
+	c := &Carousel{Pages: pages}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// Append adds a page to the end of the carousel.
+func (c *Carousel) Append(page fyne.CanvasObject) {
+	c.Pages = append(c.Pages, page)
+	c.Refresh()
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (c *Carousel) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+	pages := container.New(&carouselLayout{carousel: c})
+	clip := container.NewScroll(pages)
+	clip.Direction = container.ScrollNone
+	r := &carouselRenderer{carousel: c, pages: pages, clip: clip, dots: container.NewHBox()}
+	r.Refresh()
+	return r
+}
+
+// Dragged moves the pages with the pointer.
+//
+// Implements: fyne.Draggable
+func (c *Carousel) Dragged(ev *fyne.DragEvent) {
+	if len(c.Pages) == 0 {
+		return
+	}
+	if c.anim != nil {
+		c.anim.Stop()
+	}
+	c.dragging = true
+	c.position -= ev.Dragged.DX / c.pageStride()
+	c.position = float32(math.Max(-0.5, math.Min(float64(len(c.Pages))-0.5, float64(c.position))))
+	c.Refresh()
+}
+
+// DragEnd snaps to the nearest page, moving at least one page if dragged a quarter of the way.
+//
+// Implements: fyne.Draggable
+func (c *Carousel) DragEnd() {
+	c.dragging = false
+	page := c.current
+	if delta := c.position - float32(c.current); delta > 0.25 {
+		page = c.current + int(math.Ceil(float64(delta-0.25)))
+	} else if delta < -0.25 {
+		page = c.current - int(math.Ceil(float64(-delta-0.25)))
+	}
+	if page < 0 || page >= len(c.Pages) {
+		page = c.current
+	}
+	c.SetPage(page)
+	if page == c.current {
+		c.scrollTo(float32(page))
+	}
+	c.restartAutoplay()
+}
+
+// Next moves to the following page, wrapping to the first if Loop is set.
+func (c *Carousel) Next() {
+	page := c.current + 1
+	if page >= len(c.Pages) {
+		if !c.Loop {
+			return
+		}
+		page = 0
+	}
+	c.SetPage(page)
+}
+
+// Page returns the index of the current page.
+func (c *Carousel) Page() int {
+	return c.current
+}
+
+// Previous moves to the preceding page, wrapping to the last if Loop is set.
+func (c *Carousel) Previous() {
+	page := c.current - 1
+	if page < 0 {
+		if !c.Loop {
+			return
+		}
+		page = len(c.Pages) - 1
+	}
+	c.SetPage(page)
+}
+
+// SetAutoplay advances to the next page at the given interval, looping at the end.
+// Dragging restarts the interval and zero stops it.
+func (c *Carousel) SetAutoplay(interval time.Duration) {
+	c.autoLock.Lock()
+	defer c.autoLock.Unlock()
+	if c.autoStop != nil {
+		close(c.autoStop)
+		c.autoStop = nil
+	}
+	c.autoplay = interval
+	if interval <= 0 {
+		return
+	}
+
+	stop := make(chan struct{})
+	c.autoStop = stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if c.dragging || len(c.Pages) == 0 {
+					continue
+				}
+				c.SetPage((c.current + 1) % len(c.Pages))
+			}
+		}
+	}()
+}
+
+// SetPage moves to the page at index, animating the transition.
+func (c *Carousel) SetPage(index int) {
+	if index < 0 || index >= len(c.Pages) || index == c.current {
+		return
+	}
+	c.current = index
+	c.scrollTo(float32(index))
+	if f := c.OnPageChanged; f != nil {
+		f(index)
+	}
+}
+
+func (c *Carousel) pageStride() float32 {
+	width := c.Size().Width - c.Peek*2
+	if width <= 0 {
+		return 1
+	}
+	return width + theme.Padding()
+}
+
+func (c *Carousel) restartAutoplay() {
+	c.autoLock.Lock()
+	interval := c.autoplay
+	c.autoLock.Unlock()
+	if interval > 0 {
+		c.SetAutoplay(interval)
+	}
+}
+
+func (c *Carousel) scrollTo(page float32) {
+	if c.anim != nil {
+		c.anim.Stop()
+	}
+	from := c.position
+	if c.Size().IsZero() || from == page {
+		c.position = page
+		c.Refresh()
+		return
+	}
+	c.anim = fyne.NewAnimation(canvas.DurationStandard, func(done float32) {
+		c.position = from + (page-from)*done
+		c.Refresh()
+	})
+	c.anim.Curve = fyne.AnimationEaseOut
+	c.anim.Start()
+}
+
+type carouselRenderer struct {
+	carousel *Carousel
+	pages    *fyne.Container
+	clip     *container.Scroll
+	dots     *fyne.Container
+}
+
+func (r *carouselRenderer) Destroy() {
+	r.carousel.SetAutoplay(0)
+	if r.carousel.anim != nil {
+		r.carousel.anim.Stop()
+	}
+}
+
+func (r *carouselRenderer) Layout(size fyne.Size) {
+	pages := size
+	if !r.dots.Hidden {
+		dots := r.dots.MinSize()
+		pages.Height -= dots.Height + theme.Padding()
+		r.dots.Resize(dots)
+		r.dots.Move(fyne.NewPos((size.Width-dots.Width)/2, size.Height-dots.Height))
+	}
+	r.clip.Resize(pages)
+	r.pages.Layout.Layout(r.pages.Objects, pages)
+}
+
+func (r *carouselRenderer) MinSize() fyne.Size {
+	min := r.pages.MinSize()
+	min.Width += r.carousel.Peek * 2
+	if !r.dots.Hidden {
+		dots := r.dots.MinSize()
+		min = fyne.NewSize(fyne.Max(min.Width, dots.Width), min.Height+dots.Height+theme.Padding())
+	}
+	return min
+}
+
+func (r *carouselRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.clip, r.dots}
+}
+
+func (r *carouselRenderer) Refresh() {
+	c := r.carousel
+	if len(r.pages.Objects) != len(c.Pages) {
+		r.pages.Objects = append([]fyne.CanvasObject{}, c.Pages...)
+	} else {
+		copy(r.pages.Objects, c.Pages)
+	}
+
+	if len(r.dots.Objects) != len(c.Pages) {
+		r.dots.Objects = nil
+		for i := range c.Pages {
+			r.dots.Add(newCarouselDot(c, i))
+		}
+	}
+	for _, d := range r.dots.Objects {
+		d.Refresh()
+	}
+	r.dots.Hidden = c.HideIndicator || len(c.Pages) < 2
+
+	r.Layout(c.Size())
+}
+
+// carouselLayout positions the pages either side of the current position of the carousel.
+type carouselLayout struct {
+	carousel *Carousel
+}
+
+func (l *carouselLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	c := l.carousel
+	stride := c.pageStride()
+	page := fyne.NewSize(stride-theme.Padding(), size.Height)
+	for i, o := range objects {
+		offset := float32(i) - c.position
+		if offset <= -2 || offset >= 2 {
+			o.Hide()
+			continue
+		}
+		o.Show()
+		o.Resize(page)
+		o.Move(fyne.NewPos(c.Peek+offset*stride, 0))
+	}
+}
+
+func (l *carouselLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	min := fyne.NewSize(0, 0)
+	for _, o := range objects {
+		min = min.Max(o.MinSize())
+	}
+	return min
+}
+
+// carouselDot is a page indicator that moves to its page when tapped.
+type carouselDot struct {
+	widget.BaseWidget
+	carousel *Carousel
+	page     int
+}
+
+func newCarouselDot(c *Carousel, page int) *carouselDot {
+	d := &carouselDot{carousel: c, page: page}
+	d.ExtendBaseWidget(d)
+	return d
+}
+
+func (d *carouselDot) CreateRenderer() fyne.WidgetRenderer {
+	circle := canvas.NewCircle(color.Transparent)
+	r := &carouselDotRenderer{dot: d, circle: circle}
+	r.Refresh()
+	return r
+}
+
+func (d *carouselDot) Tapped(*fyne.PointEvent) {
+	d.carousel.SetPage(d.page)
+}
+
+type carouselDotRenderer struct {
+	dot    *carouselDot
+	circle *canvas.Circle
+}
+
+func (r *carouselDotRenderer) Destroy() {
+}
+
+func (r *carouselDotRenderer) Layout(size fyne.Size) {
+	r.circle.Resize(fyne.NewSquareSize(carouselDotSize))
+	r.circle.Move(fyne.NewPos((size.Width-carouselDotSize)/2, (size.Height-carouselDotSize)/2))
+}
+
+func (r *carouselDotRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(carouselDotSize + theme.Padding())
+}
+
+func (r *carouselDotRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.circle}
+}
+
+func (r *carouselDotRenderer) Refresh() {
+	r.circle.FillColor = theme.Color(theme.ColorNameDisabled)
+	if r.dot.carousel.current == r.dot.page {
+		r.circle.FillColor = theme.Color(theme.ColorNamePrimary)
+	}
+	r.Layout(r.dot.Size())
+	r.circle.Refresh()
+}
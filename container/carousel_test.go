@@ -0,0 +1,95 @@
+package container
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCarousel_Navigation(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	changed := -1
+	c := NewCarousel(widget.NewLabel("one"), widget.NewLabel("two"), widget.NewLabel("three"))
+	c.OnPageChanged = func(page int) {
+		changed = page
+	}
+	w := test.NewWindow(c)
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 200))
+
+	c.Next()
+	assert.Equal(t, 1, c.Page())
+	assert.Equal(t, 1, changed)
+	c.Next()
+	c.Next()
+	assert.Equal(t, 2, c.Page())
+
+	c.Loop = true
+	c.Next()
+	assert.Equal(t, 0, c.Page())
+	c.Previous()
+	assert.Equal(t, 2, c.Page())
+
+	time.Sleep(canvas.DurationStandard + 100*time.Millisecond)
+	assert.Equal(t, float32(2), c.position)
+	assert.True(t, c.Pages[0].Visible() == false)
+	assert.True(t, c.Pages[2].Visible())
+}
+
+func TestCarousel_Drag(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCarousel(widget.NewLabel("one"), widget.NewLabel("two"))
+	w := test.NewWindow(c)
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 200))
+
+	c.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DX: -20}})
+	c.DragEnd()
+	assert.Equal(t, 0, c.Page())
+
+	c.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DX: -c.Size().Width / 2}})
+	c.DragEnd()
+	assert.Equal(t, 1, c.Page())
+
+	c.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DX: -c.Size().Width}})
+	c.DragEnd()
+	assert.Equal(t, 1, c.Page())
+}
+
+func TestCarousel_Indicator(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCarousel(widget.NewLabel("one"), widget.NewLabel("two"), widget.NewLabel("three"))
+	w := test.NewWindow(c)
+	defer w.Close()
+
+	r := test.WidgetRenderer(c).(*carouselRenderer)
+	assert.Len(t, r.dots.Objects, 3)
+	test.Tap(r.dots.Objects[2].(*carouselDot))
+	assert.Equal(t, 2, c.Page())
+
+	c.HideIndicator = true
+	c.Refresh()
+	assert.True(t, r.dots.Hidden)
+}
+
+func TestCarousel_Autoplay(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCarousel(widget.NewLabel("one"), widget.NewLabel("two"))
+	c.SetAutoplay(20 * time.Millisecond)
+	time.Sleep(30 * time.Millisecond)
+	c.SetAutoplay(0)
+	assert.Equal(t, 1, c.Page())
+}
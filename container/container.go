@@ -0,0 +1,2 @@
+// Package container contains community extensions for Fyne containers
+package container // import "fyne.io/x/fyne/container"
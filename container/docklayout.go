@@ -0,0 +1,640 @@
+package container
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*DockLayout)(nil)
+var _ fyne.Widget = (*dockSplitter)(nil)
+var _ fyne.Draggable = (*dockSplitter)(nil)
+var _ desktop.Cursorable = (*dockSplitter)(nil)
+
+const (
+	dockCenterMinSize = 100
+	dockEdgeMinSize   = 48
+	dockSideSize      = 240 // the default width of the left and right areas
+	dockEndSize       = 160 // the default height of the top and bottom areas
+)
+
+// DockArea is a part of a DockLayout that panels are docked in.
+type DockArea int
+
+const (
+	// DockCenter is the area in the middle, which takes the space that the edges leave.
+	DockCenter DockArea = iota
+	// DockLeft is the area along the leading edge, as tall as the layout.
+	DockLeft
+	// DockRight is the area along the trailing edge, as tall as the layout.
+	DockRight
+	// DockTop is the area along the top edge, between the left and right areas.
+	DockTop
+	// DockBottom is the area along the bottom edge, between the left and right areas.
+	DockBottom
+)
+
+var dockAreaNames = [...]string{"center", "left", "right", "top", "bottom"}
+
+// MarshalText returns the name of the area, such as "left", which is how it is saved as JSON.
+func (a DockArea) MarshalText() ([]byte, error) {
+	if a < DockCenter || a > DockBottom {
+		return nil, fmt.Errorf("unknown dock area %d", int(a))
+	}
+	return []byte(dockAreaNames[a]), nil
+}
+
+// UnmarshalText reads the name of an area, such as "left".
+func (a *DockArea) UnmarshalText(text []byte) error {
+	for i, name := range dockAreaNames {
+		if name == string(text) {
+			*a = DockArea(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown dock area %q", text)
+}
+
+// DockPanel is a piece of content in a DockLayout, such as a file browser or a console.
+type DockPanel struct {
+	// ID identifies the panel in a saved DockState, it should not change between releases.
+	ID      string
+	Title   string
+	Icon    fyne.Resource
+	Content fyne.CanvasObject
+}
+
+// NewDockPanel creates a new panel showing content under a title.
+func NewDockPanel(id, title string, content fyne.CanvasObject) *DockPanel {
+	return &DockPanel{ID: id, Title: title, Content: content}
+}
+
+// DockState is the arrangement of the panels of a DockLayout.
+// It can be saved as JSON, such as in the app preferences, and restored with SetState.
+type DockState struct {
+	Areas    []DockAreaState  `json:"areas"`
+	Floating []DockFloatState `json:"floating,omitempty"`
+}
+
+// DockAreaState is the arrangement of the panels docked in an area.
+type DockAreaState struct {
+	Area     DockArea `json:"area"`
+	Panels   []string `json:"panels"`
+	Selected string   `json:"selected,omitempty"`
+	// Size is the width of the left and right areas or the height of the top and bottom areas.
+	Size float32 `json:"size,omitempty"`
+}
+
+// DockFloatState is a panel floating in its own window.
+type DockFloatState struct {
+	Panel string `json:"panel"`
+	// Home is the area the panel is docked in again when its window is closed.
+	Home   DockArea `json:"home"`
+	Width  float32  `json:"width"`
+	Height float32  `json:"height"`
+}
+
+// DockLayout is an IDE style workspace of panels docked in a center area and along its edges.
+// The panels of an area are tabbed together, the edges are resized by dragging the splitters beside them,
+// and the menu of an area moves its selected panel to another area or floats it in its own window.
+// The arrangement can be saved with State and restored with SetState.
+type DockLayout struct {
+	widget.BaseWidget
+
+	// OnChanged is called when the panels are rearranged or resized, such as to save the State.
+	OnChanged func() `json:"-"`
+
+	panels   map[string]*DockPanel
+	areas    [DockBottom + 1]dockArea
+	floating map[string]*dockFloat
+}
+
+type dockArea struct {
+	panels   []string
+	selected string
+	size     float32
+}
+
+type dockFloat struct {
+	window fyne.Window
+	home   DockArea
+}
+
+// NewDockLayout creates a new workspace without panels, they are added with AddPanel.
+func NewDockLayout() *DockLayout {
+	d := &DockLayout{panels: make(map[string]*DockPanel), floating: make(map[string]*dockFloat)}
+	d.ExtendBaseWidget(d)
+	return d
+}
+
+// AddPanel docks a panel in an area and selects it, a panel that was added already is moved there.
+func (d *DockLayout) AddPanel(p *DockPanel, area DockArea) {
+	d.panels[p.ID] = p
+	d.Dock(p.ID, area)
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (d *DockLayout) CreateRenderer() fyne.WidgetRenderer {
+	d.ExtendBaseWidget(d)
+	r := &dockLayoutRenderer{dock: d}
+	for i := range r.areas {
+		r.areas[i] = newDockAreaView(d, DockArea(i))
+	}
+	for i := range r.splitters {
+		r.splitters[i] = newDockSplitter(d, DockArea(i+1))
+	}
+	r.Refresh()
+	return r
+}
+
+// Dock moves a panel to an area and selects it, closing its window if it was floating.
+func (d *DockLayout) Dock(id string, area DockArea) {
+	if d.panels[id] == nil || area < DockCenter || area > DockBottom {
+		return
+	}
+	d.detach(id)
+	a := &d.areas[area]
+	a.panels = append(a.panels, id)
+	a.selected = id
+	d.Refresh()
+	d.changed()
+}
+
+// Float moves a panel to its own window, it is docked again in the same area when the window is closed.
+func (d *DockLayout) Float(id string) {
+	p := d.panels[id]
+	if p == nil || d.floating[id] != nil {
+		return
+	}
+	size := fyne.NewSize(0, 0)
+	if p.Content != nil {
+		size = p.Content.Size().Max(p.Content.MinSize())
+	}
+	d.float(p, d.areaOf(id), size)
+	d.Refresh()
+	d.changed()
+}
+
+// Panel returns the panel added with an ID, or nil.
+func (d *DockLayout) Panel(id string) *DockPanel {
+	return d.panels[id]
+}
+
+// RemovePanel takes a panel out of the layout, closing its window if it is floating.
+func (d *DockLayout) RemovePanel(id string) {
+	if d.panels[id] == nil {
+		return
+	}
+	d.detach(id)
+	delete(d.panels, id)
+	d.Refresh()
+	d.changed()
+}
+
+// Select shows a panel in front of the others tabbed with it.
+func (d *DockLayout) Select(id string) {
+	for i := range d.areas {
+		if a := &d.areas[i]; dockIndex(a.panels, id) >= 0 && a.selected != id {
+			a.selected = id
+			d.Refresh()
+			d.changed()
+			return
+		}
+	}
+}
+
+// SetAreaSize changes the width of the left or right area, or the height of the top or bottom area.
+func (d *DockLayout) SetAreaSize(area DockArea, size float32) {
+	if area <= DockCenter || area > DockBottom {
+		return
+	}
+	d.areas[area].size = fyne.Max(size, dockEdgeMinSize)
+	d.Refresh()
+	d.changed()
+}
+
+// SetState restores an arrangement saved with State.
+// Panels are only moved if they were added, and those that are not in the state stay where they are.
+func (d *DockLayout) SetState(state DockState) {
+	for _, s := range state.Areas {
+		if s.Area < DockCenter || s.Area > DockBottom {
+			continue
+		}
+		a := &d.areas[s.Area]
+		for _, id := range s.Panels {
+			if d.panels[id] != nil {
+				d.detach(id)
+				a.panels = append(a.panels, id)
+			}
+		}
+		if dockIndex(a.panels, s.Selected) >= 0 {
+			a.selected = s.Selected
+		}
+		if s.Area != DockCenter && s.Size > 0 {
+			a.size = fyne.Max(s.Size, dockEdgeMinSize)
+		}
+	}
+	for _, s := range state.Floating {
+		p := d.panels[s.Panel]
+		if p == nil || s.Home < DockCenter || s.Home > DockBottom {
+			continue
+		}
+		d.detach(s.Panel)
+		d.float(p, s.Home, fyne.NewSize(s.Width, s.Height))
+	}
+	d.Refresh()
+	d.changed()
+}
+
+// State returns the arrangement of the panels, which can be saved as JSON and restored with SetState.
+func (d *DockLayout) State() DockState {
+	var state DockState
+	for i, a := range d.areas {
+		if len(a.panels) == 0 {
+			continue
+		}
+		s := DockAreaState{Area: DockArea(i), Panels: append([]string(nil), a.panels...), Selected: a.selected}
+		if DockArea(i) != DockCenter {
+			s.Size = d.areaSize(DockArea(i))
+		}
+		state.Areas = append(state.Areas, s)
+	}
+	ids := make([]string, 0, len(d.floating))
+	for id := range d.floating {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		f := d.floating[id]
+		size := f.window.Canvas().Size()
+		state.Floating = append(state.Floating, DockFloatState{Panel: id, Home: f.home, Width: size.Width, Height: size.Height})
+	}
+	return state
+}
+
+// areaOf returns the area a panel is docked in, or its home area if it is floating.
+func (d *DockLayout) areaOf(id string) DockArea {
+	if f := d.floating[id]; f != nil {
+		return f.home
+	}
+	for i, a := range d.areas {
+		if dockIndex(a.panels, id) >= 0 {
+			return DockArea(i)
+		}
+	}
+	return DockCenter
+}
+
+// areaSize returns the width or height of an edge area.
+func (d *DockLayout) areaSize(area DockArea) float32 {
+	if size := d.areas[area].size; size > 0 {
+		return size
+	}
+	if area == DockLeft || area == DockRight {
+		return dockSideSize
+	}
+	return dockEndSize
+}
+
+func (d *DockLayout) changed() {
+	if f := d.OnChanged; f != nil {
+		f()
+	}
+}
+
+// detach takes a panel out of its area, or its window if it is floating.
+func (d *DockLayout) detach(id string) {
+	if f := d.floating[id]; f != nil {
+		delete(d.floating, id)
+		f.window.SetContent(container.NewStack()) // release the content so that it can be shown here again
+		f.window.Close()
+		return
+	}
+	for i := range d.areas {
+		a := &d.areas[i]
+		j := dockIndex(a.panels, id)
+		if j < 0 {
+			continue
+		}
+		a.panels = append(a.panels[:j:j], a.panels[j+1:]...)
+		if a.selected == id {
+			// select the tab that takes its place, or the one before it at the end
+			a.selected = ""
+			if j == len(a.panels) {
+				j--
+			}
+			if j >= 0 {
+				a.selected = a.panels[j]
+			}
+		}
+		return
+	}
+}
+
+func (d *DockLayout) float(p *DockPanel, home DockArea, size fyne.Size) {
+	d.detach(p.ID)
+	w := fyne.CurrentApp().NewWindow(p.Title)
+	if p.Icon != nil {
+		w.SetIcon(p.Icon)
+	}
+	d.floating[p.ID] = &dockFloat{window: w, home: home}
+	w.SetOnClosed(func() {
+		if d.floating[p.ID] != nil && d.floating[p.ID].window == w {
+			delete(d.floating, p.ID)
+			d.Dock(p.ID, home)
+		}
+	})
+	if p.Content != nil {
+		w.SetContent(p.Content)
+	}
+	if !size.IsZero() {
+		w.Resize(size)
+	}
+	w.Show()
+}
+
+// resize moves the splitter of an edge area, keeping the center at least its minimum size.
+func (d *DockLayout) resize(area DockArea, delta float32) {
+	total, opposite := d.Size().Width, DockRight
+	switch area {
+	case DockRight:
+		opposite = DockLeft
+	case DockTop:
+		total, opposite = d.Size().Height, DockBottom
+	case DockBottom:
+		total, opposite = d.Size().Height, DockTop
+	}
+	limit := total - dockCenterMinSize
+	if len(d.areas[opposite].panels) > 0 {
+		limit -= d.areaSize(opposite)
+	}
+	size := fyne.Min(d.areaSize(area)+delta, limit)
+	d.areas[area].size = fyne.Max(size, dockEdgeMinSize)
+	d.Refresh()
+}
+
+func dockIndex(panels []string, id string) int {
+	for i, existing := range panels {
+		if existing == id {
+			return i
+		}
+	}
+	return -1
+}
+
+type dockLayoutRenderer struct {
+	dock      *DockLayout
+	areas     [DockBottom + 1]*dockAreaView
+	splitters [4]*dockSplitter // of the left, right, top and bottom areas
+}
+
+func (r *dockLayoutRenderer) Destroy() {
+}
+
+func (r *dockLayoutRenderer) Layout(size fyne.Size) {
+	d := r.dock
+	bar := theme.Padding()
+	edge := func(area DockArea, total float32) float32 {
+		if len(d.areas[area].panels) == 0 {
+			return 0
+		}
+		return fyne.Max(0, fyne.Min(d.areaSize(area), total))
+	}
+
+	left := edge(DockLeft, size.Width-dockCenterMinSize)
+	right := edge(DockRight, size.Width-dockCenterMinSize-left)
+	x0, x1 := float32(0), size.Width
+	if left > 0 {
+		r.place(DockLeft, fyne.NewPos(0, 0), fyne.NewSize(left, size.Height))
+		r.splitters[DockLeft-1].Move(fyne.NewPos(left, 0))
+		r.splitters[DockLeft-1].Resize(fyne.NewSize(bar, size.Height))
+		x0 = left + bar
+	}
+	if right > 0 {
+		r.place(DockRight, fyne.NewPos(size.Width-right, 0), fyne.NewSize(right, size.Height))
+		r.splitters[DockRight-1].Move(fyne.NewPos(size.Width-right-bar, 0))
+		r.splitters[DockRight-1].Resize(fyne.NewSize(bar, size.Height))
+		x1 = size.Width - right - bar
+	}
+
+	width := fyne.Max(0, x1-x0)
+	top := edge(DockTop, size.Height-dockCenterMinSize)
+	bottom := edge(DockBottom, size.Height-dockCenterMinSize-top)
+	y0, y1 := float32(0), size.Height
+	if top > 0 {
+		r.place(DockTop, fyne.NewPos(x0, 0), fyne.NewSize(width, top))
+		r.splitters[DockTop-1].Move(fyne.NewPos(x0, top))
+		r.splitters[DockTop-1].Resize(fyne.NewSize(width, bar))
+		y0 = top + bar
+	}
+	if bottom > 0 {
+		r.place(DockBottom, fyne.NewPos(x0, size.Height-bottom), fyne.NewSize(width, bottom))
+		r.splitters[DockBottom-1].Move(fyne.NewPos(x0, size.Height-bottom-bar))
+		r.splitters[DockBottom-1].Resize(fyne.NewSize(width, bar))
+		y1 = size.Height - bottom - bar
+	}
+	r.place(DockCenter, fyne.NewPos(x0, y0), fyne.NewSize(width, fyne.Max(0, y1-y0)))
+}
+
+func (r *dockLayoutRenderer) MinSize() fyne.Size {
+	d := r.dock
+	bar := theme.Padding()
+	min := r.areas[DockCenter].box.MinSize().Max(fyne.NewSquareSize(dockCenterMinSize))
+	for _, area := range []DockArea{DockTop, DockBottom} {
+		if len(d.areas[area].panels) > 0 {
+			min.Width = fyne.Max(min.Width, r.areas[area].box.MinSize().Width)
+			min.Height += dockEdgeMinSize + bar
+		}
+	}
+	for _, area := range []DockArea{DockLeft, DockRight} {
+		if len(d.areas[area].panels) > 0 {
+			min.Width += dockEdgeMinSize + bar
+			min.Height = fyne.Max(min.Height, r.areas[area].box.MinSize().Height)
+		}
+	}
+	return min
+}
+
+func (r *dockLayoutRenderer) Objects() []fyne.CanvasObject {
+	objects := make([]fyne.CanvasObject, 0, len(r.areas)+len(r.splitters))
+	for _, a := range r.areas {
+		objects = append(objects, a.box)
+	}
+	for _, s := range r.splitters {
+		objects = append(objects, s)
+	}
+	return objects
+}
+
+func (r *dockLayoutRenderer) Refresh() {
+	d := r.dock
+	for i, a := range r.areas {
+		a.refresh()
+		if i > 0 {
+			r.splitters[i-1].Hidden = len(d.areas[i].panels) == 0
+		}
+	}
+	r.Layout(d.Size())
+}
+
+func (r *dockLayoutRenderer) place(area DockArea, pos fyne.Position, size fyne.Size) {
+	box := r.areas[area].box
+	box.Move(pos)
+	box.Resize(size)
+}
+
+// dockAreaView shows the panels of an area as tabs, with a menu to move the selected one.
+type dockAreaView struct {
+	dock    *DockLayout
+	area    DockArea
+	box     *fyne.Container
+	header  *fyne.Container
+	tabs    *fyne.Container
+	menu    *widget.Button
+	content *fyne.Container
+}
+
+func newDockAreaView(d *DockLayout, area DockArea) *dockAreaView {
+	v := &dockAreaView{dock: d, area: area, tabs: container.NewHBox(), content: container.NewStack()}
+	v.menu = widget.NewButtonWithIcon("", theme.MoreVerticalIcon(), v.showMenu)
+	v.menu.Importance = widget.LowImportance
+	v.header = container.NewStack(canvas.NewRectangle(theme.Color(theme.ColorNameHeaderBackground)),
+		container.NewBorder(nil, nil, nil, v.menu, container.NewHScroll(v.tabs)))
+	v.box = container.NewBorder(v.header, nil, nil, nil, v.content)
+	return v
+}
+
+func (v *dockAreaView) refresh() {
+	a := v.dock.areas[v.area]
+	v.box.Hidden = v.area != DockCenter && len(a.panels) == 0
+	v.header.Hidden = len(a.panels) == 0
+	v.header.Objects[0].(*canvas.Rectangle).FillColor = theme.Color(theme.ColorNameHeaderBackground)
+
+	v.tabs.Objects = nil
+	v.content.Objects = nil
+	for _, id := range a.panels {
+		id := id
+		p := v.dock.panels[id]
+		tab := widget.NewButtonWithIcon(p.Title, p.Icon, func() { v.dock.Select(id) })
+		tab.Importance = widget.LowImportance
+		if id == a.selected {
+			tab.Importance = widget.MediumImportance
+			if p.Content != nil {
+				v.content.Objects = []fyne.CanvasObject{p.Content}
+			}
+		}
+		v.tabs.Add(tab)
+	}
+	v.box.Refresh()
+}
+
+// showMenu offers to move the selected panel to the other areas or to float it.
+func (v *dockAreaView) showMenu() {
+	id := v.dock.areas[v.area].selected
+	c := fyne.CurrentApp().Driver().CanvasForObject(v.menu)
+	if id == "" || c == nil {
+		return
+	}
+
+	labels := [...]string{i18n.L("Dock Center"), i18n.L("Dock Left"), i18n.L("Dock Right"),
+		i18n.L("Dock Top"), i18n.L("Dock Bottom")}
+	var items []*fyne.MenuItem
+	for i, label := range labels {
+		area := DockArea(i)
+		if area != v.area {
+			items = append(items, fyne.NewMenuItem(label, func() { v.dock.Dock(id, area) }))
+		}
+	}
+	if !fyne.CurrentDevice().IsMobile() {
+		items = append(items, fyne.NewMenuItemSeparator(), fyne.NewMenuItem(i18n.L("Float"), func() { v.dock.Float(id) }))
+	}
+	widget.ShowPopUpMenuAtRelativePosition(fyne.NewMenu("", items...), c,
+		fyne.NewPos(0, v.menu.Size().Height), v.menu)
+}
+
+// dockSplitter is dragged to resize an edge area.
+type dockSplitter struct {
+	widget.BaseWidget
+	dock *DockLayout
+	area DockArea
+}
+
+func newDockSplitter(d *DockLayout, area DockArea) *dockSplitter {
+	s := &dockSplitter{dock: d, area: area}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+func (s *dockSplitter) CreateRenderer() fyne.WidgetRenderer {
+	line := canvas.NewRectangle(theme.Color(theme.ColorNameSeparator))
+	return &dockSplitterRenderer{splitter: s, bg: canvas.NewRectangle(color.Transparent), line: line}
+}
+
+func (s *dockSplitter) Cursor() desktop.Cursor {
+	if s.area == DockLeft || s.area == DockRight {
+		return desktop.HResizeCursor
+	}
+	return desktop.VResizeCursor
+}
+
+func (s *dockSplitter) DragEnd() {
+	s.dock.changed()
+}
+
+func (s *dockSplitter) Dragged(ev *fyne.DragEvent) {
+	switch s.area {
+	case DockLeft:
+		s.dock.resize(s.area, ev.Dragged.DX)
+	case DockRight:
+		s.dock.resize(s.area, -ev.Dragged.DX)
+	case DockTop:
+		s.dock.resize(s.area, ev.Dragged.DY)
+	case DockBottom:
+		s.dock.resize(s.area, -ev.Dragged.DY)
+	}
+}
+
+type dockSplitterRenderer struct {
+	splitter *dockSplitter
+	bg, line *canvas.Rectangle
+}
+
+func (r *dockSplitterRenderer) Destroy() {
+}
+
+func (r *dockSplitterRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	thickness := theme.SeparatorThicknessSize()
+	if size.Width < size.Height {
+		r.line.Resize(fyne.NewSize(thickness, size.Height))
+		r.line.Move(fyne.NewPos((size.Width-thickness)/2, 0))
+	} else {
+		r.line.Resize(fyne.NewSize(size.Width, thickness))
+		r.line.Move(fyne.NewPos(0, (size.Height-thickness)/2))
+	}
+}
+
+func (r *dockSplitterRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(theme.Padding())
+}
+
+func (r *dockSplitterRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg, r.line}
+}
+
+func (r *dockSplitterRenderer) Refresh() {
+	r.line.FillColor = theme.Color(theme.ColorNameSeparator)
+	r.Layout(r.splitter.Size())
+	r.line.Refresh()
+}
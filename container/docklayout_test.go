@@ -0,0 +1,122 @@
+package container
+
+import (
+	"encoding/json"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDock() (*DockLayout, *widget.Label, *widget.Label, *widget.Label) {
+	editor, files, console := widget.NewLabel("editor"), widget.NewLabel("files"), widget.NewLabel("console")
+	d := NewDockLayout()
+	d.AddPanel(NewDockPanel("editor", "Editor", editor), DockCenter)
+	d.AddPanel(NewDockPanel("files", "Files", files), DockLeft)
+	d.AddPanel(NewDockPanel("console", "Console", console), DockBottom)
+	return d, editor, files, console
+}
+
+func TestDockLayout_Layout(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	d, _, _, _ := newTestDock()
+	w := test.NewWindow(d)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(800, 600))
+
+	r := test.WidgetRenderer(d).(*dockLayoutRenderer)
+	assert.Equal(t, float32(dockSideSize), r.areas[DockLeft].box.Size().Width)
+	assert.Equal(t, float32(dockEndSize), r.areas[DockBottom].box.Size().Height)
+	assert.True(t, r.areas[DockRight].box.Hidden)
+	assert.True(t, r.splitters[DockRight-1].Hidden)
+
+	bar := r.splitters[DockLeft-1].Size().Width
+	center := r.areas[DockCenter].box
+	assert.Equal(t, fyne.NewPos(dockSideSize+bar, 0), center.Position())
+	assert.Equal(t, fyne.NewSize(800-dockSideSize-bar, 600-dockEndSize-bar), center.Size())
+
+	changes := 0
+	d.OnChanged = func() { changes++ }
+	splitter := r.splitters[DockLeft-1]
+	splitter.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DX: 60}})
+	splitter.DragEnd()
+	assert.Equal(t, float32(dockSideSize+60), r.areas[DockLeft].box.Size().Width)
+	assert.Equal(t, 1, changes)
+
+	splitter.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DX: 1000}})
+	assert.Equal(t, float32(800-dockCenterMinSize), r.areas[DockLeft].box.Size().Width)
+}
+
+func TestDockLayout_Dock(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	d, _, files, console := newTestDock()
+	w := test.NewWindow(d)
+	defer w.Close()
+
+	r := test.WidgetRenderer(d).(*dockLayoutRenderer)
+	d.Dock("console", DockLeft)
+	assert.True(t, r.areas[DockBottom].box.Hidden)
+	assert.Len(t, r.areas[DockLeft].tabs.Objects, 2)
+	assert.Equal(t, []fyne.CanvasObject{console}, r.areas[DockLeft].content.Objects)
+
+	test.Tap(r.areas[DockLeft].tabs.Objects[0].(*widget.Button))
+	assert.Equal(t, []fyne.CanvasObject{files}, r.areas[DockLeft].content.Objects)
+
+	d.RemovePanel("files")
+	assert.Equal(t, []fyne.CanvasObject{console}, r.areas[DockLeft].content.Objects)
+	assert.Nil(t, d.Panel("files"))
+}
+
+func TestDockLayout_Float(t *testing.T) {
+	a := test.NewApp()
+	defer test.NewApp()
+
+	d, _, _, console := newTestDock()
+	w := test.NewWindow(d)
+	defer w.Close()
+
+	windows := len(a.Driver().AllWindows())
+	d.Float("console")
+	assert.Len(t, a.Driver().AllWindows(), windows+1)
+	floating := d.floating["console"].window
+	assert.Equal(t, console, floating.Content())
+	assert.Equal(t, "console", d.State().Floating[0].Panel)
+
+	floating.Close()
+	assert.Empty(t, d.floating)
+	assert.Equal(t, DockBottom, d.areaOf("console"))
+	assert.Equal(t, "console", d.areas[DockBottom].selected)
+}
+
+func TestDockLayout_State(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	d, _, _, _ := newTestDock()
+	d.AddPanel(NewDockPanel("outline", "Outline", widget.NewLabel("outline")), DockLeft)
+	d.Select("files")
+	d.SetAreaSize(DockLeft, 300)
+
+	data, err := json.Marshal(d.State())
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"area":"left"`)
+
+	restored, _, _, _ := newTestDock()
+	restored.AddPanel(NewDockPanel("outline", "Outline", widget.NewLabel("outline")), DockRight)
+	var state DockState
+	assert.NoError(t, json.Unmarshal(data, &state))
+	restored.SetState(state)
+	assert.Equal(t, d.State(), restored.State())
+	assert.Equal(t, []string{"files", "outline"}, restored.areas[DockLeft].panels)
+	assert.Empty(t, restored.areas[DockRight].panels)
+
+	var area DockArea
+	assert.Error(t, json.Unmarshal([]byte(`"middle"`), &area))
+}
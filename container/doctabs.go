@@ -0,0 +1,485 @@
+package container
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*DocTabs)(nil)
+var _ fyne.Layout = (*docTabStripLayout)(nil)
+var _ fyne.Tappable = (*docTabButton)(nil)
+var _ fyne.SecondaryTappable = (*docTabButton)(nil)
+var _ fyne.Draggable = (*docTabButton)(nil)
+var _ desktop.Mouseable = (*docTabButton)(nil)
+var _ desktop.Hoverable = (*docTabButton)(nil)
+
+const docTabDirtySize = 8
+
+// DocTab is a document shown in DocTabs.
+type DocTab struct {
+	Title   string
+	Icon    fyne.Resource
+	Content fyne.CanvasObject
+	// Dirty marks unsaved changes with a dot in place of the close button.
+	Dirty bool
+}
+
+// NewDocTab creates a new tab with a title and content.
+func NewDocTab(title string, content fyne.CanvasObject) *DocTab {
+	return &DocTab{Title: title, Content: content}
+}
+
+// DocTabs is a tab container for editor style apps. Each tab has a close button, which shows
+// a dot while the document is dirty, and can be closed with a middle click or from its context menu.
+// Tabs can be dragged to reorder them, and those that don't fit are listed in an overflow menu.
+type DocTabs struct {
+	widget.BaseWidget
+
+	Items []*DocTab
+
+	// CloseIntercept is called instead of closing a tab, so that it can ask to save changes.
+	// Call Remove to close the tab.
+	CloseIntercept func(*DocTab) `json:"-"`
+	OnClosed       func(*DocTab) `json:"-"`
+	OnReordered    func()        `json:"-"`
+	OnSelected     func(*DocTab) `json:"-"`
+
+	selected *DocTab
+}
+
+// NewDocTabs creates a new tab container with the first item selected.
+func NewDocTabs(items ...*DocTab) *DocTabs {
+	t := &DocTabs{Items: items}
+	if len(items) > 0 {
+		t.selected = items[0]
+	}
+	t.ExtendBaseWidget(t)
+	return t
+}
+
+// Append adds a tab to the end and selects it.
+func (t *DocTabs) Append(item *DocTab) {
+	t.Items = append(t.Items, item)
+	t.Select(item)
+}
+
+// Close closes a tab, or passes it to CloseIntercept if that is set.
+func (t *DocTabs) Close(item *DocTab) {
+	if f := t.CloseIntercept; f != nil {
+		f(item)
+		return
+	}
+	t.Remove(item)
+}
+
+// CloseOthers closes every tab apart from item.
+func (t *DocTabs) CloseOthers(item *DocTab) {
+	for _, other := range append([]*DocTab{}, t.Items...) {
+		if other != item {
+			t.Close(other)
+		}
+	}
+}
+
+// CloseToRight closes the tabs after item.
+func (t *DocTabs) CloseToRight(item *DocTab) {
+	index := t.indexOf(item)
+	if index < 0 {
+		return
+	}
+	for _, other := range append([]*DocTab{}, t.Items[index+1:]...) {
+		t.Close(other)
+	}
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (t *DocTabs) CreateRenderer() fyne.WidgetRenderer {
+	t.ExtendBaseWidget(t)
+	r := &docTabsRenderer{tabs: t, buttons: map[*DocTab]*docTabButton{}, content: container.NewStack(),
+		underline: canvas.NewRectangle(theme.Color(theme.ColorNamePrimary))}
+	r.overflow = widget.NewButtonWithIcon("", theme.MoreHorizontalIcon(), r.showOverflow)
+	r.overflow.Importance = widget.LowImportance
+	r.strip = container.New(&docTabStripLayout{tabs: t, overflow: r.overflow}, r.overflow)
+	r.Refresh()
+	return r
+}
+
+// MoveTab places the tab at index from at index to.
+func (t *DocTabs) MoveTab(from, to int) {
+	if from == to || from < 0 || to < 0 || from >= len(t.Items) || to >= len(t.Items) {
+		return
+	}
+	item := t.Items[from]
+	t.Items = append(t.Items[:from], t.Items[from+1:]...)
+	t.Items = append(t.Items[:to], append([]*DocTab{item}, t.Items[to:]...)...)
+	t.Refresh()
+	if f := t.OnReordered; f != nil {
+		f()
+	}
+}
+
+// Remove closes a tab without calling CloseIntercept, selecting its neighbour if it was selected.
+func (t *DocTabs) Remove(item *DocTab) {
+	index := t.indexOf(item)
+	if index < 0 {
+		return
+	}
+	t.Items = append(t.Items[:index], t.Items[index+1:]...)
+	if t.selected == item {
+		t.selected = nil
+		if len(t.Items) > 0 {
+			if index >= len(t.Items) {
+				index = len(t.Items) - 1
+			}
+			t.Select(t.Items[index])
+		}
+	}
+	t.Refresh()
+	if f := t.OnClosed; f != nil {
+		f(item)
+	}
+}
+
+// Select shows the content of a tab.
+func (t *DocTabs) Select(item *DocTab) {
+	if t.indexOf(item) < 0 || t.selected == item {
+		t.Refresh()
+		return
+	}
+	t.selected = item
+	t.Refresh()
+	if f := t.OnSelected; f != nil {
+		f(item)
+	}
+}
+
+// Selected returns the selected tab, or nil if there are none.
+func (t *DocTabs) Selected() *DocTab {
+	return t.selected
+}
+
+// SetDirty marks whether a tab has unsaved changes.
+func (t *DocTabs) SetDirty(item *DocTab, dirty bool) {
+	item.Dirty = dirty
+	t.Refresh()
+}
+
+func (t *DocTabs) indexOf(item *DocTab) int {
+	for i, other := range t.Items {
+		if other == item {
+			return i
+		}
+	}
+	return -1
+}
+
+type docTabsRenderer struct {
+	tabs      *DocTabs
+	buttons   map[*DocTab]*docTabButton
+	strip     *fyne.Container
+	overflow  *widget.Button
+	underline *canvas.Rectangle
+	content   *fyne.Container
+}
+
+func (r *docTabsRenderer) Destroy() {
+}
+
+func (r *docTabsRenderer) Layout(size fyne.Size) {
+	bar := r.strip.MinSize().Height
+	r.strip.Resize(fyne.NewSize(size.Width, bar))
+	r.content.Move(fyne.NewPos(0, bar+theme.Padding()))
+	r.content.Resize(fyne.NewSize(size.Width, size.Height-bar-theme.Padding()))
+
+	r.underline.Hide()
+	if b, ok := r.buttons[r.tabs.selected]; ok && b.Visible() {
+		r.underline.Show()
+		r.underline.Resize(fyne.NewSize(b.Size().Width, theme.Padding()/2))
+		r.underline.Move(fyne.NewPos(b.Position().X, bar))
+	}
+}
+
+func (r *docTabsRenderer) MinSize() fyne.Size {
+	bar := r.strip.MinSize()
+	content := r.content.MinSize()
+	return fyne.NewSize(fyne.Max(bar.Width, content.Width), bar.Height+theme.Padding()+content.Height)
+}
+
+func (r *docTabsRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.strip, r.underline, r.content}
+}
+
+func (r *docTabsRenderer) Refresh() {
+	t := r.tabs
+	objects := make([]fyne.CanvasObject, 0, len(t.Items)+1)
+	buttons := make(map[*DocTab]*docTabButton, len(t.Items))
+	for _, item := range t.Items {
+		b, ok := r.buttons[item]
+		if !ok {
+			b = newDocTabButton(t, item)
+		}
+		buttons[item] = b
+		objects = append(objects, b)
+		b.Refresh()
+	}
+	r.buttons = buttons
+	r.strip.Objects = append(objects, r.overflow)
+	r.strip.Refresh()
+
+	r.content.Objects = nil
+	if t.selected != nil && t.selected.Content != nil {
+		r.content.Objects = []fyne.CanvasObject{t.selected.Content}
+	}
+	r.content.Refresh()
+	r.underline.FillColor = theme.Color(theme.ColorNamePrimary)
+	r.underline.Refresh()
+	r.Layout(t.Size())
+}
+
+func (r *docTabsRenderer) showOverflow() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(r.overflow)
+	if c == nil {
+		return
+	}
+	items := make([]*fyne.MenuItem, len(r.tabs.Items))
+	for i, item := range r.tabs.Items {
+		item := item
+		items[i] = fyne.NewMenuItem(item.Title, func() { r.tabs.Select(item) })
+		items[i].Icon = item.Icon
+		items[i].Checked = item == r.tabs.selected
+	}
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(r.overflow)
+	widget.ShowPopUpMenuAtPosition(fyne.NewMenu("", items...), c, pos.Add(fyne.NewPos(0, r.overflow.Size().Height)))
+}
+
+// docTabStripLayout places tabs in a row, hiding those that do not fit behind the overflow button.
+// The selected tab is always kept visible.
+type docTabStripLayout struct {
+	tabs     *DocTabs
+	overflow fyne.CanvasObject
+}
+
+func (l *docTabStripLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	tabs := objects[:len(objects)-1]
+	widths := make([]float32, len(tabs))
+	total := float32(0)
+	selected := 0
+	for i, o := range tabs {
+		widths[i] = o.MinSize().Width
+		total += widths[i]
+		if o.(*docTabButton).item == l.tabs.selected {
+			selected = i
+		}
+	}
+
+	avail := size.Width
+	first := 0
+	if total > avail {
+		l.overflow.Show()
+		button := l.overflow.MinSize()
+		avail -= button.Width
+		l.overflow.Resize(fyne.NewSize(button.Width, size.Height))
+		l.overflow.Move(fyne.NewPos(avail, 0))
+
+		used := float32(0)
+		for i := 0; i <= selected; i++ {
+			used += widths[i]
+		}
+		for used > avail && first < selected {
+			used -= widths[first]
+			first++
+		}
+	} else {
+		l.overflow.Hide()
+	}
+
+	x := float32(0)
+	for i, o := range tabs {
+		if i < first || x+widths[i] > avail {
+			o.Hide()
+			continue
+		}
+		o.Show()
+		o.Resize(fyne.NewSize(widths[i], size.Height))
+		o.Move(fyne.NewPos(x, 0))
+		x += widths[i]
+	}
+}
+
+func (l *docTabStripLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	min := l.overflow.MinSize()
+	tab := fyne.NewSize(0, 0)
+	for _, o := range objects[:len(objects)-1] {
+		tab = tab.Max(o.MinSize())
+	}
+	return fyne.NewSize(min.Width+tab.Width, fyne.Max(min.Height, tab.Height))
+}
+
+// docTabButton is the title of a DocTab in the strip, with its close button.
+type docTabButton struct {
+	widget.BaseWidget
+	tabs    *DocTabs
+	item    *DocTab
+	hovered bool
+	dragX   float32
+}
+
+func newDocTabButton(t *DocTabs, item *DocTab) *docTabButton {
+	b := &docTabButton{tabs: t, item: item}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+func (b *docTabButton) CreateRenderer() fyne.WidgetRenderer {
+	bg := canvas.NewRectangle(color.Transparent)
+	bg.CornerRadius = theme.InputRadiusSize()
+	icon := widget.NewIcon(nil)
+	label := widget.NewLabel("")
+	closeButton := widget.NewButtonWithIcon("", theme.CancelIcon(), func() { b.tabs.Close(b.item) })
+	closeButton.Importance = widget.LowImportance
+	dirty := canvas.NewCircle(theme.Color(theme.ColorNameForeground))
+	r := &docTabButtonRenderer{button: b, bg: bg, icon: icon, label: label, close: closeButton, dirty: dirty}
+	r.Refresh()
+	return r
+}
+
+func (b *docTabButton) Dragged(ev *fyne.DragEvent) {
+	index := b.tabs.indexOf(b.item)
+	if index < 0 {
+		return
+	}
+	b.dragX += ev.Dragged.DX
+	width := b.Size().Width
+	if b.dragX > width/2 && index < len(b.tabs.Items)-1 {
+		b.dragX -= width
+		b.tabs.MoveTab(index, index+1)
+	} else if b.dragX < -width/2 && index > 0 {
+		b.dragX += width
+		b.tabs.MoveTab(index, index-1)
+	}
+}
+
+func (b *docTabButton) DragEnd() {
+	b.dragX = 0
+}
+
+func (b *docTabButton) MouseIn(*desktop.MouseEvent) {
+	b.hovered = true
+	b.Refresh()
+}
+
+func (b *docTabButton) MouseMoved(*desktop.MouseEvent) {
+}
+
+func (b *docTabButton) MouseOut() {
+	b.hovered = false
+	b.Refresh()
+}
+
+func (b *docTabButton) MouseDown(ev *desktop.MouseEvent) {
+	if ev.Button == desktop.MouseButtonTertiary {
+		b.tabs.Close(b.item)
+	}
+}
+
+func (b *docTabButton) MouseUp(*desktop.MouseEvent) {
+}
+
+func (b *docTabButton) Tapped(*fyne.PointEvent) {
+	b.tabs.Select(b.item)
+}
+
+func (b *docTabButton) TappedSecondary(ev *fyne.PointEvent) {
+	c := fyne.CurrentApp().Driver().CanvasForObject(b)
+	if c == nil {
+		return
+	}
+	menu := fyne.NewMenu("",
+		fyne.NewMenuItem(i18n.L("Close"), func() { b.tabs.Close(b.item) }),
+		fyne.NewMenuItem(i18n.L("Close Others"), func() { b.tabs.CloseOthers(b.item) }),
+		fyne.NewMenuItem(i18n.L("Close to the Right"), func() { b.tabs.CloseToRight(b.item) }),
+	)
+	widget.ShowPopUpMenuAtPosition(menu, c, ev.AbsolutePosition)
+}
+
+type docTabButtonRenderer struct {
+	button *docTabButton
+	bg     *canvas.Rectangle
+	icon   *widget.Icon
+	label  *widget.Label
+	close  *widget.Button
+	dirty  *canvas.Circle
+}
+
+func (r *docTabButtonRenderer) Destroy() {
+}
+
+func (r *docTabButtonRenderer) Layout(size fyne.Size) {
+	pad := theme.Padding()
+	r.bg.Resize(size)
+
+	x := pad
+	if !r.icon.Hidden {
+		icon := theme.IconInlineSize()
+		r.icon.Resize(fyne.NewSquareSize(icon))
+		r.icon.Move(fyne.NewPos(x, (size.Height-icon)/2))
+		x += icon
+	}
+	closeSize := r.close.MinSize()
+	r.label.Resize(fyne.NewSize(size.Width-x-closeSize.Width-pad, size.Height))
+	r.label.Move(fyne.NewPos(x, 0))
+
+	r.close.Resize(closeSize)
+	r.close.Move(fyne.NewPos(size.Width-closeSize.Width-pad, (size.Height-closeSize.Height)/2))
+	r.dirty.Resize(fyne.NewSquareSize(docTabDirtySize))
+	r.dirty.Move(fyne.NewPos(size.Width-pad-(closeSize.Width+docTabDirtySize)/2, (size.Height-docTabDirtySize)/2))
+}
+
+func (r *docTabButtonRenderer) MinSize() fyne.Size {
+	pad := theme.Padding()
+	label := r.label.MinSize()
+	closeSize := r.close.MinSize()
+	width := pad*2 + label.Width + closeSize.Width
+	if !r.icon.Hidden {
+		width += theme.IconInlineSize()
+	}
+	return fyne.NewSize(width, fyne.Max(label.Height, closeSize.Height))
+}
+
+func (r *docTabButtonRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg, r.icon, r.label, r.close, r.dirty}
+}
+
+func (r *docTabButtonRenderer) Refresh() {
+	b := r.button
+	selected := b.tabs.selected == b.item
+	r.bg.FillColor = color.Transparent
+	if selected {
+		r.bg.FillColor = theme.Color(theme.ColorNameSelection)
+	} else if b.hovered {
+		r.bg.FillColor = theme.Color(theme.ColorNameHover)
+	}
+	r.bg.Refresh()
+
+	r.icon.SetResource(b.item.Icon)
+	r.icon.Hidden = b.item.Icon == nil
+	r.label.TextStyle.Bold = selected
+	r.label.SetText(b.item.Title)
+
+	showDirty := b.item.Dirty && !b.hovered
+	r.dirty.Hidden = !showDirty
+	r.close.Hidden = showDirty
+	r.dirty.FillColor = theme.Color(theme.ColorNameForeground)
+	r.dirty.Refresh()
+	r.Layout(b.Size())
+}
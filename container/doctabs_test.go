@@ -0,0 +1,117 @@
+package container
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDocTabs(titles ...string) *DocTabs {
+	items := make([]*DocTab, len(titles))
+	for i, title := range titles {
+		items[i] = NewDocTab(title, widget.NewLabel(title))
+	}
+	return NewDocTabs(items...)
+}
+
+func titles(t *DocTabs) []string {
+	names := make([]string, len(t.Items))
+	for i, item := range t.Items {
+		names[i] = item.Title
+	}
+	return names
+}
+
+func TestDocTabs_Close(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	tabs := newTestDocTabs("a.go", "b.go", "c.go", "d.go")
+	var closed []string
+	tabs.OnClosed = func(item *DocTab) {
+		closed = append(closed, item.Title)
+	}
+	w := test.NewWindow(tabs)
+	defer w.Close()
+
+	r := test.WidgetRenderer(tabs).(*docTabsRenderer)
+	b := r.buttons[tabs.Items[1]]
+	test.Tap(b)
+	assert.Equal(t, "b.go", tabs.Selected().Title)
+
+	b.MouseDown(&desktop.MouseEvent{Button: desktop.MouseButtonTertiary})
+	assert.Equal(t, []string{"a.go", "c.go", "d.go"}, titles(tabs))
+	assert.Equal(t, "c.go", tabs.Selected().Title)
+
+	tabs.CloseToRight(tabs.Items[1])
+	assert.Equal(t, []string{"a.go", "c.go"}, titles(tabs))
+	tabs.CloseOthers(tabs.Items[1])
+	assert.Equal(t, []string{"c.go"}, titles(tabs))
+	assert.Equal(t, []string{"b.go", "d.go", "a.go"}, closed)
+}
+
+func TestDocTabs_CloseIntercept(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	tabs := newTestDocTabs("a.go", "b.go")
+	tabs.SetDirty(tabs.Items[0], true)
+	var intercepted *DocTab
+	tabs.CloseIntercept = func(item *DocTab) {
+		intercepted = item
+	}
+	w := test.NewWindow(tabs)
+	defer w.Close()
+
+	r := test.WidgetRenderer(tabs).(*docTabsRenderer)
+	br := test.WidgetRenderer(r.buttons[tabs.Items[0]]).(*docTabButtonRenderer)
+	assert.True(t, br.close.Hidden)
+	assert.False(t, br.dirty.Hidden)
+
+	tabs.Close(tabs.Items[0])
+	assert.Equal(t, tabs.Items[0], intercepted)
+	assert.Len(t, tabs.Items, 2)
+}
+
+func TestDocTabs_Reorder(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	tabs := newTestDocTabs("a.go", "b.go", "c.go")
+	reordered := 0
+	tabs.OnReordered = func() {
+		reordered++
+	}
+	w := test.NewWindow(tabs)
+	defer w.Close()
+	w.Resize(fyne.NewSize(600, 300))
+
+	b := test.WidgetRenderer(tabs).(*docTabsRenderer).buttons[tabs.Items[0]]
+	b.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DX: b.Size().Width * 0.6}})
+	b.DragEnd()
+	assert.Equal(t, []string{"b.go", "a.go", "c.go"}, titles(tabs))
+	assert.Equal(t, 1, reordered)
+}
+
+func TestDocTabs_Overflow(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	tabs := newTestDocTabs("first.go", "second.go", "third.go", "fourth.go", "fifth.go")
+	w := test.NewWindow(tabs)
+	defer w.Close()
+	w.Resize(fyne.NewSize(600, 300))
+
+	r := test.WidgetRenderer(tabs).(*docTabsRenderer)
+	assert.False(t, r.overflow.Visible())
+
+	w.Resize(tabs.MinSize())
+	tabs.Select(tabs.Items[4])
+	assert.True(t, r.overflow.Visible())
+	assert.True(t, r.buttons[tabs.Items[4]].Visible())
+	assert.False(t, r.buttons[tabs.Items[0]].Visible())
+}
@@ -0,0 +1,270 @@
+package container
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*FrostedGlass)(nil)
+
+// frostedGlassFrameDelay is how long to wait for the window to be drawn without the glass before capturing it.
+const frostedGlassFrameDelay = time.Second / 20
+
+// FrostedGlass is a container that shows its content over a blurred and tinted view of the objects beneath it,
+// for translucent sidebars, overlays and headers.
+// The objects beneath are captured from the window when the glass is shown, moved or resized, or when
+// UpdateBackdrop is called after they change. The glass is hidden for a frame while the window is captured.
+type FrostedGlass struct {
+	widget.BaseWidget
+
+	Content fyne.CanvasObject
+	// BlurRadius is the radius of the blur, in the units of the canvas.
+	BlurRadius float32
+	// Tint is drawn over the blurred backdrop. The background colour of the theme, half transparent, if nil.
+	Tint color.Color
+
+	lock         sync.Mutex
+	capturing    bool
+	pos          fyne.Position // the absolute position of the glass, as last moved or resized
+	size         fyne.Size
+	captured     fyne.Position // the absolute position of the last capture
+	capturedSize fyne.Size
+	backdrop     *canvas.Image
+}
+
+// NewFrostedGlass creates a new container showing content over a blurred backdrop.
+func NewFrostedGlass(content fyne.CanvasObject) *FrostedGlass {
+	g := &FrostedGlass{Content: content, BlurRadius: 16}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (g *FrostedGlass) CreateRenderer() fyne.WidgetRenderer {
+	g.ExtendBaseWidget(g)
+	g.backdrop = canvas.NewImageFromImage(nil)
+	g.backdrop.FillMode = canvas.ImageFillStretch
+	r := &frostedGlassRenderer{glass: g, tint: canvas.NewRectangle(color.Transparent)}
+	r.Refresh()
+	return r
+}
+
+// Move the glass to a new position, capturing the objects beneath it again.
+func (g *FrostedGlass) Move(pos fyne.Position) {
+	g.BaseWidget.Move(pos)
+	g.track()
+}
+
+// Resize the glass, capturing the objects beneath it again.
+func (g *FrostedGlass) Resize(size fyne.Size) {
+	g.BaseWidget.Resize(size)
+	g.track()
+}
+
+// Show the glass, capturing the objects beneath it if it has moved while hidden.
+func (g *FrostedGlass) Show() {
+	g.BaseWidget.Show()
+	g.track()
+}
+
+// UpdateBackdrop captures the objects beneath the glass again, after they change.
+func (g *FrostedGlass) UpdateBackdrop() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(g)
+	if c == nil || g.backdrop == nil {
+		return
+	}
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(g)
+	g.lock.Lock()
+	g.pos, g.size = pos, g.Size()
+	g.capturedSize = fyne.NewSize(-1, -1) // captured again even if it has not moved
+	if g.capturing {
+		g.lock.Unlock()
+		return // the capture that is running starts another
+	}
+	g.capturing = true
+	g.lock.Unlock()
+	g.capture(c)
+}
+
+// track records where the glass is in the window, which is found on the thread that moves it as the tree
+// of the window is not changed meanwhile, and starts capturing the objects beneath it if it has moved.
+func (g *FrostedGlass) track() {
+	d := fyne.CurrentApp().Driver()
+	c := d.CanvasForObject(g)
+	if c == nil || g.backdrop == nil {
+		return
+	}
+	pos, size := d.AbsolutePositionForObject(g), g.Size()
+	g.lock.Lock()
+	g.pos, g.size = pos, size
+	start := !g.capturing && g.Visible() && (pos != g.captured || size != g.capturedSize)
+	if start {
+		g.capturing = true
+	}
+	g.lock.Unlock()
+	if start {
+		go g.capture(c)
+	}
+}
+
+// capture captures the objects beneath the glass until it is captured where it was last tracked.
+func (g *FrostedGlass) capture(c fyne.Canvas) {
+	for {
+		g.lock.Lock()
+		pos, size := g.pos, g.size
+		if pos == g.captured && size == g.capturedSize {
+			g.capturing = false
+			g.lock.Unlock()
+			return
+		}
+		g.captured, g.capturedSize = pos, size
+		g.lock.Unlock()
+		g.captureAt(c, pos, size)
+	}
+}
+
+func (g *FrostedGlass) captureAt(c fyne.Canvas, pos fyne.Position, size fyne.Size) {
+	if size.IsZero() {
+		return
+	}
+
+	g.BaseWidget.Hide()
+	time.Sleep(frostedGlassFrameDelay)
+	shot := c.Capture()
+	g.BaseWidget.Show()
+	if shot == nil {
+		return
+	}
+
+	scale := float32(shot.Bounds().Dx()) / c.Size().Width
+	region := image.Rect(int(pos.X*scale), int(pos.Y*scale), int((pos.X+size.Width)*scale), int((pos.Y+size.Height)*scale))
+	blurred := frostedGlassBlur(shot, region, int(g.BlurRadius*scale))
+	g.lock.Lock()
+	g.backdrop.Image = blurred
+	g.lock.Unlock()
+	g.backdrop.Refresh()
+}
+
+type frostedGlassRenderer struct {
+	glass *FrostedGlass
+	tint  *canvas.Rectangle
+}
+
+func (r *frostedGlassRenderer) Destroy() {
+}
+
+func (r *frostedGlassRenderer) Layout(size fyne.Size) {
+	g := r.glass
+	g.backdrop.Resize(size)
+	r.tint.Resize(size)
+	if g.Content != nil {
+		g.Content.Resize(size)
+		g.Content.Move(fyne.NewPos(0, 0))
+	}
+}
+
+func (r *frostedGlassRenderer) MinSize() fyne.Size {
+	if r.glass.Content == nil {
+		return fyne.NewSize(0, 0)
+	}
+	return r.glass.Content.MinSize()
+}
+
+func (r *frostedGlassRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.glass.backdrop, r.tint}
+	if r.glass.Content != nil {
+		objects = append(objects, r.glass.Content)
+	}
+	return objects
+}
+
+func (r *frostedGlassRenderer) Refresh() {
+	g := r.glass
+	if g.Tint != nil {
+		r.tint.FillColor = g.Tint
+	} else {
+		bg := color.NRGBAModel.Convert(theme.Color(theme.ColorNameBackground)).(color.NRGBA)
+		bg.A = 0x80
+		r.tint.FillColor = bg
+	}
+	r.Layout(g.Size())
+	r.tint.Refresh()
+	if g.Content != nil {
+		g.Content.Refresh()
+	}
+}
+
+// frostedGlassBlur returns the region of src blurred by three box blurs, which approximate a gaussian blur.
+// The pixels beyond the edges of the region repeat its edges.
+func frostedGlassBlur(src image.Image, region image.Rectangle, radius int) *image.RGBA {
+	out := image.NewRGBA(image.Rect(0, 0, region.Dx(), region.Dy()))
+	draw.Draw(out, out.Bounds(), src, region.Min, draw.Src)
+	if radius < 1 || out.Bounds().Empty() {
+		return out
+	}
+
+	// box sizes for three passes that together have the spread of a gaussian with a deviation of radius/2
+	sigma := float64(radius) / 2
+	box := int(math.Round((math.Sqrt(12*sigma*sigma/3+1) - 1) / 2))
+	if box < 1 {
+		box = 1
+	}
+	tmp := image.NewRGBA(out.Bounds())
+	for i := 0; i < 3; i++ {
+		frostedGlassBoxBlur(out, tmp, box, true)
+		frostedGlassBoxBlur(tmp, out, box, false)
+	}
+	return out
+}
+
+// frostedGlassBoxBlur blurs src into dst along rows, or columns if not horizontal, with a running sum.
+func frostedGlassBoxBlur(src, dst *image.RGBA, box int, horizontal bool) {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	lines, length := h, w
+	if !horizontal {
+		lines, length = w, h
+	}
+	offset := func(line, i int) int {
+		if i < 0 {
+			i = 0
+		} else if i >= length {
+			i = length - 1
+		}
+		if horizontal {
+			return line*src.Stride + i*4
+		}
+		return i*src.Stride + line*4
+	}
+
+	width := 2*box + 1
+	for line := 0; line < lines; line++ {
+		var sum [4]int
+		for i := -box; i <= box; i++ {
+			o := offset(line, i)
+			for c := 0; c < 4; c++ {
+				sum[c] += int(src.Pix[o+c])
+			}
+		}
+		for i := 0; i < length; i++ {
+			o := offset(line, i)
+			for c := 0; c < 4; c++ {
+				dst.Pix[o+c] = uint8(sum[c] / width)
+			}
+			in, out := offset(line, i+box+1), offset(line, i-box)
+			for c := 0; c < 4; c++ {
+				sum[c] += int(src.Pix[in+c]) - int(src.Pix[out+c])
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package container
+
+import (
+	"image"
+	"image/color"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrostedGlass(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	red, blue := color.NRGBA{R: 0xff, A: 0xff}, color.NRGBA{B: 0xff, A: 0xff}
+	beneath := container.NewGridWithColumns(2, canvas.NewRectangle(red), canvas.NewRectangle(blue))
+	g := NewFrostedGlass(widget.NewLabel("Header"))
+	g.Tint = color.Transparent
+	g.Hide() // captured once shown, so that the window is not changed while being captured
+	w := test.NewWindow(container.NewStack(beneath, g))
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(200, 100))
+	g.Show()
+	assert.Eventually(t, func() bool {
+		img := g.backdropImage()
+		return !g.isCapturing() && img != nil && img.Bounds().Dx() == 200
+	}, time.Second, 10*time.Millisecond)
+
+	assert.True(t, g.Visible())
+	img := g.backdropImage()
+	if !assert.NotNil(t, img) {
+		return
+	}
+	r, _, b, _ := img.At(2, 50).RGBA()
+	assert.Greater(t, r, b)
+	r, _, b, _ = img.At(100, 50).RGBA()
+	// the halves are blurred together
+	assert.Greater(t, r, uint32(0x4000))
+	assert.Greater(t, b, uint32(0x4000))
+}
+
+func (g *FrostedGlass) backdropImage() image.Image {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.backdrop.Image
+}
+
+func (g *FrostedGlass) isCapturing() bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.capturing
+}
+
+func TestFrostedGlassBlur(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 40, 10))
+	for y := 0; y < 10; y++ {
+		src.Set(20, y, color.White)
+	}
+	out := frostedGlassBlur(src, image.Rect(10, 0, 30, 10), 4)
+	assert.Equal(t, 20, out.Bounds().Dx())
+	_, _, _, a := out.At(10, 5).RGBA()
+	assert.Less(t, a, uint32(0xffff))
+	assert.NotZero(t, a)
+	_, _, _, a = out.At(0, 5).RGBA()
+	assert.Zero(t, a)
+
+	same := frostedGlassBlur(src, src.Bounds(), 0)
+	assert.Equal(t, src.Pix, same.Pix)
+}
@@ -0,0 +1,261 @@
+package container
+
+import (
+	"fmt"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*InfiniteList)(nil)
+
+const defaultInfiniteListThreshold = 5
+
+// InfiniteList is a list of paginated items that loads more as the user scrolls near the end.
+// A footer row shows while a page is loading, or the error and a retry button if loading failed.
+type InfiniteList struct {
+	widget.BaseWidget
+
+	// LoadMore is called in a goroutine to load the items following offset,
+	// returning whether there are any more to load after them.
+	LoadMore   func(offset int) (items []interface{}, hasMore bool, err error) `json:"-"`
+	CreateItem func() fyne.CanvasObject                                        `json:"-"`
+	UpdateItem func(item interface{}, obj fyne.CanvasObject)                   `json:"-"`
+	OnSelected func(item interface{})                                          `json:"-"`
+	// Threshold is how many rows from the end of the loaded items a page is requested, 5 by default.
+	Threshold int
+
+	lock       sync.RWMutex
+	items      []interface{}
+	hasMore    bool
+	loading    bool
+	requested  bool // a page was requested while one was loading
+	err        error
+	generation int
+	list       *widget.List
+}
+
+// NewInfiniteList creates a new list that calls loadMore for pages of items,
+// showing them with objects from create that are updated by update.
+func NewInfiniteList(loadMore func(offset int) ([]interface{}, bool, error),
+	create func() fyne.CanvasObject, update func(item interface{}, obj fyne.CanvasObject)) *InfiniteList {
+	l := &InfiniteList{LoadMore: loadMore, CreateItem: create, UpdateItem: update,
+		Threshold: defaultInfiniteListThreshold, hasMore: true}
+	l.ExtendBaseWidget(l)
+	l.list = widget.NewList(l.length, l.createRow, l.updateRow)
+	l.list.OnSelected = l.selected
+	return l
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (l *InfiniteList) CreateRenderer() fyne.WidgetRenderer {
+	l.ExtendBaseWidget(l)
+	return widget.NewSimpleRenderer(l.list)
+}
+
+// Err returns the error from loading the last page, or nil.
+func (l *InfiniteList) Err() error {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.err
+}
+
+// HasMore returns whether there are more items to load.
+func (l *InfiniteList) HasMore() bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.hasMore
+}
+
+// Items returns the items loaded so far.
+func (l *InfiniteList) Items() []interface{} {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return append([]interface{}(nil), l.items...)
+}
+
+// Refresh updates the list to show any changes to its items.
+func (l *InfiniteList) Refresh() {
+	l.list.Refresh()
+}
+
+// Reload discards the loaded items and starts loading again from the first page.
+func (l *InfiniteList) Reload() {
+	l.lock.Lock()
+	l.items = nil
+	l.hasMore = true
+	l.loading, l.requested = false, false
+	l.err = nil
+	l.generation++ // results of a load in progress are ignored
+	l.lock.Unlock()
+	l.list.UnselectAll()
+	l.list.ScrollToTop()
+	l.Refresh()
+}
+
+// Retry loads the page that failed to load.
+func (l *InfiniteList) Retry() {
+	l.lock.Lock()
+	l.err = nil
+	l.lock.Unlock()
+	l.load()
+}
+
+func (l *InfiniteList) createRow() fyne.CanvasObject {
+	var item fyne.CanvasObject = widget.NewLabel("")
+	if f := l.CreateItem; f != nil {
+		item = f()
+	}
+	return container.NewStack(item, newInfiniteListFooter(l))
+}
+
+func (l *InfiniteList) length() int {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	if l.hasMore || l.err != nil {
+		return len(l.items) + 1 // the footer row
+	}
+	return len(l.items)
+}
+
+// load requests the next page unless one is loading or the last failed.
+func (l *InfiniteList) load() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.err != nil || !l.hasMore || l.LoadMore == nil {
+		return
+	}
+	if l.loading {
+		l.requested = true
+		return
+	}
+	l.fetch()
+}
+
+// fetch loads the next page in a goroutine, it must be called with the lock held.
+func (l *InfiniteList) fetch() {
+	l.loading, l.requested = true, false
+	offset := len(l.items)
+	generation := l.generation
+	go func() {
+		items, more, err := l.LoadMore(offset)
+		l.lock.Lock()
+		if generation != l.generation {
+			l.lock.Unlock()
+			return
+		}
+		if err != nil {
+			l.err = err
+		} else {
+			l.items = append(l.items, items...)
+			l.hasMore = more
+		}
+		l.lock.Unlock()
+		l.Refresh()
+
+		// loading ends once the page is shown, and the rows near the end that it shows may request the next one
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		if generation != l.generation {
+			return
+		}
+		l.loading = false
+		if l.requested && l.err == nil && l.hasMore {
+			l.fetch()
+		}
+	}()
+}
+
+func (l *InfiniteList) selected(id widget.ListItemID) {
+	l.lock.RLock()
+	if id >= len(l.items) {
+		l.lock.RUnlock()
+		l.list.Unselect(id)
+		return
+	}
+	item := l.items[id]
+	l.lock.RUnlock()
+	if f := l.OnSelected; f != nil {
+		f(item)
+	}
+}
+
+func (l *InfiniteList) updateRow(id widget.ListItemID, obj fyne.CanvasObject) {
+	row := obj.(*fyne.Container)
+	item, footer := row.Objects[0], row.Objects[1].(*infiniteListFooter)
+
+	l.lock.RLock()
+	count := len(l.items)
+	err := l.err
+	var value interface{}
+	if id < count {
+		value = l.items[id]
+	}
+	l.lock.RUnlock()
+
+	if id >= count-l.Threshold {
+		defer l.load()
+	}
+	if id >= count {
+		item.Hide()
+		footer.Show()
+		footer.update(err == nil, err)
+		return
+	}
+	footer.Hide()
+	footer.update(false, nil)
+	item.Show()
+	if f := l.UpdateItem; f != nil {
+		f(value, item)
+	} else if label, ok := item.(*widget.Label); ok {
+		label.SetText(fmt.Sprint(value))
+	}
+}
+
+// infiniteListFooter is the last row of an InfiniteList, showing that a page is loading or failed to load.
+type infiniteListFooter struct {
+	widget.BaseWidget
+	activity *widget.Activity
+	message  *widget.Label
+	retry    *widget.Button
+}
+
+func newInfiniteListFooter(l *InfiniteList) *infiniteListFooter {
+	f := &infiniteListFooter{activity: widget.NewActivity(), message: widget.NewLabel(""),
+		retry: widget.NewButtonWithIcon(i18n.L("Retry"), theme.ViewRefreshIcon(), l.Retry)}
+	f.message.Truncation = fyne.TextTruncateEllipsis
+	f.message.Hide()
+	f.retry.Hide()
+	f.ExtendBaseWidget(f)
+	return f
+}
+
+func (f *infiniteListFooter) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewBorder(nil, nil, nil, f.retry,
+		container.NewStack(container.NewCenter(f.activity), f.message)))
+}
+
+func (f *infiniteListFooter) update(loading bool, err error) {
+	if loading {
+		f.activity.Show()
+		f.activity.Start()
+	} else {
+		f.activity.Stop()
+		f.activity.Hide()
+	}
+	if err != nil {
+		f.message.SetText(err.Error())
+		f.message.Show()
+		f.retry.Show()
+	} else {
+		f.message.Hide()
+		f.retry.Hide()
+	}
+}
@@ -0,0 +1,142 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPager struct {
+	lock    sync.Mutex
+	total   int
+	fail    bool
+	offsets []int
+
+	ui sync.Mutex // held while the test changes the list, so that pages are shown after each change
+}
+
+// do changes the list with pages that are loaded meanwhile held back until it is done.
+func (p *testPager) do(f func()) {
+	p.ui.Lock()
+	defer p.ui.Unlock()
+	f()
+}
+
+func (p *testPager) load(offset int) ([]interface{}, bool, error) {
+	p.ui.Lock()
+	p.ui.Unlock()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.offsets = append(p.offsets, offset)
+	if p.fail {
+		return nil, false, errors.New("offline")
+	}
+	var items []interface{}
+	for i := offset; i < offset+10 && i < p.total; i++ {
+		items = append(items, fmt.Sprintf("Item %d", i))
+	}
+	return items, offset+len(items) < p.total, nil
+}
+
+// waitForLoad returns once no page is loading, which is after the list shows the pages loaded.
+func waitForLoad(l *InfiniteList) {
+	for l.isLoading() {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func (l *InfiniteList) isLoading() bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.loading
+}
+
+func TestInfiniteList_LoadMore(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	pager := &testPager{total: 25}
+	l := NewInfiniteList(pager.load, nil, nil)
+	var w fyne.Window
+	pager.do(func() {
+		w = test.NewWindow(l)
+		w.Resize(fyne.NewSize(200, 200))
+	})
+	defer w.Close()
+	waitForLoad(l)
+
+	assert.GreaterOrEqual(t, len(l.Items()), 10)
+
+	for i := 0; i < 10 && l.HasMore(); i++ {
+		pager.do(l.list.ScrollToBottom)
+		waitForLoad(l)
+	}
+	assert.False(t, l.HasMore())
+	assert.Equal(t, 25, len(l.Items()))
+	assert.Equal(t, 25, l.length())
+	assert.Equal(t, "Item 24", l.Items()[24])
+}
+
+func TestInfiniteList_Retry(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	pager := &testPager{total: 5, fail: true}
+	l := NewInfiniteList(pager.load, nil, nil)
+	var w fyne.Window
+	pager.do(func() {
+		w = test.NewWindow(l)
+		w.Resize(fyne.NewSize(200, 200))
+	})
+	defer w.Close()
+	waitForLoad(l)
+
+	assert.NotNil(t, l.Err())
+	assert.Equal(t, 1, l.length())
+
+	pager.lock.Lock()
+	pager.fail = false
+	pager.lock.Unlock()
+	pager.do(l.Retry)
+	waitForLoad(l)
+	assert.Equal(t, 5, len(l.Items()))
+	assert.Nil(t, l.Err())
+	assert.False(t, l.HasMore())
+}
+
+func TestInfiniteList_Reload(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	pager := &testPager{total: 5}
+	l := NewInfiniteList(pager.load, nil, nil)
+	var selected interface{}
+	l.OnSelected = func(item interface{}) {
+		selected = item
+	}
+	var w fyne.Window
+	pager.do(func() {
+		w = test.NewWindow(l)
+		w.Resize(fyne.NewSize(200, 200))
+	})
+	defer w.Close()
+	waitForLoad(l)
+
+	assert.Equal(t, 5, len(l.Items()))
+	pager.do(func() { l.list.Select(2) })
+	assert.Equal(t, "Item 2", selected)
+
+	pager.do(l.Reload)
+	waitForLoad(l)
+	assert.Equal(t, 5, len(l.Items()))
+	pager.lock.Lock()
+	assert.Equal(t, []int{0, 0}, pager.offsets)
+	pager.lock.Unlock()
+}
@@ -0,0 +1,222 @@
+package container
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	xlayout "fyne.io/x/fyne/layout"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*MasterDetail)(nil)
+
+type detailPage struct {
+	title   string
+	content fyne.CanvasObject
+}
+
+// MasterDetail shows a master list beside the detail of the selected item when it is wide,
+// and becomes a navigation stack when it is narrower than Breakpoint:
+// showing a detail pushes it over the master, with a back button to return.
+type MasterDetail struct {
+	widget.BaseWidget
+
+	Master fyne.CanvasObject
+	// Placeholder is shown in the detail pane of a wide layout when there is no detail.
+	Placeholder fyne.CanvasObject
+	// Breakpoint is the width below which the layout becomes a stack, the medium responsive breakpoint by default.
+	Breakpoint float32
+	// MasterRatio is the fraction of a wide layout used by the master, 0.35 by default.
+	MasterRatio float32
+
+	OnBack          func()            `json:"-"`
+	OnLayoutChanged func(narrow bool) `json:"-"`
+
+	stack  []detailPage
+	narrow bool
+}
+
+// NewMasterDetail creates a new adaptive container with a master object and no detail.
+func NewMasterDetail(master fyne.CanvasObject) *MasterDetail {
+	m := &MasterDetail{Master: master, Breakpoint: float32(xlayout.MEDIUM), MasterRatio: 0.35}
+	m.ExtendBaseWidget(m)
+	return m
+}
+
+// Back removes the top detail, returning to the master in a narrow layout once all are removed.
+func (m *MasterDetail) Back() {
+	if len(m.stack) == 0 {
+		return
+	}
+	m.stack = m.stack[:len(m.stack)-1]
+	m.Refresh()
+	if f := m.OnBack; f != nil {
+		f()
+	}
+}
+
+// ClearDetail removes all details.
+func (m *MasterDetail) ClearDetail() {
+	m.stack = nil
+	m.Refresh()
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (m *MasterDetail) CreateRenderer() fyne.WidgetRenderer {
+	m.ExtendBaseWidget(m)
+	back := widget.NewButtonWithIcon("", theme.NavigateBackIcon(), m.Back)
+	back.Importance = widget.LowImportance
+	title := widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+	title.Truncation = fyne.TextTruncateEllipsis
+	r := &masterDetailRenderer{
+		md: m, back: back, title: title,
+		header:    container.NewBorder(nil, nil, back, nil, title),
+		separator: widget.NewSeparator(),
+		detail:    container.NewStack(),
+	}
+	r.Refresh()
+	return r
+}
+
+// Depth returns the number of details shown over the master.
+func (m *MasterDetail) Depth() int {
+	return len(m.stack)
+}
+
+// IsNarrow returns whether the container is showing a stack rather than side by side panes.
+func (m *MasterDetail) IsNarrow() bool {
+	return m.narrow
+}
+
+// PushDetail shows another level of detail, the back button returns to the previous one.
+func (m *MasterDetail) PushDetail(title string, detail fyne.CanvasObject) {
+	m.stack = append(m.stack, detailPage{title: title, content: detail})
+	m.Refresh()
+}
+
+// Resize sets a new size for the container, switching between wide and narrow layouts at the breakpoint.
+func (m *MasterDetail) Resize(size fyne.Size) {
+	narrow := size.Width < m.Breakpoint
+	changed := narrow != m.narrow
+	m.narrow = narrow
+	m.BaseWidget.Resize(size)
+	if changed {
+		m.Refresh()
+		if f := m.OnLayoutChanged; f != nil {
+			f(narrow)
+		}
+	}
+}
+
+// ShowDetail replaces any details with a new one, as when an item of the master is selected.
+func (m *MasterDetail) ShowDetail(title string, detail fyne.CanvasObject) {
+	m.stack = []detailPage{{title: title, content: detail}}
+	m.Refresh()
+}
+
+type masterDetailRenderer struct {
+	md        *MasterDetail
+	back      *widget.Button
+	title     *widget.Label
+	header    *fyne.Container
+	separator *widget.Separator
+	detail    *fyne.Container
+}
+
+func (r *masterDetailRenderer) Destroy() {
+}
+
+func (r *masterDetailRenderer) Layout(size fyne.Size) {
+	m := r.md
+	detailPos := fyne.NewPos(0, 0)
+	detailSize := size
+	if !m.narrow {
+		master := fyne.Max(size.Width*m.MasterRatio, r.masterMin().Width)
+		if m.Master != nil {
+			m.Master.Resize(fyne.NewSize(master, size.Height))
+			m.Master.Move(fyne.NewPos(0, 0))
+		}
+		sep := theme.SeparatorThicknessSize()
+		r.separator.Resize(fyne.NewSize(sep, size.Height))
+		r.separator.Move(fyne.NewPos(master, 0))
+		detailPos = fyne.NewPos(master+sep, 0)
+		detailSize = fyne.NewSize(size.Width-master-sep, size.Height)
+	} else if m.Master != nil {
+		m.Master.Resize(size)
+		m.Master.Move(fyne.NewPos(0, 0))
+	}
+
+	if r.header.Visible() {
+		header := r.header.MinSize().Height
+		r.header.Resize(fyne.NewSize(detailSize.Width, header))
+		r.header.Move(detailPos)
+		detailPos.Y += header
+		detailSize.Height -= header
+	}
+	r.detail.Resize(detailSize)
+	r.detail.Move(detailPos)
+}
+
+func (r *masterDetailRenderer) MinSize() fyne.Size {
+	master := r.masterMin()
+	detail := r.detail.MinSize()
+	if r.header.Visible() {
+		header := r.header.MinSize()
+		detail = fyne.NewSize(fyne.Max(detail.Width, header.Width), detail.Height+header.Height)
+	}
+	// the narrow layout only shows one of the master and detail
+	return master.Max(detail)
+}
+
+func (r *masterDetailRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.separator, r.header, r.detail}
+	if r.md.Master != nil {
+		objects = append([]fyne.CanvasObject{r.md.Master}, objects...)
+	}
+	return objects
+}
+
+func (r *masterDetailRenderer) Refresh() {
+	m := r.md
+	var top *detailPage
+	if len(m.stack) > 0 {
+		top = &m.stack[len(m.stack)-1]
+	}
+
+	r.detail.Objects = nil
+	if top != nil && top.content != nil {
+		r.detail.Objects = []fyne.CanvasObject{top.content}
+	} else if top == nil && !m.narrow && m.Placeholder != nil {
+		r.detail.Objects = []fyne.CanvasObject{m.Placeholder}
+	}
+	r.detail.Refresh()
+
+	showBack := top != nil && (m.narrow || len(m.stack) > 1)
+	r.back.Hidden = !showBack
+	if top != nil {
+		r.title.SetText(top.title)
+	}
+	r.header.Hidden = top == nil || (!showBack && top.title == "")
+	r.header.Refresh()
+
+	if m.Master != nil {
+		if m.narrow && top != nil {
+			m.Master.Hide()
+		} else {
+			m.Master.Show()
+		}
+	}
+	r.separator.Hidden = m.narrow
+	r.detail.Hidden = m.narrow && top == nil
+	r.Layout(m.Size())
+}
+
+func (r *masterDetailRenderer) masterMin() fyne.Size {
+	if r.md.Master == nil {
+		return fyne.NewSize(0, 0)
+	}
+	return r.md.Master.MinSize()
+}
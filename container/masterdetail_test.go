@@ -0,0 +1,72 @@
+package container
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMasterDetail_Wide(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	master := widget.NewLabel("list")
+	placeholder := widget.NewLabel("Select an item")
+	m := NewMasterDetail(master)
+	m.Placeholder = placeholder
+	w := test.NewWindow(m)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(1000, 600))
+
+	r := test.WidgetRenderer(m).(*masterDetailRenderer)
+	assert.False(t, m.IsNarrow())
+	assert.Equal(t, []fyne.CanvasObject{placeholder}, r.detail.Objects)
+	assert.Equal(t, float32(350), master.Size().Width)
+
+	detail := widget.NewLabel("detail")
+	m.ShowDetail("Item", detail)
+	assert.True(t, master.Visible())
+	assert.False(t, r.back.Visible())
+	assert.Equal(t, "Item", r.title.Text)
+
+	m.PushDetail("More", widget.NewLabel("more"))
+	assert.True(t, r.back.Visible())
+	test.Tap(r.back)
+	assert.Equal(t, []fyne.CanvasObject{detail}, r.detail.Objects)
+}
+
+func TestMasterDetail_Narrow(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	master := widget.NewLabel("list")
+	m := NewMasterDetail(master)
+	changes := []bool{}
+	m.OnLayoutChanged = func(narrow bool) {
+		changes = append(changes, narrow)
+	}
+	w := test.NewWindow(m)
+	defer w.Close()
+	w.Resize(fyne.NewSize(400, 600))
+
+	r := test.WidgetRenderer(m).(*masterDetailRenderer)
+	assert.True(t, m.IsNarrow())
+	assert.True(t, master.Visible())
+	assert.False(t, r.detail.Visible())
+
+	m.ShowDetail("Item", widget.NewLabel("detail"))
+	assert.False(t, master.Visible())
+	assert.True(t, r.back.Visible())
+
+	test.Tap(r.back)
+	assert.Zero(t, m.Depth())
+	assert.True(t, master.Visible())
+
+	w.Resize(fyne.NewSize(1000, 600))
+	assert.False(t, m.IsNarrow())
+	assert.Equal(t, []bool{true, false}, changes)
+}
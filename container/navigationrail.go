@@ -0,0 +1,297 @@
+package container
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	xwidget "fyne.io/x/fyne/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*NavigationRail)(nil)
+var _ fyne.Tappable = (*navigationRailItem)(nil)
+var _ desktop.Hoverable = (*navigationRailItem)(nil)
+
+// NavigationItem is a destination of a NavigationRail.
+type NavigationItem struct {
+	Label   string
+	Icon    fyne.Resource
+	Content fyne.CanvasObject
+	// Badge is a count shown on the icon, such as unread messages, it is hidden when zero.
+	Badge int
+}
+
+// NewNavigationItem creates a new item that shows content when it is selected.
+func NewNavigationItem(label string, icon fyne.Resource, content fyne.CanvasObject) *NavigationItem {
+	return &NavigationItem{Label: label, Icon: icon, Content: content}
+}
+
+// NavigationRail is an app shell with a vertical list of destinations along its leading edge,
+// showing the content of the selected one in the remaining space.
+// The rail can be collapsed to show only icons with the menu button at its top.
+type NavigationRail struct {
+	widget.BaseWidget
+
+	Items []*NavigationItem
+	// Collapsed shows only the icons of the items.
+	Collapsed bool
+
+	OnSelected func(index int) `json:"-"`
+
+	selected int
+}
+
+// NewNavigationRail creates a new rail with the first item selected.
+func NewNavigationRail(items ...*NavigationItem) *NavigationRail {
+	r := &NavigationRail{Items: items}
+	r.ExtendBaseWidget(r)
+	return r
+}
+
+// Append adds an item to the end of the rail.
+func (n *NavigationRail) Append(item *NavigationItem) {
+	n.Items = append(n.Items, item)
+	n.Refresh()
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (n *NavigationRail) CreateRenderer() fyne.WidgetRenderer {
+	n.ExtendBaseWidget(n)
+	toggle := widget.NewButtonWithIcon("", theme.MenuIcon(), n.ToggleCollapsed)
+	toggle.Importance = widget.LowImportance
+	r := &navigationRailRenderer{
+		rail:    n,
+		bg:      canvas.NewRectangle(theme.Color(theme.ColorNameHeaderBackground)),
+		divider: widget.NewSeparator(),
+		toggle:  toggle,
+		items:   container.NewVBox(),
+		content: container.NewStack(),
+	}
+	r.Refresh()
+	return r
+}
+
+// Select shows the content of the item at index.
+func (n *NavigationRail) Select(index int) {
+	if index < 0 || index >= len(n.Items) || index == n.selected {
+		return
+	}
+	n.selected = index
+	n.Refresh()
+	if f := n.OnSelected; f != nil {
+		f(index)
+	}
+}
+
+// Selected returns the index of the selected item.
+func (n *NavigationRail) Selected() int {
+	return n.selected
+}
+
+// SetBadge changes the count shown on the icon of the item at index.
+func (n *NavigationRail) SetBadge(index, count int) {
+	if index < 0 || index >= len(n.Items) {
+		return
+	}
+	n.Items[index].Badge = count
+	n.Refresh()
+}
+
+// SetCollapsed changes whether the rail only shows icons.
+func (n *NavigationRail) SetCollapsed(collapsed bool) {
+	n.Collapsed = collapsed
+	n.Refresh()
+}
+
+// ToggleCollapsed collapses an expanded rail, or expands a collapsed one.
+func (n *NavigationRail) ToggleCollapsed() {
+	n.SetCollapsed(!n.Collapsed)
+}
+
+type navigationRailRenderer struct {
+	rail    *NavigationRail
+	bg      *canvas.Rectangle
+	divider *widget.Separator
+	toggle  *widget.Button
+	items   *fyne.Container
+	content *fyne.Container
+}
+
+func (r *navigationRailRenderer) Destroy() {
+}
+
+func (r *navigationRailRenderer) Layout(size fyne.Size) {
+	pad := theme.Padding()
+	rail := r.railWidth()
+	r.bg.Resize(fyne.NewSize(rail, size.Height))
+
+	toggle := r.toggle.MinSize()
+	r.toggle.Resize(toggle)
+	r.toggle.Move(fyne.NewPos(pad, pad))
+	r.items.Resize(fyne.NewSize(rail-pad*2, r.items.MinSize().Height))
+	r.items.Move(fyne.NewPos(pad, toggle.Height+pad*2))
+
+	r.divider.Resize(fyne.NewSize(theme.SeparatorThicknessSize(), size.Height))
+	r.divider.Move(fyne.NewPos(rail, 0))
+	left := rail + theme.SeparatorThicknessSize()
+	r.content.Resize(fyne.NewSize(size.Width-left, size.Height))
+	r.content.Move(fyne.NewPos(left, 0))
+}
+
+func (r *navigationRailRenderer) MinSize() fyne.Size {
+	pad := theme.Padding()
+	rail := fyne.NewSize(r.railWidth(), r.toggle.MinSize().Height+r.items.MinSize().Height+pad*3)
+	content := r.content.MinSize()
+	return fyne.NewSize(rail.Width+theme.SeparatorThicknessSize()+content.Width, fyne.Max(rail.Height, content.Height))
+}
+
+func (r *navigationRailRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg, r.toggle, r.items, r.divider, r.content}
+}
+
+func (r *navigationRailRenderer) Refresh() {
+	n := r.rail
+	if len(r.items.Objects) != len(n.Items) {
+		r.items.Objects = nil
+		for i := range n.Items {
+			r.items.Add(newNavigationRailItem(n, i))
+		}
+	}
+	for _, item := range r.items.Objects {
+		item.Refresh()
+	}
+
+	r.content.Objects = nil
+	if n.selected < len(n.Items) && n.Items[n.selected].Content != nil {
+		r.content.Objects = []fyne.CanvasObject{n.Items[n.selected].Content}
+	}
+	r.bg.FillColor = theme.Color(theme.ColorNameHeaderBackground)
+	r.bg.Refresh()
+	r.items.Refresh()
+	r.content.Refresh()
+	r.Layout(n.Size())
+}
+
+func (r *navigationRailRenderer) railWidth() float32 {
+	width := r.toggle.MinSize().Width
+	for _, item := range r.items.Objects {
+		width = fyne.Max(width, item.MinSize().Width)
+	}
+	return width + theme.Padding()*2
+}
+
+// navigationRailItem is the tappable icon and label of a NavigationItem.
+type navigationRailItem struct {
+	widget.BaseWidget
+	rail    *NavigationRail
+	index   int
+	hovered bool
+}
+
+func newNavigationRailItem(n *NavigationRail, index int) *navigationRailItem {
+	i := &navigationRailItem{rail: n, index: index}
+	i.ExtendBaseWidget(i)
+	return i
+}
+
+func (i *navigationRailItem) CreateRenderer() fyne.WidgetRenderer {
+	bg := canvas.NewRectangle(color.Transparent)
+	bg.CornerRadius = theme.InputRadiusSize()
+	icon := widget.NewIcon(nil)
+	badge := xwidget.NewBadge(0)
+	label := canvas.NewText("", theme.Color(theme.ColorNameForeground))
+	label.TextSize = theme.CaptionTextSize()
+	label.Alignment = fyne.TextAlignCenter
+	r := &navigationRailItemRenderer{item: i, bg: bg, icon: icon, badge: badge, label: label,
+		decorated: xwidget.WithBadge(icon, badge)}
+	r.Refresh()
+	return r
+}
+
+func (i *navigationRailItem) MouseIn(*desktop.MouseEvent) {
+	i.hovered = true
+	i.Refresh()
+}
+
+func (i *navigationRailItem) MouseMoved(*desktop.MouseEvent) {
+}
+
+func (i *navigationRailItem) MouseOut() {
+	i.hovered = false
+	i.Refresh()
+}
+
+func (i *navigationRailItem) Tapped(*fyne.PointEvent) {
+	i.rail.Select(i.index)
+}
+
+type navigationRailItemRenderer struct {
+	item      *navigationRailItem
+	bg        *canvas.Rectangle
+	icon      *widget.Icon
+	badge     *xwidget.Badge
+	label     *canvas.Text
+	decorated *fyne.Container
+}
+
+func (r *navigationRailItemRenderer) Destroy() {
+}
+
+func (r *navigationRailItemRenderer) Layout(size fyne.Size) {
+	pad := theme.Padding()
+	icon := fyne.NewSquareSize(theme.IconInlineSize() * 1.5)
+	r.bg.Resize(size)
+	r.decorated.Resize(icon)
+	r.decorated.Move(fyne.NewPos((size.Width-icon.Width)/2, pad*2))
+	r.label.Resize(fyne.NewSize(size.Width, r.label.MinSize().Height))
+	r.label.Move(fyne.NewPos(0, icon.Height+pad*3))
+}
+
+func (r *navigationRailItemRenderer) MinSize() fyne.Size {
+	pad := theme.Padding()
+	icon := theme.IconInlineSize() * 1.5
+	if r.label.Hidden {
+		return fyne.NewSquareSize(icon + pad*4)
+	}
+	text := r.label.MinSize()
+	return fyne.NewSize(fyne.Max(icon, text.Width)+pad*4, icon+text.Height+pad*5)
+}
+
+func (r *navigationRailItemRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg, r.decorated, r.label}
+}
+
+func (r *navigationRailItemRenderer) Refresh() {
+	i := r.item
+	if i.index >= len(i.rail.Items) {
+		return
+	}
+	item := i.rail.Items[i.index]
+	selected := i.rail.selected == i.index
+
+	r.bg.FillColor = color.Transparent
+	if selected {
+		r.bg.FillColor = theme.Color(theme.ColorNameSelection)
+	} else if i.hovered {
+		r.bg.FillColor = theme.Color(theme.ColorNameHover)
+	}
+	r.icon.SetResource(item.Icon)
+	if selected && item.Icon != nil {
+		r.icon.SetResource(theme.NewPrimaryThemedResource(item.Icon))
+	}
+	r.badge.SetCount(item.Badge)
+	r.label.Text = item.Label
+	r.label.TextStyle.Bold = selected
+	r.label.Color = theme.Color(theme.ColorNameForeground)
+	r.label.Hidden = i.rail.Collapsed
+	r.bg.Refresh()
+	r.label.Refresh()
+	r.Layout(i.Size())
+}
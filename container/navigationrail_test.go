@@ -0,0 +1,72 @@
+package container
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNavigationRail_Select(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	home := widget.NewLabel("Home")
+	mail := widget.NewLabel("Mail")
+	selected := -1
+	n := NewNavigationRail(
+		NewNavigationItem("Home", theme.HomeIcon(), home),
+		NewNavigationItem("Mail", theme.MailComposeIcon(), mail),
+	)
+	n.OnSelected = func(i int) {
+		selected = i
+	}
+	w := test.NewWindow(n)
+	defer w.Close()
+
+	r := test.WidgetRenderer(n).(*navigationRailRenderer)
+	assert.Equal(t, []fyne.CanvasObject{home}, r.content.Objects)
+
+	test.Tap(r.items.Objects[1].(*navigationRailItem))
+	assert.Equal(t, 1, n.Selected())
+	assert.Equal(t, 1, selected)
+	assert.Equal(t, []fyne.CanvasObject{mail}, r.content.Objects)
+
+	n.Select(5)
+	assert.Equal(t, 1, n.Selected())
+}
+
+func TestNavigationRail_Collapsed(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	n := NewNavigationRail(NewNavigationItem("Settings", theme.SettingsIcon(), widget.NewLabel("Settings")))
+	w := test.NewWindow(n)
+	defer w.Close()
+
+	r := test.WidgetRenderer(n).(*navigationRailRenderer)
+	expanded := r.railWidth()
+	test.Tap(r.toggle)
+	assert.True(t, n.Collapsed)
+	assert.Less(t, r.railWidth(), expanded)
+
+	n.SetCollapsed(false)
+	assert.Equal(t, expanded, r.railWidth())
+}
+
+func TestNavigationRail_Badge(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	n := NewNavigationRail(NewNavigationItem("Mail", theme.MailComposeIcon(), nil))
+	w := test.NewWindow(n)
+	defer w.Close()
+
+	n.SetBadge(0, 3)
+	item := test.WidgetRenderer(n).(*navigationRailRenderer).items.Objects[0].(*navigationRailItem)
+	badge := test.WidgetRenderer(item).(*navigationRailItemRenderer).badge
+	assert.Equal(t, 3, badge.Count)
+}
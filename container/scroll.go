@@ -0,0 +1,206 @@
+package container
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Scroll)(nil)
+
+// Scroll is a scroll container that can be scrolled programmatically, with animation,
+// to an offset or to show one of the objects in its content.
+// The offset can be bound to data, and OnScrolled is called whenever it changes.
+type Scroll struct {
+	widget.BaseWidget
+
+	OnScrolled func(offset fyne.Position) `json:"-"`
+
+	scroll       *container.Scroll
+	anim         *fyne.Animation
+	xData, yData binding.Float
+	xListener    binding.DataListener
+	yListener    binding.DataListener
+}
+
+// NewScroll creates a new container that scrolls content in both directions.
+func NewScroll(content fyne.CanvasObject) *Scroll {
+	return newScroll(container.NewScroll(content))
+}
+
+// NewHScroll creates a new container that scrolls content horizontally.
+func NewHScroll(content fyne.CanvasObject) *Scroll {
+	return newScroll(container.NewHScroll(content))
+}
+
+// NewVScroll creates a new container that scrolls content vertically.
+func NewVScroll(content fyne.CanvasObject) *Scroll {
+	return newScroll(container.NewVScroll(content))
+}
+
+func newScroll(inner *container.Scroll) *Scroll {
+	s := &Scroll{scroll: inner}
+	inner.OnScrolled = func(offset fyne.Position) {
+		s.scrolled(offset, true)
+	}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// BindOffset connects the horizontal and vertical offsets to data sources, either may be nil.
+func (s *Scroll) BindOffset(x, y binding.Float) {
+	s.UnbindOffset()
+	s.xData, s.yData = x, y
+	if x != nil {
+		s.xListener = binding.NewDataListener(func() {
+			v, err := x.Get()
+			if err != nil {
+				fyne.LogError("Error getting current data value", err)
+				return
+			}
+			s.setOffset(fyne.NewPos(float32(v), s.scroll.Offset.Y), false)
+		})
+		x.AddListener(s.xListener)
+	}
+	if y != nil {
+		s.yListener = binding.NewDataListener(func() {
+			v, err := y.Get()
+			if err != nil {
+				fyne.LogError("Error getting current data value", err)
+				return
+			}
+			s.setOffset(fyne.NewPos(s.scroll.Offset.X, float32(v)), false)
+		})
+		y.AddListener(s.yListener)
+	}
+}
+
+// Content returns the object that is scrolled.
+func (s *Scroll) Content() fyne.CanvasObject {
+	return s.scroll.Content
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (s *Scroll) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	return widget.NewSimpleRenderer(s.scroll)
+}
+
+// Offset returns the current scroll position of the content.
+func (s *Scroll) Offset() fyne.Position {
+	return s.scroll.Offset
+}
+
+// ScrollTo animates scrolling to an offset.
+func (s *Scroll) ScrollTo(offset fyne.Position) {
+	if s.anim != nil {
+		s.anim.Stop()
+	}
+	offset = s.clamp(offset)
+	from := s.scroll.Offset
+	if from == offset {
+		return
+	}
+	s.anim = fyne.NewAnimation(canvas.DurationStandard, func(done float32) {
+		s.setOffset(fyne.NewPos(from.X+(offset.X-from.X)*done, from.Y+(offset.Y-from.Y)*done), true)
+	})
+	s.anim.Curve = fyne.AnimationEaseInOut
+	s.anim.Start()
+}
+
+// ScrollToBottom animates scrolling to the end of the content.
+func (s *Scroll) ScrollToBottom() {
+	s.ScrollTo(fyne.NewPos(s.scroll.Offset.X, s.scroll.Content.MinSize().Height))
+}
+
+// ScrollToObject animates scrolling the least distance that shows obj, which must be inside the content.
+func (s *Scroll) ScrollToObject(obj fyne.CanvasObject) {
+	d := fyne.CurrentApp().Driver()
+	content := d.AbsolutePositionForObject(s.scroll.Content)
+	pos := d.AbsolutePositionForObject(obj).Subtract(content)
+	size := obj.Size()
+	view := s.scroll.Size()
+
+	offset := s.scroll.Offset
+	if pos.X < offset.X {
+		offset.X = pos.X
+	} else if pos.X+size.Width > offset.X+view.Width {
+		offset.X = fyne.Min(pos.X, pos.X+size.Width-view.Width)
+	}
+	if pos.Y < offset.Y {
+		offset.Y = pos.Y
+	} else if pos.Y+size.Height > offset.Y+view.Height {
+		offset.Y = fyne.Min(pos.Y, pos.Y+size.Height-view.Height)
+	}
+	s.ScrollTo(offset)
+}
+
+// ScrollToTop animates scrolling to the start of the content.
+func (s *Scroll) ScrollToTop() {
+	s.ScrollTo(fyne.NewPos(s.scroll.Offset.X, 0))
+}
+
+// SetMinSize specifies a minimum size for the scroll container.
+func (s *Scroll) SetMinSize(size fyne.Size) {
+	s.scroll.SetMinSize(size)
+}
+
+// SetOffset scrolls to an offset immediately.
+func (s *Scroll) SetOffset(offset fyne.Position) {
+	if s.anim != nil {
+		s.anim.Stop()
+	}
+	s.setOffset(offset, true)
+}
+
+// UnbindOffset disconnects any data sources bound by BindOffset.
+func (s *Scroll) UnbindOffset() {
+	if s.xData != nil {
+		s.xData.RemoveListener(s.xListener)
+	}
+	if s.yData != nil {
+		s.yData.RemoveListener(s.yListener)
+	}
+	s.xData, s.yData = nil, nil
+	s.xListener, s.yListener = nil, nil
+}
+
+func (s *Scroll) clamp(offset fyne.Position) fyne.Position {
+	content := s.scroll.Content.MinSize()
+	size := s.scroll.Size()
+	offset.X = fyne.Max(0, fyne.Min(offset.X, content.Width-size.Width))
+	offset.Y = fyne.Max(0, fyne.Min(offset.Y, content.Height-size.Height))
+	return offset
+}
+
+func (s *Scroll) setOffset(offset fyne.Position, notifyBinding bool) {
+	offset = s.clamp(offset)
+	if offset == s.scroll.Offset {
+		return
+	}
+	s.scroll.Offset = offset
+	s.scroll.Refresh()
+	s.scrolled(s.scroll.Offset, notifyBinding)
+}
+
+func (s *Scroll) scrolled(offset fyne.Position, notifyBinding bool) {
+	if notifyBinding {
+		if s.xData != nil {
+			if err := s.xData.Set(float64(offset.X)); err != nil {
+				fyne.LogError("Error setting current data value", err)
+			}
+		}
+		if s.yData != nil {
+			if err := s.yData.Set(float64(offset.Y)); err != nil {
+				fyne.LogError("Error setting current data value", err)
+			}
+		}
+	}
+	if f := s.OnScrolled; f != nil {
+		f(offset)
+	}
+}
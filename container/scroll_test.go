@@ -0,0 +1,107 @@
+package container
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestScrollContent() (*fyne.Container, []*widget.Label) {
+	labels := make([]*widget.Label, 50)
+	box := container.NewVBox()
+	for i := range labels {
+		labels[i] = widget.NewLabel("row")
+		box.Add(labels[i])
+	}
+	return box, labels
+}
+
+func TestScroll_SetOffset(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	content, _ := newTestScrollContent()
+	s := NewVScroll(content)
+	var scrolled fyne.Position
+	s.OnScrolled = func(p fyne.Position) {
+		scrolled = p
+	}
+	w := test.NewWindow(s)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+
+	s.SetOffset(fyne.NewPos(0, 100))
+	assert.Equal(t, float32(100), s.Offset().Y)
+	assert.Equal(t, float32(100), scrolled.Y)
+
+	s.SetOffset(fyne.NewPos(0, -50))
+	assert.Equal(t, float32(0), s.Offset().Y)
+
+	s.SetOffset(fyne.NewPos(0, 1e6))
+	assert.Equal(t, content.MinSize().Height-s.Size().Height, s.Offset().Y)
+}
+
+func TestScroll_ScrollToObject(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	content, labels := newTestScrollContent()
+	s := NewVScroll(content)
+	w := test.NewWindow(s)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(200, 200))
+
+	target := labels[20]
+	s.ScrollToObject(target)
+	time.Sleep(canvas.DurationStandard + 100*time.Millisecond)
+	assert.Equal(t, target.Position().Y+target.Size().Height-s.Size().Height, s.Offset().Y)
+
+	s.ScrollToTop()
+	time.Sleep(canvas.DurationStandard + 100*time.Millisecond)
+	assert.Zero(t, s.Offset().Y)
+}
+
+// waitForBinding returns once the data listeners that are already queued have been called.
+func waitForBinding() {
+	done := make(chan struct{})
+	binding.NewBool().AddListener(binding.NewDataListener(func() {
+		close(done)
+	}))
+	<-done
+}
+
+func TestScroll_BindOffset(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	content, _ := newTestScrollContent()
+	s := NewVScroll(content)
+	w := test.NewWindow(s)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+
+	y := binding.NewFloat()
+	s.BindOffset(nil, y)
+	waitForBinding()
+	y.Set(80)
+	waitForBinding()
+	assert.Equal(t, float32(80), s.Offset().Y)
+
+	s.SetOffset(fyne.NewPos(0, 40))
+	v, _ := y.Get()
+	assert.Equal(t, 40.0, v)
+	waitForBinding()
+
+	s.UnbindOffset()
+	y.Set(10)
+	waitForBinding()
+	assert.Equal(t, float32(40), s.Offset().Y)
+}
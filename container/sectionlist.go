@@ -0,0 +1,316 @@
+package container
+
+import (
+	"image/color"
+	"unicode/utf8"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*SectionList)(nil)
+var _ fyne.Draggable = (*sectionIndex)(nil)
+var _ fyne.Tappable = (*sectionIndex)(nil)
+
+// ListSection is a titled group of items in a SectionList.
+type ListSection struct {
+	Header string
+	// Index is the short label for the section in the index bar, the first letter of Header if empty.
+	Index string
+	Items []fyne.CanvasObject
+}
+
+// NewListSection creates a new section with a header and items.
+func NewListSection(header string, items ...fyne.CanvasObject) *ListSection {
+	return &ListSection{Header: header, Items: items}
+}
+
+func (s *ListSection) indexLabel() string {
+	if s.Index != "" {
+		return s.Index
+	}
+	r, _ := utf8.DecodeRuneInString(s.Header)
+	if r == utf8.RuneError {
+		return ""
+	}
+	return string(r)
+}
+
+// SectionList is a scrolling list of grouped items, like contacts or settings, where the header of
+// the section being scrolled sticks to the top. An index bar beside the list jumps to a section.
+type SectionList struct {
+	widget.BaseWidget
+
+	Sections  []*ListSection
+	HideIndex bool
+
+	OnSectionChanged func(section int) `json:"-"`
+
+	current  int
+	scrollTo func(section int) // set by the renderer, which knows where the sections are
+}
+
+// NewSectionList creates a new list showing the given sections.
+func NewSectionList(sections ...*ListSection) *SectionList {
+	l := &SectionList{Sections: sections}
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// Append adds a section to the end of the list.
+func (l *SectionList) Append(section *ListSection) {
+	l.Sections = append(l.Sections, section)
+	l.Refresh()
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (l *SectionList) CreateRenderer() fyne.WidgetRenderer {
+	l.ExtendBaseWidget(l)
+	r := &sectionListRenderer{list: l, content: container.NewVBox(), sticky: newSectionHeader("")}
+	r.scroll = container.NewVScroll(r.content)
+	r.scroll.OnScrolled = func(fyne.Position) {
+		r.updateSticky()
+	}
+	r.index = &sectionIndex{list: l, jump: r.scrollToSection}
+	l.scrollTo = r.scrollToSection
+	r.index.ExtendBaseWidget(r.index)
+	r.Refresh()
+	return r
+}
+
+// CurrentSection returns the index of the section at the top of the list.
+func (l *SectionList) CurrentSection() int {
+	return l.current
+}
+
+// ScrollToSection scrolls the list so that the section with the given index is at the top.
+func (l *SectionList) ScrollToSection(section int) {
+	if section < 0 || section >= len(l.Sections) {
+		return
+	}
+	if l.scrollTo != nil {
+		l.scrollTo(section)
+	}
+}
+
+type sectionListRenderer struct {
+	list    *SectionList
+	scroll  *container.Scroll
+	content *fyne.Container
+	headers []*sectionHeader
+	sticky  *sectionHeader
+	index   *sectionIndex
+}
+
+func (r *sectionListRenderer) Destroy() {
+}
+
+func (r *sectionListRenderer) Layout(size fyne.Size) {
+	width := size.Width
+	if !r.index.Hidden {
+		index := r.index.MinSize().Width
+		width -= index
+		r.index.Resize(fyne.NewSize(index, size.Height))
+		r.index.Move(fyne.NewPos(width, 0))
+	}
+	r.scroll.Resize(fyne.NewSize(width, size.Height))
+	r.sticky.Resize(fyne.NewSize(width, r.sticky.MinSize().Height))
+	r.updateSticky()
+}
+
+func (r *sectionListRenderer) MinSize() fyne.Size {
+	min := r.scroll.MinSize()
+	if !r.index.Hidden {
+		index := r.index.MinSize()
+		min = fyne.NewSize(min.Width+index.Width, fyne.Max(min.Height, index.Height))
+	}
+	return min
+}
+
+func (r *sectionListRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.scroll, r.sticky, r.index}
+}
+
+func (r *sectionListRenderer) Refresh() {
+	l := r.list
+	r.headers = make([]*sectionHeader, len(l.Sections))
+	objects := make([]fyne.CanvasObject, 0, len(l.Sections))
+	for i, s := range l.Sections {
+		r.headers[i] = newSectionHeader(s.Header)
+		objects = append(objects, r.headers[i])
+		objects = append(objects, s.Items...)
+	}
+	r.content.Objects = objects
+	r.content.Refresh()
+	r.index.Hidden = l.HideIndex || len(l.Sections) == 0
+	r.index.Refresh()
+	r.sticky.Hidden = len(l.Sections) == 0
+	r.Layout(l.Size())
+	canvas.Refresh(l)
+}
+
+func (r *sectionListRenderer) scrollToSection(section int) {
+	if section < 0 || section >= len(r.headers) {
+		return
+	}
+	r.scroll.Offset = fyne.NewPos(0, r.headers[section].Position().Y)
+	r.scroll.Refresh()
+	r.updateSticky()
+}
+
+// updateSticky shows the header of the section at the top of the viewport,
+// pushed up by the next header as it scrolls into its place.
+func (r *sectionListRenderer) updateSticky() {
+	l := r.list
+	if len(r.headers) == 0 {
+		return
+	}
+	offset := r.scroll.Offset.Y
+	current := 0
+	for i, h := range r.headers {
+		if h.Position().Y > offset {
+			break
+		}
+		current = i
+	}
+
+	y := float32(0)
+	if current+1 < len(r.headers) {
+		next := r.headers[current+1].Position().Y - offset
+		y = fyne.Min(0, next-r.sticky.Size().Height)
+	}
+	r.sticky.Move(fyne.NewPos(0, y))
+	if r.sticky.label.Text != l.Sections[current].Header {
+		r.sticky.label.SetText(l.Sections[current].Header)
+	}
+
+	if current != l.current {
+		l.current = current
+		if f := l.OnSectionChanged; f != nil {
+			f(current)
+		}
+	}
+}
+
+// sectionHeader is the title of a section, with a background so that it covers items scrolling under it.
+type sectionHeader struct {
+	widget.BaseWidget
+	bg    *canvas.Rectangle
+	label *widget.Label
+}
+
+func newSectionHeader(text string) *sectionHeader {
+	h := &sectionHeader{bg: canvas.NewRectangle(theme.Color(theme.ColorNameBackground)),
+		label: widget.NewLabelWithStyle(text, fyne.TextAlignLeading, fyne.TextStyle{Bold: true})}
+	h.label.Truncation = fyne.TextTruncateEllipsis
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+func (h *sectionHeader) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewStack(h.bg, h.label))
+}
+
+func (h *sectionHeader) Refresh() {
+	h.bg.FillColor = theme.Color(theme.ColorNameBackground)
+	h.BaseWidget.Refresh()
+}
+
+// sectionIndex is the bar of section labels that jumps to a section when tapped or dragged over.
+type sectionIndex struct {
+	widget.BaseWidget
+	list *SectionList
+	jump func(section int)
+}
+
+func (i *sectionIndex) CreateRenderer() fyne.WidgetRenderer {
+	r := &sectionIndexRenderer{index: i, bg: canvas.NewRectangle(color.Transparent)}
+	r.Refresh()
+	return r
+}
+
+func (i *sectionIndex) Dragged(ev *fyne.DragEvent) {
+	i.jumpAt(ev.Position.Y)
+}
+
+func (i *sectionIndex) DragEnd() {
+}
+
+func (i *sectionIndex) Tapped(ev *fyne.PointEvent) {
+	i.jumpAt(ev.Position.Y)
+}
+
+// jumpAt scrolls to the section whose label is at the y position, the labels are spread evenly over the bar.
+func (i *sectionIndex) jumpAt(y float32) {
+	count := len(i.list.Sections)
+	height := i.Size().Height
+	if count == 0 || height <= 0 {
+		return
+	}
+	section := int(y / height * float32(count))
+	if section < 0 {
+		section = 0
+	} else if section >= count {
+		section = count - 1
+	}
+	i.jump(section)
+}
+
+type sectionIndexRenderer struct {
+	index  *sectionIndex
+	bg     *canvas.Rectangle
+	labels []*canvas.Text
+}
+
+func (r *sectionIndexRenderer) Destroy() {
+}
+
+func (r *sectionIndexRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	if len(r.labels) == 0 {
+		return
+	}
+	step := size.Height / float32(len(r.labels))
+	for i, t := range r.labels {
+		min := t.MinSize()
+		t.Resize(fyne.NewSize(size.Width, min.Height))
+		t.Move(fyne.NewPos(0, step*float32(i)+(step-min.Height)/2))
+	}
+}
+
+func (r *sectionIndexRenderer) MinSize() fyne.Size {
+	width := float32(0)
+	height := float32(0)
+	for _, t := range r.labels {
+		min := t.MinSize()
+		width = fyne.Max(width, min.Width)
+		height += min.Height
+	}
+	return fyne.NewSize(width+theme.Padding()*2, height)
+}
+
+func (r *sectionIndexRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.bg}
+	for _, t := range r.labels {
+		objects = append(objects, t)
+	}
+	return objects
+}
+
+func (r *sectionIndexRenderer) Refresh() {
+	r.labels = make([]*canvas.Text, len(r.index.list.Sections))
+	for i, s := range r.index.list.Sections {
+		t := canvas.NewText(s.indexLabel(), theme.Color(theme.ColorNamePrimary))
+		t.Alignment = fyne.TextAlignCenter
+		t.TextSize = theme.CaptionTextSize()
+		t.TextStyle.Bold = true
+		r.labels[i] = t
+	}
+	r.Layout(r.index.Size())
+	canvas.Refresh(r.index)
+}
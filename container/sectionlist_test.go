@@ -0,0 +1,86 @@
+package container
+
+import (
+	"fmt"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSectionList() *SectionList {
+	var sections []*ListSection
+	for _, letter := range []string{"A", "B", "C"} {
+		s := NewListSection(letter)
+		for i := 0; i < 10; i++ {
+			s.Items = append(s.Items, widget.NewLabel(fmt.Sprintf("%s%d", letter, i)))
+		}
+		sections = append(sections, s)
+	}
+	return NewSectionList(sections...)
+}
+
+func TestListSection_IndexLabel(t *testing.T) {
+	assert.Equal(t, "Ä", NewListSection("Änne").indexLabel())
+	assert.Equal(t, "", NewListSection("").indexLabel())
+
+	s := NewListSection("Favourites")
+	s.Index = "★"
+	assert.Equal(t, "★", s.indexLabel())
+}
+
+func TestSectionList_StickyHeader(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	l := newTestSectionList()
+	changed := -1
+	l.OnSectionChanged = func(section int) {
+		changed = section
+	}
+	w := test.NewWindow(l)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+
+	r := test.WidgetRenderer(l).(*sectionListRenderer)
+	assert.Equal(t, "A", r.sticky.label.Text)
+	assert.Equal(t, float32(0), r.sticky.Position().Y)
+
+	l.ScrollToSection(1)
+	assert.Equal(t, 1, l.CurrentSection())
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, "B", r.sticky.label.Text)
+
+	// the next header pushes the sticky header up as it arrives
+	next := r.headers[2].Position().Y
+	r.scroll.Offset = fyne.NewPos(0, next-r.sticky.Size().Height/2)
+	r.scroll.Refresh()
+	r.updateSticky()
+	assert.Equal(t, "B", r.sticky.label.Text)
+	assert.Equal(t, -r.sticky.Size().Height/2, r.sticky.Position().Y)
+}
+
+func TestSectionList_Index(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	l := newTestSectionList()
+	w := test.NewWindow(l)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 300))
+
+	r := test.WidgetRenderer(l).(*sectionListRenderer)
+	assert.True(t, r.index.Visible())
+
+	height := r.index.Size().Height
+	test.TapAt(r.index, fyne.NewPos(1, height*0.9))
+	assert.Equal(t, 2, l.CurrentSection())
+	test.TapAt(r.index, fyne.NewPos(1, 1))
+	assert.Equal(t, 0, l.CurrentSection())
+
+	l.HideIndex = true
+	l.Refresh()
+	assert.False(t, r.index.Visible())
+}
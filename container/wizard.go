@@ -0,0 +1,268 @@
+package container
+
+import (
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Wizard)(nil)
+
+const (
+	wizardDotSize    = 8
+	wizardNumberSize = 24
+)
+
+// WizardProgress is how a Wizard shows the progress through its steps.
+type WizardProgress int
+
+const (
+	// WizardProgressNone shows no progress indicator, this is the default.
+	WizardProgressNone WizardProgress = iota
+	// WizardProgressDots shows a row of dots, one for each step.
+	WizardProgressDots
+	// WizardProgressNumbers shows the numbers and titles of the steps.
+	WizardProgressNumbers
+)
+
+// WizardStep is a page of a Wizard.
+type WizardStep struct {
+	// Title names the step in the WizardProgressNumbers indicator.
+	Title   string
+	Content fyne.CanvasObject
+
+	// Validate is called before moving on from the step, an error keeps the wizard on the step and is shown below it.
+	Validate func() error `json:"-"`
+	// Skip is called when the wizard moves towards the step, it is passed over if true is returned.
+	// This can branch on the answers of earlier steps.
+	Skip func() bool `json:"-"`
+}
+
+// NewWizardStep creates a new step showing content under a title.
+func NewWizardStep(title string, content fyne.CanvasObject) *WizardStep {
+	return &WizardStep{Title: title, Content: content}
+}
+
+// Wizard leads through an ordered sequence of steps, such as an installer or onboarding flow, with
+// buttons to go back, to go on once the step is valid, and to finish after the last step.
+// Steps can be skipped depending on earlier answers, and the progress can be shown above them.
+type Wizard struct {
+	widget.BaseWidget
+
+	Steps    []*WizardStep
+	Progress WizardProgress
+
+	OnStepChanged func(step int) `json:"-"`
+	// OnFinished is called when Finish is tapped on the last step and it is valid.
+	OnFinished func() `json:"-"`
+
+	current int
+	err     error
+}
+
+// NewWizard creates a new wizard starting at the first of the steps that is not skipped.
+func NewWizard(steps ...*WizardStep) *Wizard {
+	w := &Wizard{Steps: steps}
+	w.ExtendBaseWidget(w)
+	if first := w.following(-1, 1); first > 0 {
+		w.current = first
+	}
+	return w
+}
+
+// Back moves to the previous step that is not skipped.
+func (w *Wizard) Back() {
+	if previous := w.following(w.current, -1); previous >= 0 {
+		w.SetStep(previous)
+	}
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (w *Wizard) CreateRenderer() fyne.WidgetRenderer {
+	w.ExtendBaseWidget(w)
+	r := &wizardRenderer{
+		wizard:   w,
+		progress: container.NewHBox(),
+		content:  container.NewStack(),
+		error:    widget.NewLabel(""),
+		back:     widget.NewButton(i18n.L("Back"), w.Back),
+		next:     widget.NewButton(i18n.L("Next"), w.Next),
+	}
+	r.error.Importance = widget.DangerImportance
+	r.error.Wrapping = fyne.TextWrapWord
+	r.next.Importance = widget.HighImportance
+	buttons := container.NewHBox(layout.NewSpacer(), r.back, r.next)
+	r.box = container.NewBorder(container.NewCenter(r.progress),
+		container.NewVBox(r.error, widget.NewSeparator(), buttons), nil, nil, r.content)
+	r.Refresh()
+	return r
+}
+
+// Finish checks the last step and calls OnFinished if it is valid.
+func (w *Wizard) Finish() {
+	if !w.validate() {
+		return
+	}
+	if f := w.OnFinished; f != nil {
+		f()
+	}
+}
+
+// Next checks the current step and moves to the next one that is not skipped if it is valid,
+// or finishes the wizard from the last step.
+func (w *Wizard) Next() {
+	next := w.following(w.current, 1)
+	if next < 0 {
+		w.Finish()
+		return
+	}
+	if w.validate() {
+		w.SetStep(next)
+	}
+}
+
+// SetStep moves to the step at index without checking the current step.
+func (w *Wizard) SetStep(index int) {
+	if index < 0 || index >= len(w.Steps) {
+		return
+	}
+	changed := index != w.current
+	w.current = index
+	w.err = nil
+	w.Refresh()
+	if f := w.OnStepChanged; changed && f != nil {
+		f(index)
+	}
+}
+
+// Step returns the index of the current step.
+func (w *Wizard) Step() int {
+	return w.current
+}
+
+// following returns the index of the next step in a direction from an index that is not skipped, or -1 if none.
+func (w *Wizard) following(from, dir int) int {
+	for i := from + dir; i >= 0 && i < len(w.Steps); i += dir {
+		if skip := w.Steps[i].Skip; skip == nil || !skip() {
+			return i
+		}
+	}
+	return -1
+}
+
+// validate checks the current step, showing the error if it is not valid.
+func (w *Wizard) validate() bool {
+	w.err = nil
+	if w.current < len(w.Steps) {
+		if f := w.Steps[w.current].Validate; f != nil {
+			w.err = f()
+		}
+	}
+	w.Refresh()
+	return w.err == nil
+}
+
+type wizardRenderer struct {
+	wizard     *Wizard
+	box        *fyne.Container
+	progress   *fyne.Container
+	content    *fyne.Container
+	error      *widget.Label
+	back, next *widget.Button
+}
+
+func (r *wizardRenderer) Destroy() {
+}
+
+func (r *wizardRenderer) Layout(size fyne.Size) {
+	r.box.Resize(size)
+}
+
+func (r *wizardRenderer) MinSize() fyne.Size {
+	return r.box.MinSize()
+}
+
+func (r *wizardRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.box}
+}
+
+func (r *wizardRenderer) Refresh() {
+	w := r.wizard
+	r.content.Objects = nil
+	if w.current < len(w.Steps) {
+		if c := w.Steps[w.current].Content; c != nil {
+			r.content.Objects = []fyne.CanvasObject{c}
+		}
+	}
+	r.content.Refresh()
+
+	r.error.Hidden = w.err == nil
+	if w.err != nil {
+		r.error.SetText(w.err.Error())
+	}
+
+	if w.following(w.current, -1) < 0 {
+		r.back.Disable()
+	} else {
+		r.back.Enable()
+	}
+	if w.following(w.current, 1) < 0 {
+		r.next.SetText(i18n.L("Finish"))
+	} else {
+		r.next.SetText(i18n.L("Next"))
+	}
+
+	r.refreshProgress()
+	r.box.Refresh()
+}
+
+// refreshProgress shows the steps that are not skipped, marking the ones up to the current step.
+func (r *wizardRenderer) refreshProgress() {
+	w := r.wizard
+	r.progress.Objects = nil
+	r.progress.Hidden = w.Progress == WizardProgressNone
+	if r.progress.Hidden {
+		return
+	}
+
+	number := 0
+	for i, step := range w.Steps {
+		if i != w.current && step.Skip != nil && step.Skip() {
+			continue
+		}
+		number++
+		fill := theme.Color(theme.ColorNameDisabled)
+		if i <= w.current {
+			fill = theme.Color(theme.ColorNamePrimary)
+		}
+		circle := canvas.NewCircle(fill)
+
+		if w.Progress == WizardProgressDots {
+			r.progress.Add(container.NewGridWrap(fyne.NewSquareSize(wizardDotSize), circle))
+			continue
+		}
+		text := canvas.NewText(strconv.Itoa(number), theme.Color(theme.ColorNameForegroundOnPrimary))
+		text.Alignment = fyne.TextAlignCenter
+		text.TextStyle.Bold = true
+		title := widget.NewLabelWithStyle(step.Title, fyne.TextAlignLeading, fyne.TextStyle{Bold: i == w.current})
+		if i > w.current {
+			title.Importance = widget.LowImportance
+		}
+		if number > 1 {
+			line := canvas.NewRectangle(theme.Color(theme.ColorNameSeparator))
+			line.SetMinSize(fyne.NewSize(theme.Padding()*4, theme.SeparatorThicknessSize()))
+			r.progress.Add(container.NewCenter(line))
+		}
+		r.progress.Add(container.NewGridWrap(fyne.NewSquareSize(wizardNumberSize), container.NewStack(circle, text)))
+		r.progress.Add(title)
+	}
+}
@@ -0,0 +1,87 @@
+package container
+
+import (
+	"errors"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWizard_Navigation(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	name := widget.NewEntry()
+	welcome := NewWizardStep("Welcome", widget.NewLabel("Hello"))
+	details := NewWizardStep("Details", name)
+	details.Validate = func() error {
+		if name.Text == "" {
+			return errors.New("a name is needed")
+		}
+		return nil
+	}
+	done := NewWizardStep("Done", widget.NewLabel("Ready"))
+
+	finished := false
+	steps := []int{}
+	wiz := NewWizard(welcome, details, done)
+	wiz.OnStepChanged = func(step int) { steps = append(steps, step) }
+	wiz.OnFinished = func() { finished = true }
+	w := test.NewWindow(wiz)
+	defer w.Close()
+
+	r := test.WidgetRenderer(wiz).(*wizardRenderer)
+	assert.True(t, r.back.Disabled())
+	assert.Equal(t, "Next", r.next.Text)
+
+	test.Tap(r.next)
+	assert.Equal(t, 1, wiz.Step())
+	test.Tap(r.next)
+	assert.Equal(t, 1, wiz.Step(), "an invalid step should not be left")
+	assert.False(t, r.error.Hidden)
+	assert.Equal(t, "a name is needed", r.error.Text)
+
+	name.SetText("Ada")
+	test.Tap(r.next)
+	assert.Equal(t, 2, wiz.Step())
+	assert.True(t, r.error.Hidden)
+	assert.Equal(t, "Finish", r.next.Text)
+
+	test.Tap(r.back)
+	assert.Equal(t, 1, wiz.Step())
+	wiz.SetStep(2)
+	test.Tap(r.next)
+	assert.True(t, finished)
+	assert.Equal(t, []int{1, 2, 1, 2}, steps)
+}
+
+func TestWizard_Skip(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	custom := widget.NewCheck("Custom install", nil)
+	options := NewWizardStep("Options", widget.NewLabel("Options"))
+	options.Skip = func() bool { return !custom.Checked }
+	wiz := NewWizard(NewWizardStep("Type", custom), options, NewWizardStep("Install", widget.NewLabel("Install")))
+	wiz.Progress = WizardProgressDots
+	w := test.NewWindow(wiz)
+	defer w.Close()
+
+	r := test.WidgetRenderer(wiz).(*wizardRenderer)
+	assert.Len(t, r.progress.Objects, 2, "a skipped step should have no dot")
+	wiz.Next()
+	assert.Equal(t, 2, wiz.Step())
+	wiz.Back()
+	assert.Equal(t, 0, wiz.Step())
+
+	custom.SetChecked(true)
+	wiz.Next()
+	assert.Equal(t, 1, wiz.Step())
+	assert.Len(t, r.progress.Objects, 3)
+
+	wiz.Progress = WizardProgressNumbers
+	wiz.Refresh()
+	assert.Len(t, r.progress.Objects, 3*2+2, "numbers and titles with lines between them")
+}
@@ -0,0 +1,235 @@
+package container
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Zoom)(nil)
+var _ fyne.Draggable = (*zoomGestures)(nil)
+var _ fyne.DoubleTappable = (*zoomGestures)(nil)
+var _ fyne.Scrollable = (*zoomGestures)(nil)
+var _ fyne.Layout = (*zoomLayout)(nil)
+
+// zoomWheelStep is the zoom factor of one scroll wheel notch.
+const zoomWheelStep = 1.1
+
+// Zoom is a container that lets the user zoom and pan its content, such as an image or a plan.
+// The scroll wheel zooms around the pointer, dragging pans and double tapping zooms in.
+// At a zoom of 1 the content is shown at its minimum size.
+type Zoom struct {
+	widget.BaseWidget
+
+	Content          fyne.CanvasObject
+	MinZoom, MaxZoom float32
+
+	// OnTransformChanged is called when the zoom or the position of the content changes.
+	OnTransformChanged func(zoom float32, offset fyne.Position) `json:"-"`
+
+	zoom   float32
+	offset fyne.Position // the position of the content in the container
+}
+
+// NewZoom creates a new container showing content at a zoom of 1, which can be zoomed from 0.1 to 10.
+func NewZoom(content fyne.CanvasObject) *Zoom {
+	z := &Zoom{Content: content, MinZoom: 0.1, MaxZoom: 10, zoom: 1}
+	z.ExtendBaseWidget(z)
+	return z
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (z *Zoom) CreateRenderer() fyne.WidgetRenderer {
+	z.ExtendBaseWidget(z)
+	// a scroll container that can't scroll clips the content to the container
+	inner := container.New(&zoomLayout{zoom: z})
+	if z.Content != nil {
+		inner.Add(z.Content)
+	}
+	clip := container.NewScroll(inner)
+	clip.Direction = container.ScrollNone
+	gestures := &zoomGestures{zoom: z}
+	gestures.ExtendBaseWidget(gestures)
+	return &zoomRenderer{zoom: z, inner: inner, clip: clip, gestures: gestures}
+}
+
+// FitToView zooms so the whole content fits in the container, centred.
+func (z *Zoom) FitToView() {
+	base, size := z.baseSize(), z.Size()
+	if base.IsZero() || size.IsZero() {
+		return
+	}
+	// clamping centres content smaller than the container
+	z.setTransform(z.clampZoom(fyne.Min(size.Width/base.Width, size.Height/base.Height)), fyne.NewPos(0, 0))
+}
+
+// Offset returns the position of the top left corner of the content within the container.
+func (z *Zoom) Offset() fyne.Position {
+	return z.offset
+}
+
+// Pan moves the content by the given distance.
+func (z *Zoom) Pan(dx, dy float32) {
+	z.setTransform(z.zoom, z.offset.AddXY(dx, dy))
+}
+
+// Reset returns to a zoom of 1 with the top left of the content in view.
+func (z *Zoom) Reset() {
+	z.setTransform(z.clampZoom(1), fyne.NewPos(0, 0))
+}
+
+// Resize sets a new size for the container, keeping the content in view.
+func (z *Zoom) Resize(size fyne.Size) {
+	z.BaseWidget.Resize(size)
+	z.setTransform(z.zoom, z.offset)
+}
+
+// SetZoom zooms around the centre of the container.
+func (z *Zoom) SetZoom(zoom float32) {
+	size := z.Size()
+	z.ZoomAt(zoom, fyne.NewPos(size.Width/2, size.Height/2))
+}
+
+// Zoom returns the current zoom level.
+func (z *Zoom) Zoom() float32 {
+	return z.zoom
+}
+
+// ZoomAt zooms keeping the point of the content at pos, in container coordinates, in place.
+func (z *Zoom) ZoomAt(zoom float32, pos fyne.Position) {
+	zoom = z.clampZoom(zoom)
+	scale := zoom / z.zoom
+	z.setTransform(zoom, fyne.NewPos(pos.X-(pos.X-z.offset.X)*scale, pos.Y-(pos.Y-z.offset.Y)*scale))
+}
+
+func (z *Zoom) baseSize() fyne.Size {
+	if z.Content == nil {
+		return fyne.NewSize(0, 0)
+	}
+	return z.Content.MinSize()
+}
+
+func (z *Zoom) clampZoom(zoom float32) float32 {
+	if z.MinZoom > 0 && zoom < z.MinZoom {
+		return z.MinZoom
+	}
+	if z.MaxZoom > 0 && zoom > z.MaxZoom {
+		return z.MaxZoom
+	}
+	return zoom
+}
+
+// setTransform zooms and moves the content, keeping it covering the container or centred where it is smaller.
+func (z *Zoom) setTransform(zoom float32, offset fyne.Position) {
+	base, size := z.baseSize(), z.Size()
+	content := fyne.NewSize(base.Width*zoom, base.Height*zoom)
+	offset.X = clampOffset(offset.X, content.Width, size.Width)
+	offset.Y = clampOffset(offset.Y, content.Height, size.Height)
+
+	changed := zoom != z.zoom || offset != z.offset
+	z.zoom, z.offset = zoom, offset
+	z.Refresh()
+	if f := z.OnTransformChanged; changed && f != nil {
+		f(zoom, offset)
+	}
+}
+
+func clampOffset(offset, content, view float32) float32 {
+	if content <= view {
+		return (view - content) / 2
+	}
+	return fyne.Max(view-content, fyne.Min(0, offset))
+}
+
+type zoomRenderer struct {
+	zoom     *Zoom
+	inner    *fyne.Container
+	clip     *container.Scroll
+	gestures *zoomGestures
+}
+
+func (r *zoomRenderer) Destroy() {
+}
+
+func (r *zoomRenderer) Layout(size fyne.Size) {
+	r.clip.Resize(size)
+	r.gestures.Resize(size)
+	r.inner.Layout.Layout(r.inner.Objects, size)
+}
+
+func (r *zoomRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+func (r *zoomRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.clip, r.gestures}
+}
+
+func (r *zoomRenderer) Refresh() {
+	if len(r.inner.Objects) == 0 || r.inner.Objects[0] != r.zoom.Content {
+		r.inner.Objects = nil
+		if r.zoom.Content != nil {
+			r.inner.Objects = []fyne.CanvasObject{r.zoom.Content}
+		}
+	}
+	// moving and resizing the content refreshes it
+	r.Layout(r.zoom.Size())
+}
+
+// zoomGestures covers the content to receive the scroll and drag events that zoom and pan it.
+// Taps pass through to the content.
+type zoomGestures struct {
+	widget.BaseWidget
+	zoom *Zoom
+}
+
+func (g *zoomGestures) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewWithoutLayout())
+}
+
+func (g *zoomGestures) DoubleTapped(ev *fyne.PointEvent) {
+	z := g.zoom
+	if z.MaxZoom > 0 && z.zoom >= z.MaxZoom {
+		z.FitToView()
+		return
+	}
+	z.ZoomAt(z.zoom*2, ev.Position)
+}
+
+func (g *zoomGestures) Dragged(ev *fyne.DragEvent) {
+	g.zoom.Pan(ev.Dragged.DX, ev.Dragged.DY)
+}
+
+func (g *zoomGestures) DragEnd() {
+}
+
+func (g *zoomGestures) Scrolled(ev *fyne.ScrollEvent) {
+	z := g.zoom
+	if ev.Scrolled.DY == 0 {
+		return
+	}
+	// DY is in pixels, where a notch of the wheel is usually 10
+	factor := float32(math.Pow(zoomWheelStep, float64(ev.Scrolled.DY)/10))
+	z.ZoomAt(z.zoom*factor, ev.Position)
+}
+
+// zoomLayout sizes and places the content for the zoom and offset of a Zoom.
+type zoomLayout struct {
+	zoom *Zoom
+}
+
+func (l *zoomLayout) Layout(objects []fyne.CanvasObject, _ fyne.Size) {
+	base := l.zoom.baseSize()
+	for _, o := range objects {
+		o.Resize(fyne.NewSize(base.Width*l.zoom.zoom, base.Height*l.zoom.zoom))
+		o.Move(l.zoom.offset)
+	}
+}
+
+func (l *zoomLayout) MinSize([]fyne.CanvasObject) fyne.Size {
+	return fyne.NewSize(0, 0)
+}
@@ -0,0 +1,102 @@
+package container
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestZoom() (*Zoom, *canvas.Rectangle) {
+	content := canvas.NewRectangle(theme.Color(theme.ColorNamePrimary))
+	content.SetMinSize(fyne.NewSize(400, 200))
+	return NewZoom(content), content
+}
+
+func TestZoom_ZoomAt(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	z, content := newTestZoom()
+	calls := 0
+	z.OnTransformChanged = func(float32, fyne.Position) {
+		calls++
+	}
+	w := test.NewWindow(z)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(200, 100))
+	calls = 0
+
+	z.ZoomAt(2, fyne.NewPos(100, 50))
+	assert.Equal(t, float32(2), z.Zoom())
+	assert.Equal(t, fyne.NewSize(800, 400), content.Size())
+	// the point under the pointer stays in place
+	assert.Equal(t, fyne.NewPos(-100, -50), z.Offset())
+	assert.Equal(t, fyne.NewPos(-100, -50), content.Position())
+	assert.Equal(t, 1, calls)
+
+	z.ZoomAt(100, fyne.NewPos(0, 0))
+	assert.Equal(t, z.MaxZoom, z.Zoom())
+	z.ZoomAt(0.01, fyne.NewPos(0, 0))
+	assert.Equal(t, z.MinZoom, z.Zoom())
+}
+
+func TestZoom_Pan(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	z, _ := newTestZoom()
+	w := test.NewWindow(z)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(200, 100))
+
+	z.Pan(-50, -20)
+	assert.Equal(t, fyne.NewPos(-50, -20), z.Offset())
+
+	// the content can't be dragged out of view
+	test.Drag(w.Canvas(), fyne.NewPos(10, 10), 500, 500)
+	assert.Equal(t, fyne.NewPos(0, 0), z.Offset())
+	z.Pan(-1000, -1000)
+	assert.Equal(t, fyne.NewPos(-200, -100), z.Offset())
+}
+
+func TestZoom_FitToView(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	z, content := newTestZoom()
+	w := test.NewWindow(z)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(200, 200))
+
+	z.FitToView()
+	assert.Equal(t, float32(0.5), z.Zoom())
+	assert.Equal(t, fyne.NewSize(200, 100), content.Size())
+	assert.Equal(t, fyne.NewPos(0, 50), z.Offset())
+
+	z.Reset()
+	assert.Equal(t, float32(1), z.Zoom())
+	assert.Equal(t, fyne.NewPos(0, 0), z.Offset())
+}
+
+func TestZoom_Scrolled(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	z, _ := newTestZoom()
+	w := test.NewWindow(z)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(200, 100))
+
+	test.Scroll(w.Canvas(), fyne.NewPos(50, 50), 0, 10)
+	assert.InDelta(t, 1.1, z.Zoom(), 0.001)
+	test.Scroll(w.Canvas(), fyne.NewPos(50, 50), 0, -10)
+	assert.InDelta(t, 1, z.Zoom(), 0.001)
+}
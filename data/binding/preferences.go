@@ -0,0 +1,220 @@
+package binding
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// PreferencesStruct keeps the fields of a Go struct in sync with app preferences.
+// Its listeners are called when any field changes.
+type PreferencesStruct interface {
+	binding.DataItem
+
+	// GetItem returns the two-way binding of a field, by name, to its preference.
+	// It is a binding.String, binding.Bool, binding.Int or binding.Float depending on the field type.
+	GetItem(field string) (binding.DataItem, error)
+	// Save writes the current values of the struct fields to the preferences.
+	Save() error
+}
+
+type preferenceField struct {
+	index int
+	key   string
+	item  binding.DataItem
+}
+
+type preferencesStruct struct {
+	self   binding.Untyped
+	lock   sync.Mutex
+	value  reflect.Value
+	fields map[string]*preferenceField
+}
+
+var (
+	errNotStructPointer = errors.New("a pointer to a struct is required")
+	errNoSuchField      = errors.New("no such field bound to a preference")
+)
+
+// BindPreferencesStruct returns a binding that loads the exported fields of the struct pointed to by ptr
+// from preferences and updates them whenever a preference changes.
+// Each field is stored under its name, or the key in a `preference:"key"` tag, and a tag of
+// `preference:"-"` leaves a field out. Preferences that are not set yet are given the value in a
+// `default:"value"` tag, if there is one, or the current value of the field.
+// Fields may be strings, bools, ints or floats.
+func BindPreferencesStruct(ptr interface{}, p fyne.Preferences) (PreferencesStruct, error) {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil, errNotStructPointer
+	}
+	ret := &preferencesStruct{self: binding.NewUntyped(), value: v.Elem(), fields: make(map[string]*preferenceField)}
+
+	t := v.Elem().Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		key := f.Tag.Get("preference")
+		if !f.IsExported() || key == "-" {
+			continue
+		}
+		if key == "" {
+			key = f.Name
+		}
+		field := &preferenceField{index: i, key: key}
+		if err := ret.bindField(field, f, p); err != nil {
+			return nil, err
+		}
+		ret.fields[f.Name] = field
+	}
+
+	for _, field := range ret.fields {
+		field.item.AddListener(binding.NewDataListener(ret.changed(field)))
+	}
+	return ret, nil
+}
+
+func (s *preferencesStruct) AddListener(l binding.DataListener) {
+	s.self.AddListener(l)
+}
+
+func (s *preferencesStruct) GetItem(field string) (binding.DataItem, error) {
+	f, ok := s.fields[field]
+	if !ok {
+		return nil, errNoSuchField
+	}
+	return f.item, nil
+}
+
+func (s *preferencesStruct) RemoveListener(l binding.DataListener) {
+	s.self.RemoveListener(l)
+}
+
+func (s *preferencesStruct) Save() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, f := range s.fields {
+		fv := s.value.Field(f.index)
+		var err error
+		switch item := f.item.(type) {
+		case binding.String:
+			err = item.Set(fv.String())
+		case binding.Bool:
+			err = item.Set(fv.Bool())
+		case binding.Int:
+			err = item.Set(int(fv.Int()))
+		case binding.Float:
+			err = item.Set(fv.Float())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindField creates the preference binding of a field, setting its default if the preference is not set,
+// and loads the field from the preference.
+func (s *preferencesStruct) bindField(field *preferenceField, f reflect.StructField, p fyne.Preferences) error {
+	fv := s.value.Field(field.index)
+	def, hasDefault := f.Tag.Lookup("default")
+	key := field.key
+
+	switch f.Type.Kind() {
+	case reflect.String:
+		if hasDefault {
+			fv.SetString(def)
+		}
+		if p.StringWithFallback(key, "a") == "a" && p.StringWithFallback(key, "b") == "b" {
+			p.SetString(key, fv.String())
+		}
+		field.item = binding.BindPreferenceString(key, p)
+		fv.SetString(p.String(key))
+	case reflect.Bool:
+		if hasDefault {
+			b, err := strconv.ParseBool(def)
+			if err != nil {
+				return fmt.Errorf("invalid default for field %s: %w", f.Name, err)
+			}
+			fv.SetBool(b)
+		}
+		if p.BoolWithFallback(key, true) && !p.BoolWithFallback(key, false) {
+			p.SetBool(key, fv.Bool())
+		}
+		field.item = binding.BindPreferenceBool(key, p)
+		fv.SetBool(p.Bool(key))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if hasDefault {
+			i, err := strconv.ParseInt(def, 10, f.Type.Bits())
+			if err != nil {
+				return fmt.Errorf("invalid default for field %s: %w", f.Name, err)
+			}
+			fv.SetInt(i)
+		}
+		if p.IntWithFallback(key, 1) == 1 && p.IntWithFallback(key, 2) == 2 {
+			p.SetInt(key, int(fv.Int()))
+		}
+		field.item = binding.BindPreferenceInt(key, p)
+		fv.SetInt(int64(p.Int(key)))
+	case reflect.Float32, reflect.Float64:
+		if hasDefault {
+			fl, err := strconv.ParseFloat(def, f.Type.Bits())
+			if err != nil {
+				return fmt.Errorf("invalid default for field %s: %w", f.Name, err)
+			}
+			fv.SetFloat(fl)
+		}
+		if p.FloatWithFallback(key, 1) == 1 && p.FloatWithFallback(key, 2) == 2 {
+			p.SetFloat(key, fv.Float())
+		}
+		field.item = binding.BindPreferenceFloat(key, p)
+		fv.SetFloat(p.Float(key))
+	default:
+		return fmt.Errorf("field %s has unsupported type %s", f.Name, f.Type)
+	}
+	return nil
+}
+
+// changed returns a listener that copies the value of a preference into its field.
+func (s *preferencesStruct) changed(field *preferenceField) func() {
+	return func() {
+		s.lock.Lock()
+		fv := s.value.Field(field.index)
+		var err error
+		switch item := field.item.(type) {
+		case binding.String:
+			var v string
+			if v, err = item.Get(); err == nil {
+				fv.SetString(v)
+			}
+		case binding.Bool:
+			var v bool
+			if v, err = item.Get(); err == nil {
+				fv.SetBool(v)
+			}
+		case binding.Int:
+			var v int
+			if v, err = item.Get(); err == nil {
+				fv.SetInt(int64(v))
+			}
+		case binding.Float:
+			var v float64
+			if v, err = item.Get(); err == nil {
+				fv.SetFloat(v)
+			}
+		}
+		// a copy of the struct, so that listeners are notified even though the pointer is unchanged
+		snapshot := reflect.New(s.value.Type())
+		snapshot.Elem().Set(s.value)
+		s.lock.Unlock()
+
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		_ = s.self.Set(snapshot.Interface())
+	}
+}
@@ -0,0 +1,104 @@
+package binding_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	xbinding "fyne.io/x/fyne/data/binding"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSettings struct {
+	Name     string  `preference:"settings.name" default:"Guest"`
+	Dark     bool    `default:"true"`
+	Volume   int     `preference:"settings.volume"`
+	Scale    float64 `default:"1.5"`
+	Ignored  string  `preference:"-"`
+	internal string
+}
+
+func TestBindPreferencesStruct(t *testing.T) {
+	a := test.NewApp()
+	defer test.NewApp()
+	p := a.Preferences()
+	p.SetInt("settings.volume", 7)
+
+	s := &testSettings{Volume: 3}
+	data, err := xbinding.BindPreferencesStruct(s, p)
+	assert.NoError(t, err)
+	waitForBinding()
+	assert.Equal(t, "Guest", s.Name)
+	assert.True(t, s.Dark)
+	assert.Equal(t, 7, s.Volume)
+	assert.Equal(t, 1.5, s.Scale)
+	assert.Equal(t, "Guest", p.String("settings.name"))
+	assert.True(t, p.Bool("Dark"))
+	assert.Equal(t, "", p.String("Ignored"))
+
+	propagated := NewListener(data)
+	item, err := data.GetItem("Name")
+	assert.NoError(t, err)
+	item.(binding.String).Set("Ada")
+	waitOnChan(t, propagated)
+	assert.Equal(t, "Ada", p.String("settings.name"))
+	waitForBinding()
+	assert.Equal(t, "Ada", s.Name)
+
+	scale, err := data.GetItem("Scale")
+	assert.NoError(t, err)
+	scale.(binding.Float).Set(2)
+	waitForBinding()
+	assert.Equal(t, 2.0, p.Float("Scale"))
+	assert.Equal(t, 2.0, s.Scale)
+
+	_, err = data.GetItem("Ignored")
+	assert.Error(t, err)
+}
+
+func TestBindPreferencesStruct_Save(t *testing.T) {
+	a := test.NewApp()
+	defer test.NewApp()
+	p := a.Preferences()
+
+	s := &testSettings{}
+	data, err := xbinding.BindPreferencesStruct(s, p)
+	assert.NoError(t, err)
+	waitForBinding()
+
+	s.Dark = false
+	s.Volume = 11
+	assert.NoError(t, data.Save())
+	assert.False(t, p.Bool("Dark"))
+	assert.Equal(t, 11, p.Int("settings.volume"))
+	waitForBinding()
+	assert.Equal(t, 11, s.Volume)
+}
+
+// waitForBinding returns once the listeners that are already queued have been called, which is when
+// the fields of a bound struct have been updated.
+func waitForBinding() {
+	done := make(chan struct{})
+	binding.NewBool().AddListener(binding.NewDataListener(func() {
+		close(done)
+	}))
+	<-done
+}
+
+func TestBindPreferencesStruct_Errors(t *testing.T) {
+	a := test.NewApp()
+	defer test.NewApp()
+	p := a.Preferences()
+
+	_, err := xbinding.BindPreferencesStruct(testSettings{}, p)
+	assert.Error(t, err)
+
+	_, err = xbinding.BindPreferencesStruct(&struct{ List []string }{}, p)
+	assert.Error(t, err)
+
+	_, err = xbinding.BindPreferencesStruct(&struct {
+		Count int `default:"many"`
+	}{}, p)
+	assert.Error(t, err)
+}
@@ -0,0 +1,176 @@
+package binding
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// RESTData is a binding to the JSON response of an HTTP endpoint, fetched again at an interval.
+// You should call `Close()` on the binding once you are done to stop polling.
+type RESTData interface {
+	binding.DataItem
+	io.Closer
+
+	// Body returns a binding of the latest response, which can be passed to NewJSONFromString.
+	Body() binding.String
+	// Err returns a binding of the error message of the latest request, empty while requests succeed.
+	Err() binding.String
+	// Get returns the latest decoded response, which stays in place while requests fail.
+	Get() (interface{}, error)
+	// Refresh requests the endpoint again straight away.
+	Refresh()
+}
+
+// RESTOptions configures how a RESTData binding requests its endpoint.
+type RESTOptions struct {
+	// Client makes the requests, http.DefaultClient if not set.
+	Client *http.Client
+	// Header is added to each request, such as for authorization.
+	Header http.Header
+	// Interval is the time between requests, or 0 to request only once and on Refresh.
+	Interval time.Duration
+}
+
+type restData struct {
+	value binding.Untyped
+	body  binding.String
+	err   binding.String
+
+	url     string
+	options RESTOptions
+	typ     reflect.Type
+	etag    string
+
+	refresh   chan struct{}
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// BindREST returns a binding to the JSON response of the endpoint at url, decoded into a new value
+// of the type that v points to each time it changes. Get returns v until the first response arrives.
+// Responses are cached by their ETag, so an unchanged response does not notify listeners.
+func BindREST(url string, v interface{}, options *RESTOptions) (RESTData, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr {
+		return nil, errWrongType
+	}
+	if _, err := http.NewRequest(http.MethodGet, url, nil); err != nil {
+		return nil, err
+	}
+
+	ret := &restData{value: binding.NewUntyped(), body: binding.NewString(), err: binding.NewString(),
+		url: url, typ: rv.Type().Elem(), refresh: make(chan struct{}, 1), stop: make(chan struct{})}
+	if options != nil {
+		ret.options = *options
+	}
+	if ret.options.Client == nil {
+		ret.options.Client = http.DefaultClient
+	}
+	_ = ret.value.Set(v)
+	go ret.poll()
+	return ret, nil
+}
+
+func (r *restData) AddListener(l binding.DataListener) {
+	r.value.AddListener(l)
+}
+
+func (r *restData) Body() binding.String {
+	return r.body
+}
+
+func (r *restData) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.stop)
+	})
+	return nil
+}
+
+func (r *restData) Err() binding.String {
+	return r.err
+}
+
+func (r *restData) Get() (interface{}, error) {
+	return r.value.Get()
+}
+
+func (r *restData) Refresh() {
+	select {
+	case r.refresh <- struct{}{}:
+	default: // a refresh is already waiting
+	}
+}
+
+func (r *restData) RemoveListener(l binding.DataListener) {
+	r.value.RemoveListener(l)
+}
+
+// fetch requests the endpoint, updating the bindings with the response or the error.
+func (r *restData) fetch() error {
+	req, err := http.NewRequest(http.MethodGet, r.url, nil)
+	if err != nil {
+		return err
+	}
+	for key, values := range r.options.Header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	if r.etag != "" {
+		req.Header.Set("If-None-Match", r.etag)
+	}
+
+	resp, err := r.options.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified {
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	v := reflect.New(r.typ)
+	if err := json.Unmarshal(data, v.Interface()); err != nil {
+		return err
+	}
+	r.etag = resp.Header.Get("ETag")
+	_ = r.body.Set(string(data))
+	return r.value.Set(v.Interface())
+}
+
+func (r *restData) poll() {
+	var tick <-chan time.Time
+	if r.options.Interval > 0 {
+		ticker := time.NewTicker(r.options.Interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		message := ""
+		if err := r.fetch(); err != nil {
+			message = err.Error()
+		}
+		_ = r.err.Set(message)
+
+		select {
+		case <-r.stop:
+			return
+		case <-tick:
+		case <-r.refresh:
+		}
+	}
+}
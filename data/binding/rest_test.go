@@ -0,0 +1,136 @@
+package binding
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+	"github.com/stretchr/testify/assert"
+)
+
+type restTestStatus struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type restTestServer struct {
+	lock     sync.Mutex
+	body     string
+	status   int
+	requests int
+	matched  int
+}
+
+func (s *restTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.requests++
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	etag := `"` + s.body + `"`
+	if r.Header.Get("If-None-Match") == etag {
+		s.matched++
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("ETag", etag)
+	_, _ = w.Write([]byte(s.body))
+}
+
+func (s *restTestServer) set(body string, status int) {
+	s.lock.Lock()
+	s.body, s.status = body, status
+	s.lock.Unlock()
+}
+
+func TestBindREST(t *testing.T) {
+	handler := &restTestServer{body: `{"name":"build","count":1}`}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	initial := &restTestStatus{Name: "loading"}
+	r, err := BindREST(server.URL, initial, nil)
+	assert.NoError(t, err)
+	defer r.Close()
+
+	changes := 0
+	var lock sync.Mutex
+	r.AddListener(binding.NewDataListener(func() {
+		lock.Lock()
+		changes++
+		lock.Unlock()
+	}))
+	assert.Eventually(t, func() bool {
+		v, _ := r.Get()
+		return v.(*restTestStatus).Count == 1
+	}, time.Second, 10*time.Millisecond)
+	v, _ := r.Get()
+	assert.Equal(t, "build", v.(*restTestStatus).Name)
+	assert.Equal(t, "loading", initial.Name)
+	body, _ := r.Body().Get()
+	assert.Equal(t, `{"name":"build","count":1}`, body)
+
+	// an unchanged response is not decoded again
+	r.Refresh()
+	assert.Eventually(t, func() bool {
+		handler.lock.Lock()
+		defer handler.lock.Unlock()
+		return handler.matched == 1
+	}, time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	lock.Lock()
+	assert.Equal(t, 2, changes) // when added and the first response
+	lock.Unlock()
+
+	handler.set(`{"name":"build","count":2}`, 0)
+	r.Refresh()
+	assert.Eventually(t, func() bool {
+		v, _ := r.Get()
+		return v.(*restTestStatus).Count == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestBindREST_Errors(t *testing.T) {
+	handler := &restTestServer{status: http.StatusInternalServerError}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	_, err := BindREST(server.URL, restTestStatus{}, nil)
+	assert.Error(t, err)
+
+	r, err := BindREST(server.URL, &restTestStatus{}, &RESTOptions{Interval: 20 * time.Millisecond})
+	assert.NoError(t, err)
+	defer r.Close()
+	assert.Eventually(t, func() bool {
+		message, _ := r.Err().Get()
+		return message == "unexpected response: 500 Internal Server Error"
+	}, time.Second, 10*time.Millisecond)
+
+	handler.set(`{"count":`, 0)
+	assert.Eventually(t, func() bool {
+		message, _ := r.Err().Get()
+		return message == "unexpected end of JSON input"
+	}, time.Second, 10*time.Millisecond)
+
+	// polling recovers once the endpoint does
+	handler.set(`{"count":3}`, 0)
+	assert.Eventually(t, func() bool {
+		message, _ := r.Err().Get()
+		v, _ := r.Get()
+		return message == "" && v.(*restTestStatus).Count == 3
+	}, time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, r.Close())
+	handler.lock.Lock()
+	requests := handler.requests
+	handler.lock.Unlock()
+	time.Sleep(100 * time.Millisecond)
+	handler.lock.Lock()
+	assert.LessOrEqual(t, handler.requests, requests+1)
+	handler.lock.Unlock()
+}
@@ -0,0 +1,294 @@
+package binding
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// SQLList is a list of the rows of a database query, loaded a page at a time as they are needed.
+// Each item is a binding.Untyped holding the row as a []interface{}, with a value for each column.
+type SQLList interface {
+	binding.DataList
+
+	// Columns returns the names of the columns of the query.
+	Columns() ([]string, error)
+	// GetRow returns the values of the row at index, loading its page if needed.
+	GetRow(index int) ([]interface{}, error)
+	// Refresh counts the rows again and forgets the loaded pages, so they are read again as needed.
+	Refresh() error
+	// SetRow saves new values for the row at index with the Write hook of the query.
+	SetRow(index int, row []interface{}) error
+}
+
+// SQLQuery describes the rows of an SQLList and how to write changes back.
+type SQLQuery struct {
+	// Count returns the number of rows, such as "SELECT COUNT(*) FROM people".
+	Count string
+	// Select returns a page of rows. It must end with placeholders for the limit and offset,
+	// which are passed after Args, such as "SELECT id, name FROM people ORDER BY id LIMIT ? OFFSET ?".
+	Select string
+	// Args are the arguments of both queries.
+	Args []interface{}
+
+	// PageSize is the number of rows loaded at a time, 100 if not set.
+	PageSize int
+	// MaxPages is the number of pages kept in memory, 10 if not set.
+	MaxPages int
+
+	// Write saves a row that was changed, such as with an UPDATE of the row's id.
+	// The list is read only if it is nil.
+	Write func(db *sql.DB, old, new []interface{}) error `json:"-"`
+}
+
+type sqlPage struct {
+	rows [][]interface{}
+}
+
+type sqlList struct {
+	version binding.Int // changed to notify listeners
+
+	db    *sql.DB
+	query SQLQuery
+
+	lock    sync.Mutex
+	columns []string
+	length  int
+	pages   map[int]*sqlPage
+	recent  []int // page numbers, least recently used first
+	items   map[int]*sqlRow
+}
+
+type sqlRow struct {
+	version binding.Int
+	list    *sqlList
+	index   int
+}
+
+var (
+	errOutOfBounds = errors.New("index out of bounds")
+	errReadOnly    = errors.New("the list has no Write hook")
+)
+
+// BindSQLQuery returns a list of the rows of a query, suitable for a List or other data widget,
+// that loads only the pages of rows that are shown rather than the whole table.
+func BindSQLQuery(db *sql.DB, query SQLQuery) (SQLList, error) {
+	if query.PageSize <= 0 {
+		query.PageSize = 100
+	}
+	if query.MaxPages <= 0 {
+		query.MaxPages = 10
+	}
+	l := &sqlList{version: binding.NewInt(), db: db, query: query,
+		pages: make(map[int]*sqlPage), items: make(map[int]*sqlRow)}
+	if err := l.Refresh(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *sqlList) AddListener(listener binding.DataListener) {
+	l.version.AddListener(listener)
+}
+
+func (l *sqlList) Columns() ([]string, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.columns == nil && l.length > 0 {
+		if _, err := l.page(0); err != nil {
+			return nil, err
+		}
+	}
+	return l.columns, nil
+}
+
+func (l *sqlList) GetItem(index int) (binding.DataItem, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if index < 0 || index >= l.length {
+		return nil, errOutOfBounds
+	}
+	item, ok := l.items[index]
+	if !ok {
+		item = &sqlRow{version: binding.NewInt(), list: l, index: index}
+		l.items[index] = item
+	}
+	return item, nil
+}
+
+func (l *sqlList) GetRow(index int) ([]interface{}, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if index < 0 || index >= l.length {
+		return nil, errOutOfBounds
+	}
+	p, err := l.page(index / l.query.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	row := p.rows[index%l.query.PageSize]
+	return append([]interface{}(nil), row...), nil
+}
+
+func (l *sqlList) Length() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.length
+}
+
+func (l *sqlList) Refresh() error {
+	var length int
+	if err := l.db.QueryRow(l.query.Count, l.query.Args...).Scan(&length); err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	l.length = length
+	l.pages = make(map[int]*sqlPage)
+	l.recent = nil
+	items := l.items
+	l.items = make(map[int]*sqlRow)
+	l.lock.Unlock()
+
+	l.notify(l.version)
+	for _, item := range items {
+		l.notify(item.version)
+	}
+	return nil
+}
+
+func (l *sqlList) RemoveListener(listener binding.DataListener) {
+	l.version.RemoveListener(listener)
+}
+
+func (l *sqlList) SetRow(index int, row []interface{}) error {
+	if l.query.Write == nil {
+		return errReadOnly
+	}
+	old, err := l.GetRow(index)
+	if err != nil {
+		return err
+	}
+	if err := l.query.Write(l.db, old, row); err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	if p, ok := l.pages[index/l.query.PageSize]; ok {
+		p.rows[index%l.query.PageSize] = append([]interface{}(nil), row...)
+	}
+	item := l.items[index]
+	l.lock.Unlock()
+
+	if item != nil {
+		l.notify(item.version)
+	}
+	return nil
+}
+
+// page returns a page of rows, loading it and forgetting the least recently used page if needed.
+// The lock must be held.
+func (l *sqlList) page(n int) (*sqlPage, error) {
+	if p, ok := l.pages[n]; ok {
+		l.use(n)
+		return p, nil
+	}
+
+	args := append(append([]interface{}(nil), l.query.Args...), l.query.PageSize, n*l.query.PageSize)
+	rows, err := l.db.Query(l.query.Select, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	l.columns = columns
+
+	p := &sqlPage{}
+	for rows.Next() {
+		row := make([]interface{}, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range row {
+			dest[i] = &row[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		p.rows = append(p.rows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// rows may have been deleted since counting, the missing ones are shown empty
+	for len(p.rows) < l.query.PageSize && n*l.query.PageSize+len(p.rows) < l.length {
+		p.rows = append(p.rows, make([]interface{}, len(columns)))
+	}
+
+	if len(l.pages) >= l.query.MaxPages {
+		l.evict(l.recent[0])
+	}
+	l.pages[n] = p
+	l.use(n)
+	return p, nil
+}
+
+// evict forgets a page and its items, the lock must be held.
+func (l *sqlList) evict(n int) {
+	delete(l.pages, n)
+	for i := n * l.query.PageSize; i < (n+1)*l.query.PageSize; i++ {
+		delete(l.items, i)
+	}
+	for i, r := range l.recent {
+		if r == n {
+			l.recent = append(l.recent[:i], l.recent[i+1:]...)
+			break
+		}
+	}
+}
+
+// use marks a page as the most recently used, the lock must be held.
+func (l *sqlList) use(n int) {
+	for i, r := range l.recent {
+		if r == n {
+			l.recent = append(l.recent[:i], l.recent[i+1:]...)
+			break
+		}
+	}
+	l.recent = append(l.recent, n)
+}
+
+func (l *sqlList) notify(version binding.Int) {
+	v, err := version.Get()
+	if err != nil {
+		fyne.LogError("Error getting current data value", err)
+		return
+	}
+	_ = version.Set(v + 1)
+}
+
+func (r *sqlRow) AddListener(listener binding.DataListener) {
+	r.version.AddListener(listener)
+}
+
+// Get returns the values of the row as a []interface{}.
+func (r *sqlRow) Get() (interface{}, error) {
+	return r.list.GetRow(r.index)
+}
+
+func (r *sqlRow) RemoveListener(listener binding.DataListener) {
+	r.version.RemoveListener(listener)
+}
+
+// Set saves new values for the row, which must be a []interface{}.
+func (r *sqlRow) Set(value interface{}) error {
+	row, ok := value.([]interface{})
+	if !ok {
+		return errWrongType
+	}
+	return r.list.SetRow(r.index, row)
+}
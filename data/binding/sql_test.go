@@ -0,0 +1,206 @@
+package binding
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSQL is a database driver serving a table of numbered people, counting the page queries.
+type fakeSQL struct {
+	lock    sync.Mutex
+	names   []string
+	queries int
+}
+
+func (d *fakeSQL) Connect(context.Context) (driver.Conn, error) {
+	return &fakeSQLConn{db: d}, nil
+}
+
+func (d *fakeSQL) Driver() driver.Driver {
+	return d
+}
+
+func (d *fakeSQL) Open(string) (driver.Conn, error) {
+	return &fakeSQLConn{db: d}, nil
+}
+
+type fakeSQLConn struct {
+	db *fakeSQL
+}
+
+func (c *fakeSQLConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeSQLConn) Close() error {
+	return nil
+}
+
+func (c *fakeSQLConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeSQLStmt{db: c.db, query: query}, nil
+}
+
+type fakeSQLStmt struct {
+	db    *fakeSQL
+	query string
+}
+
+func (s *fakeSQLStmt) Close() error {
+	return nil
+}
+
+func (s *fakeSQLStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.lock.Lock()
+	defer s.db.lock.Unlock()
+	// UPDATE people SET name = ? WHERE id = ?
+	s.db.names[args[1].(int64)] = args[0].(string)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeSQLStmt) NumInput() int {
+	return strings.Count(s.query, "?")
+}
+
+func (s *fakeSQLStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.db.lock.Lock()
+	defer s.db.lock.Unlock()
+	if strings.Contains(s.query, "COUNT") {
+		return &fakeSQLRows{columns: []string{"count"}, rows: [][]driver.Value{{int64(len(s.db.names))}}}, nil
+	}
+
+	s.db.queries++
+	limit, offset := int(args[0].(int64)), int(args[1].(int64))
+	rows := &fakeSQLRows{columns: []string{"id", "name"}}
+	for i := offset; i < offset+limit && i < len(s.db.names); i++ {
+		rows.rows = append(rows.rows, []driver.Value{int64(i), s.db.names[i]})
+	}
+	return rows, nil
+}
+
+type fakeSQLRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeSQLRows) Close() error {
+	return nil
+}
+
+func (r *fakeSQLRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeSQLRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func openFakeSQL(rows int) (*sql.DB, *fakeSQL) {
+	d := &fakeSQL{}
+	for i := 0; i < rows; i++ {
+		d.names = append(d.names, fmt.Sprintf("Person %d", i))
+	}
+	return sql.OpenDB(d), d
+}
+
+var testSQLQuery = SQLQuery{
+	Count:    "SELECT COUNT(*) FROM people",
+	Select:   "SELECT id, name FROM people ORDER BY id LIMIT ? OFFSET ?",
+	PageSize: 10,
+	MaxPages: 2,
+}
+
+func TestBindSQLQuery(t *testing.T) {
+	db, d := openFakeSQL(95)
+	defer db.Close()
+
+	l, err := BindSQLQuery(db, testSQLQuery)
+	assert.NoError(t, err)
+	assert.Equal(t, 95, l.Length())
+	assert.Equal(t, 0, d.queries)
+
+	row, err := l.GetRow(12)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(12), "Person 12"}, row)
+	columns, err := l.Columns()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id", "name"}, columns)
+
+	_, _ = l.GetRow(19)
+	_, _ = l.GetRow(94)
+	assert.Equal(t, 2, d.queries)
+
+	// page 1 was forgotten after loading page 0 and 9
+	_, _ = l.GetRow(0)
+	_, _ = l.GetRow(10)
+	assert.Equal(t, 4, d.queries)
+
+	item, err := l.GetItem(50)
+	assert.NoError(t, err)
+	value, err := item.(interface{ Get() (interface{}, error) }).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(50), "Person 50"}, value)
+
+	_, err = l.GetRow(95)
+	assert.Error(t, err)
+	_, err = l.GetItem(-1)
+	assert.Error(t, err)
+}
+
+func TestBindSQLQuery_Refresh(t *testing.T) {
+	db, d := openFakeSQL(5)
+	defer db.Close()
+
+	l, err := BindSQLQuery(db, testSQLQuery)
+	assert.NoError(t, err)
+	row, _ := l.GetRow(2)
+	assert.Equal(t, "Person 2", row[1])
+
+	d.lock.Lock()
+	d.names = append(d.names, "Someone")
+	d.names[2] = "Changed"
+	d.lock.Unlock()
+	row, _ = l.GetRow(2)
+	assert.Equal(t, "Person 2", row[1])
+	assert.Equal(t, 5, l.Length())
+
+	assert.NoError(t, l.Refresh())
+	assert.Equal(t, 6, l.Length())
+	row, _ = l.GetRow(2)
+	assert.Equal(t, "Changed", row[1])
+}
+
+func TestBindSQLQuery_Write(t *testing.T) {
+	db, d := openFakeSQL(5)
+	defer db.Close()
+
+	l, err := BindSQLQuery(db, testSQLQuery)
+	assert.NoError(t, err)
+	assert.Error(t, l.SetRow(1, []interface{}{int64(1), "Nobody"}))
+
+	query := testSQLQuery
+	query.Write = func(db *sql.DB, old, new []interface{}) error {
+		_, err := db.Exec("UPDATE people SET name = ? WHERE id = ?", new[1], old[0])
+		return err
+	}
+	l, err = BindSQLQuery(db, query)
+	assert.NoError(t, err)
+	item, _ := l.GetItem(1)
+	assert.NoError(t, item.(interface{ Set(interface{}) error }).Set([]interface{}{int64(1), "Renamed"}))
+	assert.Equal(t, "Renamed", d.names[1])
+	row, _ := l.GetRow(1)
+	assert.Equal(t, "Renamed", row[1])
+}
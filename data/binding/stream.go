@@ -0,0 +1,269 @@
+package binding
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"github.com/gorilla/websocket"
+)
+
+// Stream binds the messages of a live feed, a WebSocket or Server-Sent Events, reconnecting when the connection drops.
+// You should call `Close()` on the stream once you are done to free the connection.
+type Stream interface {
+	io.Closer
+
+	// Connected returns a binding that is true while the stream is connected.
+	Connected() binding.Bool
+	// Err returns a binding of the error message of the last connection, empty while connected.
+	Err() binding.String
+	// Latest returns a binding of the latest message.
+	Latest() binding.String
+	// Messages returns a binding of the most recent messages, oldest first.
+	Messages() binding.StringList
+}
+
+// StreamOptions configures the connection of a Stream.
+type StreamOptions struct {
+	// Header is added to the request that opens the connection, such as for authorization.
+	Header http.Header
+	// MinBackoff is the wait before reconnecting, 1 second if not set.
+	// It doubles after each failed attempt up to MaxBackoff, 30 seconds if not set.
+	MinBackoff, MaxBackoff time.Duration
+	// MaxMessages is the number of messages kept by Messages, 100 if not set.
+	MaxMessages int
+}
+
+// streamReader reads the next message from a connection, returning an error when it ends.
+type streamReader func() (string, error)
+
+type stream struct {
+	connected binding.Bool
+	err       binding.String
+	latest    binding.String
+	messages  binding.StringList
+
+	options StreamOptions
+	dial    func(ctx context.Context) (streamReader, io.Closer, error)
+
+	lock   sync.Mutex
+	conn   io.Closer
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewWebSocketStream returns a stream of the text messages of the web socket server at url.
+func NewWebSocketStream(url string, options *StreamOptions) Stream {
+	s := newStream(options)
+	s.dial = func(ctx context.Context) (streamReader, io.Closer, error) {
+		conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, s.options.Header)
+		if err != nil {
+			return nil, nil, err
+		}
+		return func() (string, error) {
+			_, p, err := conn.ReadMessage()
+			return string(p), err
+		}, conn, nil
+	}
+	go s.run()
+	return s
+}
+
+// NewSSEStream returns a stream of the data of the Server-Sent Events at url.
+// After reconnecting the server is sent the id of the last event, so it can resend any that were missed.
+func NewSSEStream(url string, options *StreamOptions) Stream {
+	s := newStream(options)
+	lastID := ""
+	s.dial = func(ctx context.Context) (streamReader, io.Closer, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		for key, values := range s.options.Header {
+			req.Header[key] = values
+		}
+		req.Header.Set("Accept", "text/event-stream")
+		if lastID != "" {
+			req.Header.Set("Last-Event-ID", lastID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, nil, fmt.Errorf("unexpected response: %s", resp.Status)
+		}
+
+		events := &sseReader{scanner: bufio.NewScanner(resp.Body)}
+		return func() (string, error) {
+			data, err := events.next()
+			if events.id != "" {
+				lastID = events.id
+			}
+			if events.retry > 0 {
+				s.options.MinBackoff = events.retry
+			}
+			return data, err
+		}, resp.Body, nil
+	}
+	go s.run()
+	return s
+}
+
+func newStream(options *StreamOptions) *stream {
+	s := &stream{connected: binding.NewBool(), err: binding.NewString(), latest: binding.NewString(),
+		messages: binding.NewStringList()}
+	if options != nil {
+		s.options = *options
+	}
+	if s.options.MinBackoff <= 0 {
+		s.options.MinBackoff = time.Second
+	}
+	if s.options.MaxBackoff <= 0 {
+		s.options.MaxBackoff = 30 * time.Second
+	}
+	if s.options.MaxMessages <= 0 {
+		s.options.MaxMessages = 100
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	return s
+}
+
+func (s *stream) Close() error {
+	s.cancel()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	conn := s.conn
+	s.conn = nil
+	return conn.Close()
+}
+
+func (s *stream) Connected() binding.Bool {
+	return s.connected
+}
+
+func (s *stream) Err() binding.String {
+	return s.err
+}
+
+func (s *stream) Latest() binding.String {
+	return s.latest
+}
+
+func (s *stream) Messages() binding.StringList {
+	return s.messages
+}
+
+func (s *stream) add(message string) {
+	_ = s.latest.Set(message)
+	messages, _ := s.messages.Get()
+	messages = append(messages, message)
+	if len(messages) > s.options.MaxMessages {
+		messages = messages[len(messages)-s.options.MaxMessages:]
+	}
+	_ = s.messages.Set(messages)
+}
+
+// run connects and reads messages until the stream is closed, waiting longer between each failed attempt.
+func (s *stream) run() {
+	backoff := s.options.MinBackoff
+	for {
+		read, conn, err := s.dial(s.ctx)
+		if err == nil {
+			s.lock.Lock()
+			if s.ctx.Err() != nil {
+				s.lock.Unlock()
+				conn.Close()
+				return
+			}
+			s.conn = conn
+			s.lock.Unlock()
+
+			_ = s.err.Set("")
+			_ = s.connected.Set(true)
+			err = s.read(read)
+			backoff = s.options.MinBackoff // which an event stream may have changed
+			_ = s.connected.Set(false)
+
+			s.lock.Lock()
+			if s.conn == conn {
+				s.conn = nil
+				conn.Close()
+			}
+			s.lock.Unlock()
+		}
+		if s.ctx.Err() != nil {
+			return
+		}
+		_ = s.err.Set(err.Error())
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > s.options.MaxBackoff {
+			backoff = s.options.MaxBackoff
+		}
+	}
+}
+
+func (s *stream) read(read streamReader) error {
+	for {
+		message, err := read()
+		if err != nil {
+			return err
+		}
+		s.add(message)
+	}
+}
+
+// sseReader parses the events of a Server-Sent Events stream.
+type sseReader struct {
+	scanner *bufio.Scanner
+	id      string
+	retry   time.Duration
+}
+
+// next returns the data of the next event.
+func (r *sseReader) next() (string, error) {
+	var data []string
+	for r.scanner.Scan() {
+		line := r.scanner.Text()
+		if line == "" {
+			if data == nil {
+				continue
+			}
+			return strings.Join(data, "\n"), nil
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "data":
+			data = append(data, value)
+		case "id":
+			r.id = value
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil {
+				r.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+	if err := r.scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", io.EOF
+}
@@ -0,0 +1,107 @@
+package binding
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertMessages(t *testing.T, s Stream, expected ...string) {
+	assert.Eventually(t, func() bool {
+		messages, _ := s.Messages().Get()
+		return fmt.Sprint(messages) == fmt.Sprint(expected)
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestNewSSEStream(t *testing.T) {
+	var lock sync.Mutex
+	var lastIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		lastIDs = append(lastIDs, r.Header.Get("Last-Event-ID"))
+		connection := len(lastIDs)
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		// each connection sends two events then drops
+		fmt.Fprintf(w, ": comment\nretry: 10\n\nid: %d\ndata: first %d\n\n", connection, connection)
+		fmt.Fprintf(w, "data: second\ndata: line\n\n")
+		w.(http.Flusher).Flush()
+	}))
+	defer server.Close()
+
+	s := NewSSEStream(server.URL, &StreamOptions{MaxMessages: 3})
+	defer s.Close()
+
+	assertMessages(t, s, "second\nline", "first 2", "second\nline")
+	lock.Lock()
+	assert.Equal(t, []string{"", "1"}, lastIDs[:2])
+	lock.Unlock()
+	latest, _ := s.Latest().Get()
+	assert.Equal(t, "second\nline", latest)
+}
+
+func TestNewSSEStream_Error(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	s := NewSSEStream(server.URL, nil)
+	assert.Eventually(t, func() bool {
+		message, _ := s.Err().Get()
+		return message == "unexpected response: 404 Not Found"
+	}, time.Second, 10*time.Millisecond)
+	connected, _ := s.Connected().Get()
+	assert.False(t, connected)
+	assert.NoError(t, s.Close())
+}
+
+func TestNewWebSocketStream(t *testing.T) {
+	var upgrader websocket.Upgrader
+	send := make(chan string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for message := range send {
+			if message == "drop" {
+				return
+			}
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(message))
+		}
+	}))
+	defer server.Close()
+	defer close(send)
+
+	s := NewWebSocketStream("ws"+strings.TrimPrefix(server.URL, "http"),
+		&StreamOptions{MinBackoff: 10 * time.Millisecond})
+	defer s.Close()
+
+	send <- "1.5"
+	send <- "1.7"
+	assertMessages(t, s, "1.5", "1.7")
+	connected, _ := s.Connected().Get()
+	assert.True(t, connected)
+
+	send <- "drop"
+	assert.Eventually(t, func() bool {
+		message, _ := s.Err().Get()
+		return message != ""
+	}, time.Second, 10*time.Millisecond)
+	send <- "1.6" // after reconnecting
+	assertMessages(t, s, "1.5", "1.7", "1.6")
+
+	assert.NoError(t, s.Close())
+	assert.Eventually(t, func() bool {
+		connected, _ := s.Connected().Get()
+		return !connected
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,80 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"fyne.io/fyne/v2"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// NewRequired returns a new validator that fails for text that is empty or only white space.
+// Rules can be combined with validation.NewAllStrings from Fyne.
+func NewRequired() fyne.StringValidator {
+	return func(text string) error {
+		if strings.TrimSpace(text) == "" {
+			return errors.New(i18n.L("required"))
+		}
+		return nil
+	}
+}
+
+// NewLength returns a new validator for text with between min and max characters.
+// A max of 0 or less allows text of any length from min.
+func NewLength(min, max int) fyne.StringValidator {
+	return func(text string) error {
+		n := utf8.RuneCountInString(text)
+		if n < min {
+			return fmt.Errorf(i18n.L("must be at least %d characters"), min)
+		}
+		if max > 0 && n > max {
+			return fmt.Errorf(i18n.L("must be at most %d characters"), max)
+		}
+		return nil
+	}
+}
+
+// NewRange returns a new validator for text that is a number from min to max, inclusive.
+func NewRange(min, max float64) fyne.StringValidator {
+	return func(text string) error {
+		f, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return errors.New(i18n.L("must be a number"))
+		}
+		if f < min || f > max {
+			return fmt.Errorf(i18n.L("must be from %s to %s"), formatNumber(min), formatNumber(max))
+		}
+		return nil
+	}
+}
+
+// NewEqual returns a new validator for text that matches the current value of another field,
+// such as the confirmation of a password. The other value is read each time the text is validated.
+func NewEqual(other func() string, reason string) fyne.StringValidator {
+	err := errors.New(reason)
+	return func(text string) error {
+		if text != other() {
+			return err
+		}
+		return nil
+	}
+}
+
+// NewCustom returns a new validator that fails with reason when valid returns false for the text.
+func NewCustom(valid func(text string) bool, reason string) fyne.StringValidator {
+	err := errors.New(reason)
+	return func(text string) error {
+		if !valid(text) {
+			return err
+		}
+		return nil
+	}
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,61 @@
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	fynevalidation "fyne.io/fyne/v2/data/validation"
+	"fyne.io/x/fyne/data/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequired(t *testing.T) {
+	required := validation.NewRequired()
+
+	assert.NoError(t, required("fyne"))
+	assert.Error(t, required(""))
+	assert.Error(t, required(" \t"))
+}
+
+func TestLength(t *testing.T) {
+	length := validation.NewLength(2, 4)
+
+	assert.NoError(t, length("ab"))
+	assert.NoError(t, length("äöüß"))
+	assert.EqualError(t, length("a"), "must be at least 2 characters")
+	assert.EqualError(t, length("abcde"), "must be at most 4 characters")
+
+	assert.NoError(t, validation.NewLength(1, 0)(strings.Repeat("a", 1000)))
+}
+
+func TestRange(t *testing.T) {
+	age := validation.NewRange(0, 120.5)
+
+	assert.NoError(t, age("42"))
+	assert.NoError(t, age(" 120.5 "))
+	assert.EqualError(t, age("-1"), "must be from 0 to 120.5")
+	assert.EqualError(t, age("old"), "must be a number")
+}
+
+func TestEqual(t *testing.T) {
+	password := "secret"
+	confirm := validation.NewEqual(func() string { return password }, "passwords do not match")
+
+	assert.NoError(t, confirm("secret"))
+	assert.EqualError(t, confirm("Secret"), "passwords do not match")
+
+	password = "Secret"
+	assert.NoError(t, confirm("Secret"))
+}
+
+func TestCustom_Combined(t *testing.T) {
+	even := validation.NewCustom(func(text string) bool {
+		return len(text)%2 == 0
+	}, "must have an even length")
+	all := fynevalidation.NewAllStrings(validation.NewRequired(), even)
+
+	assert.NoError(t, all("ab"))
+	assert.EqualError(t, all("abc"), "must have an even length")
+	assert.EqualError(t, all(""), "required")
+}
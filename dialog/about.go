@@ -10,13 +10,15 @@ import (
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
 )
 
 // NewAbout creates a parallax about dialog using the app metadata along with the
 // markdown content and links passed into this method.
 // You should call Show on the returned dialog to display it.
 func NewAbout(content string, links []*widget.Hyperlink, a fyne.App, w fyne.Window) dialog.Dialog {
-	d := dialog.NewCustom("About", "OK", aboutContent(content, links, a), w)
+	d := dialog.NewCustom(i18n.L("About"), i18n.L("OK"), aboutContent(content, links, a), w)
 	d.Resize(fyne.NewSize(400, 360))
 
 	return d
@@ -26,7 +28,7 @@ func NewAbout(content string, links []*widget.Hyperlink, a fyne.App, w fyne.Wind
 // markdown content and links passed into this method.
 // You should call Show on the returned window to display it.
 func NewAboutWindow(content string, links []*widget.Hyperlink, a fyne.App) fyne.Window {
-	w := a.NewWindow("About")
+	w := a.NewWindow(i18n.L("About"))
 	w.SetContent(aboutContent(content, links, a))
 	w.Resize(fyne.NewSize(360, 300))
 
@@ -0,0 +1,286 @@
+package dialog
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*tourOverlay)(nil)
+var _ fyne.Tappable = (*tourOverlay)(nil)
+
+const (
+	tourCardWidth     = 300
+	tourPreferencePre = "fyne-x_tour_completed_"
+)
+
+// TourStep is a target object to highlight in a Tour, with a caption explaining it.
+type TourStep struct {
+	Target  fyne.CanvasObject
+	Title   string
+	Caption string
+}
+
+// Tour is a first-run guide that highlights a sequence of objects in a window.
+// Each step dims the window apart from a spotlight on its target and shows its caption
+// with buttons to skip the tour or move to the next step.
+// Finishing or skipping a tour is remembered in the app preferences under its ID.
+type Tour struct {
+	ID    string
+	Steps []*TourStep
+
+	// OnFinished is called when the tour ends, completed is false if it was skipped.
+	OnFinished func(completed bool) `json:"-"`
+
+	window  fyne.Window
+	overlay *tourOverlay
+	step    int
+}
+
+// NewTour creates a new tour of a window, the id is used to remember that it has been seen.
+// You should call Show or ShowOnce on the returned tour to start it.
+func NewTour(id string, steps []*TourStep, w fyne.Window) *Tour {
+	return &Tour{ID: id, Steps: steps, window: w}
+}
+
+// ShowTourOnce starts a tour of a window unless it has been seen before.
+func ShowTourOnce(id string, steps []*TourStep, w fyne.Window) *Tour {
+	t := NewTour(id, steps, w)
+	t.ShowOnce()
+	return t
+}
+
+// Completed returns whether the tour has been finished or skipped before.
+func (t *Tour) Completed() bool {
+	return fyne.CurrentApp().Preferences().Bool(t.preferenceKey())
+}
+
+// Hide ends the tour without marking it as completed.
+func (t *Tour) Hide() {
+	if t.overlay == nil {
+		return
+	}
+	t.window.Canvas().Overlays().Remove(t.overlay)
+	t.overlay = nil
+}
+
+// Next moves to the following step, finishing the tour after the last.
+func (t *Tour) Next() {
+	if t.step+1 >= len(t.Steps) {
+		t.finish(true)
+		return
+	}
+	t.step++
+	t.overlay.Refresh()
+}
+
+// Previous moves back to the preceding step.
+func (t *Tour) Previous() {
+	if t.step == 0 {
+		return
+	}
+	t.step--
+	t.overlay.Refresh()
+}
+
+// Reset forgets that the tour has been seen, so ShowOnce will show it again.
+func (t *Tour) Reset() {
+	fyne.CurrentApp().Preferences().RemoveValue(t.preferenceKey())
+}
+
+// Show starts the tour from the first step.
+func (t *Tour) Show() {
+	if len(t.Steps) == 0 {
+		return
+	}
+	t.step = 0
+	if t.overlay == nil {
+		t.overlay = newTourOverlay(t)
+		t.window.Canvas().Overlays().Add(t.overlay)
+	}
+	t.overlay.Resize(t.window.Canvas().Size())
+	t.overlay.Refresh()
+}
+
+// ShowOnce starts the tour if it has not been completed or skipped before,
+// returning whether it was shown.
+func (t *Tour) ShowOnce() bool {
+	if t.Completed() {
+		return false
+	}
+	t.Show()
+	return true
+}
+
+// Skip ends the tour early, it is remembered as seen.
+func (t *Tour) Skip() {
+	t.finish(false)
+}
+
+// Step returns the index of the current step.
+func (t *Tour) Step() int {
+	return t.step
+}
+
+func (t *Tour) finish(completed bool) {
+	t.Hide()
+	fyne.CurrentApp().Preferences().SetBool(t.preferenceKey(), true)
+	if f := t.OnFinished; f != nil {
+		f(completed)
+	}
+}
+
+func (t *Tour) preferenceKey() string {
+	return tourPreferencePre + t.ID
+}
+
+// tourOverlay covers the window, dimming everything outside the spotlight on the current target.
+// It absorbs taps so the app can't be used until the tour ends.
+type tourOverlay struct {
+	widget.BaseWidget
+	tour *Tour
+}
+
+func newTourOverlay(t *Tour) *tourOverlay {
+	o := &tourOverlay{tour: t}
+	o.ExtendBaseWidget(o)
+	return o
+}
+
+func (o *tourOverlay) CreateRenderer() fyne.WidgetRenderer {
+	r := &tourOverlayRenderer{overlay: o}
+	for i := range r.dim {
+		r.dim[i] = canvas.NewRectangle(color.Transparent)
+	}
+	r.spotlight = canvas.NewRectangle(color.Transparent)
+	r.spotlight.StrokeWidth = 2
+	r.spotlight.CornerRadius = theme.InputRadiusSize()
+
+	r.title = widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+	r.caption = widget.NewLabel("")
+	r.caption.Wrapping = fyne.TextWrapWord
+	r.progress = widget.NewLabel("")
+	r.skip = widget.NewButton(i18n.L("Skip"), o.tour.Skip)
+	r.skip.Importance = widget.LowImportance
+	r.next = widget.NewButton(i18n.L("Next"), o.tour.Next)
+	r.next.Importance = widget.HighImportance
+	r.cardBG = canvas.NewRectangle(theme.Color(theme.ColorNameOverlayBackground))
+	r.cardBG.CornerRadius = theme.InputRadiusSize()
+	r.card = container.NewStack(r.cardBG, container.NewPadded(container.NewVBox(
+		r.title, r.caption, container.NewHBox(r.progress, layout.NewSpacer(), r.skip, r.next))))
+	r.Refresh()
+	return r
+}
+
+// Tapped absorbs taps on the backdrop.
+func (o *tourOverlay) Tapped(*fyne.PointEvent) {
+}
+
+type tourOverlayRenderer struct {
+	overlay   *tourOverlay
+	dim       [4]*canvas.Rectangle
+	spotlight *canvas.Rectangle
+
+	card           *fyne.Container
+	cardBG         *canvas.Rectangle
+	title, caption *widget.Label
+	progress       *widget.Label
+	skip, next     *widget.Button
+}
+
+func (r *tourOverlayRenderer) Destroy() {
+}
+
+func (r *tourOverlayRenderer) Layout(size fyne.Size) {
+	pad := theme.Padding()
+	pos, target := r.target()
+	if target.IsZero() {
+		pos = fyne.NewPos(size.Width/2, size.Height/2)
+	} else {
+		pos = pos.Subtract(fyne.NewPos(pad, pad))
+		target = target.Add(fyne.NewSquareSize(pad * 2))
+	}
+	end := pos.Add(fyne.NewPos(target.Width, target.Height))
+
+	// four rectangles surround the spotlight: above, below, left and right of it
+	r.dim[0].Move(fyne.NewPos(0, 0))
+	r.dim[0].Resize(fyne.NewSize(size.Width, pos.Y))
+	r.dim[1].Move(fyne.NewPos(0, end.Y))
+	r.dim[1].Resize(fyne.NewSize(size.Width, size.Height-end.Y))
+	r.dim[2].Move(fyne.NewPos(0, pos.Y))
+	r.dim[2].Resize(fyne.NewSize(pos.X, target.Height))
+	r.dim[3].Move(fyne.NewPos(end.X, pos.Y))
+	r.dim[3].Resize(fyne.NewSize(size.Width-end.X, target.Height))
+	r.spotlight.Move(pos)
+	r.spotlight.Resize(target)
+
+	width := fyne.Min(tourCardWidth, size.Width-pad*2)
+	r.card.Resize(fyne.NewSize(width, 0))
+	card := fyne.NewSize(width, r.card.MinSize().Height)
+	r.card.Resize(card)
+	x := fyne.Max(pad, fyne.Min(pos.X, size.Width-card.Width-pad))
+	y := end.Y + pad*2
+	if y+card.Height > size.Height && pos.Y-card.Height-pad*2 >= 0 {
+		y = pos.Y - card.Height - pad*2
+	}
+	r.card.Move(fyne.NewPos(x, fyne.Max(pad, fyne.Min(y, size.Height-card.Height-pad))))
+}
+
+func (r *tourOverlayRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+func (r *tourOverlayRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.dim[0], r.dim[1], r.dim[2], r.dim[3], r.spotlight, r.card}
+}
+
+func (r *tourOverlayRenderer) Refresh() {
+	t := r.overlay.tour
+	if t.step >= len(t.Steps) {
+		return
+	}
+	step := t.Steps[t.step]
+
+	dim := withAlpha(theme.Color(theme.ColorNameShadow), 0xaa)
+	for _, d := range r.dim {
+		d.FillColor = dim
+		d.Refresh()
+	}
+	r.spotlight.StrokeColor = theme.Color(theme.ColorNamePrimary)
+	r.spotlight.Refresh()
+	r.cardBG.FillColor = theme.Color(theme.ColorNameOverlayBackground)
+	r.cardBG.Refresh()
+
+	r.title.SetText(step.Title)
+	r.title.Hidden = step.Title == ""
+	r.caption.SetText(step.Caption)
+	r.progress.SetText(fmt.Sprintf("%d of %d", t.step+1, len(t.Steps)))
+	if t.step == len(t.Steps)-1 {
+		r.next.SetText(i18n.L("Done"))
+		r.skip.Hide()
+	} else {
+		r.next.SetText(i18n.L("Next"))
+		r.skip.Show()
+	}
+	r.Layout(r.overlay.Size())
+}
+
+// target returns the position and size of the current target within the canvas.
+func (r *tourOverlayRenderer) target() (fyne.Position, fyne.Size) {
+	t := r.overlay.tour
+	if t.step >= len(t.Steps) || t.Steps[t.step].Target == nil {
+		return fyne.NewPos(0, 0), fyne.NewSize(0, 0)
+	}
+	target := t.Steps[t.step].Target
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(target)
+	return pos, target.Size()
+}
@@ -0,0 +1,73 @@
+package dialog
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTour_Steps(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	open := widget.NewButton("Open", nil)
+	save := widget.NewButton("Save", nil)
+	w := test.NewWindow(container.NewVBox(open, save))
+	defer w.Close()
+	w.Resize(fyne.NewSize(400, 400))
+
+	finished := 0
+	completed := false
+	tour := NewTour("intro", []*TourStep{
+		{Target: open, Title: "Open", Caption: "Open a file"},
+		{Target: save, Title: "Save", Caption: "Save your work"},
+	}, w)
+	tour.OnFinished = func(c bool) {
+		finished++
+		completed = c
+	}
+
+	assert.True(t, tour.ShowOnce())
+	assert.Len(t, w.Canvas().Overlays().List(), 1)
+	r := test.WidgetRenderer(tour.overlay).(*tourOverlayRenderer)
+	assert.Equal(t, "1 of 2", r.progress.Text)
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(open)
+	assert.Equal(t, pos.Y-4, r.spotlight.Position().Y)
+
+	test.Tap(r.next)
+	assert.Equal(t, 1, tour.Step())
+	assert.Equal(t, "Done", r.next.Text)
+	assert.False(t, r.skip.Visible())
+
+	test.Tap(r.next)
+	assert.Equal(t, 1, finished)
+	assert.True(t, completed)
+	assert.Empty(t, w.Canvas().Overlays().List())
+	assert.True(t, tour.Completed())
+	assert.False(t, tour.ShowOnce())
+
+	tour.Reset()
+	assert.False(t, tour.Completed())
+}
+
+func TestTour_Skip(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	w := test.NewWindow(widget.NewLabel("content"))
+	defer w.Close()
+
+	completed := true
+	tour := ShowTourOnce("skipped", []*TourStep{{Caption: "one"}, {Caption: "two"}}, w)
+	tour.OnFinished = func(c bool) {
+		completed = c
+	}
+	tour.Skip()
+	assert.False(t, completed)
+	assert.True(t, tour.Completed())
+	assert.Empty(t, w.Canvas().Overlays().List())
+}
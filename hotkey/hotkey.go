@@ -0,0 +1,83 @@
+// Package hotkey registers global hotkeys, keyboard shortcuts that work while other apps have focus.
+package hotkey // import "fyne.io/x/fyne/hotkey"
+
+import (
+	"errors"
+	"sync"
+
+	"fyne.io/fyne/v2"
+)
+
+var (
+	// ErrUnsupported is returned where the platform has no global hotkeys, such as on mobile.
+	ErrUnsupported = errors.New("global hotkeys are not supported on this platform")
+	// ErrUnknownKey is returned for a shortcut whose key can't be used for a global hotkey.
+	ErrUnknownKey = errors.New("key can not be used for a global hotkey")
+	// ErrInUse is returned when another app has already registered the shortcut.
+	ErrInUse = errors.New("hotkey is already registered")
+)
+
+// Hotkey is a registered global hotkey.
+type Hotkey struct {
+	Shortcut fyne.KeyboardShortcut
+
+	id int
+	fn func()
+}
+
+var (
+	lock    sync.Mutex
+	hotkeys = make(map[int]*Hotkey)
+	nextID  = 1
+)
+
+// RegisterGlobal registers a shortcut, such as Ctrl+Shift+Space, that calls fn when it is pressed
+// even while another app has focus. fn is called on a background goroutine.
+// On Linux this needs an X11 display, so it fails under Wayland without XWayland.
+func RegisterGlobal(shortcut fyne.KeyboardShortcut, fn func()) (*Hotkey, error) {
+	if shortcut == nil || fn == nil {
+		return nil, ErrUnknownKey
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	h := &Hotkey{Shortcut: shortcut, id: nextID, fn: fn}
+	if err := register(h); err != nil {
+		return nil, err
+	}
+	hotkeys[h.id] = h
+	nextID++
+	return h, nil
+}
+
+// UnregisterAll removes all hotkeys registered by the app, such as when it quits.
+func UnregisterAll() {
+	lock.Lock()
+	defer lock.Unlock()
+	for _, h := range hotkeys {
+		if err := unregister(h); err != nil {
+			fyne.LogError("Error unregistering hotkey", err)
+		}
+	}
+	hotkeys = make(map[int]*Hotkey)
+}
+
+// Unregister removes the hotkey, freeing the shortcut for other apps.
+func (h *Hotkey) Unregister() error {
+	lock.Lock()
+	defer lock.Unlock()
+	if _, ok := hotkeys[h.id]; !ok {
+		return nil
+	}
+	delete(hotkeys, h.id)
+	return unregister(h)
+}
+
+// pressed is called by the platform code when the hotkey with id is pressed.
+func pressed(id int) {
+	lock.Lock()
+	h := hotkeys[id]
+	lock.Unlock()
+	if h != nil {
+		go h.fn()
+	}
+}
@@ -0,0 +1,114 @@
+//go:build darwin && !ios
+// +build darwin,!ios
+
+package hotkey
+
+/*
+#cgo LDFLAGS: -framework Carbon
+#include <Carbon/Carbon.h>
+
+extern void hotkeyPressed(int id);
+
+static OSStatus onHotkey(EventHandlerCallRef next, EventRef event, void *data) {
+	EventHotKeyID hotkeyID;
+	GetEventParameter(event, kEventParamDirectObject, typeEventHotKeyID, NULL, sizeof(hotkeyID), NULL, &hotkeyID);
+	hotkeyPressed(hotkeyID.id);
+	return noErr;
+}
+
+static OSStatus installHandler() {
+	EventTypeSpec type = {kEventClassKeyboard, kEventHotKeyPressed};
+	return InstallApplicationEventHandler(&onHotkey, 1, &type, NULL, NULL);
+}
+
+static OSStatus registerHotkey(int id, UInt32 key, UInt32 mods, EventHotKeyRef *ref) {
+	EventHotKeyID hotkeyID = {'fynx', id};
+	return RegisterEventHotKey(key, mods, hotkeyID, GetApplicationEventTarget(), 0, ref);
+}
+*/
+import "C"
+
+import (
+	"errors"
+
+	"fyne.io/fyne/v2"
+)
+
+// macKeys are the virtual key codes of the ANSI keyboard layout.
+var macKeys = map[fyne.KeyName]C.UInt32{
+	fyne.KeyA: 0x00, fyne.KeyS: 0x01, fyne.KeyD: 0x02, fyne.KeyF: 0x03, fyne.KeyH: 0x04, fyne.KeyG: 0x05,
+	fyne.KeyZ: 0x06, fyne.KeyX: 0x07, fyne.KeyC: 0x08, fyne.KeyV: 0x09, fyne.KeyB: 0x0B, fyne.KeyQ: 0x0C,
+	fyne.KeyW: 0x0D, fyne.KeyE: 0x0E, fyne.KeyR: 0x0F, fyne.KeyY: 0x10, fyne.KeyT: 0x11, fyne.Key1: 0x12,
+	fyne.Key2: 0x13, fyne.Key3: 0x14, fyne.Key4: 0x15, fyne.Key6: 0x16, fyne.Key5: 0x17, fyne.KeyEqual: 0x18,
+	fyne.Key9: 0x19, fyne.Key7: 0x1A, fyne.KeyMinus: 0x1B, fyne.Key8: 0x1C, fyne.Key0: 0x1D,
+	fyne.KeyRightBracket: 0x1E, fyne.KeyO: 0x1F, fyne.KeyU: 0x20, fyne.KeyLeftBracket: 0x21, fyne.KeyI: 0x22,
+	fyne.KeyP: 0x23, fyne.KeyReturn: 0x24, fyne.KeyL: 0x25, fyne.KeyJ: 0x26, fyne.KeyApostrophe: 0x27,
+	fyne.KeyK: 0x28, fyne.KeySemicolon: 0x29, fyne.KeyBackslash: 0x2A, fyne.KeyComma: 0x2B,
+	fyne.KeySlash: 0x2C, fyne.KeyN: 0x2D, fyne.KeyM: 0x2E, fyne.KeyPeriod: 0x2F, fyne.KeyTab: 0x30,
+	fyne.KeySpace: 0x31, fyne.KeyBackTick: 0x32, fyne.KeyBackspace: 0x33, fyne.KeyEscape: 0x35,
+	fyne.KeyAsterisk: 0x43, fyne.KeyPlus: 0x45, fyne.KeyEnter: 0x4C,
+	fyne.KeyF1: 0x7A, fyne.KeyF2: 0x78, fyne.KeyF3: 0x63, fyne.KeyF4: 0x76, fyne.KeyF5: 0x60, fyne.KeyF6: 0x61,
+	fyne.KeyF7: 0x62, fyne.KeyF8: 0x64, fyne.KeyF9: 0x65, fyne.KeyF10: 0x6D, fyne.KeyF11: 0x67, fyne.KeyF12: 0x6F,
+	fyne.KeyInsert: 0x72, fyne.KeyHome: 0x73, fyne.KeyPageUp: 0x74, fyne.KeyDelete: 0x75, fyne.KeyEnd: 0x77,
+	fyne.KeyPageDown: 0x79, fyne.KeyLeft: 0x7B, fyne.KeyRight: 0x7C, fyne.KeyDown: 0x7D, fyne.KeyUp: 0x7E,
+}
+
+var (
+	macHandlerInstalled bool
+	macRefs             = make(map[int]C.EventHotKeyRef)
+)
+
+//export hotkeyPressed
+func hotkeyPressed(id C.int) {
+	go pressed(int(id))
+}
+
+func register(h *Hotkey) error {
+	key, ok := macKeys[h.Shortcut.Key()]
+	if !ok {
+		return ErrUnknownKey
+	}
+	if !macHandlerInstalled {
+		if C.installHandler() != C.noErr {
+			return errors.New("failed to install hotkey handler")
+		}
+		macHandlerInstalled = true
+	}
+
+	var mods C.UInt32
+	mod := h.Shortcut.Mod()
+	if mod&fyne.KeyModifierSuper != 0 {
+		mods |= C.cmdKey
+	}
+	if mod&fyne.KeyModifierShift != 0 {
+		mods |= C.shiftKey
+	}
+	if mod&fyne.KeyModifierAlt != 0 {
+		mods |= C.optionKey
+	}
+	if mod&fyne.KeyModifierControl != 0 {
+		mods |= C.controlKey
+	}
+
+	var ref C.EventHotKeyRef
+	switch C.registerHotkey(C.int(h.id), key, mods, &ref) {
+	case C.noErr:
+		macRefs[h.id] = ref
+		return nil
+	case C.eventHotKeyExistsErr:
+		return ErrInUse
+	}
+	return errors.New("failed to register hotkey")
+}
+
+func unregister(h *Hotkey) error {
+	ref, ok := macRefs[h.id]
+	if !ok {
+		return nil
+	}
+	delete(macRefs, h.id)
+	if C.UnregisterEventHotKey(ref) != C.noErr {
+		return errors.New("failed to unregister hotkey")
+	}
+	return nil
+}
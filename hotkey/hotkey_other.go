@@ -0,0 +1,17 @@
+//go:build !((linux && !android) || freebsd || openbsd || netbsd || windows || (darwin && !ios))
+// +build !linux android
+// +build !freebsd
+// +build !openbsd
+// +build !netbsd
+// +build !windows
+// +build !darwin ios
+
+package hotkey
+
+func register(*Hotkey) error {
+	return ErrUnsupported
+}
+
+func unregister(*Hotkey) error {
+	return nil
+}
@@ -0,0 +1,46 @@
+package hotkey
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterGlobal(t *testing.T) {
+	_, err := RegisterGlobal(nil, func() {})
+	assert.Equal(t, ErrUnknownKey, err)
+
+	shortcut := &desktop.CustomShortcut{KeyName: fyne.KeyF9, Modifier: fyne.KeyModifierControl | fyne.KeyModifierAlt}
+	h, err := RegisterGlobal(shortcut, func() {})
+	if err == ErrUnsupported {
+		t.Skip("no global hotkeys in this environment")
+	}
+	assert.NoError(t, err)
+	assert.Equal(t, shortcut, h.Shortcut)
+	assert.Len(t, hotkeys, 1)
+
+	_, err = RegisterGlobal(&desktop.CustomShortcut{KeyName: fyne.KeyUnknown}, func() {})
+	assert.Equal(t, ErrUnknownKey, err)
+
+	assert.NoError(t, h.Unregister())
+	assert.NoError(t, h.Unregister())
+	assert.Empty(t, hotkeys)
+}
+
+func TestPressed(t *testing.T) {
+	called := make(chan bool, 1)
+	lock.Lock()
+	hotkeys[-1] = &Hotkey{id: -1, fn: func() { called <- true }}
+	lock.Unlock()
+	defer func() {
+		lock.Lock()
+		delete(hotkeys, -1)
+		lock.Unlock()
+	}()
+
+	pressed(-2)
+	pressed(-1)
+	assert.True(t, <-called)
+}
@@ -0,0 +1,165 @@
+package hotkey
+
+import (
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"fyne.io/fyne/v2"
+)
+
+const (
+	winModAlt      = 0x0001
+	winModControl  = 0x0002
+	winModShift    = 0x0004
+	winModWin      = 0x0008
+	winModNoRepeat = 0x4000
+
+	winHotkeyMessage  = 0x0312 // WM_HOTKEY
+	winWakeMessage    = 0x8000 // WM_APP, to handle a request
+	winErrHotkeyInUse = 1409   // ERROR_HOTKEY_ALREADY_REGISTERED
+)
+
+var (
+	user32                 = syscall.NewLazyDLL("user32.dll")
+	kernel32               = syscall.NewLazyDLL("kernel32.dll")
+	procRegisterHotKey     = user32.NewProc("RegisterHotKey")
+	procUnregisterHotKey   = user32.NewProc("UnregisterHotKey")
+	procGetMessage         = user32.NewProc("GetMessageW")
+	procPostThreadMessage  = user32.NewProc("PostThreadMessageW")
+	procPeekMessage        = user32.NewProc("PeekMessageW")
+	procGetCurrentThreadID = kernel32.NewProc("GetCurrentThreadId")
+)
+
+var winKeys = map[fyne.KeyName]uintptr{
+	fyne.KeyEscape: 0x1B, fyne.KeyReturn: 0x0D, fyne.KeyTab: 0x09, fyne.KeyBackspace: 0x08,
+	fyne.KeyInsert: 0x2D, fyne.KeyDelete: 0x2E, fyne.KeyRight: 0x27, fyne.KeyLeft: 0x25,
+	fyne.KeyDown: 0x28, fyne.KeyUp: 0x26, fyne.KeyPageUp: 0x21, fyne.KeyPageDown: 0x22,
+	fyne.KeyHome: 0x24, fyne.KeyEnd: 0x23, fyne.KeyEnter: 0x0D, fyne.KeySpace: 0x20,
+	fyne.KeyApostrophe: 0xDE, fyne.KeyComma: 0xBC, fyne.KeyMinus: 0xBD, fyne.KeyPeriod: 0xBE,
+	fyne.KeySlash: 0xBF, fyne.KeyBackslash: 0xDC, fyne.KeyLeftBracket: 0xDB, fyne.KeyRightBracket: 0xDD,
+	fyne.KeySemicolon: 0xBA, fyne.KeyEqual: 0xBB, fyne.KeyAsterisk: 0x6A, fyne.KeyPlus: 0x6B,
+	fyne.KeyBackTick: 0xC0,
+}
+
+// winMsg is the MSG structure of a Windows message.
+type winMsg struct {
+	hwnd    uintptr
+	message uint32
+	wParam  uintptr
+	lParam  uintptr
+	time    uint32
+	x, y    int32
+}
+
+type winRequest struct {
+	hotkey *Hotkey
+	add    bool
+	done   chan error
+}
+
+var (
+	winRequests chan winRequest
+	winThread   uintptr
+)
+
+func register(h *Hotkey) error {
+	return winDo(h, true)
+}
+
+func unregister(h *Hotkey) error {
+	return winDo(h, false)
+}
+
+// winDo asks the hotkey thread to register or unregister a hotkey, as they belong to the thread that registers them.
+func winDo(h *Hotkey, add bool) error {
+	if winRequests == nil {
+		started := make(chan uintptr)
+		winRequests = make(chan winRequest, 1)
+		go winRun(started)
+		winThread = <-started
+	}
+	done := make(chan error)
+	winRequests <- winRequest{hotkey: h, add: add, done: done}
+	procPostThreadMessage.Call(winThread, winWakeMessage, 0, 0)
+	return <-done
+}
+
+// winRun runs the message loop of a thread that receives the hotkey messages.
+func winRun(started chan uintptr) {
+	runtime.LockOSThread()
+	var msg winMsg
+	// create the message queue of the thread before it is posted to
+	procPeekMessage.Call(uintptr(unsafe.Pointer(&msg)), 0, 0, 0, 0)
+	id, _, _ := procGetCurrentThreadID.Call()
+	started <- id
+
+	for {
+		ret, _, _ := procGetMessage.Call(uintptr(unsafe.Pointer(&msg)), 0, 0, 0)
+		if int32(ret) <= 0 {
+			return
+		}
+		switch msg.message {
+		case winHotkeyMessage:
+			go pressed(int(msg.wParam))
+		case winWakeMessage:
+			for len(winRequests) > 0 {
+				r := <-winRequests
+				r.done <- winHandle(r)
+			}
+		}
+	}
+}
+
+func winHandle(r winRequest) error {
+	if !r.add {
+		ret, _, err := procUnregisterHotKey.Call(0, uintptr(r.hotkey.id))
+		if ret == 0 {
+			return err
+		}
+		return nil
+	}
+
+	key := r.hotkey.Shortcut.Key()
+	vk, ok := winKeys[key]
+	if !ok {
+		switch {
+		case len(key) == 1 && (key[0] >= 'A' && key[0] <= 'Z' || key[0] >= '0' && key[0] <= '9'):
+			vk = uintptr(key[0])
+		case len(key) > 1 && key[0] == 'F':
+			n := 0
+			for _, c := range key[1:] {
+				if c < '0' || c > '9' {
+					return ErrUnknownKey
+				}
+				n = n*10 + int(c-'0')
+			}
+			vk = uintptr(0x70 + n - 1) // VK_F1
+		default:
+			return ErrUnknownKey
+		}
+	}
+
+	mods := uintptr(winModNoRepeat)
+	mod := r.hotkey.Shortcut.Mod()
+	if mod&fyne.KeyModifierAlt != 0 {
+		mods |= winModAlt
+	}
+	if mod&fyne.KeyModifierControl != 0 {
+		mods |= winModControl
+	}
+	if mod&fyne.KeyModifierShift != 0 {
+		mods |= winModShift
+	}
+	if mod&fyne.KeyModifierSuper != 0 {
+		mods |= winModWin
+	}
+	ret, _, err := procRegisterHotKey.Call(0, uintptr(r.hotkey.id), mods, vk)
+	if ret == 0 {
+		if errno, ok := err.(syscall.Errno); ok && errno == winErrHotkeyInUse {
+			return ErrInUse
+		}
+		return err
+	}
+	return nil
+}
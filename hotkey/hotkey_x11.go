@@ -0,0 +1,192 @@
+//go:build (linux && !android) || freebsd || openbsd || netbsd
+// +build linux,!android freebsd openbsd netbsd
+
+package hotkey
+
+/*
+#cgo LDFLAGS: -lX11
+#include <stdlib.h>
+#include <X11/Xlib.h>
+
+static int lastError;
+
+static int onError(Display *d, XErrorEvent *e) {
+	lastError = e->error_code;
+	return 0;
+}
+
+// grab takes the key with any state of the caps and num locks, returning an X error code.
+static int grab(Display *d, int keycode, unsigned int mods, int ungrab) {
+	unsigned int locks[] = {0, LockMask, Mod2Mask, LockMask | Mod2Mask};
+	XErrorHandler previous = XSetErrorHandler(onError);
+	lastError = 0;
+	for (int i = 0; i < 4; i++) {
+		if (ungrab) {
+			XUngrabKey(d, keycode, mods | locks[i], DefaultRootWindow(d));
+		} else {
+			XGrabKey(d, keycode, mods | locks[i], DefaultRootWindow(d), False, GrabModeAsync, GrabModeAsync);
+		}
+	}
+	XSync(d, False);
+	XSetErrorHandler(previous);
+	return lastError;
+}
+
+static int nextKeyPress(Display *d, int *keycode, unsigned int *state) {
+	while (XPending(d)) {
+		XEvent e;
+		XNextEvent(d, &e);
+		if (e.type == KeyPress) {
+			*keycode = e.xkey.keycode;
+			*state = e.xkey.state & (ShiftMask | ControlMask | Mod1Mask | Mod4Mask);
+			return 1;
+		}
+	}
+	return 0;
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"time"
+	"unsafe"
+
+	"fyne.io/fyne/v2"
+)
+
+// x11PollInterval is how often the X11 connection is checked for hotkey presses.
+const x11PollInterval = 20 * time.Millisecond
+
+var x11KeySyms = map[fyne.KeyName]string{
+	fyne.KeySpace: "space", fyne.KeyApostrophe: "apostrophe", fyne.KeyComma: "comma", fyne.KeyMinus: "minus",
+	fyne.KeyPeriod: "period", fyne.KeySlash: "slash", fyne.KeyBackslash: "backslash",
+	fyne.KeyLeftBracket: "bracketleft", fyne.KeyRightBracket: "bracketright", fyne.KeySemicolon: "semicolon",
+	fyne.KeyEqual: "equal", fyne.KeyAsterisk: "KP_Multiply", fyne.KeyPlus: "KP_Add", fyne.KeyBackTick: "grave",
+}
+
+type x11Grab struct {
+	keycode C.int
+	mods    C.uint
+}
+
+type x11Request struct {
+	hotkey *Hotkey
+	grab   bool
+	done   chan error
+}
+
+var (
+	x11Requests chan x11Request
+	x11Err      error
+)
+
+func register(h *Hotkey) error {
+	return x11Do(h, true)
+}
+
+func unregister(h *Hotkey) error {
+	return x11Do(h, false)
+}
+
+// x11Do asks the X11 thread to grab or ungrab the key of a hotkey, starting the thread if needed.
+func x11Do(h *Hotkey, grab bool) error {
+	if x11Requests == nil && x11Err == nil {
+		started := make(chan error)
+		x11Requests = make(chan x11Request)
+		go x11Run(started)
+		if x11Err = <-started; x11Err != nil {
+			x11Requests = nil
+		}
+	}
+	if x11Err != nil {
+		return x11Err
+	}
+	done := make(chan error)
+	x11Requests <- x11Request{hotkey: h, grab: grab, done: done}
+	return <-done
+}
+
+// x11Run owns a connection to the X server, grabbing keys and waiting for them to be pressed.
+func x11Run(started chan error) {
+	runtime.LockOSThread()
+	d := C.XOpenDisplay(nil)
+	if d == nil {
+		started <- ErrUnsupported
+		return
+	}
+	started <- nil
+
+	grabs := make(map[x11Grab]int)
+	ticker := time.NewTicker(x11PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case r := <-x11Requests:
+			r.done <- x11Grabbing(d, grabs, r)
+		case <-ticker.C:
+			var keycode C.int
+			var state C.uint
+			for C.nextKeyPress(d, &keycode, &state) != 0 {
+				if id, ok := grabs[x11Grab{keycode, state}]; ok {
+					go pressed(id)
+				}
+			}
+		}
+	}
+}
+
+func x11Grabbing(d *C.Display, grabs map[x11Grab]int, r x11Request) error {
+	name := string(r.hotkey.Shortcut.Key())
+	if sym, ok := x11KeySyms[r.hotkey.Shortcut.Key()]; ok {
+		name = sym
+	} else if len(name) == 1 {
+		name = strings.ToLower(name)
+	}
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	sym := C.XStringToKeysym(cName)
+	if sym == C.NoSymbol {
+		return ErrUnknownKey
+	}
+	keycode := C.int(C.XKeysymToKeycode(d, sym))
+	if keycode == 0 {
+		return ErrUnknownKey
+	}
+	key := x11Grab{keycode, x11Modifiers(r.hotkey.Shortcut.Mod())}
+
+	if !r.grab {
+		delete(grabs, key)
+		if code := C.grab(d, key.keycode, key.mods, 1); code != 0 {
+			return errors.New("X11 error ungrabbing hotkey")
+		}
+		return nil
+	}
+	if code := C.grab(d, key.keycode, key.mods, 0); code == C.BadAccess {
+		C.grab(d, key.keycode, key.mods, 1)
+		return ErrInUse
+	} else if code != 0 {
+		return errors.New("X11 error grabbing hotkey")
+	}
+	grabs[key] = r.hotkey.id
+	return nil
+}
+
+func x11Modifiers(mod fyne.KeyModifier) C.uint {
+	var mods C.uint
+	if mod&fyne.KeyModifierShift != 0 {
+		mods |= C.ShiftMask
+	}
+	if mod&fyne.KeyModifierControl != 0 {
+		mods |= C.ControlMask
+	}
+	if mod&fyne.KeyModifierAlt != 0 {
+		mods |= C.Mod1Mask
+	}
+	if mod&fyne.KeyModifierSuper != 0 {
+		mods |= C.Mod4Mask
+	}
+	return mods
+}
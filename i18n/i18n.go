@@ -0,0 +1,182 @@
+// Package i18n translates the text shown by fyne-x widgets and dialogs, and can translate the text of apps too.
+//
+// Messages are written in English in the source and looked up in the catalog of the current locale,
+// falling back from a regional locale such as "pt-BR" to its language and then to the English text.
+package i18n // import "fyne.io/x/fyne/i18n"
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2/lang"
+)
+
+var (
+	lock     sync.RWMutex
+	locale   string
+	catalogs = make(map[string]map[string]map[string]string) // locale, message, plural category
+)
+
+// AddTranslations adds translations of messages for a locale, such as "de" or "pt-BR",
+// replacing any earlier translations of the same messages.
+func AddTranslations(locale string, messages map[string]string) {
+	lock.Lock()
+	defer lock.Unlock()
+	c := catalog(normalize(locale))
+	for message, text := range messages {
+		c[message] = map[string]string{"other": text}
+	}
+}
+
+// AddPluralTranslations adds the translation of a message with a count for a locale,
+// as a text for each plural category the language uses: "zero", "one", "two", "few", "many" or "other".
+// The message is the singular English text passed to N.
+func AddPluralTranslations(locale, message string, forms map[string]string) {
+	lock.Lock()
+	defer lock.Unlock()
+	c := catalog(normalize(locale))
+	c[message] = make(map[string]string, len(forms))
+	for category, text := range forms {
+		c[message][category] = text
+	}
+}
+
+// AddTranslationsJSON adds translations for a locale from a JSON object of messages.
+// A translation is either a string, or an object of plural categories to strings for a message with a count.
+//
+//	{"Next": "Weiter", "%d file": {"one": "%d Datei", "other": "%d Dateien"}}
+func AddTranslationsJSON(locale string, data []byte) error {
+	var messages map[string]json.RawMessage
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return err
+	}
+	simple := make(map[string]string)
+	plural := make(map[string]map[string]string)
+	for message, raw := range messages {
+		var text string
+		if err := json.Unmarshal(raw, &text); err == nil {
+			simple[message] = text
+			continue
+		}
+		var forms map[string]string
+		if err := json.Unmarshal(raw, &forms); err != nil {
+			return fmt.Errorf("translation of %q is not a string or plural forms: %w", message, err)
+		}
+		plural[message] = forms
+	}
+
+	AddTranslations(locale, simple)
+	for message, forms := range plural {
+		AddPluralTranslations(locale, message, forms)
+	}
+	return nil
+}
+
+// Locale returns the locale that messages are translated to.
+// Unless it was set with SetLocale, this is the locale of the system.
+func Locale() string {
+	lock.RLock()
+	l := locale
+	lock.RUnlock()
+	if l != "" {
+		return l
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if locale == "" {
+		locale = normalize(string(lang.SystemLocale()))
+	}
+	return locale
+}
+
+// SetLocale sets the locale that messages are translated to, such as "fr" or "en-GB".
+// Objects that are already shown keep their text until they are refreshed or created again.
+func SetLocale(l string) {
+	lock.Lock()
+	defer lock.Unlock()
+	locale = normalize(l)
+}
+
+// L returns the translation of a message to the current locale, or the message if it has no translation.
+func L(message string) string {
+	if forms := lookup(message); forms != nil {
+		if text, ok := forms["other"]; ok {
+			return text
+		}
+	}
+	return message
+}
+
+// N returns the translation of a message with a count to the current locale, formatted with n.
+// The singular and plural are the English texts, such as "%d file" and "%d files".
+func N(singular, plural string, n int) string {
+	text := plural
+	if n == 1 {
+		text = singular
+	}
+	if forms := lookup(singular); forms != nil {
+		if t, ok := forms[PluralCategory(Locale(), n)]; ok {
+			text = t
+		} else if t, ok := forms["other"]; ok {
+			text = t
+		}
+	}
+	if !strings.Contains(text, "%") {
+		return text
+	}
+	return fmt.Sprintf(text, n)
+}
+
+// catalog returns the messages of a locale, creating them if needed. The lock must be held for writing.
+func catalog(locale string) map[string]map[string]string {
+	c, ok := catalogs[locale]
+	if !ok {
+		c = make(map[string]map[string]string)
+		catalogs[locale] = c
+	}
+	return c
+}
+
+func lookup(message string) map[string]string {
+	l := Locale()
+	lock.RLock()
+	defer lock.RUnlock()
+	for _, candidate := range fallbacks(l) {
+		if forms, ok := catalogs[candidate][message]; ok {
+			return forms
+		}
+	}
+	return nil
+}
+
+// fallbacks returns a normalized locale followed by the less specific locales it falls back to.
+func fallbacks(locale string) []string {
+	var list []string
+	for {
+		list = append(list, locale)
+		i := strings.LastIndexByte(locale, '-')
+		if i < 0 {
+			return list
+		}
+		locale = locale[:i]
+	}
+}
+
+// normalize converts a locale such as "pt_BR" or "en-US-Latn" to the form used as a catalog key.
+func normalize(locale string) string {
+	parts := strings.FieldsFunc(locale, func(r rune) bool {
+		return r == '-' || r == '_' || r == '.' || r == '@'
+	})
+	if len(parts) == 0 {
+		return "en"
+	}
+	parts[0] = strings.ToLower(parts[0])
+	if len(parts) > 1 && len(parts[1]) == 2 {
+		// drop the script and encoding, keeping the region
+		return parts[0] + "-" + strings.ToUpper(parts[1])
+	}
+	return parts[0]
+}
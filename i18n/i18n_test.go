@@ -0,0 +1,106 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func useLocale(t *testing.T, l string) {
+	previous := Locale()
+	SetLocale(l)
+	t.Cleanup(func() {
+		SetLocale(previous)
+	})
+}
+
+func TestL(t *testing.T) {
+	useLocale(t, "de_AT.UTF-8")
+	assert.Equal(t, "de-AT", Locale())
+	assert.Equal(t, "Weiter", L("Next"))
+	assert.Equal(t, "Not translated", L("Not translated"))
+
+	AddTranslations("de-AT", map[string]string{"January": "Jänner"})
+	assert.Equal(t, "Jänner", MonthName(time.January))
+	assert.Equal(t, "Februar", MonthName(time.February))
+	assert.Equal(t, "Mo", ShortWeekdayName(time.Monday))
+
+	SetLocale("en-GB")
+	assert.Equal(t, "Next", L("Next"))
+	assert.Equal(t, "Mon", ShortWeekdayName(time.Monday))
+}
+
+func TestN(t *testing.T) {
+	useLocale(t, "en")
+	assert.Equal(t, "1 file", N("%d file", "%d files", 1))
+	assert.Equal(t, "3 files", N("%d file", "%d files", 3))
+
+	AddPluralTranslations("ru", "%d file", map[string]string{"one": "%d файл", "few": "%d файла", "many": "%d файлов"})
+	SetLocale("ru")
+	assert.Equal(t, "21 файл", N("%d file", "%d files", 21))
+	assert.Equal(t, "3 файла", N("%d file", "%d files", 3))
+	assert.Equal(t, "11 файлов", N("%d file", "%d files", 11))
+}
+
+func TestAddTranslationsJSON(t *testing.T) {
+	useLocale(t, "nl")
+	assert.NoError(t, AddTranslationsJSON("nl", []byte(`{"Next": "Volgende", "%d day": {"one": "%d dag", "other": "%d dagen"}}`)))
+	assert.Equal(t, "Volgende", L("Next"))
+	assert.Equal(t, "1 dag", N("%d day", "%d days", 1))
+	assert.Equal(t, "2 dagen", N("%d day", "%d days", 2))
+
+	assert.Error(t, AddTranslationsJSON("nl", []byte(`{"Next": 3}`)))
+	assert.Error(t, AddTranslationsJSON("nl", []byte(`[]`)))
+}
+
+func TestPluralCategory(t *testing.T) {
+	for _, tt := range []struct {
+		locale string
+		n      int
+		want   string
+	}{
+		{"en", 1, "one"}, {"en", 0, "other"}, {"de-DE", 2, "other"},
+		{"fr", 0, "one"}, {"fr", 2, "other"},
+		{"ja", 1, "other"},
+		{"pl", 1, "one"}, {"pl", 22, "few"}, {"pl", 12, "many"}, {"pl", 21, "many"},
+		{"cs", 3, "few"}, {"cs", 5, "other"},
+		{"ar", 0, "zero"}, {"ar", 2, "two"}, {"ar", 103, "few"}, {"ar", 11, "many"}, {"ar", 100, "other"},
+	} {
+		assert.Equal(t, tt.want, PluralCategory(tt.locale, tt.n), "%s %d", tt.locale, tt.n)
+	}
+}
+
+func TestFirstWeekday(t *testing.T) {
+	assert.Equal(t, time.Sunday, FirstWeekday("en"))
+	assert.Equal(t, time.Sunday, FirstWeekday("en_US.UTF-8"))
+	assert.Equal(t, time.Monday, FirstWeekday("en-GB"))
+	assert.Equal(t, time.Monday, FirstWeekday("de"))
+	assert.Equal(t, time.Saturday, FirstWeekday("fa"))
+	assert.Equal(t, time.Sunday, FirstWeekday("he"))
+	assert.Equal(t, time.Monday, FirstWeekday("not a locale"))
+}
+
+func TestFormatNumber(t *testing.T) {
+	assert.Equal(t, "1,234.5", FormatNumber("en", 1234.5, -1))
+	assert.Equal(t, "1.234,50", FormatNumber("de_DE.UTF-8", 1234.5, 2))
+	assert.Equal(t, "0.3", FormatNumber("en", 0.1+0.2, -1))
+	assert.Equal(t, "-12", FormatNumber("en", -12.4, 0))
+
+	group, decimal := NumberSeparators("de")
+	assert.Equal(t, '.', group)
+	assert.Equal(t, ',', decimal)
+	group, decimal = NumberSeparators("en-US")
+	assert.Equal(t, ',', group)
+	assert.Equal(t, '.', decimal)
+}
+
+func TestFormatCurrency(t *testing.T) {
+	assert.Equal(t, 2, CurrencyDigits("EUR"))
+	assert.Equal(t, 0, CurrencyDigits("JPY"))
+	assert.Equal(t, "$1,234.50", FormatCurrency("en", "USD", 1234.5))
+	assert.Equal(t, "-$3.00", FormatCurrency("en", "USD", -3))
+	assert.Equal(t, "1.234,50 €", FormatCurrency("de", "EUR", 1234.5))
+	assert.Equal(t, "¥1,235", FormatCurrency("en", "JPY", 1234.6))
+	assert.Equal(t, "XYZ", CurrencySymbol("en", "XYZ"))
+}
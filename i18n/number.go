@@ -0,0 +1,90 @@
+package i18n
+
+import (
+	"strings"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// the languages that write the currency symbol after the amount, such as "1.234,50 €"
+var currencySuffixLanguages = map[string]bool{
+	"bg": true, "cs": true, "da": true, "de": true, "el": true, "es": true, "et": true, "fi": true,
+	"fr": true, "hr": true, "hu": true, "is": true, "it": true, "lt": true, "lv": true, "nb": true,
+	"no": true, "pl": true, "pt": true, "ro": true, "ru": true, "sk": true, "sl": true, "sr": true,
+	"sv": true, "tr": true, "uk": true, "vi": true,
+}
+
+// FormatNumber returns a number written with the separators of a locale, such as "1.234,5" for 1234.5 in German.
+// It has the given digits after the decimal separator, or as many as needed up to 15 if decimals is negative.
+func FormatNumber(locale string, v float64, decimals int) string {
+	opt := number.MaxFractionDigits(15)
+	if decimals >= 0 {
+		opt = number.Scale(decimals)
+	}
+	return printer(locale).Sprint(number.Decimal(v, opt))
+}
+
+// NumberSeparators returns the thousands separator and the decimal separator of a locale,
+// such as '.' and ',' in German.
+func NumberSeparators(locale string) (group, decimal rune) {
+	digits := []rune(FormatNumber(locale, 1234.5, 1))
+	if len(digits) != 7 {
+		return ',', '.'
+	}
+	return digits[1], digits[5]
+}
+
+// CurrencyDigits returns the digits after the decimal separator of an amount of a currency, given by its
+// ISO 4217 code, such as 2 for "EUR" and 0 for "JPY".
+func CurrencyDigits(code string) int {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return 2
+	}
+	scale, _ := currency.Standard.Rounding(unit)
+	return scale
+}
+
+// CurrencySymbol returns the symbol of a currency in a locale, such as "$" for "USD" in English and "CA$"
+// for "CAD", or the code if the currency isn't known.
+func CurrencySymbol(locale, code string) string {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return code
+	}
+	return printer(locale).Sprint(currency.Symbol(unit))
+}
+
+// FormatCurrency returns an amount of a currency written as in a locale, such as "$1,234.50" in English and
+// "1.234,50 $" in German.
+func FormatCurrency(locale, code string, v float64) string {
+	amount := FormatNumber(locale, v, CurrencyDigits(code))
+	symbol := CurrencySymbol(locale, code)
+	if CurrencySymbolAfter(locale) {
+		return amount + " " + symbol
+	}
+	if strings.HasPrefix(amount, "-") {
+		return "-" + symbol + amount[1:]
+	}
+	return symbol + amount
+}
+
+// CurrencySymbolAfter returns whether the currency symbol is written after the amount in a locale.
+func CurrencySymbolAfter(locale string) bool {
+	lang := normalize(locale)
+	if i := strings.IndexByte(lang, '-'); i >= 0 {
+		lang = lang[:i]
+	}
+	return currencySuffixLanguages[lang]
+}
+
+func printer(locale string) *message.Printer {
+	tag, err := language.Parse(normalize(locale))
+	if err != nil {
+		tag = language.English
+	}
+	return message.NewPrinter(tag)
+}
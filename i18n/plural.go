@@ -0,0 +1,67 @@
+package i18n
+
+import "strings"
+
+// PluralCategory returns the CLDR plural category of a count in the language of a locale:
+// "zero", "one", "two", "few", "many" or "other".
+// Languages without a known rule use the English one, with "one" for 1 and "other" for any other count.
+func PluralCategory(locale string, n int) string {
+	if n < 0 {
+		n = -n
+	}
+	language := normalize(locale)
+	if i := strings.IndexByte(language, '-'); i >= 0 {
+		language = language[:i]
+	}
+
+	mod10, mod100 := n%10, n%100
+	switch language {
+	case "ja", "ko", "zh", "vi", "th", "id", "ms":
+		return "other"
+	case "fr":
+		if n <= 1 {
+			return "one"
+		}
+	case "ru", "uk", "be":
+		switch {
+		case mod10 == 1 && mod100 != 11:
+			return "one"
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return "few"
+		}
+		return "many"
+	case "pl":
+		switch {
+		case n == 1:
+			return "one"
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return "few"
+		}
+		return "many"
+	case "cs", "sk":
+		switch {
+		case n == 1:
+			return "one"
+		case n >= 2 && n <= 4:
+			return "few"
+		}
+	case "ar":
+		switch {
+		case n == 0:
+			return "zero"
+		case n == 1:
+			return "one"
+		case n == 2:
+			return "two"
+		case mod100 >= 3 && mod100 <= 10:
+			return "few"
+		case mod100 >= 11:
+			return "many"
+		}
+	default:
+		if n == 1 {
+			return "one"
+		}
+	}
+	return "other"
+}
@@ -0,0 +1,104 @@
+package i18n
+
+import "time"
+
+// MonthName returns the name of a month in the current locale.
+func MonthName(m time.Month) string {
+	return L(m.String())
+}
+
+// ShortWeekdayName returns the abbreviated name of a day of the week in the current locale, such as "Mon".
+func ShortWeekdayName(d time.Weekday) string {
+	return L(d.String()[:3])
+}
+
+func init() {
+	for locale, messages := range builtin {
+		AddTranslations(locale, messages)
+	}
+}
+
+// builtin holds the translations of the text shown by fyne-x widgets and dialogs.
+var builtin = map[string]map[string]string{
+	"de": {
+		"January": "Januar", "February": "Februar", "March": "März", "April": "April",
+		"May": "Mai", "June": "Juni", "July": "Juli", "August": "August",
+		"September": "September", "October": "Oktober", "November": "November", "December": "Dezember",
+		"Sun": "So", "Mon": "Mo", "Tue": "Di", "Wed": "Mi", "Thu": "Do", "Fri": "Fr", "Sat": "Sa",
+
+		"About": "Über", "OK": "OK", "Cancel": "Abbrechen", "Cancelled": "Abgebrochen", "Close": "Schließen",
+		"Close Others": "Andere schließen", "Close to the Right": "Rechts schließen",
+		"Back": "Zurück", "Next": "Weiter", "Finish": "Fertigstellen", "Skip": "Überspringen", "Done": "Fertig",
+		"Search": "Suchen", "Recent": "Zuletzt verwendet", "Retry": "Erneut versuchen", "Search places": "Orte suchen",
+		"Today": "Heute", "Last 7 days": "Letzte 7 Tage", "Last 30 days": "Letzte 30 Tage",
+		"Year": "Jahr", "Month": "Monat", "Week": "Woche",
+		"This month": "Dieser Monat", "Last month": "Letzter Monat", "Select the first date": "Erstes Datum wählen",
+		"Click to record shortcut": "Klicken, um Tastenkürzel aufzunehmen", "Press a shortcut…": "Tastenkürzel drücken…",
+		"Ctrl": "Strg", "Shift": "Umschalt", "E": "O",
+		"Colors": "Farben", "Sizes": "Größen", "Light": "Hell", "Dark": "Dunkel", "Reset": "Zurücksetzen",
+		"Keyboard Shortcuts": "Tastenkürzel", "Search shortcuts": "Tastenkürzel suchen", "No shortcuts found": "Keine Tastenkürzel gefunden",
+		"Dock Left": "Links andocken", "Dock Right": "Rechts andocken", "Dock Top": "Oben andocken",
+		"Dock Bottom": "Unten andocken", "Dock Center": "Mittig andocken", "Float": "Abdocken",
+		"Button": "Knopf", "Primary": "Primär", "Disabled": "Deaktiviert", "Check": "Auswahl", "Option": "Option",
+
+		"required": "erforderlich", "must be a number": "muss eine Zahl sein",
+		"must be at least %d characters": "muss mindestens %d Zeichen lang sein",
+		"must be at most %d characters":  "darf höchstens %d Zeichen lang sein",
+		"must be from %s to %s":          "muss zwischen %s und %s liegen",
+		"must be a date like %s":         "muss ein Datum wie %s sein",
+	},
+	"es": {
+		"January": "enero", "February": "febrero", "March": "marzo", "April": "abril",
+		"May": "mayo", "June": "junio", "July": "julio", "August": "agosto",
+		"September": "septiembre", "October": "octubre", "November": "noviembre", "December": "diciembre",
+		"Sun": "dom", "Mon": "lun", "Tue": "mar", "Wed": "mié", "Thu": "jue", "Fri": "vie", "Sat": "sáb",
+
+		"About": "Acerca de", "OK": "Aceptar", "Cancel": "Cancelar", "Cancelled": "Cancelada", "Close": "Cerrar",
+		"Close Others": "Cerrar las demás", "Close to the Right": "Cerrar las de la derecha",
+		"Back": "Atrás", "Next": "Siguiente", "Finish": "Finalizar", "Skip": "Omitir", "Done": "Hecho",
+		"Search": "Buscar", "Recent": "Recientes", "Retry": "Reintentar", "Search places": "Buscar lugares",
+		"Today": "Hoy", "Last 7 days": "Últimos 7 días", "Last 30 days": "Últimos 30 días",
+		"Year": "Año", "Month": "Mes", "Week": "Semana",
+		"This month": "Este mes", "Last month": "El mes pasado", "Select the first date": "Selecciona la primera fecha",
+		"Click to record shortcut": "Haz clic para grabar un atajo", "Press a shortcut…": "Pulsa un atajo…",
+		"Shift": "Mayús", "W": "O",
+		"Colors": "Colores", "Sizes": "Tamaños", "Light": "Claro", "Dark": "Oscuro", "Reset": "Restablecer",
+		"Keyboard Shortcuts": "Atajos de teclado", "Search shortcuts": "Buscar atajos", "No shortcuts found": "No se encontraron atajos",
+		"Dock Left": "Acoplar a la izquierda", "Dock Right": "Acoplar a la derecha", "Dock Top": "Acoplar arriba",
+		"Dock Bottom": "Acoplar abajo", "Dock Center": "Acoplar al centro", "Float": "Desacoplar",
+		"Button": "Botón", "Primary": "Principal", "Disabled": "Desactivado", "Check": "Casilla", "Option": "Opción",
+
+		"required": "obligatorio", "must be a number": "debe ser un número",
+		"must be at least %d characters": "debe tener al menos %d caracteres",
+		"must be at most %d characters":  "debe tener como máximo %d caracteres",
+		"must be from %s to %s":          "debe estar entre %s y %s",
+		"must be a date like %s":         "debe ser una fecha como %s",
+	},
+	"fr": {
+		"January": "janvier", "February": "février", "March": "mars", "April": "avril",
+		"May": "mai", "June": "juin", "July": "juillet", "August": "août",
+		"September": "septembre", "October": "octobre", "November": "novembre", "December": "décembre",
+		"Sun": "dim", "Mon": "lun", "Tue": "mar", "Wed": "mer", "Thu": "jeu", "Fri": "ven", "Sat": "sam",
+
+		"About": "À propos", "OK": "OK", "Cancel": "Annuler", "Cancelled": "Annulée", "Close": "Fermer",
+		"Close Others": "Fermer les autres", "Close to the Right": "Fermer à droite",
+		"Back": "Précédent", "Next": "Suivant", "Finish": "Terminer", "Skip": "Passer", "Done": "Terminé",
+		"Search": "Rechercher", "Recent": "Récentes", "Retry": "Réessayer", "Search places": "Rechercher des lieux",
+		"Today": "Aujourd’hui", "Last 7 days": "7 derniers jours", "Last 30 days": "30 derniers jours",
+		"Year": "Année", "Month": "Mois", "Week": "Semaine",
+		"This month": "Ce mois-ci", "Last month": "Le mois dernier", "Select the first date": "Choisissez la première date",
+		"Click to record shortcut": "Cliquez pour enregistrer un raccourci", "Press a shortcut…": "Appuyez sur un raccourci…",
+		"Shift": "Maj", "W": "O",
+		"Colors": "Couleurs", "Sizes": "Tailles", "Light": "Clair", "Dark": "Sombre", "Reset": "Réinitialiser",
+		"Keyboard Shortcuts": "Raccourcis clavier", "Search shortcuts": "Rechercher des raccourcis", "No shortcuts found": "Aucun raccourci trouvé",
+		"Dock Left": "Ancrer à gauche", "Dock Right": "Ancrer à droite", "Dock Top": "Ancrer en haut",
+		"Dock Bottom": "Ancrer en bas", "Dock Center": "Ancrer au centre", "Float": "Détacher",
+		"Button": "Bouton", "Primary": "Principal", "Disabled": "Désactivé", "Check": "Case", "Option": "Option",
+
+		"required": "obligatoire", "must be a number": "doit être un nombre",
+		"must be at least %d characters": "doit contenir au moins %d caractères",
+		"must be at most %d characters":  "doit contenir au plus %d caractères",
+		"must be from %s to %s":          "doit être entre %s et %s",
+		"must be a date like %s":         "doit être une date comme %s",
+	},
+}
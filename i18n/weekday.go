@@ -0,0 +1,46 @@
+package i18n
+
+import (
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// the regions whose weeks don't start on Monday, from the CLDR week data
+var firstWeekdays = map[string]time.Weekday{
+	"AE": time.Saturday, "AF": time.Saturday, "BH": time.Saturday, "DJ": time.Saturday, "DZ": time.Saturday,
+	"EG": time.Saturday, "IQ": time.Saturday, "IR": time.Saturday, "JO": time.Saturday, "KW": time.Saturday,
+	"LY": time.Saturday, "OM": time.Saturday, "QA": time.Saturday, "SD": time.Saturday, "SY": time.Saturday,
+	"MV": time.Friday,
+
+	"AG": time.Sunday, "AS": time.Sunday, "BD": time.Sunday, "BR": time.Sunday, "BS": time.Sunday,
+	"BT": time.Sunday, "BW": time.Sunday, "BZ": time.Sunday, "CA": time.Sunday, "CN": time.Sunday,
+	"CO": time.Sunday, "DM": time.Sunday, "DO": time.Sunday, "ET": time.Sunday, "GT": time.Sunday,
+	"GU": time.Sunday, "HK": time.Sunday, "HN": time.Sunday, "ID": time.Sunday, "IL": time.Sunday,
+	"IN": time.Sunday, "JM": time.Sunday, "JP": time.Sunday, "KE": time.Sunday, "KH": time.Sunday,
+	"KR": time.Sunday, "LA": time.Sunday, "MH": time.Sunday, "MM": time.Sunday, "MO": time.Sunday,
+	"MT": time.Sunday, "MX": time.Sunday, "MZ": time.Sunday, "NI": time.Sunday, "NP": time.Sunday,
+	"PA": time.Sunday, "PE": time.Sunday, "PH": time.Sunday, "PK": time.Sunday, "PR": time.Sunday,
+	"PT": time.Sunday, "PY": time.Sunday, "SA": time.Sunday, "SG": time.Sunday, "SV": time.Sunday,
+	"TH": time.Sunday, "TT": time.Sunday, "TW": time.Sunday, "UM": time.Sunday, "US": time.Sunday,
+	"VE": time.Sunday, "VI": time.Sunday, "WS": time.Sunday, "YE": time.Sunday, "ZA": time.Sunday,
+	"ZW": time.Sunday,
+}
+
+// FirstWeekday returns the day that weeks start on in the region of a locale, such as Sunday for "en-US".
+// A locale without a region uses the region where its language is most spoken, so "en" starts on Sunday too,
+// and Monday is returned when the region isn't known.
+func FirstWeekday(locale string) time.Weekday {
+	tag, err := language.Parse(normalize(locale))
+	if err != nil {
+		return time.Monday
+	}
+	region, confidence := tag.Region()
+	if confidence == language.No {
+		return time.Monday
+	}
+	if d, ok := firstWeekdays[region.String()]; ok {
+		return d
+	}
+	return time.Monday
+}
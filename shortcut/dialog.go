@@ -0,0 +1,103 @@
+package shortcut
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+	xwidget "fyne.io/x/fyne/widget"
+)
+
+// NewDialog creates a "Keyboard Shortcuts" dialog that lists the actions of the manager by category.
+// The list can be searched by name, category or shortcut, and tapping a shortcut records a new one for its action.
+// You should call Show on the returned dialog to display it.
+func (m *Manager) NewDialog(w fyne.Window) dialog.Dialog {
+	d := dialog.NewCustom(i18n.L("Keyboard Shortcuts"), i18n.L("Close"), m.dialogContent(), w)
+	d.Resize(fyne.NewSize(480, 520))
+
+	return d
+}
+
+// ShowDialog opens a "Keyboard Shortcuts" dialog that lists the actions of the manager by category.
+func (m *Manager) ShowDialog(w fyne.Window) {
+	m.NewDialog(w).Show()
+}
+
+func (m *Manager) dialogContent() fyne.CanvasObject {
+	list := container.NewVBox()
+	search := widget.NewEntry()
+	search.SetPlaceHolder(i18n.L("Search shortcuts"))
+	search.OnChanged = func(query string) {
+		list.Objects = m.dialogRows(query)
+		list.Refresh()
+	}
+	reset := widget.NewButton(i18n.L("Reset"), func() {
+		m.ResetAll()
+		search.OnChanged(search.Text)
+	})
+	search.OnChanged("")
+
+	return container.NewBorder(search, container.NewHBox(layout.NewSpacer(), reset), nil, nil,
+		container.NewVScroll(list))
+}
+
+// dialogRows returns a heading for each category followed by the actions in it that match a query.
+func (m *Manager) dialogRows(query string) []fyne.CanvasObject {
+	query = strings.ToLower(strings.TrimSpace(query))
+	var categories []string
+	matches := make(map[string][]*Action)
+	for _, a := range m.Actions() {
+		if query != "" && !m.matches(a, query) {
+			continue
+		}
+		if _, ok := matches[a.Category]; !ok {
+			categories = append(categories, a.Category)
+		}
+		matches[a.Category] = append(matches[a.Category], a)
+	}
+
+	var rows []fyne.CanvasObject
+	for _, category := range categories {
+		if category != "" {
+			rows = append(rows, widget.NewLabelWithStyle(category, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+		}
+		for _, a := range matches[category] {
+			rows = append(rows, container.NewBorder(nil, nil, nil, m.recorder(a), widget.NewLabel(a.Name)))
+		}
+	}
+	if len(rows) == 0 {
+		rows = append(rows, widget.NewLabelWithStyle(i18n.L("No shortcuts found"), fyne.TextAlignCenter, fyne.TextStyle{}))
+	}
+	return rows
+}
+
+func (m *Manager) matches(a *Action, query string) bool {
+	if strings.Contains(strings.ToLower(a.Name), query) || strings.Contains(strings.ToLower(a.Category), query) {
+		return true
+	}
+	s := m.Shortcut(a.ID)
+	return s != nil && strings.Contains(strings.ToLower(xwidget.ShortcutLabel(s)), query)
+}
+
+// recorder returns a HotkeyRecorder that rebinds an action, rejecting shortcuts bound to other actions.
+func (m *Manager) recorder(a *Action) *xwidget.HotkeyRecorder {
+	r := xwidget.NewHotkeyRecorder(func(s *desktop.CustomShortcut) {
+		if err := m.Bind(a.ID, s); err != nil {
+			fyne.LogError("Error binding shortcut", err)
+		}
+	})
+	r.Shortcut = m.Shortcut(a.ID)
+	r.Validator = func(s *desktop.CustomShortcut) error {
+		if other := m.Conflict(a.ID, s); other != nil {
+			return &ConflictError{Shortcut: s, Action: other}
+		}
+		return nil
+	}
+	return r
+}
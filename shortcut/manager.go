@@ -0,0 +1,305 @@
+// Package shortcut keeps a registry of the named actions of an app and the keyboard shortcuts that trigger them,
+// which users can rebind and browse in a "Keyboard Shortcuts" dialog.
+package shortcut // import "fyne.io/x/fyne/shortcut"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+
+	xwidget "fyne.io/x/fyne/widget"
+)
+
+// ErrDuplicateAction is returned when an action is registered with the ID of another one.
+var ErrDuplicateAction = errors.New("an action with this ID is already registered")
+
+// ErrUnknownAction is returned for an ID that no action is registered with.
+var ErrUnknownAction = errors.New("no action is registered with this ID")
+
+// the preference value for an action whose shortcut the user removed
+const unboundPreference = "none"
+
+// Action is a named command of an app that can be triggered by a keyboard shortcut.
+type Action struct {
+	// ID identifies the action in the preferences, such as "file.save", it should not change between releases.
+	ID string
+	// Name is shown in the shortcuts dialog, such as "Save".
+	Name string
+	// Category groups actions in the shortcuts dialog, such as "File".
+	Category string
+	// Default is the shortcut used until the user rebinds the action, or nil for none.
+	Default *desktop.CustomShortcut
+
+	Run func() `json:"-"`
+}
+
+// ConflictError is returned when a shortcut is already bound to another action.
+type ConflictError struct {
+	Shortcut *desktop.CustomShortcut
+	// Action is the one that the shortcut is bound to.
+	Action *Action
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s is already used by %q", xwidget.ShortcutLabel(e.Shortcut), e.Action.Name)
+}
+
+// Manager registers the actions of an app with their shortcuts, and adds the shortcuts to canvases so that they
+// trigger the actions. Shortcuts rebound by the user are saved in the app preferences and restored when the
+// actions are registered again.
+type Manager struct {
+	// OnChanged is called when the shortcut of an action changes, s is nil if it was removed.
+	OnChanged func(a *Action, s *desktop.CustomShortcut) `json:"-"`
+
+	lock     sync.RWMutex
+	prefix   string
+	actions  []*Action
+	bindings map[string]*desktop.CustomShortcut
+	canvases []fyne.Canvas
+}
+
+// NewManager creates a new manager that saves shortcuts in the app preferences under keys starting with prefix.
+func NewManager(prefix string) *Manager {
+	return &Manager{prefix: prefix, bindings: make(map[string]*desktop.CustomShortcut)}
+}
+
+// Action returns the action registered with an ID, or nil.
+func (m *Manager) Action(id string) *Action {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.action(id)
+}
+
+// Actions returns the registered actions in the order they were registered.
+func (m *Manager) Actions() []*Action {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return append([]*Action(nil), m.actions...)
+}
+
+// AddToCanvas adds the shortcuts to a canvas, such as that of the main window, so that they trigger their actions.
+// Later changes to the shortcuts are applied to it as well.
+func (m *Manager) AddToCanvas(c fyne.Canvas) {
+	m.lock.Lock()
+	m.canvases = append(m.canvases, c)
+	bound := make(map[*Action]*desktop.CustomShortcut, len(m.bindings))
+	for _, a := range m.actions {
+		if s := m.bindings[a.ID]; s != nil {
+			bound[a] = s
+		}
+	}
+	m.lock.Unlock()
+
+	for a, s := range bound {
+		addShortcut(c, a, s)
+	}
+}
+
+// Bind changes the shortcut of an action and saves it in the preferences, nil removes it.
+// A shortcut bound to another action returns a *ConflictError and is not changed.
+func (m *Manager) Bind(id string, s *desktop.CustomShortcut) error {
+	m.lock.Lock()
+	a := m.action(id)
+	if a == nil {
+		m.lock.Unlock()
+		return ErrUnknownAction
+	}
+	if other := m.boundTo(s, id); other != nil {
+		m.lock.Unlock()
+		return &ConflictError{Shortcut: s, Action: other}
+	}
+	old := m.bindings[id]
+	m.bindings[id] = s
+	canvases := append([]fyne.Canvas(nil), m.canvases...)
+	m.lock.Unlock()
+
+	m.save(a, s)
+	m.apply(canvases, a, old, s)
+	return nil
+}
+
+// Conflict returns the action other than the one with an ID that a shortcut is bound to, or nil.
+// It suits validating a shortcut before it is bound, such as in a HotkeyRecorder.
+func (m *Manager) Conflict(id string, s *desktop.CustomShortcut) *Action {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.boundTo(s, id)
+}
+
+// Lookup returns the action that a shortcut is bound to, or nil.
+func (m *Manager) Lookup(s fyne.KeyboardShortcut) *Action {
+	if s == nil {
+		return nil
+	}
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.boundTo(&desktop.CustomShortcut{KeyName: s.Key(), Modifier: s.Mod()}, "")
+}
+
+// Register adds an action with the shortcut saved in the preferences, or else its default.
+// If the shortcut is already bound to another action the new one is registered without a shortcut
+// and a *ConflictError is returned.
+func (m *Manager) Register(a *Action) error {
+	m.lock.Lock()
+	if m.action(a.ID) != nil {
+		m.lock.Unlock()
+		return ErrDuplicateAction
+	}
+	m.actions = append(m.actions, a)
+	s := m.load(a)
+	var err error
+	if other := m.boundTo(s, a.ID); other != nil {
+		err = &ConflictError{Shortcut: s, Action: other}
+		s = nil
+	}
+	m.bindings[a.ID] = s
+	canvases := append([]fyne.Canvas(nil), m.canvases...)
+	m.lock.Unlock()
+
+	m.apply(canvases, a, nil, s)
+	return err
+}
+
+// Reset restores the default shortcut of an action, unless another action is bound to it.
+func (m *Manager) Reset(id string) error {
+	a := m.Action(id)
+	if a == nil {
+		return ErrUnknownAction
+	}
+	if err := m.Bind(id, a.Default); err != nil {
+		return err
+	}
+	fyne.CurrentApp().Preferences().RemoveValue(m.prefix + id)
+	return nil
+}
+
+// ResetAll restores the default shortcuts of all actions.
+func (m *Manager) ResetAll() {
+	actions := m.Actions()
+	for _, a := range actions {
+		// clear first so that defaults which were swapped around don't conflict
+		if err := m.Bind(a.ID, nil); err != nil {
+			fyne.LogError("Error clearing shortcut", err)
+		}
+	}
+	for _, a := range actions {
+		if err := m.Reset(a.ID); err != nil {
+			fyne.LogError("Error resetting shortcut", err)
+		}
+	}
+}
+
+// Shortcut returns the shortcut bound to an action, or nil.
+func (m *Manager) Shortcut(id string) *desktop.CustomShortcut {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.bindings[id]
+}
+
+// Trigger runs the action registered with an ID.
+func (m *Manager) Trigger(id string) {
+	if a := m.Action(id); a != nil && a.Run != nil {
+		a.Run()
+	}
+}
+
+func (m *Manager) action(id string) *Action {
+	for _, a := range m.actions {
+		if a.ID == id {
+			return a
+		}
+	}
+	return nil
+}
+
+func (m *Manager) apply(canvases []fyne.Canvas, a *Action, old, s *desktop.CustomShortcut) {
+	if sameShortcut(old, s) {
+		return
+	}
+	for _, c := range canvases {
+		if old != nil {
+			c.RemoveShortcut(old)
+		}
+		if s != nil {
+			addShortcut(c, a, s)
+		}
+	}
+	if f := m.OnChanged; f != nil {
+		f(a, s)
+	}
+}
+
+// boundTo returns the action other than the one with an ID that a shortcut is bound to, or nil.
+func (m *Manager) boundTo(s *desktop.CustomShortcut, id string) *Action {
+	if s == nil {
+		return nil
+	}
+	for _, a := range m.actions {
+		if a.ID != id && sameShortcut(m.bindings[a.ID], s) {
+			return a
+		}
+	}
+	return nil
+}
+
+// load returns the shortcut of an action saved in the preferences, or its default.
+func (m *Manager) load(a *Action) *desktop.CustomShortcut {
+	saved := fyne.CurrentApp().Preferences().String(m.prefix + a.ID)
+	if saved == "" {
+		return a.Default
+	}
+	if saved == unboundPreference {
+		return nil
+	}
+	s, err := parseShortcut(saved)
+	if err != nil {
+		fyne.LogError("Error reading saved shortcut of "+a.ID, err)
+		return a.Default
+	}
+	return s
+}
+
+func (m *Manager) save(a *Action, s *desktop.CustomShortcut) {
+	value := unboundPreference
+	if s != nil {
+		value = formatShortcut(s)
+	}
+	fyne.CurrentApp().Preferences().SetString(m.prefix+a.ID, value)
+}
+
+func addShortcut(c fyne.Canvas, a *Action, s *desktop.CustomShortcut) {
+	c.AddShortcut(s, func(fyne.Shortcut) {
+		if a.Run != nil {
+			a.Run()
+		}
+	})
+}
+
+// formatShortcut returns a stable text for a shortcut to save, such as "3+K" for Shift+Control+K.
+func formatShortcut(s *desktop.CustomShortcut) string {
+	return strconv.Itoa(int(s.Modifier)) + "+" + string(s.KeyName)
+}
+
+func parseShortcut(text string) (*desktop.CustomShortcut, error) {
+	mod, key, ok := strings.Cut(text, "+")
+	if !ok || key == "" {
+		return nil, fmt.Errorf("malformed shortcut %q", text)
+	}
+	m, err := strconv.Atoi(mod)
+	if err != nil {
+		return nil, fmt.Errorf("malformed shortcut %q: %w", text, err)
+	}
+	return &desktop.CustomShortcut{KeyName: fyne.KeyName(key), Modifier: fyne.KeyModifier(m)}, nil
+}
+
+func sameShortcut(a, b *desktop.CustomShortcut) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.KeyName == b.KeyName && a.Modifier == b.Modifier
+}
@@ -0,0 +1,102 @@
+package shortcut
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	ctrlS = &desktop.CustomShortcut{KeyName: fyne.KeyS, Modifier: fyne.KeyModifierControl}
+	ctrlO = &desktop.CustomShortcut{KeyName: fyne.KeyO, Modifier: fyne.KeyModifierControl}
+)
+
+func TestManager_Register(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := NewManager("shortcut.")
+	save := &Action{ID: "file.save", Name: "Save", Category: "File", Default: ctrlS}
+	assert.NoError(t, m.Register(save))
+	assert.Equal(t, ErrDuplicateAction, m.Register(&Action{ID: "file.save"}))
+	assert.Equal(t, ctrlS, m.Shortcut("file.save"))
+	assert.Equal(t, save, m.Lookup(&desktop.CustomShortcut{KeyName: fyne.KeyS, Modifier: fyne.KeyModifierControl}))
+
+	err := m.Register(&Action{ID: "file.store", Name: "Store", Default: ctrlS})
+	conflict, ok := err.(*ConflictError)
+	assert.True(t, ok)
+	assert.Equal(t, save, conflict.Action)
+	assert.Equal(t, `Ctrl+S is already used by "Save"`, err.Error())
+	assert.Nil(t, m.Shortcut("file.store"))
+	assert.Len(t, m.Actions(), 2)
+}
+
+func TestManager_Bind(t *testing.T) {
+	a := test.NewApp()
+	defer test.NewApp()
+
+	m := NewManager("shortcut.")
+	assert.NoError(t, m.Register(&Action{ID: "file.save", Name: "Save", Default: ctrlS}))
+	assert.NoError(t, m.Register(&Action{ID: "file.open", Name: "Open", Default: ctrlO}))
+	assert.Equal(t, ErrUnknownAction, m.Bind("file.close", ctrlS))
+	assert.IsType(t, &ConflictError{}, m.Bind("file.open", ctrlS))
+
+	ctrlP := &desktop.CustomShortcut{KeyName: fyne.KeyP, Modifier: fyne.KeyModifierControl | fyne.KeyModifierShift}
+	assert.NoError(t, m.Bind("file.open", ctrlP))
+	assert.NoError(t, m.Bind("file.save", nil))
+	assert.Equal(t, "3+P", a.Preferences().String("shortcut.file.open"))
+
+	restored := NewManager("shortcut.")
+	assert.NoError(t, restored.Register(&Action{ID: "file.save", Name: "Save", Default: ctrlS}))
+	assert.NoError(t, restored.Register(&Action{ID: "file.open", Name: "Open", Default: ctrlO}))
+	assert.Nil(t, restored.Shortcut("file.save"))
+	assert.Equal(t, ctrlP, restored.Shortcut("file.open"))
+
+	restored.ResetAll()
+	assert.Equal(t, ctrlS, restored.Shortcut("file.save"))
+	assert.Equal(t, ctrlO, restored.Shortcut("file.open"))
+	assert.Equal(t, "", a.Preferences().String("shortcut.file.open"))
+}
+
+func TestManager_AddToCanvas(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	saved := 0
+	m := NewManager("shortcut.")
+	assert.NoError(t, m.Register(&Action{ID: "file.save", Name: "Save", Default: ctrlS, Run: func() { saved++ }}))
+	c := test.NewCanvas()
+	m.AddToCanvas(c)
+	typed := c.(fyne.Shortcutable)
+
+	typed.TypedShortcut(ctrlS)
+	assert.Equal(t, 1, saved)
+
+	assert.NoError(t, m.Bind("file.save", ctrlO))
+	typed.TypedShortcut(ctrlS)
+	assert.Equal(t, 1, saved, "the old shortcut should be removed")
+	typed.TypedShortcut(ctrlO)
+	assert.Equal(t, 2, saved)
+
+	m.Trigger("file.save")
+	assert.Equal(t, 3, saved)
+}
+
+func TestManager_DialogRows(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := NewManager("shortcut.")
+	assert.NoError(t, m.Register(&Action{ID: "file.save", Name: "Save", Category: "File", Default: ctrlS}))
+	assert.NoError(t, m.Register(&Action{ID: "file.open", Name: "Open", Category: "File", Default: ctrlO}))
+	assert.NoError(t, m.Register(&Action{ID: "view.zoom", Name: "Zoom In", Category: "View"}))
+
+	assert.Len(t, m.dialogRows(""), 5)
+	assert.Len(t, m.dialogRows("zoom"), 2)
+	assert.Len(t, m.dialogRows("ctrl+o"), 2)
+	assert.Len(t, m.dialogRows("file"), 3)
+	assert.Len(t, m.dialogRows("nothing"), 1)
+}
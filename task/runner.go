@@ -0,0 +1,112 @@
+package task
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// Runner runs submitted tasks in the background, queuing those that exceed its number of workers.
+type Runner struct {
+	workers int
+	tasks   binding.UntypedList
+
+	lock    sync.Mutex
+	queue   []*Task
+	running int
+}
+
+// NewRunner returns a runner that runs up to workers tasks at once, or one if workers is less than 1.
+func NewRunner(workers int) *Runner {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Runner{workers: workers, tasks: binding.NewUntypedList()}
+}
+
+// CancelAll cancels the tasks that are queued or running.
+func (r *Runner) CancelAll() {
+	for _, t := range r.list() {
+		if !t.State().Done() {
+			t.Cancel()
+		}
+	}
+}
+
+// ClearFinished removes the tasks that have finished from the list of tasks.
+func (r *Runner) ClearFinished() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var kept []interface{}
+	for _, t := range r.list() {
+		if !t.State().Done() {
+			kept = append(kept, t)
+		}
+	}
+	if err := r.tasks.Set(kept); err != nil {
+		fyne.LogError("Error setting current data value", err)
+	}
+}
+
+// Submit adds a job to the runner, starting it when a worker is free.
+// The task stays in the list of tasks after it finishes, until ClearFinished is called.
+func (r *Runner) Submit(name string, job Job) *Task {
+	t := newTask(r, name, job)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := r.tasks.Append(t); err != nil {
+		fyne.LogError("Error setting current data value", err)
+	}
+	if r.running < r.workers {
+		r.start(t)
+	} else {
+		r.queue = append(r.queue, t)
+	}
+	return t
+}
+
+// Tasks is the list of submitted tasks, as *Task values, in the order they were submitted.
+func (r *Runner) Tasks() binding.UntypedList {
+	return r.tasks
+}
+
+// dequeue removes a task that has not started from the queue, returning whether it was queued.
+func (r *Runner) dequeue(t *Task) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for i, queued := range r.queue {
+		if queued == t {
+			r.queue = append(r.queue[:i], r.queue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Runner) list() []*Task {
+	items, _ := r.tasks.Get()
+	tasks := make([]*Task, len(items))
+	for i, item := range items {
+		tasks[i] = item.(*Task)
+	}
+	return tasks
+}
+
+// start runs a task in a new goroutine, starting the next queued task when it finishes.
+// The lock must be held.
+func (r *Runner) start(t *Task) {
+	r.running++
+	go func() {
+		t.run()
+
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.running--
+		if len(r.queue) > 0 {
+			next := r.queue[0]
+			r.queue = r.queue[1:]
+			r.start(next)
+		}
+	}()
+}
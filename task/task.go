@@ -0,0 +1,224 @@
+// Package task runs background jobs that report their progress through data bindings,
+// so that apps can show them and let users cancel them.
+package task // import "fyne.io/x/fyne/task"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// ErrCancelled is the error of a task that was cancelled.
+var ErrCancelled = errors.New("task was cancelled")
+
+// State is the stage of a task, from waiting in the queue to done.
+type State int
+
+const (
+	// Queued tasks are waiting for a runner to start them.
+	Queued State = iota
+	// Running tasks have started and not yet returned.
+	Running
+	// Succeeded tasks returned no error.
+	Succeeded
+	// Failed tasks returned an error or panicked.
+	Failed
+	// Cancelled tasks were cancelled before they started or returned.
+	Cancelled
+)
+
+// Done returns whether a task in this state has finished.
+func (s State) Done() bool {
+	return s >= Succeeded
+}
+
+// String returns the name of the state.
+func (s State) String() string {
+	switch s {
+	case Queued:
+		return "queued"
+	case Running:
+		return "running"
+	case Succeeded:
+		return "succeeded"
+	case Failed:
+		return "failed"
+	case Cancelled:
+		return "cancelled"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// Job is the work of a task. It should return soon after ctx is cancelled,
+// and report its progress with the task while it runs.
+type Job func(ctx context.Context, t *Task) error
+
+// Task is a job submitted to a Runner. It is a data item whose listeners are notified
+// when its state changes, and its progress and status are bindings that the job updates.
+type Task struct {
+	name     string
+	job      Job
+	progress binding.Float
+	status   binding.String
+	changed  binding.Int
+
+	lock   sync.Mutex
+	state  State
+	err    error
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	runner *Runner
+}
+
+var _ binding.DataItem = (*Task)(nil)
+
+func newTask(r *Runner, name string, job Job) *Task {
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &Task{name: name, job: job, progress: binding.NewFloat(), status: binding.NewString(),
+		changed: binding.NewInt(), ctx: ctx, cancel: cancel, done: make(chan struct{}), runner: r}
+	_ = t.progress.Set(-1)
+	return t
+}
+
+// AddListener adds a listener that is called when the state of the task changes.
+//
+// Implements: binding.DataItem
+func (t *Task) AddListener(l binding.DataListener) {
+	t.changed.AddListener(l)
+}
+
+// Cancel stops the task. A queued task is removed from the queue,
+// and the context of a running task is cancelled.
+func (t *Task) Cancel() {
+	t.lock.Lock()
+	state := t.state
+	t.lock.Unlock()
+	t.cancel()
+	if state == Queued && t.runner.dequeue(t) {
+		t.finish(ErrCancelled)
+	}
+}
+
+// Err returns the error of a task that failed or was cancelled, or nil.
+func (t *Task) Err() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.err
+}
+
+// Name returns the name the task was submitted with.
+func (t *Task) Name() string {
+	return t.name
+}
+
+// OnDone calls f when the task finishes, or soon if it already has.
+// Like data listeners, f is called in order with other binding updates, so it may update widgets.
+func (t *Task) OnDone(f func(err error)) {
+	var once sync.Once
+	var l binding.DataListener
+	l = binding.NewDataListener(func() {
+		if !t.State().Done() {
+			return
+		}
+		once.Do(func() {
+			t.changed.RemoveListener(l)
+			f(t.Err())
+		})
+	})
+	t.changed.AddListener(l)
+}
+
+// Progress is the fraction of the job that is done, from 0 to 1.
+// It is -1 until the job reports its progress, while the progress is unknown.
+func (t *Task) Progress() binding.Float {
+	return t.progress
+}
+
+// RemoveListener removes a listener added with AddListener.
+//
+// Implements: binding.DataItem
+func (t *Task) RemoveListener(l binding.DataListener) {
+	t.changed.RemoveListener(l)
+}
+
+// SetProgress reports the fraction of the job that is done, from 0 to 1.
+func (t *Task) SetProgress(p float64) {
+	if err := t.progress.Set(p); err != nil {
+		fyne.LogError("Error setting current data value", err)
+	}
+}
+
+// SetStatus reports what the job is doing, to be shown to the user.
+func (t *Task) SetStatus(s string) {
+	if err := t.status.Set(s); err != nil {
+		fyne.LogError("Error setting current data value", err)
+	}
+}
+
+// State returns the stage the task is at.
+func (t *Task) State() State {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.state
+}
+
+// Status is a description of what the job is doing, set by the job.
+func (t *Task) Status() binding.String {
+	return t.status
+}
+
+// Wait blocks until the task finishes, returning its error.
+func (t *Task) Wait() error {
+	<-t.done
+	return t.Err()
+}
+
+func (t *Task) run() {
+	if t.ctx.Err() != nil { // cancelled as it was leaving the queue
+		t.finish(ErrCancelled)
+		return
+	}
+	t.setState(Running, nil)
+	err := t.call()
+	if err != nil && t.ctx.Err() != nil {
+		err = ErrCancelled
+	}
+	t.finish(err)
+}
+
+func (t *Task) call() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("task %q panicked: %v", t.name, r)
+		}
+	}()
+	return t.job(t.ctx, t)
+}
+
+func (t *Task) finish(err error) {
+	switch {
+	case err == nil:
+		t.SetProgress(1)
+		t.setState(Succeeded, nil)
+	case errors.Is(err, ErrCancelled):
+		t.setState(Cancelled, err)
+	default:
+		t.setState(Failed, err)
+	}
+	t.cancel()
+	close(t.done)
+}
+
+func (t *Task) setState(s State, err error) {
+	t.lock.Lock()
+	t.state, t.err = s, err
+	t.lock.Unlock()
+	if err := t.changed.Set(int(s)); err != nil {
+		fyne.LogError("Error setting current data value", err)
+	}
+}
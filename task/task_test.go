@@ -0,0 +1,102 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func waitForBinding() {
+	time.Sleep(time.Millisecond * 100) // data resolves on background thread
+}
+
+// waitForDone returns the error passed to an OnDone callback that sends it on done.
+func waitForDone(t *testing.T, done <-chan error) error {
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(time.Second):
+		t.Fatal("OnDone was not called")
+		return nil
+	}
+}
+
+func TestRunner_Submit(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	r := NewRunner(2)
+	release := make(chan struct{})
+	job := func(ctx context.Context, t *Task) error {
+		t.SetStatus("working")
+		t.SetProgress(.5)
+		<-release
+		return nil
+	}
+	a, b, c := r.Submit("a", job), r.Submit("b", job), r.Submit("c", job)
+	assert.Equal(t, 3, r.Tasks().Length())
+	waitForBinding()
+	assert.Equal(t, Running, a.State())
+	assert.Equal(t, Running, b.State())
+	assert.Equal(t, Queued, c.State())
+	status, _ := a.Status().Get()
+	assert.Equal(t, "working", status)
+	progress, _ := c.Progress().Get()
+	assert.Equal(t, -1.0, progress)
+
+	done := make(chan error, 2)
+	a.OnDone(func(err error) { done <- err })
+	close(release)
+	assert.NoError(t, c.Wait())
+	assert.NoError(t, a.Wait())
+	assert.NoError(t, waitForDone(t, done))
+	waitForBinding()
+	assert.Len(t, done, 0, "OnDone should be called once")
+	assert.Equal(t, Succeeded, c.State())
+	progress, _ = c.Progress().Get()
+	assert.Equal(t, 1.0, progress)
+
+	r.ClearFinished()
+	assert.Equal(t, 0, r.Tasks().Length())
+}
+
+func TestRunner_Cancel(t *testing.T) {
+	r := NewRunner(1)
+	running := r.Submit("running", func(ctx context.Context, t *Task) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	queued := r.Submit("queued", func(ctx context.Context, t *Task) error {
+		return errors.New("should not run")
+	})
+
+	queued.Cancel()
+	assert.Equal(t, ErrCancelled, queued.Wait())
+	assert.Equal(t, Cancelled, queued.State())
+
+	r.CancelAll()
+	assert.Equal(t, ErrCancelled, running.Wait())
+	assert.Equal(t, Cancelled, running.State())
+}
+
+func TestRunner_Failed(t *testing.T) {
+	r := NewRunner(0)
+	failed := r.Submit("failed", func(context.Context, *Task) error {
+		return errors.New("disk full")
+	})
+	assert.EqualError(t, failed.Wait(), "disk full")
+	assert.Equal(t, Failed, failed.State())
+
+	panicked := r.Submit("panicked", func(context.Context, *Task) error {
+		panic("oops")
+	})
+	assert.EqualError(t, panicked.Wait(), `task "panicked" panicked: oops`)
+
+	done := make(chan error, 1)
+	failed.OnDone(func(err error) { done <- err })
+	assert.EqualError(t, waitForDone(t, done), "disk full")
+}
@@ -0,0 +1,185 @@
+package theme
+
+import (
+	"errors"
+	"image/color"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	portalDestination   = "org.freedesktop.portal.Desktop"
+	portalPath          = "/org/freedesktop/portal/desktop"
+	portalSettings      = "org.freedesktop.portal.Settings"
+	appearanceNamespace = "org.freedesktop.appearance"
+	accentColorKey      = "accent-color"
+)
+
+// AccentPollInterval is how often WatchAccentColor reads the accent color where the settings portal
+// can't send its changes.
+var AccentPollInterval = 5 * time.Second
+
+// ErrNoAccentColor is returned where the user has not chosen an accent color, or the desktop has none.
+var ErrNoAccentColor = errors.New("no accent color is set")
+
+// AdwaitaAccent returns a new Adwaita theme that uses accent for its primary, selection and hyperlink colors,
+// such as the accent color chosen in the settings of GNOME, returned by AccentColor.
+func AdwaitaAccent(accent color.Color) fyne.Theme {
+	return &Adwaita{accent: accent}
+}
+
+// AccentColor returns the accent color that the user has chosen, read from the settings of
+// the xdg-desktop-portal over D-Bus, as on GNOME 44 and later.
+func AccentColor() (color.Color, error) {
+	conn, err := dbus.SessionBus()
+	if err != nil {
+		return nil, err
+	}
+	return readAccentColor(conn)
+}
+
+// WatchAccentColor calls changed with the accent color each time the user chooses another, until stop is called.
+// It subscribes to the changes sent by the settings portal, or reads the color every AccentPollInterval if
+// they can't be subscribed to. Use it with AdwaitaAccent to follow the accent color while the app runs:
+//
+//	stop, err := theme.WatchAccentColor(func(c color.Color) {
+//		a.Settings().SetTheme(theme.AdwaitaAccent(c))
+//	})
+func WatchAccentColor(changed func(color.Color)) (stop func(), err error) {
+	conn, err := dbus.SessionBus()
+	if err != nil {
+		return nil, err
+	}
+
+	match := []dbus.MatchOption{
+		dbus.WithMatchObjectPath(portalPath),
+		dbus.WithMatchInterface(portalSettings),
+		dbus.WithMatchMember("SettingChanged"),
+		dbus.WithMatchArg(0, appearanceNamespace),
+	}
+	if err = conn.AddMatchSignal(match...); err != nil {
+		return pollAccentColor(conn, changed), nil
+	}
+	signals := make(chan *dbus.Signal, 4)
+	conn.Signal(signals)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case s := <-signals:
+				if s.Name != portalSettings+".SettingChanged" || len(s.Body) < 3 || s.Body[1] != accentColorKey {
+					continue
+				}
+				if v, ok := s.Body[2].(dbus.Variant); ok {
+					if c, ok := parseAccentColor(v); ok {
+						changed(c)
+					}
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			conn.RemoveSignal(signals)
+			_ = conn.RemoveMatchSignal(match...)
+			close(done)
+		})
+	}, nil
+}
+
+// pollAccentColor reads the accent color every AccentPollInterval, calling changed when it is different.
+func pollAccentColor(conn *dbus.Conn, changed func(color.Color)) (stop func()) {
+	ticker := time.NewTicker(AccentPollInterval)
+	done := make(chan struct{})
+	go func() {
+		last, _ := readAccentColor(conn)
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				c, err := readAccentColor(conn)
+				if err != nil || c == last {
+					continue
+				}
+				last = c
+				changed(c)
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+		})
+	}
+}
+
+func readAccentColor(conn *dbus.Conn) (color.Color, error) {
+	var value dbus.Variant
+	obj := conn.Object(portalDestination, portalPath)
+	err := obj.Call(portalSettings+".Read", 0, appearanceNamespace, accentColorKey).Store(&value)
+	if err != nil {
+		return nil, err
+	}
+	c, ok := parseAccentColor(value)
+	if !ok {
+		return nil, ErrNoAccentColor
+	}
+	return c, nil
+}
+
+// parseAccentColor returns the color of an accent-color setting, which holds red, green and blue between 0 and 1.
+// Other values mean that no accent color is set. The deprecated Read method of the portal
+// wraps the value in a second variant.
+func parseAccentColor(v dbus.Variant) (color.Color, bool) {
+	if inner, ok := v.Value().(dbus.Variant); ok {
+		v = inner
+	}
+	var rgb []float64
+	switch value := v.Value().(type) {
+	case []interface{}: // a struct of (ddd)
+		for _, c := range value {
+			f, ok := c.(float64)
+			if !ok {
+				return nil, false
+			}
+			rgb = append(rgb, f)
+		}
+	case []float64:
+		rgb = value
+	}
+	if len(rgb) != 3 {
+		return nil, false
+	}
+	for _, c := range rgb {
+		if c < 0 || c > 1 {
+			return nil, false
+		}
+	}
+	return color.NRGBA{R: uint8(rgb[0]*0xff + .5), G: uint8(rgb[1]*0xff + .5), B: uint8(rgb[2]*0xff + .5), A: 0xff}, true
+}
+
+// accentColor returns the color named for a theme with accent, if the accent replaces it.
+func accentColor(name fyne.ThemeColorName, accent color.Color) (color.Color, bool) {
+	switch name {
+	case theme.ColorNamePrimary, theme.ColorNameHyperlink:
+		return accent, true
+	case theme.ColorNameSelection:
+		c := color.NRGBAModel.Convert(accent).(color.NRGBA)
+		c.A = 0x40
+		return c, true
+	}
+	return nil, false
+}
@@ -0,0 +1,34 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2/theme"
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdwaitaAccent(t *testing.T) {
+	accent := color.NRGBA{R: 0xe6, G: 0x61, B: 0x00, A: 0xff}
+	th := AdwaitaAccent(accent)
+	assert.Equal(t, accent, th.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, accent, th.Color(theme.ColorNameHyperlink, theme.VariantDark))
+	assert.Equal(t, color.NRGBA{R: 0xe6, G: 0x61, B: 0x00, A: 0x40}, th.Color(theme.ColorNameSelection, theme.VariantDark))
+	assert.Equal(t, adwaitaDarkScheme[theme.ColorNameBackground], th.Color(theme.ColorNameBackground, theme.VariantDark))
+}
+
+func TestParseAccentColor(t *testing.T) {
+	c, ok := parseAccentColor(dbus.MakeVariant([]interface{}{1.0, 0.5, 0.0}))
+	assert.True(t, ok)
+	assert.Equal(t, color.NRGBA{R: 0xff, G: 0x80, B: 0x00, A: 0xff}, c)
+
+	c, ok = parseAccentColor(dbus.MakeVariant(dbus.MakeVariant([]interface{}{0.0, 0.0, 1.0})))
+	assert.True(t, ok)
+	assert.Equal(t, color.NRGBA{B: 0xff, A: 0xff}, c)
+
+	_, ok = parseAccentColor(dbus.MakeVariant([]interface{}{-1.0, -1.0, -1.0}))
+	assert.False(t, ok)
+	_, ok = parseAccentColor(dbus.MakeVariant("blue"))
+	assert.False(t, ok)
+}
@@ -5,23 +5,51 @@ import (
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/theme"
+
+	"fyne.io/x/fyne/theme/iconpack"
 )
 
 //go:generate go run ./adwaita_theme_generator.go
 
 var _ fyne.Theme = (*Adwaita)(nil)
 
+// adwaitaIcons are the bundled icons, which are decompressed as they are used.
+var adwaitaIcons = iconpack.NewBundled("Adwaita", adwaitaIconAssets)
+
 // Adwaita is a theme that follows the Adwaita theme. It provides a light and dark theme + icons.
 // See: https://gnome.pages.gitlab.gnome.org/libadwaita/doc/main/named-colors.html
-type Adwaita struct{}
+type Adwaita struct {
+	variant fyne.ThemeVariant
+	forced  bool        // whether variant replaces that of the app settings
+	accent  color.Color // replaces the accent colors of the scheme, if set
+}
 
 // AdwaitaTheme returns a new Adwaita theme.
 func AdwaitaTheme() fyne.Theme {
 	return &Adwaita{}
 }
 
+// AdwaitaIcons returns the icons of the Adwaita theme, to use them with other themes.
+func AdwaitaIcons() iconpack.IconPack {
+	return adwaitaIcons
+}
+
+// AdwaitaVariant returns a new Adwaita theme that always uses the light or dark variant,
+// whatever the variant of the app settings.
+func AdwaitaVariant(variant fyne.ThemeVariant) fyne.Theme {
+	return &Adwaita{variant: variant, forced: true}
+}
+
 // Color returns the named color for the current theme.
 func (a *Adwaita) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	if a.forced {
+		variant = a.variant
+	}
+	if a.accent != nil {
+		if c, ok := accentColor(name, a.accent); ok {
+			return c
+		}
+	}
 	switch variant {
 	case theme.VariantLight:
 		if c, ok := adwaitaLightScheme[name]; ok {
@@ -42,7 +70,7 @@ func (a *Adwaita) Font(style fyne.TextStyle) fyne.Resource {
 
 // Icon returns the named resource for the current theme.
 func (a *Adwaita) Icon(name fyne.ThemeIconName) fyne.Resource {
-	if icon, ok := adwaitaIcons[name]; ok {
+	if icon := adwaitaIcons.Icon(name); icon != nil {
 		return icon
 	}
 	return theme.DefaultTheme().Icon(name)
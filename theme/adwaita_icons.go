@@ -12,432 +12,520 @@ package theme
 import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/theme"
+
+	"fyne.io/x/fyne/theme/iconpack"
 )
 
-var adwaitaIcons = map[fyne.ThemeIconName]fyne.Resource{
-
-	theme.IconNameAccount: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "avatar-default-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 1 c -1.65625 0 -3 1.34375 -3 3 s 1.34375 3 3 3 s 3 -1.34375 3 -3 s -1.34375 -3 -3 -3 z m -1.5 7 c -2.492188 0 -4.5 2.007812 -4.5 4.5 v 0.5 c 0 1.109375 0.890625 2 2 2 h 8 c 1.109375 0 2 -0.890625 2 -2 v -0.5 c 0 -2.492188 -2.007812 -4.5 -4.5 -4.5 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameArrowDropDown: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "go-down-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 1 5 c 0 -0.265625 0.105469 -0.519531 0.292969 -0.707031 c 0.390625 -0.390625 1.023437 -0.390625 1.414062 0 l 5.292969 5.292969 l 5.292969 -5.292969 c 0.390625 -0.390625 1.023437 -0.390625 1.414062 0 c 0.1875 0.1875 0.292969 0.441406 0.292969 0.707031 s -0.105469 0.519531 -0.292969 0.707031 l -6 6 c -0.390625 0.390625 -1.023437 0.390625 -1.414062 0 l -6 -6 c -0.1875 -0.1875 -0.292969 -0.441406 -0.292969 -0.707031 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameArrowDropUp: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "go-up-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 1 11 c 0 -0.265625 0.105469 -0.519531 0.292969 -0.707031 l 6 -6 c 0.390625 -0.390625 1.023437 -0.390625 1.414062 0 l 6 6 c 0.1875 0.1875 0.292969 0.441406 0.292969 0.707031 s -0.105469 0.519531 -0.292969 0.707031 c -0.390625 0.390625 -1.023437 0.390625 -1.414062 0 l -5.292969 -5.292969 l -5.292969 5.292969 c -0.390625 0.390625 -1.023437 0.390625 -1.414062 0 c -0.1875 -0.1875 -0.292969 -0.441406 -0.292969 -0.707031 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameCancel: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "window-close-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 4 4 h 1 h 0.03125 c 0.253906 0.011719 0.511719 0.128906 0.6875 0.3125 l 2.28125 2.28125 l 2.3125 -2.28125 c 0.265625 -0.230469 0.445312 -0.304688 0.6875 -0.3125 h 1 v 1 c 0 0.285156 -0.035156 0.550781 -0.25 0.75 l -2.28125 2.28125 l 2.25 2.25 c 0.1875 0.1875 0.28125 0.453125 0.28125 0.71875 v 1 h -1 c -0.265625 0 -0.53125 -0.09375 -0.71875 -0.28125 l -2.28125 -2.28125 l -2.28125 2.28125 c -0.1875 0.1875 -0.453125 0.28125 -0.71875 0.28125 h -1 v -1 c 0 -0.265625 0.09375 -0.53125 0.28125 -0.71875 l 2.28125 -2.25 l -2.28125 -2.28125 c -0.210938 -0.195312 -0.304688 -0.46875 -0.28125 -0.75 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameCheckButton: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "checkbox-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"16px\" height=\"16px\" viewBox=\"0 0 16 16\" version=\"1.1\">\n<g id=\"surface27683\">\n<path style=\" stroke:none;fill-rule:nonzero;fill:rgb(18.039216%,20.392157%,20.392157%);fill-opacity:0.960784;\" d=\"M 3 0 C 1.355469 0 0 1.355469 0 3 L 0 13 C 0 14.644531 1.355469 16 3 16 L 13 16 C 14.644531 16 16 14.644531 16 13 L 16 3 C 16 1.355469 14.644531 0 13 0 Z M 3 2 L 13 2 C 13.570312 2 14 2.429688 14 3 L 14 13 C 14 13.570312 13.570312 14 13 14 L 3 14 C 2.429688 14 2 13.570312 2 13 L 2 3 C 2 2.429688 2.429688 2 3 2 Z M 3 2 \"/>\n</g>\n</svg>\n"),
-	}),
-
-	theme.IconNameCheckButtonChecked: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "checkbox-checked-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 3 0 c -1.644531 0 -3 1.355469 -3 3 v 10 c 0 1.644531 1.355469 3 3 3 h 10 c 1.644531 0 3 -1.355469 3 -3 v -10 c 0 -1.644531 -1.355469 -3 -3 -3 z m 0 2 h 10 c 0.421875 0 0.765625 0.234375 0.917969 0.585938 l -0.667969 0.757812 l -6.296875 7.195312 l -2.246094 -2.246093 c -0.390625 -0.390625 -1.023437 -0.390625 -1.414062 0 s -0.390625 1.027343 0 1.417969 l 3 3 c 0.410156 0.40625 1.078125 0.386718 1.460937 -0.050782 l 6.246094 -7.136718 v 7.476562 c 0 0.570312 -0.429688 1 -1 1 h -10 c -0.570312 0 -1 -0.429688 -1 -1 v -10 c 0 -0.570312 0.429688 -1 1 -1 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameColorPalette: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "applications-graphics-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 7.886719 0.00390625 c -2.109375 0.03124995 -4.945313 0.80078175 -4.898438 3.27734375 c 0.050781 2.589844 2.921875 1.664062 3.15625 2.71875 c 0.035157 -0.042969 0.085938 -0.082031 0.121094 -0.125 c 0.199219 -0.210938 0.394531 -0.386719 0.59375 -0.59375 c 0.3125 -0.351562 0.636719 -0.675781 0.964844 -1.03125 c 1.109375 -1.175781 1.15625 -1.25 3.800781 -3.25 c -1.035156 -0.617188 -2.261719 -0.9609375 -3.300781 -0.99609375 c -0.132813 -0.00390625 -0.292969 -0.00390625 -0.4375 0 z m 6.144531 0 c -1.796875 1.29687475 -4.203125 3.30078175 -6.113281 5.33203175 c -0.171875 0.183593 -0.339844 0.363281 -0.503907 0.546874 c -0.101562 0.109376 -0.210937 0.195313 -0.3125 0.308594 c -0.066406 0.078125 -0.128906 0.160156 -0.191406 0.238282 c -1.300781 1.4375 -2.582031 3.128906 -3.648437 4.683593 c -0.308594 -0.15625 -0.699219 -0.171875 -1.117188 0.101563 c -0.753906 0.496094 -0.597656 2.285156 -2.14843725 2.285156 c 0.14843725 0.601562 1.73437525 0.59375 2.80859425 0.324219 c 1.011718 -0.253907 1.324218 -1.140625 1.132812 -1.886719 c -0.03125 -0.105469 -0.078125 -0.210938 -0.144531 -0.320312 c 1.984375 -1.640626 3.386719 -3.003907 4.34375 -4.023438 c 0.242187 -0.246094 0.53125 -0.566406 0.851562 -0.925781 c 1.207031 -1.332031 2.925781 -3.433594 5.042969 -6.66406275 z m -8.042969 1.52734375 c 0.824219 0 1.492188 0.671875 1.492188 1.5 c 0 0.832031 -0.667969 1.5 -1.492188 1.5 c -0.828125 0 -1.5 -0.667969 -1.5 -1.5 c 0 -0.828125 0.671875 -1.5 1.5 -1.5 z m 7.265625 0.664062 c -1.417968 2.234376 -2.367187 3.554688 -3.367187 4.585938 c -0.640625 0.660156 -1.164063 1.3125 -1.625 1.78125 c -0.835938 0.890625 -1.9375 1.941406 -3.148438 3.09375 c 0.0625 1.46875 0.808594 2.746094 3.242188 3.21875 c 3.898437 0.757812 8.027343 -1.824219 7.613281 -7.0625 c -0.179688 -2.246094 -1.40625 -4.28125 -2.714844 -5.617188 z m 0.34375 3.804688 c 0.546875 0 0.996094 0.449219 0.996094 1 c 0 0.554688 -0.449219 1 -0.996094 1 s -0.996094 -0.445312 -0.996094 -1 c 0 -0.550781 0.449219 -1 0.996094 -1 z m -0.625 3 c 0.546875 0 0.996094 0.449219 0.996094 1 c 0 0.554688 -0.449219 1 -0.996094 1 s -0.996094 -0.445312 -0.996094 -1 c 0 -0.550781 0.449219 -1 0.996094 -1 z m -4.992187 2 c 0.550781 0 1 0.449219 1 1 s -0.449219 1 -1 1 c -0.546875 0 -0.996094 -0.449219 -0.996094 -1 s 0.449219 -1 0.996094 -1 z m 2.996093 0 c 0.546876 0 1 0.449219 1 1 s -0.453124 1 -1 1 c -0.546874 0 -0.996093 -0.449219 -0.996093 -1 s 0.449219 -1 0.996093 -1 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameComputer: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "computer-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 3 0 c -1.660156 0 -3 1.339844 -3 3 v 7 c 0 1.660156 1.339844 3 3 3 h 10 c 1.660156 0 3 -1.339844 3 -3 v -7 c 0 -1.660156 -1.339844 -3 -3 -3 z m 0 2 h 10 c 0.554688 0 1 0.445312 1 1 v 7 c 0 0.554688 -0.445312 1 -1 1 h -10 c -0.554688 0 -1 -0.445312 -1 -1 v -7 c 0 -0.554688 0.445312 -1 1 -1 z m 2 12 c -1.105469 0 -2 0.894531 -2 2 h 10 c 0 -1.105469 -0.894531 -2 -2 -2 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameConfirm: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "object-select-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 13.753906 4.660156 c 0.175782 -0.199218 0.261719 -0.460937 0.246094 -0.726562 c -0.019531 -0.265625 -0.140625 -0.511719 -0.339844 -0.6875 c -0.199218 -0.175782 -0.460937 -0.261719 -0.726562 -0.246094 c -0.265625 0.019531 -0.511719 0.140625 -0.6875 0.339844 l -6.296875 7.195312 l -2.242188 -2.242187 c -0.390625 -0.390625 -1.023437 -0.390625 -1.414062 0 c -0.1875 0.1875 -0.292969 0.441406 -0.292969 0.707031 s 0.105469 0.519531 0.292969 0.707031 l 3 3 c 0.195312 0.195313 0.464843 0.304688 0.738281 0.292969 c 0.277344 -0.007812 0.539062 -0.132812 0.722656 -0.339844 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameContentAdd: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "list-add-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 7 1 v 6 h -6 v 2 h 6 v 6 h 2 v -6 h 6 v -2 h -6 v -6 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
+// the icons are gzip compressed, see iconpack.NewBundled
+var adwaitaIconAssets = map[fyne.ThemeIconName]iconpack.Asset{
+
+	theme.IconNameAccount: {
+		Name:     "avatar-default-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffTP[n\xeb \x14\xfc\xf7*F\xe7~\x83\x0f`;\xe4\xca8R?\xba\x82v\x01\x95\xe3\xdaH~D\xb1\x05VW_\xd1(M*\x1eb\x86afD}ڧ\x11\xa1\xbb\xae~\x99\x1d)Ʉnn\x97\xb3\x9f{G\xefo\xaf\xc2ҩ\xc9\xea5\xf4\x18:\xdf\x0f\x9b#U]vB\xf0]|YvG\f\x86\xaa\xa0*B\xf4\xe7m\xb8\v\xf6i\x9cWGö]\xfe\xe7y\x8cQF#\x97k\x9fkf\xce\xd7\xd0S\x93\x01@}\xf9\xd8\x06\x9c\x1dM\xb0Ph!\x94\xac\xcaJ\x97`\b\x03%Ma\x0ee:\x1a\xac\xbf\xd0\xe0\x86\ră\x12\x89\x11O/n\xf3\vSbK\x1c\x92\xbb\x96\xc5Q+k\xc1\x10\x85,\xa1%\xf3\xc1*}Ci\x05\xb0,т\xa1\xa4\xe2c\xf2bi\x8f\x9c:\xe9\x9f1\xc0\xa2}\xba\x85\x86x\x92\b\x8d\x00q7y$\x8a\xbfY\x8f-5d0\xe1ӏ\xa3\xa3\x7f\xba3\x85\xa9(o\xb2:\xfdT\x93}\x0f\x00\xd5\xc1\xc8\x1a\xa8\x01\x00\x00",
+	},
+
+	theme.IconNameArrowDropDown: {
+		Name:     "go-down-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x9c\x91On\xb30\x10\xc5\xf7\x9c\xe2i\xbe\xb5\xcd\xd8\xc6&|\xc2D\xea\xa2'h\x0fP%\x14\x90\xf8\x13\x05\x04\xa8\xa7\xafH\t\xa1jW]\xcd\xe8\xa7y\xe3\xf7\xc6\xe9qnj\x8c\xf9\xb5\xaf\xba֓\x92L\xc8\xdbSw\xae\xda\xc2\xd3\xeb˳8\xd01\v\xd2~,P\xe6UQ\x0e\x9e\x94\xbb̄\xb1ʧ\xa7n\xf6\xc4`(\a\xe5\bSu\x1e\xca\xfb\xc0\xdc\xd4m\xef\xa9\x1c\x86\xcb\xff0\x9c\xa6INFv\xd7\"\xd4\xcc\x1c\xf6cAY\x00\x00\xe9\xe5m(q\xf6\xd4@\xc1\xe2\x04\x86`\xa9\x9duڂ\xa5b\x1b\xb9dAV%\xd6(\xb0ԉN\xbeP\xcc1\x1b\xb5h\xa4Ix\x11\x88\xadS\x92\xb5\x89L\xfc\rE*b\xa7\xc1\xa8a\xef{\xb6f\xc7\xc4\xd6\xfda\xf7\"Q\x87\xd8>ʺ\x8bet\x1bړ5B\x0f\xb1eݢ\x8a\x9fs5\x84\x83\xc3i\xf7\xf2\xc3\xdffk\x8fv\x91\x85\x83X\xb57c\xbb\xfa\xb8\xe9jQ\xfcr\xe7\x0f4`0ὪkO\xfftn\"\xe3(̂t\xf9\xd0,\xf8\x1c\x00\xfb\x1f\xf3\xb3O\x02\x00\x00",
+	},
+
+	theme.IconNameArrowDropUp: {
+		Name:     "go-up-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x9c\x91On\xb3@\f\xc5\xf7\x9c\xe2\xc9\xdfz\x06{\x06\x86\xf0\t\x88\xd4EO\xd0\x1e\xa0J( \xf1'\n\bPO_ARBծ\xba\xb2\xf5\x93\xdf\xf8=Or\x9c\x9b\x1ac~\xed\xab\xaeMI4\x13\xf2\xf6ԝ\xab\xb6H\xe9\xf5\xe5Y\x1d\xe8\x98yI?\x16(\xf3\xaa(\x87\x94\xc4]f\xc2X\xe5\xd3S7\xa7\xc4`\x88\x838\xc2T\x9d\x87\xf2k`n\xea\xb6O\xa9\x1c\x86\xcb\x7fߟ\xa6IOVw\xd7\xc27\xcc\xec\xf7cA\x99\a\x00\xc9\xe5m(qN\xa9\x81@\x04'0\x14k\xe3BgB\xb0\x16\x0e\x03\x17/(\x948\xb4\x02\xd6&6\xf1\rE\x1c\xb1\x15\xd4pPn\x91j\x1b\xf3\xa2S['\x9a\x8d\rl\xf4\r\x05\x12\xb03\xe0Uy\x13\xca!\n\x1f徂u\xb0\x8e\xee\xc9}g\x0f\xb5\x99ۼ\xa9\x9fs\xa7\xdd⇽\xcd\xd5\x1e\xed\\\xa9pK\xb9u{\xba5\x7fy~լAw\xf5q\xd4{d\xf5ˡ?Ѐ\xc1\x84\xf7\xaa\xaeS\xfagr\x1bXG~\xe6%˗f\xde\xe7\x00[ȮGQ\x02\x00\x00",
+	},
+
+	theme.IconNameCancel: {
+		Name:     "window-close-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfft\x92M\x8e\x9c0\x10\x85\xf7}\x8a\x92\xb3\xb6\xa9\xb2\xb11Q\xc3HY\xe4\x04\xc9\x01\xa2i\x02H\xfc\xb4\x06\x04(\xa7\x8f\xca\x1e\xe8!\x9dY\xf9\xf1\\\xf5\xfc\xb9\xcc\xf5e\xeb;X\xaa\xb7\xa9\x1d\x87B\x90B\x01\xd5\xf0:\xdeڡ.\xc4\xcf\x1fߥ\x17/\xe5\xe5:-54U[7s!\xc8\xdd7\x01K[\xad\xdfƭ\x10\b\b䀜\x80\xb5\xbd\xcd\xcd^\xb0\xf5\xdd0\x15\xa2\x99\xe7\xfb\xd7$Y\xd7U\xadF\x8dou\xa2\x111\x99\x96Z\x94\x17\x00\x80\xeb\xfd\xd7\xdc\xc0\xad\x10=\xa4\x90B\x03\x04\r\xa0BC\xda\xc2+\xa0\xd2\xd6\xe4\xe8\xd8\"\xca(\aTv\x17\xa4}\xdcr>\xb3\x80*\xf4t\xa0\x95\xf6\xac\xf6\x95\x9d\xb0%w'\xe4:\xeb\xd8C\xa5\r\xa6\x8e\xf3\xd2\xd4\x1a\xd2l\xb1\xe3\xfd\x9e,ߣ\x19n\x01\xe2v\x0e\xf0\x96\xac\xe3M4A\xa1\xb2\x163O!\x93y2>[\xfe\x0f'~F\x10\x8a\xf0\xefK\xacA\x15P>\x1aY(X\xc2|$3\xc8\xe3\x0e\xc8:\xd63Mn\"s\xec\x90x\x9c{\xa0H\xfd\xec=f#\xcfL\xf2\t\xe6\xc8ލ@\xb4D,\xfc\b\xf6\x80\xf9$\xe1\xf1ZR\xab3\x90<\x11i\xc2\xdcxV\x94\xff\xf3J\f\xe8NW\x95a\xf6\x7f\xa0\a\x04\x14\xf0\xbb\xed\xbaB|ѕI\x8d\x13Iy\xb9\xf2\x0fX^\xfe\x0e\x00\x1dq\x82\xcf\xff\x02\x00\x00",
+	},
+
+	theme.IconNameCheckButton: {
+		Name:     "checkbox-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x8eA\x8f\x9b0\x10\x85\xef\xf9\x15\xa3\x91*\xb5R\xd7xl\xe3\x00\x1b\xb2R\x91zJo\xed\xa5\xb74\xf1\x1akY\x88\xc0\vl\x7f}eG\x1b\x88T\t\xd9\uf37fy\xbc\xdd\xd3\xfc\xda\xc0h\xfa\xc1um\x89\xc48\x82iO\xddٵ\xb6\xc4_?\xbf?d\xf8\xb4\xdf\xec\x86\xd1\xc2\xfcڴC\x89\xb5\xf7\x97\"I\xa6ib\x93d]o\x13\xc19O\x86\xd1\xe2\x15)\xe6Ƶ/\xff\x03)\xcf\xf3$\xbe\"L\xee\xec\xeb\x12I_f\x84\xda8[\xfb\x0f7:3}\xeb\xe6\x129p \r\xa4q]\x91p\xbf\xd9Yp\xe7\x12\x87\xb7\xfe\xf9x2b\xab3\x19\xa6\x97\xa3\xafa\xf0\xef\x8d)\x11\x06\xdfw/\xa6h\xbb\xd6<>\xbb\xa6y\xe8ߚh\xff\x9a\xbe\x8b\x93\xa2\xb7\x7f>SƸ\xcc\x05\xe9O_\x05gA\xa5۵\xfcr]\xee.Ǔ\xf3\xef\x05g\xb9\xe6\xdbL=\"\x9cK\xfc\x01\x128T@L\xa6\xa9\xd29\xc4ʋ\x91p\b\x03\tU\xb8\x14\xd3J\xa5\x92\x16\x824\xc8p\x1c\x02C\x1a\xaa5\xa4\xe3w\xe7C^ܩ\xa2\xbd\xc5ܠ\xf83\x0e\xbf!4\x13\xd7\\\x11h\xc9\xd2-\x97$@\x00)\x10L\x89\\gY\xd01S]K\x92Z\x91+\x15\x9fI\xc1\x01\xe2U\xdd\x05\x88\xfb\xf4\x90'bE\xb1`\x8b\x88\xbd>\xfaa\xb2\xdf\xec\x12\x1b\x8ea\xb4\xfbͿ\x01\x00\x88\\\vԐ\x02\x00\x00",
+	},
+
+	theme.IconNameCheckButtonChecked: {
+		Name:     "checkbox-checked-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffT\x92K\xae\xdb<\f\x85\xe7YŁ\xfe\xb1\x14J\xd4\xf3G\x9c\vt\xd0\x15\xb4\v(n\xd2\u0600\xf3\xc0\x8da\a]}A9q\\\xd8\x03\xe2\xe83yx\xe4\xdd\xc7\xe3\xdcc<~ݻ\xeb\xa5Q\u0590\xc2\xf1\xf2y=t\x97S\xa3~\xfe\xf8\xae\xb3\xfa\xd8ov\xf7\xf1\x84\xf6؝ڡQ6\xde\x1e\ncw\x9c\xbe]\x1f\x8d\"\x10l\x84\x8d\nSw\x18\xda\x17\xf08\xf7\x97{\xa3\xdaa\xb8\xfd\xbf\xddN\xd3d&6ׯ\xd3\xd6\x11\xd1\xf6>\x9e\xd4~\x03\x00\xbbۯ\xa1šQg0\b\x9f\xd0\xd6D\xef\x03[\x104\xc3\x1a\x0e\xc1\xc7\"5c\x84\x15\x86\xb0@\xcb9ק\x9d\x81U\x0f\x86^1Zz\xe8g\x93\xf7(\xbd\x1e3\xbf\x7fp\x06\xc1\xbd:\x92\xf1\xce\xe6\x14@ \x93b\x88.\x80\x8ccϢ\x99bS\x89\x05dB\x0e\x853zh21>\xc5\x14R\xb6N\xc4h\\\x89\xd2&\x19[\x02Ϣ3\xceG*~\xa9Xr Åd̪\xb2\x86\xea\xc8\x7f5o=E\a\xc2}\xa5\v\x9a\xd8s\r\xcb\xcf\xf6\xfa\x9aQ]ƒ\rQ\x8a\x17,\x0ee\x13\xce1\xd9,߈\x91:\x89\x02\xa5,N\xe3b4\x19˕\x1b\x91\x8c\xafy\xd4HɄD\xb2\x96\x96\xc0J\xcc\x19\x92.,\xdag\xeczAH\x0eޜ\xae\xe0\xeav\xde\xe0\n\xa9\xd0|7\xa4\xf0\xbb\xeb\xfbF\xfd\xe7\x8e\xec9\xaa\xed~\xb3\x93_k\xbf\xf9;\x003\xa7S\xf4\xd9\x02\x00\x00",
+	},
+
+	theme.IconNameColorPalette: {
+		Name:     "applications-graphics-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xccVKNlG\f\x9d\xb3\n\xebf\\Ɵ\xfaF4O\xca +H\x16\x10\x01\x81\x96\xf8\xe95\xa2QV\x1fٮjZ$/\xe3\xcch\x97?\xc7\xc7Ǿ\\}\xfbxz\x84\xf7\xbb\xef\x87\xfd\xcb\xf3nc\xa4\r\xee\x9eo^n\xf7\xcf\xf7\xbb\xed\xf7\xdf~M}\xfbv}qux\xbf\x87\x87\xbb\xfd\xfd\xc3\xdbn\xe3\xfa\xfa\xb1\xc1\xfb\xfe\xee\xf8\xcb\xcb\xc7n# \xe0\n\\78\xeeo\xdf\x1e\x96\xc3\xc7\xd3\xe3\xf3a\xb7=\xbc\xbd\xbd\xfe|yy<\x1e\xf1\xa8\xf8\xf2\xfd\xfeR\x88\xe8\xf2\xf0~\xbf]_\x00\x00\\\xbd\xfe\xf1\xf6\x00\xb7\xbb\xed\t\x1a\xf6^\x1b\x0f $\xd2AU\n\xdc@\x12d\x1aڊ\x99\x95%\x8fQ e\x1c\xb9(+\x10v\xa2ֹ\xb9\xb1\x8f\x9e\xb5\x83\xa2\xb4\xa6قn,\xac\x98\a\b\x16{\xcf 8\x84{+\xc0Xk\xa6*\xa0\xc8\xc5\xca\t6\xee+J\v\x97\x06\x89\x90\xb2\x8c\xea\xb0z\x19\xda\xddԅ\x94\x81\x90\x85id3\xb1D\x1c\x8f!<\xccbOځP\x1d\xac\x99tuX\xbc\xa5\xb4\xfe\xb0@\xb5\f\xe6S\f\f\x10Vu\xe7DX[\xb1\x0e\bG\xcd\xd6Ab\xe7\xc2\xe2xѓ\x189\xdcxv\x93\x18\xa5\x80N\x86 )\x06\xa3\x1c\xbdU\xcf̍{7\x96\xa5\xf2\xac6\xeą\xa83\x94p,\xeb\x8d\xfdb\x95\xce\n\xe9lT\xd6\xf0p\xa2\xbeX}\x0e\x04\x7f\xc1\x13T\xe4\xecTP\xe0h\xa3\xc6\x1c\xc4\xfe\xc81D\x89\xd6Vq3Vd\xaf\b\x05U\xed\xfd\x84#\xe6E\xc8]\xcbp@\xaa>dB\xad\x1eb\x1c\x1b\x9a\x06\x84%[\x99\x19J\x93f篞\x06f~<\\\\i\x0e\x85Pm\xf43\x90\\4@h\xe8\xa2E\x96>\xdcĕ&\xb1<8\xbcD\xbbt\x89~'\x9d\x1c\x9c$S\xa4\xebHW\x86\xa4h\x13\xd6\x06\x19k\xb4\xe45g\xfdDk\xb4\x84\xf5\xa4\xb3I\x82\t \xa69\x9b\x9b\xb1\xadh\x80\xcb6\xc2\x1c\xa2k\xb5T\x10\x94\x1eB\x10d/+\xe5\xd3\xe8b^V\xc2\x1a|1\xc6f\xb9-\xc4+\xd8\x1d\x9e\x9bT\xb2\xc12a\x92\xc3qb\x8b\x0f\x80\xe3\xb5;\xd4\xec\xfa\xe0\x90\x92\x98i\xae\x7f\x90\xbc\xb6\x81\xa9䩩\x13\xdds\xb1\x12-9\x19C.\x1b\xaf;z\x9e\v\xe1\xdb]A\xd7\xde%\re\x1a\xbd\x1aN\x19I4k\xf7v\x1d\x9d\xef\xbcd犰, e\x8d\xbd\xc7~&\xc2!\xbeoVS\xa8\x91\x01\xe1)P\x90\xf5\x9a\x14\xb3\xaaM\xaf\xacK\x92\xea<<\xad\xf8Z\xa4\xbe^\x18\x8b\x9c]\xae\x1ed\x120\xe6!1\xda\xda\xe6\xed\x9a\x16F\xf75靖\bkm3\x1b\xa4/\x8eɲ:\x91do\xe5\xcc=M\xff\xc8w\xe6\xb8j\xfa\xdb\xc9ɐ7\x94ꂤ\xd9Q\xe8<\xb3m6\b\x1a\xb5\xcd\xf5\xa5\x9e\x03\x14\x8b-a\xf7\xe3\x12\xa6\x8c%\uea83\xab\x99V\xbeX%F6\x9b\x02\xcf\v\xc9\x18\xc2\t9DGZ\xe2\xd2\xf6yu\x18]\x99\x8c#\xfb\x1a&\r)wP\xa4\xd3ŝ\n\xcc5nH\xa8\x18\x04[\f_C\x0f\x16#\xf3\xb3\xa0\xf1\x89i@\xe8\xb7V\xa0#\xf9\xc4\\\xc01\xae\x865\xaeUj\xb8\xbeb\x84NI\\\xdaHo<\x05ڌAt\x12l\x1c\a\xbe\xac\xcbl,\xd3\x14\xabb''\xeffݲ\xe2\x83\x1fc\x8a5g\xbf\t'\vOe,\xceO\x1e\x9f'ݜ\x0eg\xbf\xdc\xc7D\x7fn㥈\x12\x9f\xd2S\x9e\xc4p\xee\xe5b&\x9f\x8f\xfe\xbfAf\x1c\xfeO\x00H\xe0\x9c!p\x16ƫ\xe8'\x1cv\xb0鬯/\x98\xd6I\xfe\xacv\xf8O\x1c\x12\xbf\xd5?\x883m\xfd\x11\fk8\xff\x13F>\x83\xa1\xff\x02C\x7f\bC\x17\f\x02\xda\xe0\xcf\xfd\xe3\xe3n\xfbI\xee4k\xdd.\xaf/\xae\xec\xff\xb4닿\a\x00 \xcf!\xe1&\n\x00\x00",
+	},
+
+	theme.IconNameComputer: {
+		Name:     "computer-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\x91\xddj\xb40\x10\x86Ͻ\x8a\x97|\xc7\x13g\x8c\xe6s\x8b\xbaЃ^A{\x01eת\xe0ϲ\x8aJ\xaf\xbeDY\r\xce\xc1\xc0<>\xf3&ɮk\xd7b.\x9fc3\xf4\xb9\x12\xcd\ne\x7f\x1b\xeeM_\xe5\xea\xeb\xf3\x83Ru-\x82l\x9c+\xd4eS\xd5S\xae\xc4>V\x85\xb9)\x97\xf7a\xcd\x15\x83!\x16b\x15\x96\xe6>\xd5/`\xed\xda~\xccU=M\x8f\xb70\\\x96E/F\x0f\xcf*\x8c\x989\x1c\xe7J\x15\x01\x00d\x8f\xef\xa9\xc6=W\x1d\f\x187\x90hkY\x12\v\x06\x19\x886\xe6\x92Ʊ\xeb\rf\xfc\xc7\r\x8c\x839\xc6f\xfbj\x88sx\n\x03\xf2\x18r\n\xda\x1d\xe7\"\xf2\x97\xec\xf5\x8b\x0e\x8c\xe8%d\x9d$\xb1MS0\x04\xac\xe381\x12A G\xa2\x83 oL\x8e\xa8A\x9b\x83<\t\x89\xc7\xd1\x06\x9e\xb9N\xce#6ƥ\x8a \xd1~M\xc2Il/\xee\x8f\b\xacӋc]\x7f\xa6\xf6(\xf2\x89\xbd\xf63\xb2\xc2OӶ\xb9\xfa\x17\x95&6V\x85E\x90\xb9\a*\x82\xbf\x01\x00g\x94\"\xe8\x1f\x02\x00\x00",
+	},
+
+	theme.IconNameConfirm: {
+		Name:     "object-select-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffd\x92]\xae\x9b0\x10\x85߳\x8a\x91\xfb\x8c\x19\xcf\xd8\x1e\xbb\n\xb9R\x1f\xba\x82v\x01\x15\xa1\x80D~tA!\xea\xea+\x1b\x88\x88\xee\x13GG\xe3\xf3\x1d3>~</\x03<\x9aϱ\xbf]+e4*h\xae\xf5\xed\xdc_\xdbJ\xfd\xfe\xf5\xb3\b\xea\xe3t8\x8e\x8f\x16\xba\xa6o\xbb\xa9R\xc6ߟ\n\x1e}3\xff\xb8=+\x85\x80`<\x18\xaf`\xee\xcfS\xb7\r</\xc3u\xacT7M\xf7\xefe9ϳ\x9eY\xdf>ے\x10\xb1\x1c\x1f\xad:\x1d\x00\x00\x8e\xf7?S\a\xe7J]\xc0\xb0\x16\xc7\x11=X\xed=\x1a\xe7\xa1\x06\xd4F\x9c\x04\x82\x02\xb5\x89\x91L\x00\xd4䍘\x98,\xeb1\xb2$+)\x9b,!\xef<A\x9d4\x9a\xe8\xd8$\x95M\x97S,\xaeʙ-\x869\x06\x9bO\xfb \x0e\xea\x1d\xad\xd87Xqž\xc2\xca+^\x1d\xea\x1do_a\xc5\xed\x1bd\xda\v?@\xe15\xc5l\x8a\xce\xe7(\x99\xa4ɒ\t\xe1\xa5dap\xdcr6e4\x12[\x96w\xcff \xe0z\xaf\x85\x99?\xa9h\xa4\xe8S+\x9b\xc7\xde,AA60\xa6qt6{n\xb9\xcfש\x01\x18x\xd9\xd8\xd2|\x15\x9c\xb2\xbd\r6\tF\xebCZ\xa1p\xa0\xb0\x8bI\xe7H\x84\x97- J\xc8\t\xf9=,\xcbgZ,\xa1\xf4ow[\xfb\a\x17@@\x05\x7f\xfba\xa8\xd47jزW\xe5\xe9pL\x0f\xedt\xf8?\x00\xe8V$O\xe7\x02\x00\x00",
+	},
+
+	theme.IconNameContentAdd: {
+		Name:     "list-add-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff4\xcdMJ\xc50\x14G\xf1yW\xf1\xe7:ns\x93\xcaU\xa4\xe9\x03\a\xae@\x17 6&\x81~\xf1\x12\x92\xe0\xea\xe5\xa1o\xfa\xe3\xc0\x99.m[Q\xdc5\xc5c\xb7\xa4\a&\xb8\xfd\xebX\xe2\xee-}\xbc\xbf\xf5\xcft\x99\xbb)\x15\x8f\xe0\xa2\x0fْ\x96\xb3\x11Jt\xf5\xf5h\x96\x18\f-\xd0B\xa8q\xc9\xe1\x1e\xb4mݓ\xa5\x90\xf3\xf9\xa2T\xadu\xa8\xe3p\\\xbd2̬R\xf14w\x000\x9d\x9f9`\xb1\xb4\xe1\t\x1a\x05\x82\x80^P`\x10 \xff`Pn\xf8\a\xbd\xb9'\xbd\xe0\a\x1b\x18L\xf8\x8e\xebj\xe9\xc1\xb8\xf1q\x14Rs7\xdd.s\xf7;\x00d\x96\tt\xe4\x00\x00\x00",
+	},
+
+	theme.IconNameContentClear: {
+		Name:     "edit-clear-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x9cT\xed\x8a\x1aQ\f\xfd\xefS\x84\xdb\xdf7&7\xf7\xb38.\xf4G\x9f\xa0}\x80\xb2kU\x18u\xd9\x11G\xfa\xf4%w\x9cUq\xe8\xb6\x05\xc1prr\x92\x9c\\]<\x9dw-\x9cVo\xdd\xf6\xb0o\f#\x19X\xed\x9f\x0f/\xdb\xfd\xba1߿}\xb5\xd9<-g\x8b\ued06\xcdj\xbb\xde\x1c\x1b\xc3\xf1\xf5l\xe0\xb4]\xf5_\x0e\xe7\xc6\x10\x10p\x04\x8e\x06\xfa\xed\xcbq3\x12λv\xdf5fs<\xbe~\x9e\xcf\xfb\xbe\xc7^\xf0\xf0\xb6\x9e;\"\x9aw\xa7\xb5Y\xce\x00\x00\x16\xaf?\x8e\x1bxi\xcc\x0e\x128x\x06K\x98ő0\x10X\xc6\x10r(\x1e\b\xc5K\n`\x1d\xba\xe2J,@\x982\xbb\x00\x9d\xb2|\xf4\xd9\v0\x12I\xa1\xa84vY#\xc6PR\fqP\x8e\x918D\xa0\x11\xb4\x8c.\xc8\xc0s\xce]\xa0DI\xfb3\xa6\x12s\nCi\xcd\n\x10V]Q\xc8s\x1a&\t\xd1\x05\x05\x82\xd6)'K(\x92\x87B\x0eN|-LI\x03K(D)\xb3\x16\n\xbbp\x87\x94\x9c]f耐u\xa7\xac\x8b2\x95\x1aܰ\xaa\x1b\xcfp\x91\xf7\xa3\xfc\xd5)\xc2\x10\xc6.wc\xa5Z\xe6\x87֚\xcc\xec\"0\x92\xaf\xcb2\xaa\xf9\x15\xb9\xf7!ֺ\x18=E\xb9:\xc8(E\xb5\x80\x915\xe5\xe0\xc6\xfa궃\x0e\xf4B\x97\x1d\x86\xab=\x9cq\x03\xaa\xcf\x18}U#\x10\xbd\x84\x84\xe0c\xd1X\xe0\x04\xb6\x8e\x00\xf6\x9dueX\xb9|~\xc1\x0e\x18\xa4Ϊ\x17\xd3\x1d\x810p\xa9\xb2\xc8T\xf94.G\xe3 -\xf0\x18\xf2\x04fߣj\xba:e/߄\u07b3\xa7\xa8\xc0u\xabA\xfe\nu\x7f5\x85\x8aK\xa1:\xf6C\xc0H\xfa\x94\x12\x90\x1a\xca\xd5\xed\xf6f2\xfe\xd3.\xff\xac\\\x7fX#<\x12\xed{\x9a\xeeZۛ\xdev\xaa\xb9}l\xfa\x81|\av\x8az\xc3i\xe1\x83\xfevr\x80\xc9h\xb2\xc1\x7f\x9eZ\xdf \x01\x19\xf8\xb9m\xdb\xc6|r+\xf1\x12\xcd|9[\xe8?\xdfr\xf6{\x00\"\xaeR;x\x05\x00\x00",
+	},
+
+	theme.IconNameContentCopy: {
+		Name:     "edit-copy-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xac\x92On\xb30\x14\xc4\xf7\x9cb\xe4om\xf3\x1e\xc6\x04>\x01\x91\xba\xe8\t\xda\x03T\x84\x02\x12\x7f\xa2\x80\x00\xf5\xf4\x15\x0e\x10\xd4M\x16\xad\x90Xx\xe61\xbf78>\xcfM\x8d1\xbf\xf5U\xd7&\x82\x15\t\xe4m\xd6]\xaa\xb6H\xc4\xfb۫\f\xc59u\xe2~,P\xe6UQ\x0e\x89\xe0\xe0:\v\x8cU>\xbdts\"\b\x04\x0e\xc0\x81\xc0T]\x86r3\xccM\xdd\xf6\x89(\x87\xe1\xfa\xdfu\xa7iR\x93Vݭp=\"r\xfb\xb1\x10\xa9\x03\x00\xf1\xf5c(qID\x03\x82F\x06\x82d\x15\xf8\xbe\xd1\fV\xda\x18?\x88 5\xf4\xf2*a\x90aח\x89ݣ\xd7qR\xc6\xd0)dHR\xbe\x1fy\x1c\x81!\x19\x8c\x1e\xf2x*\xed\xb1M$eN\xa4ٳ\xaa\x17\x05a\xb8\xaa\x92QB.\xa1\x0f\xcfB\x88\xa3\x8f1\xc2l٫g\x93\xd9>ك\x8a\xc0\xd8\x11V\xaa\x9f\xa0١\x03\x82Ԑ\xfb\x96\xd2\xf6\xf0\x85\x06f\xcd\xfcE]\x1b\xf6\xee\x90\a\xd9~d[\xfe9\x8e\azZÝ硂\x0f\x85\xdb\xdd\xc7{\xde\x1f\xfc\x92\x85\x89@\x02\x9fU]'⟗k_\a\xc2M\x9dx\xb9~\xa9\xf3=\x00\xc6\xc5\x0e5\xfd\x02\x00\x00",
+	},
+
+	theme.IconNameContentCut: {
+		Name:     "edit-cut-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xecV\xcfn\xf36\f\xbf\xf7)\b\xef,\x85\x14%Y\x1a\x9a~\xc0\x0e{\x82\xed\x01\x067K\x02\xa4\x7f\xf09H\x8a=\xfd@J\x96墻\xf70$\a\x9a&\xa9\x1f\xc9\x1fi=\xfe\xf8x\xb9\xc0\xed\xf0s>\xbf\xbd\xee\a\xb28\xc0\xe1uz{>\xbf\x1e\xf7ß\x7f\xfcn\xd2\xf0\xe3\xe9\xe1q\xbe\x1d\xe1t8\x1fO\xd7\xfd@\xf1\xfdc\x80\xdb\xf9p\xff\xed\xedc?  P\x04\x8a\x03\xdc\xcf\xcf\xd7\xd3b\xf0\xf1ry\x9d\xf7\xc3\xe9z}\xffu\xb7\xbb\xdf\xef\xf6\xce\xf6\xed\xe7q\xe7\x10q7ߎ\xc3\xd3\x03\x00\xc0\xe3\xfb_\xd7\x13<\xef\x87\x17 g1F\x8f\x11\b&0h1\x85̣J\xc8\x19\xa3H4R\x1a\x03\xa0\xc8.\x8c\x89\x1c\xa0E\nх\xe2\xe4\"\x8d\x94E\x19|L\xea\xed\xb3\xa3\x94\x00\xad\xc3\x11\x99D\x159\x16+\xafA\xe0\x02\xc6Y\xce\xc9\xf3\blCH!{8\x81\xb3\x9e<F\a\x17 +\xd0\\\x10C\xafZ\x98\x00-y\xf5\x91\x83\v0\xeb\x90I\xcc\xd0\xfa\x94\\rb\x14\x1c{/\xaa\xb1\xa2Ār\xec\x8a\xd7l\x1c\v`\x91JT\x83\xcb\xe9\xeaM\xde\av\"a\x1ec\xd0\xe3\x99\xc4\x02L\x7f\xda\x1a\x87\xe2\x98c\x86\x7f\xe0\x05LޔY\xf2J\x9e\x13 \x18\x06\xb2\x1c\xa4\x98\"3\xcc\xeb3\xeb\xef\x04lǠ\xbd\xb8\x88k(\x1dr\x96C\xf01\x8b\x12m,\xe7\xa3\xcdQ\xb0\xabN\xb1W\xb8\x11\xa6\xae\xa7}sQ\x8a\xc2Є\x8d\x8a\xb4N\xa5ǉ4\x9c\x0fK?\xa5 J\x85,)\x8f\x9d\x8al(\xd9N\x80В\xfd\x94\xd7\f\f\xa6\xd3\x19VsQ\xa5Xۓ#fN`\xdc\x12\xd08[\xe8\x90Q\x13\x16\x8ex\xd2:\x1b\xb7\xa4z\x82`\x13;d)6\xdaP\xfa\x8ePQgGYd\x82\xb9S\x18\xaa\xff\x93t\xabR^\xdc\t3\x17:p\xa9@}gpIlUђB}c\xfaޖ\xbf\xd0\x01\x81l\t\x8e\v6\xb4\xa4\x13\x05]\x93hi\xaa6\xc4\x15\a\xefrL\x8b\xd5(B\xd2L[\xb7В#\xcc\xeaG9\xf0\xe2\x17\xd3\xd8\xc5B[\xd2j\x83\x8c\x96d>5\xb4&\xaa\xa5kP\x96w)\xeb\x18\xa0\rn\x14pT\xad\xa9\xf3j\x11W\xb0\x8eK\xa10\xe5TRZ\x8aY\x02O\xd0\xd3N\x0e\xe5M \xc1\x1c\u05ccZ\x8e\xb7\xb5^\x13\xf4\xfc\x96t\xe36]\x9d\xdaNC\xa5GS\xa9>\v\xc1\xc1t\x87\xd6U\xb7n\x0e\xb4\x9c\xd4\xea\xf6i6P\x9a<*\xaaЖ\xa8.?\x1f\x05\xa5i\x87\xe12\xb5ů\xac\xcbV\x16\xb1S \x1b\x96\t\xbd\xd5\xca\x05!\xe7\xe7\xb1\f\x14\"\xf4\xbb(\x96\x9d\xe8s9\x9b\x16\x06\xa3]VY]\xc4\xea\x1f5[SK\xab#\"5t\xa1K\\\xbdB{O\xdc泭\xcaPzf\xc8bY\xe4\"\xb5\xe5ٶlm\xdbv\x1b\x87\xeds\x1d\xff\x19zL\x15\xe7\x17v\x13\xf4\x88ddx\x84\x15\x90\x80\xd4\xcf\xcd\x17\xc8ֆU6\xba\xd0\xd6bk\xf9\xe7b\xc8\x00{H\xff\x8f\xef\xf7\x1a߅\x00\x952\x1bf\xaf\xe3\x1b\xdb\xe0T\x8fƘ\x95Í1+\x99{.\x97\xc4\x1b\xa3+e6\xc4̸\xe1\xea\xfc\xbd\am\x02\xec`\x15Y\x91m\xef\t\xb2\xabR3\xfe\xea\"\xf1_\xaa\tڊ4h\xebe\xd2\xea=B\x13a\xacE.w\fC\xeb\r\xb0\x8d\x85\xe9n\x9c\xf5j\xd3\xdfT\xc8%\x19'ӏQ.\xdf\xf5\xa6*_^\x1c\xe0\xef\xf3\xe5\xb2\x1f~q\a\xf6\x1c\x87\xdd\xd3ã\\\x8e\x9f\x1e\xfe\x1d\x00\x00oG\xb9\x9b\v\x00\x00",
+	},
+
+	theme.IconNameContentPaste: {
+		Name:     "edit-paste-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\x91\xddj\x840\x10\x85\xef}\x8aCz\x9d8c\xd4բ.\xf4\xa2O\xd0>@q\xad\x11\xfcYVQ\xe9ӗ\xe8\xea\x06\x83\x04\xe7\x9bs\xe6\x8c\xd9u\xedZ\xcc\xd5cl\x86>\x17\xacH\xa0\xea\xcb\xe1\xd6\xf4u.\xbe\xbf>e\"\xae\x85\x97\x8ds\rS5\xb5\x99r\xc1\xf1}\x15\x98\x9bj\xf9\x18\xd6\\\x10\b\x1c\x83c\x81\xa5\xb9M\xe6\x00֮\xed\xc7\\\x98i\xba\xbf\xfb\xfe\xb2,j\xd1jx\xd4~@D\xfe8ע\xf0\x00 \xbb\xffL\x06\xb7\\t\xb8\x80PB\x92\x8a\xa20N\x12\x10$\x83T\x18F\x9a\x03{g\x18\xc8\xc02\xac\xe2\xed\xb3e4Xiےڻƌ\x14%\b's\x96\xf5\xf6\x18$(\xe1(hH\a\x91VA\xee\x12/\x1f\xe9z\xec\xe79\v9\x13Kw\xda\xfd\xfc\xa1\xdb\xc72`\xcc\xe0\xad\xe3\x15\xf1\xe0\x19{\xbc\x10\xa5S\x05;\x92\x9b\xdcl_f\x97Qхl\xc1b\xa4\xc2 \xb5=\xbc٤\x87\xcdNH\xa7|\xec1y\xee\xfaА\xec`\xf20KτO\xce!\xce|\x04\x12\xf8m\xda6\x17oA\xa5C\x1d\v\xbf\xf02\xfb\x97\v\xef\x7f\x00>\x1aE\xc8d\x02\x00\x00",
+	},
+
+	theme.IconNameContentRedo: {
+		Name:     "edit-redo-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflR펛0\x10\xfc\x9f\xa7\x18\xb9\xbfmv\x17\xdb\xd8U\xc8I\xfd\xd1'h\x1f\xa0J( \x91\x0f\x1d(D}\xfaj\xc9]\x82t\x11?\x98\x1df\xbc\xb3\x8b\xb7o\xb7\xe3\x80k\xf3>\xf6\xe7SmؑAsڟ\x0f\xfd\xa9\xad\xcd\xef_?m2o\xbb\xcdv\xbc\xb6蚾\xed\xa6\xdap\xbc\xdc\f\xae}3\xff8\xdfjC p\x04G\x83\xb9?Lݧ\xe0v\x1cNcm\xbai\xba|/\x8ay\x9e\xdd\\\xba\xf3{[\b\x11\x15\xe3\xb55\xbb\r\x00l/\x7f\xa6\x0e\x87\xda\x1c\xc1\f\xc1\x1e\xe4$\x86(\x01\x04r\x81s(\x19䘂\x8f\x19\xe4*\xaaha$K\x8e\x19\x03<\xfcb+3-\xb6/\x80\x1dI\xe9\xcb\n\x04v\x9e=E\xc1\x00{\xf7ٯz\xfb4\xac\xa8\x0f#a\x84}%]i\x06\xc8g<\xfb@\x1dlr!\x85\\&mˎ\xb9\xe2\x94\xd4( \x97\x92$\x16ł\xf1Y\xdf\x1f\x1d/\x04\xaa\x12\x83\xa0\xc3{\x9f\x853\x18|\x8f\xf3\xa8\xadR{m\xbblN\xf4x\xaf\xdd\x12-v-<F(\xe1cJ\xf75X\x8f\x0e\x8ft\xc3*\xf5\x13\xadW\xf5\x12\xbd\\\x85&\xe7T-\xc2\xe5\xadY\xf5\x93\x12\x1f'?~\xea\x93\xfa\x87#\bd\xf0\xb7\x1f\x86\xda|\x93\xa6\xf4e4\xc5n\xb3\xd5˳\xdb\xfc\x1f\x00\xd4RM\xbb\xbb\x02\x00\x00",
+	},
+
+	theme.IconNameContentRemove: {
+		Name:     "list-remove-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff4\xccMJ\xc50\x14\x06\xd0yW\xf1q\x1d\xbf\xe6\xa6-U\xa4\xe9\x03\a\xae@\x17 6&\x81\xfc\x94&$\xc1Ջ\x837?\x9c\xedރG\xd5Wv)*\x92#\x13t\xfcN\x87\x8bF\xd1\xe7\xc7\xfb\xed\x85\xee\xfb\xb0\xe5j`\xb53\xb6(\x92\xeb\xd9\t\xd5\xe9\xf6\x96\xba\"\x06C\xae\x90+\xa1\xb9\xa3\xd8\a\xe8\xc1ǬȖr\xbe\n\xd1Z\x1b\xdb<\xa6ˈ\x89\x99E\xae\x86\xf6\x01\x00\xb6\xf3\xabX\x1c\x8a\x02$\x9ea!\x17TL\xb0\xb8\xc9\x05\xbf\b`0\xe1\xc7y\xaf\xe8i\xd2\xf32\xaf$\xf6a\xfb?\xf6\xe1o\x00\x1ac\xae\xd8\xc2\x00\x00\x00",
+	},
+
+	theme.IconNameContentUndo: {
+		Name:     "edit-undo-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x84Rێ\x9c0\f}\x9f\xaf8J\x9f\x13l\x93\x84\xa4\x1af\xa5>\xf4\v\xda\x0f\xa8\x18\nH\xccE\v\x1aF\xfd\xfa*\xecry\x18ie$\x8eO|\xec#'Ƿ\xe7\xa5ǣ~\x1f\xba۵TlH\xa1\xbeV\xb7swmJ\xf5\xfb\xd7O\x1d\xd4\xdb\xe9p\x1c\x1e\rںkڱT\xec\xefO\x85GWO?n\xcfR\x11\b\xec\xc1^a\xea\xcec\xbb\x14</\xfdu(U;\x8e\xf7\xefY6M\x93\x99rs{o2!\xa2lx4\xeat\x00\x80\xe3\xfd\xcf\xd8\xe2\\\xaa\v\x1c\x04\x154\x19\xf1\u038b\x03%\xec8\xba\x9cA\x86\xc9Y\x1f\x13UPA3%Q\xa2\x8f\xe8\xa1-\xec\x874\x8f\xe4\xc5\t\x16\xb4\xe7ؐ\xe46/@`cْ\x17\xf4\xf8\x90\xae\xf5+ت7\xe6SD\x18v\x03vm\xf5\xae\xaf\x96\xc5߆Z\x04るy@\x056\xcc\x05\x87\x00B\xf2\x1b\x82\x04\x16\xa4\x18\xa0w\xb9\x96e/\xceQ\x11x\x9e\x032\xd6F\xe1\x980c\xd8r\x9e\xa3\x82\x18\x8e3A\xb0\xc9X\xa0Yl\xa1-\x86\x1d\xa1\xed\xe7\xd7B\xaf\xeez\xbcp_\xbd\xd8\xeaW{\x98}s(\xdc\xfeo\xe7Ä\x96\x19\xeb\xa5n\xdc?\\@ \x85\xbf]ߗ\xea\x9bԹͽ\xcaN\x87cz>\xa7\xc3\xff\x01\x00\x02\xd1:\x06\xbd\x02\x00\x00",
+	},
+
+	theme.IconNameDelete: {
+		Name:     "edit-delete-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x9c\x93]\xaa\xdb0\x10\x85߳\x8a\x83\xfa,y\xf47\x96K\x9c\v}\xe8\n\xda\x05\x94ĵ\r\xce\x0fW&\x0e]}\x91s\x13\v\xae)%`\xf0\xe8Ӝ9\x1a\x8d\xbd}\xbb\x1d\a\\\x9b\xf7؟O\xb5Њ\x04\x9a\xd3\xfe|\xe8Om-~\xfe\xf8.\x83x\xdbm\xb6\xf1ڢk\xfa\xb6\x1bk\xa1\xf9r\x13\xb8\xf6\xcd\xf4\xed|\xab\x05\x81\xa0\x19\x9a\x05\xa6\xfe0v\x8f\x84\xdbq8\xc5Zt\xe3x\xf9Z\x14\xd34\xa9ɪ\xf3{[\x18\"*\xe2\xb5\x15\xbb\r\x00l/\xbf\xc6\x0e\x87Z\x1c\x11@\xd8C:\xe54i\xcf \xc8\x00\xab|\xa8\x82s)\x0e\x88\xcb:\xe0\x0e\x02d\xc6dB2\x17ݟ?8\x82`\xb0\x87U\xd6\x1a\xb2\x1a\x04\x86Q\xcce\xc5\x15\x18\x9c\x84\xd9Z~ Ψ\x9cqD\x0ef\x94\xcaK\xa3\xc8j\xe3aTš\xf4\xa9\x17R\x86=\x1b\x0fJ\xb1וO\xc6J\x93wIM\xaa\xa4r>\x8b2\x95I\x05g\x8d\xadh\xd6<\x82\x05iE\xc6:[\x82\xa0\x95ӎ\xd8`\x80~\xa8\x9f\xc1\x00\xf9\x19\xbeP;ft\xc9\xfbؤ\xdcZf\xdek֟\x9d\xffY9.T.\x89\x90ϔ\xbc\xc3Uo\xb9f\xbev\x01\xab\xe5\xe7\xbb\xd2i\x8a\xd9\xdb͛)zx<\a\xb8\xb0\xf8\x9f\x93^\x1d\xd1zW\xaf\x1f\xe6\xfeٓ\xc0\xef~\x18j\xf1\xc54\xd6Y\x16\xc5n\xb3M\xff\xe0n\xf3w\x00)͋\xb6\x02\x04\x00\x00",
+	},
+
+	theme.IconNameDocument: {
+		Name:     "text-x-generic-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x91ݎ\x9b0\x10\x85\xef\xf3\x14G\xee\xb5͌\xff\bU\xc8J\xbd\xe8\x13\xb4\x0fP\x11\x8a\x91\bD\v2\xa8O_\xd9l6\\\xac\x10\xe3\xd1\xcc7g\x8e\xe5\xcb\xdbv\x1f\x10\xdb\xf7\xb9\x9f\xc6Z\xb0\"\x81vl\xa6[?v\xb5\xf8\xfd\xeb\xa7<\x8b\xb7\xeb\xe92\xc7\x0e\xa1\xed\xbb\xb0Ԃ\xfdc\x13\x88}\xbb\xfe\x98\xb6Z\x10\b\xec\xc1^`\xedoKx\x02\xdb}\x18\xe7Z\x84ey|/\x8au]\xd5j\xd4\xf4\xde\x15\x9a\x88\x8a9v\xe2z\x02\x80\xcb\xe3\xcf\x12p\xab\xc5\x1d\x0e\x8c\x06\x92\x95\xb7\xd6\x19\x06A\x1a\xb02\xceY_\xa5\xdc \xa2B\x03\xc2'\xf3\xd96\xf9\v\xf0hpP0\x90\aD&\x05Y*\x97E$)\xed\x9d\xd7.eL\xfb\x16R\x8e\xab4\x9b\xba\x95\xae\xf6ZI%\x19\xc6\x00i\x94\xdbC\x93\xa7Υ;\x9eֲ%\xff\xe5\xec\xab\xf6\x0fw\x104\x02,\"\xf8\xc3\r\x83\x94\x03\x1f\xfe\x90cD\x99\xfb\xa4\\\xd2\xd1y\x8d\xae\xfc\xf9\f\x86d0\x02\xa4\xdf\xed|\x10\x94\xea/Lf.BV\xcfk?\xb9\x03\x91\x99\xdd\x19\t\xfc퇡\x16\xdftk\xac\U00062e1e.\xe9ɮ\xa7\xff\x03\x00\xd9VŴ1\x02\x00\x00",
+	},
+
+	theme.IconNameDocumentCreate: {
+		Name:     "document-new-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflR۪\xdb0\x10|\xcfW\f\xea\xb3\xe4]\xdd,\x958\a\xfa\xd0/h?\xa0\x9c\xb8\xb6\xc1\xb9pl\xe4Я/\xeb8'\x81\x16\t\xbc\xcc\xccjg\a\xef\xdfn\xa7\x11\xa5\xfd\x98\x86˹QlH\xa1=\xbf_\x8eùk\xd4\xcf\x1f\xdfuRo\x87\xdd~*\x1d\xfav\xe8\xfa\xb9Q\x1c\xaf7\x852\xb4˷˭Q\x04\x02GpTX\x86\xe3\xdc?\x04\xb7\xd3x\x9e\x1a\xd5\xcf\xf3\xf5kU-\xcbb\x16g.\x1f]e\x89\xa8\x9aJ\xa7\x0e;\x00\xd8_\x7f\xcd=\x8e\x8d:\xc1\x83\xf0\x0e\xcd&z\x1f\x1c\x83\xa0\x1dظ\x10|\xccR;\x14\xb0h\b\x9f\xa2Oޭ\xa7\x87\x13ބ@ub\x11B\x93\xf1>[\xceR3\xa6\x17@\xf3v{h\xe9\xd3dBM\x8e-h%\xc8x\x9bcJ\x9b\xaa@o\xe3_\x84/\x92\xed\xa9`B\n\xd9%\x8cpƳ\xa7h\x9fE\x01=hy\xe8\xe9\xf4iR\xce\xf4\xafq\x99\xfe\x18nc\x886H\xc5tO\x87L\xe0,\x81\b\x9bm\xbec5\x89K\x8c\xd0^\xee\xba!\xa7:\xbc~\xfd\xea\xec\xbf}O\xec\x0fN\xa8\x91P\u058c\xb5C\x81]þ\x03\xb2\x97v\x1b\xa0\xedC\xa2\xdd\xdaH \x85\xdf\xc386\xea\x8bm\x9dwQU\x87\xdd^\xfe\x82\xc3\xee\xef\x00ӃN\t\x84\x02\x00\x00",
+	},
+
+	theme.IconNameDocumentPrint: {
+		Name:     "document-print-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\x90[n\xb30\x10\x85\xdfY\xc5\xd1\xfc\xcf63\xe6\x12\xf8\x05D\xeaCW\xd0.\xa0\"\x14#q\x89\x022\xa8\xab\xaf\x1c\x92\x06i\x1e\xc6>\x9fg\x8eOqކ\x1e\xae\xb9\xcd\xdd4\x96$\x9a\t\xcdXO\x97nlK\xfa\xfcxW\x19\x9d\xab\xa0\x98]\v\xdbt\xad]J\x92\xf4\xba\x11\\\u05eco\xd3V\x12\x83!)$%\xac\xdde\xb1O`\x1b\xfaq.\xc9.\xcb\xf5\x7f\x18\xae\xeb\xaa\xd7HO\xb764\xcc\x1cή\xa5*\x00\x80\xe2\xfa\xb5X\\J\x1a\x90@PC\xb1N\x12>e\x02\x86\x12\xb0\x8e\xe3\xdcH\xee{\x81\x83\xc0\"\x83\xf3\xc7\x1a|\xa0Ց\xdc\xeb\a\x03T\x84\xc8O\x15-\x9cG\xa7Ŀ1`\x9d圚\xc4\xf7\x06\x0e\xf1}؋y\xca\x06\xbb.\x0f\x9d\xb3<\x8bc\xb0΅%I\x1f\xbawT\x1fT\x18\xa8\x03\xa2\xcc\xd3\xefk\xc1\x8e\xfcmّ\xdd\xc5˪:\xda\xdc\xcb\x7f\xc9 }\x84\x10\xc1Be\xf7K\x06\x13\xbe\xbb\xbe/\xe9\x9fi\xa28J)\xac\x82\xc2']\x05\xbf\x03\x00\xa2f\x98\xbe\xe8\x01\x00\x00",
+	},
+
+	theme.IconNameDocumentSave: {
+		Name:     "document-save-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x91]\xaa\x9c@\x14\x84\xdfg\x15E\xe7\xb9\xdbs\xfaG\xed\xa0^\xc8CV\x90, 8F\x05\x7f\x86\xab\xa8d\xf5AoF{\xc8\x05\xc1\xa2\xa8\xea\x0fNeo[\xdfa\xa9ާv\x1cr\xc1\x8a\x04\xaa\xa1\x1c\xef\xedP\xe7\xe2\xe7\x8f\xef2\x15o\xc5-\x9b\x96\x1aM\xd5\xd6͜\v\x8e\x1f\x9b\xc0\xd2V\xeb\xb7q\xcb\x05\x81\xc018\x16X\xdb\xfb\xdc<\x03[\xdf\rS.\x9ay~|\x8d\xa2u]\xd5j\xd4\xf8^G\x9a\x88\xa2i\xa9Eq\x03\x80\xec\xf1knp\xcfE\x8f\x14\x84\x12\x92\x94s\x94\xa4\f\x82d\x90\xb2\xd6k\xf6\xbbf,H\x95K\x9d7):HV\xdak\x1f\xfb@\x1d}N\x13\x17\xfe\xadeK\xf1\xae\x9eyR\t%d8\xf0\xa6\x83\xccޙ\x1d\xca\xe4\xecK\x90^\b\xc6S\xac\x1dNqY\xacH\x1bk\x12\x10X\x1d\\\x8d\x0e\x06\x06%\xfe\xef]\xe9\xcb\xf9W\xa2\xa3&_z\x9f\x91\x03\xa0<\xcbS\xe0\xcb0\x1b<~\x1d\xed\x14\v\xe4y\xdf\x12\x14l!\xc3\x1d>\xbe?\xe8!\x13\xb0\xc5\x02\x8d\xe6CH}\xf8\x04\x12\xf8\xddv].\xbe\xe8\xcaX\x13\x8b\xa8\xb8e\xfb\xee\xc5\xed\xef\x007\xc9!\x1cv\x02\x00\x00",
+	},
+
+	theme.IconNameDownload: {
+		Name:     "folder-download-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x91]\xaa\x9c@\x14\x84\xdfg\x15E\xe7\xb9\xdbs\xfaO;\x8c^\xc8CV\x90, 8F\x05\x7f\x86\xab\xa8d\xf5A\x93\xab=$ X\x14\xf5u\xc1\xa9\xfb\xdb\xd6wX\xaa\xf7\xa9\x1d\x87\\\xb0\"\x81j(\xc7G;Թ\xf8\xfe\xed\xab\xcc\xc4[q\xbbOK\x8d\xa6j\xebf\xce\x05\xfb\xe7&\xb0\xb4\xd5\xfae\xdcrA \xb0\a{\x81\xb5}\xcc\xcdG`\xeb\xbba\xcaE3\xcf\xcf\xcfI\xb2\xae\xabZ\x8d\x1a\xdf\xebD\x13Q2-\xb5(n\x00p\x7f\xfe\x98\x1b<r\xd1#\x03\xa1\x84$\xe5\x1c\xa5\x19\x83 \x19\xa4\xac\r\x9aî\x19\v2\xe52\x17L\x86\x0eR+\x1dt\xf0!R\a\xcfY\xea⿵l\xc9\xef\xea#O*\xa5\x94\fG\xdet4spf/er\xf6%H/\r&\x90\xd7\x0e\xa7\xb8,V\xa4\x8d5)\b\xac\x8e^\x8d\x0e\x16\x16%\xfe\xe5\xae\xf4\xe5\xfc\x85\xe8\xc0\xe4\v\xf7\xbf\xe6\xa8P\x9e\xf0\x14\xf92\xceF\x8f_G;\xc5\x02y\u07b7\x04E[\xc8x\x87?\xdf/\xf4\x90\x1el\xb1@\xa3\x01\xeb\x9dׇO \x81\x9fm\xd7\xe5⓮\x8c5^$\xc5\xed\xbe\xef^\xdc~\x0f\x00}hm\xaav\x02\x00\x00",
+	},
+
+	theme.IconNameError: {
+		Name:     "dialog-error-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\xceMj\xec0\x10\x04\xe0\xbdOQ\xf4[\xcbn\xd9F\xd6<,\x0fd\x91\x13$\a\bcG\x12\xf8\x8f\x91\x90LN\x1f\x9c!d\xa0\x17\xdd\x1f]P\xfd\xf5Xf\xa4\xe9\x1e\xfc\xb6\x1a\x92%\x13\xa6\xf5\xb6\x8d~\xb5\x86\xde\xdf^\x85\xa6\xebP\xf4!Y\xb8\xc9[\x17\rI\xb5\x1f\x84\xe4\xa7\xfc\xb2\x1d\x86\x18\f\xa9 \x15!\xfb1\xba߇c\x99\xd7`\xc8Ÿ\xff\xaf\xaa\x9cs\x99\x9br\xbb۪f\xe6*$KC\x01\x00\xfd\xfe\x11\x1dFC\v4$n\x10M\xa9;ɗ\x16\fѡ)e\xad/\xacνC\xf8\xbb;<\xa0\x83x2q\x92x\x0e=\xe6\v\vD\v\x05\a\x8d\x84\x1a\x0eB\xff(\x83\t\x9f~\x9e\r\xfd\xab\xa7\xa6m\x14UCџ\x15\x87\xe2{\x00\xd2\xfe\x1e\xf3!\x01\x00\x00",
+	},
+
+	theme.IconNameFile: {
+		Name:     "application-x-generic.svg",
+		Symbolic: false,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffd\x90Kj\xc30\x14E\xe7Y\xc5\xe5u,\xf9Y\x92e\xa9\xd8\tt\xd0\x15\xb4\v\b\xf1\x17\xfc\t\xb1\xb1MW_\x94P\xb7%\x03\x81\xf4\xb8G\xf7H\xd9i\xeb;,\xe5mj\xc7!\xa7X2\xa1\x1c.c\xd1\x0euN\x9f\x1f\xef\xc2\xd1\xe9xȦ\xa5FS\xb6u3\xe7\x14+w\xdd\bK[\xaeo\xe3\x96\x13\x83\x11+\x17\x16am\x8b\xb9\xd93[\xdf\rSN\xcd<__\xa3h]W\xb9j9\xde\xeaH1s4-5\x1d\x0f\x00\x90]\xcfs\x83\"\xa7\x1e\xca\xc1\xa1A\xc2\xe8\xa0Y\xb2Q\xdez(/}\x9c\x86݂\xd4HN\\\xe25.`\x18i\xb4N\xbc\x81\xd0Ҳ\xd7i\x02'Yi\xa3\x1d\x84\xfb\xe1\xf7Q\x03\x91*\\ v\x8e!\\\x80\x13k\r\xdb\xfb\xc1a\x81\xf0\xf6~\xffo\xf0o\xe4\x1e\xfaB\x0f\x06\x13\xaa\xb6\xebrz\xa9l\x95T\x86\xa2\xa77\xa5\x01X\xa0\xd4\x7f\xe3]8\x95^\xc5\xeea\xfe\xf0\xdd']\xa0\x04Kf\xed\xd9>U\x16eQ\x14\xe7P\x99\x85\xef<\x1e\xbe\a\x00u\x14\x9b\xfe\xd1\x01\x00\x00",
+	},
+
+	theme.IconNameFileApplication: {
+		Name:     "application-x-executable.png",
+		Symbolic: false,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x00\x1c\x11\xe3\xee\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00w\x00\x00\x00w\b\x06\x00\x00\x0099ߊ\x00\x00\x00\tpHYs\x00\x00\x0e\xc3\x00\x00\x0e\xc3\x01\xc7o\xa8d\x00\x00\x00\x19tEXtSoftware\x00www.inkscape.org\x9b\xee<\x1a\x00\x00\x10\xa9IDATx\x9cݝ{\x8c\\\xd5}\xc7?\xe7\xbef\xee\xcc\xec\xecs\xd6އ\x97\xdd\xf5\xda\xc6\xc6\x10\b\x04\xc2#D!\x82\x94\xaa\xa9Ԧ\xff\xb4A\xaa\xaa\xb4\xe4\x81ڨI\xd5\xfe\xd1JQ\x84\xa2J\x91\xa8ڤM\x89\x924R\vj*\xaaHQi @\xa3R\xa0\x94\x02q\xd8\xda\x18\xb0\x8d\x1f\xd8\x18\xdb\xeb}\xce\xfb>N\xff\x98\x1d{gwfw\x1e\xf7\u07b9w\xbf\xff\xac\xf6\xce}\x9c;\x9f9\xbfs\xce\xef\xfc\xce\xef\b)%\xdbU\x02\xc4-\xdf8\xf7\x80\x80\xdf\x06q\x13\xc8!\x10s\x02\xf9\v\x81\xf2\xa3W\xfe|\xe41\t\xdb\xf6\v\x10\xdb\x15\xee]ߜ\xeb)[\xa5\x97\x80\xeb79\xed\xb0b\x17\xee\xfc\x9f\xaf\xcd,\aU\xae \xb5-\xe1\xde\xf6\x97\xe7\x06\xa5+ު\xd4\xd4\xcd%$\xf3\x8e\xa9\xec{\xed\xab#sA\x94-Hm;\xb8\x15\xb0\xbc\x03\f4{\x8d\x90bYs\xd4=/}m\xc7E\x1f\x8b\x16\xb8\x94n\x17\xc0K\xb5\x03\x16@\n\x99\xb6U\xe7؝_\xbf0\xecSѺ\xa2m\x03\xb7]\xb0UmG\xc0\xdb\x02n\xa7`\xab\xdan\x80#\x0f\xd7+\xb0Um'\xc0\x91\x86\xeb5ت\xb6\v\xe0\xc8\u009d\xfc\xe2\x7f\xdd#]\xe99ت\xb6\x03\xe0H\u009d|\xf0\xb9\xcf\xd8\xc5\xdcs+\v\x17}\x01[U\xd4\x01G\x0e\xee\xe4\x83\xcf}ƶ\xac'\xa4t\xc5\xf2\xe5\x0f\xb0\xcbE_\x9f\x17e\xc0\x91\x82\xbb\x16,@<у\xaa\xc7|\x7fnT\x01G\x06\xeez\xb0F<\xc1\xc0\xc8$B\x88@\x9e\x1fE\xc0\x91\x80\xbb\x1e\xac\xaa\x1b\f\x8cN#D\xb0ŏ\x1a\xe0\xd0\xc3]\x0fVQ5\x86\xc6v\xa3\xaaZW\xca\x13%\xc0\xa1\x86\xbb\x1e\xac\x10\n\x83\xa3\xd3h\x01\xb4\xb3\x9b)*\x80C\vw=X\x80\xde\xcc\x18F<\xd1\xcdb]Q\x14\x00\x87\x12n=\xb0\x00\xc5\xdcb\xb7\x8aTWa\a\x1c:\xb8\x8d\xc0\x02\x14s+\x94\xf2+\xdd(VC\x85\x19p\xa8\xe0n\x06\xb6\xaa\xa5\xcb\xe7\x83,RS\n+\xe0\xd0\xc0m\x06,\x80U\xccS*\xe4\x82*V\xd3\n#\xe0P\x84\xd94\v\xb6\xaax\xb2\x97\xc1\xd1)\x00\\\xc7\xc6u\x1d\xa4\xe3\xe0\xba6\x8e\xed\xa0\xa8\n\xf1d\xaf\xafen\xa40\x85\xect\x1dn\xab`+\x12(\xaa\x8a\xeb\xdau\x03S\x15Ue\xe7\xd4\xc1\xc0\xbcW\xeb\x15\x16\xc0]5\xcb\xed\x81\x05\x90\xb8N}\xb0\x00\xae\xe3P.v\xcft\x87\xc5Dw\rn\xfb`\x9bS1\xdb\xddaS\x18\x00w\x05\xae\xdf`\xa12l궺\r8p\xb8A\x80\x05\xb0\xed\x12\x8ec\xfb\xf9\x88\xa6\xd4M\xc0\x81\xc2\r\n,\x00\x12\xcaŬ\xef\x8fiF\xdd\x02\x1c\x18\xdc@\xc1\xae\xca.\x97\x83z\x14\xaa\"ؑV\xb9a\xdc@\xad\xd3K\xef\x06\xe0@\x86BA\x835\xcc$=\xfd\xc3\xc4\x13\xbd\xe0\xe3\x13\a\x92*\x13\x83*\xbb\x064\x86R\n\xca*ԗ\x8e\x17y\xeb\xbcU\xf7\x9a \x87I\xbe\xc3\r\x12\xec\x15\xa8>;0\x06\x92*\x9f<\x10'\x1d\xafo\xf8\xb2%\xc9\x13\xaf\xe6p\x1b|\xb7A\x01\xf6\xd5,\a\x056\x96\xe8!\xb3k/\x99\xf1=\x81x\xa6T\x85\x86`\x01R1\xc1\xee\xe1\xc6\xc1\x04U\x13\xfd\xc7O\xe4n\xf4\xa3|U\xf9\x067(\xb0\xf1T/Cc\xbb\x03\x9d\xe7-Y[[\xbb\x0f\xed26m\x11\xa4\x90\xe9\xa4^z\xf5\x9bO-|ֻ\x92\xd5\xca\x17\xb8A\x9abǮ߶\xf9\xa9\xa2\xbd5\xdc^Sa*Ӹ\xf6\xc64\xc1h\x9f\xaa\xa5L\xe51\xbf\x00{\x0e7\xe8Γc\a\xd7#\xae\xcardS\xb9\x16n\x9c\xa8_{\x85\x80\xa9\x8c\x86\xaa\bTE\xe0\x17`O;T\xdd\x18\xee `l\xa6\xf3\xa6+\x11S(Y\x12\xc7m\xee\xfb\xf8\xecGS\xc4\xf5\xad_\xf3̼\x8d\xa6\b\x921\x81\xa9\vtUPo>\xc3q%ق\xfb\xc0\x9f\xde\xdf\xffx\xabeo$\xcfjnW\xc0Be\xf2\xa0\xc3\x1f\xe8\xe4\x90\xc6oޔ\xe0\xfe\xebMbZs\xc5o\xa6\xdd\x05\x98\x18\xd0\x18\xedS\xe95\x15\f\xad>X\xc0\x97\x1a\xec\tܮ\x81]\x95+ݶ\xae\xd3T\xb8kO\x9cO\xee7\x89\xe9\x15'įݘ \x15\xdb\xfa5J\x8e\xf7CH\xaf\x01wl\x96\xbb\r\x16 \u0557\xc1L\xf7c\x18\x89\xa6\x9d\x16\tC\xe1\xbe\x03&\x83=\x1b\x7f\xdf\xf9\xb2\xcb3\x87\v\\\xcem\xfc\xd1\xf4\x9a\n\a\xc7\f\xf6쨴\x99~\xc8+\x13\xdd\x11\xdc0\x80]+U30Si\xccT\x1fF<\xd5\x10\xf4`O\x05l\xc2hl\xb8,\x1b\xfe\xe3h\x9es\x8b\x0e\x00}\t\x85[&\r&\x06\xf4\x86\xa6\xd5Ky\x01\xb8m\xb8a\x03\xbb^\xaaf`\xf6\xf4\xd1ӗA\xd1\xf4\x9a\xcf~\xfd\xc6\x04\x99\x1eu\xcb{8\xae\xe4\x95wK\f\xa6T\xf6\xee\b\x06\xea\xfa\xe7w\x02\xb8-\xb8a\a\xbbV\x99\xf1\x19\f3UsljH\xe3\x9e\xfdf\x97JԚ:\x01\xdcr\x87*J`\x01\x84\xaao8vj\xcef\xa1N{\x1aFu\xd2\xc9j\tn\xd4\xc0\x02\xa8\xca\xc6W\x94\xc0\xa1\xf7J\xc1\x17\xa6M\xb5\v\xb8i\xb8Q\x04\v\x02E\xab\xef\x02<5g3\x9fs\x02.O\xfbj\apSp\xa3\t\x16T]\xa7Q\x97YJ\xf8\xe5{\xc1\xbb.;Q\xab\x80\xb7\xecPE\x15,\xd4\x06\xafד\x00~\xe3\xc3I\xfa\x93\xfeL\x8e\x15,\xc9|\xcee\xb9ಘw)\x94],g\xd57-AW\xc1\xd0\x04\x86&\xe85\x15\xfaL\x85\xfe\x94\xba\xa5\x13\xa5\xd9N֦+\x98\xa3\f\x16@7\xe2\x9b~n\xe8\x02/\xd3-K\t\xe7\x97\x1c\xce\xccۼ\xbfh\xb3\x98sۺ{*&\x18\xe9\xd3\x18\xef\u05f8fP\xdd\xe0,YS\x83\xd9\fpÚ\x1b\x05\xb0z,N<ً\x10*\x8a\xaa\xa2(\n\x8a\xaa\"\x84\x82\xa2j\xa8\x9a\x81\xa8ӡ\x82J\xad\xf9\x95\x83\t\x86\xd3[\x8fw\xb7R\xb6$y\xeb|\x99\xe3\x17-r%oݒ\xba\x06S\x83:\u05ce\xe8\x1b\xc6\xe6[\xd5\xe0\xbap\xa3\x00\x16\xa0ox\x9cdomJ\xe5t\\\xe1\x86]\x06'.Y|\xb0\xe8ԭ9B\xc0\xa7\xaeK0\xd6\xdf\x19إ\x82\xcb\x1b\xef\x959q\xd1n\x18R\xe3\xa5\xc6\xfaT>4a0\xd2{\xd5\xe0n\x06x\x03ܨ\x80\x05\xd89u\x00U3j\x8e\xddw\x9dɮ\x81\xca˗l\xc9\xc99\x9b\x13\x17,.,_\x05}\xdbt\x8c\x83c\x06\xed\xcar\xe1\xffΖ\x99}\xaf\xdc\xf4\x14\xa1\x97\x9a\x18и}&~\xa5mn\x04\xb8\x06n\x94\xc0\xea\x86\xc9\xf05\xfbj\x8eMg4>qm}\xcf\xd3J\xd1\xe5\xddK6%Kr\xebt\xfb95\xce\xccۼt\xbcD\xbe\xd4]'\x88\xa6\u0087'b\x1c\x1c\xaf\x04\x04\xd4\x03|\x05n\x94\xc0\x02\xf4\x0e\x8d\x92\xea\xbf\x1a\x02\xac+\xf0[\x1fIn:\x19Љ\x1cW\xf2\xda\xe92GΖC\xb5\xe3\xc5x\xbf\xc6\xdd\xfb☺\xd8\x00X\x01\x98|\xe8\x99;\xa2\x04V\bA\"\xdd_sl\xff\xa8\xe1\x1bؒ-\xf9\xe9l\x81\xc3!\x03\vpv\xc1\xe6'\x87r\xccg]TE\xd0c\xaa\xff\xf4\xf0Ss\x1f\aP\x0e~\xfdM\xc3-\x8b\x9fG\x05,\x80\xa2\xea5\t\xc6t\x15\x0e\x8e\xb7߆n\xa6\\\xc9姳\x05.\xae\x84כ\x95+I\x9e\x9c\xcdqn\xd1AQ\x10\tU\xff\xd9\xe7_GW\x8a\x97\xe6\xbf\xefX\xe5\xee&vjQ\x8e]\xe6\xe2{Ǯ\x04\xc7\xed\xdda`6\x11\xcfԪ\xb2%ɿ\xbd\x91\x8f\x84\x9b\xd2r\xe0\xd9#\x05\xce-:\x98\xba\x8c\xa9G\xce\x7fOM\xdf\xf8;?t\x1dg\xf3\xd1~\b\xe5:6\xf9\x95E\xe2\x89\x14\x8bE\x95\x1eSa\xc0COSђ<5\x9bg\xa5\x186C\xdcXR©\xcb\x16\xc2\xc9\xe3\xda\xf65\x8ac\xd9\xe9\xd05$M\xcau,.\x9d=A.\xbb\xc4\xf3o\x17\x98=덯\xd8q%\xcf\x1c)\xb0X\x88ƴ\xe0Z\x95\x8ae^<2\x8fe9iŕNŞI\"\xb9\xf1\x99t\x1d\xe6ϟ$\xbb8ǫ'K\xbct\xbc\xd8i0$\xaf\xbc[\xe2R\x88\xdb\xd8Frl\x8b\xfc\xca\x02\x96#9z\xbe\x8c\xa2\b\xb5\xf6\xab\x88\"`)Y\xbcx\x96Bv\x89\xb7\xce[<s\xa4\x80\xd5&\x9b\x93s6G\x1b\xac\xd0\v\xb3\\Ǣ\xb0\xb2@uh\x9b-9ˊ\xa2\xe9K\x1bΌ `\xa1(\xc4\x12\x95p\x9a\xb3\v6O\xce\xe6\xb0Z\\X_\xb2$\xff}\xdc\xdf\xcc\xeb~ȱ-\xf2K\v\xb8\xee\x9afD\xa8o*Z,\xfe\xefu\xaf\x88\x18\xe0\x9e\xfe\x1d(\xcaU_q\xb1\f\r\xe6\xe9\x1b\xea\xb5S%\x8aM\x06\x9b\x87E\x8emQX^\xa8\x89\xdd\x16\x80\xa2\xa9\x8f+\xefZ\xf1\xdfS4\xb5\xfeo<\"頻\x1aɾ\xda\t\x84\x99a\xad\xa5u\xd7sY\x97\xb7/D\xcb\x1c\xd7\x03\v\xa0hƙه\xf7=\xaa\xc8\xef\xdeli\xc2\xfd4B\xd4G\xd9e\xc0\xc9\xf4હm\x8c*=4ZSk\x01\xf6\xec\xd8\x18\x18\xb7\x99~y\xa6\xdcqG,H5\x02+\x84(\xc8\xfc\xca\xdd\x12\xa4\x02p\xf2\xfb\xbf\xfa\xb4\xaak\x0f\t\xa1\x84\np\"\xddOߎ]\f\x8dͰs\xea@%߲\x99\xac\xe1\x1c3S$zjw\xa0\xe9\x89+\xf4%\x9a\x1f\xf3.\xe5]\xce\xccG\xa7\xd66\x04+E\xa9\\.\xdc\xfd\xe6\xdf\xdeq\x1a\xd6\xc4P\x9d\xf9\xde}\x7f\xaf\xa8ڗ\xc2R\x83\x8dX\x82\xbe\xe1\x89+\xff\xab\x9aN\xaa/Cf|\x0f;'\xf6\x93\x1e܉n\x98\xf4\x0e\x8fo\xa8\xd4\x13\x83\xad5\xb6\x87\xcfE\xa7\xd6V{\xc5u\xc1\xca\xe2\xc7N|\xfb\xce\u05ee\x1e[\xf7V\x13\x9f{\xf6\v\x8ec}\a)\xeb\xdb\xc1\x00<Ъ\x11#3\xbeg\xcb}\fTE`\xac\xc6!\xe9\xaa \xa6UBg\x0e\x8e\x1aMGX8\xae\xe4\x9f_\xc9QjbAu\xb7\xe5:\x16\xf9\xe5u\xbdbր\xfd\xeb;^\xad=^\xe7'\xdbM\xc0\xaaf\x90\x19\x9fA\xd5\x1bO\x04\xdc6\x1d\xe3\xc0\xa8~%{L':9g\xf3\U000e314e\xef\xe3\xb7Z\x05\v\rB[\xcf\xfc\xe0\xdeGUU\uf289\x8e\x99\x89M\xc1\x02\f\xaeI\vԩNυ\xbf\xadm\a,l\x12\xb7\xdc-\xc0\x85\xecҖ[\xb8\xf5%:\x0fj\x83\xca+\xbc\xbf\x14n\xffq\xbb`a\x8b\xa0\xf4n\x00\x96R\xb22\x7f\xa1\xe1\xe7\xa6.<\x9b\xde[^\x8d%\x0e\xab:\x01\vM\xac8\xe8\x06\xe0Bv\xb1a\xedM\x18\xde5\xf8\x97\xb2\xe1\x9d\x1c\xe8\x14,4\xb9\x9c$h\xc0\x95\xda\xfbA\xdd\xcf\xe2\x1e\x86\xd2,\xe6\xc3Yk\xbd\x00\v-,\x04\v\x1ap~e\t\xab\xb4\xb1\xf6z\x19q\xb1\\\f\x1f\\\xaf\xc0B\x8bK8;\x05\x1c3S\x98=}M>M\xb2\xb2\xb0\xb1\xed])\xba\\\\\xae\x1flުr!\x8b\xb2\xf0\x12l\xe5\xba6\\3\xad\x8e\x83u#Nzh\xa4\x92\x97Q\xc2\xf2\xfc\xf9M;M\x00\x8a\xa2ҿ\xf3\x1a\xe2\xc9t\xddϓ1\xc1\xe4\x90\xceԐ\xc6pZmk\xe8\xfd\xe3\xd7\xf3,\xe4\xc3\xd1\xeez\r\xb6rm\xbb91>\xf7\xb3\x87l\xc7\xfdvèI\x0113I\xaa\x7fx\x15P\xedi\xb9\xa59\x96.\x9d\xa3\xde\xf3U\xcd`hl\x1am\x8b\x85\\U\xa5b\x82Ɍ\xce\xf4\x90\xd6T\xae\x8b\xaa\xfe\xe5\x7f\xb3d=^\xdbӎ\x1a\x82E\x94\xec\xfc\xca'\x8e=\xfa\xf1\x97۹o۽\x93S?\xf8\xd4\xdfi\xaa\xf2\x87\x8d&\x1bbf\x0fCW\xb2\xa8n\xe4\x9f\xec\x1db`\xe7䆅Z\xb1D\x0f\x99\x89=M\x83\x85J\x94\xe2\xe1\xb3e\x9e\x7f\xa7\xb5\x89v\xb7\x81\xe1\tR~\x81\x85\x0e\x93\x8cm\x06ر\xcb[&\u074c\xa7z\x19\x1aݍ\xa2j \x04\xe9\xa1\x11\x86ƦQ\xeb\xe4\xb1hFN\x8b\x89\xbfto|!m\xcbO\xb0\xe0A\x06\xb9F\x80]ǡ\xb0\xbc\xb0%`\xc3L\x92ٵ\x87\xcc\xf8^z\xfawЉ\xe3\xbaո\xa9V#5\xbc\x94\xdf`\xc1\xa3\xf4\x80\xf5\x00K\xe9\xe2J\xb7)\xc0\x9a\x1eÈw\x9e:\xc8rZK\x03\x19W\xbbc\x96\x83\x00\v\x1e&\xf6\\\x0fX\xae\xce76\v\xd8\v\xb9R\x92o\xc1\x9d\x986\x83\xdfV)(\xb0\xe0q\xbe\xe5\x1a\xc0k\xb2\xa9\x06\t\xb8\x95\x95\xedA\xc3\r\x12,\xf8\x90L{-`\xb9&Z\xc0o\xc0qCp\xcbd\x8c\xfeT\xf3\xbd\xa4\xfedp=\xaa\xa0\xc1\x82\x8f\xbb\x93L\xfe\xfe\xd3_\x8a%\xfa\x1f\x11\x9aV3\xa6Q\x84\x82\x99\xeeG\xd5\xda\xeb\x11\xaf\x97\xa9\v\x0e\x8e\x1b\x1c\x181\xd0Zde\xb9\xf0\xf8\xcbY\xdfW\xc7w\x03,\xf8\xbc\xf5\xcc̗_\xbcYS\xcd\x17\x80\x9aޒ\x17\x80S\xb1\n\xd4kw\xea\x1d\xa5\xc6}\xf2\x8d<\x17\x96\xfd\xf3Ru\v,\x04\xb0\xaf\x90\x1f\x80\x0f\x8e\x19\xdc:\x15\xf3$\x8b\xea/Δ9tڟT\x81\xdd\x04\v\x01l\xf7v\xfco\xeez\xddv\n\x1f\x03j\x02\x95:j\x83\x05\x9e\xa5ǝɴ\x16\xbcެ\xba\r\x16\x02\xda\xcb\xcfk\xc0\x17\x96\xbc3\xa3iSi\xc9\x1f\u074c\xc2\x00\x16\x02ܨ\xd1K\xc0\x97\xb3.\xb6\x87\xcd\xe4\xbe\x11o:w\x10\x1e\xb0\x10\xf0\x16\xab^\x01v\xa5\xe4\x83e\xef\xf6\xc6ݝњڴb+\x85\t,tasd\xaf\x00\x9f\xbe\xec\x1d\\U\x11\\?\xdeYZ\x90\xb0\x81\x85.mk\xee\x05\xe0\xd3s\xb6\xa7K@\xf6\xed\xd4\xe8ma}\xd1Z\x85\x11,\x04\xb4\x7fn#u:L\xbay2\x86\xa6T\xf2D\x95,y\xe5oq\xf5\xef\xfd7$6\xdd-s\xbd\xce-8<}8\xdf\xd2;\x84\x15,t\x19.\xf8\xeb\xe880\xaas\xfb\xee\xd6\x12\xf5\xfc\xe7[\x05N\\j\xce\xe4\x87\x19,t\xc9,\xaf\x95/\xe3\xe0U\xbds\xc1jy\x81\xd7\xed3qz\x9a\xa8\xeda\a\v!\x80\v\xfe\x01\xb6\x1d\x98m1\xd5}L\x13ܳ?\xbe\xa9K3\n`!$p\xc1?\xc0G\u07b7Z\x0e\x82\x1bJ\xa9ܽ7^\xd7\v\x16\x15\xb0\x10\"\xb8\xe0\x0f`Ǖ\x1c}\xbf\xf5\xe4c\xd3\x19\x8d[\xa7j\x87GQ\x02\v!\x83\v\xde\x03NǕ\xb6\x93~\x1e\x1c3\xf8\xc8T\fA\xf4\xc0B\b\xe1\x82w\x80\xe3\xba\xe0\xbe\xeb̎\x96\xa0\xdc0np\xeb\x94Jay1R`!\xa4p\xa1s\xc0\xba\x02\xf7\x1e0\xdbvLT\x95+\x96YY\x98gjHA_s\xab\xb0\x83\x85\x10\x8cs\xb7R;\xe3`!\xe0\xde\xfd&\xbbZL|\xb2^\xb9b\x99\xd9c\x17)\xaf\xc6̖\x1dɩ9\x87\\I\x86\x1e,D\x00.\xb4\x0e\xf8\x8e\x998\xfb;\x9c\xe9Y\x0f\xb6*!\x04\x8e#~\xf7G_\xb9\xee\x1f;z@\x00\n\xadY^\xabVL\xf4H\xaf\xea+ؔi\xfcI\x14\xc0BD\xe0B\xf3\x80\xcf/9\x1d\xcd\x18m\x066\x16\x8b}\xf9\xbb\x0f\xee}\xa4\xed\x9b\a\xac\xc8\xc0\x85\xe6\x01\xbfx\xacH\xae\x89\xada\xa4\xac]R\xbcU\x8d\xfd\x87/\xec\xf9V\xc7/\x11\xa0\"\x05\x17\x9a\x03\\\xb4$Ͽ\xdd8\xa9\xb6\x94p\xec\x82ſ\xbe\x96\xe3\x85w*\xe7m\x056J5\xb6\xaaHt\xa8꩙N\xd6\xfe\x11\x9d;f\xae\xce\nI\t'.Y\x1c:]\xaeI\x990\x92\x96\xf4\xb2\x8c\xedl\x04ۓ0\xbe\xfa\xe8\x1f\xec\xfd+\x7f\xdf\xc6\x1fE\x16.4\x06,\x84\xc0L\xf5\xa2\x19qn\xdf]\xe99\x9f\xbal\xf3\xfa\xe9\x12K뒜\xd8\xe5\x12\x85\xec\"\xa6\x0e\xd3\x19\x1dC\xbdz\x8f\xa8\xd6ت\"\r\x176\x01\x8cD3Lb\xc9$}\x89؆\xe4&\x8ecc\x15\xb2X\xa5\x02r5\xb8UW\x05\xd3\x19\x8dTL\x89t\x8d\xad*\xf2p\xa11\xe0\xaa\x14UCU\x15\x84\xa2\"]\a\xc7qp\x9d\xfa\xe1\x93\xe3}\xba=3\x12\xff\xb3\xa8\x83\x85m\x02\x17\xb6\x06܌\x04\xa2\xf4\xd1}\xe9\xaf\xfc\xf0\xf33\xdf\xf1\xb0h]Ӷ\x81\v\x9d\x01\x16\x88Ҡ\xe9~\xfa\xc5o\xdc\xf2\xac\x0fE늶\x15\\h\x0fp\x14&\x01\xdaѶ\x83\v0\xf9G/\xdfd\xea\xb1\x17\xa4\x94ɭ\xceU\x10\xf9\xbcp\xef:\xf5\xc8-\x87\x82([\x90\x8a\x9c\x13\xa3\x19\x9d\xfa\xd6\xed\x874\xb2\xfbUM?\xdd\xe8\x1c\x01h\x9a~F\x15\xd9k\xb7#Xئ5\xb7*\x01↿x狮c?\x80\x94\xd7J){\x84\x10+\b\x8e*Z\xec\xb1هg\x1e\x95]\xdf\x7f\xc5?\xfd?7u\xd2W_^\xf1;\x00\x00\x00\x00IEND\xaeB`\x82\x03\x00V\x13%\xe6\x1c\x11\x00\x00",
+	},
+
+	theme.IconNameFileAudio: {
+		Name:     "audio-x-generic.png",
+		Symbolic: false,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x9c\xca\xd3_$\x8e\x03\xee\xf1ɶ\xfb\xe6\xdal۶\xb5m\xb6mo\x9cj\xdbjs\x93ͭͶ7m\x9b\xadɵ\x935s^\xe7wq\xfe\x80s\xf1ܼ\x9fO\xa2\xb6\xa6\x12\x16:9:\x00\x00\xc0RQ\x96\xd7\x05\x00\x00\xbe\x00\x00\xc0\x15\x15\x19\x00\x00\xf4Pg-\x02\x00\x004Oe\x13\x1f\x00\x00{\xe4\xff\x0en£\xda\x16\x00\x00\x90\xf9*\x18\xfb\xeay\xd8\xfb\x06Xy\xdb\x01\x02\x02\x028\x9d\xdc]|l\xac<\xed8=\xbc\x1d\xf2\xaf\xc5\xc9\x01\x00\xb2\r\x15y\x19\xfd\xc0\x82+PD+\xa5\x91߮\xd4[Aד\x84\x84I{r\xa2\x06-\xd6\xdeh\x0e\xb5\xe2\xda\n\x8e\xde\xd2\xdf\xd8i\xd6/\x1cn*$vx\x9b\t\v\xef\x91\xf5\x84\x8e\x8ep\xb0\xf4\x86\xdaqZ?תXK\xe5\x16S\xc3\x1aʄ\xea|䖖\xe2/k\xad\x18\xd1\xffR\xd7Zb8\xa6\x10\xd7\xc7\xc7\a8L\xb2\xeew\xfa\xf8\x1cn\xa0^s\xb3\xa0\x90\x9bޢ'\x93\x9b\x97\x87\xb0U\xf2\xb3\x9d.>\x87\xc7\xf5ݾ\xa7u\x87\xa2\xdc k\xfbzB\xd7\xf5\x9e\x0f\x8f\xfc\r\xc3d\x0eV\xffv\x1a\a\xf8*\xf8\x8bH\xd7\xc41\xb64\x04\xa5(\x87Hj\x84\xb7٣\xb7\xd8V\xf7\xcd>\xab\t\xbd2AN.?\xd9\xf9\x01p\xba\xd1\xeb\xec\xc0\xaez;\x1c\x98\xbdM\x9c~0\x92:\xf2\xbd\xa31\xa6i\xeb\xe9d\x9e\xc2T\x99\x9e\xcbDVh'b\x9dKy\x9f\vi\x13\xb95۴Pa\\'\x8a+p⡣\xc8\xe7wƝȿOJ\xa7\x95=\x01\x9a\xcauo,_բ\x9a+\xb2\xc0\n\xe3<1u\xab\x12\x9b\xfd\xd0\xf6\x8e\x1b\xe6\x13\xefa5\xe0\x02Ǔy\xbb\t\xe8\x8d\xd5\xf3Dw\xc6;\xfa\xdf\xd7\xe2י\xa7\x82\xb7\xdcx{\xb1\x85\xbe\xe7\x16vl\xc1\x9b\xfe\xf7\x91\xf3\xad\x80\x17X\xaaz$F\xafOD\x9f\xd7\x13\x11̳\x1d\xc1\x02?\xc6}\xc3g\xec\x13#D\xc1p\xc7X\x84\xba\x80u\xd8\xc2u'l\xaci\xcew\xa1\x05\xa7+\xdf\xdfc\x80\xbc\xf9J\x8dc\x827\x1a\xdd,\x14\x1c\xe7ҿq\x7f\xd8\xc2\xe8{WxE\xcd\xf3k\x9e\xa3\x8db\x06\xf7\xcb\xc8GY\x8f\x90Y\xd8\xc5\x1e\xceL\xd6Bʓ[D\x9d%\x8cđ\xa3\x8d}\x86P\x8b\x84\x11\xbc\xfd\x96\xa5%e\xb8\xdb\xc5>\xb1\x80\x15\xc2)f\xf0\xb3\xa9Y\xf5D\xfd9w\xf6L#Z9\f\xdc\xc2\xd0\xeav\x1d\x10Y\xf4\xc5Y$KB}'\x1cz=#i\xbc\xdbv){=.\x91\xedF\xe8\x13\xf0S=ō\xec\xe0̵\xe5\x03\xa3\xc3\xe2\xe7!*\xbb\\Eb\x17\x9bI\x9c7\x87ͻ:\xcf\xceK\x03d\xa6\x05\xb2\xc0\xd1\xd3\x14\x9c\xa1\xba\x860Y\x8f\xd2T\x8a\x1c\xe2\xbc=e\xd3\xd4\x7f% V\x99\x95ɔC80ǚ\x8b\x11Y\x10~\xaf\f\xe54\x96t\x89q\xfd\x98\x9b\x9b5g$\xe1\xb4ϯ\xb4\xa4D\xfd2\xd4\r\xf4\x877d6Ddw\xdc\x02\x02\x98\xe8TE<9\xe2-2\xd2@Ktb\x15}Օ.^\x7fϲ\xec\b\xbc;.\xb7\xa1\x82\x06o;\xd6m\xd9\xd4\xe7\xba\x14\x86[y^\x9e\xf0%\x8aG\xba\xb8\xa2\x00\xa1\x13ҷ\xcf\x04\xb6U\xec\x1b\xb3\x97\xcf\xfa+\x92~\xeb1fz\x9b\xdd\xc2Xdz\x11w\xe2\xa0\xf8oVc\x10\xee\x15FG\xa5\xdc\xd0Sf\xd0\x04\x13I\xddT\xa2\xff\xe4nw(*\x11tκ\xd5\xe7\x12\xeap\xf6~\xbff\xcd\xef'\xbb\x10\xe6\f\xbc\xceH\x92\xab\xd5\v\xdbl!\x93\xe31\x97r&\x00\xc5\xe3\xfc\tzF\r\xdf\x0e\xecr\xc1\xef\x1c\x93\x87\x9a\x81v%\xaclR\xb7\x1f\xe6\xfd)\xa8\x1fD1\x86L\x84\".m\x17oYGw\x80\x105\xbaw\x82\xa5\xfb\xfa\xbbQ\xc2p7\x8c\x88%Gߜ\ue21f\xec\xe3\v\x1a\x01\x11\x9d\n\x17\xc7\x19\x91\x16\x8e\xff\x11'\xcbE\x04\x01B1\x06\xdc)\"\x96\xf2\xf2M/V\xcd\xfa\xc1۫0[<\x85/\xfc\xbd\xfb'\xa9\xfd&r\xff\xde\x12z\x11\x06\x9b\xa6r\x84]\xb6\x95\x13\xd3ɵ8\x93\xbe_\xac\x16\xfa\xf3\xd2\x7fG\xe3~8\x04\xa3f Is\xd2\rt\xdd\x18\xb9o\xb5\x9bM!\x06\xee\x1d\xcc_\x89x\xde\x12\xdd\xf0_\x9aFr\x13\xa1N\x8c(\xbb\xa1{O\xb7д\x8a\xd9](\xba\x95\xddMZ\x01ԏ\xcaP\xc2/\x06\xde\xf7'eA\x80\xb5o\x94\xc1\x96\x9b$\x9c<ϩ\x80\xfdX\x91mA~\xbd\xa4+;\x19Ǔ\x964\x8fG\xd6B\x91o\x83\xd9\xd8\xe6\x10&.4ދ@\xcf\xf7\\-@D\x05hQ\x9c\x90\xa0\x83\x1d\x95\x92\fي\xb7\x88\xdd\x14Z[AlG\xfb\xf3\xe9\x1a]\xf8\xa3\x93X\xd4[\xf6\x17\x1d\xaa\x80\x01\xaa\xff]\x99\x98{\x90o\x8d\x9d\t\xf3\xa3\xc5}\xac\x1874x\xd9\xdf\x10\xb1\x04p\xf2\\\xa4\xc61R\xed\xc9\x01\x1bPr\xb7\xe1A4\xc1v\xf4\xfb\xd8\xfe\x1f\x15J\xd0w4\x93F\xb3\xa3\x88\xdf\xdfoTe\x9b\xc6\xe9к\x98\xde\x05_\x96\xa0\xb1}\xd5F\xd8ls\x19%\xf4\"\x93&\xed\xb2\xef\xe2E\xfd\xa2\x10k kDj\x88\xf6\xc5\xca\x18/F\xf5\x84\xd8Mǋ\x85\a\xda\U000a8b12&\x1cb\xab\x80\xdb\r\xa4\xfa\xdf\xf9\xc97\xd6*s\x81(\xee\xff\xd5\xff\x9f\xb0X\xb8\xd7o\t\xcbl2\xb0\xad\xdb\x17\x04\x9e]p\a5\xa0r\xf1\t\xa3q\xf3\x88U\xde&\x0f\xca$#\xa0\x06\xbcn\xed\x06\xbf\xc5Qr\xad\x83u\xf2<w\x10\x8c\n\x8f\xd17Y\v\xefF\x85\xffK[Mh\xde9\xb0\xa8D\x1d\xe4!(\xcc\x1a\x94\xf9\x19\x16\xb7\xad2\xee\x16з\x9f7Ar\xf8J/1\xd4w<\x04r0]pC&I\xf2\\IFY\xe5\xd23\xff3\x98\xfc\x86\xf4\x96\x85\xf4\xf1\x1c\x8d\x145\xd5#h\xc6\xc73\xfcBo\xf5\xa7pƂ렝֛\xe7ֹ\xf8\x91\xe1q\x8f~]\xf0\x9e\xa7v\xe4\x9a\xe8Y\x8d\xaf\xa7 V\x0f2\x19-~\x1f\x8d`ԧ\xa0\x01\xe5\xf0ģ\xeb\x87JNv?\x8fx\xaf\x0f\xea\xc4\r\xfe\x17%\x04\f\xf8\xc7\xd2R\x11!7X\xd0\xfc\x12\x9f\r+\xcd\x1d\xd0/\x88 \xa1i\xfe\xcc;c\xf30\x828!\xe1\xddH\x06\xa6K\xbe\xcf\x03\x05\xe1u\x1fCAM\xdcM\xabѽ\xd4\xf2\xac\xeb!\xb0\xfb\x92\x88T\x83R\xa5\u0558g{m\x04\x02\\\xb9\x82\x91Y\xcb\xfb\t\x06\v\xfd\x82\x9e\x90\xb7\xba^0\x9f\xe6\xbb ^\xf5\x8e\xa7\xa9\xbf\xd6\xc9k)\xa2\xcd`'ܐ\xd9\x158(]\xccA\xb6\xe3r\u05eb9H\x92k\x92E\x13\x91\x89fn,I\x94\x0e\x0f\x15\xb9\xdaĻ\xb5\x9b{*\x12%\xd1.r\xb9E\x0e\xe3t6q\xb0\xb2o3\x82o\xe6H4\xaa\xcd/\x95\x10e\x8eB\xb3<U\x9b\xfe\\\x96ŏ\xf0I\x11\xf3\x9c6\x9c\x98\x81\x8a\r!~\x1e\xee\x17~\xf0J\xaa\xa4\xa0\xbfs\xe0:u\xe4{\xf0\x8c\x88\xe9\x1c^JK\xe8C#B\xe2\xb0\xe9y\xa4*\x83\x81\xc1YD43>\xc9<[\"%\r\xd1Eе\xb8؝\xf5\x01\xc7S\x98\xb7\xdbfʇ\x03^\x90\x05H\x99\xeb[\xd6]\x82t(\x95\xe4Y|\xe1)p\xec\xf7\xc1\xc8\x14R\xb4\x0eS\xc0\xda`HyVwn~\x01\x1f~\xf0܇\x98\x96\xe9\xcd\xcan\xba\xc8\xd3\\\xbbO \xd83Rx(\x11\x01\x11o\x84=(\x93a\xff\xaf6O\xb4p\x01\xd9\x10\xa1\x1b\xae\xd9,7\x19M\xdb\x16\x0e\x82\xb3\x89\xa8\xeb\x9a\xcfz\r\x87\xd0#IBo\x03\xc3\x1f\xb4\xe4\x17bɳ\xe1\xc4=\xdcQ\x13\x0eb\x05\xc7R\xae\x1c<\xf9[ҡd:\xc7\xf3\x88\xa0\xd6\xd1j\x06\x84r\xfc\xb2U3}\x1e\x8e\x92\x88\x00ا\v\xc6\xf7\xe7\x8e\t\xb3\xf6\xae+\xbe^\xd4\xdcN\xc7\xc4\x03\xcf)1\xfa\x92H\xe5y\xb11m>x\xc5\x12Ui\xe6e\xe6\xfa\xfbU\x19H\xfa\xa0\x00|\x85\xfb\x98\xda\xe4YeO@\x7f\xef\xf6\xe3.^\x9aPł\xfcI\x8c\xd7\xeap\x88\x12\xe3\xe2d\xdfE\x90=\x03\xc3㘮\x14\x7fZ\xb50\xbc\xaa\x15\x90\xa0D[#f\x153\xe4\xfbr\xf0}\x1eRJL\xa1\x0fl\xeeѴ\xe3d\xa0i\xea\xfd\xa4}\xc61qol\x7ft?\xace\x94\xd0\xfb\x13\x9a\xa5\xa6u\x8eF\x99\"\xed\x9e6\x85l\x14-s\x8eN\xf5\x03\xda\x16F\x87\x1f\xda\xf90\x17(۽;\x04\x1c\x93r\x1cq}n\vt\x18v\xb0\xe5<g\xf0Y\x14\xebC\x95%\xeb\"%\x8d+\r\bNLc\x13\x92Q\xf7\xac\xdd#\xa8\xa9\xfdX\xac\xbd\xce\xf5\xa3\x9c-\x05*ʮ>\x1f\x8c\xf3솃sf\x1cEH\x90H\x94\f1\xb8\xcc˧\x17~\x0f\x9aa\xe2\f\x95f\x8b\xfe\xb0\x94\xa5\x05\x16O\x01\xc4\xe9T\xa9Z\xb5\xf6\x96\x88\xdf\xe4<*2\x91\bxY]\xd9a-?\xac\x04\x1f\x82\xdc\xd8\xe8\t\xb4NJ%y\xbd\x02U\x82y<\x1b\xa5Xt\t\xdbr\bc\xf9\xdf\x13d\xb8'\x15\xa4\x89(\b\x8dU\x19\xe3\xef\x80\xe6\xf5y\u06dd\xf1\xa8ې\xc1oOma\x8f.\x87\xe9\x8b\xe99\x88\n%\x94\xb9\xa8;\x99\x94\xca\xe6\xaa\xcb]\xf0'ղn\xd2\xcc\xeae\x9c\xc9\xcf@\xbd$\xfa\x1aQz\xe6{\xf1_\x84\xddE\xeaC\xd6\xe5V+\xe1좂W}\x90\xb2\xe8ު\x02UT\xee\xfd\xcdV\xfc܆#\x90\xa4\x02WѸv|\xb9\xb8\x8en\xe9u\xb0\xdcw\xd2\xd8(GuV\x8d6\xc1\xbf<\xdez\xc5,M9o\xb8\xcbJ\xa7\x9f\x9f̽\x90\x1b.\x93\x0e9\x93dŴ\x18e\xd5\xcc\x04\xcc[\xcfѤ9츪ؐ\xe2\xf84\xf6\xd7h5\a\x11\xffD\xe2\x81s\x81r_\x06\t\xe3\xea\x1bu\xd6\b\xfe\xf3\x17\xbaꃀ\x87\x1a3\xbd\xd1\xd3\xf1\x8b\xb3R\x19ڿ\xa8\xd8/\xc7\a`\xa7\xea\x97g\xc9U\x9b\xbc\xf3)\x1f?\xb3\x10\xd3\x13\xd1)C\xd4\x01zr 9\x1e\xf4\x8al#~l1\xbe\x8b\xd2Tm?h\x1c\xcf\xd4J\xb6|\xb0\x12z۷d\x86\xde\xfb\xfa\x96\xda\xcbl4\xbc=y\xb4\x13O\xe2I\x90Um\x9a2\x8a=\\ۚ\x97\x87l(\xdeFd\xb1\xee[\xaf\xed\xe2\n\x94\x80\xee\xd0.\x8b\x9e.9\xc4\xe8l8\xdb\x16\xfb\xf4\x93\x1a\xde\xcc'E\x83\xe93\xa0N\x02-c\x02˱q\x05\xc3\xf0\x97\xe4^\x19\xe9\xf2\x1c\xd0lW\xe0\xe9x\x98\x91\x9b\xaf&'\x85\x0f\xf6M9~7\x05\x93\xeaM\xbd,\x176\xb8\x1cH\xea\x8c[p0\xabcI\xc3y\xe2\x7f\xc0mM\xeaO\xbci\x80\xd9\xf4\x8d\x86\x16+\xed\xa3\xcc16}\x8b@\xf8[a\xc4.э\xa7\xe6\x8eA\x9cid\xfb] X\x0f\x9e\x90o\x033Ѷ\x92\xb6\xe68=>k\fi\x89;\x86\x917#\xbe\xfb\x87\xbc\xe9\xf2W\x11%\xed\x94o\xd1\xf8\xf2\xa5B8N\xe5ľ\x0e\x9eK@m\a\xb3ky\x06\x1f\xaa\xa2\x13\x93\xbf\x9d4\xb2j\xc1\xa3\xf6\a\x93\x80\xa2\x96\xed\x16||Do\xcc\fa\x12\x01\nF\x1e\x8e'ł\xaf\xb9\xb8\xcc\x13\xf9\x81\x9a\xb1\xc8N\xb1\"\x0e\x97I\xf0\xe0# \xefl֔\xf6\x8c\x88\xf8\xa2^\x9fp(\xef\xac㥗\U000b4c9c\xde4\x17\xcdy\xb96nbF\xb6\xb1N\x92\x1a\xa7&a\xfc\b\xde$\x03y\x87O\xb4Z\xdcN|I\xbfV\xd3wL˙B\x90C\xfa?5\x0e\x12p\xae\xee\x7f{\xa0Ny\xcf-\xdeU\x02I\x84\xf2\x93(]|\xc78\xa0\xdcbNa6\xb8Ꟃ.PJ\x7f\xd3\xf4@t\xf8d\xdb\b\x14\xef\xe0\xb2-\x86\xe4\x18RtT\xb5Jr6\xff\\\x96V\xf5\xbd\xa0\x97%g\xbf\x80\xbeC\x98\x06\tϬHҺK{\xe9\x9e\x13Z:\xb2#\x92ꬃli.\x15\x99\xe0Cgp\xac\x91\x9e9&\x1b͐~\x18yŖ\x94\\b\xc4\xe3ͳ̏Ύ\xad\xa4>?S͂Z\x1a\xc9PR\xc1\xe4@\xfd\xfa\b2\x8e\xfb\x1do\xc1\xa2\x96[\x11 \x9a\xe8\xa3X@\x83\x9f\x84\"̢7\\;\xa9\x8b\x97\xb4@'\xaa\x185^CA\x8b\xef\x98\xde˝\xebc2@,\xe3\x9c-\xf0\xe5\x19\xf7\b\xa0~IW\x9cGa\xe4`\x846mY\xd67~1\x00\xfc\x01\xba\n*HX\xc8\x0fQn\xa8\xe6^\x86\xa4ˮ\x9dIs\xfe\x18\x92\xcc\xde\xef\x80n\a\xeb\xa1\x15\xaf\f\xa4\x13\xd6]\xb5C\x05\x9d\x89\xb4ɶt\x02&k\xa7^\x9cX6}asE\x17\x1c\x9a\x18\x9d\xe6i Ｔ\xcaO\xc7\xc6N\xd65\xafz\x86q4\xe5]Y\x95\xaeBT\\\xd5\xcc\xe5\x90b\x95\xf3\xea\xf4s\x15,*\xc7?\xe1\xb9T\xbc\xddn\xae\x1b\x94;օ\x7f\\\xdc\f\x91MTu\x1a\v\x00:Z5\x16:R\xcd7\xb1\x15]\xbf2\x1f.\xbd\x1fX\xe6\xb8\xfe\xb2\x1b|G\xd4h\xb7b\xf8\xc0\x057\xe8\xcc\xf0#W\xe7z\xa3\xcb0\xf8\x10\xde0g\xff\x8a\xae \x8a\xf2]\xb4ܣ\xac\xae\bv\x9d^\x81\x00!\xa4\xa2X\xda)\xc7\xfd\xdfkih+.$\xa2\xfe\xf0\xa6?\x8a\x16\xfbL\x9a7\xbc:\xc4ٸއ\x94͞\xad\x9c\xab\x15e?\xa2& Sb\x19\x12E#p\x91gP\x9b\xf1z\x8eU\xf9\b\x9c\xd1\xe2\xfa\x99\x18\xba\x19\xa4\xb3\x91\xfeh\xdb\xd1-\vS\xe4ە\xcc\xf9f\xc7\xe8nSÓ\xa9o\xc6do-\x99\xd9\x10\x1dH\xc1\xf2@\x9c\xe4\x14@N2\xedIp\x91I\xae\xa2\x80VYC\x9f'\x9a!nL\xf8\xc7I\xde\xe3|\x8a\"\x98)I\x9ayr\xbc_\x9c\xe3:\xe9\xa8KY_\xfa\xb6\\6\x8a\x14;\xc9\xe7\xcc6'k\x92\xddyH\x9f\a^[\xf9~'\xb2\xb8\xd2@\xa1\xa1` \x9fAW)\xafTEl\xe4\xf2\xb2\x85@\x15\xa2\x88\xd7\xcb\x12\xd5j\x96e\x88̍Ԧ\x7fp\xd1\xf0n\x82\xaf̑\x86Nh\xe8O\x83\xa7ޥ\x98\xc7n\xa8\xda8m\xac\x87.`,\x90\x87Q\x96\xc4M\x91\x04\xda^NN{\xfd\xb4\xa7\x8a\xa0\xe0\x99EL\xe1I\x03\xa4\rL\nB5\xe8\xcf\x1bn\x1c;5\xca\xd1)\xcf\xcc\"\xc5\xcaXD\x90\xd1\xd3Ϸ\xcbuU\xccL\xb0%\xbb톄\x94\x90\xafr\xeb\xa0gג\x93W1~\xf91\x8b\x16\xa7$\xb07\xf6\x89\xf5SJZzV<{\x9ai\xb0C\xbd\x051\xf9\x13\x8b\xddP\xb7\xa6\xad\x82[zp\xb8\x01]\x9d\xc3'\x8c\x98\x84{H\x82\xb3\xc3{\xd5g\xfb\x1b\xc6\xc0\xc4X\xdf\xf3\xfc(\xfc\xf4k]\x05e]\xec\x12'W\x0e9\x8fW3w\x1d\aӳ\x86\x9b\xeb\xfc Ѻ\xdc\xf6\x82\\\xd0\xca&\x1e\xc6\xe8\xbfҼ\x15\xdeL\x8aO\x1d\xcf}uQ\x8dd\x94\x18\xbe\x06\x7f`\x8aJr~f\xd9k\x9a\ue28d\xaa%\xb4\xe9u\xb4\x8dI\xac\x88\x7fe\xbc\x90\xc5۴\x96rCtO\x95\x9b\f\x89\xe4g}7\x06\xd4\xf1\xd4\xf5F\x13\xbb\x99\xf9\xc3\xe7\r\xce*\xa1\xe3\x1caB\x15_\x17e\xceL\x8b]T\x1b\xf3|Kk(\xe9\xabu~\x88\xaa,\xd6\xebJ\xeb\x8dF\xe7\xfa\xa5\xd5\xcd\x17\xd8\xf4\x86+[\x01;\xf4\x97pS\xc8j\bԭ\xbd\xb6\xc9\x02노\x14t\x91\xd5\xecR\fx\x8bu\u0894\xdd\xe1\x10\xe9\xba2\x7f\xfa\xd9T튻\x89F\xc7\xf3,\xedN\xacy\xee21\xd6B4\x11dy\uf353\xdaH\xd3\x12\xac\f\x7fs\xa7\x04\xd0\xdd\x1b\x16\xc7\xfcp\x1e\xf5\xe2oh\xadVTb\xc1bh\x12\x17:\x9f\f\xaeq_\x92\xc6\xf2Y\x88\xb5\xe2\xe4\xabK\x95NK6\x93\xf3kLd\x88H\xf9\x84x\xc3n(*[\xe0\xda\xf3C\xae\x8eQ\x97\xa3\xf2.\xb6r\x93}\xfag\x9d\xaaU\x94\x19\x98\xd4\x1d,\x1a>^f~\xcf\x04\xa0+\xc1NwO\xee\x94=\xc0C\xa7\xdf\xee\xb2\xd4\xff\x14\xdfz\xf4Y\xd3m\xbam\xc1[\x81\xd58\xa1\x9c\x91\x92\xe8\xcf\xf6\xb0)}g\x84\x03B4Q.K\u008dT+7\x1bSҙ\xbb\x00Ʉ\x06\x13bJ&\r\x1enCE,\x8b\x03:)q2M^:ܧr\\\x83\xfb4\x13\xbe\xfdW0\x83\x02\xf5ЯAQ\xabr\x9e\xfb~\xacU\xca\xdd\xce'`\\\\I\xc0\x84t\xfd\xfbh\x85\xb4\xb6u\x98\x10\xc1\x90\x88\xf6_c\x15U\x03\x9e%R\xd5<\xf8,\x93\x86<\xdd\x18\xf9ZE]9\x01\xcf\x15\x82\xe1!\xf1\xe1`A\xc9\x14\xd5\xd6\xffDM\x9a@\x85{\xb8\r\x8aX\xbcl\xd9c\xd6&j\xb8\x05IlL?0\xb6\fj\x9a\xd99\x95{\x0f\xb9\x82\x1c\x18\xfe\x92\xbb\xdbԣ\x99\xde\xc4,.\x94\x9cYm\x0f\xfd\v\x84\xfbْ\xa7\xb7*\a3\x12\xf8\xa4\xa4\xcb\x00\x94S\xf7\xc4\xf60$ژ\xf1d\x12\xf8lP:4\x1eR\xbf\xa4>\xfcC\x16\xebWp\xf5\xd5C\b\xf3\xa9`\xf0\xb94\x89(R\xaeS\xaf^\x83\a\x1dh\x1c`P\xc1\x16\xad7\"F\xd2\xf7\x8bA\xc0\xc4\xea\xc3\xfd\x02\xb2#\xa9Al\xa2i3-\xab\xe0\xba\xf3\x8aDM\xe7\x01u2\xf7\xb2u.|\xb1\x94\x12|o\xd2R_#ӷۂ^d\xdf\xd5=\xafG\x9a\t\x10\x13\xb1\x9f\xe0\xd9\xf1\xf4O\xb3\x95\xdf/P\x89\x94,\xa9P\xf0\xf91ː>\x8b7Xi\xed\xfe\xbd\rU\xe2Cgo\xc4\x12\b\x00LO+\x173\x1b\x91\xb1s\xb8\xff\xa7\x83a\xec\x97W\xf1Y\xb8\x8dQ\xd9.*\xb3\xf8+\xec)\xe2\xfaך\b\rE!\xf9\v\x0ej\x8e\xc8(|\x1f\xb9\xff\x86\x96\x8f\xc0_vNS\x1b\xa0^\xb6\xfb>{\xdf\x1f\x83\xd7\xd6\xe5\"\xc8\xe3J\x9enܛ\xa9\x10\xe4\f\x7f\x94\xccW\x97\xa2p\x16\x94\xa7n\x90nĄ\xb9\xf5b\xae\xe7\xabM\x8f\x13\xa5\x99\xf0'\xab\x91؋\x95\x00>\b\x95G\xdfE\xcb\xf8?\xba\xa6\xa7\x88y1\x94'\x1e\x97\xc0\x1a\xfa\x88\xc7g\f\x1f\xc9N\xb8\xa7\xbe\xbb\xee\xc3\xca\xfb\x83G\xcc\xf1\x15\xf9\x18\xe7\xbc%G\xf5\xd7n\xd3\x05,\x85\xe5DFP\xf9[l\xde\xe6O\xe4\x0f\xcc\xd0¯~\xe0y\n\xa9#\xfe\xc9_DAr\x85\xba.\xae\x933\xc11\x1a\x1d\x1a\\\xae)\xb3\x92\x1c\x86\x1b\x05b\xff!o\x17Q\x91\x9d\xfd\xdd!f;S\x8a\xb7S\x19\x10\x9c2f\xb2e\x96\x17t\xbb\x0f\xa3\xe2\xbd\xefw\xd1\x00\x83\xfe\\\x8cE/\x85\x1d\xbcLP\xb0\xa2̞[\xb5\x8b[\xbf~\xe7f?ݳ/^\xf1\fuB\x14\xd8'\x17\x13\xf9\xfa\x88\xf2]J(\x91j\x1eg\xf6#پ\xba\x1b\xe5w\xb9T\xab\xc6f\x00)\x9d\x9f)\xc8X\x83Z\x82\xc9\xd1\xdd\xe5V\xb4m\xd7AG\x15\xba\xec\xd5\xfa\x13\x05D\xfa\xc6\xf1lu@\x8a\x9b\x17\x87\xa1\xe1\x92\xf6u \xad\xb7\xc5\x1f\xf3c\x00G\xb3\x16\f\x9c\x1b\x89\x97\xb1\x85\xf5j:Go\x84\xcf\xfe\x01\xc7\x129\x17\xf2aՌ\xc4\xed6%9\x95\x19\x16Ya\xb6J\xe1\xd0\x17\x05\x9fr\x97\xb6\xec\xd6d\xf4h\\\xfc\xca\x1eRL9\x1b\xefY\x17\xb2\xe6\x19\xb8y\xdf\xc7\xe6\xfa\xa1\xbe\x9c\x13|\x144\xeaz4\x10\xc2\xd8Y\x1d?\xadG\xba\xf4\xf5/j\x8e\xe4\xeb\xac\xc33\x8bߦ\xfc\xc9\xd6\x1d\x1aۏk\x1b%\\\x87\x00\aӵ\x8e/\x9f\xb9M\x13\x8es\xca!\x86]ݦ\xf9\x8e+\xd4\b\x97\x16e7.\xfd-\x8cu0=\xb2\xf0\f\xbb9N_\x02\xd1T\xca1\xd1\xd8%\x7f\x98\xc3\x0e\x13\x8et\xef\x8es\xab4\xaf\xf5\xa7f3\x05\xce\x1b\xed\xd33\x1aNڕ\xf0\xaaբ\x93\x1f\x9dЮJ\n\xb6$\xa4\x91B\x8f\xbe\xaf\xf6\x99}w\xb7x\xf5\v5\x97\x8e^=\x9boIbTp\xd84W\xccG\v\n\xc8\\kw\xc6.\xad\xd3;P\xfb\xa4\xcb6!\xf4\\Ru\xe837|\x00\xf4(\xceCR\b\x86\xfc\xeauc\x7f\x8dP\xc8O\x96o\xde.9\xef\xf4M\xf0\xf7\xe6Oμ2\xc3n\xe5]\xa8\xc9r\xf1\xf8\xf2\xd0\bj(x/\xdb=_\xf5\x89\xf0\x1f\x13#Uhϐw\x1e\xaa\xca\xee_䘶(\xdb\xea\xae\x14\xaa}\x04\xf4|\x94\xeeTj\xb9\xd4cR\xff\xbbhr\xd2\xc8f9\x99~kί\xf5\n\xeb\x1f\xd0\xdeR)\n\xd3\fM\xf2\a\xb8\xdf\xf2\x82\x19\"\xd4۾\xd4\x12@\x7fx\xc6-L;\x9dͳl\xb8\n\t\x9d\xc7\xc6\xeeg\xf5\xe6\x01\xfc\xce~\x01\x7f\xfb\xef\x93\x1b\xed\xb8\xc7$\xe3\xcb\xf4Jp\xdd\r\xfe\x8fU\x18\xce\xe5]\xc70t\xe9\x10\x8e>\\5\x04'̇\xa9\xd7\xd7-X\x17\xd0|\x9a\xbf\xdbV&νk\xe9e\x8a'\x92Dt\xbbF\xea\xab\xfc\xe95\xd6&N\xf4\aف\xe5\xc1\x8f~\f\x01\x9c\xf3\alA\xb7\x8fr\xac\xcf>Ni\x18r\xe0\xc5C\xe3\x92Ȱ\x17%1\xe8\x84\x1e\x86G\r\xf5SA\xc8K\x1fj\a\x1d\xd5J\xfd\x85\xde=.\x1e\xe6E\xf9k\xb5\x13\x9b\x9b\xbf\xb6\a\x96^wɭ\x18\x8a\xbaD(\x85\x1e\xc2~\x92\xd6\xfbN\xc77\x19\xb7u\x95f\x17@F\xb3@gb\x1b\xc2\xca\x1a};{ \x7f\\\xb6\xa4\\5\xd9Hdշ胠\na]\x00!\xf6\a\x0e\xbd\xc0\n\x80\xe8\x8d?2Y\x8b\xe6ХV[\xd8\a\xcf\xea\xf6\xed\xa7d%\xac\xffgu&5z\x8c\r\xd2`yP_ɖ\x05^\xf0\xcd\x7f\\}\x13{\x8c\x00\x91\x17\xfd\xad<\x81\x7f\xf1\xc8%\x0eu\xb8\x02\xe9¥F\xf0\x8eF\x938\x06\x17\xafB\xd0ӥ/R\xe4\rcV[!\x8fU\xee\x04\xcfȑ\x9f#\xc8y\x05\x05q\x1brb\xeb\x9d\xdai\x95\x1c\x81\xe1>\xcbrJ\xcc\xd8U\x90\xb6\xa96\x14\xa9\xae\x8bDHG\x7f\xec~\x96O\x11\"6}\xb4\xd4\af\xb9\xc4\xca[\xf3\xe2y^{\xdc!1\xdcF\xec\xad,\xe1\xea\xde\xd4B\x1be\xd2\x1f\b7&\fo\x8f\x94\xce֡\x00\xce$?8\xe1\no\x86=\x98\x8c\xb1\x9d˛\x83\xc6\xd9\t\x05HºA%\xb93\xd7\xd8\x00\xdbQ:\xbdj\xfaX\xd5\x02N\xc6\xc3ᒨP\xfe\xe7q\xfe\x8f}\xdfn\x0e\xf2\x1b\xcdji\xb2\xa8\xee\xd5-:\xf4t\xcf#\xe2\xec\xf6\xc0\xbc\"\xbeg\xee}\x8d\x9e.\x14\x1d\x00\xfeW5@\x97.\xba\x97\xf9`U\x9dk\x19lS\xfb\x17\xbfj\xc8SB\xe9ԋ^J?\x8c\x03s\a\xb6\x16\x0f\xbd\r\xfc\xc8)\xf9,t\x1d\xf8w`Î1\x02q\x14\xac\xbdR\x84l\xa3\xf6\xb8\"\x00\xa4\x8fz_ߐMXo{ہu5\r\xac\xad\xf6\x1dfRw\xe1\xad!\xd1\xcc\xed\xaf\fP\x98\x1e\xf2}\x03\xfd&*\x85\xc3\xf7\x83ʓ\x7f\xa7\xc6\xf6-\xba8\x93\xb1|\x83\xba\x15\xc1\xae\xf7\x9aS\x9e\x0eN\xcf xr\xfd\x06\x85\xac.\x9aq\xcd~\x05\x93U:줠\t\x1f\b\xf85Kt |\x9c\x85\xc9MJ\x87\xf7\xb9\xe6\x90\x1a\xb1\x1fe\xb4\x87o\xa50:S/O\xc6\xc35/\x03\xe7\xaah\x00Ӑ\x14T\xe4\x1fbc?\xaa!\x15\xfa\xe4\xb7*H\xb9\xab|\xfa%\x94y\xbe\xbb\x97d\xff\x9b\xf9\xcdF\xb5\x85R\xfd[\x7f\xe7\xe8\xc9<X\x9d\xaa\x80\x81\xa2\xceO\xf6R\x8cK\x18ֿ\xb9\xd3oS.\xf6~\xf2u\xe0u=U\x8b\xb5!\x00\xa3\xbdc\xe3lB'\x00߾a\x9f \xcf\x1b\x88\xf7]j\xd5\x0e\xb2\xc7 Z\xe3\x97\x19q\xc8\x0f\x86ٙ\x98P\x8d\xb6\x06̄=\x1d\x0f\xbc\x0e\xdb:\xbc\xdf\u0604\xfe\xea0\xd2\xfe\xaf,\xc7\x19\xaf;)\xb4\f$\xe3\xd59\xd3\x1c\xa6t*.\x9a\x18\x9b\xd7Kga\x11\xb0\xb4,%\xf9\xfcH\xa9\x97\x14\xb2\xb8\xfe\xef\xab\x1fu\xa0\xa0\xcf\xfe\r-\xa4\xafP\x1c\x1b\xcb\rXC\xbc\xf1\xe5\b\x83\xfc(\xbe\x9bb\xf3I\x1c4Qu \xfe\xeb鏆M\x95\xc4]anr\xf0\xcaj]\xa1\x0flu\xc3E3\xf8\xe0\xd7\xcao\xa9z\x10\xfc\x05\xf1\x0f\xd8\xe7\xb4C\xd38\x8bCϫؠ\x06M$\x88\xdc\x17\xa0\x1c%\x7fj\x8b\xf2\xc9\xe1I\x9fʉG\xa0\x1e\xb8DͿ/`u\xb2#79Z#\x15\xb6\x18\xc3V\t\x15V'\"1\xe9\x18\x14E\xda\xe0\xf8\xa9\x89]\"m\x1aW\xe4ٹ2|\x8fˈ0V\x82\xfd\xb2\xcf)X\x1d?\x8b\x92=5\x00ۗꃞ\x9f\x89\xff\x1a-\xd8\xcd\xeey\x19\xba\xe0\xc2\xdc\xf6,\xf3Kz\x90\xb0\xbe\x03Z\xb2\xefMP\xba\xa7L\xa4\x04\xbd\xe7$\xcd\x03_\x9f\xa53\x9cz\xfb\xfet\xc3]1\x8f\xfd\x8b\xbe\x95\xfdF4\xb2\xaa\x05\n$\a\x87_E=mݦ \x9d\x82\x15F\xc6\x11\x84\xde\xe3\x8e\xc7n>W\x19\x7fƯ\x15\x88\x1e#\x14\xba\xbe%\xd0\xc1Y\xf2\xeed\xdf\x191cT1$\xdd\xd0i%X8\x8c\xf2l\x95N\xda\x17#ɠ\xa2\xba3\xb9@\b\x81\xbd\xdcl\x8b\x92\xbc\x9c<\xc0\x1fa<\x86\x9d\xb4^\xff\xf8\xf8\xa6I4`\xb5-{\x1ec\xda1\bQ\xf0\xa2#(ڶ]\x82\n\xbdq\xf5^\xe7\x8e9z6{A\xa1.\x165\x13\x90\xdd6\xf75A\xe5j?\xf4zcB\t\u0083픡\xadL\x1c\xf3\xf7uz\x1f\t'\x19\x8f֝ۏ\xba]v\x85\xa7\f\x96\xf7g\x05:\xfd\xa8\xcf\xcbg\x03!\xb7\a\\;R\xaarL\xe8\xe0p\xd8\xf9]k\xbeE\xe5\xf6\xbb\x85q\xd5w\x05:~\x94\xfe\xf3ٰ\xd7(\x9c\xf7\xa1^\x8f\x06\xb23\xa2c\x8f\xe7\x13\xbb\xa6\x7f%kĢ/\n+XM\x16\xa4%\xedJ\xf2\xee\xd8\xe9\xdb\xef{cEa*\xfd\x11P\xac\xdd\xfew\xbf\xf0\x965\xe2\xee\x17\x85\x15\x9693@\t\xe3\x9aD\\O\r\xd1\xf3\xf4\x0eN84q]\xcbi\\9f\xc5kn5\xfc\r\f\x99\xba6\xfc\xae\x81ꐿ\xee\"/\x9cr\xaf\x84\x18Y6\xf8\v\x19\x85g\x13\xfc\xbd\xc7\xf1\xed\xf3\xd3\a\x04\x1c\xde\xcd\f\xcfNP\xf0PE\xfd\x16\x94M\xf2\xf9\xb7\xb0Ŭy\xfcd]ɒ\x1c\xcd\x19ܑ\a\xeef\xfc:(\xc8p\xeb\xf1\x10\x03\x16X.F\xa2\xf6.%\xbaa\x16\xe7Ý\x8f\b\x00\x00\x00*\n\x9a\U0008dc96\xd1\xffg\x00\xef\x1ew0M\x1a\x00\x00",
+	},
+
+	theme.IconNameFileImage: {
+		Name:     "image-x-generic.svg",
+		Symbolic: false,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x93\xcdn\x9b@\x1c\xc4\xefy\x8a\xd1?\x97\xf6\xc0\xb2\x1f\xecW\xe4M\xa4\x1e\xdac\x0fm\x1e\x80\xb0`P1X@lҧ\xaf\xc0N\x94:\x1f\a\x0e\xc3\xfe\x86\x99\x01\xb1\xb9\x9bw-\x0e\xe506}\x17H0N(\xbb\xa2\x8fM\xb7\rt\xff\xfb{\xe2\xe8\xee\xf6j3\x1e\xb6\xa8\xcbf[O\x81\x84t\xfb\x99ph\xca\xe3\xb7~\x0e\xc4\xc1!\xa4[.±\x89S\xfd\xc2̻\xb6\x1b\x03\xd5Ӵ\xbfI\xd3\xe3\xf1Ȏ\x8a\xf5\xc36\x95\x9c\xf3t<l\xcf\xc8\xcd\xdc6ݟ\xf7@\xe1\xbdO\xd7S\xba\xbd\x02\x80M\xdbte>\xfc\x18\xf2݄ؔ&\x06\xca\t۳\xbe\xef\x9ai\f\xf48\x96ï}^\x94?\xbb\xfb\xb1$\xcc\"\x900L:nMF\x98e \xafX\xe6\xb9[\xe4\x93\b\xa4\f\xf3\\K\xab\xa4&<-\xe7\x86ie}\xe6\xf49yM\x1f\xa7~\x8f\xbe\xaa\xc6r\n\xc4\t\x8bN\x8a\xbe\xed\x87@\xd7\"\xd7\xd5CF\xe9\x87<\x13\xc2\bu\xe1rBE_|\xe6R\xd6J+/leTJ=|f3\xc6X\xed/lUe\x1d\xe7\x1f\xdb\xc4%o\xa2\x92\xf1\x99ߤ\xff\xbf\xfe\xf3\xdd}>Ո\x81v\x10\x06\xd22/\xbdq\x0e5\xbcA\x81\x8ceJi\x9f\x81\xc3A1m\xb9\x12\x12\x0e\x0e\ah\xce\xf8I\xb7H\x14\x87⨑\xd8\f\x05\x92W\xbe\xc4!QL\x1b\x93q\xb3\x8aśX\xf9l.\x16&c\xd9)\xf75\xb9\xb2\x7f\xb1\x03\a'TMۮ\xa3*]\xbd|\xa8\xd7\xf5%\x873LH\xbdԐ\xf2m\x8d\x97\xfe\xc9i\x80\x14\xccY\xfd&!\x961\xc6\xfc\xbd\x04\x03ep\x80\x95\xa8a\xb3e\x86P\xeb#\x9e7\x9c\xc2\xdel\xa8!VZ\xf3\xb5\xdeE\xe0\xe3\xd0~\xb9ο.\x81\x9b\xe5Ǻ\xbd\xfa7\x00Č\xf3\xe9\xdb\x03\x00\x00",
+	},
+
+	theme.IconNameFileText: {
+		Name:     "text-x-generic.svg",
+		Symbolic: false,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xe4\x96͊\xdb0\x14\x85\xf7y\x8a\x83\xba\x96|u\xf5c\xa9\xc4\x19\xe8\xa2O\xd0>\xc0\x10'\x96\xc1\xf9al\xecЧ/vf\x1cO;0[\xd3.\f\xd2\xe1\x1c\xdd\xf3\x81\x10\xde>\xddN\r\xfa\xc3K[_΅Њ\x04\x0e\xe7\xfd\xa5\xac\xcfU!~\xfe\xf8.\x83x\xdam\xb6m_!\x1d\xea*u\x85\xd0\x1c\xae7\x81\xbe>\f\xdf.\xb7B\x10\b\x9a\xc3\xf8\t\fu٥\xd9s;5\xe7\xb6\x10\xa9\xeb\xae_\xb3l\x18\x065\x18uy\xa92&\xa2\xac\xed+\xb1\xdb\x00\xc0\xf6\xfa\xdc%\x94\x858\x81\x03\x02\x12\x1c\xa1\x81!E\x96\xa3\x8fਢ\xce\xc7U\x8f\xdc*r\xc1E\x83=\bVYc\\\xb4\x90Fy\x8a&w\b\x8a\xd8X\x13 \xc3[~\x96\x12d\xce\xd8C\xce9\x82\fc\xd8yo\xc9O\x9b\x80\x1e2\xfa\xe9\xfc\x87qi\x99L\xbfp\x02\x81\x04\x8eu\xd3\x14\xe2\xcb\xd1\x1f\xddъ앩z\xd3\xcbCY\x96ϯ\xa8\x7f\xe0\xe6\xe3Y=\x98\xdf\xc3\xcc,\xb9\x8a\xac\xc3\x1d\xea\x8e2+͘\x92\xa4\x88L$?\xb7\xc9>\x9cc,4\x11\x12<a\x0f\xad49\xeb#\b\fR!Zg4\x18\x8c\x16r\xb1\x97\xa3\x94 \xa7\x90\\\xa4$/|r2\xb6X\n\x93\xf4i\xa5\xc8kk\x14\xec\xda\x1a\xe5~m\x8d|X]\xa3\xd5\xddl\xb7\xba\x9bm-\x12\x8c\xff\xbb\x91\x9b\x1e\x1a\x86V\x86-\xeb\x88~\\:\xed\xfc\xfdQ\"\x95\x1b\x1a\xed\x8f\x16\xb3U.b\t\xd2\xf8\x0f\xbb\xdf'\x8c\xeb\xc5\f\xf9n\x88\x9c\xa7<\xc8f\xf32\xf8)\xa7\xf1\xff\a'\x87\x7f\x91s\x9bU\xbb\xcdv\xfc5\xd8m~\x0f\x00\x81\x82@\x13\x9d\b\x00\x00",
+	},
+
+	theme.IconNameFileVideo: {
+		Name:     "video-x-generic.svg",
+		Symbolic: false,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfft\x94\xdfn\xab8\x10\xc6\xef\xfb\x14#\xf7\xe6\x9c\v;3\xfe\uf8f8\x95\xf6b\xf7r/\xb6}\x006\x81\x80\x96@\x04\x94\xd0}\xfa#\x934$m\x1a\ti\xc6\xfe}ߌ'\x86\xf5\xf3\xb4\xafa̻\xbej\x9b\xc8H \x83\xbcٴ۪\xd9E\xf6\xfa\xf2'\xf7\xec\xf9\xe9aݏ;(\xf3jW\x0e\x91\x91\xf4\x87\x89\xc1X\xe5\xc7?\xda)2\x04\x04\x92>=\f\x8e\xd5v(/̴\xaf\x9b>\xb2r\x18\x0e\xbfV\xab\xe3\xf1(\x8eJ\xb4\xddn%\x11qՏ\xbb3\xf2k\xaa\xab\xe6\xbf{ \x85\x10V\xf3.{z\x00\x00X\xd7U\x93g\xdd_]\xb6\xad\xf2f\x80j\x1bY\xc6`w\xce_\xba\xac鋶\xdbG\xb6φ\xae\x9a~\xa0\x90&h\x13 \xf5\x99\x12\x90\xca\t\fN\x060\xf6\xe7\"}m\xaa\xa1\x8f\xec\xadϻ\x7f\x0e\xd9&\xff\xbby\xeds\x06\x13Eƽ&a\xb4\xf5\xce0\x98dd\\\a%\x1czE\x8a\xc1;E&Q3xO;\x0eϝ\xce\xdd\xf6C{\x80\xb6(\xfa|\x88\f\x19\xa4\x9coں\xed\"{̑\xfe\x95\x9a\xad\xbe\xe5\x85\n\x9a,}\x96YK\x88\xdf\xcb>\xf3\xdb\"\xb8\xfc¯W\xb7\x13<\xaf\x1e\xb2\xa1\x84md{ #\fH\x0f%\x04\a\x1bЂ4Zi\x00\xc1\t\x03J(\x13\x943s\x92\x9e\x11\x9c\x82\r\xe0\x02\xf2\x1b\x86\x7f\x80%\xf0ِ_9Λ\v\xcf/\x82\x11\xf8\xd9v\xc1o\xb1\v\xfa?\xec\x01\x01\x19\x14U]G\xf6X\xd8\xc2\x14\x97\xa9^\x1f,\b\xe7\x9c\xd2\x1a\x94\x85\x12\xbc\x17Z\x1bE\x126 \x05z\x13\x94\a\x04\xf5\x01\x91\xb0!U^V.\xc1\bV/b\\\xe4\xfc\xab\x88\x7f\x95\x97\xc0\xaf\x8b\xf3\xab\xea\v\xbdX\xf1;^#\xf0\xdb\x16\x16\x93;\xba;\x06\x9f\xa6\xf6\xd6\xd5?\x1e\xb3\x9fw\xa6f,X\x84\x11\xa4\x84\x12\xa4\xa0\x10$\x85TR(/=\xc9\xf9\xadrƥ\x90cz\xb1\xac\xb1@\x02O\x7f\x15\n\xe9\x03Z\tu\xbaX*\xa4\x8e\x81{\xe1\x82\xf5\xce\xce>֟\xc0\xf3&\t:kI\x9047\xf9|0\t}µ$\x7fZ5\x14f\xd7[\x12\xc9X\xa8\x81ߔ\xf5\x88\xce\xcfCG\xa1$*R)\"\x9fnV\x8al\x8a\xf4\xa9\xedT\x9c\x93@\x83\xce\xd3\xd5If1\x129\ni\xf0(P\xce#=\xc5Z\x06\x9bֿ\\\xcb\xf9\x97\x06\xbcN_\xbe\xa7\x87\xdf\x03\x00\xfb\x83\xcf\xd6|\x05\x00\x00",
+	},
+
+	theme.IconNameFolder: {
+		Name:     "folder.svg",
+		Symbolic: false,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xd4U\xcdn\xdbF\x10\xbe\xfb)\x06\xebKr\xd8\xd1\xcc\xec\x7f :@\x0f\xed\xb1\x87\xc6\x0f@H\x94H\x94\"\x05\x92\x91\x98>}\xb1\x94\xed\xc8N+\xc0-P\xa0\a\x01\xdc\xd9o\xe6\xfb\xd9\x01\xb4\xfe<\x1fZ8U\xc3\xd8\xf4]\xa1\x18IA\xd5m\xfam\xd3\xed\v\xf5\xf8\xe5g\x1d\xd5燻\xf5x\xdaC]5\xfbz*\x14K<\xce\nNMu\xfe\xa9\x9f\vE@\xc0\x12\xf3O\xc1\xb9\xd9N\xf5\vf>\xb4\xddX\xa8z\x9a\x8e\x9fV\xab\xf3\xf9\x8cg\x83\xfd\xb0_\t\x11\xad\xc6\xd3\xfe\t\xf2in\x9b\xee\xf7\xbf\x02rJi\xb5ܪ\x87;\x00\x80u\xdbtU9\xfc2\x94ۦ\xea&h\xb6\x85*\x15\xec\x9f\xce_\x86\xb2\x1bw\xfdp(ԡ\x9c\x86f\xfe@h\x9du\fYf\xfevN\x044\v\x13J\x12f\v\x9e=r\x10O\xe1\xe3\xf7A\x8f]3\x8d\x85\xfa:V\xc3o\xc7rS\xfd\xda=\x8e\x95\x82\x99\v%>&\x14\xc7\xc9\x19\x05\xb3\x14J\x12G$\x9f\xa2X\x05߸P\x9a\x99<F\x92\x14\x92\x82o\xf2\xa6rq\xb2\xb8\x19\xa7\xfe\b\xfdn7VS\xa1HA>\xebM\xdf\xf6C\xa1\uef54T\x95j\xf5\xb7x$\x17|J\xfc\xa6\xaf\xdcm\xednw\xab\x8fE\x84\xec\xbb\xe9b0\x86\xfc\xbbےs)\x857m\x1bں[m|\x93f\xbdz\xbd\aO\xd5c9հ-\xd4\x01\x841\x05\xef\xbc\x00\vl@;t\x12\x8c5@\xa0\xd3\xf3\x95E\xeb)\x99xUb\x82\x13D\x8fdX\x1cl\x80\xc0\xa1\xb3\x92|\xcah\x9b\x84S\xc6\\\xe3k\x88\x16\xe9\x82\xd9d\xf8Һ`\"Y\x9f@[\xb4.\x90\xe1\xab\xd2£\x83 E\x97\x15d&\xed\xd1KL\xe4\xb3\\\xe3\x92\t.o*h\xcef\xc4;\xbf\x1ck\xd0\xd6#e;6[\x13\xb4ϔڢO\xf9\x00\x9a\xd1D\x1f8\xe6Y!\xf9\x98g\x19tQ\xb2\x8e6\xdf;2\v\x97`J\xc21\xe4Y\x8c\xd9\xd5\x02\x95\xb48\xd2\x0e)\xaf:/\xa2\x02S\xb2\xa0#\xfa\xb4D\xf1\xbd\xf6\a\x1c\x80\x80\x14욶-Խ5q[y\xb5\xfa\xe1e\xbc{\xce\xce\xf8\x8b\xfc\xb0\xbcB\x96\xefPd\xb9bd\xe2\xfc\xa1\xc3ũ\x01\xc1\x1c\xcd\xf2(Zp\xa9E\x10\x14g\x9e\x13\x8b\x12ـC\xa6Kt\x11\xbdy]\xaaAKDcR\xb4\xf6\xdd[\xe1\xec\x7f\xb4\x15^\xae\x88\xb2\xc6\xecP@\xbfPe\x9c~\xd5\xf5&\xfd\xafC\xfb\xe1\xbe\xfcx;}\xf9\x7f\xa5\xff\"\xfc)\xfeLl\xfe]\xfc\x99(\xfc\x18\xbf\xb9f\xfaG\xf9ߗvSVU\xce\x7f\x9d\xff\xe7\x1e\xee\xfe\x1c\x00\x9b^[\x85j\a\x00\x00",
+	},
+
+	theme.IconNameFolderNew: {
+		Name:     "folder-new-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffd\x92۪\x9c0\x14\x86\xef\xe7)~\xd2\xebĵrR\xcb8\x1bz\xd1'h\x1f\xa0\xccX#8\a\xb6\x12\xa5O_\xe2i\x84M\x02.\xfe\xf5\xad\xc3\x1f<\x7fL\xf7\x0e\xb1\xfe\xec\xdb\xe7\xa3\x12\xacH\xa0~\\\x9f\xb7\xf6\xd1T\xe2\xf7\xaf\x9f\xb2\x10\x1f\x97ӹ\x8f\rB\xdd6a\xa8\x04\xfb\xd7$\x10\xdbz\xfc\xf1\x9c*A \xb0\a{\x81\xb1\xbd\ra\x03\xa6{\xf7\xe8+\x11\x86\xe1\xf5=\xcb\xc6qT\xa3Q\xcf\xcf&\xd3D\x94\xf5\xb1\x11\x97\x13\x00\x9c_\x7f\x86\x80[%\xee0`\\!Yyk\x9da\x10\xa4\x01+\xe3\x9c\xf5e\x8a\r\"\n\\Aؙ=m\xe6\x13`R^9Gy\xc1\t\x84$em\xa9\xb9L1\xa3?\b\x92\xd7\x1b S\x9d$\xe5\xbcv\xa0Y&eM\xeeV\"B\xe6\b`^\xda/\x14ce\x18\x89\x98s\x87\xe1\xef\xb9\xe9\xf4_w\x89\x90K\xcd۳<\xfa]nZN\xb9\u0095\xa6@\x97\x80\x9cr2|\x88\xe6\u0379\xc8\xdd\xf1k-[\xf2)ҥ.SC\xda+w\xed\x1f\xee h\x04\x1cF0\xe6\x17q\x9b2o\xb8\x99\xde\x1fev\x90\xea\v8D,{\".\xcdVA'\x8bf\x15\xa4\xde\x10i\xd6\xc1$\xf0\xb7\xed\xbaJ|ӵ\xb1Ƌ\xecr:\xa7\x9f\xe3r\xfa?\x00-\xa5\x1f؛\x02\x00\x00",
+	},
+
+	theme.IconNameFolderOpen: {
+		Name:     "folder-open-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflU[\x8e*G\f\xfd\x9fUX\x9d\xef6v=]\x11̕\xf2\x91\x15$\v\x88z\b\x8d\xc4\xc0\xe8\x82\x00e\xf5\x91]\xd5E\xdf\xe4\x8a\x0f\n\xd7\xf1\xf1\xeb\xb8\xd8~{~\x9e\xe0\xbe\xff~=^λ\x81\x91\x06؟\xa7\xcb\xc7\xf1|\xd8\r\x7f\xfe\xf1\xfb(÷\xf7\xb7\xed\xf5~\x80y\x7f<̷\xdd\xc0\xe9\xeb9\xc0\xfd\xb8\x7f\xfcvy\xee\x06\x02\x02N\xc0i\x80\xc7\xf1\xe36/\x80\xe7\xe7\xe9|\xdd\r\xf3\xed\xf6\xf5\xebf\xf3x<\xf0\xe1\xf1\xf2\xfd\xb0qD\xb4\xb9\xde\x0f\xc3\xfb\x1b\x00\xc0\xf6\x00\x7f\x1fO\xa7\xdd\xf0\x8b\xdb\xfb\xe0S3\xdb\xd5\xd7_\xb7\x19>v\xc3'x`\x98`dL!D\xcf@0z`\xf41\x86T\xf4\xec\xe1\x0e\x02\x13\x10tL\xbf\xf6\xf6\x99AP\xc4\t;\x85\xa1xG\xde\x19>fa\x97`$\f\xe4|\b\xe0\x90bH\"j*>Gs\b٥h\xa8\xe8\xd9E \xcc^\xe94+N\xb9\xa4\x02\x84\x85\x89\x15\xc4(DY\x18Njl\x9e\x0e\x13u:_(9C\x86P\x1c\x17%\xae\xb7\x8a\x8b\x96\xc7H(\x8e<;\x18\xfd\x12c\xb2<%\x18\x92\xd0/\x87\xccA\xaa\x8b+N\x81#۽\vJ~\xafYZr\xda$en\xbd#\x8cQb\t\x8a\x88R\x8c\x84\x97T\x18\xadc\x11\xa6W\xe1\x1a\xa2WN̹&\xdf\xf2\xfb\x99M\x9b\xc0\xb4\x84#\xd2\xdaa֢4\xb2\x178\xa9[\xa6L\x9eW'\x8bɒ\xe3\xfa;\x04\x0e\x94\xd6uR\xf7\xec\xb6\x7f\xe0\x13\b\x1c\xcc\xe0^!:\xef*@\xe3\xed_\x8d\xbd\x13u\xeen\x99!\x98\x1f\xf9\xa8\xcd$=\xe6:\xa3^ڏ\xd5\xe6&\xb9\x8a\xe5b\xfa]\x0e#-\xed\xea\xdeVv\xa3\x7f]\xbf\xe4A\xe8\xe2\xebgw\xbbC\x8b\x821jL\xa0EYl\x9f\t\xd8\xd4N!\xd5Z-k=\x88$\x8d\xc0\xc8N\x94\xe9\x04c\x13m\x86\x95fu\b\x9cY\xb22/\x99\x94$9(G\x95\xfb\xd8\xf7\x84\xb0\x94D%4\xc7\x1ckJ\\\x16\xb1;\xa9\rD\x85\u05ca\x9a\x10\xbbi\x86q\xb5\xb3\n\xa8\x9d\xa6&\xee\xa0\xe1\xc5~\xb0J\\\x16m/8\xac\xb5\x8e\x84\xb9NϐU\x1d4l~\xfa\xd4dH&X\nM\x01\xeb\xb1\x14\x95\x87\x87\xb1]\x8b\xa95.\xebl\xbbks\xf5@X,\xf6T\x97S\x9b\b\x8c\xceէ\x80\x91\xadB\x87.g_W\xae\xb5\xd0c\xe6\xeeȹ\tS\x1f\x04/\xd0G\xc0HuW\tS\xb0Ќ.\x99R&\x83\x1bk'\xe0\x97x:A\x83\x8f\x9dA\x1d\x83\xfcg\xb3\t\x8b\xab\xbb\xc7(\xacH`\xf4\xbeH\xf0Z\xad\x90\x8dL]\x9d\x16iof\\\xd6\xc0\xc6P_Vc\x01B\xcd\xfa\a\xd4\f\x11\xdb\x13\xf3\x92.\xd4\xf9.\xfa\x1d\x19\xae+C\x1d\xf8\xff'\xb9\xdd\x1c\xde߶\xfa\xe7\xf2\xfe\xf6\xef\x00\xcaё\xcd\xdb\x06\x00\x00",
+	},
+
+	theme.IconNameGrid: {
+		Name:     "view-grid-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xecRKj\xec@\f\xdc\xfb\x14\x85\u07bam\xc9?\xcc\xc3\xed\x81,r\x82\xe4\x00\xc1v\xdc\r\xfe1n\xbaMN\x1f\x1c3\x99\xd9d\x9fE\x90\x16\x82*U\x15B\xf5e\x9fF\xf8\xfe\xba\xd9e\xd6$1\x13\xfa\xb9]:;\x0f\x9a^_\x9eUE\x97&\xaa7?\xc0\xf4v0N\x93\x94\xebN\xf0\xb6\x0fOˮ\x89\xc1\x90\x12R\x12\x82휹\x11\xf6i\x9c7Mƹ\xf5\x7f\x92\x84\x10\xe2\x90\xc5\xcbuHRfN6?P\x13\x01@\xbd\xbe9\x83Nӄ\x14\x82\x16\x8a\xe3\xa2\xc8˪\x02C\t8\xce\xf3\"\x93\xf4\x98\x05\x1e9Z0\xee\x9c\x1b,_eN\xfc\x1b\x85@=Pԡ\xa0N\x89\xbb\x8fz\xf48\xfb\x03\x13\x8e\xf5_\x14GU\xa8\xfe\xce\xf3c\x1c\x06\x13\xde\xed8j\xfa\x97\xf6Y\x9e\x95\x944Q}|Z\x13}\x0e\x00\x11\x97!\xb5\xe8\x02\x00\x00",
+	},
+
+	theme.IconNameHelp: {
+		Name:     "help-about-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfft\x92[Λ0\x10\x85߳\x8a#\xf7y\xcc\xf8\x821U\xc8/\xf5\xa1+h\x17P%\x14\x90r\xd3\x0f\x82\xa8\xab\xaf\x06CC\"U\x02\xdb\xf3͜\xf1\xc1x\xff\xf1\xb8\x9c1֟}w\xbbV\xcahV\xa8\xaf\xc7۩\xbb6\x95\xfa\xf9\xe3;E\xf5q\xd8\xed\xfb\xb1A[wM;Tʄ\xfbCa\xec\xea\xe9\xdb\xedQ)\x06\xc3\x04\x98\xa00u\xa7\xa1]\v\x1e\x97\xf3\xb5\xafT;\f\xf7\xafY6M\x93\x9e\x9c\xbe}6\x99e\xe6\xac\x1f\x1bu\xd8\x01\xc0\xfe\xfekhq\xaa\xd4\x05\x11\x8c#\xc8ko\xd8\xe4\x01\f\x8ap:\x8fe\xf4^\xd6\x11\xfd3\x8eH \x826\x8c\x04\xd1V\x94\x9e?\xb8\x80aq\x84\xd3\xceYv\x06\x8c\x00\xabC(\xcaP\" \x88p\x13ӂ\u0086Ҍ{l\xc1\x8cR{\xa3c\x91\xcb'\xb0\x0e\xd6p\xe9\xc1 \xa3\x8d\xcd\xc1:gWrX\xe34\xf6O\xbe\xc5k2\xcd\xf4VCk\x9aޛ\xbeN≌έ\xf3N\xce\xd1\xd8ś\ry\xb0\x1e\xac٘\u0094\x82|!\f\xac\xadc\x1f^\x91\xa8XRE\xe1\xbcȬ\x95\x0esf}\xdby\x1c\xe1\xd0ζ\x96\x8d\x16EB\xff\x84\xb4\xaa\xfa\xff5s\xb2\xe5S.\x90^J)\xc9\xe9\xb5\xe7sX\\\x8c /k\xbb:b.\xa2\xb1ɑ\xdc1\x9b/\xebtH\xbc\xfcHV\xf8ݝϕ\xfabk\xe7]P\xd9a\xb7\x97K{\xd8\xfd\x1d\x002\xc7\xe4{3\x03\x00\x00",
+	},
+
+	theme.IconNameHome: {
+		Name:     "user-home-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x92K\x8e\xdb0\x10D\xf7s\x8a\x02\xf7\xa4\xbb\xf9g`y\x80,r\x82\xe4\x00\x81mH\x02\xfc\xc3X\x90\x8c\x9c>h}\x1c\x8d3\xf0\xc2D\xabX\xfd\xaa\x9b\xdb\xf7\xc7\xf9\x84\xfe\xf8qo\xaf\x97J\xb1!\x85\xe3e\x7f=\xb4\x97\xbaR\xbf~\xfe\xd0Y\xbd\xef\u07b6\xf7\xbeFsl릫\x14\xc7\xdbC\xa1o\x8f\xc3\xf7\xeb\xa3R\x04\x02GpT\x18\xdaC\xd7,\x82\xc7\xf9t\xb9W\xaa\xe9\xba۷\xcdf\x18\x0638s\xfd\xa87\x96\x886\xf7\xbeV\xbb7\x00\xd8\xde~w\r\x0e\x95:\x83\xad8\xed\xc1&F\xe2\x10Ap\xd0l\x9c+\xd9{9;\xf4\xd0\"!h2Ŗ\x98\xb3\x9c<{\x8aV\xb4\x99m\x90\x7f\xb6\xb9P\x84\xb6\xc6\xf3\xe8u\x82\xf6\xc6G*.C;\x938\xa7\x80\xbd\\\xce\x1c\xbdH\xc9\xc44\x19ZC\xe4\xcak-[rlA\x9f\xac>9%\xf6\x02J&\x94\x14C\\q\xb0\xf1\x99|̫ғ\xacǔ\xe8\x19{\x95X~\x7fp\x86.\xaf\xe1\x9d\vҟ\x8c\xf4t\xe3\x14b\x886\x80\x16_\x89\x96\x98\x8a\xc7\t_E'C\xc9[\x1e\xaf\xd2t\x93\xb3\vů\n6\xa4<g\xfe\"2\x19\x1b9\xb1P\xd8%\xfaܜLpa:<+3͒\x97L\xf0Q\x8cd\x81\xc1\xc9\xe2\x18\x9a\xc1h\xa0\xf34\xd1YAR\xff'ӣN\xe6B \xb5\xf9\xef!%d4\xb0#a\b\x942\x83\xc0\x82\xe2\x8b\xe5\x02\xe9\xd1#,\x14\x93B\xaf>/\x14v\xa6X<\xf4Z6Q\xf4\xd0a\xd9ɢ[)^I\xb7\xf2\xf4wo\x7f\a\x00B\xbdV\vy\x03\x00\x00",
+	},
+
+	theme.IconNameInfo: {
+		Name:     "dialog-information-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x9cT[\x8e\x1aK\f\xfdg\x15V\xdf\xef2~\xd4\xf3\x8af\xa4\xfbqW\x90,`\xd4C\xa0%^\x1a\x10\x90\xac>ru7\x0fe\xbe\"$\xe4\xb2}\xec\xe3S\xeeZ\xbc\xddv[\xb8\xac>O\xfda\xdf6\x8c\xd4\xc0j\xdf\x1d>\xfa\xfd\xbam\xbe\x7f\xfb\xdf\xe5\xe6m9[\x9c.kج\xfa\xf5\xe6\xdc6\x1c\x8f\xb7\x06.\xfd\xea\xfa\xdf\xe1\xd66\x04\x04\x1c\x81c\x03\xd7\xfe㼙\x12n\xbb\xed\xfe\xd46\x9b\xf3\xf9\xf8\xef|~\xbd^\xf1\xaax\xf8\\υ\x88\xe6\xa7˺Y\xce\x00\x00\x16k\xf8\xd1o\xb7m\xf3\x8f\xac\xd4k\x1c\xdd5t|?o\xe0\xa3mv\x90\xb0\x94H\xc5\x03A\aN0k(\x9a\x81\xc0\x05\x94\"%\x16\x10\xb3sb\xcb\xf2\x98RR\xef-\x990H\xb5\x05\x83\x06\x0e\x11\bcN\x01\x02\xd2p\x16\xb4cD\x95\xcc\x12\xe0\x02\x84\xb9\xf8\xa0\f\x1d\x10\x10\x86\xe0c\xce@\xe8}\x11.\xc0\xf5\xb7\x01+oQJ\x99\x81\x80\xad\x977\x9c\x98Ͱ\x05B\"-\x14-\x92K\xf6\x9a\xa1\x03AN\xc5\n:\x1e\xb8gP\xd4!\xea\x14-\x96\x8cm\x8e\x89-+\xa2\xaa\x90\xf28L\x16R1\rlBM\x06\xa9=\x14\x9c\xc7\x14\x8b\xf1\xaeJ\x84'\xc7/\xd8\x01\x81@\a<\x10I@\xa0\x932\x8c\xb5\x81\x80b\xa9\xdc@'\x8e6\xa1U\x97h\xc8h\x12U\x16i\xccO\x12C\xactԴ\xf3\xe8s\x96<r\xd5!\x9f\x90C\x94Pq%\xc5z\x03\xe1\xee\x8ad&\x10\x16\xf6\x14\xa5\xca\xef\xad{\x81\r8;\xbb\xbb\xa3V}H\xaa\xc3$\x8eP4\x8f\xccR\x98\xa2caw\xafܙ\xe2\xc9KU\x950EN\\\xaa\x926L4\xabr\xb2Y\xa2\xa7d\xfay\xeb\x10\xe0\x04\x824RVd[?_\xd5\xf2U\xf8\xbb\xcbtv<-+\x1b\xfb/\xb6h\\\x91a\x8b\x04\xba\xa7\xe8\x9f[t\xb1\xbf\xe1\xfe\xa8\x99\x7f\xf9uD\x8c\x15\x01\x11ُ\x8b\xeb\b\xb9\xd4*\xa3\xa1/\xaeQ\x7f\x02\xc2D6?l+\x9f\xee\x91\xfed\x04\xaeR=yF\x10U\x98{\xc1\xd56&̋\xab\x96\xa8_\xec\x1d\xfcL\xf2\x01z\xe4\xbe\xf8\x9e\x1a\xda\xdd\xfaa\x87\a#ON\x1fT\xee\xe1\xfc\x97\r&\xad\xeb\xbb\xe4\x0e\xc7\xf7\xae?\xffl\x1bB\xf5\x85d\xba\x82\xc5|\xbd\x9c-\xec![\xce~\x0f\x00\xd9(\r;G\x05\x00\x00",
+	},
+
+	theme.IconNameList: {
+		Name:     "view-list-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xb4\x92\xdfj\xac0\x18\xc4\xef}\x8a\xe1;\u05c9ߧƍ\au\xe1\\\x9c'h\x1f\xa0\xecZ#\xf8gYE\xa5O_\xacuw\v]Z\n%\xb9\b\x99\xc9\xcc/!\xe9~nj\x8cŹ\xaf\xba6#\xd1L(\xdaCw\xac\xda2\xa3Ǉ\xff\xca\xd2>\xf7\xd2~,ኪtCF\x12\x9ff\xc2X\x15ӿnΈ\xc1\x90\x18\x12\x13\xa6\xea8\xb8\xcd07u\xdbg\xe4\x86\xe1\xf4\xd7\xf7\xa7i\xd2S\xa8\xbbs\xe9\a\xcc\xec\xf7cI\xb9\a\x00\xe9\xe9ip8f\xd4 \x80\xe0\x00\xc5ژ(\xb6\x16\f%`\x1dE&\x94`Y\vF\x048\x80q\xf5l\xb2\xbc\r\xb7\xea\x17\x15\x02ucQK\x82Z#\xae=\xea\xb6c\x9d/h`\xde\xfb\x1c\xeczj\xd9T\x06\x91f1q`\uec86\x1b\xab\xe8\xc4r\x14'\x17d\xdeY\xf9.r\x12HrA\xfe\x90\xb4\xb5\xee\ue4f3Nl\x14\xee\xcc\xe7\x17\xf8\xfa\x95\x7f\x99\xdc\xfe\x84\x9c\xc1\x84窮3\xfa\x13\x14a\x14\xc6\xe4\xe7^\xba\xfc\xa5\xdc{\x1d\x00\xa6\xad\xb7\x95\xca\x02\x00\x00",
+	},
+
+	theme.IconNameLogout: {
+		Name:     "system-log-out-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflTI\x8e\"K\f\xdds\n+\xff:\x8d\x87\x18\xbfHJ\xfa\x8b\x7f\x82\xee\x03\xb4(:\x13\x89\xa1T @}\xfa\x96\x1dIM\xaa\x15\xc1\v\x0f\xcf\xef\x85s\xf5t?\xec\xe1\xba}=\xefNǡc\xa4\x0e\xb6\xc7\xcd\xe9yw\x1c\x87\xee\xe7\x8f\xff\xfb\xd2=\xad\x17\xab\xf3u\x84i\xbb\x1b\xa7\xcb\xd0qz\xb9wp\xddmo\xff\x9d\xeeCG@\xc0\t8up\xdb=_\xa6G\xc0\xfd\xb0?\x9e\x87n\xba\\^\xfe].o\xb7\x1b\xde\x14O\xaf\xe3R\x88hy\xbe\x8e\xddz\x01\x00\xb0\x1a\xe1\xf7n\xbf\x1f\xba\x7fd\xabA\xd3\f\xfb\xd5˯\xcb\x04\xcfCw\x00V\x88p\x05B\"\xad\x94`\x03\x84\x92b\x92\b\x04\x84\x91kT\x06B\xa6\x18R\x05\xc2L\x99\x1c\x91R))\xecA@<\xcd\xf2%~s`$Ѡ\x19\b\x18\x03\aJ\x02{\xe8[^O\xc8%[4\x17\x8d5\x18\x10<\xe8\xbdG\xff\xa9m\xe4\x98gʹ\xb0\xc0\x04=\xc3\xd5\xeaM\xd0\xc7V2F\xbb\x03\xb2+/X\x85\xab\xffa8\xc3G\xc0\xa1\xc9E\xe8\x05\xfe\xc0\x01\b\xa8[~+VE\xce5\x95b\x13՜\xa2\xc9\xd5\vj\rQ\xc5\xfb\xf8\xbc}\xc0\xcad,\tCT6(a\f\xa9\xe4\x04\x16^\x82\x96\x96\xca9\x1aO\xc1\xd8\xea\xbdCi.FPa\x03\xf2\xe8\xfc\x16\x19\xb1\x8a#\x8a\x12]\x87\x82%\xa9\x14\x01F\xf7ϭT\n\xa9\xb8\xc8*\x85\xd5M)\xe6EO\xa8\x91c2ȘECRs\xd92)%\xf3\xa0\xa7V\xde¨\x8dB\x18\x1f\a\x9br\xf6'q\xe6\xdaė\x9c58\x1a\x8c\xaa+SJ\xb2{\xeb1\x8bĨ)siL\x8c8ϒ4\x0e\x8c¡\x84`j\xe6\xf9Abv%-\xb6U`\xf6\n\x1b;ǹ\xea,\xc2\x1b\x12\xda/Yfh\x92m\xe0K8C@\x11\x99\x1dP\x15Rs\xe0c\xa3\x99 a(.\xa9\x8d鏚\x91J5\xa2\xf4\x88\xfa<Yf\xef\xf8\xe6\x80\t\xe4\x1bfP\xca5\xb9*\x11>\xa9\xd9\x1cu5\xfd\xe1\xeb\a#\xec\xa4\xc5g\x9c\x15nZ\xbf\x9bX5\xcf{\x90\xcd\x7fo\x10\xc4\xd7\xccd\xe16;a\xaam\v\x04\x95\x1fn\xdb\x12j\xf9\xba\b\xab\xe5\xb8^\xac\xec\xeb\xb2^\xfc\x1d\x00\xc2R\x1dg\xdc\x04\x00\x00",
+	},
+
+	theme.IconNameMailAttachment: {
+		Name:     "mail-attachment-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflT]n37\f|\xcf)\x88\xed\xf3\xd2$%QR\x11\xe7\x03\xfa\xd0\x13\xb4\a(\x12\xd76\xb0\xf9\xc1g\xc36z\xfa\x82\x94\xf6\xa7h\x808\xe0R\xe4h4C\xe9\xf9\xc7\xe3}\x82\xdb\xe1\xe7\xe5\xfc\xf9\xb1\x1f\x18i\x80\xc3\xc7\xeb\xe7\xdb\xf9\xe3\xb8\x1f\xfe\xfc\xe3\xf7\xb1\f?^\x9e\x9e/\xb7#\x9c\x0e\xe7\xe3\xe9\xba\x1fX\xbf\x1e\x03\xdc·\xfbo\x9f\x8f\xfd@@\xc0\n\xac\x03\xdc\xcfo\xd7\xd3\\\xf0x\x9f>.\xfb\xe1t\xbd~\xfd\xba\xdb\xdd\xefw\xbc\a\xfc\xfcy\xdc\t\x11\xed.\xb7\xe3\xf0\xf2\x04\x00\xf0\xfc\xf5\xd7\xf5\x04o\xfb\xe1\x1d*\xe6\x04\x84\x92s\x88\x11^a$,\x95T\x12\x10\x8c\x8c\xb9\xb0\x85\x18B-1\xc2(\x18S\xa6\xc0\xc0H\x9c\xacl\x821`\x02\xfby7qM\x81a\t,\x15*i\x80\x1e\x88\xa7RI5ZJen4\\\xaf*B\xad1\xe5(\\\x81P\xc5HY*W\xa9Z\x811\x86\xdc\x1b\x85\x8b\x9f\xa1PԒ7\xe8\x8c5\x8b&\xb5\xb5\xc6_\xb0\x92\x84\x18\xe0\x15\b+GcCX#G\xca \xc8m\x1bF\xaa\x86.Xc\nl%E3\x97\xe2m\xae\x88m\x1b(:\x13\x11\xd1\xe4\xfbڶ\xa1\x00\xa3\x17\x18\xab\xc9H\x14/\x1c\x97\xc8PB\x13y\\\xa25\xc5\xe8\x1css 6\x96\x97M~\xdc\xd6\xf6u2#\x04\xa4kI\xb94-]rO1gײ\a\xdfXe\xbc\x98\x83\x14\x17\xdf\xcaJ\xb1\xc8U14\t\xa5/\xa6\xe2z\x12\x06q\x8b\x9a\x11U\x9b\x13\x1b\a\r2\x96κj\xb4.\xe3\x1f\xbc\xc2\xdb|9\xb5j\xfbl~\x9a\xd7L&\xa7\x95\xc7l>\x1aD)Rĩ\x92MG#\x98\x9b^(\xc9\x06֡\xda\xc1\t\x83[g)U\xe2\xa40A\xc0\x18\xab\xb1\x1e\x97\xc8\xf04\xc6>\xb0=b\x8cm2֔ 5\x8fMo\xc1\xe4\xbfM\xf3\xff\x83\x05\x84\xd6\xe6\tF\x055O\t\xb9\x84v\x13<\b\x96\x8a\xd9\a\x8ap\x1dq\xbf\x93V\xe4C넒\x0f\xf2\"\xe5H\xe8\xaa˚:\xc1\x18Z?k\xaeZ\x81\x1c\xbd\x9f}뵶\xf5\u0558\xcb&\x9e\x8dY?{\xf9\r\xc6h\x87\xf7\x8ad#\xb7\xc5\xe7\xfeg\x93\v\xdb4\xc3\rZ\x1fai\x98ݦ\n<\x03\x11V\xf6Md\x9e\xa6\v0J\xfafaɜ\xa0]\xec\x19Դ\xd7>K\xa6mN\xc0XmJ\xcb\xe6\x14\xd6\x11\xd5n\xbc\u05f9E@\x98\xedEt;\xda\xed\xfc\xef\xf7\x04\n\xa3\xc2+0\x86\x94\xfa\xf5\xeeњ\n\x98R\x83\x831\xce\xef\x8d\xddSLퟛ\xa39u\xe9\xe6\x881\xa9\xba\xcf\xcb3\xbb\xbe\xbck\xee\x1fx\a\x02\x1a\xe0\xef\xf34\xed\x87_\xe4\x10b\xd0a\xf7\xf2\xf4l\x8f\xfd\xcbӿ\x03\x00\xfb\xa0F%k\x06\x00\x00",
+	},
+
+	theme.IconNameMailCompose: {
+		Name:     "mail-message-new-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffdR]Λ0\x10|\xcf)F\xee\xb3ͮ\xd7\x06\xbb\n\xf9\xa4>\xf4\x04\xed\x01\xaa\x84\x02\x12\xf9Q@\x10\xf5\xf4\x95]HR}\xb2\x1f\xcc\xec\xec\xce̊\xfd\xc7\xe3<`n\xeec\x7f\xbdԊ\r)4\x97\xe3\xf5\xd4_\xdaZ\xfd\xfc\xf1]\a\xf5q\xd8\xedǹE\xd7\xf4m7Պ\xcb\xdbCa\xee\x9b\xe5\xdb\xf5Q+\x02\x81Kp\xa9\xb0\xf4\xa7\xa9\xdb\b\x8f\xf3p\x19k\xd5M\xd3\xedkQ,\xcbb\x161\xd7{[X\"*ƹU\x87\x1d\x00\xeco\xbf\xa6\x0e\xa7Z\x9d!`\x1c\xa1ٔ\xceya\x10\xb4\x80\x8dx\xefʘނ\x19%\x8e <9ϲ\xe4\xd3\xc1\xa6\xba\xf1\x9e\xaa\xc0\x89\bMƹh9\xa67c|\x034\xaf\xb7\x83N}\x9a\x8c\xafH\u0602r\x81\x8c\xb3\xb1\fae\xcd\xd0\xde\x04ʓ\a8S\xb2\xd8\xc0\x10\x93\x10\xeb\xb3p\x10\x1f%d\a\xc1G\a6Q*\xff\xfa\xb6\xa6\xaa\xc4%\xc6\xfb\b\xfd\x9c1C6\x89\x94\xf3\x95\xe4\x15\"\x9d\xf1s\xb0\x19\xda\xe5\x9e\xd7\x02\xf5\xfb\xf2\xfe\xdd?8\x836\x05\x8b\x0e\xc1H\f\xc9А\\Dˡ\U000b06f1\xbc\x13\xae2H\x86W\xb7d$\xd8\xc0\xf2\x1f\x94\x84B\x00e\x89\xcap\xcc\xd6r\"tЂ9\xeb\xc9\n\xd8dXV@ۍ\xb2Y\x04)\xfc\ue1e1V_l#NJU\x1cv\xfb\xf4\xdf\x1cv\x7f\a\x00\x82^\xbe\xb4\xb6\x02\x00\x00",
+	},
+
+	theme.IconNameMailForward: {
+		Name:     "mail-forward-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflR[\xae\x9b0\x14\xfc\xcf*F\xee\xb7\xcd9~aW!W\xeaGW\xd0.\xa0J( 9\x0f]\x10D]}eȃ\xab\xe4\x8b\xd1x\x06ό\xbc\xfd\xb8\x1e\x13\xc6\xfa\xb3\xefΧJ\xb0\"\x81\xfa\xb4?\x1f\xbaSS\x89߿~\xca >v\x9bm?6h\xeb\xaei\x87J\xb0\xbf\\\x05Ʈ\x9e~\x9c\xaf\x95 \x10\u0603\xbd\xc0\xd4\x1d\x86\xf6.\xb8\x1eө\xafD;\f\x97\xefE1M\x93\x9a\x8c:\x7f6\x85&\xa2\xa2\x1f\x1b\xb1\xdb\x00\xc0\xf6\xf2ghq\xa8\xc4\x11\xcc\xd0\u0603\x94\xf6\xcek\a\x02)\xc7\xd1\x19\x06)&g}\x04\xa9\x92J\x9a\x19\x1du\xf4\x11\t\x16v\xb6\x99H\xb3\xed\x05\xb0\"m\xac)A`eْ\xd7H\x90\x8bO\xbe\xea\xe5Ӱ\xa2nFB\x0f\xf9N\xba\xd2$\xe8{<\xf9@-dP.\xb8hB\xbe\x96\x15s\xc9!d\xa3\x06\xa9\x10t`\x9d\xb1\xc6\b\x83\xfd\xd2\xdfQ\x198_hm\xd4\x1c\xc1\x90\f\xce\x19֬\x9c\xe9\x11r\xf1IZR\x85\x1b\xb6>\x94\xb9\x1f\xd1=6\xa9y\xc6eo2\x8e\xe7ťV\xec-y\x03VA\xe7\xf3\xd2A\x1a\x95\v\x84\x00\xa3b\xf4\x14\xcd\x17\xb2ţUZ\xb5}\xa2\xf5\xc4o\xd1\xdb\ts.Ω\xe5훻\xe6\xa3L\xdc\xfe\xfcx\fO\xea\x1f\x8e \x90\xc0\xdf.\xa5J|ӵ\xb1Ƌb\xb7\xd9\xe6G\xb7\xdb\xfc\x1f\x00\x86\xda~\x8f\xf3\x02\x00\x00",
+	},
+
+	theme.IconNameMailReply: {
+		Name:     "mail-reply-sender-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x84\x92\xcbΛ0\x10\x85\xf7y\x8a#wm3\xe3\x1bv\x15\xf2K]\xf4\t\xda\a\xa8\x12\nH\xe4\xa2\x1f\x04Q\x9f\xbe2ᶈ\xf4\xcb\v\x8e\x0e3\xf67\xc7>~<\xaf-\x86\xf2\xb3k\xee\xb7B\xb0\"\x81\xf2v\xbe_\x9a[U\x88߿~\xca >N\x87c7T\xa8˦\xaa\xfbB\xb0\x7f<\x05\x86\xa6\x1c\x7fܟ\x85 \x10\u0603\xbd\xc0\xd8\\\xfaz)x^\xdb[W\x88\xba\xef\x1f߳l\x1cG5\x1au\xff\xac2MDY7T\xe2t\x00\x80\xe3\xe3O_\xe3R\x88+\x1c4ΐ\xa4\xb4w^;PҎ\xa33\fRL\xce\xfa\x98\xac\x9cr\x9a,\x1du\xf4\x11-\xa4\x85}\xb5\x9aH^;\x8dE\xed=V\xa4\x8d59\b\xac,[\xf2\x1a-^\xadk\xfd*\xb6\xea͙\x9b\b\xdd\xee\x80ݶr\xb7\xaf\xd4\vߦj\x04傋&\xe0\fV\xcc9\x87\x00B\xe2\rA\a\xd6Hk\x80IH \xe5\x1c\xe5!\x8djm\xd4\x1c\xc1\xd3\xea\xc0\x90;O2\x06\xc8W\x8f\xa4\x17N\x98\xb5\xf5!\x9fR J\xc0\x93J\xf1\xcdQ\x93q\xec|Bdoɛ4B\xd0d\xd8@\x1a\x95\xa8C\x98T\xf4\x14\xedΫ!\xd7YZ\xbc\x99\xf5\xfc\xe6\x0e\xbeJmB\xe2\x19x\xf9\xda\xe9gR\xcb\x19\xeb\x13ؼ\x7f\xb8\x82@\x02\x7f\x9b\xb6-\xc47]\x1ak\xbc\xc8N\x87czl\xa7\xc3\xff\x01\x00\x93\xa7K^\xeb\x02\x00\x00",
+	},
+
+	theme.IconNameMailReplyAll: {
+		Name:     "mail-reply-all-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xbc\x93\xcbΛ0\x10\x85\xf7y\x8a#wm3\xe3\x1bv\x15\xf2K]\xf4\t\xda\a\xa8\x12\nH\xceE?\b\xa2>}e\x92\x10\x16\x91\xba\xa9\"/\xf8t\xf0\x8c\xcf\x19\xc3\xf6\xe3zL\x18\xebϾ;\x9f*\xc1\x8a\x04\xea\xd3\xfe|\xe8NM%~\xfe\xf8.\x83\xf8\xd8m\xb6\xfdؠ\xad\xbb\xa6\x1d*\xc1\xfer\x15\x18\xbbz\xfav\xbeV\x82@`\x0f\xf6\x02Sw\x18\xdaǆ\xeb1\x9d\xfaJ\xb4\xc3p\xf9Z\x14\xd34\xa9ɨ\xf3gSh\"*\xfa\xb1\x11\xbb\r\x00l/\xbf\x86\x16\x87J\x1cᠱ\x87$\xa5\xbd\xf3ځ2;\x8e\xce0H19\xebc\x96J*i\x96t\xd4\xd1G$H\v{+5\x91\xbcv\x1a\x0fZk\xacH\x1bkJ\x10XY\xb6\xe45\x12n\xa5\xcb\xfe\x05\x9e\xbb\x9fʽ\x88Я\x0eX\xb5\x95\xab\xbe\xd2<\xfc=)ᅸ\x7fa\xf6_\xed\xe7\xac\x1cJ\xb7~\xda\xf9e\xa6\xc7\x19ˬ\x9e\xda\x1f\x1caA\xffiЯ\xac\xbfy\xcczI\xbbP\v\xab\\p\xd1\x04\xec\xc1\x8a\xb9\xe4\x10@\xd0 \x15\x82\x0e\xac\x91\xd7\b\x93-\x81\x94sT\x86\x1c\xd4ڨ9\x82\xe7Ճ!W\x9ad\x8c\x90\xb7\x1aI7;\xe1\xce\xd6\xdf/\x81(\x1b\x9e)\x0f\xef~Ud\x1c;\x9f-\xb2\xb7\xe4M\x8e\x104\x196\x90Fe\xd7!\xcc\x14=E\xbb\xd2Z\xc8%K\u008b\xac\xef\xffz\b$\xf0\xbbK\xa9\x12_tm\xac\xf1\xa2\xd8m\xb6\xf9\x9f\xdem\xfe\x0e\x00!-\x9f\x14R\x04\x00\x00",
+	},
+
+	theme.IconNameMailSend: {
+		Name:     "mail-send-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffTS[\xae\xda<\x10~g\x15\x9f\xfc?\xdb\xccx|\xfdE8R\x1f\xba\x82v\x01\x15\x87\x12\xa4p\xd1\x01%\xa8\xab\xaf\xec\\H\x052\xe3!\xf3\xdd\x06v\x1f\xafK\x87\xfe\xf8\xf58߮\x8dbC\n\xc7\xeb\xe1\xf6y\xbe\x9e\x1a\xf5\xf3\xc7w\x9d\xd4\xc7~\xb3{\xf4'\xb4\xc7\xf3\xa9}6\x8a\xc3\xfd\xa5П\x8f÷۫Q\x04\x02\apP\x18Ο\xcfv~\xe0u鮏F\xb5\xcf\xe7\xfd\xff\xedv\x18\x063\x88\xb9}\x9d\xb6\x96\x88\xb6\x8f\xfe\xa4\xf6\x1b\x00\xd8\xdd\x7f=[|6\xea\x82\x04\xc2\x01\x9a\x8c\xf51\xb1\x05\x95\xda3G\xce C9\x06\x1fl\xe9E\x8a$\f26\xdb\x1c2:h6֣\x1e\xf5\x12C\x9db\x13\x85\\\xc8#,gv\x14P\x8al9U\xa6\xc4փ\x8cs\xf5\xabU'z\x1c@ 3\xd1\xf2\xf4:`\xa5\x8f\x8c\xb7Q\x9c\xab\xe86e\x8ae\x92S\xf4\xa5#\\\xe5\xf0\x84\xa9碇\x18_\x1cX<@\x13\xee\x03\f\xcd\xe3\xd1C/O\xbc\xe7\xe7\xcf\x03\xd6V\x92\xf8,\xc5\x01\x13\xfb\x80\x89uV1]\xab\x93\x82M\x86\xac8I#\xcfa\x8cX(\xf9\\=P\xcait\xe3=\xc5d߁\xe8\x9a\xc8:[\xbd\x84;\xc7?\x9eSԾ\x88_\xaa\x1a\xb6sٖ\xc1eo\xefU\xbe{\x7fp\x81\xf6\xa8+c\x13\x9c\xf3\xc2E\xa6\x80\x8dx_\xf8\xb4@\xd0á\x85\x1d\xb3JD11:8\x13Xlb\x88)\x9d)\xad\x9aQ*\xdb\xf2\xd5)\x9b,ѿ\xef\xd6\xc4XS\xa15\x84^0z,\x14\x13\xa5\x1b\xb3[\x04굸\xf1]\x8c\xd0<f\xd1\"\x19ɩ\xb0t\x05\xba\xfc\x02\xa3\x87\x9d\xd9\xc6\xd8\xe6\xad\xf1$\x81\x8c$\x9bX\xfei\x15\xa2T\xc4V\n\x90\xc2\xefs\xd75\xea?{\x14'Am\xf7\x9b]\xf9\x87\xed7\x7f\a\x00xv\xdd\xcd\xe0\x03\x00\x00",
+	},
+
+	theme.IconNameMediaFastForward: {
+		Name:     "media-seek-forward-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xe4\x92]\x8a\xa40\x14\x85\xdf]\xc5!\xf3\x9cx\xaf\x891\x19Ԃy\x98\x15\xcc,\xa0\xa9\xb2\xa3\xe0OQ\x8aJ\xaf\xbe\x89\xddEUC\xef\xa0_\xc2\xc9\xc9\xfd\u0381K\xca\xd3>\xf4X\x9b\xdb\xdcMc%X\x91@3\x9e\xa7K7\x86J\xfc\xff\xf7W:q\xaa\x93r^\x03ڦ\v\xedR\t\xb6\xd7]`\xed\x9a\xedϴW\x82@`\v\xb6\x02[wY\xda\xfb\xc0>\xf4\xe3\\\x89vY\xae\xbf\xd3t\xdb6\xb5i5\xddB\x9a\x11Q:\xafA\xd4\t\x00\x94\x01\xaf]\xdfW\xe2W\xd6h\xa3\xed\xa7}<]_\x96\x16\x97J\f\xc8`p\x06).\xbcu\x0e\x04R:\xf7\xba\xc8A\x8a\x8cu\x87\xc89\xb7Y\x14l(\x8a\x1e9\xf4\x81Y\xe3\x8c.\"\xe4l\xc1\xfe\xe1\xb0ҙ\xe3\b\x81U\xc11\xa7\x87\xfc\xc0$\xa9{ 9\uf309\x8e>j\x1f\x1d\xf2\xb9V\x1f\xe1gH\x8e\fiO6jI\xca{K\xde|\xbd\xac\x90\x163\xe4\xf3(\xc7\xe3\r\x03\b$\xd2oW\xe1\x7f\xf0*\xca4\xd4I\x19\x7fO\x9d\xbc\x0f\x00\xfd\x95+w\xbc\x02\x00\x00",
+	},
+
+	theme.IconNameMediaFastRewind: {
+		Name:     "media-seek-backward-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\x92݊\xa40\x10\x85\xef}\x8aC\xf6\xdaXeb\x12\x17c\xc3^\xec\x13\xec>\xc0\xd0\xedD\xc1\x9f\xa6\x15\x95y\xfa\xc1֦\x1bf\x1ea \x84⫟S\x14\xa78\xad]\x8b\xb9\xba\x8d\xcd\xd0{\xc1\x92\x04\xaa\xfe<\\\x9a>x\xf1\xff\xdf\xdf؉S\x19\x15\xe3\x1cPWM\xa8'/\xd8\\W\x81\xb9\xa9\x96?\xc3\xea\x05\x81\xc0\x06l\x04\x96\xe62Տ\x82\xb5k\xfbыz\x9a\xae\xbf\x93dY\x16\xb9(9\xdcB\x92\x12Q2\xceA\x94\x11\x00\x14\x01\xefM\xdbz\xf1+\xad\x94V\xe6\xc0\xf7\xd4\xf5m\xaaq\U000620c5\x96D*'\x833b\x92ls\xe3\xdc\x16\x1d4&\xa9\xb2\\\xd9\f$I\x1bg\xb3\re\x9c\x99tC\xaci\vZ\xc4\x19\xd4>\xc2h\xa7\x95\x05I\xe5\x8c\xe5\xfc\x05\xb1T\xa9\xe3\xad\x0f,-k\xa75Z\xec\x8d$\x1f#\xe9PSwݧ\xc6W\xd5\x19\xf4\\\x9e_\x96fļ\x7f3b\x83\xf1Y\x17\xf3\xf1>Ё@\"\xf9\xf6,\xac\x7f\uecbb(\teTl\xb6*\xa3\xcf\x01\x00.\xcek5\xd5\x02\x00\x00",
+	},
+
+	theme.IconNameMediaMusic: {
+		Name:     "audio-x-generic-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xc4S˪\xdb@\f\xdd\xe7+\x0eӵ\x15\xc9\xf3\xf0\xb8Ĺ\xd0E\xbf\xa0\xfd\x80\x92\xa4\xb6!/\xae\x8d\x1d\xfa\xf5E\xe3Grw\xa5\x9b\v\x9eA\xd2\xd1\x1cIGx\xf7\xf6\xb8\x9c1\x9c\u07bb\xf6v\xad\x8c\x10\x1b\x9c\xae\x87۱\xbd֕\xf9\xf9\xe3{\x16\xcd\xdb~\xb3\xeb\x86\x1aͩ\xad\x9b\xbe2\x12\xee\x0f\x83\xa1=\x8d\xdfn\x8f\xca0\x18\x12 \xc1`l\x8f}\xb3$<.\xe7kW\x99\xa6\xef\xef_\xb7\xdbq\x1ci\xb4t{\xaf\xb793o\xbb\xa16\xfb\r\x00\xec\xee\xbf\xfa\x06\xc7\xca\\\x10!8 \x13\n\xcey+`d\x16B\xd6{\x17J\xb5-\x068\xb2\xce\x16^\x13\x99\x9c/؊Z\xb9\xc4«Q\x06\x17\x9dSk\xca˄\xfc\xd3K\xf4唬\xf4\xe4!\xe4\x8b(\xb9\x9f<=\xdd3\xb6\x84\xf4\x1c T\x8a㐃a\xc9)ML\xfc\xdaD\x9er2K.\xb2\xb6{P~&f%\x9am\x91B\xca\xc5.u\xc4\x01Y\x91\xa8SЇ܃i\xee\x1b\xa2W\x03\xa7\xf0\x8cA\x16\\\xe1\xcfT\xa3Kw\xf6\x01Ko^GZW\x99\xbd\xaeq\xfa\xfe\xe0\x82̑GҊ\xa2-\xb5S\xd6j`\n\x81Ň\xe4H\"Uɒ\x06\x87WY)\xda|\x9e9\x84\xb4\x1a!\x17\x9f\xa3\xae^z\xb5\xd6Xd\x98\xabL\xae\xa4\xa9>\x06W@\xbb\x8d\xe0\x7fh\xb5{!^\x9f\xcf\xc0\x7f\x95e\xb0\xc1\xef\xf6|\xae̗\xfcd\x9d\rf\xbb\xdf\xec\xf4\x1f\xdao\xfe\x0e\x00&\x18\x80\xb4\xc2\x03\x00\x00",
+	},
+
+	theme.IconNameMediaPause: {
+		Name:     "media-playback-pause-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xcc\xd1]j\xb40\x14\xc6\xf1{W\xf1p\xde\xeb\x98s\xd4\xf1\x9d)Ɓ^t\x05\xed\x02\xcah\x93\x80_\x8c\x92HW_\x94)\xf5\xa2\v\xe8mΏ?\x0f\xa4\xba\xae}\x87\xd0\xdeg?\x0e\x86$eB;\xdc\xc6\xc6\x0f\xd6\xd0\xdb\xeb\x8b:ӵN\xaa9X\xb8\xd6[\xb7\x18\x92rZ\t\xc1\xb7\xf1y\\\r1\x18RBJB\xf4\xcd\xe2\xbe\xc1\xdaw\xc3l\xc8-\xcb\xf4\xa4u\x8c1\x8dy:ޭΘY\xcf\xc1R\x9d\x00@e\xf1\xe1\xbb\xceп\xac͋\xbc|<\xef\xa7\xe9}qh\f\xf5\xc8!p\xc8q\x03\xa7\xa7\x13\xff?\v\x18\x02N\x8b\xe2\x92\xc9\x05\x02A\x80d\x1b\xf8!\xeapW\xb2'\xd4\xd6P\x87\x88:2\xb5\xbb\x00\xf5(\x1d\xe0\x81\xec\xe8\x13=\x18L\xfa\u05fd\xc2\x7f}p\xa5m\x9dT\xdbG\xd4\xc9\xd7\x00~5\xbc\xa7\a\x02\x00\x00",
+	},
+
+	theme.IconNameMediaPhoto: {
+		Name:     "image-x-generic-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflR펛0\x10\xfc\x9f\xa7\x18\xb9\xbfmvm\xec\x90\nrR\x7f\xf4\t\xda\a\xa8.\x14#\x91\x0f\x1d\bP\x9f\xbe\xb2\x9d\x03\xeb\x14ad\xb33\xcc\xcc.\xd4o\xebu\xc0\xdc~\x8c\xfd\xfd\xd6\bV$\xd0\xde\xde\xef\x97\xfe\xd65\xe2\xf7\xaf\x9f\xb2\x12o\xe7C=\xce\x1d|\xdbw~j\x04\xbb\xc7*0\xf7\xed\xf2\xe3\xbe6\x82@`\av\x02K\x7f\x99\xfc'a\xbd\x0e\xb7\xb1\x11~\x9a\x1eߋbY\x16\xb5\x18u\xff\xe8\nMD\xc58w\xe2|\x00\x80\xba\xc3\xdf~\x18\x1a\xf1M\xb7\xa64\xeeY\x8e\xd0\xe3\xcf\xe4qi\xc4\x15\x0e\x16\uf424\xac\xa5c\xc5 H\x06\xa9\xb2<i>\x853cܟ\x19\xa9\xc0\x90YM\x86\x92\xcc_J\xeb\x1f\xae0\xca\xc2`\x80\xd4\xd0ace\x03\xf4,\xcc \x15\xfc)\xee_o\x8fc2?jg]p cٺ\b\x86Ș?m\b$\x8a\x97\r\x96\xe0\xd0 +W\x96\xd6\xc4\x06\rX\x19kKw\ng\x83\x19Ṵq6\xd8\xc4\xcbG<S0\x90\x19E\x06\x05\x99$v\x1f\x99{\xa4\x95\x82\xea\xa7\x1e){$\xc3\x1a\x848q}rU\x05\x06oy6\x86\xcc\xe0\xf8I|\U00093646䌖濧\xday\x19\x83_\f\xaf.\xba\xf3\xa1\x0e\x7f\xd1\xf9\xf0\x7f\x00\xd8\xe3V@\xc4\x02\x00\x00",
+	},
+
+	theme.IconNameMediaPlay: {
+		Name:     "media-playback-start-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x91]\x8a\xdc0\x10\x84\xdf\xe7\x14\x85\xf2\xacv\xb7\xfe\x15Ƴ\x90\x87\x9c 9@\xd8ql\x83g<\xac\x8dmr\xfa -\xbbِ\xbc\x15\xa5\xfe\x9a\xea\xd2\xf9\xe9\xb8Mغ\x97e\x9c\xef\xad\x12b\x85\xee\xfe<_\xc7{ߪ\xef߾ꤞ.\xa7\xf3\xb2\xf5\x18\xba\xb1\x1f\xd6VIx\x1c\n\xdb\xd8\xed_\xe6\xa3U\f\x86\x04HP\xd8\xc7\xeb:\xbc\r\x1c\xb7龴jX\xd7\xc7\xe7\xa6\xd9\xf7\x9dvK\xf3K\xdf\x18fn\x96\xadW\x97\x13\x00\x9c\x1f?\xd6\x01\xd7V\xdd``\xc8c\x83\b\x9e\xcbV\xf2\x102!{+\x10r\xd9HJ\xffq\x060\x89I\x99c\xc1ȸ\xc0ق\x89\xd9f\x0e`r)\x99$\xd0L\xec9&\x03\xa6\x90\v[,\x89\x92b\xc0\x84L1\x87\x14=\xb4'\x9fc\xf0\xa1\xaes\xd6\xfa\xecʤqFR,\xb0\x0f\xc6\x17'Zg\xa3\xff\xe3\b\x19Sd\x8d\xaf\xf9-`a\xebCQ9UF\xff\x05\xd5\xc7\t\xfa\x9f\f夂\vg\x1b\x8b\x92*\xebro\xe55\x86D\x1f_\xef\xfbxV\xf5\x06\xe8\xf7r\x16\xe8\xf7\xf2\xf8\x83.=\xff\xc2\r\fV\xf89NS\xab>\x99\xce:\x1bTs9\x9d\xcb_]N\xbf\a\x00\xdea\r\xf5*\x02\x00\x00",
+	},
+
+	theme.IconNameMediaRecord: {
+		Name:     "media-record-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\xceMJ\xc50\x1c\x04\xf0}O1\xfc]7\x1f\x8d&A\x9a>p\xe1\t\xf4\x00\xf2\x1a\x93@\xbfx\tM\xf1\xf4\xf2D\xb1\xab\x81\x1f30\xfd\xe5\x98'\xec\xfe\x96Ӻ8\x92L\x10\xfcr]Ǵ\x04G\xefo\xaf\xad\xa5\xcb\xd0\xf4y\x0f\x88>\x85X\x1cI\xbd\x1d\x84=\xf9\xfa\xb2\x1e\x8e\x04\x04\xa4\x86Ԅ\x9a\xc6\x12\xff\n\xc7<-\xd9Q,e{\xe6\xbc\xd6ʪb\xeb-\xf0N\b\xc1\xf3\x1ehh\x00\xa0\xdf>J\xc4\xe8h\x86|\x82\xc5\x15\x02\x8aYm\xa4\xb5h\x15\x93\xaa\xb3\xb2\x83Ak`\x90\xef\xf1\xaf\xed\x0fg\x9c\xe1\x97\fN[\x83/\xcc\x10\x10\x84\xcf4M\x8e\x1e:\xaf\x1e\x95&>4\xfd\xfd\xcc\xd0|\x0f\x00\xbf\xe6e\xd4\v\x01\x00\x00",
+	},
+
+	theme.IconNameMediaReplay: {
+		Name:     "media-seek-backward-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\x92݊\xa40\x10\x85\xef}\x8aC\xf6\xdaXeb\x12\x17c\xc3^\xec\x13\xec>\xc0\xd0\xedD\xc1\x9f\xa6\x15\x95y\xfa\xc1֦\x1bf\x1ea \x84⫟S\x14\xa78\xad]\x8b\xb9\xba\x8d\xcd\xd0{\xc1\x92\x04\xaa\xfe<\\\x9a>x\xf1\xff\xdf\xdf؉S\x19\x15\xe3\x1cPWM\xa8'/\xd8\\W\x81\xb9\xa9\x96?\xc3\xea\x05\x81\xc0\x06l\x04\x96\xe62Տ\x82\xb5k\xfbыz\x9a\xae\xbf\x93dY\x16\xb9(9\xdcB\x92\x12Q2\xceA\x94\x11\x00\x14\x01\xefM\xdbz\xf1+\xad\x94V\xe6\xc0\xf7\xd4\xf5m\xaaq\U000620c5\x96D*'\x833b\x92ls\xe3\xdc\x16\x1d4&\xa9\xb2\\\xd9\f$I\x1bg\xb3\re\x9c\x99tC\xaci\vZ\xc4\x19\xd4>\xc2h\xa7\x95\x05I\xe5\x8c\xe5\xfc\x05\xb1T\xa9\xe3\xad\x0f,-k\xa75Z\xec\x8d$\x1f#\xe9PSwݧ\xc6W\xd5\x19\xf4\\\x9e_\x96fļ\x7f3b\x83\xf1Y\x17\xf3\xf1>Ё@\"\xf9\xf6,\xac\x7f\uecbb(\teTl\xb6*\xa3\xcf\x01\x00.\xcek5\xd5\x02\x00\x00",
+	},
+
+	theme.IconNameMediaSkipNext: {
+		Name:     "media-skip-forward-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflRۮ\x9b0\x10|\xcfW\x8c\xdcg\x9b]\xdf0\x15\xe4H}\xe8\x17\xb4\x1fP\x9dP@\"\x17\x1d\x10D\xfd\xfa\xca>&9\x89\xf2\x00\x1a\x86ٙ\xf5\xae\xeb\xb7\xebq\xc4\xd2~L\xc3\xf9\xd4\bV$О\xdeχ\xe1\xd45\xe2\xf7\xaf\x9f2\x88\xb7\xfd\xae\x9e\x96\x0e};t\xfd\xdc\b\xf6\x97\xab\xc02\xb4\xeb\x8f\xf3\xb5\x11\x04\x02{\xb0\x17X\x87\xc3\xdco\x82\xebq<M\x8d\xe8\xe7\xf9\xf2\xbd(\xd6uU\xabQ珮\xd0DTLK'\xf6;\x00\xa8;\xfc\x1dƱ\x11\xdftk\xac\xf1\x99N\xbf.\x7f\xe6\x1e\x87F\x1c\xc10X\xc0\x84\xf7\x18\aV\x14\\e\xc2#\xecA\x8a\xd9\xe8\xc0Q\xa64SeJ\x10HY.+_A\x92\"\xebC\xe9@\xca\x13;\xaf#\xc56X\x130\"(S%(\xad\nDe\xf61\xa5\x8b2M%\x19\x06)\xe7u\"\xbc\x0e\x15\xf9\x1b\xc1\x8a\\\xaa\x99\x92i\xf8\xac\n6\x97?\x89Fȗq\xa9Ը\xca$\x14{L\xc8T\x94Ss\xb7\xf2\xc5\tz\xc8\xdb\x00&\xc8\xdbd\xe8\vf\xfc\xc3\x11\x04\x12\xc5\xebA[堣U\xeeF\x97\xa5\xb16z\x90rq\xaaZ{\xe7\xb7/\x17\xb7\xc2i+w\xe9\xa6\xd9$\xf1鑗\xb2\xd9ER>H姝\xcc~\xf7l\xf9\x98z\x7f=\x1d\xa5.\xba\xfd\xae\x8ewk\xbf\xfb?\x00@\x06#\xdc\xda\x02\x00\x00",
+	},
+
+	theme.IconNameMediaSkipPrevious: {
+		Name:     "media-skip-backward-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x92]\xae\x9b0\x14\x84߳\x8a\x91\xfbls\xecc\x1bSA\xaeԇ\xae\xa0]@\x95P\x8cD~tA\x10u\xf5\x95\x89\xc9\xfd\xcb\xdb`\xbe9g\x18S\xbf\xdcN\x03\xe6\xf6u\xec/\xe7FhE\x02\xed\xf9p9\xf6\xe7\xae\x11\xbf\x7f\xfd\x94A\xbc\xecw\xf58w\x88m\xdfũ\x11\xda_o\x02s\xdf.?.\xb7F\x10\b\xdaC{\x81\xa5?Nq\x03n\xa7\xe1<6\"N\xd3\xf5{Q,ˢ\x16V\x97\u05ee0DT\x8cs'\xf6;\x00\xa8;\xfc퇡\x11\xdfL˖}>^_]\xffL\x11\xc7F\x9c\xa0\x1d\x1834\xe1\x90\xf6AjE\xc1U\x1c>\xe9\bIJk6A㐴\xd1Tq\tJ\xda\xea\xb2\xf2URd}(]R\x9e\xb4\xf3fu\xd9`9`\x80\f\x8a\xabUK\xab\x02Q\xb9\xcd\xe2\xbb\xc5PI\xac\x93r\xde܇\x98P\x91\x7f;ъ\xdcj\x1bAJ\xe7M\xc1&\xff\x17d\xc0\xd3u\xc9Ǯ\xe25Y\xca\xc8 \xc5\x15\xe5\x8d9\xec\x93\xfc\x11\x8f\x02F<\x9a!\xbc+\xe9\x1fN \x90(\x9eW\xad\x1c\f\"\xee)LY\xb2\xb5\tW)\xbc1\xc6;\x9f\x1f\\\xba\x91\x15{\x03\xe5\aFn`\x84\xdc.d\x1b(3\x90q\xf9\xe0g\xc8<\xf5\x1d\xfe\x01{\xa0\x9f\xbe\xa4.\xba\xfd\xaeN?\xd7~\xf7\x7f\x00\xe0nH\xba\xdb\x02\x00\x00",
+	},
+
+	theme.IconNameMediaStop: {
+		Name:     "media-playback-stop-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffT\xcfMj\xc30\x10\x05\xe0\xbdO\xf1\x98\xae%Kv\xad\xb8\xc5r\xa0\x8b\x9e\xa0=@ITI\xe0?b!\x99\x9e\xbe(!\x7f\x8bY\f\xf3\xf1\x86\xd7\xed\xb7q@4\xa7\xd5ϓ&\xc9\x05\xc1L\x87\xf9\xe8'\xab\xe9\xfb듵\xb4\xef\x8bn\x8d\x16\xcex\xeb\x82&\xa9\x96\x8d\x10\xbdI\x1f\xf3\xa6I@@*HEH\xfe\x18\xdc\x15l\xe30\xad\x9a\\\b\xcb{Y\xa6\x94x\xaa\xf9|\xb2e%\x84(\xd7h\xa9/\x00\xa0[~\x82\xc3Qӈ\x9a7\xa8\xe0\xf0\x86\x03\x04o\xabVVMN\xe7\r\x04W;\xd9\xee\x9a\xf3\x92'^\xd4ݱ'®\u0381e\xc8\x1e\xf2η;g7\x1f/V<\xea'u\x93\x7f\x18\xf3o¯\x1f\x06M/\x95\xa9_kEe_t\xb9Z_\xfc\x0f\x00\x85\xbe\x1c\xe4Y\x01\x00\x00",
+	},
+
+	theme.IconNameMediaVideo: {
+		Name:     "video-x-generic-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x91]n\xab0\x10\x85\xdfYő\xef\xf3\x981\x03\x0e\\\x01\x91\xee\xc3]A\xbb\x80\x8aP\x8c\xc4O\x14\x10\xa0\xae\xbe\x82$\x04U\x15<\xcd\xf9\xf4ͱ\x9d\x9e\x97\xb6\xc1Tކ\xba\xef2e4+\x94]\xd1_\xea\xae\xca\xd4\xfb\xdb\x7f\x8a\xd59\xf7\xd2a\xaa\xe0ʺrc\xa6\x8c\xbd.\nS]\xce\xff\xfa%S\f\x86\xb10Va\xae/\xa3{\x02K\xdbtC\xa6\xdc8^\xff\xfa\xfe<\xcfz\x16\xdd\xdf*?`f\x7f\x98*\x95{\x00\x90V\xf8\xac\x9b&S\x7f\x82RB\xb1\x8f\xf1\x16]?F\x87K\xa6Z\xb0\xb6\xa7\xc4\xc61D'I`\xe2\x18\x0e\xb4\x0f'$p+\x13\x86\x91\x184\b\xb5DQh\x13P\xa8#1A\x84/\xb4`\xb0\xf2\x7f՟ (@\xe6i`\x90\xc0\xec\x12\x81`B\x84\x02\x8c\x9d\xd9c\xd9>\a\x8b\x02\a\x83\x80\x0e\b\xad\x06\xba+^{\xe8\xb8\xe3\xfeߋ\x06\x0f\x1f\xeb\xe8\xc4b\x020\fX\x87\xc1v^\x03\xb3\xf7\xd9\t:Ĵ\x12\x0e\xb4*\xe8\xe0 s\xc0h\xe3^\xad^܁ؘ\x1f\x97\x97\xfaU\xee\xa5\xeb\x1b\xe6\xde\xf7\x00\x85?\x94\tB\x02\x00\x00",
+	},
+
+	theme.IconNameMenu: {
+		Name:     "open-menu-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x8e\xcfJ\xc40\x18\xc4\xef}\x8a\xe1\xf3\xbc\xcd\xf7\xb5\xa5\x8a$]\xf0\xe0\x13\xe8\x03\x88\x8dI\xa0\xff\u0604$\xf8\xf4\xe2\xa2G\x91\xbd\r3\xf3cF\x9f\xeb\xba \xdbK\f\xfbfHZ&\xd8\xed}\x9f\xc3\xe6\f\xbd\xbe<\x9f\x1e\xe8<5:f\ao\x83\xf3ɐ\x8cG%\xe4`\xcb\xd3^\r1\x182BFB\ts\U000bf17a.[4\xe4S:\x1e\x95*\xa5\xb4\xa5o\xf7\x8bS\x1d3\xab\x98\x1dM\r\x00h\x87\x8f\xb0,\x86\xee:\xdb\x0f\xfd\xf8c_\xa3\xe3-y̆V\b:xȀ|\x15'\x19\xf0\x89\x15\f&\xf5\ap\x7f+ \xffMh\xe5\xa6F\x7f\x7f\x9f\x9a\xaf\x01\x00'\xd9͝:\x01\x00\x00",
+	},
+
+	theme.IconNameMenuExpand: {
+		Name:     "pan-end-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\xccMJ\xc50\x14G\xf1yW\xf1\xe7:nr\xf3Z.V\x9a>p\xe0\nt\x01bc\x12H?hC\x13\\\xbd8\x10\xde\xf0\xc0\xe17\xde\xeb\x92p\xb9\xe3\x8c\xdbj\xc9(&\xb8\xf5k\x9b\xe3\xea-}\xbc\xbf\xb5\xcft\x9f\x9a\xf1\xbc<\x82\x8b>dKF\xf6J\xb8\xa2+\xaf[\xb5\xc4`\x18\x81\x11B\x89s\x0e\xffC]\xd2zZ\n9\xef/Z\x97RT\xe9\xd4vx}cf}^\x9e\xa6\x06\x00\xc6\xfd3\a̖\x16\xf4j\x18\x84\x87\x1e\xa6G\x82(\xe6n`A+Hh\x1f\xf3\a\v\x18L\xf8\x8e)Yz\xba\xb9\xae\xef\x84\xf4Ԍ\x7f\xf2\xd4\xfc\x0e\x00~F\xdb?\xd8\x00\x00\x00",
+	},
+
+	theme.IconNameMoreHorizontal: {
+		Name:     "view-more-horizontal-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xe4\x91On\xb40\f\xc5\xf7\x9c\xe2\xc9\xdf:\xc1I \xc3|\"\x8c\xd4EO\xd0\x1e\xa0\x1a(D⟆\x88\xa0\x9e\xbeʨ\xf4\x12\x95\xbd\xb0\xfc{\xcf^\xbc\xfavL#\xf6\xee\xb1\xf9ev\xa4$\x13\xba\xf9\xbe\xb4~\xee\x1d\xbd\xbf\xbd\x8a\x8anMVo{\x8f\xa1\xf3\xfd\x10\x1c)\xbb\x1e\x84\xddw\xf1e9\x1c1\x18\xcaBYB\xf4m\x18N\xc11\x8d\xf3\xe6h\ba\xfd\x9f\xe71F\x19\x8d\\\x1e}\xae\x999\xdf\xf6\x9e\x9a\f\x00\xea\xf5#\fh\x1dMа\x92\xf9R)\x8d;\x84\x92\x8a\xcb\xc2^\xc1\x10\x1a,\xabkQ\x1a\x9d\xe6\x84\x19\x89\xabҚ\x13)\xe8gm\xd0\x10iW\x15悧!\xc9\xcf{\x15įA\xe8\x9f\xfe\xc2\x04\v\xfeCo\x19L\xf8\xf4\xe3\xe8\xe8\x9f\xeeLa,\xe5MV\xa7`\x9a\xec{\x00K\v\x9e\xa3\x17\x02\x00\x00",
+	},
+
+	theme.IconNameMoreVertical: {
+		Name:     "view-more-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffܐ]J\xc50\x10\x85\u07fb\x8a\xc3\xf8\xdct\x92\xf6\xc6V\x9a^\xf0\xc1\x15\xe8\x02\xa4\xadM\xa0\x7f܄\xa6\xb8z\xc9\x15\xd15\xc8\xcc\xc3|3\x1f\f\x9c\xf6z.3\x8e\xf1\xe6ݶ\x1a\x92\x82\t\xe3\xdao\x83['Co\xaf/yM\xd7.k\xfd1\xc1\x8en\xb2\xc1\x90\xd4\xfbI8\xdc\x18\x9f\xb7\xd3\x10\x83!5\xa4&D7\x04\xfb#\x9c˼zC6\x84\xfd\xa9(b\x8c\"\x96b\xbbM\x85b\xe6\xc2\x1f\x13u\x19\x00\xb4\xfb{\xb0\x18\f-x\x14M\xa3\xb9\xa9\xc0\xe8\x91K!\xf9R\xe9\x06\x8c\\\x81E\xddT\x97R\xa6Y\xc1\xff\xb2\xbaW\x8f\xa4ˋV`(\xe4\x7f\xcey\xd2\uf2fa*\xeb\x84\xdf\xfd\x89\x05\f\xfd/_1\xe1\xc3ͳ\xa1\a5\x96U\xa9\xa9\xe8\xb26\x85\xdee_\x03\x00\xf3R\xae\xfd\xf3\x01\x00\x00",
+	},
 
-	theme.IconNameContentClear: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "edit-clear-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 7 2 c -0.832031 0 -1.558594 0.34375 -2.292969 0.78125 s -1.464843 1.003906 -2.128906 1.597656 c -0.660156 0.597656 -1.253906 1.222656 -1.707031 1.796875 c -0.226563 0.289063 -0.417969 0.5625 -0.570313 0.835938 c -0.152343 0.277343 -0.300781 0.53125 -0.300781 0.988281 s 0.148438 0.710938 0.300781 0.984375 c 0.152344 0.277344 0.34375 0.550781 0.570313 0.835937 c 0.453125 0.578126 1.046875 1.203126 1.707031 1.796876 c 0.664063 0.597656 1.394531 1.164062 2.128906 1.601562 s 1.460938 0.78125 2.292969 0.78125 h 6 c 1.644531 0 3 -1.355469 3 -3 v -6 c 0 -1.644531 -1.355469 -3 -3 -3 z m 1 3 c 0.265625 0 0.519531 0.105469 0.707031 0.292969 l 1.292969 1.292969 l 1.292969 -1.292969 c 0.1875 -0.1875 0.441406 -0.292969 0.707031 -0.292969 s 0.519531 0.105469 0.707031 0.292969 c 0.390625 0.390625 0.390625 1.023437 0 1.414062 l -1.292969 1.292969 l 1.292969 1.292969 c 0.390625 0.390625 0.390625 1.023437 0 1.414062 s -1.023437 0.390625 -1.414062 0 l -1.292969 -1.292969 l -1.292969 1.292969 c -0.390625 0.390625 -1.023437 0.390625 -1.414062 0 s -0.390625 -1.023437 0 -1.414062 l 1.292969 -1.292969 l -1.292969 -1.292969 c -0.390625 -0.390625 -0.390625 -1.023437 0 -1.414062 c 0.1875 -0.1875 0.441406 -0.292969 0.707031 -0.292969 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
+	theme.IconNameMoveDown: {
+		Name:     "go-down-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x9c\x91On\xb30\x10\xc5\xf7\x9c\xe2i\xbe\xb5\xcd\xd8\xc6&|\xc2D\xea\xa2'h\x0fP%\x14\x90\xf8\x13\x05\x04\xa8\xa7\xafH\t\xa1jW]\xcd\xe8\xa7y\xe3\xf7\xc6\xe9qnj\x8c\xf9\xb5\xaf\xba֓\x92L\xc8\xdbSw\xae\xda\xc2\xd3\xeb˳8\xd01\v\xd2~,P\xe6UQ\x0e\x9e\x94\xbb̄\xb1ʧ\xa7n\xf6\xc4`(\a\xe5\bSu\x1e\xca\xfb\xc0\xdc\xd4m\xef\xa9\x1c\x86\xcb\xff0\x9c\xa6INFv\xd7\"\xd4\xcc\x1c\xf6cAY\x00\x00\xe9\xe5m(q\xf6\xd4@\xc1\xe2\x04\x86`\xa9\x9duڂ\xa5b\x1b\xb9dAV%\xd6(\xb0ԉN\xbeP\xcc1\x1b\xb5h\xa4Ix\x11\x88\xadS\x92\xb5\x89L\xfc\rE*b\xa7\xc1\xa8a\xef{\xb6f\xc7\xc4\xd6\xfda\xf7\"Q\x87\xd8>ʺ\x8bet\x1bړ5B\x0f\xb1eݢ\x8a\x9fs5\x84\x83\xc3i\xf7\xf2\xc3\xdffk\x8fv\x91\x85\x83X\xb57c\xbb\xfa\xb8\xe9jQ\xfcr\xe7\x0f4`0ὪkO\xfftn\"\xe3(̂t\xf9\xd0,\xf8\x1c\x00\xfb\x1f\xf3\xb3O\x02\x00\x00",
+	},
 
-	theme.IconNameContentCopy: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "edit-copy-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 0 3 c 0 -1.644531 1.355469 -3 3 -3 h 5 c 1.644531 0 3 1.355469 3 3 c 0 0.550781 -0.449219 1 -1 1 s -1 -0.449219 -1 -1 c 0 -0.570312 -0.429688 -1 -1 -1 h -5 c -0.570312 0 -1 0.429688 -1 1 v 5 c 0 0.570312 0.429688 1 1 1 c 0.550781 0 1 0.449219 1 1 s -0.449219 1 -1 1 c -1.644531 0 -3 -1.355469 -3 -3 z m 5 5 c 0 -1.644531 1.355469 -3 3 -3 h 5 c 1.644531 0 3 1.355469 3 3 v 5 c 0 1.644531 -1.355469 3 -3 3 h -5 c -1.644531 0 -3 -1.355469 -3 -3 z m 2 0 v 5 c 0 0.570312 0.429688 1 1 1 h 5 c 0.570312 0 1 -0.429688 1 -1 v -5 c 0 -0.570312 -0.429688 -1 -1 -1 h -5 c -0.570312 0 -1 0.429688 -1 1 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
+	theme.IconNameMoveUp: {
+		Name:     "go-up-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x9c\x91On\xb3@\f\xc5\xf7\x9c\xe2\xc9\xdfz\x06{\x06\x86\xf0\t\x88\xd4EO\xd0\x1e\xa0J( \xf1'\n\bPO_ARBծ\xba\xb2\xf5\x93\xdf\xf8=Or\x9c\x9b\x1ac~\xed\xab\xaeMI4\x13\xf2\xf6ԝ\xab\xb6H\xe9\xf5\xe5Y\x1d\xe8\x98yI?\x16(\xf3\xaa(\x87\x94\xc4]f\xc2X\xe5\xd3S7\xa7\xc4`\x88\x838\xc2T\x9d\x87\xf2k`n\xea\xb6O\xa9\x1c\x86\xcb\x7fߟ\xa6IOVw\xd7\xc27\xcc\xec\xf7cA\x99\a\x00\xc9\xe5m(qN\xa9\x81@\x04'0\x14k\xe3BgB\xb0\x16\x0e\x03\x17/(\x948\xb4\x02\xd6&6\xf1\rE\x1c\xb1\x15\xd4pPn\x91j\x1b\xf3\xa2S['\x9a\x8d\rl\xf4\r\x05\x12\xb03\xe0Uy\x13\xca!\n\x1f徂u\xb0\x8e\xee\xc9}g\x0f\xb5\x99ۼ\xa9\x9fs\xa7\xdd⇽\xcd\xd5\x1e\xed\\\xa9pK\xb9u{\xba5\x7fy~լAw\xf5q\xd4{d\xf5ˡ?Ѐ\xc1\x84\xf7\xaa\xaeS\xfagr\x1bXG~\xe6%˗f\xde\xe7\x00[ȮGQ\x02\x00\x00",
+	},
 
-	theme.IconNameContentCut: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "edit-cut-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 12.066406 1 c -0.085937 -0.003906 -0.171875 0 -0.257812 0.015625 c -0.261719 0.054687 -0.492188 0.207031 -0.636719 0.425781 l -2.398437 3.558594 h 2.414062 l 1.640625 -2.441406 c 0.148437 -0.21875 0.203125 -0.488282 0.152344 -0.75 c -0.050781 -0.261719 -0.203125 -0.492188 -0.421875 -0.640625 c -0.144532 -0.097657 -0.316406 -0.152344 -0.492188 -0.167969 z m -9.066406 1 c -1.648438 0 -3 1.351562 -3 3 s 1.351562 3 3 3 h 3.753906 l -1.585937 2.355469 l -0.652344 0.96875 l -0.0625 0.097656 c -0.003906 0.003906 -0.007813 0.007813 -0.007813 0.011719 c -0.28125 0.457031 -0.445312 0.992187 -0.445312 1.566406 c 0 1.648438 1.351562 3 3 3 s 3 -1.351562 3 -3 c 0 -1.386719 -0.960938 -2.566406 -2.25 -2.902344 l 1.417969 -2.097656 h 5.832031 c 0.550781 0 1 -0.449219 1 -1 s -0.449219 -1 -1 -1 h -9.171875 c 0.109375 -0.3125 0.171875 -0.648438 0.171875 -1 c 0 -1.648438 -1.351562 -3 -3 -3 z m 0 1.5 c 0.050781 0 0.101562 0.003906 0.152344 0.007812 c 0.042968 0.003907 0.082031 0.011719 0.121094 0.019532 c 0.046874 0.007812 0.09375 0.015625 0.136718 0.03125 c 0.503906 0.136718 0.894532 0.527344 1.03125 1.03125 c 0.015625 0.042968 0.023438 0.089844 0.03125 0.136718 c 0.007813 0.039063 0.015625 0.078126 0.019532 0.121094 v 0.003906 c 0.003906 0.046876 0.007812 0.097657 0.007812 0.148438 c 0 0.132812 -0.015625 0.257812 -0.050781 0.382812 v 0.011719 c -0.035157 0.125 -0.085938 0.246094 -0.148438 0.355469 c -0.054687 0.089844 -0.113281 0.171875 -0.179687 0.25 h -0.011719 c -0.285156 0.316406 -0.6875 0.496094 -1.109375 0.5 c -0.136719 0 -0.265625 -0.019531 -0.390625 -0.050781 c -0.519531 -0.136719 -0.921875 -0.539063 -1.058594 -1.0625 c -0.03125 -0.121094 -0.050781 -0.25 -0.050781 -0.386719 s 0.019531 -0.265625 0.050781 -0.386719 c 0.136719 -0.523437 0.539063 -0.925781 1.058594 -1.0625 c 0.125 -0.03125 0.253906 -0.050781 0.390625 -0.050781 z m 4 8 c 0.050781 0 0.101562 0.003906 0.152344 0.007812 c 0.042968 0.003907 0.082031 0.011719 0.121094 0.019532 c 0.046874 0.007812 0.09375 0.015625 0.136718 0.03125 c 0.503906 0.136718 0.894532 0.527344 1.03125 1.03125 c 0.015625 0.042968 0.023438 0.089844 0.03125 0.136718 c 0.007813 0.039063 0.015625 0.078126 0.019532 0.121094 v 0.003906 c 0.003906 0.046876 0.007812 0.097657 0.007812 0.148438 c 0 0.136719 -0.019531 0.265625 -0.050781 0.386719 c -0.136719 0.523437 -0.539063 0.925781 -1.058594 1.0625 c -0.125 0.03125 -0.253906 0.050781 -0.390625 0.050781 s -0.265625 -0.019531 -0.390625 -0.050781 c -0.519531 -0.136719 -0.921875 -0.539063 -1.058594 -1.0625 c -0.03125 -0.121094 -0.050781 -0.25 -0.050781 -0.386719 c 0 -0.019531 0 -0.039062 0.003906 -0.054688 c 0 -0.003906 0.003906 -0.003906 0.003906 -0.003906 c 0.035157 -0.71875 0.570313 -1.308594 1.28125 -1.414062 l 0.011719 -0.015625 c 0.0625 -0.007813 0.128907 -0.011719 0.199219 -0.011719 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
+	theme.IconNameNavigateBack: {
+		Name:     "go-previous-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x9c\x91An\xab0\x10\x86\xf7\x9c\xe2\u05fc50\xb6\xc1\x84'L\xa4.z\x82\xf6\x00\x15\xa1\x80d \n\bPO_\x91\x10'Q\xb3\xa8\xba\xf2\xaf\xcf3\x9a\xcf\xe3l\xbf\xb4\x16Sy\x1a\x9a\xbe3$\x02&\x94]\xd1\x1f\x9a\xae2\xf4\xfe\xf6\xea\xefh\x9f{\xd90U\xa8˦\xaaGCB\x1f\x17\xc2Ԕ\xf3K\xbf\x18b0\x84\x86Є\xb99\x8c\xf5\xb5`im7\x18\xaa\xc7\xf1\xf8?\f\xe7y\x0ef\x15\xf4\xa7*\x94\xcc\x1c\x0eSE\xb9\a\x00\xd9\xf1c\xacq0\xd4BHH\x14`\xf8\x1cH\x1dk\x19\xafIp\x1c\xe9tM\xb1Hc%η\xa9L/,ᄕ@\xb1f\x95\xf2\xd6sM\"`\xa9\"\x95<\xb2HD\xac%\x18\x16\xbe\x86\xbe4\x8b]\x12c;n\x138\x88\xce\xd5\x0fh\x9b9\xc0\xc99\xb7\x9fU\x16\x97\x11N\xc0\x05'wG\x9cZq粩\xdd\x1e\xbd9=\xd9\xc3\xf0\xeb\x8dY\xf8\xb1\xa3.Y<\x81\x7ft\xf9B\v\x06\x13>\x1bk\r\xfd\x93\xa5\x8a\x94\xa60\xf7\xb2\xf5\xffs\xef{\x00Q\xc5\r\x18~\x02\x00\x00",
+	},
 
-	theme.IconNameContentPaste: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "edit-paste-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 7 0 c -0.554688 0 -1 0.445312 -1 1 h -2 c -1.644531 0 -3 1.355469 -3 3 v 9 c 0 1.644531 1.355469 3 3 3 h 8 c 1.644531 0 3 -1.355469 3 -3 v -9 c 0 -1.644531 -1.355469 -3 -3 -3 h -2 c 0 -0.554688 -0.445312 -1 -1 -1 z m -3 3 h 1 v 1 c 0 0.554688 0.445312 1 1 1 h 4 c 0.554688 0 1 -0.445312 1 -1 v -1 h 1 c 0.570312 0 1 0.429688 1 1 v 9 c 0 0.570312 -0.429688 1 -1 1 h -8 c -0.570312 0 -1 -0.429688 -1 -1 v -9 c 0 -0.570312 0.429688 -1 1 -1 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
+	theme.IconNameNavigateNext: {
+		Name:     "go-next-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x9c\x91An\x830\x14D\xf7\x9cb\xe4\xaem\xbem0\xa1\xc2D\xea\xa2'h\x0fP%\x14\x90\fD\x01\x01\xea\xe9+Hj\xa8\xdaE\xd5\xd5\x1f\x8d\xfe0\x8f\xef\xec87\x0ecq\xed뮵L\nb(\xdaSw\xae\xdbҲחg~`\xc7<\xc8\xfa\xb1DU\xd4e5X&\xcdef\x18\xebbz\xeaf\xcb\b\x04i \r\xc3T\x9f\x87\xeakan\\\xdb[V\r\xc3\xe51\f\xa7i\x12\x93\x16ݵ\f\x15\x11\x85\xfdX\xb2<\x00\x80\xec\xf26T8[\xd6 \x82\xc2\t\x04NB\x99ب\x18$$őI\x17+\x96i\xac%H\xa8T\xa57+\xa1\x84\xb4\\2B\xa7\xb4\x04\xb8WR\x90ґN\xbeY\x91\x8c\xc8(\x10\x1c\f\xcc\x1a\x94\x87$\xde\xc6\xfd\xdb$\xa2uu\xef\xdc\xcbzpO\xe5\xa1\xf8\xcf=\a~\xab\xd8\xfa\xbd\xe0\x1enoy\xb85\xb3\x02\xed\xe6\xf6\xd7w4\xfe\xcb%\xfa?^\xcc!\xf6\xa6Wn\xa77\xf5\x7f\x98\x0f4 \x10\xc3{\xed\x9ce\x0f\xaaБ6,̃ly\xff<\xf8\x1c\x00\x90\xf4\xd9\xfd~\x02\x00\x00",
+	},
 
-	theme.IconNameContentRedo: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "edit-redo-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 11 2 c 0.265625 0 0.519531 0.105469 0.707031 0.292969 l 4 4 c 0.390625 0.390625 0.390625 1.023437 0 1.414062 l -4 4 c -0.390625 0.390625 -1.023437 0.390625 -1.414062 0 s -0.390625 -1.023437 0 -1.414062 l 2.292969 -2.292969 h -8.585938 c -1.117188 0 -2 0.882812 -2 2 s 0.882812 2 2 2 c 0.550781 0 1 0.449219 1 1 s -0.449219 1 -1 1 c -2.195312 0 -4 -1.800781 -4 -4 s 1.804688 -4 4 -4 h 8.585938 l -2.292969 -2.292969 c -0.390625 -0.390625 -0.390625 -1.023437 0 -1.414062 c 0.1875 -0.1875 0.441406 -0.292969 0.707031 -0.292969 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
+	theme.IconNameQuestion: {
+		Name:     "dialog-question-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflTK\x8e\xdb0\f\xdd\xe7\x14\x84\xba\x16CR\xff\"\xce\x00]\xf4\x04\xed\x01\x8aL\x1a\a\xc8g0\x0e젧/(\x7f\x92\xe9t#S\x14\x1f\xf9\xf8Hx\xf3r?\x9f\xa0߿w\xc7\xeb\xa51\x8cd`\x7f\xd9]_\x8f\x97Cc~\xfe\xf8n\xb3yٮ6]\x7f\x80v\x7f<\xb4\xb7\xc6p|\xbb\x1b\xe8\x8f\xfb\xe1\xdb\xf5\xde\x18\x02\x02\x8e\xc0\xd1\xc0p|\xbd\xb5s\xc0\xfd|\xbat\x8dio\xb7\xb7\xaf\xeb\xf50\f88\xbc\xbe\x1f\xd6BD\xeb\xae?\x98\xed\n\x00`\xf3\xf6\xeb\xd6\xc2kcΐ\x81\x90\x1cK\x80\x1dXBW\xb2w\x19H\xed\xcc\xd1S\x04B\x8e\xc4!\x82e\xd48B\x1fs\np\x02\x1bѥ\x00\xe3Y\xe1Q\x98\x8a\x87\xc5x\xb8\x18\xa3\xe4\xa2\xe9@P\x14\xfd\x11\xfc\x19\xfb@̞\n\xa4\x05j?a\xff\xc7ੰ\x9d*\xdb\x7f\xf0\xda7\xe5P\xfcG+I\fQ\xc0\xce\rO\xfd/\xf7?p\x06B\x0e\xe2\xbcr+\xa9\x86\xef`A\x12\x92\v\x1c\x120z\xe7B\xa5\xa5_\x97\x81\xb1\xd4\x1c\x849U\x9e\n\xcb!\xf8\x98g\xa3\x00#\xb3\x93\xcc s\x15\xc28\x1a\x0e%\x96\xe0db\uf11c\xd6K\x9a\xb5\x92/9K\x16`t\x85\xa2T\x1dRr\xbe\x96\x8e\x895\xaa\xd7\x02\x8a\xc8Ђ\x15\xe8\xf5؍\xb3\x0f\x81Rf \xf4\xbe\b\x17\xb0\f\xac\x87\xd2\xf4\x14\xeb\x1c\b\x93r`\x8d\x17/\x9c\x95z\x91\xa0@\x9d\x04'u\xed\x1e\x1a)\xd3\xda3%r\xec\xf4\x9e\x89\xa6p\xe1Icʺ\x83ccur\xb2X\x15\x92\xd8\xeb{M6\x8fx\xc2,iv\x8fZ\x1c\xa2\x84'>\x84\x81g\u058bkb\xdd.\n0\xea\xfa8}SՔ\x99̫\xc1\x98\x03\xd7͐Y\x81*\x8bjY4-\xa70\x8a\x97\xa3\xd3!T}\xe2\xb8\xd3N\x1b\x1e]\"1\xa4\xbaEv\x91(a)2\xcb6\x0f\x01\x9e\x06\xc1\xc0Ё}\xba\xdb\xc9\xc5O^[\xdd\xdd\xe7\xf9i5\x022\xf0\xfbx:5\xe6\x8b\xec\x9dwѬ\xb7\xab\x8d\xfe\x1f\xb6\xab\xbf\x03\x00\xfdÞ\xe4\x9e\x04\x00\x00",
+	},
 
-	theme.IconNameContentRemove: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "list-remove-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 1 7 h 14 v 2 h -14 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
+	theme.IconNameRadioButton: {
+		Name:     "radio-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflOM\x8f\x9b0\x10\xbd\xe7W\x8cF\xaa\xd4J]\xe3\x0f\xe2\x186\xceJEꭷ\xf6\xd2[\x9ax\x8d\xb5,F\xd8\vl\x7f}e\xd0\x12\"\xf52~3ｙ\xe7\xe3\xd3\xf4\xda\xc0`\xfa\xe0|\xab\x91\x11\x8a`ڋ\xbf\xba\xd6j\xfc\xf5\xf3\xfb\x83§\xd3\xee\x18\x06\v\xd3k\xd3\x06\x8du\x8c]\x99e\xe38\x92Q\x10\xdfیSJ\xb30X\\$\xe5Ը\xf6\xe5\x7fBV\x14E6\xb3\b\xa3\xbb\xc6Z#\x93݄P\x1bg\xeb\xf8\xd1\rΌ\xdf\xfc\xa4\x91\x02\x05&\x81I\xdcFdx\xda\x1d-\xb8\xab\xc6\xf0\xd6?\x9f/\x86\x8b\x82\xa94\xedα\x86\x10\xdf\x1b\xa3\x11B\xec\xfd\x8b)[ߚ\xc7g\xd74\x0f\xfd[3\xb7\x7fM\xef\xe7I\xd9\xdb?\x9f\x99\"T\x14\x9c\xc9O_9%\t\xed\x0f[\xf8e1\xfb\xee|q\xf1\xbdd\x8f\bW\x8d?@\x01\x85\n\x04\xd9\x17Ⰷ\x14\xf5\x86\x15T):'9\x95|\xbf\x12L\x82J\xa5Z(.\xf2|\xf9\xdfM:K\xaa\xf4\xac\xa6UJ盿!\xdd\xe6IĈ\xe0T0\x0e\x1cX\x0e9\x91\x87B*\x95\xf0\xbc#\xdf(6(\xb1,\x87\xea\xce\xc0\xef\xb6%;\xbf\xf1+\xe0\xf3\xe5\x8f\x04\x98\x9dv\xc7̦\x12\x06{\xda\xfd\x1b\x00\xcd9\x17DM\x02\x00\x00",
+	},
 
-	theme.IconNameContentUndo: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "edit-undo-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 5 2 c -0.265625 0 -0.519531 0.105469 -0.707031 0.292969 l -4 4 c -0.3906252 0.390625 -0.3906252 1.023437 0 1.414062 l 4 4 c 0.390625 0.390625 1.023437 0.390625 1.414062 0 s 0.390625 -1.023437 0 -1.414062 l -2.292969 -2.292969 h 8.585938 c 1.117188 0 2 0.882812 2 2 s -0.882812 2 -2 2 c -0.550781 0 -1 0.449219 -1 1 s 0.449219 1 1 1 c 2.199219 0 4 -1.800781 4 -4 s -1.800781 -4 -4 -4 h -8.585938 l 2.292969 -2.292969 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameDelete: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "edit-delete-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 0 c -4.410156 0 -8 3.589844 -8 8 s 3.589844 8 8 8 s 8 -3.589844 8 -8 s -3.589844 -8 -8 -8 z m 0 2 c 3.332031 0 6 2.667969 6 6 s -2.667969 6 -6 6 s -6 -2.667969 -6 -6 s 2.667969 -6 6 -6 z m -2.03125 2.96875 c -0.265625 0 -0.519531 0.105469 -0.707031 0.292969 c -0.390625 0.390625 -0.390625 1.023437 0 1.414062 l 1.292969 1.292969 l -1.292969 1.292969 c -0.390625 0.390625 -0.390625 1.023437 0 1.414062 s 1.023437 0.390625 1.414062 0 l 1.292969 -1.292969 l 1.292969 1.292969 c 0.390625 0.390625 1.023437 0.390625 1.414062 0 s 0.390625 -1.023437 0 -1.414062 l -1.292969 -1.292969 l 1.292969 -1.292969 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 s -0.519531 0.105469 -0.707031 0.292969 l -1.292969 1.292969 l -1.292969 -1.292969 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameDocument: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "text-x-generic-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 5 1 c -1.644531 0 -3 1.355469 -3 3 v 9 c 0 1.644531 1.355469 3 3 3 h 6 c 1.644531 0 3 -1.355469 3 -3 v -7.5 c 0 -0.265625 -0.105469 -0.519531 -0.292969 -0.707031 l -3.5 -3.5 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 z m 0 2 h 4 v 1.5 c 0 1 0.5 1.5 1.5 1.5 h 1.5 v 7 c 0 0.570312 -0.429688 1 -1 1 h -6 c -0.570312 0 -1 -0.429688 -1 -1 v -9 c 0 -0.570312 0.429688 -1 1 -1 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameDocumentCreate: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "document-new-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 4 0 c -1.644531 0 -3 1.355469 -3 3 v 10 c 0 1.644531 1.355469 3 3 3 h 3 c 0.550781 0 1 -0.449219 1 -1 s -0.449219 -1 -1 -1 h -3 c -0.570312 0 -1 -0.429688 -1 -1 v -10 c 0 -0.570312 0.429688 -1 1 -1 h 5.585938 l 3.414062 3.414062 v 0.585938 c 0 0.550781 0.449219 1 1 1 s 1 -0.449219 1 -1 v -1 c 0 -0.265625 -0.105469 -0.519531 -0.292969 -0.707031 l -4 -4 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 z m 7 8 v 3 h -3 v 2 h 3 v 3 h 2 v -3 h 3 v -2 h -3 v -3 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameDocumentPrint: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "document-print-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 5 1 c -0.550781 0 -1 0.449219 -1 1 v 1 h 8 v -1 c 0 -0.550781 -0.449219 -1 -1 -1 z m -3 3 c -1.109375 0 -2 0.890625 -2 2 v 4 c 0 1.109375 0.890625 2 2 2 v 1 c 0 1.089844 0.910156 2 2 2 h 8 c 1.089844 0 2 -0.910156 2 -2 v -1 c 1.109375 0 2 -0.890625 2 -2 v -4 c 0 -1.109375 -0.890625 -2 -2 -2 z m 2 6 h 8 v 3 h -8 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameDocumentSave: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "document-save-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 0 c -0.550781 0 -1 0.449219 -1 1 v 8.585938 l -1.292969 -1.292969 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 s -0.519531 0.105469 -0.707031 0.292969 c -0.390625 0.390625 -0.390625 1.023437 0 1.414062 l 3 3 c 0.390625 0.390625 1.023437 0.390625 1.414062 0 l 3 -3 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 s -1.023437 -0.390625 -1.414062 0 l -1.292969 1.292969 v -8.585938 c 0 -0.550781 -0.449219 -1 -1 -1 z m -7 14 v 2 h 14 v -2 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameDownload: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "folder-download-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 0 c -0.550781 0 -1 0.449219 -1 1 v 8.585938 l -2.292969 -2.292969 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 s -0.519531 0.105469 -0.707031 0.292969 c -0.390625 0.390625 -0.390625 1.023437 0 1.414062 l 4 4 c 0.390625 0.390625 1.023437 0.390625 1.414062 0 l 4 -4 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 s -1.023437 -0.390625 -1.414062 0 l -2.292969 2.292969 v -8.585938 c 0 -0.550781 -0.449219 -1 -1 -1 z m -6 14 v 2 h 12 v -2 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameError: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "dialog-error-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 1 c -3.871094 0 -7 3.128906 -7 7 s 3.128906 7 7 7 s 7 -3.128906 7 -7 s -3.128906 -7 -7 -7 z m -4 6 h 8 v 2 h -8 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameFile: &fyne.StaticResource{
-		StaticName:    "application-x-generic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"128px\" viewBox=\"0 0 128 128\" width=\"128px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 28 8 h 50 l 30.042969 29.917969 v 74.058593 c 0 4.433594 -3.609375 8.023438 -8.042969 8.023438 h -72 c -4.433594 0 -8 -3.566406 -8 -8 v -96 c 0 -4.433594 3.566406 -8 8 -8 z m 0 0\" fill=\"#f6f5f4\"/>\n    <path d=\"m 78 8 v 22 c 0 4.433594 3.609375 7.921875 8.042969 7.921875 l 22 -0.003906 z m 0 0\" fill=\"#deddda\"/>\n</svg>\n"),
-	},
-
-	theme.IconNameFileApplication: &fyne.StaticResource{
-		StaticName:    "application-x-executable.png",
-		StaticContent: []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00w\x00\x00\x00w\b\x06\x00\x00\x0099ߊ\x00\x00\x00\tpHYs\x00\x00\x0e\xc3\x00\x00\x0e\xc3\x01\xc7o\xa8d\x00\x00\x00\x19tEXtSoftware\x00www.inkscape.org\x9b\xee<\x1a\x00\x00\x10\xa9IDATx\x9cݝ{\x8c\\\xd5}\xc7?\xe7\xbef\xee\xcc\xec\xecs\xd6އ\x97\xdd\xf5\xda\xc6\xc6\x10\b\x04\xc2#D!\x82\x94\xaa\xa9Ԧ\xff\xb4A\xaa\xaa\xb4\xe4\x81ڨI\xd5\xfe\xd1JQ\x84\xa2J\x91\xa8ڤM\x89\x924R\vj*\xaaHQi @\xa3R\xa0\x94\x02q\xd8\xda\x18\xb0\x8d\x1f\xd8\x18\xdb\xeb}\xce\xfb>N\xff\x98\x1d{gwfw\x1e\xf7\u07b9w\xbf\xff\xac\xf6\xce}\x9c;\x9f9\xbfs\xce\xef\xfc\xce\xef\b)%\xdbU\x02\xc4-\xdf8\xf7\x80\x80\xdf\x06q\x13\xc8!\x10s\x02\xf9\v\x81\xf2\xa3W\xfe|\xe41\t\xdb\xf6\v\x10\xdb\x15\xee]ߜ\xeb)[\xa5\x97\x80\xeb79\xed\xb0b\x17\xee\xfc\x9f\xaf\xcd,\aU\xae \xb5-\xe1\xde\xf6\x97\xe7\x06\xa5+ު\xd4\xd4\xcd%$\xf3\x8e\xa9\xec{\xed\xab#sA\x94-Hm;\xb8\x15\xb0\xbc\x03\f4{\x8d\x90bYs\xd4=/}m\xc7E\x1f\x8b\x16\xb8\x94n\x17\xc0K\xb5\x03\x16@\n\x99\xb6U\xe7\u061d_\xbf0\xecSѺ\xa2m\x03\xb7]\xb0UmG\xc0\xdb\x02n\xa7`\xab\xdan\x80#\x0f\xd7+\xb0Um'\xc0\x91\x86\xeb5ت\xb6\v\xe0\xc8\u009d\xfc\xe2\x7f\xdd#]\xe99ت\xb6\x03\xe0H\u009d|\xf0\xb9\xcf\xd8\xc5\xdcs+\v\x17}\x01[U\xd4\x01G\x0e\xee\xe4\x83\xcf}ƶ\xac'\xa4t\xc5\xf2\xe5\x0f\xb0\xcbE_\x9f\x17e\xc0\x91\x82\xbb\x16,@<у\xaa\xc7|\x7fnT\x01G\x06\xeez\xb0F<\xc1\xc0\xc8$B\x88@\x9e\x1fE\xc0\x91\x80\xbb\x1e\xac\xaa\x1b\f\x8cN#D\xb0ŏ\x1a\xe0\xd0\xc3]\x0fVQ5\x86\xc6v\xa3\xaaZW\xca\x13%\xc0\xa1\x86\xbb\x1e\xac\x10\n\x83\xa3\xd3h\x01\xb4\xb3\x9b)*\x80C\vw=X\x80\xde\xcc\x18F<\xd1\xcdb]Q\x14\x00\x87\x12n=\xb0\x00\xc5\xdcb\xb7\x8aTWa\a\x1c:\xb8\x8d\xc0\x02\x14s+\x94\xf2+\xdd(VC\x85\x19p\xa8\xe0n\x06\xb6\xaa\xa5\xcb\xe7\x83,RS\n+\xe0\xd0\xc0m\x06,\x80U\xccS*\xe4\x82*V\xd3\n#\xe0P\x84\xd94\v\xb6\xaax\xb2\x97\xc1\xd1)\x00\\\xc7\xc6u\x1d\xa4\xe3\xe0\xba6\x8e\xed\xa0\xa8\n\xf1d\xaf\xafen\xa40\x85\xect\x1dn\xab`+\x12(\xaa\x8a\xeb\xdau\x03S\x15Ue\xe7\xd4\xc1\xc0\xbcW\xeb\x15\x16\xc0]5\xcb\xed\x81\x05\x90\xb8N}\xb0\x00\xae\xe3P.v\xcft\x87\xc5Dw\rn\xfb`\x9bS1\xdb\xddaS\x18\x00w\x05\xae\xdf`\xa12l궺\r8p\xb8A\x80\x05\xb0\xed\x12\x8ec\xfb\xf9\x88\xa6\xd4M\xc0\x81\xc2\r\n,\x00\x12\xcaŬ\xef\x8fiF\xdd\x02\x1c\x18\xdc@\xc1\xae\xca.\x97\x83z\x14\xaa\"ؑV\xb9a\xdc@\xad\xd3K\xef\x06\xe0@\x86BA\x835\xcc$=\xfd\xc3\xc4\x13\xbd\xe0\xe3\x13\a\x92*\x13\x83*\xbb\x064\x86R\n\xca*ԗ\x8e\x17y\xeb\xbcU\xf7\x9a \x87I\xbe\xc3\r\x12\xec\x15\xa8>;0\x06\x92*\x9f<\x10'\x1d\xafo\xf8\xb2%\xc9\x13\xaf\xe6p\x1b|\xb7A\x01\xf6\xd5,\a\x056\x96\xe8!\xb3k/\x99\xf1=\x81x\xa6T\x85\x86`\x01R1\xc1\xee\xe1\xc6\xc1\x04U\x13\xfd\xc7O\xe4n\xf4\xa3|U\xf9\x067(\xb0\xf1T/Cc\xbb\x03\x9d\xe7-Y[[\xbb\x0f\xed26m\x11\xa4\x90\xe9\xa4^z\xf5\x9bO-|ֻ\x92\xd5\xca\x17\xb8A\x9abǮ߶\xf9\xa9\xa2\xbd5\xdc^Sa*Ӹ\xf6\xc64\xc1h\x9f\xaa\xa5L\xe51\xbf\x00{\x0e7\xe8Γc\a\xd7#\xae\xcardS\xb9\x16n\x9c\xa8_{\x85\x80\xa9\x8c\x86\xaa\bTE\xe0\x17`O;T\xdd\x18\xee `l\xa6\xf3\xa6+\x11S(Y\x12\xc7m\xee\xfb\xf8\xecGS\xc4\xf5\xad_\xf3̼\x8d\xa6\b\x921\x81\xa9\vtUPo>\xc3q%ق\xfb\xc0\x9f\xde\xdf\xffx\xabeo$\xcfjnW\xc0Be\xf2\xa0\xc3\x1f\xe8\xe4\x90\xc6oޔ\xe0\xfe\xebMbZs\xc5o\xa6\xdd\x05\x98\x18\xd0\x18\xedS\xe95\x15\f\xad>X\xc0\x97\x1a\xec\tܮ\x81]\x95+ݶ\xae\xd3T\xb8kO\x9cO\xee7\x89\xe9\x15'įݘ \x15\xdb\xfa5J\x8e\xf7CH\xaf\x01wl\x96\xbb\r\x16 \u0557\xc1L\xf7c\x18\x89\xa6\x9d\x16\tC\xe1\xbe\x03&\x83=\x1b\x7f\xdf\xf9\xb2\xcb3\x87\v\\\xcem\xfc\xd1\xf4\x9a\n\a\xc7\f\xf6쨴\x99~\xc8+\x13\xdd\x11\xdc0\x80]+U30Si\xccT\x1fF<\xd5\x10\xf4`O\x05l\xc2hl\xb8,\x1b\xfe\xe3h\x9es\x8b\x0e\x00}\t\x85[&\r&\x06\xf4\x86\xa6\xd5Ky\x01\xb8m\xb8a\x03\xbb^\xaaf`\xf6\xf4\xd1ӗA\xd1\xf4\x9a\xcf~\xfd\xc6\x04\x99\x1eu\xcb{8\xae\xe4\x95wK\f\xa6T\xf6\xee\b\x06\xea\xfa\xe7w\x02\xb8-\xb8a\a\xbbV\x99\xf1\x19\f3UsljH\xe3\x9e\xfdf\x97JԚ:\x01\xdcr\x87*J`\x01\x84\xaao8vj\xcef\xa1N{\x1aFu\xd2\xc9j\tn\xd4\xc0\x02\xa8\xca\xc6W\x94\xc0\xa1\xf7J\xc1\x17\xa6M\xb5\v\xb8i\xb8Q\x04\v\x02E\xab\xef\x02<5g3\x9fs\x02.O\xfbj\apSp\xa3\t\x16T]\xa7Q\x97YJ\xf8\xe5{\xc1\xbb.;Q\xab\x80\xb7\xecPE\x15,\xd4\x06\xafד\x00~\xe3\xc3I\xfa\x93\xfeL\x8e\x15,\xc9|\xcee\xb9ಘw)\x94],g\xd57-AW\xc1\xd0\x04\x86&\xe85\x15\xfaL\x85\xfe\x94\xba\xa5\x13\xa5\xd9N֦+\x98\xa3\f\x16@7\xe2\x9b~n\xe8\x02/\xd3-K\t\xe7\x97\x1c\xce\xccۼ\xbfh\xb3\x98sۺ{*&\x18\xe9\xd3\x18\xef\u05f8fP\xdd\xe0,YS\x83\xd9\fpÚ\x1b\x05\xb0z,N<ً\x10*\x8a\xaa\xa2(\n\x8a\xaa\"\x84\x82\xa2j\xa8\x9a\x81\xa8ӡ\x82J\xad\xf9\x95\x83\t\x86\xd3[\x8fw\xb7R\xb6$y\xeb|\x99\xe3\x17-r%oݒ\xba\x06S\x83:\u05ce\xe8\x1b\xc6\xe6[\xd5\xe0\xbap\xa3\x00\x16\xa0ox\x9cdomJ\xe5t\\\xe1\x86]\x06'.Y|\xb0\xe8ԭ9B\xc0\xa7\xaeK0\xd6\xdf\x19إ\x82\xcb\x1b\xef\x959q\xd1n\x18R\xe3\xa5\xc6\xfaT>4a0\xd2{\xd5\xe0n\x06x\x03ܨ\x80\x05\xd89u\x00U3j\x8e\xddw\x9dɮ\x81\xca˗l\xc9\xc99\x9b\x13\x17,.,_\x05}\xdbt\x8c\x83c\x06\xed\xcar\xe1\xffΖ\x99}\xaf\xdc\xf4\x14\xa1\x97\x9a\x18и}&~\xa5mn\x04\xb8\x06n\x94\xc0\xea\x86\xc9\xf05\xfbj\x8eMg4>qm}\xcf\xd3J\xd1\xe5\xddK6%Kr\xebt\xfb95\xce\xccۼt\xbcD\xbe\xd4]'\x88\xa6\u0087'b\x1c\x1c\xaf\x04\x04\xd4\x03|\x05n\x94\xc0\x02\xf4\x0e\x8d\x92\xea\xbf\x1a\x02\xac+\xf0[\x1fIn:\x19Љ\x1cW\xf2\xda\xe92GΖC\xb5\xe3\xc5x\xbf\xc6\xdd\xfb☺\xd8\x00X\x01\x98|\xe8\x99;\xa2\x04V\bA\"\xdd_sl\xff\xa8\xe1\x1bؒ-\xf9\xe9l\x81\xc3!\x03\vpv\xc1\xe6'\x87r\xccg]TE\xd0c\xaa\xff\xf4\xf0Ss\x1f\aP\x0e~\xfdM\xc3-\x8b\x9fG\x05,\x80\xa2\xea5\t\xc6t\x15\x0e\x8e\xb7߆n\xa6\\\xc9姳\x05.\xae\x84כ\x95+I\x9e\x9c\xcdqn\xd1AQ\x10\tU\xff\xd9\xe7_GW\x8a\x97\xe6\xbf\xefX\xe5\xee&vjQ\x8e]\xe6\xe2{Ǯ\x04\xc7\xed\xdda`6\x11\xcfԪ\xb2%ɿ\xbd\x91\x8f\x84\x9b\xd2r\xe0\xd9#\x05\xce-:\x98\xba\x8c\xa9G\xce\x7fOM\xdf\xf8;?t\x1dg\xf3\xd1~\b\xe5:6\xf9\x95E\xe2\x89\x14\x8bE\x95\x1eSa\xc0COSђ<5\x9bg\xa5\x186C\xdcXR©\xcb\x16\xc2\xc9\xe3\xda\xf65\x8ac\xd9\xe9\xd05$M\xcau,.\x9d=A.\xbb\xc4\xf3o\x17\x98=덯\xd8q%\xcf\x1c)\xb0X\x88ƴ\xe0Z\x95\x8ae^<2\x8fe9iŕNŞI\"\xb9\xf1\x99t\x1d\xe6ϟ$\xbb8ǫ'K\xbct\xbc\xd8i0$\xaf\xbc[\xe2R\x88\xdb\xd8Frl\x8b\xfc\xca\x02\x96#9z\xbe\x8c\xa2\b\xb5\xf6\xab\x88\"`)Y\xbcx\x96Bv\x89\xb7\xce[<s\xa4\x80\xd5&\x9b\x93s6G\x1b\xac\xd0\v\xb3\\Ǣ\xb0\xb2@uh\x9b-9ˊ\xa2\xe9K\x1bΌ `\xa1(\xc4\x12\x95p\x9a\xb3\v6O\xce\xe6\xb0Z\\X_\xb2$\xff}\xdc\xdf\xcc\xeb~ȱ-\xf2K\v\xb8\xee\x9afD\xa8o*Z,\xfe\xefu\xaf\x88\x18\xe0\x9e\xfe\x1d(\xcaU_q\xb1\f\r\xe6\xe9\x1b\xea\xb5S%\x8aM\x06\x9b\x87E\x8emQX^\xa8\x89\xdd\x16\x80\xa2\xa9\x8f+\xefZ\xf1\xdfS4\xb5\xfeo<\"頻\x1aɾ\xda\t\x84\x99a\xad\xa5u\xd7sY\x97\xb7/D\xcb\x1c\xd7\x03\v\xa0hƙه\xf7=\xaa\xc8\xef\xdeli\xc2\xfd4B\xd4G\xd9e\xc0\xc9\xf4હm\x8c*=4ZSk\x01\xf6\xec\xd8\x18\x18\xb7\x99~y\xa6\xdcqG,H5\x02+\x84(\xc8\xfc\xca\xdd\x12\xa4\x02p\xf2\xfb\xbf\xfa\xb4\xaak\x0f\t\xa1\x84\np\"\xddOߎ]\f\x8dͰs\xea@%߲\x99\xac\xe1\x1c3S$zjw\xa0\xe9\x89+\xf4%\x9a\x1f\xf3.\xe5]\xce\xccG\xa7\xd66\x04+E\xa9\\.\xdc\xfd\xe6\xdf\xdeq\x1a\xd6\xc4P\x9d\xf9\xde}\x7f\xaf\xa8ڗ\xc2R\x83\x8dX\x82\xbe\xe1\x89+\xff\xab\x9aN\xaa/Cf|\x0f;'\xf6\x93\x1e܉n\x98\xf4\x0e\x8fo\xa8\xd4\x13\x83\xad5\xb6\x87\xcfE\xa7\xd6V{\xc5u\xc1\xca\xe2\xc7N|\xfb\xce\u05ee\x1e[\xf7V\x13\x9f{\xf6\v\x8ec}\a)\xeb\xdb\xc1\x00<Ъ\x11#3\xbeg\xcb}\fTE`\xac\xc6!\xe9\xaa \xa6UBg\x0e\x8e\x1aMGX8\xae\xe4\x9f_\xc9QjbAu\xb7\xe5:\x16\xf9\xe5u\xbdbր\xfd\xeb;^\xad=^\xe7'\xdbM\xc0\xaaf\x90\x19\x9fA\xd5\x1bO\x04\xdc6\x1d\xe3\xc0\xa8~%{L':9g\xf3\U000e314e\xef\xe3\xb7Z\x05\v\rB[\xcf\xfc\xe0\xdeGUU\uf289\x8e\x99\x89M\xc1\x02\f\xaeI\vԩNυ\xbf\xadm\a,l\x12\xb7\xdc-\xc0\x85\xecҖ[\xb8\xf5%:\x0fj\x83\xca+\xbc\xbf\x14n\xffq\xbb`a\x8b\xa0\xf4n\x00\x96R\xb22\x7f\xa1\xe1\xe7\xa6.<\x9b\xde[^\x8d%\x0e\xab:\x01\vM\xac8\xe8\x06\xe0Bv\xb1a\xedM\x18\xde5\xf8\x97\xb2\xe1\x9d\x1c\xe8\x14,4\xb9\x9c$h\xc0\x95\xda\xfbA\xdd\xcf\xe2\x1e\x86\xd2,\xe6\xc3Yk\xbd\x00\v-,\x04\v\x1ap~e\t\xab\xb4\xb1\xf6z\x19q\xb1\\\f\x1f\\\xaf\xc0B\x8bK8;\x05\x1c3S\x98=}M>M\xb2\xb2\xb0\xb1\xed])\xba\\\\\xae\x1flުr!\x8b\xb2\xf0\x12l\xe5\xba6\\3\xad\x8e\x83u#Nzh\xa4\x92\x97Q\xc2\xf2\xfc\xf9M;M\x00\x8a\xa2ҿ\xf3\x1a\xe2\xc9t\xddϓ1\xc1\xe4\x90\xceԐ\xc6pZmk\xe8\xfd\xe3\xd7\xf3,\xe4\xc3\xd1\xeez\r\xb6rm\xbb91>\xf7\xb3\x87l\xc7\xfdvèI\x0113I\xaa\x7fx\x15P\xedi\xb9\xa59\x96.\x9d\xa3\xde\xf3U\xcd`hl\x1am\x8b\x85\\U\xa5b\x82Ɍ\xce\xf4\x90\xd6T\xae\x8b\xaa\xfe\xe5\x7f\xb3d=^\xdbӎ\x1a\x82E\x94\xec\xfc\xca'\x8e=\xfa\xf1\x97۹o۽\x93S?\xf8\xd4\xdfi\xaa\xf2\x87\x8d&\x1bbf\x0fCW\xb2\xa8n\xe4\x9f\xec\x1db`\xe7䆅Z\xb1D\x0f\x99\x89=M\x83\x85J\x94\xe2\xe1\xb3e\x9e\x7f\xa7\xb5\x89v\xb7\x81\xe1\tR~\x81\x85\x0e\x93\x8cm\x06ر\xcb[&\u074c\xa7z\x19\x1aݍ\xa2j \x04\xe9\xa1\x11\x86ƦQ\xeb\xe4\xb1hFN\x8b\x89\xbfto|!m\xcbO\xb0\xe0A\x06\xb9F\x80]ǡ\xb0\xbc\xb0%`\xc3L\x92ٵ\x87\xcc\xf8^z\xfawЉ\xe3\xbaո\xa9V#5\xbc\x94\xdf`\xc1\xa3\xf4\x80\xf5\x00K\xe9\xe2J\xb7)\xc0\x9a\x1eÈw\x9e:\xc8rZK\x03\x19W\xbbc\x96\x83\x00\v\x1e&\xf6\\\x0fX\xae\xce76\v\xd8\v\xb9R\x92o\xc1\x9d\x986\x83\xdfV)(\xb0\xe0q\xbe\xe5\x1a\xc0k\xb2\xa9\x06\t\xb8\x95\x95\xedA\xc3\r\x12,\xf8\x90L{-`\xb9&Z\xc0o\xc0qCp\xcbd\x8c\xfeT\xf3\xbd\xa4\xfedp=\xaa\xa0\xc1\x82\x8f\xbb\x93L\xfe\xfe\xd3_\x8a%\xfa\x1f\x11\x9aV3\xa6Q\x84\x82\x99\xeeG\xd5\xda\xeb\x11\xaf\x97\xa9\v\x0e\x8e\x1b\x1c\x181\xd0Zde\xb9\xf0\xf8\xcbY\xdfW\xc7w\x03,\xf8\xbc\xf5\xcc̗_\xbcYS\xcd\x17\x80\x9aޒ\x17\x80S\xb1\n\xd4kw\xea\x1d\xa5\xc6}\xf2\x8d<\x17\x96\xfd\xf3Ru\v,\x04\xb0\xaf\x90\x1f\x80\x0f\x8e\x19\xdc:\x15\xf3$\x8b\xea/Δ9tڟT\x81\xdd\x04\v\x01l\xf7v\xfco\xeez\xddv\n\x1f\x03j\x02\x95:j\x83\x05\x9e\xa5ǝɴ\x16\xbcެ\xba\r\x16\x02\xda\xcb\xcfk\xc0\x17\x96\xbc3\xa3iSi\xc9\x1f\u074c\xc2\x00\x16\x02ܨ\xd1K\xc0\x97\xb3.\xb6\x87\xcd\xe4\xbe\x11o:w\x10\x1e\xb0\x10\xf0\x16\xab^\x01v\xa5\xe4\x83e\xef\xf6\xc6ݝњڴb+\x85\t,tasd\xaf\x00\x9f\xbe\xec\x1d\\U\x11\\?\xdeYZ\x90\xb0\x81\x85.mk\xee\x05\xe0\xd3s\xb6\xa7K@\xf6\xed\xd4\xe8ma}\xd1Z\x85\x11,\x04\xb4\x7fn#u:L\xbay2\x86\xa6T\xf2D\x95,y\xe5oq\xf5\xef\xfd7$6\xdd-s\xbd\xce-8<}8\xdf\xd2;\x84\x15,t\x19.\xf8\xeb\xe880\xaas\xfb\xee\xd6\x12\xf5\xfc\xe7[\x05N\\j\xce\xe4\x87\x19,t\xc9,\xaf\x95/\xe3\xe0U\xbds\xc1jy\x81\xd7\xed3qz\x9a\xa8\xeda\a\v!\x80\v\xfe\x01\xb6\x1d\x98m1\xd5}L\x13ܳ?\xbe\xa9K3\n`!$p\xc1?\xc0G\u07b7Z\x0e\x82\x1bJ\xa9ܽ7^\xd7\v\x16\x15\xb0\x10\"\xb8\xe0\x0f`Ǖ\x1c}\xbf\xf5\xe4c\xd3\x19\x8d[\xa7j\x87GQ\x02\v!\x83\v\xde\x03NǕ\xb6\x93~\x1e\x1c3\xf8\xc8T\fA\xf4\xc0B\b\xe1\x82w\x80\xe3\xba\xe0\xbe\xeb̎\x96\xa0\xdc0np\xeb\x94Jay1R`!\xa4p\xa1s\xc0\xba\x02\xf7\x1e0\xdbvLT\x95+\x96YY\x98gjHA_s\xab\xb0\x83\x85\x10\x8cs\xb7R;\xe3`!\xe0\xde\xfd&\xbbZL|\xb2^\xb9b\x99\xd9c\x17)\xaf\xc6̖\x1dɩ9\x87\\I\x86\x1e,D\x00.\xb4\x0e\xf8\x8e\x998\xfb;\x9c\xe9Y\x0f\xb6*!\x04\x8e#~\xf7G_\xb9\xee\x1f;z@\x00\n\xadY^\xabVL\xf4H\xaf\xea+ؔi\xfcI\x14\xc0BD\xe0B\xf3\x80\xcf/9\x1d\xcd\x18m\x066\x16\x8b}\xf9\xbb\x0f\xee}\xa4\xed\x9b\a\xac\xc8\xc0\x85\xe6\x01\xbfx\xacH\xae\x89\xada\xa4\xac]R\xbcU\x8d\xfd\x87/\xec\xf9V\xc7/\x11\xa0\"\x05\x17\x9a\x03\\\xb4$Ͽ\xdd8\xa9\xb6\x94p\xec\x82ſ\xbe\x96\xe3\x85w*\xe7m\x056J5\xb6\xaaHt\xa8꩙N\xd6\xfe\x11\x9d;f\xae\xce\nI\t'.Y\x1c:]\xaeI\x990\x92\x96\xf4\xb2\x8c\xedl\x04ۓ0\xbe\xfa\xe8\x1f\xec\xfd+\x7f\xdf\xc6\x1fE\x16.4\x06,\x84\xc0L\xf5\xa2\x19qn\xdf]\xe99\x9f\xbal\xf3\xfa\xe9\x12K뒜\xd8\xe5\x12\x85\xec\"\xa6\x0e\xd3\x19\x1dC\xbdz\x8f\xa8\xd6ت\"\r\x176\x01\x8cD3Lb\xc9$}\x89؆\xe4&\x8ecc\x15\xb2X\xa5\x02r5\xb8UW\x05\xd3\x19\x8dTL\x89t\x8d\xad*\xf2p\xa11\xe0\xaa\x14UCU\x15\x84\xa2\"]\a\xc7qp\x9d\xfa\xe1\x93\xe3}\xba=3\x12\xff\xb3\xa8\x83\x85m\x02\x17\xb6\x06܌\x04\xa2\xf4\xd1}\xe9\xaf\xfc\xf0\xf33\xdf\xf1\xb0h]Ӷ\x81\v\x9d\x01\x16\x88Ҡ\xe9~\xfa\xc5o\xdc\xf2\xac\x0fE늶\x15\\h\x0fp\x14&\x01\xdaѶ\x83\v0\xf9G/\xdfd\xea\xb1\x17\xa4\x94ɭ\xceU\x10\xf9\xbcp\xef:\xf5\xc8-\x87\x82([\x90\x8a\x9c\x13\xa3\x19\x9d\xfa\xd6\xed\x874\xb2\xfbUM?\xdd\xe8\x1c\x01h\x9a~F\x15\xd9k\xb7#Xئ5\xb7*\x01↿x狮c?\x80\x94\xd7J){\x84\x10+\b\x8e*Z\xec\xb1هg\x1e\x95]\xdf\x7f\xc5?\xfd?7u\xd2W_^\xf1;\x00\x00\x00\x00IEND\xaeB`\x82"),
-	},
-
-	theme.IconNameFileAudio: &fyne.StaticResource{
-		StaticName:    "audio-x-generic.png",
-		StaticContent: []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00t\x00\x00\x00l\b\x06\x00\x00\x00\xbb\x1f\x96\xd1\x00\x00\x00\tpHYs\x00\x00\x0e\xc3\x00\x00\x0e\xc3\x01\xc7o\xa8d\x00\x00\x00\x19tEXtSoftware\x00www.inkscape.org\x9b\xee<\x1a\x00\x00\x19\xdaIDATx\x9c\xed\x9d\x7f\xb4\x1dWu\xdf?\xfb\x9c\xb9\xf7==Y\xb6\x8c\x89M!\r\xe0Ę\x1fF\xd8\xd6\x0fS\xd2Յ\xca*]-mI\x17e\x11ۈ\xd0\xfc\x80\xac\x14hh\x01\xff\x92\xad\xaa\xc6!ul\xa7\x85`H\xb2ZV\xa9\x1d\x88\xa8\x9b\x06\xb2\xb2\xa0]ش\v\x82\xf1\x8fز\x84-\xc9\x04\xd9\xc6ƿ-Y\x96\xf4\u07bd3g\xef\xfeq\xce̝{？\xf7Y\xef\xf9\xf5}\xd7\x1a\xe9\u07b93g\xf6\xd9߽\xf7\xd9g\x9f\x99ybf\xac\x14lٻ\xfdo\x9b\xdaV\x8c-*v\xb6 g\x02\xa7\x02\xeb\x80l\x89\xc5+\x91\x03G\x81g\x80\x1f\x03\xfb\xcc\xe4\xfb\x85\xb4n۽a\xc7S\xa36./uBϿ\xe7\x92W\xfa̿\xdfL\xb6\x01\xe7,\xb5<#\xc0\x80\x1f\x80\xfc\xb7\xae\x84\xaf\xdc\xf7\xe6\xcf\x1cZH#/YB7\xde\x7f\xd9/H\xe1/\x05\xdb\x06\xb4\x97Z\x9eE\xc6Q\x81/x\xc7\xf5\xb7\x9fs͓\xf39\xf1%G覻wNH\xab\xfb)\x83K\x81\xb1\xa5\x96\xe7E\xc61\x84\xab\xd7=۾\xfe\xb6\xb7\xef(\xe6r\xc2K\x8a\xd0-\xf7^\xb6Y\x9d\xfb*p\xe6R\xcbr\x82\xf1\x83\xa0\xfa\xcb\xf7\x9c\xfb\x99\x87f;н\xf8\xb2,\x0e6\xef\xbe\xfc\xc3\xea\xdcw\xf9\xff\x8fL\x80\v\xbcs\x7f\xbdq\xf7\x15\xffp\xb6\x03_\x12\x84n\xdas\xc5%&\xf2EV\xdeX9\x1f\x9c*\xc2_l\xde}ů\xcetв\x0f\xb9\x9bvo\xbf\x1a\xb1\xedK-\xc72\x82\n\\|\xe7\x86k\xbe\xda\xf4\xe3\xb2&t\xf3\x9e\xed\x1f1\xb3\xcf-\xb5\x1c\xcb\x10]\xc3\xfd\xa3\xbb7\\}\xeb\xe0\x0f˖Ѝ\xf7m\x7f\xab`\xff\x17h-\xb5,\xcb\x14O\x17&\xe7\xdd\xfb\x96O?V߹,\xc7\xd0\r{.;U\xb0\xaf\xb1J\xe6L\xf8\x99\xcc\xe9M\x82H}\xe7\xb2$\xb4m\xeew\x80\x9f]j9\x96=L\xde~\xfe\xee\xcb>Xߵ\xecB\xee\xc6=\x97m\x14sw\xb0L\x8dm\x19\xe2\xe9l\xb2\xfd\v\xb7_\xb0\xe3\b,C\xa5\x89\xb9+Y\x86r-c\xfcL1\xde\xf9\xad\xf2˲\xf2\xd0-\xbb\xafz\x93J\xd8\x03Ȭ\a\xaf\xa2\x06{j\xdd\xc9c\xaf\xbe\xed5;\xa6\x96\x95'\x98\x14\xbf\xce*\x99\v\x80\x9c~\xf4H\xfe.X>k\x84l\xfd\xce\xce\xcc^&\x17.f\x9b\xa6`\x1d\b]\xc1\xba\x8av\x02V(V\x04,h܊\x00'\"J9p-\x87_\x93\x91\x9d\xd2\";\xa5\xbd\xa8\xa6kq\xd5\xe9\x96e\x13r\xb7\xec\xdd\xfe6U\xfb\xdeb\xb5\x97\x1f\xeaR\x1cVܚqp\x02\xa1F\xe4R\x10:\x007\xe6\x18\xfb[\x13d\xa7,\xda\xcc\xec\xf8T\xd6>uل\\Sۺ8\r\x19S\x7f\xf3<\x9d\x87\x8ea\xc5\xf20\xd6&hG\x99|\xe8(\x9d\xc7'\x17\xabɉv\xc8ߺ|\b\x15\xfe\xceb\xb4s\xec\xfeg\xe9\xfc\xf4\xd8b4uB\xd0}j\x8a\ue4cbC\xaaS}۲\x19C1^?j\x13\x9d\x87\x0f\xd3y\xf8\b~\xddx\xb9k\x12\xb8\xc5D\xfe\\\x9d\xdf=ac\x8f\xdd\xf5\xcfv\x1c\x1f\xf5:\v\xc1Y7\x7f\xecd\xd1\xf0*\xc4ފ\xf2K\"\xfc\x13\xd2\xf4\xac\xf3\xc4\x14~m\v\x7f\xd2ht\x98\xba\x7f\xb0,\xc6\xd0Mw\x7f\xb8E\xeb哀_h\x1b\x16\x8cC\x7fy\x00|\v\xbfn\x1c\x7fҚ\x9bZ\xeb\xd7\\\xbe\xe7\xdd\xd7\xffd\x11E]4\xbc\xe1揾YC\xf1\xfb\x88\xbc\x03\xc0\xafɘ8k\xddH\x89\x92\x1aO.\x8b\x90\xebמv2#\x90\t0\xf5\xe3\xe7\b\x93\x05@.\"\xbf\xb9\xefWnܶ\\\xc9\x04x\xe0\xe2\xcf\xed9p\xf0\x15\xef4\xecZ\x800\x15\b\xc7\xc3Hm\nrʲ \xb4;e\xebFm\xa3\xf3\xc8a\x00L\xe4\xa3\a~\xeb\xbf\xfc\xe1\xc8B\x9d\x00؎\x1dz`\xdb\x17.1\xf8\x8f\x00\xe1\x859\xdd64S\x8b\xedeAh沑o\xf6*\x9e9\x8e\xc0\x97\x0e^\xf2'/\t2\xebxp\xfc\x99O\x00\x7f\xa5\x9d\xd1<\x14\x13\xb7,\b\x1d\x19\x06a2\x9f,Z\xfe\xaa\xa5\x16e!\xb0\xf7\xee\n8\xfd\xb8\x16:rB\xb3\"\b5U\bv\xf3\xa3;nyt\xa9eY(\xf6_\xf4\x85;0\xfez\xd4vV\x04\xa1\x00\xe6\xf8\x9fK-èp-\xff\xbfGnc1\x04Y\x0ehu\xfd\xddK-èp\x9e=#\xb7\xb1\x18\x82,\a<\xf4\xf4ڧ\x97Z\x86Q!kZ\xf3z\xec\xa1\t+\x83P\x03۵k\xc4\x14q\x19@\x18\xb9f\xb92\b]E\x85UBW\x18V\t]aX\x11\x84J\xe6\x16mQq)1\xfe\xb2\xf6HI\x918{dE\x10\xba\x8a\x1eV\t]aX%t\x85a\x95\xd0\x15\x86UBW\x18V\t]aX%t\x85a\x95\xd0\x15\x86UBW\x18V\t]aX%t\x85a\x95\xd0\x15\x86UBW\x18V\t]aX%t\x85a\x95\xd0\x15\x86UBW\x18V\t]aX%t\x85aў\xe0\xbe`\xff\x95\xafUd\xab\xe16\x8a\xe9\xeb0y\xad\b/38\t01;\xa6\xf0\x8c\xc0A\x8c\x03\bw\xfa\xdc\xdfz\xfb\x86\x1d/\xd9\xe7Q\x9ap\xde\x03W\x9e\xe5\n\xdb*\x9e\xf3\xc48\x1b\x91\u05c8\xb1\xde\xe2_\xa6\b\xc01\x13\x9e\x96\xc0A\xb0}\x86\xddI\xc6mw\xbd\xe1\x9a\xc7\x17\xe3\xfa#=\xc1\xbd\xe5\xc1\x9dgZ\xd0m\x06\x17\x8bp\u058c\a\xd7/S^\xd3\xc0\x8c\xfb\x05\xfb\x96\x05\xfd\xf8\x82\x05\x81ɻ6\\31\xc2\xf9#aӞ\xcb_/\xe2\xb6!r1\xf0j\xa0\xf6$\xf6\xe0#\xd96\xf41\xaa\xc3\xee\x15\xf8K3\xbb\x9c\x85S\xf2Ȃ<\xf4\x82\x03W\xbdEM\xfe-p\x11\"\xbe\xfe>Ⱥ\xf8\xc3r\xd9\xc0Q\x86\xc0\x1b\x817\x8aw\xf1)\xb2\xa5\x7fC\xc0\x9c\xb1\xf9\x87\x97\xff\xa2\x99\xbfT\x9c\x7f\x17 \xb1[2\xcbc\xf5\xc3\x04\xc7=r\xae\x19\xe7\"\x8c\xf4\x9a\x9dy\x11\xba\xe5\xfe\x9d\xaf0\xafׂ\xbc\x1fD*\xd9{\xff\xf4\xa1\x7f\x8fU\xa2Gׄ\xf8fP\x03\x13\x10C\x9c\xc3\xcc`\xf4\xc7$_T\x9c\xbb{\xfb\xab\xbc\xe73N\xfc6\x11\xa8\xdepZvO\xe6\xfa\xa2\x04c\xc0\xb8\x01\xc1\\\xed\xe7y\x92;gB\xb7\xec\xdfq\xb1y>/\xc8)N\x04' \xceŋ:\"\x11\b\x06\xa8Yr\xb4\xba0ɀ\a\x89e\x80ԲC\v\xe8̉\xc0\xa6\xbd\xdb\x7f3\xcb\xe4:\x81\xb5u\x8f\x14\a\xce\x1cN\xa4\x8fP#\xeaB)u\x14\xf7F\f\xea!~\x16$\x1e+\x03\x87\xcf\x01\xb3\x12z֏>6vjx\xd9\x1f\x80\xfcz\xcb9Z\xce\x11\x8d\xb2|\xf5\xae\x03\x89\xc2Z\xea\x80J$UU\xe9\x7f\x82(\x12\x17\xcf+\x89\x1d \x15\xeby\xee<;\xf3b\xe2-\xf7}rmۍ\xfdg\x11y_\x9dH/\x8e\x96\xf3\x88@|\x1e>釞\xe8\x8a\xc5\xcd\xe2\xc3\xc9\x05\x82Q'w\xd8\xc0{\xa4\x96\xba\x99\x9b\x9c3\x12z\xce\xfd;OZ\xef\xd6ߒ9\xf7ζsx\xe7p\x808\xc1\x89\x03\x04\x11\xc3,y\x95$\xe1\xd5P1\x828\x9c\x19\xc1\x14m\x10\\\xcc0\x19 \xb5\xdc\x0f\x03jY:l\xd8s٩-7\xf6\x17\x88\xbc\xad$\xd3\t\x8c\xf9\x16>\xe9\u0089\xe0\x10\xc4Y-\x16Eh\xa2/\x98\x11D\xf0\x18\xc1\x8c\"h\xea\x7fy\xb4Ĩ$\x03\xa4\x12\xa3\xd7\\T1-\xa1\x7fw\xff%\xeb&\xfc\xf8\xb7\xc7\\\xb6\xb9\xed3\xbc\b\x99\xb8h\x89\xe2p\xc9;#\xa1\x80H\xcf;\xc5P3\x02F\xa1J@(\xd4(\xac\xf4\xd7A\xf2\x92\xc05\x02\xa5n\xc5K\xc8馻w\xbe\xbc\xdd\xf6\xdf\x11\x917\xa5\xd0D\xe6\x84q\xd7\xc2{G&\xd1Ƚ\xebyf$$\xf6\xc5R?4\x91\xa88\x02Ja\x8a\x13\xa1PM\x84\xd7\f{\x90\xd4z\xf4\x9a\x05\x8d\x84\x9es\xff\xce\xf6Z\xb7濯\xc9\xfc\xe6\xb6\xcbh\x89\xe0\xc5\xe1\xc4\xe1}\xb2\xc4\x14r%\t`Nb\x98M\xa3F!\xe0P\x1cB\x81!(\x98Q\xa8\xf5\t\xdc\v\xb7u\t\xea\xc2/\x9d\x97n\xba{焍\xe5\x7f\xee\xead\x8ag\xc2gd.\xea$s\xd1;\xbd\bB\x19\xb9\x18\x18\x86\xa2wz\x89\x91+7ALp\xaa\xe0\x13\xa9\xaa\xfdѪ\xee\x99u\x1d̢\x8aFB\xd7\xf8\xe2\xc61\xdf~\xe7\x98\xcbh9\x17\x05\x17GV\v/^\xa4\xca\xd0\xcd\xc0\\\f\x0f\xc1\xa2\x97:\x94`B!\x8a\xa0\xc9\x00<\"J\x1e\xb4O\xe0\xd2\x16\xfbCo\xa5\x95\x05\x132*l,\xff\xb2\x94a6\xf5ym+#\x13O\xe6\xa2>2qxIz1p\xae?)R\x14\xb5\x98\x14\x854\xfc\x88A0\xc8E@\x15\x1c\x14XJ&\x87\xf3\x8a^\xac\x9aݸ\x87\b\xdd\xf2\xc0\x8e\xf7\xb5}\xf6k\xe3\x92ђ\x98\x04E\xa1\x1d\x99\bޕ\x1dH^JԹ\x02\xe6\xa8Bm@(L\xa3.\x8c\xf8\x8aS\x8b#\xa9:#(\xf4<\xb3\x14\xba\x9fL\xc1b\xa4a\xd6~,:6\xed\xbd\U000a30bc\xa7\x9cJ\b0\xe1۴\x12\x99\xad\xe4\x9d>E/\x9f\xc6P\x87\xa4<QR\xa2\xeezC\x90\x18\x85\x81hL*M\xb56\xd51rS\xa0)\xaf\x98\xfb\x10\xd4G\xe8[\xf7^q\x06\xad\xec\x8b\xe3.\x8bB;O&BK\\5^\xb4\xea\t@-e/\xa7+\x05\x863M\xe1\xd8!N\xc0\x04Ӏ\x11癊C]\xc0\x14\x86\xac\xaeQ\xd8\x13\x1bv7\xed\xbd\xf2\xe7\xc1\xae\x95r\n\x92\x12\xa0\x96\x8f$\xb6]Ifԇw\x0e\x8fT\xa4\x96C\xa8Y\xfc3H\xe5\xf8)\x16#\x15\"H\xf2L\x00SC\x9dC1\n\xa5\x97W4\x0e;3뢏Pu\xfe\x861\xc9֗DzG\n\xb5\x8e\x8c$\xbc\xf4\xac\xb2\xaa\xec\x97\t\x11\xe0D\t\xe6p\x16ȝa\xaa\x91H\af\x01\xb5\xd8qoB|\x11ڀ\xa0R\xfb\xbcd\xd1\xd6\xfe\x13\"\xe3e\x96#\"\x8c{W\xe9\u0097d\x0e\xe8%K\x11\xcb%FU\xca\xe9\x8aQ\x88!&\x88)\xe5X\xa5$\xc2]\x8c\xbcA@\xa4\x98\x9d̹x\xe8\xc6}WmtN.\x1cs\x0e\xef\xa4\x12\xba\x1c'J\xef\xacB/\x0e礊\x18j\x10\x9c\xe2\xccQ` .\xe6v\xe20b\x18v\x16\xdbU\f\xaf\x8e !\r\x91\xfd\xa3h\x85\x92\xdc\x138\x8e\x9e\x7f\xdf\x15\xefpN\xdeU\x86Z\x80\xb6\xf3x\xe7S\x02\x143\xda\f\x89d\xa6!\xa9咇\x96\xc5\x05\xd20\x84&2\x93\x87\xba\x94DZԃ9GP\x8d\x8e\x82\x12D\xa27\x0fi\xa4\x16tgpҊPg\\\xeeD$s\x1e\x9f\xe6Yո BKz\xc4f\xe2\xc8\x00\x9f\xb2ݲ\x12\x12\x04r\f\x938\x175\x1c&1-\xf7F\x9c\x83\x99\x10(ǛxN\x85\x06i\x859g\xec\x8b\x02\xe7\xe4\x8a2̖\xc9P\xcb9<\xd1S\xbd8|2\xcch\xecqH\xcaHCS\xca/ \xea\xa4P\x10\x89\x93\x97XQ\x8bK.N\x14\x87\xc3\x11\xc8$\x1a\xb7s\x82K\x86އ\xa1\xbeO\xaf\x90\f`˞\x9dg\x92\xf1K-\x17\xe7\x99R\x1b\xe0\x9d\xb8Dp\xdc2\xd7\x13>\x03\xa4\xe6\x81R\x12h\x86\x8aCј\x9e\x97\xe7\xa7\xf1ER\x8a?T\xdbZ\xe2:\xc2\xe6\xddW\x9d\x87gk\xdd;\x05h{\x9f\xe4\xa7\xd7\x17\xe9\xcf\xf8\xa3\x91\xa7\x90\x9c\xbc)\x98\xe1\x9c#\xb78 \x05\x11\\\x9f>b\xb9P\xd2\xf4.\xfe\xa2\xc3\xde9\x8fjQ\x06`^?\x80\x88s\"U\xe5M\x92\x95\xc5B\x82$\x92\xe3\x80\xed+\x8b\x8c\xa1W1r1\xd44\n,\x0eGL\xcf˨)\b\xa2\x80$\xa1\xa5'g\x1f\xfa\xf6\x9dX\x86͇_)K\x99\xa5w:\x89sF\x9c \x12\x8b\a8)Sª\xc8R\x11\x8b\xd0\":F\x81Ʃ\x1c!\x12h\x92\xbc0\x99sY\xbf\x16Aj\x975]\xf8\x10\xe4\x00L\xec\"\xa0\x9a\x1cWgW\t\xca`\xa3\xbd\xc6뿊\x94\x9d\xedy\x9c\x88Л{H\xad\xa80\xd4\xf2\x92B\xd8\xe9@.\x94\xc1>\x97\xe1\xb7\xfe\xddzS\t\xa0ֿ\x92\x14\xab\xed\xb6\xfe6j\x15P\x19\xdcQwȪ\xc9\xf9i)\xdbt\xffΟ\xeb-N\v\xb8^\x91\x9dr\x9a\x91\xa6%\xe5Xib\xa9\xfaSV\x86 \xa4\xb9\x96\xa6l7\x1e/\xa8(\xa4\xf2\xa0Iꨒ\xeaI\r\x1eh\xd3~\x99\x1e2\xbaml\xda\x1d\xdeb\x9e\xd3kmV\x06YJQ\xae\x9c\x00QO\xa9_Q?\xb1\uf165\xb9\xb3\x95\xf5\xecr\xf5)\xce/\xd5,U\x90\x04M\xb6a$\xfd\x10\xe7\xadQ\xcb4\x06\xa8\x99r\nA$s\x14\xef(\x97\xb3\x82\xa5\x15\x81t\xd1`\xe0\x1d\xa8\xa5zl\xca\xd6\xf2\x8a\xf2\x18:\rP\x8d徐\xaa !\xa5\xeb=\x81\xd32\x92\xf6:\xa0s\xc8^\xad\xfag\x86cl\xf4{\xa3\xcc\xe9\xd6\xeaK\x9f\x97\xf6\b\x88\x06\x1d\rV\x15\x82\x03/\x1a\x93\x1f\\2pŧs5\xe9!\x10uYVmU\x92+\x18\x94\xb5\xdeR\xa3}F3\xdf>\x98\x8ee&nc\xa91\x95T\\'fb>\x95\xad\x82x\x1c)\xf5\x16\x8biw\x1a\x17\xcap\x13\xeb\x95\x1aIE\t\xa6\xa9#\x9a:\x93<X\x14\xd3iDo\xea\xc9\x1cz'\x8b@(\xc8ƾ<-\xee\x8b\xe4\xb9HT@\xf0\xa4B\x81\x18\x0e\x8bs\xe9d\x98\x96\xc8,j\xc1T1\x02PH\xf4ހ\xa0\xa6UE\xad\x9c\xbf\x9b$RG\x9a\xa2I;\xc3\xec\xec\xb2\x13J\xf2F\x11\xbc)\x81\xb4\\\x96V\x060\x05\xb5T\xe2\xeb\xad\xfcY\x12H-\x91\n\x14Vv \x11L\xb9F\x9a,VJ\xae\xcaXS\n5X5\x9a\v\xa3\x8b0\x1c\x8b\x9d\xddԌ\x91\xfa%\xe0J\x03Ep\x96\x16\x1cp \x8a!x\x8by\bU\xbe\x9a®\xc5\xe8W\x98Q\xa4\x95\x96\x18\r\x93\xd1\x03AST\x9be\x99lF\x95\x88d\x19\xf0\xba\xf2{\xa1\x1a\xd70Q\n\x97\xaa\x1a\x1a\xa7&]\x94\xcc\t\x86G5\xe0\xc5%*%\x8d\x91\x92\x96\x87,\x91Zzg\xac_\x16\x1a\xf7)e\xc1\xbaNdEm\x92z~U\"\xabg%\v\x84\x88\xf4\xf2\x88jg\xfc\xa7[f\xef&x\x89\x85tꛁ\x12\x92\xeeREHR\x0e\xa1il-Co\xfa\\nQgZ\xe9\xad\xef\xee\x9by:\xab\x99\xb6\x9c\x99\x9d\xd6\xdb\x11\v\xc4\xf1\xa2\x9a\xd62\x95\x1c%\xb7\xf8\xbd\xab\x81\xae\x19\x1d\vtU\xd3\xffFGCu\\\x97\xd8NnF\xaeJ\xa1!\x92\xab!\xae\x8b*\x04\xd5Aq\x06<\xb5Oҙ{R\xe8H\xafV\x15D\xcctڿL\x11LSĉ\xba(4~\xcfU\xe9\xa6\xfe\xc6-}7\xa5\x83\xd1A\xe9Z\xa0kJ\xae\x9at\xa2\xa9\x1d#\xa8Q\x94:IѬR@SĂ\x99u\x91\xabϜc\xbc~Ha\x8a\xb7T\xd2\x10\x8d\x19\xa9\x13Lbq\xdd\x19x\xac7/ER\x06Ke\x8dU2\xa0Q\x01\x81Hn\x01\x04\"\xb9\x95\xb0uc\xa7\xdf<m:\x82\x871\xd2\xdf\xc7\xd8p\xdf'&\xaa\xf2N\x03B2\xf4X\x8b\xb5\x18\x91\x88aV\xcd0\x8dw\"\xf4V\xa0\x84\x94j\xc4q4\xad\xb4\xa8FG)\r$\xaf<7\xea\xc8z\xa9n\xd2@\rsЅa.3\xab\x8f@\x91\x8c\\Cu\xae\x89$\x7f\x8d%\x04\xef,V:B\x9cl\xbb\x94C\xab&R-\xa6\xf3\x85\xa6\xdb,ʰ\xabJa\x81\\\xe7\x18n\xe7:~ƣ^\xf4'\x00\"\xa1\x0e\x92n\x8c\xb8B\xe2\x11\n#ݹ`T%\x87\xb4\xe4[Nmʵ\xd0rE*X\x88\xa4&\x1d\x15\xd3\xdd\xc2Z#\xb8\x7fg\x03\x82\x15\x99)\x88\xef?\xb40+'\x8b\xe9n5\x17\xc7M'\x04\x8d\x95 \x11\x10\xad\xa5\x85)g\n\x18\x16\x8c \x9a\x92\x01%\xa4\x10U\xf4\x91Y3\xe1\xfa\xe7$E\x1f\xc1\xb3\xc0:a\xa41\xf4\xbe\r\xd7\x1d߸\xf7\x8a\x86\x86\xa1w\xc7@\xac\xfcĥ@Pb}7\x13\xc19P\xd5XIJU1\xd2\x18J\x9a\x02\x96Y\xad\x9aR\x84D\xaaFRC5p\xd6\x13\xc2\xc1<\xc2z6>\x8dJ\xb4\x1b:Y\xaf\x9d\x9e\xe0\x10\xadF\r2+\x97\xc5bYK\x10\x9c\x8b+'\x94\v\xdcU\xa9\xb1,.H\xbc\xe3/yg$\xd5\x1anc\xac\tZ\xef\x84\xd1С\xe9a\xdd\xc1\xf1x\x01\xb0\xb2\x9aS\xd7C\xffW5%GR$\x8aCLp\x0eoV\x15\xda\xcbp'5[U\xa2\xc1\xc6{\xac\xd2L\u0094B\r\xb3z\xa8\xed\xf5{(\xe86z\xea@\x17:\x05\x99i\xbcS\xado\"\x9d\xc6\x00U\xa5+\x82S\xc3;\x17\xbd\xb3$5Ya\xfdn\xeb\xf2\xde>M\x16YNc\xcaBEl\xde\xfa\x05\x1f\"o\xfei^qdj\x9e\xf9`?6\xfc\xafOL\xd8\x19Te\xdc\xfe\xeb\x97\xf3\xab\xf8ـ\\5\x16\x15,\xe6\x11e1\xbe\xca+\xa4\xf4\xd0\x1e9\xa1\xd2I\x1c3u(A\x18\xf8<\xadaO\xdf\xd5\xf0|G3\n,\xae\r5w\x00\xca\xcaH\xa0(W\x19,-n\x1bQ\vXu\x9a\xa5[8\xb5&He\x81\x95\xa0\x83\xff\xf7\x7f\xee\xb3\xd89 \x1c\x9e\x1a\xf9\x0f\b\x989\xc4\x02\xbd\x1av\xdaOs5\xd5,.Zc\x8aS\x97n\xe1,\xbd\xd3U\xfa\xb4ԟ\xf2\xf6֚R\x86\xfbZ7\xf2\xe9\x12\xc4\x19tR\x1c\x9e̝\x9aLU\x92W'\f\xdc\xf9^StP#\x0f\x81N\x88Ӗ\xae\x16q*\x13\x02y\b1TkOX\x1b\"\xaf\xf7\x7f\xcf;\a\xf71kx\xa9#\x7f\xf6\xf8\vs>\xb8\x01\xf7\xbd\xf3\xba\xe3\xa6\xf4\xe2\xf6\f\xc6\xd6D\x84j\x9a\xd2hL\xfa\xbaZ\xd0\rEԉ&\x9d\xa4\xfb\x85\x9a۰\x06\xfd\f|\x9e\x83u\xe7\xcf\x1c?\xe44ȳ\x15yC\x9eRkl\xc8\xcbz\x84M\xb7M/l\x8d\xcc>-Vڜ;\x1b\x06ݟ\x1e\x19\xe9\xd5\xde\x16+\xe9G\x87eI\xbf6\xc9X'd(D6m\xf4}\x1e2\xf4\xbekM\xe7\x9d\xd3\xebł\xd2}\xe2\xf9\xc7\x1c*\a\xcc\xeaa\xb6<b\xfa\x900,\xe8\xe0f\xa0\xd6p|i\x045\xe1\x1a;9\x83\xf6\a\x90?7\x89\x1e\xcf\x0f\xcc\xfd\x8cf\xa8\xba\aͤ?\xb4M\xdbw\x18\"uZ\x9dXM\x1f='hnk\xf0:\xb5\xdfgQJ\xfe\xd4q\xb4\xb0\a\x9d\x18\xfb-\xf8a\xe2\x18\x10\x9a\x86\vMk\x91\x83\xbf\x91\xbc\xb2v\f\xfd\xbf\x0fN\xaa\xe7\x8a\xceÇAd\xff\xbcNj\x82\xda~\xcc\xd3\xe7\x85\x15j\x9e3\r\xa9Æ߯\x8bi\xa3V\x9fa\f\xb4?\x0f#\x9fz\xe80\xa2\xb2ߩ\x93\xbbM볗\xc1F\x8d\xe9ƻ\xd97b1\xbf\xef\xfc\xe1\x0e/\x94LԘz\xe4y\xc4l\xe4\xbf{&\xb8\xa8\x87\xcaS\x18҃\xd5\b\x98>\xfa\xccg\xf8)u\xdbD\xe6\xdc\xf3\t+\x94\xeecG\x10gwgZط][0Z\x88嘤\xf2V\xb9\xbaZ\x9bh\xd6\x1f\x03\xec_\xa3\xefk\xbe\xb2&\xab\xffS\x19~\x93e\xce.t\x13:\x8f\x1d\xc5:\x85\xd2v\xffg\xde'\x0f@\xbc\xdej\xb4@2b%\xb1\\E.\xefP\xe8\xe9 .!\xd6~\xa7ן攸\xfe\xb9\xa6\x1c+\x8b\x05Þo\xbd\x83f\x95\xbd\xf3\xf0\x11\xacЩ\xechq\xbb\xdb\xfb\x8b;\x1e\x01\x0e \xed(\xe4\xd0E\x06/\xd8\xf3Xk\xb4\xca\xde\xfef\x8b\xec\xb57\n\x99\x18L\xfe\xf80\x02w\xfe\xe4\x86o>7\xff\x06\xfaq\xeff\xee\x03\x9e4\x19\x9b^\xb6>\xcfi\x8e6\xcd۰.\x9a\xa3V\xbaH\xdf\xfeYԠ\xc6\xd4\xc1\xc3\b|\xe7\xe0\x97n\x9bJ\xf7\x14\xf1'&\x1e|\xbb\xc1r\x9a\bI\xdf\xd5\x06\x84\xb5\x81d\xa8\xb9\x8d\x1e\xe1\x03\n\x9b\a\xba\x8f\x1fC\x8f\xe5\x18v\xf3\x82\x1a\x18\x80\xb1CA\xbeb.\x83\xacE\xd9\xfd\xfe\xbc\xa2?\x1cZ\x93N\x1a\xf5\x01\xcdD6\x939\x1f\xddt\x1f=\x8av\x02&\xdc\feQ\xdb\xe9\x97\x01\xa5=Q\xf6\xae\xba\xf8L\xb1\xbf\x12\xb8\xbe5vf\x90\xc8ڶ\x10\xe5\x17\xc6\xd4#G\x01\xba\xadv\xf6\xa7\vj\xa4\xb9\xe5\xff\n@{-\xbd\x10K\x03i\f\xf5\xc9\xfa\xfa\xdc\xff\xb9\x8a^\xca4\xbadA\x86n\xb9\xd2\xf9\xc91\x80\x17:ݩ?\x83D\xe8\xee\r\xdb\x0f\x82\xfd\x19\xe2`┾\v5\x0f\xea\xf5\x0e6m\xfd\xa4\r[si\x91\vC\xe7\xd1\xe3X\xa1\x80}\xf9G;\xfe\xc7S\vo\xa9\x1f\xf7\x9c{\xf9\xbd\b\xb7\"\x1e֬\xebS\xf4\x10\x11\f\xeb\xa4\xfa\xa8i+mvPo\rS\xba\xa1\xf0;\aL=|\x1cS\x03\xe1\x8bO\xfc\u07b7\x8eAm\xd9I\xb1k\x00\x93\xb15\xb8\x89\xb5\x03\xd6\xd8#\xb6,x4\x86\x97>C\xa8\x19À\xa7\x8e\x82\xe2y\xa58R\x00\x14\x0e\xfd\x0f#5\xd6\x00\x15\xfb4\x80\x8cO \xe3k\xaaP8s\x11a\xf0\xfb\xb0>\xa6\xff\xbe\xb0\xa8\x95\x1f\n\x84c\x05\xc0\xa4y\xbd\xa1\xdc_\x11z\xef\x1b/\xbd\xc7\xe0&\x009\xf9Tܚ5\xf1\x87\x06\xa1g\xab\x105\x928\xa2W\x02hW\xc8\x0fU\xeb\xfa7\xfe\xe8\xd2]?\x1a\xad\xc5a\xdc{\xf6\xa7n\x13\xf8\x06\x80[\x7f\x1a266\x10\xad\x98\x85\xaci\xb6!Gh\x8a~s\xd4CG(\x0e\xa7\xf2\xb5ɵ\a?\xb9\xeb\x89\U000b7f85\xe1\x96s\x9f\x04\x0e#\x82?\xfd\f\xa4=\xd6\xfb\xb1\xd1ꚶ\x86\xe3\x16\x01څ\xf0B\x14\xd7\xe0\xc9е\x1d\x8b\xd3\xf20\f\xff\x11\xe0\x18\"dg\x9c\x01Y\x9bz\x88\xed\x11\xcb,z\x19&\xb6/r- ji\a\x8a\x17*\xda\x1e>\xdeή\xad\xff\xdeG\xe8\xed\xaf\xfd\xd7O\x8aȇ\x01\x10\x17I\x1d\x1bo\xea\xf1,\xe1e\xce\xf2\xcd\ta\xd2ȟ\xab\xfam\x82|\xe8\xe0o\x7f\xe9\xf0\xe2^\xa5\x87\xbb\xce\xfc\xd8#\"|\n\x00\xe7\xf1\xa7\xbf\"\x92:\xe0\x95\xf5a\xa8\x99`zc\xaa\xd5\x12\xa7\xc1p=G\xe8q#?T}-\f\xde\xff؇\xfe\xf0x\xfd\x98\xa1[7\xeexտ\xdae&\x7f\x04\xc4\xe7P֟\x06cK\xf6\xd65\x8a#\x81\xfc\xd9\xdaB\x88ٵ\xfb\xde\xff\xb9\xaf\xbf\xd8\u05fd\xe3\x95\x1f\xb9\x11\xd8\x05 \xce\xe1ֿ\x1cZ\xe33\x8e\x9d\xcd\x15\xa2\x01\x12u\xfeD\x1a\x90\x1f\x0et\x9f\xeb\xe9A`\xfb\xfem\x9f\xff\xee్\xf7\xe2\xe8\xe9\xf2\x11C\xbeU\x9e*kN\x86\xb1u\x9cȧQ,\x18\x9d\xc7s\xf2\xe7\xfa\x96:\xbf~\xc6)'m?Q2\xf8\xa9\xe3\x1f\x04\xbe\aĻ3֞\x82\x95S\x9aAol\x9a\x93\x0f\ud7ff\fV\x18\x9d\x9fv{cf\xc4M?|\xf7u\xd76\x1d\xdfH\xe8]|(Ϻ\xbc\x17\xe1\x8e^\xefڨ_G\xac\xfb\xbe\xb8\xc4\xe6\xcf\xe7L\x1e\x9c$\x1c\xabuB\xec;/8\xff\xbe\xdb\u07bec\xa4;\xfc惿\xfaُO*\xadw\v\xb6\xb7\xda\xe9\xc7Qw\x12f\xad\xe1\x13\x9ar\x8a\x11\x90?\xd7e\xf2\xe0$:\xa9u\x95\x7f\xe34\xe7\xffeYY\x1eĴw\xcb}\xf7\xe5\xbf\xfa\xc2dg\xfc\xefc|\xb3\xb7WP\x1b\xa3\x98j\x11\xba\x8b|\xa3\x9dAq\xb8˱}G\xe8<:\x89\x85\x9a\xbc\"__w\xd2\xd4?>\xf8\xf6\x1dS\x8b{\xd1\xd9\xf1\x83u\x1fx6s\xe1\xef!\xf2\xbd\x9e<\x0e\rm\x8a\xa9\x16\xda]\xe4\v\x1a䇺\x1c\xdb\xf7<\x9dǧ\xe2<\xb3\xf7\xd3Mc\xa7=\U000de64cz\xd6\u05eb\x9es\xff\xd7\xdakNz\xe2\xb3\xd6\xcd?\xac\x9d\x02\xeb\x16\x94\xff[\x91\xe3Z\x86_\xe3p\xed\x85y\xadN\x05\xf2C]\x8aC\x1d4\x8f\xb2H\xe6\xe3\xe6\xbdI\xe6oxS\xe7\xa1Kv\xbdw\xd7ȷ\x99\x8c\x82M\x8f\xffф+\xa6\xfe8L\x15\x17Y\xb7\xc0:\x05\xda-\xb0N\x0e\xa1@Z\x86\x9fp\xb8\xd6\xc2\xf4\x10&\x03š\x0e\xf9\xe1.6\xa8\x87\xcc\a\x97ɿ\xff\xe1?\xbd\xfe\xea\xe9<\xb3Ĝߗ\xbb\xf9\xc1\xeb/\f\xddp\xa3u\x8b\xf5=b\xf3\x8a`,\xe0\xc7\x1dn\x8dǍy\xfc\x98\x8bϊ\xfa\xf8@\x93i\xbc\xbdӺ\x01\xed(\xc5\xf1\x82\xf0B\x8e\x15\xc3\xd7O\x9dx\x1a\xe7~\xed\x81\xf7\xdc\xf0\x8d\x05\xe8\xe7E\xc3\xc6\x037\xfc\x86\xe5\xc5\xef[\xa7X[\x12\xaa5\x82\xc5\x147\xee\xf0\x13Q\x0f\xd2r\xb8,\xde\xc3\\&IV\x18\xdaQ\xb4\x13\xd0\xe3\x81p\xb4@\x8b\xe1;\x17\x93\x1e\x1e\xf3Y\xeb\x03{\xff\xf9\xef\xdd:\x17\xf9\xe6\xf5\x02\xe4\v\xf6}\xe6\xb4\xee\x94\xfd\x8eN\x15\xbfa\xddB\xea\x84Z\xb7\xc0\xf2Eq\"\x13\x9f\xddd\xd2\xfe7\xfb/\xbc\xee\x99\xc5hp\xb1q\xfe\xfek_\xa9\xc7\xf2ߵn\xd86H\xa8u\n\xacX\x14=\x14\xe2ݍ\xc1ܕ\x0f^\xfc\xd9#s=iAo\xb4\xde\xf0\xfd\xab\xdf,E\xf7\x93)\xfc\xf8E\"T\x81[\xd4\xe9\xbf{\xf0\xe2/\xde?JC'\n\xe7~\xff\xea\xf3\xb4\x9b_\xa6\xdd\xfc_X\xa7\x90E\"4\a\xbe\xea\xcc}\xfa\x81\x0f\xfc\xc1\xbco\xad\x19\xe9\x15\xe5o\xf8\xe6e\xaf\xf1\xa1\xd8\x16:\xf9E\xd6\r\xaf_\x18\xa1\xb6GDn\x0e\x92\xdd\xfc\xe0ş}I\xbe\x7f\xfe\r߾\xfcu~\xb2\xd8\x16\xba\xf9E\xd6)\xce\\\x00\xa1&\xd8=\x86\xbb\xa9\x15\xf8\xca\xde\x0f~\xfe\x89\xd9Oi\xc6H\x84\xd6q\xce\xd7~\xfb\xe7\xf2\xc9\xeeV\x90M\bg\x9b\xd9kD8\x8d\xf4G\x04\x80\xa3\xc0\xb3\x06\a1\xdb琻h\xfb[\xf7\xfd\xf2\xe7~\xba(\x02,\x13\x9c\xf5\xa7\x1f\xfby\x97\x17[\xcd8_\xcc^\x87ȫ\xa1\xd2C \xe9\x01\xe4o\x10ۇٝyV\xdc\xf6\xe3\v\xffx\xa4;\x17K\xfc?:\xda\\\x86s0\x9b\x04\x00\x00\x00\x00IEND\xaeB`\x82"),
-	},
-
-	theme.IconNameFileImage: &fyne.StaticResource{
-		StaticName:    "image-x-generic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"128px\" viewBox=\"0 0 128 128\" width=\"128px\" xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\">\n    <linearGradient id=\"a\" gradientUnits=\"userSpaceOnUse\" x1=\"16.280764\" x2=\"93.490864\" y1=\"36.90527325\" y2=\"96.5379485\">\n        <stop offset=\"0\" stop-color=\"#1a5fb4\"/>\n        <stop offset=\"0.11613\" stop-color=\"#813d9c\"/>\n        <stop offset=\"0.377272\" stop-color=\"#ed333b\"/>\n        <stop offset=\"0.666759\" stop-color=\"#ff7800\"/>\n        <stop offset=\"1\" stop-color=\"#f6d32d\"/>\n    </linearGradient>\n    <path d=\"m 16 27.929688 h 96 c 4.433594 0 8 3.570312 8 8 v 50.070312 l -30 30 h -74 c -4.433594 0 -8 -3.566406 -8 -8 v -72.070312 c 0 -4.429688 3.566406 -8 8 -8 z m 0 0\" fill=\"#f6f5f4\"/>\n    <path d=\"m 120 86.125 h -22 c -4.433594 0 -8 3.570312 -8 8 v 21.875 z m 0 0\" fill=\"#deddda\"/>\n    <path d=\"m 16 36 v 72 h 74 v -13.875 c 0 -4.433594 3.566406 -8 8 -8 h 14 v -50.125 z m 0 0\" fill=\"url(#a)\"/>\n</svg>\n"),
-	},
-
-	theme.IconNameFileText: &fyne.StaticResource{
-		StaticName:    "text-x-generic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"128px\" viewBox=\"0 0 128 128\" width=\"128px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 28 8 h 50 l 30.042969 29.917969 v 74.058593 c 0 4.433594 -3.609375 8.023438 -8.042969 8.023438 h -72 c -4.433594 0 -8 -3.566406 -8 -8 v -96 c 0 -4.433594 3.566406 -8 8 -8 z m 0 0\" fill=\"#f6f5f4\"/>\n    <g fill=\"#deddda\">\n        <path d=\"m 78 8 v 22 c 0 4.433594 3.609375 7.921875 8.042969 7.921875 l 22 -0.003906 z m 0 0\"/>\n        <path d=\"m 34 100 h 60 c 1.105469 0 2 0.894531 2 2 s -0.894531 2 -2 2 h -60 c -1.105469 0 -2 -0.894531 -2 -2 s 0.894531 -2 2 -2 z m 0 0\"/>\n        <path d=\"m 34 92 h 60 c 1.105469 0 2 0.894531 2 2 s -0.894531 2 -2 2 h -60 c -1.105469 0 -2 -0.894531 -2 -2 s 0.894531 -2 2 -2 z m 0 0\"/>\n        <path d=\"m 34 84 h 60 c 1.105469 0 2 0.894531 2 2 s -0.894531 2 -2 2 h -60 c -1.105469 0 -2 -0.894531 -2 -2 s 0.894531 -2 2 -2 z m 0 0\"/>\n        <path d=\"m 34 76 h 60 c 1.105469 0 2 0.894531 2 2 s -0.894531 2 -2 2 h -60 c -1.105469 0 -2 -0.894531 -2 -2 s 0.894531 -2 2 -2 z m 0 0\"/>\n        <path d=\"m 34 68 h 60 c 1.105469 0 2 0.894531 2 2 s -0.894531 2 -2 2 h -60 c -1.105469 0 -2 -0.894531 -2 -2 s 0.894531 -2 2 -2 z m 0 0\"/>\n        <path d=\"m 34 60 h 60 c 1.105469 0 2 0.894531 2 2 s -0.894531 2 -2 2 h -60 c -1.105469 0 -2 -0.894531 -2 -2 s 0.894531 -2 2 -2 z m 0 0\"/>\n        <path d=\"m 34 52 h 60 c 1.105469 0 2 0.894531 2 2 s -0.894531 2 -2 2 h -60 c -1.105469 0 -2 -0.894531 -2 -2 s 0.894531 -2 2 -2 z m 0 0\"/>\n        <path d=\"m 34 44 h 36 c 1.105469 0 2 0.59375 2 1.324219 v 1.351562 c 0 0.730469 -0.894531 1.324219 -2 1.324219 h -36 c -1.105469 0 -2 -0.59375 -2 -1.324219 v -1.351562 c 0 -0.730469 0.894531 -1.324219 2 -1.324219 z m 0 0\"/>\n        <path d=\"m 34 36 h 36 c 1.105469 0 2 0.59375 2 1.324219 v 1.351562 c 0 0.730469 -0.894531 1.324219 -2 1.324219 h -36 c -1.105469 0 -2 -0.59375 -2 -1.324219 v -1.351562 c 0 -0.730469 0.894531 -1.324219 2 -1.324219 z m 0 0\"/>\n        <path d=\"m 34 28 h 36 c 1.105469 0 2 0.59375 2 1.324219 v 1.351562 c 0 0.730469 -0.894531 1.324219 -2 1.324219 h -36 c -1.105469 0 -2 -0.59375 -2 -1.324219 v -1.351562 c 0 -0.730469 0.894531 -1.324219 2 -1.324219 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	},
-
-	theme.IconNameFileVideo: &fyne.StaticResource{
-		StaticName:    "video-x-generic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"128px\" viewBox=\"0 0 128 128\" width=\"128px\" xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\">\n    <linearGradient id=\"a\" gradientTransform=\"matrix(0.259459 0 0 0.25 237.09729 56)\" gradientUnits=\"userSpaceOnUse\" x1=\"-841.546875\" x2=\"-493.708313\" y1=\"204\" y2=\"-70\">\n        <stop offset=\"0\" stop-color=\"#e01b24\"/>\n        <stop offset=\"0.394161\" stop-color=\"#e66100\"/>\n        <stop offset=\"1\" stop-color=\"#df97e0\"/>\n    </linearGradient>\n    <path d=\"m 15.5 28 h 97 c 4.140625 0 7.5 3.359375 7.5 7.5 v 73 c 0 4.140625 -3.359375 7.5 -7.5 7.5 h -97 c -4.140625 0 -7.5 -3.359375 -7.5 -7.5 v -73 c 0 -4.140625 3.359375 -7.5 7.5 -7.5 z m 0 0\" fill=\"#f6f5f4\"/>\n    <path d=\"m 19.777344 36 h 88.445312 c 2.085938 0 3.777344 1.691406 3.777344 3.777344 v 64.445312 c 0 2.085938 -1.691406 3.777344 -3.777344 3.777344 h -88.445312 c -2.085938 0 -3.777344 -1.691406 -3.777344 -3.777344 v -64.445312 c 0 -2.085938 1.691406 -3.777344 3.777344 -3.777344 z m 0 0\" fill=\"url(#a)\"/>\n    <path d=\"m 56 60 v 22 h 2.199219 c 0.382812 0 0.757812 -0.097656 1.09375 -0.289062 l 15.394531 -8.796876 c 0.6875 -0.394531 1.109375 -1.125 1.109375 -1.914062 s -0.421875 -1.519531 -1.109375 -1.910156 l -15.394531 -8.800782 c -0.320313 -0.183593 -0.683594 -0.28125 -1.050781 -0.289062 c -0.011719 0 -0.027344 0 -0.042969 0 z m 0 0\" fill=\"#ffffff\"/>\n</svg>\n"),
-	},
-
-	theme.IconNameFolder: &fyne.StaticResource{
-		StaticName:    "folder.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"128px\" viewBox=\"0 0 128 128\" width=\"128px\" xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\">\n    <linearGradient id=\"a\" gradientTransform=\"matrix(0.45451 0 0 0.455522 -1210.292114 616.172607)\" gradientUnits=\"userSpaceOnUse\" x1=\"2689.251953\" x2=\"2918.069824\" y1=\"-1106.802979\" y2=\"-1106.802979\">\n        <stop offset=\"0\" stop-color=\"#62a0ea\"/>\n        <stop offset=\"0.0576991\" stop-color=\"#afd4ff\"/>\n        <stop offset=\"0.122204\" stop-color=\"#62a0ea\"/>\n        <stop offset=\"0.873306\" stop-color=\"#62a0ea\"/>\n        <stop offset=\"0.955997\" stop-color=\"#c0d5ea\"/>\n        <stop offset=\"1\" stop-color=\"#62a0ea\"/>\n    </linearGradient>\n    <path d=\"m 21.976562 12 c -5.527343 0 -9.976562 4.460938 -9.976562 10 v 86.03125 c 0 5.542969 4.449219 10 9.976562 10 h 84.042969 c 5.53125 0 9.980469 -4.457031 9.980469 -10 v -72.085938 c 0 -6.628906 -5.359375 -12 -11.972656 -12 h -46.027344 c -2.453125 0 -4.695312 -1.386718 -5.796875 -3.582031 l -1.503906 -2.992187 c -1.65625 -3.292969 -5.019531 -5.371094 -8.699219 -5.371094 z m 0 0\" fill=\"#438de6\"/>\n    <path d=\"m 65.976562 36 c -2.746093 0 -5.226562 1.101562 -7.027343 2.890625 c -2.273438 2.253906 -5.382813 5.109375 -8.632813 5.109375 h -28.339844 c -5.527343 0 -9.976562 4.460938 -9.976562 10 v 54.03125 c 0 5.542969 4.449219 10 9.976562 10 h 84.042969 c 5.53125 0 9.980469 -4.457031 9.980469 -10 v -62.03125 c 0 -5.539062 -4.449219 -10 -9.980469 -10 z m 0 0\" fill=\"url(#a)\"/>\n    <path d=\"m 65.976562 32 c -2.746093 0 -5.226562 1.101562 -7.027343 2.890625 c -2.273438 2.253906 -5.382813 5.109375 -8.632813 5.109375 h -28.339844 c -5.527343 0 -9.976562 4.460938 -9.976562 10 v 55.976562 c 0 5.539063 4.449219 10 9.976562 10 h 84.042969 c 5.53125 0 9.980469 -4.460937 9.980469 -10 v -63.976562 c 0 -5.539062 -4.449219 -10 -9.980469 -10 z m 0 0\" fill=\"#a4caee\"/>\n</svg>\n"),
-	},
-
-	theme.IconNameFolderNew: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "folder-new-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 3 1 c -1.644531 0 -3 1.355469 -3 3 v 8 c 0 1.644531 1.355469 3 3 3 h 3 c 0.550781 0 1 -0.449219 1 -1 s -0.449219 -1 -1 -1 h -3 c -0.5625 0 -1 -0.4375 -1 -1 v -7 h 11 c 0.5625 0 1 0.4375 1 1 v 1 c 0 0.550781 0.449219 1 1 1 s 1 -0.449219 1 -1 v -1 c 0 -1.644531 -1.355469 -3 -3 -3 h -3.585938 l -1.707031 -1.707031 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 z m 0 2 h 3.585938 l 1 1 h -5.585938 c 0 -0.5625 0.4375 -1 1 -1 z m 8 5 v 3 h -3 v 2 h 3 v 3 h 2 v -3 h 3 v -2 h -3 v -3 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameFolderOpen: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "folder-open-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 3 1 c -1.644531 0 -3 1.355469 -3 3 v 8 c 0 1.644531 1.355469 3 3 3 h 8.882812 c 0.832032 0 1.578126 -0.402344 2.054688 -0.9375 c 0.472656 -0.53125 0.738281 -1.167969 0.910156 -1.800781 l 0.972656 -2.609375 c 0.390626 -1.449219 -0.09375 -2.652344 -0.820312 -3.167969 c -0.484375 -0.34375 -0.714844 -0.292969 -1 -0.324219 v -1.160156 c 0 -0.855469 -0.558594 -1.589844 -1.09375 -1.828125 c -0.53125 -0.238281 -1.011719 -0.167969 -1.011719 -0.167969 l 0.105469 -0.003906 h -3.585938 l -1.707031 -1.707031 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 z m 0 2 h 2.585938 l 1.707031 1.707031 c 0.1875 0.1875 0.441406 0.292969 0.707031 0.292969 h 4 c 0.035156 0 0.070312 -0.003906 0.105469 -0.007812 c 0 0 0.019531 0.019531 -0.011719 0.003906 c -0.035156 -0.011719 -0.09375 -0.25 -0.09375 0.003906 v 2 c 0 0.550781 0.449219 1 1 1 c 1 0 1.046875 0.703125 0.886719 1.128906 l -0.972657 2.609375 c -0.117187 0.4375 -0.296874 0.800781 -0.472656 0.996094 c -0.175781 0.199219 -0.285156 0.265625 -0.558594 0.265625 h -8.882812 c -0.570312 0 -1 -0.429688 -1 -1 v -8 c 0 -0.570312 0.46875 -0.792969 1 -1 z m 0 0\"/>\n        <path d=\"m 7 6 l 0.042969 0.003906 c -0.914063 -0.042968 -1.75 0.390625 -2.195313 0.96875 c -0.710937 1.222656 -1.15625 2.277344 -1.800781 3.71875 c -0.171875 0.523438 0.117187 1.089844 0.640625 1.261719 c 0.527344 0.171875 1.09375 -0.117187 1.261719 -0.640625 c 0.488281 -1.011719 0.921875 -1.816406 1.339843 -2.808594 c 0.210938 -0.503906 0.703126 -0.492187 0.898438 -0.503906 h 5.8125 c 0.550781 0 1 -0.449219 1 -1 s -0.449219 -1 -1 -1 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameGrid: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "view-grid-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 2 1 c -0.554688 0 -1 0.445312 -1 1 v 4 c 0 0.554688 0.445312 1 1 1 h 4 c 0.554688 0 1 -0.445312 1 -1 v -4 c 0 -0.554688 -0.445312 -1 -1 -1 z m 8 0 c -0.554688 0 -1 0.445312 -1 1 v 4 c 0 0.554688 0.445312 1 1 1 h 4 c 0.554688 0 1 -0.445312 1 -1 v -4 c 0 -0.554688 -0.445312 -1 -1 -1 z m -8 8 c -0.554688 0 -1 0.445312 -1 1 v 4 c 0 0.554688 0.445312 1 1 1 h 4 c 0.554688 0 1 -0.445312 1 -1 v -4 c 0 -0.554688 -0.445312 -1 -1 -1 z m 8 0 c -0.554688 0 -1 0.445312 -1 1 v 4 c 0 0.554688 0.445312 1 1 1 h 4 c 0.554688 0 1 -0.445312 1 -1 v -4 c 0 -0.554688 -0.445312 -1 -1 -1 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameHelp: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "help-about-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 0 c -4.410156 0 -8 3.589844 -8 8 s 3.589844 8 8 8 s 8 -3.589844 8 -8 s -3.589844 -8 -8 -8 z m 0 2 c 3.332031 0 6 2.667969 6 6 s -2.667969 6 -6 6 s -6 -2.667969 -6 -6 s 2.667969 -6 6 -6 z m 0 1.875 c -0.621094 0 -1.125 0.503906 -1.125 1.125 s 0.503906 1.125 1.125 1.125 s 1.125 -0.503906 1.125 -1.125 s -0.503906 -1.125 -1.125 -1.125 z m -1.523438 3.125 c -0.265624 0.011719 -0.476562 0.230469 -0.476562 0.5 c 0 0.277344 0.222656 0.5 0.5 0.5 h 0.5 v 3 h -0.5 c -0.277344 0 -0.5 0.222656 -0.5 0.5 s 0.222656 0.5 0.5 0.5 h 3 c 0.277344 0 0.5 -0.222656 0.5 -0.5 s -0.222656 -0.5 -0.5 -0.5 h -0.5 v -4 h -2.5 c -0.007812 0 -0.015625 0 -0.023438 0 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameHome: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "user-home-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 12 16 c 1.660156 0 3 -1.339844 3 -3 v -6 c 0 -0.929688 -0.414062 -1.8125 -1.128906 -2.410156 l -4.460938 -3.71875 c -0.816406 -0.679688 -2.003906 -0.679688 -2.820312 0 l -4.460938 3.71875 c -0.714844 0.597656 -1.128906 1.480468 -1.128906 2.410156 v 6 c 0 1.660156 1.339844 3 3 3 z m -9 -3 v -6 c 0 -0.335938 0.148438 -0.65625 0.410156 -0.871094 l 4.460938 -3.71875 c 0.074218 -0.0625 0.183594 -0.0625 0.257812 0 l 4.460938 3.71875 c 0.261718 0.214844 0.410156 0.535156 0.410156 0.871094 v 6 c 0 0.546875 -0.453125 1 -1 1 h -8 c -0.546875 0 -1 -0.453125 -1 -1 z m 0 0\"/>\n    <path d=\"m 7 8 h 2 c 0.550781 0 1 0.449219 1 1 v 5 c 0 0.550781 -0.449219 1 -1 1 h -2 c -0.550781 0 -1 -0.449219 -1 -1 v -5 c 0 -0.550781 0.449219 -1 1 -1 z m 0 0\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameInfo: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "dialog-information-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 7.996094 0 c -2.835938 0 -5.292969 2 -5.871094 4.777344 c -0.527344 2.535156 0.6875 5.035156 2.875 6.328125 v 0.894531 c 0 0.554688 0.449219 1 1 1 h 4 c 0.550781 0 1 -0.445312 1 -1 l 0.003906 -0.898438 c 2.179688 -1.292968 3.398438 -3.796874 2.867188 -6.332031 c -0.582032 -2.773437 -3.039063 -4.769531 -5.875 -4.769531 z m 0 2 c 1.898437 0 3.527344 1.320312 3.917968 3.179688 c 0.390626 1.863281 -0.570312 3.726562 -2.3125 4.488281 c -0.363281 0.15625 -0.597656 0.515625 -0.601562 0.914062 v 0.417969 h -2 v -0.417969 c -0.003906 -0.398437 -0.238281 -0.753906 -0.601562 -0.914062 c -1.742188 -0.761719 -2.703126 -2.625 -2.316407 -4.484375 s 2.015625 -3.183594 3.914063 -3.183594 z m -1.996094 12 v 1 c 0 0.554688 0.445312 1 1 1 h 2 c 0.554688 0 1 -0.445312 1 -1 v -1 z m 0 0\"/>\n        <path d=\"m 6.644531 6.144531 c -0.195312 0.195313 -0.195312 0.515625 0 0.707031 l 1 1 c 0.195313 0.195313 0.511719 0.195313 0.707031 0 l 1 -1 c 0.195313 -0.191406 0.195313 -0.511718 0 -0.707031 c -0.195312 -0.191406 -0.511718 -0.191406 -0.707031 0 l -0.648437 0.648438 l -0.644532 -0.648438 c -0.195312 -0.191406 -0.511718 -0.191406 -0.707031 0 z m 0 0\" fill-opacity=\"0.34902\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameList: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "view-list-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 2 1 c -0.554688 0 -1 0.445312 -1 1 v 2 c 0 0.554688 0.445312 1 1 1 h 2 c 0.554688 0 1 -0.445312 1 -1 v -2 c 0 -0.554688 -0.445312 -1 -1 -1 z m 5 1 v 2 h 8 v -2 z m -5 4.015625 c -0.554688 0 -1 0.445313 -1 1 v 1.980469 c 0 0.550781 0.445312 1 1 1 h 2 c 0.554688 0 1 -0.449219 1 -1 v -1.980469 c 0 -0.554687 -0.445312 -1 -1 -1 z m 5 0.984375 v 2 h 8 v -2 z m -5 4 c -0.554688 0 -1 0.445312 -1 1 v 1.980469 c 0 0.550781 0.445312 1 1 1 h 2 c 0.554688 0 1 -0.449219 1 -1 v -1.980469 c 0 -0.554688 -0.445312 -1 -1 -1 z m 5 0.984375 v 2 h 8 v -2 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameLogout: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "system-log-out-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 13 5 v 0.003906 c 0.265625 0 0.519531 0.105469 0.707031 0.289063 l 2 2 c 0.390625 0.390625 0.390625 1.023437 0 1.414062 l -2 2 c -0.1875 0.183594 -0.441406 0.289063 -0.707031 0.285157 v 0.007812 h -1 v -2 h -5 c -0.550781 0 -1 -0.449219 -1 -1 s 0.449219 -1 1 -1 h 5 v -2 z m 0 0\"/>\n        <path d=\"m 9.179688 1.097656 c -2.394532 -0.40625 -4.910157 0.453125 -6.546876 2.398438 c -2.175781 2.597656 -2.175781 6.40625 0 9 c 2.179688 2.597656 5.929688 3.257812 8.863282 1.5625 c 0.230468 -0.132813 0.398437 -0.351563 0.46875 -0.605469 c 0.066406 -0.257813 0.03125 -0.53125 -0.101563 -0.761719 c -0.277343 -0.476562 -0.886719 -0.640625 -1.367187 -0.363281 c -2.105469 1.214844 -4.765625 0.75 -6.328125 -1.117187 c -1.5625 -1.863282 -1.5625 -4.5625 0 -6.429688 c 1.5625 -1.863281 4.222656 -2.332031 6.328125 -1.113281 c 0.480468 0.273437 1.089844 0.113281 1.367187 -0.367188 c 0.132813 -0.226562 0.167969 -0.5 0.101563 -0.757812 c -0.070313 -0.257813 -0.238282 -0.476563 -0.46875 -0.609375 c -0.730469 -0.421875 -1.515625 -0.699219 -2.316406 -0.835938 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameMailAttachment: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "mail-attachment-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 9.75 0.277344 c -0.890625 0 -1.78125 0.339844 -2.457031 1.015625 l -3.5 3.5 c -0.019531 0.019531 -0.039063 0.039062 -0.058594 0.0625 c -0.070313 0.082031 -0.574219 0.628906 -0.792969 1.4375 c -0.21875 0.804687 -0.058594 1.972656 0.839844 2.902343 c 0.914062 0.941407 2.128906 1.09375 2.945312 0.867188 c 0.8125 -0.230469 1.222657 -0.585938 1.5 -0.875 l 1.980469 -1.980469 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 s -1.023437 -0.390625 -1.414062 0 l -2 2 c -0.007813 0.003906 -0.011719 0.011719 -0.019531 0.019531 c 0.113281 -0.117188 -0.289063 0.238281 -0.589844 0.324219 c -0.296875 0.082031 -0.5 0.148437 -0.964844 -0.332031 c -0.484375 -0.5 -0.421875 -0.710938 -0.347656 -0.988282 c 0.074218 -0.273437 0.257812 -0.503906 0.386718 -0.660156 l 3.449219 -3.449219 c 0.644531 -0.644531 1.441407 -0.644531 2.085938 0 l 2.5 2.5 c 0.644531 0.644531 0.644531 1.441407 0 2.085938 l -6 6 s -0.183594 0.183593 -0.472657 0.375 c -0.289062 0.191406 -0.652343 0.332031 -0.820312 0.332031 h -3 c -0.167969 0 -0.449219 -0.113281 -0.667969 -0.332031 s -0.332031 -0.5 -0.332031 -0.667969 v -4 c 0 -0.550781 -0.449219 -1 -1 -1 s -1 0.449219 -1 1 v 4 c 0 0.832031 0.386719 1.550781 0.917969 2.082031 s 1.25 0.917969 2.082031 0.917969 h 3 c 0.832031 0 1.46875 -0.359375 1.929688 -0.667969 c 0.460937 -0.308593 0.777343 -0.625 0.777343 -0.625 l 6 -6 c 1.355469 -1.355469 1.355469 -3.558593 0 -4.914062 l -2.5 -2.5 c -0.675781 -0.675781 -1.566406 -1.015625 -2.457031 -1.015625 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMailCompose: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "mail-message-new-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 3 1 c -1.644531 0 -3 1.355469 -3 3 v 6 c 0 1.644531 1.355469 3 3 3 h 2 c 0.550781 0 1 -0.449219 1 -1 s -0.449219 -1 -1 -1 h -2 c -0.570312 0 -1 -0.429688 -1 -1 v -5.800781 l 4.613281 3.078125 c 0.835938 0.558594 1.9375 0.558594 2.773438 0 l 4.613281 -3.078125 v 3.800781 c 0 0.550781 0.449219 1 1 1 s 1 -0.449219 1 -1 v -4 c 0 -1.644531 -1.355469 -3 -3 -3 z m 0.800781 2 h 8.398438 l -3.921875 2.613281 c -0.171875 0.113281 -0.382813 0.113281 -0.554688 0 z m 7.199219 5 v 3 h -3 v 2 h 3 v 3 h 2 v -3 h 3 v -2 h -3 v -3 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMailForward: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "mail-forward-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 11 2 c 0.265625 0 0.519531 0.105469 0.707031 0.292969 l 4 4 c 0.390625 0.390625 0.390625 1.023437 0 1.414062 l -4 4 c -0.390625 0.390625 -1.023437 0.390625 -1.414062 0 s -0.390625 -1.023437 0 -1.414062 l 2.292969 -2.292969 h -8.585938 c -1.117188 0 -2 0.882812 -2 2 v 3 c 0 0.550781 -0.449219 1 -1 1 s -1 -0.449219 -1 -1 v -3 c 0 -0.023438 0 -0.046875 0.00390625 -0.070312 c 0.03515625 -2.164063 1.82031275 -3.929688 3.99609375 -3.929688 h 8.585938 l -2.292969 -2.292969 c -0.390625 -0.390625 -0.390625 -1.023437 0 -1.414062 c 0.1875 -0.1875 0.441406 -0.292969 0.707031 -0.292969 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMailReply: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "mail-reply-sender-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 5 2 c -0.265625 0 -0.519531 0.105469 -0.707031 0.292969 l -4 4 c -0.3906252 0.390625 -0.3906252 1.023437 0 1.414062 l 4 4 c 0.390625 0.390625 1.023437 0.390625 1.414062 0 s 0.390625 -1.023437 0 -1.414062 l -2.292969 -2.292969 h 8.585938 c 1.117188 0 2 0.882812 2 2 v 3 c 0 0.550781 0.449219 1 1 1 s 1 -0.449219 1 -1 v -3 c 0 -0.023438 0 -0.046875 -0.003906 -0.070312 c -0.035156 -2.164063 -1.820313 -3.929688 -3.996094 -3.929688 h -8.585938 l 2.292969 -2.292969 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMailReplyAll: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "mail-reply-all-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 5 2 c -0.265625 0 -0.519531 0.105469 -0.707031 0.292969 l -4 4 c -0.3906252 0.390625 -0.3906252 1.023437 0 1.414062 l 4 4 c 0.390625 0.390625 1.023437 0.390625 1.414062 0 s 0.390625 -1.023437 0 -1.414062 l -3.292969 -3.292969 l 3.292969 -3.292969 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 z m 4 0 c -0.265625 0 -0.519531 0.105469 -0.707031 0.292969 l -4 4 c -0.390625 0.390625 -0.390625 1.023437 0 1.414062 l 4 4 c 0.390625 0.390625 1.023437 0.390625 1.414062 0 s 0.390625 -1.023437 0 -1.414062 l -2.292969 -2.292969 h 4.585938 c 1.117188 0 2 0.882812 2 2 v 3 c 0 0.550781 0.449219 1 1 1 s 1 -0.449219 1 -1 v -3 c 0 -0.023438 0 -0.046875 -0.003906 -0.070312 c -0.035156 -2.164063 -1.820313 -3.929688 -3.996094 -3.929688 h -4.585938 l 2.292969 -2.292969 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMailSend: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "mail-send-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 0 c -0.257812 0 -0.511719 0.0976562 -0.707031 0.292969 l -1.25 1.25 l -1.761719 1.730469 c -0.191406 0.199218 -0.28125 0.441406 -0.28125 0.75 c 0 0.976562 1 1 1 1 c 0.257812 0 0.527344 -0.128907 0.71875 -0.3125 l 1.28125 -1.28125 v 3.570312 s 0 1 1 1 s 1 -1 1 -1 v -3.570312 l 1.28125 1.28125 c 0.191406 0.183593 0.410156 0.3125 0.71875 0.3125 c 0 0 1 -0.023438 1 -1 c 0 -0.308594 -0.089844 -0.550782 -0.28125 -0.75 l -1.761719 -1.730469 l -1.25 -1.25 c -0.195312 -0.1953128 -0.449219 -0.292969 -0.707031 -0.292969 z m -5 9 c -1.644531 0 -3 1.355469 -3 3 v 4 h 2 v -3.800781 l 4.613281 3.078125 c 0.835938 0.558594 1.9375 0.558594 2.773438 0 l 4.613281 -3.078125 v 3.800781 h 2 v -4 c 0 -1.644531 -1.355469 -3 -3 -3 z m 0.800781 2 h 8.398438 l -3.921875 2.613281 c -0.171875 0.113281 -0.382813 0.113281 -0.554688 0 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaFastForward: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "media-seek-forward-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 2 4 c 0.179688 0 0.359375 0.046875 0.515625 0.140625 l 5 3 c 0.648437 0.386719 0.648437 1.328125 0 1.71875 l -5 3 c -0.15625 0.089844 -0.335937 0.140625 -0.515625 0.136719 c -1 0.003906 -1 -0.996094 -1 -0.996094 v -6 s -0.003906 -1 1 -1 z m 0 0\"/>\n        <path d=\"m 9 4 c 0.179688 0 0.359375 0.046875 0.515625 0.140625 l 5 3 c 0.648437 0.386719 0.648437 1.328125 0 1.71875 l -5 3 c -0.15625 0.089844 -0.335937 0.140625 -0.515625 0.136719 c -1 0.003906 -1 -0.996094 -1 -0.996094 v -6 s -0.003906 -1 1 -1 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaFastRewind: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "media-seek-backward-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 7 4.003906 c -0.179688 -0.003906 -0.359375 0.046875 -0.515625 0.140625 l -5 3 c -0.648437 0.386719 -0.648437 1.328125 0 1.714844 l 5 3 c 0.15625 0.09375 0.335937 0.140625 0.515625 0.140625 v 0.003906 c 1 -0.003906 1 -1 1 -1 v -6 s 0.003906 -1 -1 -1 z m 0 0\"/>\n        <path d=\"m 14 4.003906 c -0.179688 -0.003906 -0.359375 0.046875 -0.515625 0.140625 l -5 3 c -0.648437 0.386719 -0.648437 1.328125 0 1.714844 l 5 3 c 0.15625 0.09375 0.335937 0.140625 0.515625 0.140625 v 0.003906 c 1 -0.003906 1 -1 1 -1 v -6 s 0.003906 -1 -1 -1 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaMusic: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "audio-x-generic-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 1 c -1.644531 0 -3 1.355469 -3 3 v 4.34375 c -0.457031 -0.21875 -0.964844 -0.34375 -1.5 -0.34375 c -1.921875 0 -3.5 1.578125 -3.5 3.5 s 1.578125 3.5 3.5 3.5 c 1.914062 0 3.492188 -1.570312 3.5 -3.480469 c 0 -0.007812 0 -0.011719 0 -0.019531 v -7.5 c 0 -0.5625 0.4375 -1 1 -1 h 4 c 0.5625 0 1 0.4375 1 1 v 4.34375 c -0.457031 -0.21875 -0.964844 -0.34375 -1.5 -0.34375 c -1.921875 0 -3.5 1.578125 -3.5 3.5 s 1.578125 3.5 3.5 3.5 s 3.5 -1.578125 3.5 -3.5 v -7.5 c 0 -1.644531 -1.355469 -3 -3 -3 z m -4.5 9 c 0.839844 0 1.5 0.660156 1.5 1.5 v 0.015625 c -0.007812 0.832031 -0.664062 1.484375 -1.5 1.484375 c -0.839844 0 -1.5 -0.660156 -1.5 -1.5 s 0.660156 -1.5 1.5 -1.5 z m 8 0 c 0.839844 0 1.5 0.660156 1.5 1.5 s -0.660156 1.5 -1.5 1.5 s -1.5 -0.660156 -1.5 -1.5 s 0.660156 -1.5 1.5 -1.5 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaPause: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "media-playback-pause-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 3 1 h 3 c 0.550781 0 1 0.449219 1 1 v 12 c 0 0.550781 -0.449219 1 -1 1 h -3 c -0.550781 0 -1 -0.449219 -1 -1 v -12 c 0 -0.550781 0.449219 -1 1 -1 z m 0 0\"/>\n        <path d=\"m 10 1 h 3 c 0.550781 0 1 0.449219 1 1 v 12 c 0 0.550781 -0.449219 1 -1 1 h -3 c -0.550781 0 -1 -0.449219 -1 -1 v -12 c 0 -0.550781 0.449219 -1 1 -1 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaPhoto: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "image-x-generic-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 6 5 c -0.550781 0 -1 0.449219 -1 1 s 0.449219 1 1 1 s 1 -0.449219 1 -1 s -0.449219 -1 -1 -1 z m 3.5 3 l -2 2 l -1.5 -1 l -2 2 v 0.5 c 0 0.5 0.5 0.5 0.5 0.5 h 7 s 0.472656 -0.035156 0.5 -0.5 v -1 z m 0 0\"/>\n        <path d=\"m 4 1 c -1.644531 0 -3 1.355469 -3 3 v 8 c 0 1.644531 1.355469 3 3 3 h 8 c 1.644531 0 3 -1.355469 3 -3 v -8 c 0 -1.644531 -1.355469 -3 -3 -3 z m 0 2 h 8 c 0.570312 0 1 0.429688 1 1 v 8 c 0 0.570312 -0.429688 1 -1 1 h -8 c -0.570312 0 -1 -0.429688 -1 -1 v -8 c 0 -0.570312 0.429688 -1 1 -1 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaPlay: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "media-playback-start-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 2 2.5 v 11 c 0 1.5 1.269531 1.492188 1.269531 1.492188 h 0.128907 c 0.246093 0.003906 0.488281 -0.050782 0.699218 -0.171876 l 9.796875 -5.597656 c 0.433594 -0.242187 0.65625 -0.734375 0.65625 -1.226562 c 0 -0.492188 -0.222656 -0.984375 -0.65625 -1.222656 l -9.796875 -5.597657 c -0.210937 -0.121093 -0.453125 -0.175781 -0.699218 -0.175781 h -0.128907 s -1.269531 0 -1.269531 1.5 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaRecord: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "media-record-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 15 8 c 0 3.867188 -3.132812 7 -7 7 s -7 -3.132812 -7 -7 s 3.132812 -7 7 -7 s 7 3.132812 7 7 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaReplay: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "media-seek-backward-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 7 4.003906 c -0.179688 -0.003906 -0.359375 0.046875 -0.515625 0.140625 l -5 3 c -0.648437 0.386719 -0.648437 1.328125 0 1.714844 l 5 3 c 0.15625 0.09375 0.335937 0.140625 0.515625 0.140625 v 0.003906 c 1 -0.003906 1 -1 1 -1 v -6 s 0.003906 -1 -1 -1 z m 0 0\"/>\n        <path d=\"m 14 4.003906 c -0.179688 -0.003906 -0.359375 0.046875 -0.515625 0.140625 l -5 3 c -0.648437 0.386719 -0.648437 1.328125 0 1.714844 l 5 3 c 0.15625 0.09375 0.335937 0.140625 0.515625 0.140625 v 0.003906 c 1 -0.003906 1 -1 1 -1 v -6 s 0.003906 -1 -1 -1 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaSkipNext: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "media-skip-forward-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 1 3 v 10 c 0 1 1.085938 1 1.085938 1 h 0.113281 c 0.210937 0 0.417969 -0.046875 0.601562 -0.148438 l 8.398438 -4.800781 c 0.375 -0.207031 0.5625 -0.628906 0.5625 -1.050781 s -0.1875 -0.84375 -0.5625 -1.050781 l -8.398438 -4.800781 c -0.183593 -0.101563 -0.390625 -0.148438 -0.601562 -0.148438 h -0.113281 s -1.085938 0 -1.085938 1 z m 0 0\"/>\n        <path d=\"m 14.5 2 h -1 c -0.277344 0 -0.5 0.222656 -0.5 0.5 v 11 c 0 0.277344 0.222656 0.5 0.5 0.5 h 1 c 0.277344 0 0.5 -0.222656 0.5 -0.5 v -11 c 0 -0.277344 -0.222656 -0.5 -0.5 -0.5 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaSkipPrevious: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "media-skip-backward-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 15 3 v 10 c 0 1 -1.085938 1 -1.085938 1 h -0.113281 c -0.210937 0 -0.417969 -0.046875 -0.601562 -0.148438 l -8.398438 -4.800781 c -0.375 -0.207031 -0.5625 -0.628906 -0.5625 -1.050781 s 0.1875 -0.84375 0.5625 -1.050781 l 8.398438 -4.800781 c 0.183593 -0.101563 0.390625 -0.148438 0.601562 -0.148438 h 0.113281 s 1.085938 0 1.085938 1 z m 0 0\"/>\n        <path d=\"m 1.5 2 h 1 c 0.277344 0 0.5 0.222656 0.5 0.5 v 11 c 0 0.277344 -0.222656 0.5 -0.5 0.5 h -1 c -0.277344 0 -0.5 -0.222656 -0.5 -0.5 v -11 c 0 -0.277344 0.222656 -0.5 0.5 -0.5 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaStop: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "media-playback-stop-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 3.5 2 h 9 c 0.828125 0 1.5 0.671875 1.5 1.5 v 9 c 0 0.828125 -0.671875 1.5 -1.5 1.5 h -9 c -0.828125 0 -1.5 -0.671875 -1.5 -1.5 v -9 c 0 -0.828125 0.671875 -1.5 1.5 -1.5 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMediaVideo: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "video-x-generic-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 0.679688 3.992188 h -0.679688 v 9 h 0.644531 l 4.355469 -4.53125 z m 0 0\"/>\n        <path d=\"m 7 3 c -1.644531 0 -3 1.355469 -3 3 v 5 c 0 1.644531 1.355469 3 3 3 h 6 c 1.644531 0 3 -1.355469 3 -3 v -5 c 0 -1.644531 -1.355469 -3 -3 -3 z m 0 2 h 6 c 0.570312 0 1 0.429688 1 1 v 5 c 0 0.570312 -0.429688 1 -1 1 h -6 c -0.570312 0 -1 -0.429688 -1 -1 v -5 c 0 -0.570312 0.429688 -1 1 -1 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameMenu: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "open-menu-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 1 2 h 14 v 2 h -14 z m 0 0\"/>\n        <path d=\"m 1 7 h 14 v 2 h -14 z m 0 0\"/>\n        <path d=\"m 1 12 h 14 v 2 h -14 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameMenuExpand: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "pan-end-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 4.996094 14 l 6.003906 -6 l -6.003906 -6 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMoreHorizontal: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "view-more-horizontal-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 2 6.007812 c -1.105469 0 -2 0.894532 -2 2 c 0 1.101563 0.894531 2 2 2 s 2 -0.898437 2 -2 c 0 -1.105468 -0.894531 -2 -2 -2 z m 6 0 c -1.105469 0 -2 0.894532 -2 2 c 0 1.101563 0.894531 2 2 2 s 2 -0.898437 2 -2 c 0 -1.105468 -0.894531 -2 -2 -2 z m 6 0 c -1.105469 0 -2 0.894532 -2 2 c 0 1.101563 0.894531 2 2 2 s 2 -0.898437 2 -2 c 0 -1.105468 -0.894531 -2 -2 -2 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMoreVertical: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "view-more-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 7.996094 0 c -1.105469 0 -2 0.894531 -2 2 s 0.894531 2 2 2 c 1.101562 0 2 -0.894531 2 -2 s -0.898438 -2 -2 -2 z m 0 6 c -1.105469 0 -2 0.894531 -2 2 s 0.894531 2 2 2 c 1.101562 0 2 -0.894531 2 -2 s -0.898438 -2 -2 -2 z m 0 6 c -1.105469 0 -2 0.894531 -2 2 s 0.894531 2 2 2 c 1.101562 0 2 -0.894531 2 -2 s -0.898438 -2 -2 -2 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMoveDown: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "go-down-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 1 5 c 0 -0.265625 0.105469 -0.519531 0.292969 -0.707031 c 0.390625 -0.390625 1.023437 -0.390625 1.414062 0 l 5.292969 5.292969 l 5.292969 -5.292969 c 0.390625 -0.390625 1.023437 -0.390625 1.414062 0 c 0.1875 0.1875 0.292969 0.441406 0.292969 0.707031 s -0.105469 0.519531 -0.292969 0.707031 l -6 6 c -0.390625 0.390625 -1.023437 0.390625 -1.414062 0 l -6 -6 c -0.1875 -0.1875 -0.292969 -0.441406 -0.292969 -0.707031 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameMoveUp: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "go-up-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 1 11 c 0 -0.265625 0.105469 -0.519531 0.292969 -0.707031 l 6 -6 c 0.390625 -0.390625 1.023437 -0.390625 1.414062 0 l 6 6 c 0.1875 0.1875 0.292969 0.441406 0.292969 0.707031 s -0.105469 0.519531 -0.292969 0.707031 c -0.390625 0.390625 -1.023437 0.390625 -1.414062 0 l -5.292969 -5.292969 l -5.292969 5.292969 c -0.390625 0.390625 -1.023437 0.390625 -1.414062 0 c -0.1875 -0.1875 -0.292969 -0.441406 -0.292969 -0.707031 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameNavigateBack: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "go-previous-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 12 2 c 0 -0.265625 -0.105469 -0.519531 -0.292969 -0.707031 c -0.390625 -0.390625 -1.023437 -0.390625 -1.414062 0 l -6 6 c -0.1875 0.1875 -0.292969 0.441406 -0.292969 0.707031 s 0.105469 0.519531 0.292969 0.707031 l 6 6 c 0.390625 0.390625 1.023437 0.390625 1.414062 0 c 0.1875 -0.1875 0.292969 -0.441406 0.292969 -0.707031 s -0.105469 -0.519531 -0.292969 -0.707031 l -5.292969 -5.292969 l 5.292969 -5.292969 c 0.1875 -0.1875 0.292969 -0.441406 0.292969 -0.707031 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameNavigateNext: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "go-next-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 4 2 c 0 -0.265625 0.105469 -0.519531 0.292969 -0.707031 c 0.390625 -0.390625 1.023437 -0.390625 1.414062 0 l 6 6 c 0.1875 0.1875 0.292969 0.441406 0.292969 0.707031 s -0.105469 0.519531 -0.292969 0.707031 l -6 6 c -0.390625 0.390625 -1.023437 0.390625 -1.414062 0 c -0.1875 -0.1875 -0.292969 -0.441406 -0.292969 -0.707031 s 0.105469 -0.519531 0.292969 -0.707031 l 5.292969 -5.292969 l -5.292969 -5.292969 c -0.1875 -0.1875 -0.292969 -0.441406 -0.292969 -0.707031 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameQuestion: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "dialog-question-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 0.03125 c -0.398438 0 -0.816406 0.160156 -1.125 0.46875 l -6.375 6.375 c -0.621094 0.621094 -0.621094 1.628906 0 2.25 l 6.375 6.375 c 0.621094 0.621094 1.628906 0.621094 2.25 0 l 6.375 -6.375 c 0.621094 -0.621094 0.621094 -1.628906 0 -2.25 l -6.375 -6.375 c -0.308594 -0.308594 -0.726562 -0.46875 -1.125 -0.46875 z m 0.152344 2.976562 c 0.726562 0.035157 1.433594 0.335938 1.96875 0.871094 c 0.855468 0.855469 1.113281 2.152344 0.652344 3.269532 c -0.332032 0.796874 -0.988282 1.390624 -1.773438 1.671874 v 1.179688 h -2 v -2 c 0 -0.550781 0.449219 -1 1 -1 c 0.40625 0 0.769531 -0.242188 0.925781 -0.617188 c 0.152344 -0.375 0.070313 -0.800781 -0.21875 -1.089843 c -0.289062 -0.289063 -0.714843 -0.371094 -1.089843 -0.21875 c -0.375 0.15625 -0.617188 0.519531 -0.617188 0.925781 h -2 c 0 -1.210938 0.734375 -2.308594 1.851562 -2.769531 c 0.417969 -0.175781 0.863282 -0.246094 1.300782 -0.222657 z m -0.152344 7.992188 c 0.550781 0 1 0.449219 1 1 s -0.449219 1 -1 1 s -1 -0.449219 -1 -1 s 0.449219 -1 1 -1 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameRadioButton: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "radio-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"16px\" height=\"16px\" viewBox=\"0 0 16 16\" version=\"1.1\">\n<g id=\"surface23918\">\n<path style=\" stroke:none;fill-rule:nonzero;fill:rgb(18.039216%,20.392157%,20.392157%);fill-opacity:1;\" d=\"M 8 0 C 3.59375 0 0 3.59375 0 8 C 0 12.40625 3.59375 16 8 16 C 12.402344 16 16 12.40625 16 8 C 16 3.59375 12.402344 0 8 0 Z M 8 2 C 11.320312 2 14 4.679688 14 8 C 14 11.320312 11.320312 14 8 14 C 4.679688 14 2 11.320312 2 8 C 2 4.679688 4.679688 2 8 2 Z M 8 2 \"/>\n</g>\n</svg>\n"),
-	}),
-
-	theme.IconNameRadioButtonChecked: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "radio-checked-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 0 c -4.40625 0 -8 3.59375 -8 8 s 3.59375 8 8 8 s 8 -3.59375 8 -8 s -3.59375 -8 -8 -8 z m 0 2 c 3.320312 0 6 2.679688 6 6 s -2.679688 6 -6 6 s -6 -2.679688 -6 -6 s 2.679688 -6 6 -6 z m -0.007812 3 c -1.652344 0 -3 1.34375 -3 3 s 1.347656 3 3 3 c 1.65625 0 3 -1.34375 3 -3 s -1.34375 -3 -3 -3 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameSearch: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "edit-find-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 6.5 0 c -3.578125 0 -6.5 2.921875 -6.5 6.5 s 2.921875 6.5 6.5 6.5 c 1.429688 0 2.753906 -0.46875 3.828125 -1.257812 l 3.945313 3.945312 c 0.957031 0.9375 2.363281 -0.5 1.40625 -1.4375 l -3.929688 -3.929688 c 0.785156 -1.074218 1.25 -2.394531 1.25 -3.820312 c 0 -3.578125 -2.921875 -6.5 -6.5 -6.5 z m 0 2 c 2.496094 0 4.5 2.003906 4.5 4.5 s -2.003906 4.5 -4.5 4.5 s -4.5 -2.003906 -4.5 -4.5 s 2.003906 -4.5 4.5 -4.5 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameSearchReplace: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "edit-find-replace-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 13.550781 4 c -0.488281 0.003906 -0.988281 0.195312 -1.429687 0.636719 l -7.625 7.625 c -0.316406 0.316406 -0.496094 0.75 -0.496094 1.199219 v 2.539062 h 2.539062 c 0.449219 0 0.882813 -0.179688 1.199219 -0.496094 l 7.625 -7.625 c 1.515625 -1.511718 0.066407 -3.714844 -1.601562 -3.871094 c -0.070313 -0.007812 -0.140625 -0.007812 -0.210938 -0.007812 z m -1.503906 3.054688 l 0.898437 0.898437 l -5.980468 5.980469 l -0.898438 -0.898438 z m 0 0\"/>\n        <path d=\"m 6.5 0 c -3.578125 0 -6.5 2.921875 -6.5 6.5 c 0 2.128906 1.035156 4.027344 2.628906 5.210938 l 1.433594 -1.429688 c -1.242188 -0.796875 -2.0625 -2.1875 -2.0625 -3.78125 c 0 -2.496094 2.003906 -4.5 4.5 -4.5 c 1.59375 0 2.984375 0.820312 3.78125 2.0625 l 1.433594 -1.433594 c -1.1875 -1.59375 -3.085938 -2.628906 -5.214844 -2.628906 z m 7.042969 12.113281 l -1.421875 1.421875 l 2.152344 2.152344 c 0.957031 0.9375 2.363281 -0.5 1.40625 -1.4375 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameSettings: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "applications-system-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 0 c -0.550781 0 -1 0.449219 -1 1 v 0.238281 c 0 0.46875 -0.375 0.902344 -0.820312 1.046875 c -0.023438 0.007813 -0.042969 0.011719 -0.0625 0.019532 c -0.445313 0.148437 -1.003907 0.019531 -1.28125 -0.359376 l -0.140626 -0.195312 c -0.15625 -0.214844 -0.390624 -0.359375 -0.652343 -0.398438 c -0.261719 -0.042968 -0.527344 0.019532 -0.742188 0.175782 c -0.449219 0.324218 -0.550781 0.953125 -0.222656 1.398437 l 0.140625 0.199219 c 0.277344 0.378906 0.226562 0.953125 -0.050781 1.328125 c -0.011719 0.015625 -0.023438 0.035156 -0.035157 0.050781 c -0.273437 0.378906 -0.804687 0.601563 -1.25 0.457032 l -0.230468 -0.074219 c -0.523438 -0.171875 -1.089844 0.117187 -1.257813 0.640625 c -0.171875 0.527344 0.113281 1.089844 0.640625 1.261718 l 0.222656 0.074219 c 0.445313 0.144531 0.738282 0.636719 0.75 1.101563 v 0.070312 c 0.015626 0.464844 -0.304687 0.960938 -0.746093 1.105469 l -0.226563 0.074219 c -0.527344 0.167968 -0.8125 0.734375 -0.640625 1.257812 c 0.167969 0.527344 0.734375 0.8125 1.257813 0.644532 l 0.230468 -0.078126 c 0.445313 -0.144531 0.976563 0.078126 1.25 0.457032 c 0.011719 0.019531 0.027344 0.035156 0.039063 0.050781 c 0.277344 0.375 0.324219 0.953125 0.050781 1.328125 l -0.144531 0.203125 c -0.324219 0.445313 -0.226563 1.070313 0.222656 1.394531 c 0.445313 0.324219 1.070313 0.226563 1.394531 -0.21875 l 0.144532 -0.199218 c 0.273437 -0.375 0.835937 -0.507813 1.277344 -0.359376 c 0.019531 0.007813 0.042968 0.011719 0.0625 0.019532 c 0.445312 0.140625 0.820312 0.578125 0.820312 1.046875 v 0.238281 c 0 0.550781 0.449219 1 1 1 c 0.554688 0 1 -0.449219 1 -1 v -0.238281 c 0 -0.46875 0.378906 -0.90625 0.824219 -1.046875 c 0.019531 -0.007813 0.039062 -0.015625 0.0625 -0.019532 c 0.441406 -0.148437 1.003906 -0.019531 1.277343 0.359376 l 0.144532 0.199218 c 0.324218 0.445313 0.949218 0.542969 1.394531 0.21875 c 0.445313 -0.324218 0.546875 -0.949218 0.222656 -1.394531 l -0.144531 -0.203125 c -0.277344 -0.375 -0.226562 -0.953125 0.050781 -1.328125 c 0.011719 -0.015625 0.023438 -0.03125 0.035157 -0.050781 c 0.273437 -0.378906 0.804687 -0.601563 1.25 -0.457032 l 0.234374 0.078126 c 0.523438 0.167968 1.089844 -0.117188 1.257813 -0.644532 c 0.171875 -0.523437 -0.117187 -1.089844 -0.640625 -1.257812 l -0.230469 -0.074219 c -0.445312 -0.144531 -0.734375 -0.640625 -0.742187 -1.105469 c -0.003906 -0.023437 -0.003906 -0.046875 -0.003906 -0.070312 c -0.015626 -0.464844 0.300781 -0.957032 0.746093 -1.101563 l 0.230469 -0.074219 c 0.523438 -0.171874 0.8125 -0.734374 0.640625 -1.261718 c -0.171875 -0.523438 -0.734375 -0.8125 -1.257813 -0.640625 l -0.230468 0.074219 c -0.445313 0.144531 -0.976563 -0.078126 -1.25 -0.457032 c -0.015625 -0.015625 -0.027344 -0.035156 -0.039063 -0.050781 c -0.277343 -0.375 -0.324219 -0.949219 -0.050781 -1.328125 l 0.144531 -0.199219 c 0.324219 -0.445312 0.226563 -1.074219 -0.222656 -1.398437 c -0.214844 -0.15625 -0.480469 -0.21875 -0.742187 -0.175782 c -0.261719 0.039063 -0.5 0.183594 -0.652344 0.398438 l -0.144532 0.195312 c -0.273437 0.378907 -0.835937 0.507813 -1.277343 0.363282 c -0.023438 -0.007813 -0.042969 -0.015625 -0.066407 -0.023438 c -0.441406 -0.140625 -0.820312 -0.578125 -0.820312 -1.046875 v -0.238281 c 0 -0.550781 -0.449219 -1 -1 -1 z m 0 4 c 0.871094 0 1.675781 0.273438 2.332031 0.742188 c 0.003907 0.007812 0.011719 0.015624 0.019531 0.023437 c 0.011719 0.003906 0.019532 0.007813 0.03125 0.015625 c 0.660157 0.484375 1.160157 1.175781 1.421876 1.976562 v 0.007813 s 0.003906 0.003906 0.003906 0.007813 c 0.292968 0.851562 0.15625 1.65625 0 2.457031 c 0 0 -0.003906 0.003906 -0.003906 0.007812 v 0.003907 c -0.257813 0.800781 -0.757813 1.488281 -1.414063 1.976562 c -0.015625 0.003906 -0.027344 0.011719 -0.039063 0.019531 c -0.007812 0.007813 -0.015624 0.011719 -0.019531 0.019531 c -0.65625 0.46875 -1.460937 0.742188 -2.332031 0.742188 c -0.855469 0 -1.644531 -0.265625 -2.289062 -0.714844 c -0.019532 -0.015625 -0.042969 -0.03125 -0.0625 -0.046875 c -0.011719 -0.007812 -0.023438 -0.015625 -0.035157 -0.019531 c -0.648437 -0.484375 -1.148437 -1.160156 -1.40625 -1.945312 c -0.003906 -0.011719 -0.007812 -0.023438 -0.011719 -0.035157 c -0.003906 -0.007812 -0.007812 -0.011719 -0.011718 -0.019531 c -0.28125 -0.847656 -0.148438 -1.644531 0 -2.4375 c 0.003906 -0.003906 0.007812 -0.011719 0.011718 -0.015625 c 0.003907 -0.015625 0.007813 -0.027344 0.011719 -0.039063 c 0.261719 -0.789062 0.761719 -1.464843 1.414063 -1.945312 c 0.007812 -0.007812 0.019531 -0.011719 0.027344 -0.015625 c 0.019531 -0.015625 0.042968 -0.035156 0.0625 -0.050781 c 0.644531 -0.449219 1.433593 -0.714844 2.289062 -0.714844 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameStorage: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "drive-harddisk-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 4 0 c -1.644531 0 -3 1.355469 -3 3 v 10 c 0 1.644531 1.355469 3 3 3 h 8 c 1.644531 0 3 -1.355469 3 -3 v -10 c 0 -1.644531 -1.355469 -3 -3 -3 z m 0 2 h 8 c 0.570312 0 1 0.429688 1 1 v 9 c 0 0.570312 -0.429688 1 -1 1 h -8 c -0.554688 0 -1 -0.445312 -1 -1 v -9 c 0 -0.554688 0.445312 -1 1 -1 z m 4 1 c -2.210938 0 -4 1.789062 -4 4 v 4 h 4 c 2.5 0 4 -1.789062 4 -4 s -1.789062 -4 -4 -4 z m 0 2 c 1.105469 0 2 0.894531 2 2 s -0.894531 2 -2 2 s -2 -0.894531 -2 -2 s 0.894531 -2 2 -2 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameUpload: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "send-to-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 0 c -0.257812 0 -0.511719 0.0976562 -0.707031 0.292969 l -1.246094 1.25 l -1.765625 1.730469 c -0.191406 0.199218 -0.28125 0.441406 -0.28125 0.75 c 0 0.976562 1 1 1 1 c 0.257812 0 0.527344 -0.128907 0.71875 -0.3125 l 1.28125 -1.28125 v 7.570312 s 0 1 1 1 s 1 -1 1 -1 v -7.570312 l 1.28125 1.28125 c 0.191406 0.183593 0.410156 0.3125 0.71875 0.3125 c 0 0 1 -0.023438 1 -1 c 0 -0.308594 -0.089844 -0.550782 -0.28125 -0.75 l -1.765625 -1.730469 l -1.246094 -1.25 c -0.195312 -0.1953128 -0.449219 -0.292969 -0.707031 -0.292969 z m -3.96875 6.964844 c -1.660156 -0.027344 -3.03125 1.371094 -3.03125 3.035156 v 2.972656 c 0 1.664063 1.371094 3.035156 3.03125 3.035156 h 7.9375 c 1.664062 0 3.03125 -1.371093 3.03125 -3.035156 v -2.972656 c 0 -1.664062 -1.367188 -3.0625 -3.03125 -3.035156 l -1.96875 0.035156 v 2 l 1.96875 -0.035156 c 0.589844 -0.007813 1.03125 0.445312 1.03125 1.035156 v 2.972656 c 0 0.589844 -0.441406 1.035156 -1.03125 1.035156 h -7.9375 c -0.585938 0 -1.03125 -0.445312 -1.03125 -1.035156 v -2.972656 c 0 -0.589844 0.445312 -1.042969 1.03125 -1.035156 l 1.96875 0.035156 v -2 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameViewFullScreen: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "view-fullscreen-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 1 9 c -0.550781 0 -1 0.449219 -1 1 v 6 h 6 c 0.550781 0 1 -0.449219 1 -1 s -0.449219 -1 -1 -1 h -2.585938 l 3.292969 -3.292969 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 s -0.519531 0.105469 -0.707031 0.292969 l -3.292969 3.292969 v -2.585938 c 0 -0.550781 -0.449219 -1 -1 -1 z m 0 0\"/>\n        <path d=\"m 15 7 c 0.550781 0 1 -0.449219 1 -1 v -6 h -6 c -0.550781 0 -1 0.449219 -1 1 s 0.449219 1 1 1 h 2.585938 l -3.292969 3.292969 c -0.390625 0.390625 -0.390625 1.023437 0 1.414062 c 0.1875 0.1875 0.441406 0.292969 0.707031 0.292969 s 0.519531 -0.105469 0.707031 -0.292969 l 3.292969 -3.292969 v 2.585938 c 0 0.550781 0.449219 1 1 1 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameViewRefresh: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "view-refresh-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 7.40625 1 c -0.613281 0.007812 -1.234375 0.089844 -1.847656 0.253906 c -3.273438 0.878906 -5.558594 3.855469 -5.558594 7.246094 s 2.285156 6.367188 5.558594 7.242188 c 3.273437 0.878906 6.742187 -0.558594 8.4375 -3.492188 c 0.277344 -0.480469 0.109375 -1.089844 -0.367188 -1.367188 c -0.476562 -0.273437 -1.089844 -0.109374 -1.367187 0.367188 c -1.246094 2.160156 -3.777344 3.207031 -6.1875 2.5625 c -2.40625 -0.644531 -4.074219 -2.820312 -4.074219 -5.3125 c 0 -2.496094 1.667969 -4.667969 4.074219 -5.3125 c 2.410156 -0.644531 4.941406 0.402344 6.1875 2.5625 c 0.058593 0.085938 0.125 0.164062 0.203125 0.226562 l -0.019532 0.015626 l -0.007812 0.007812 h -1.4375 c -0.550781 0 -1 0.449219 -1 1 c 0 0 0 1 1 1 h 5 v -5 s 0.003906 -1 -1 -1 c -0.550781 0 -1 0.449219 -1 1 v 1.6875 l -0.015625 0.011719 l -0.011719 0.011719 c -1.277344 -2.179688 -3.53125 -3.519532 -5.953125 -3.691407 c -0.203125 -0.015625 -0.40625 -0.019531 -0.613281 -0.019531 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameViewRestore: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "view-restore-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 2 8 c -0.550781 0 -1 0.449219 -1 1 s 0.449219 1 1 1 h 2.585938 l -3.292969 3.292969 c -0.390625 0.390625 -0.390625 1.023437 0 1.414062 s 1.023437 0.390625 1.414062 0 l 3.292969 -3.292969 v 2.585938 c 0 0.550781 0.449219 1 1 1 s 1 -0.449219 1 -1 v -6 z m 0 0\"/>\n        <path d=\"m 14 8 c 0.550781 0 1 -0.449219 1 -1 s -0.449219 -1 -1 -1 h -2.585938 l 3.292969 -3.292969 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 s -1.023437 -0.390625 -1.414062 0 l -3.292969 3.292969 v -2.585938 c 0 -0.550781 -0.449219 -1 -1 -1 s -1 0.449219 -1 1 v 6 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameViewZoomFit: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "zoom-fit-best-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 4 1 c -1.660156 0 -3 1.339844 -3 3 v 8 c 0 1.660156 1.339844 3 3 3 h 8 c 1.660156 0 3 -1.339844 3 -3 v -8 c 0 -1.660156 -1.339844 -3 -3 -3 z m 0 2 h 3.011719 v 2 h -2 v 2 h -2 l -0.011719 -3 c 0 -0.128906 0.027344 -0.253906 0.074219 -0.375 c 0.003906 -0.003906 0.003906 -0.007812 0.007812 -0.011719 c 0.046875 -0.117187 0.117188 -0.21875 0.203125 -0.308593 c 0.007813 -0.007813 0.011719 -0.011719 0.019532 -0.019532 c 0.089843 -0.085937 0.191406 -0.15625 0.308593 -0.203125 c 0.003907 -0.003906 0.007813 -0.007812 0.015625 -0.007812 c 0.117188 -0.046875 0.242188 -0.074219 0.371094 -0.074219 z m 5 0 h 3.011719 c 0.125 0 0.253906 0.027344 0.371093 0.074219 c 0.003907 0.003906 0.011719 0.003906 0.015626 0.007812 c 0.113281 0.046875 0.21875 0.117188 0.304687 0.203125 c 0.007813 0.007813 0.015625 0.011719 0.019531 0.019532 c 0.089844 0.089843 0.160156 0.191406 0.207032 0.308593 c 0 0.003907 0.003906 0.007813 0.007812 0.015625 c 0.046875 0.117188 0.070312 0.242188 0.074219 0.371094 l -0.011719 3 h -2 v -2 h -2 z m -5.988281 6 h 2 v 2 h 2 v 2 h -3.011719 c -0.128906 0 -0.253906 -0.027344 -0.375 -0.074219 c -0.003906 -0.003906 -0.007812 -0.003906 -0.011719 -0.007812 c -0.117187 -0.046875 -0.21875 -0.117188 -0.308593 -0.203125 c -0.007813 -0.007813 -0.011719 -0.011719 -0.019532 -0.019532 c -0.085937 -0.089843 -0.15625 -0.191406 -0.203125 -0.308593 c -0.003906 -0.003907 -0.007812 -0.007813 -0.007812 -0.015625 c -0.046875 -0.117188 -0.074219 -0.242188 -0.074219 -0.371094 z m 7.988281 0 h 2 l 0.011719 3 c -0.003907 0.128906 -0.027344 0.253906 -0.074219 0.375 c -0.003906 0.003906 -0.003906 0.007812 -0.007812 0.011719 c -0.046876 0.117187 -0.117188 0.21875 -0.203126 0.308593 c -0.007812 0.007813 -0.015624 0.011719 -0.019531 0.019532 c -0.089843 0.085937 -0.195312 0.15625 -0.308593 0.203125 c -0.003907 0.003906 -0.011719 0.007812 -0.015626 0.007812 c -0.117187 0.046875 -0.246093 0.074219 -0.371093 0.074219 h -3.011719 v -2 h 2 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameViewZoomIn: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "zoom-in-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 4 1 c -1.660156 0 -3 1.339844 -3 3 v 8 c 0 1.660156 1.339844 3 3 3 h 8 c 1.660156 0 3 -1.339844 3 -3 v -8 c 0 -1.660156 -1.339844 -3 -3 -3 z m 3 3 h 2 v 3 h 3 v 2 h -3 v 3 h -2 v -3 h -3 v -2 h 3 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameViewZoomOut: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "zoom-out-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 4 1 c -1.660156 0 -3 1.339844 -3 3 v 8 c 0 1.660156 1.339844 3 3 3 h 8 c 1.660156 0 3 -1.339844 3 -3 v -8 c 0 -1.660156 -1.339844 -3 -3 -3 z m 0 6 h 8 v 2 h -8 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameVisibility: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "view-reveal-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 8 2 c -3.648438 0.003906 -6.832031 2.476562 -7.738281 6.007812 c 0.914062 3.527344 4.097656 5.988282 7.738281 5.992188 c 3.648438 -0.003906 6.832031 -2.476562 7.738281 -6.011719 c -0.914062 -3.523437 -4.097656 -5.984375 -7.738281 -5.988281 z m 0 2 c 2.210938 0 4 1.789062 4 4 s -1.789062 4 -4 4 s -4 -1.789062 -4 -4 s 1.789062 -4 4 -4 z m 0 2 c -1.105469 0 -2 0.894531 -2 2 s 0.894531 2 2 2 s 2 -0.894531 2 -2 s -0.894531 -2 -2 -2 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameVisibilityOff: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "view-conceal-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 1.53125 0.46875 l -1.0625 1.0625 l 14 14 l 1.0625 -1.0625 l -2.382812 -2.382812 c 1.265624 -1.0625 2.171874 -2.496094 2.589843 -4.097657 c -0.914062 -3.523437 -4.097656 -5.984375 -7.738281 -5.988281 c -1.367188 0.011719 -2.707031 0.371094 -3.894531 1.042969 z m 6.46875 3.53125 c 2.210938 0 4 1.789062 4 4 c -0.003906 0.800781 -0.246094 1.578125 -0.699219 2.238281 l -1.46875 -1.46875 c 0.105469 -0.242187 0.164063 -0.503906 0.167969 -0.769531 c 0 -1.105469 -0.894531 -2 -2 -2 c -0.265625 0.003906 -0.527344 0.0625 -0.769531 0.167969 l -1.46875 -1.46875 c 0.660156 -0.453125 1.4375 -0.695313 2.238281 -0.699219 z m -6.144531 0.917969 c -0.753907 0.898437 -1.296875 1.957031 -1.59375 3.09375 c 0.914062 3.523437 4.097656 5.984375 7.738281 5.988281 c 0.855469 -0.007812 1.703125 -0.152344 2.511719 -0.425781 l -1.667969 -1.667969 c -0.277344 0.058594 -0.5625 0.089844 -0.84375 0.09375 c -2.210938 0 -4 -1.789062 -4 -4 c 0.003906 -0.28125 0.035156 -0.566406 0.09375 -0.84375 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameVolumeDown: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "audio-volume-low-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 7 1.007812 c -0.296875 -0.003906 -0.578125 0.125 -0.769531 0.351563 l -3.230469 3.640625 h -1 c -1.09375 0 -2 0.84375 -2 2 v 2 c 0 1.089844 0.910156 2 2 2 h 1 l 3.230469 3.640625 c 0.210937 0.253906 0.492187 0.363281 0.769531 0.359375 z m 2.957031 2.980469 c -0.199219 0.011719 -0.394531 0.074219 -0.5625 0.203125 c -0.441406 0.332032 -0.53125 0.960938 -0.195312 1.402344 c 1.074219 1.425781 1.074219 3.386719 0 4.8125 c -0.335938 0.441406 -0.246094 1.070312 0.195312 1.402344 c 0.441407 0.332031 1.066407 0.242187 1.398438 -0.195313 c 0.804687 -1.070312 1.207031 -2.339843 1.207031 -3.613281 s -0.402344 -2.542969 -1.207031 -3.613281 c -0.183594 -0.246094 -0.464844 -0.382813 -0.753907 -0.398438 c -0.027343 0 -0.054687 0 -0.085937 0 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameVolumeMute: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "audio-volume-muted-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <g fill=\"#2e3436\">\n        <path d=\"m 7 1.007812 c -0.296875 -0.003906 -0.578125 0.125 -0.769531 0.351563 l -3.230469 3.640625 h -1 c -1.09375 0 -2 0.84375 -2 2 v 2 c 0 1.089844 0.910156 2 2 2 h 1 l 3.230469 3.640625 c 0.210937 0.253906 0.492187 0.363281 0.769531 0.359375 z m 0 0\"/>\n        <path d=\"m 10 5 c -0.265625 0 -0.519531 0.105469 -0.707031 0.292969 c -0.390625 0.390625 -0.390625 1.023437 0 1.414062 l 1.292969 1.292969 l -1.292969 1.292969 c -0.390625 0.390625 -0.390625 1.023437 0 1.414062 s 1.023437 0.390625 1.414062 0 l 1.292969 -1.292969 l 1.292969 1.292969 c 0.390625 0.390625 1.023437 0.390625 1.414062 0 s 0.390625 -1.023437 0 -1.414062 l -1.292969 -1.292969 l 1.292969 -1.292969 c 0.390625 -0.390625 0.390625 -1.023437 0 -1.414062 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 s -0.519531 0.105469 -0.707031 0.292969 l -1.292969 1.292969 l -1.292969 -1.292969 c -0.1875 -0.1875 -0.441406 -0.292969 -0.707031 -0.292969 z m 0 0\"/>\n    </g>\n</svg>\n"),
-	}),
-
-	theme.IconNameVolumeUp: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "audio-volume-high-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 7 1.007812 c -0.296875 -0.003906 -0.578125 0.125 -0.769531 0.351563 l -3.230469 3.640625 h -1 c -1.09375 0 -2 0.84375 -2 2 v 2 c 0 1.089844 0.910156 2 2 2 h 1 l 3.230469 3.640625 c 0.210937 0.253906 0.492187 0.363281 0.769531 0.359375 z m 6.460938 0.960938 c -0.191407 -0.003906 -0.386719 0.054688 -0.558594 0.167969 c -0.457032 0.3125 -0.578125 0.933593 -0.269532 1.390625 c 1.824219 2.707031 1.824219 6.238281 0 8.945312 c -0.308593 0.457032 -0.1875 1.078125 0.269532 1.390625 c 0.457031 0.308594 1.078125 0.1875 1.390625 -0.269531 c 1.136719 -1.691406 1.707031 -3.640625 1.707031 -5.59375 s -0.570312 -3.902344 -1.707031 -5.59375 c -0.195313 -0.285156 -0.511719 -0.4375 -0.832031 -0.4375 z m -3.421876 2.019531 c -0.222656 -0.007812 -0.453124 0.058594 -0.644531 0.203125 c -0.261719 0.199219 -0.394531 0.5 -0.394531 0.804688 v 0.058594 c 0.011719 0.191406 0.074219 0.375 0.199219 0.535156 c 1.074219 1.429687 1.074219 3.390625 0 4.816406 c -0.125 0.164062 -0.1875 0.347656 -0.199219 0.535156 v 0.0625 c 0 0.304688 0.132812 0.605469 0.394531 0.804688 c 0.441407 0.332031 1.066407 0.242187 1.398438 -0.199219 c 0.804687 -1.066406 1.207031 -2.335937 1.207031 -3.609375 s -0.402344 -2.542969 -1.207031 -3.613281 c -0.183594 -0.246094 -0.464844 -0.382813 -0.753907 -0.398438 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
-
-	theme.IconNameWarning: theme.NewThemedResource(&fyne.StaticResource{
-		StaticName:    "dialog-warning-symbolic.svg",
-		StaticContent: []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg height=\"16px\" viewBox=\"0 0 16 16\" width=\"16px\" xmlns=\"http://www.w3.org/2000/svg\">\n    <path d=\"m 7.90625 0.09375 c -0.527344 -0.0273438 -1.039062 0.28125 -1.4375 0.96875 l -6.25 11.59375 c -0.535156 0.964844 0.046875 2.34375 1.09375 2.34375 h 13.15625 c 0.980469 0 1.902344 -1.160156 1.21875 -2.34375 l -6.3125 -11.53125 c -0.398438 -0.644531 -0.941406 -1.003906 -1.46875 -1.03125 z m 1.09375 3.90625 v 5 c 0.007812 0.527344 -0.472656 1 -1 1 s -1.007812 -0.472656 -1 -1 v -5 z m -1 7 c 0.550781 0 1 0.449219 1 1 s -0.449219 1 -1 1 s -1 -0.449219 -1 -1 s 0.449219 -1 1 -1 z m 0 0\" fill=\"#2e3436\"/>\n</svg>\n"),
-	}),
+	theme.IconNameRadioButtonChecked: {
+		Name:     "radio-checked-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\x90M\x8e\xa30\x10\x85\xf7\x9c\xe2ɳ\xb6)\xbb\xc08# \xd2,\xe6\x043\ah\x11\x1a\x90\xf8\x89b\x04\xa8O\xdf2$\x1dD-\xf8>\xbd\xb2\xfc\x9c_\xb7\xa1\xc7R?|7\x8d\x85Њ\x04걚n\xdd\xd8\x14\xe2\xff\xbf\xbf҉k\x19\xe5~i\xd0\xd6]\xd3΅\xd0\xf6\xbe\t,]\xbd\xfe\x99\xb6B\x10\b\xdaB[\x81\xb5\xbb\xcd\xed+\xb0\r\xfd\xe8\v\xd1\xce\xf3\xfdw\x1c\xaf\xeb\xaaVVӣ\x89\r\x11\xc5~iD\x19\x01@~\xff\x98[\xdc\n1\xc0\x81PA&*!kR\x10\xa4\x03\xab\xf4\xc2Y\x1a~\x1d\xfc\x0f:\x1c\xec \xdfJ\x06#O\x1b\xc7|a\x00\xc1\xa0\x02+6\xc4ڀ`a\x94\xcd.\xd69Xذwb\xf9T\xf6d\xe5\xae=\xcebW\xe1xI\x8a(sڀC\x03\xadlj8I@\x90\f\xad8\xd9/\xc4`\xf8\x1d3\x9bZ\xf0\xfeU\b\xe9\xa3/C\xbe\xc2\x1c\xe2\xfe͒\x9fs\xb4!\x81Ϯ\xef\v\xf1\xcbԜ\xb0\x15q\x19\xe5\xe1U\xcb\xe8{\x00\x8f\xa8\xfb\x03\xd4\x01\x00\x00",
+	},
+
+	theme.IconNameSearch: {
+		Name:     "edit-find-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffTQK\xae\x9c0\x10\xdc\xcf)J\xceڦ\xfdŎ\x86yR\x169Ar\x80\x88!\x80\xc4g\xf4@\x80r\xfa\xa8\xe1\xcdoa\xd4U\xd5\xea\xeaj\xce\x1f[\xdfa\xa9>\xa7v\x1c\n\xa1\x15\tTC9^ۡ.\xc4\xef_?e\x14\x1f\x97\xd3yZj4U[7s!t\xb8m\x02K[\xad?ƭ\x10\x04\x82\x0e\xd0A`m\xafsso\xd8\xfan\x98\n\xd1\xcc\xf3\xed{\x96\xad\xeb\xaaV\xab\xc6\xcf:3D\x94MK-.'\x008\xdf\xfe\xcc\r\xae\x85\xe8\x11\x94\a\xa1\x84\xb4\xca\xe7Q\x1bF\x92I\xa3\x92\xd11\xf7\a\xe27=\xb9;ů\x84VΤ\x10#\bF\xe5\xde&\n\x90\xa4\\\xe0V\xab\xa2\xd9\xe7J\xad\xccn\x81\x0eV%筶\xf7\u00a0\x04\xa9\xe4s\xb2\x9a\v\x9b\xf3\x026X\x135\x8f\xf2\xecA\xe1\x98\xe2X\xedx\xe3t\xd8>+\x9e\x92G\xaf}\xe0Fʝ\xd1\x11\xec\vi\x94ݭ\xbe \xafE_\xc6/\xe1\xe5{\xec\xe7\xe7\x1fz\x8e\x87\x12F\xb9\x14(9\x10\xdc~'\xa2=1\x03\xb7\x9fI\xbeq\xf2E\xd8\xf1C\x95\x0fy\xc2;\xf9\x10\x0e[\x12\xf8\xdbv]!\xbe\x99\xca:\x1bDv9\x9d\xf9\x7f^N\xff\a\x00\x11\xdf3\xa9N\x02\x00\x00",
+	},
+
+	theme.IconNameSearchReplace: {
+		Name:     "edit-find-replace-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflSI\xae\x1aA\f\xdds\n\xab\xb2n\xe3\xa1ƈ\xe6KY\xe4\x04\xc9\x01\">i\x90\x9aA\x1f\x04(\xa7\x8f\\=@\xbe\xb2h\xf4\xca\xe5z~~6\xab\xb7ǡ\x87\xdb\xf6\xe3\xb2?\x1d[\xc7H\x0e\xb6\xc7\xcd\xe9}\x7f\xecZ\xf7\xf3\xc7\xf7&\xbb\xb7\xf5bu\xb9u\xb0\xdb\xee\xbbݵu\x1c\xcf\x0f\a\xb7\xfd\xf6\xfe\xed\xf4h\x1d\x01\x01G\xe0\xe8\xe0\xbe\x7f\xbf\ue984ǡ?^Z\xb7\xbb^\xcf_\x97\xcb\xfb\xfd\x8ew\xc5\xd3G\xb7\x14\"Z^n\x9d[/\x00\x00V\x1d\xfc\xde\xf7}\xeb\xbe\xc8V\xbd\xc61\\\xafο\xae;xo\xdd\x01X1\x04J\x99\xc1\xc3\x06\x1aB\x9f\xb3d\x06B\"-\x14-T\xa6\x10\x97\xa0,\xd00z)1' \x8c\x1a\x13\x17\xe8\xa1I\x18%\xc0\xf0[\x99\x94\xa3\xa7\b30\xf2\x12\xa9x L\xe1\xe5\xc8ȥ\b\x17\xb8\x81`\xb0\xaa\x02\xbb'\xdc\x00\xa1\xf75\x81\x80\xb0\x8aQ{ΩĜ\x9fϟ\x8c\xfd\xa8\xa3\x99\xe40\x06\x0e5b\x88\x13g\xeb0FO\t\x1a\xc5\xc4>{o\x97\x91,\xcfb9\xb1Q\xd5V(\x91\x0e5ɼ\x92Z\xddS%|\x8d\tS\xd1\xfc\x12\xfb\x03\x87Zr\xf0R\x91\x827ɽ\xf5Q\xb2\xd7\xf4\x04=4\x01K&\x1f3\x8c\xa0\xfa:&\xe4\x17d\xac\x04\xe4\x96\xff\x1di\xc4\x00d\xba\x15\x83\x89\xb0ScA\xc1\"\x9cS\x18N\xf6m\x80@\x90%\x9b:FRs\t<\x92$\xf5\x1e\x04\xe3p\x15\xa6\xcez`\xf4\xaa\xa1\xf8y\v\xb2\x95b\x14/\x9c\xabH\x9b\x8a\x15\x11\x1c\f\x12\xe4\x7fΊ\x83*+\xde\xc842\x99\x17\xcec\x00\xfb*\xa8\xa3+\x9aBUZ\x9d\n晐\xb2\xc0D5R\x7fR7\xa0\xaanP0Q5\x8a\x94C\x9d\xd4\xdcbc=\x0e[0\xc7\xcc\xe7\x84dm\x16`Af\x95\xcc\xd0\x0f\xbdW\xce\x19\xf4fd\x90\xc1\xb6\x11\xd8\xe6\x96`\xbbc\xc0*\vj\xac\x1c\ra}L\xe3R־>\xcdu\xb5\xec\u058b\x95\xfd\xa5\u05cb\xbf\x03\x001\x10v\x15Q\x04\x00\x00",
+	},
+
+	theme.IconNameSettings: {
+		Name:     "applications-system-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x84X]Nd7\x13}g\x15\xa5\xfb=\xdbT\xf9ߟhF\xcaCV\x90, \xbaC\x00\xa9\x81р\x1a\x94\xd5G.\xdbe\xbb\xbb\x93hF\xc2\xf8\xda\xe5\xfa9u\x8e\xcdݷ\xaf\x97#\x9c\x1e~\xbe?\xbf\xbd\x1e6Ҹ\xc1\xc3\xeb\xfe\xf6\xfd\xf9\xf5\xf1\xb0\xfd\xfeۯ*m\xdf\xeeo\xee\xdeO\x8f\xf0\xf4\xf0\xfc\xf8\xf4q\xd8(\xfc\xf8\xda\xe0\xf4\xfc\xf0\xf9\xcb\xdb\xd7aC@\xa0\x00\x146\xf8|\xfe\xfe\xf1\xd4\x17|\xbd\x1c_\xdf\x0f\xdb\xd3\xc7Ǐ\xff\xdf\xde~~~\xeaO\xab\xdf~>\xde\x1aD\xbc}?=n\xf77\x00\x00w?\xfe\xf8x\x82\xef\x87\xed\x05\x12 \xec\xa0P{\x8f1\x11 (\x02\xd4\xceeC\xb9\x8c\tN\x80\xda\xd8d\x12\xc1\x0eX>\x86\x14}\xd9c\xa3\a\xd4\x19\x8du\xae\xfc\x9e\fZ2@\x1a\xeb\x126\\\xbe\xda\x04\xa8\xb1\x1c`yʙ\x1cr\x99\"\x8a\xe5\x18\xd4\x18\x8c\xe7\x89쭩\x1b\x9d\xf3\x96,\xa0&\x97\x9c\x8d\xa0H#ڌ\xb1\xaf\xa32e\x12\x99\xea\x8c\xcf6\x068\x9619\f&\xf0\xa8\xack\x06ɇ\xba\xd4\x14\x8b\xec\xb1\xcd\x18\x8c\x93\xed\xfc5\xf8\xe2q\xfd\x9a\x8a\xeb\xbc\xd9\x04q\xb5x\x9f\xcațX\"\x17\xb7\x15\xea\xe8\f\xa5\x12-E\x1f\x93D\xc2\xd9DmM\xf9<\xa7[\xb3\x7f\xd5+c\x82\x0f@\xf5\xd8\bGh\x81\xf82\xc8lb\a\xd4&\xb6SmL\x19C\x99)\x1b\xcdb\f\xab}Ҷ&\xa8\x96\xa2\xa6\xbb\fz*Fu\xac'\xcf)\xe3Q\x04\xb1QÏ\xb6\xf8$\x87\x96js\x95\x01u(\xe6,\x17\xc3\x03j\xe7#ZS\valYTF\x18]\r\x80\xf3Ƈ*\xd4\x14\x89\xb1D\x1aSN\x1c\x15\xf1\\\xb5ƀA\x1dj\x16\xf6iǔ|\xa2\x12#L&\xdaz\xaaEK\x9cɖ\xddɑ\x05ae\x04\xa8c\xc1\xb9)&l\xa8\xa9\x8a\xc5\x0e\xd5\bO\xbc\x1d+\xa0Z\x16\x037\x84\xe0\xa9\xe7$\a\xcc5\xc4\xe8ʐ\x8dx\x17r\xcbKq\xc6\xc2yZzD!6\x84q\xed\x8a[\xae\x83SB+ɩ~\xf0\xf2<\xa7\xa4\xado\xfb\x97L:ϵYK\x93Ȅ9#jJI\x8e\xe2*/[\xab\xbc\xc3\x02\xab\\\xf7\xa04FEU\x19d\fv\x06\xd5\x02dߛ#\x0f\x14_\x82\xf8\xb88\xc6d\xd3P!{G\x00-\xc5T+fai06\xb1@\xa0YX\x967\x03my\xb1\xc9\xd8;vĘ\xca0\xd9Pj\x01q\x93\b3&&\x95\xf2\xbb\xaf\xdcG=\xe6\xc1W\xfb\x927luj\x1c3\xe7\xf6\x8c \x9b\xebf&\x89ƿ\xa8\x19\x1b\x1e.\x19\xf9\x82˅\x87\x1aG\x11\xff\xdb\xf9\x8b\v)\x01\x02M\x14F\xa0\x8a \xa8Ŋ\xea\x920\xb3C\x16\x9f\\U\x92!\n\x83\xbe爙\x8aA\xb5\xb6\xf2=du\x16s\t\xb6\u0080I\xb2iB\x90uԓlAr<\x15l\xa9W\xa3\xe3\t\x05\xd9\xe5:\xe3\xabDI\xf1{\xed\xd7&\x11\x03^4Q,4\xb4)11\xa3W\xad\xf0\x9dP\x11\xbd\x80\x97\xb3q\xde\fj\xa2\xf4E@%kB\xae\xd8wVBWg\xcd7\xb0\xdad\xa4\x11\xba\x12F\xe7VW\x13\xa3\xa3.ƣ\x83\x854|א\xc6[\xc2Ī\xb1y\x1a\x14\xa4\x84\x83\xca\xceN\xff\xcdF\x045\xf3\xff\xb0\xd2XO\t\xed\rq\xc9\xe7\xe2\xd2\xdab\xc9\xf5\x05\x7fv\xa5\xe6s\x1a1\xf3\xee\tM\xe2\xd14'e\x9e\xe6\xba (Q\x04%\x92\x80\xdab-[\xa9$gQ\x14A\x89\xae\x1c\xe1j0\x17B\xe9:\xa3\xf7\x90\x1c,\xb9\xa9j7\xcb\xe4\"\xb6#\v\x89\xa6l\xda)/\xb3h_I뤕J\x94a(\x88:\x03\xcc>\x01s\x1d\x99\x8e\xf7\xf9\xda\xc1\n\xa1\xce\xef\x1d\xb1_Ƣ\x97\x96\xcb\xd2i\x19ԕ\xd68.~Nw\xa7\xb1[سѼ\xa2\x1e\xaeZ{\x97if_\xaf\x8d\x12\x88K\xbdj\xa6\xe7\xbb\xe3j\xbd\x02\xb6\xeb\xe3\x1c\xa6\a\xd4T$\xc2ɭ\x93\x01S/\x9d\x83-*i\x8dk\xecz\x15㓚ЈΨ\x99\x03\x835\u074b\x89\x1b\xce/\xe3k}Bp\x18\xa7\x1d\r\x02\x83|{\x175\x81Q\xa29\xd3ܤ:\x17\x82\xd1tG\xcdO\x8d\xfa\xff/x\x01\x04W\x16\xea\x14\t\xb3\x03\x04ҡ$\x93:q%0\xda\xdar\x0eȝ{\x87֖\xb1+\xa9\x81\xf3;\xaf[o)\xb6\xd6v^U\xfbZDgQ\xa8F\xa75Me[\bX\xaf\xca,F\x1eHS\x9d\xa1Z|\x02\xd2\f\x87\x00T\xdb\xc5\xc0i\xd8|_\x0e\xbc2\xe0U\xe5 \x93\xdb} \x95vaD\xf8z\x0f\f\xfc\x13\xc1Ԧk\xba\x0ej\xb1#\\\xb5\x98\xee\xbep\xca*\xb4\xfa=1\tk\xc5:E\xda%\xae\x9f\"\xcd0\xb0#\xa2}՟q\xdax\x1e\x89J\xc95\xb0\x16a\x17,\x9a\xf5\x858\x8a%[\xa5l\xd3\xd6\x16}\x7f\x92\x92fj\x8d\x03\x14\xea\x1aNT\xc9#s>\x96=ahr\xb5\xa7\x8c6\xa9_Gb\xed\xfa}\xba\x89\xac\xbd2\xfaG\x1e_\xf2iz\x06\x8f@j\xb8k;\x0esC\xac\xe78\xdb\x13X\x90\xa6hz\x163蘮D\v\xb2\x1b\x841U\xe4?\x9c\x90\xafՉ\xf3\xddc\xcf\x18\x8d=\xc4\xeas\xe6\xb8<ϓ\x8b\xc1\x8f\x9b[\x9a\x12\x8f\xa0\x8c\xe6\xa8\xf6\x7fE\xeb\xfa\x82\x1d\xa7I;6(\xafh\x14H\xfd#\x1aw\x10~V\xa8c\xad<\xeaئ\xa8\x8a\xb9\x05A\xfe\x9c\xdf+iY\xee\xb7\xe2\xf0\x90\xbc\xc9\xe1i]wX\xfe\xba0\xdeNay\xd3\xef\xfd\x1d\xb7\\̵\xb3E\x04&\xc4^\x01q\xe5V\xdc\xe0\xcf\xe7\xe3\xf1\xb0\xfd\xcf<Xg\xc3v{\x7fsW\xfe<t\x7f\xf3\xf7\x00\xbc\xf13\x01\x9d\x12\x00\x00",
+	},
+
+	theme.IconNameStorage: {
+		Name:     "drive-harddisk-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\x92ݎ\x9b0\x10\x85\xef\xf3\x14G\xee\xf5\x98\x19۰\xa6\nY\xa9\x17}\x82\xf6\x01\xaa\x84b\xa4\xfciA\x10\xf5\xe9\xabq\xb2\xac\x85/<\xc7\x1f\xdf\xc0\xc8\xfb\xf7\xc7匥\xff\x98\xc6۵3b٠\xbf\x1eo\xa7\xf1:t\xe6\xf7\xaf\x9f\x14\xcd\xfba\xb7\x9f\x96\x01\xa9\x1f\x874wF\x9a\xfb\xc3`\x19\xfb\xf5\xc7\xed\xd1\x19\x06C\x1aHc\xb0\x8e\xa79}\x02\x8f\xcb\xf9:u&\xcd\xf3\xfd{U\xad\xebjWoo\x1fC嘹\x9a\x96\xc1\x1cv\x00\xb0\xbf\xff\x99\x13N\x9d\xb9 \x80q\x04\x89mB\xa8\xbd\x80A\x1eb}]\x87\xa6ս\xc7\x02Q\x86\xb1A۹\xcfOB\xc4\x11\x85\u0083\n\x84TA/\xc7W'*\xbb<\xd7?\\\xc0p/!\xdb\xfa\x8d\xbd80\x04l\x83k\x9b\x18!\x10,h\xb3l#\xa88&%\x12H\x15\xc4V[Ę;gL{\xbb\\\xa8\x87\x9e\xa2\x82+\x88\xcc\xe87\x05\x88ʜu\u00adϲ\x00\xb1o\xb1\xe5\xc6\xe9>`A@B\xc0\x11\xce\xd6`\x04\xd0\x06\x04E\xa6\"\xa0\xf0Z\x9f?\xac\xd3\x13\xce\xc3Вml\xf3\x8c\x1c\x9c\xbeX\xd4\xf4\x8a\\\x91R\x8e'\x94A\x8e\x9ez6\xf8;\x9eϝ\xf9\xe6z\x1f|c\xaa\xc3n\xaf\xb7\xe1\xb0\xfb?\x00\xc8U\xcf\x05\x8c\x02\x00\x00",
+	},
+
+	theme.IconNameUpload: {
+		Name:     "send-to-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfftT\xed\x8e\xda0\x10\xfc\xcfS\x8c\xdc\xdf6\xbb\xfevE8\xa9?\xfa\x04\xed\x03T9J\x90\xf88\x1d(A}\xfa\xcaN\xe2\xe4t\xad\x90\x88\x19vf=\xb3\v\xbb\x97\xe7\xe5\x8c\xfe\xf0~?ݮ\x8d`E\x02\x87k{{=]\x8f\x8d\xf8\xf9㻌\xe2e\xbf\xd9\xdd\xfb#\xba\xc3\xe9\xd8=\x1a\xc1\xfe\xed)П\x0e÷۳\x11\x04\x02{\xb0\x17\x18N\xaf\x8fn.x^\xce\xd7{#\xba\xc7\xe3\xed\xebv;\f\x83\x1a\x8c\xba\xbd\x1f\xb7\x9a\x88\xb6\xf7\xfe(\xf6\x1b\x00ؽ\xfdztxm\xc4\x05\x11\x84\x16\x92\x94v!\xb2\x06\xe5\xb3c\x0e\x9c@\x8aR\xf0\xce\xeb\x8c\x05\nd\x18\xa4t\xd2\xc9'\x9c!Yi\xeb)Y\xb0\xd2n\x04J\xb9\x03\xab`\xc8\xfa4JsbK\x1e\xf9\x904\xc7\xd2-\xb2v em\xf9j\x85\x04\x87\x16\x04RSk\x9e^-Vw$\xe5t0\xd6\x16u\x1d\x13\x85\xcc\xe4\x18\\F\f\x97\xeb\xf0\xa4)\xe7C\x8f\xa0\\v\xa1q\aM\xbaw0$\x8fo=d\xadX\xf8\xf3\xb3\xc5\xdaJ4.\x99쀉\x9d\xc7\xd4u\xbe\xc5\xf4\xb18\xc9ڤH\x1bk\xe2ا\x1dc6\x14]*\x1e(\xa68\xbaq\x8eB\xd4K \xb2$\xb2\xceV\xd6p\xd7#\x90e\x06S\xdc.\x1b\xa8\xa7\x12\xb8\xb5Is\x82\xac\xf3[F\xba`\x7fp\x814*\xf9l\xc1\xab\xe4m\xbeU\x9bŽ/6\x8b\x911y\xa3Ȍ\xf1\x98\xc0\x94VH~\xba\\\xddC\xab\x14\xb4w\xbeX\xce*\x96\xbcY(\xb5\xf2\x13\xb5CPɔe\x98h\x1aT\xcb\xe4\xa4`\x16d\xd5T~\xe8*+?\xb3|\xe0\x18K\xb5\x9fY\x1f\xe9%\xd41\x01Z\xf9(\xfb0²\xe2y#\\\x1d\x1dQ\x88\x9c͑\x99\x97\xbb\f\x82kP\xff\x8ee\xad1\xfd\x1ej\xa9\xfcD\xee k22S]2\x11\xb4T\xca\xdax\x81\xf8\xbf\xe1\xd4\xe6\x1fX\xb6\xac\xc3g\xfa\x12\x02\xad\x15\xcb\xe2\x10H\xe0\xf7\xe9|n\xc4\x17}0\xd6x\xb1\xddov\xf9og\xbf\xf9;\x00*b\xedf\xf5\x04\x00\x00",
+	},
+
+	theme.IconNameViewFullScreen: {
+		Name:     "view-fullscreen-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x84\x93]\x8e\x9c0\x10\x84\xdf\xe7\x14%\xe7٦\xdb\xc6\x06G0+\xe5!'H\x0e\x101\x04\x90\x98\x1f-Ȍr\xfa\x88\t\xeb\xf5d\x91V \xb9\xd4.\xa0\xebk\\\xbd\xdc\xcf#B\xfb:\r\xd7K-X\x91@{i\xae\xa7\xe1\xd2\xd5\xe2\xe7\x8f\xef\xb2\x14/\xc7C5\x85\x0e};t\xfd\\\vv\xb7\xbb@\x18\xda\xe5\xdb\xf5^\v\x02\x81\x1d\xd8\t,\xc3i\xee\xdf\f\xf7\xf3x\x99j\xd1\xcf\xf3\xedk\x96-ˢ\x16\xa3\xae\xaf]\xa6\x89(\x9bB'\x8e\a\x00\xa8:\xfc\x1eƱ\x16_tkr\xe3\xb6\xf2c\xeb\xf6k\xeeq\xaa\xc5\x19\f\x8f\x06\x92\x94\xb5T\x94\f\x82d\x90\xcas\xafٯ\x9a\x11\xe0\xd0áA\xe2b\xc8\xe8\xe2\xd57%\x05\xc9\xdb\xddCjeK\xebM\x89\x11Fi\xaf\xbd\xf3\x90Q\xad\xef4\x9e\x9c\xb6\x90Q\xbd\x97X\x916\xb9)\x1em\xa9\x9csr\xfa_\xbb\\\x166]\xf3\xc7\xe6\xaa\u07beA\xaa\xa0\x82\f'\xb5G\x8f\x96\xbd5\fRL6\x7f2Fߘt\x18EH\xb24\xa0\x84\xd9N\xf0?8\x83@\"ۇnQ|\x823@\xaeԥ\xfbl:\x13\x92\a\u05ebG\x82|'G\xb3K:\xaa\x04yB|\x03\x1d\x97\rw$\xf6\x91ᄈZF\xd6;3\xd9\xfd-\x02\x9eP\xbf\xe7\x7f\x8e\xfa\x1f\xe5*뎇j=\x02\xc7\xc3\xdf\x01\x00݆\xbb\xf2\x81\x03\x00\x00",
+	},
+
+	theme.IconNameViewRefresh: {
+		Name:     "view-refresh-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x84Sۊ[;\f}\xcfW\b\x9fg+\x92l\xcb\xf6!;\x03}\xe8\x17\xb4\x1fP2iv \x97a\x12\x92Я/\xf2\xbed\xa6\x14\xca\x0ed\xb1\xac\xcbZ\x92\xbdzy\x1c\x0fp۾_\xf6\xe7S\xe7\x18\xc9\xc1\xf6\xb49\xbf\xeeO\xbb\xce}\xff\xf6\xd5\x17\xf7\xb2^\xac.\xb7\x1d\xf4\xdb\xfd\xae\xbfv\x8e\xf5\xed\xe1\xe0\xb6\xdf\u07bf\x9c\x1f\x9d# `\x05V\a\xf7\xfd뵟\x02\x1e\xc7\xc3\xe9ҹ\xfez}\xfb\x7f\xb9\xbc\xdf\xefx\x0fx~\xdf-\x85\x88\x96\x97\xdbέ\x17\x00\x00\xab\xb7\x1f\xd7\x1e^;w\x84\x8c\x91T\x120l\xc0\x13*\a)\f\x84D\xb9\xb0\x80g\x94\x10CNF\x95Zb4\xaaĬI\x81PR\xa8\xa4\x96\x1aPr\x88\xa1\x00a\xc9\xc5H\x9f0\xa5\x92j\x84\x80%\xa5\xa8\xf5\x03\x95Q\xa2R\x8dp\x01A)\x89\x93\x82b\xd0̥\xc0\xa7(1f\x03c\xfd\xfc\xac\xaf\x98\xed0\x9b\xec1\xbe`\x93\xea\x03\xc6:\xe6\x11J\xce\xc1d\x13\xc6B\xa6\x82\x90\xa9\xb68\x9e=\xd1\xd4\xdb\xf3\x84\xda<\x9aQ14\xb6\xff\x94\xd3\xea\xc49\xc7\xc4=\x93y\xb2(\xc8Jf\xd0\ã\x98\x80B\x99\x02\x83W\xe4\x92\x13\b&\xdb\xc2\x06\xbc\x8c\v\xb1eĘ,&\"\x99\xd5j\x87E(\xb0|\xe4\x12\x06n\xa9Ԓk\xebɨ\x9a\xab\x8d<N\xe8/\x19\x82\x91\aes\xb3\x885r$\x05\xc2HbZ\xffTHH6\xed`\xc0\xfem\xe5V\x8e\x90դ\x03a\xd3h\x8cH\x1b\xdf\xc1\x1a\x10\xd7\x14\xec\xd4:\x8a\x8e\xe4p\xcdf\xd0\xdb\xdc\xda\x127\xc3^\x8d\x06\x02\xcf&ȶZ\rs\xb3k\x1f\xb7\xaf\x87\x047\xf0\t.\xadT\xbb\x94\x9e\xc7\xdf?*\xddlX\xe6pT\xd9l\x1a\xe0\xccu\"\x1b\x9e\xc1\xb0\xdd\xf1^\tr\xaejW'`j\xbe\r\ff}\xc2:sZ9R\x1e\xe4\x8c\x13z6\xf4\xf4\xdc{\x9b\x14\x7fx\x8eO\xee\x17\x1c\x81\x80\x1c\xfc\xdc\x1f\x0e\x9d\xfbO\xb6!\x06u\xcb\xf5be\xef{\xbd\xf8=\x00l\xefU\x8e^\x04\x00\x00",
+	},
+
+	theme.IconNameViewRestore: {
+		Name:     "view-restore-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x92M\x8e\x9c0\x10\x85\xf7}\x8a'gmSe\x83\a\"`\xa4,r\x82\xe4\x00\x11M\x00\x89\x9f\xd6\x18\x19\x94\xd3G\x8dz\xc6n5\x82E\xf1\xaax<\xber\xf9\xbeO#|\xfb\xe1\x86e\xae\x04+\x12h\xe7f\xb9\x0esW\x89߿~\xca\\\xbcח\xd2\xf9\x0e};t\xfdZ\t\xb6\xb7]\xc0\x0f\xed\xf6c\xd9+A \xb0\x05[\x81m\xb8\xae\xfd\xe7\xc0>\x8d\xb3\xabD\xbf\xae\xb7\xefI\xb2m\x9bڌZ>\xbaD\x13Q\xe2|'\xea\v\x00\x94\x1d\xfe\x0e\xe3X\x89o\xba5\xa9\xb1\x0f\xf9h\xdd\xfe\xac=\xae\x95\x98\xa0\x91\xa3\x81$\x95e\xf4\x963\b\x92A*M\v\xcdŽf\xb8\xf0\xcc\xc7\xd5C\xab,\xcf\n\x93c\x844J\x17\xba\xb0\x05\xbe\x8a\xc3\xcf\x14du\x86\xaf\"H\xacH\x9bԼ\x81\xc0*唬\x86\x8b\xd40\xf7h\x12\xc6`\x1e\xbe\xe7C\x8c\x06\x84\xf0\v\xcfi\x1d\x182\xd2$\xc3CZ\xfc\xc3t\x7fK$\xa7\\8=\xc0\x04\xd3W\x17\x17\t\x92\x1fw\x0f\x19\xc19Iݜ!\tR\x04GFt\x82.\xe3\xd9\b\xd0\xc9\x1e<\xe4\x13\xa1\xb0\xe5\x93\xe0\xeeu\xf1\x1e/\x94ʤ\xab/\xe5\xfd\x94\u0557\xff\x03\x00C\xfc\xce;\xe4\x02\x00\x00",
+	},
+
+	theme.IconNameViewZoomFit: {
+		Name:     "zoom-fit-best-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfft\x95ێ\xe28\x10\x86\xefy\x8a\x92\xf7\xbaLٕ\xd8\xce\nhi/\xf6\tv\x1f`D3\x04\x89C\xabA\x80\xe6\xe9Gv\x12\xbb\f\x19uK]\xedC\x9d\xbe\xfa\x9d\xd5\xc7\xf3t\x84\xfb\xee\xfbz\xb8\x9c\xd7\xcahR\xb0;o/\x9f\x87\xf3~\xad\xfe\xff\xef_\f\xeac\xb3X]\xef{\xe8w\x87}\x7f[+㾞\n\xee\x87\xdd\xe3\x9f\xcbs\xad\b\b\x8c\x03\xe3\x14<\x0e\x9f\xb7~:\xf0<\x1d\xcf\u05f5\xeao\xb7\xaf\xbf\x97\xcb\xc7\xe3\xa1\x1f\xac/\xdf\xfb\xa5%\xa2\xe5\xf5\xbeW\x9b\x05\x00\xc0\xea\xebǭ\x87ϵ:A\x03\x06\xb6\x80F;G\xa6u@\x80\fF3w\xa1i\xa2\xcdp\x87\x00[ \xc8g\xf26\xa7\x9f>\xed\v\x0f\f(\x8e`\U001000cb\x12\ae\x8c\xe1\xf7\x17\x9c\x80\xc0B\x0f\xac\xc9\x18o:\xb8\xa7\x7f\xd1\x16\xe3\bH\xd3.\xf2\xe0\x94\xb4\xb1\xa1#\a\xa4\xc9z\x8e>Iۖ\xc7%\xdf\xd8x\x984\xfb6^\xd0Di\v\xb3U/\xf9`,d\xa3\x84KW\x1b\x17|\x9bB\x1ao\x82\x87\xd1\b)\xa4\x89{\xa4-\xb1\xb1\xe9\x10Sh;\x1e\x83\xfa`8G`(ed+\x1a]\xcbc\xd0d\xa5\xab\xa1\v\xcdp5\xbaKA;\xd3\f\xf9\x9a\xd6\xd9\x16r(\xcc\xe1s\xa5\xfe\xa5\xd2*\x8fT\xe9\xe0\xa3,m\xab\xb2ƚI\xdb\xc6NKCOI\xb37\xd45b)Bl\x81$\xc4\xe4-\xe6\b\x92\xca\x00j\xf4\xc0\x85\x93H[f\x9d\x1bTVZg\x1d\xbc\xe4\xcc6\x18\x90)\x8fH\xc6jHsڂ\x97.M@\xb21u\xb5\x06c\xe0\x9dKS\x00\x916\xa3\x04&>\xa4-yb\vr\x14\xe6\v\xacr\x10T\xb6\xb2\x9c\\\x06\xf9\x98~a\xf2\x8eD\n\x85'\x11ᨢH\t[݅\x10\xfb堇Ib\xd3_\x14\xf8\x84\xc0\x84\xb2P\xaa\x8d\aQd\x868\xa32\xac4U֊\n&\x92E^(%7\xc0D9\x9b3S\x8f3Z\xc3\x19\xb1\xe1\xacڊ\xc8P\n/+\xa4\boF\xe5\xefU\xfbת_ć\x823\xbe=/Rk8\xa3?̴#P?\xf1\xa4\xc4\xf1\b\x82\xffVd\x94i\xa2\x90\xa1\x80Z\x06\xa9\xadj\xfa\xc3\xc3Y\xd5f\x81\xaa\xb9I\x059\x90<\xf3\fg\x9c\xa9\x93\x0e^\x1b)\xdfaΝj\xe0\x8d`%K\x14r,$ӱ\xe8-\x83\x1cc\xbd\x0eN\xad\xcb\xeai\xae\x89UO\x8f\xfc\x1e\x88ym\\\xf5\xb4\xe1\xfbk'\x856\xcaӎ\x1fBR\xf0\xf3p<\xae\xd5_v\xc7\r;\xb5\xdc,V\xf1#\xbeY\xfc\x1e\x00\xe81<BC\b\x00\x00",
+	},
+
+	theme.IconNameViewZoomIn: {
+		Name:     "zoom-in-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\x8f]j\x850\x14\x84\xdf]\xc5p\xfa\x1cM\x8c\r\xb6\x18/\xf4\xa1+h\x17PԚ\x80\x7f\\C\"]}\x89\xd6^I \xe1\xcc\xcc7\x9c궍\x03|w_\xed<i\x12)'tS3\xb7v\xea5}~\xbc\xb3\x92nuR\xad\xbe\x87\xe9lo\x9c&\xa1\x96\x8d\xe0m\x17\xde\xe6M\x13\a\x87P\x10\x8a\x10l\xeb\xcci\xd8\xc6aZ5\x19\xe7\x96\xd7,\v!\xa4A\xa6\xf3\xbd\xcfr\xcey\xb6\xfa\x9e\xea\x04\x00\xaa\xe5\xcb\x19\xb4\x9aF\x14\x10h\xc0D\xaa\x14\x17\xcf\n\x1cLB\xa4R\xbe\x94E\x11\xff\x12\x1e%\x9a\xd8xz\xfee\xb9\x1f\xb3\xeb\x17\x82\x04\xbbXX$\xb0\x03\xf1\xe8a\u05ce\xe3\xfe`\xfc\xe3\xe5\xf0\xfb\x1b\x939́\x88\x03\x16\x15&\xcf\x11\xcbwS\frp·\x1d\x06MOy'\v\xa9(\xab\x93*.]'\xbf\x03\x00H\xd1Εs\x01\x00\x00",
+	},
+
+	theme.IconNameViewZoomOut: {
+		Name:     "zoom-out-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\x8f]j\x850\x10\x85\xdf]\xc5a\xfa\x1c\x9d\x18\x1bl1^\xe8CW\xd0.\xa0\xa85\x01\xff\xb8\x86D\xba\xfa\xa2\xd2[\x99y\x18\xe6\x9c\xf9\x0eSݶq@\xe8\ueadb'C2eB75s\xeb\xa6\xde\xd0\xe7ǻ(\xe9V'\xd5\x1az\xd8\xce\xf5\xd6\x1b\x92z\xd9\b\xc1u\xf1m\xde\f1\x18RCjBt\xad\xb7\x7f\x86m\x1c\xa6Ր\xf5~yͲ\x18c\x1aU:\xdf\xfb,g\xe6l\r=\xd5\t\x00T˗\xb7h\r\x8d( \xd1@\xc8Tk\x96\xcf\x1a\f\xa1 S\xa5^ʢ\xd8g\x85\x80\x12\r\x18\x0f\xcfCVG\xd9C\xbf\x10\x14\xc4\xc5\"v\x828\x11\xff9\xe2\x9aq\xf6\x0fF0\xf4\xc1\v\xc8a\xf7\xabsɄo7\f\x86\x9e\xf2N\x15JSV'\xd5\xfeP\x9d\xfc\x0e\x00(8\xfc\xccO\x01\x00\x00",
+	},
+
+	theme.IconNameVisibility: {
+		Name:     "view-reveal-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\x91M\x8e\x9b@\x10\x85\xf7>\xc5Sg]Euu\xd3?\x91\xf1HY\xe4\x04\xc9\x01\"\x9b\x00\x92\xff4 c\xe5\xf4Q\xe3\x19\x06\x89M}\xe2\xd5WO\xbd\x7f{^\xcex\xb4\xef\xe3p\xbb6Ʋ\x18\xb4\xd7\xe3\xed4\\\xbb\xc6\xfc\xfe\xf5\x93\x92y;\xec\xf6\xe3\xa3C\xdf\x0e]?5Ɔ\xfb\xd3\xe01\xb4\xf3\x8f۳1\x02\x81\r\xb0\xc1`\x1eNS\xff\xf9\xc3\xf3r\xbe\x8e\x8d\xe9\xa7\xe9\xfe\xbd\xaa\xe6y\xe6\xd9\xf1\xed\xbd\xabTD\xaa\xf1љ\xc3\x0e\x00\xf6\xf7?S\x8fSc.HP\x1cA\x8e\x83O\xde%\b\x8b\xb8,\x01\x1489\x15g\xa1\xecc\xa8\x83\x82\"G\x974Y\x04\x16\x89ɖ\xa8p\xb6^\x82\xc2q\xad\xd1y\x0fϒK\x005\xe7\x944)\xd6\\\xcd9\xabM\tG\xacFZ\x95\xab\x91V嚤\xc0bm\xb4\xb9\\\xbb:\xa9H\x9dw\x11\xb4Z\xa9h\xbd\x8b\xf5\xe6^\xfa8\xc5\xe2\x1f.\x90\xa5\xb3\xb2Zɥ2<,ǔ\xcbF\x0f\x8f\x11\xb4\x99\xe9\x03\xf9\r\xa5\x05\x8f\u0602\x05}\xad'\xcbVj\x1f2\x04\xa4\x10N\xd9\xd7K7(ƯY\xf1\x02\n\xda0*\x88\xb6\xa1\xd7\xf7\xda/\x06\x7f\x87\xf3\xb91ߴu\xde\x05S\x1dv\xfb\xf2\xbe\x87\xdd\xff\x01\x00j\xb0\xf1\xb6^\x02\x00\x00",
+	},
+
+	theme.IconNameVisibilityOff: {
+		Name:     "view-conceal-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfftSݎ\x1a;\f\xbe\xe7)\xac\x9c\xeb\x18;q\x9c\xe4\bX\xe9\\\x9c'h\x1f\xa0\x9a\xa5\x804\xc0jA\x80\xfa\xf4\x953C\x96^T\x1a\x89\xe0\xf1\xf8\xfb\xf1\x97\xd5\xdb\xe38\xc2m\xfby9\x9cOk\xc7H\x0e\xb6\xa7\xe1\xfc~8\xed\xd6\xee\xfb\xb7\xff}qo\x9b\xc5\xear\xdb\xc1~{\xd8\xed\xafk\xc7\xfa\xf1pp;l\xef\xff\x9d\x1fkG@\xc0\n\xac\x0e\xee\x87\xf7\xeb\xfe\xd9\xf08\x8e\xa7\xcb\xda\xed\xaf\u05cf\x7f\x97\xcb\xfb\xfd\x8e\xf7\x88\xe7\xcf\xdd2\x10\xd1\xf2r۹\xcd\x02\x00`\xf5\xf1㺇\xf7\xb5;\x02c\x8a\x1c\x12\x10\x8a\x96\x9c`\x04\xcfH\x1a\x12\xcc?#\xb0\xd83>\v\xbe\xbf\xf0\x01c\t\x85\xc3\xcbi\x00ƠI\x83\xf4ƀ\x9c\xb9d\xb1.\xa9JU `*\xb5H\x04/H5k\xca0\x80'\xac,\xa4\x01|\xc4\x14\xa2\xc4\xdc\xdf+\xf8\x84\xf6EN\xe03\xe6\x866\xd5\xdai0\xb4\xa8\x99K\x01Bb\xce\\\r/S\xa6\xc8@\x183\x1b\xb0\x8fX\xaa\xa4ȦFB\xd5\n\xbf\xe0\b:\xab\x8f\xb3\x1b\x03\x04\fL5\x16 \x10`̥\x1a1\x01\x99\x88\x12\xc5J\n\x84\x85(\x1b\x13\xc2 M\x1ac\xca\xc5fxB\xad5p\xb5Y\x13\xdff\xee\x84\xd4\x0f\x03\x102%\xd1:\r\t\\\xb2\x95TH\xa3\x95\xd2\x13\x8a5ש+k5\t\x03\x90\xcd\xf9\xfaz\x96\xe6\xc3\xfc4\xaam\x1b\t:g\x1b\x19r\x14\xb1\x92\x86\xf42\xb0c\xfc\x8d\xa8*qj#d2\x8aqZ\x89iM\x91\xe3\x97\xd6/\xf9f\xb0Wd\x91\t\xa3r\xc3h\xe4r\x8a\x95Lo\x8bC6\xb4P\x1b\x1ccMmw\x9e1\xd5ؖC5\xceD\xe6\xa0\xf4\x9c\xf4\x98\xf4\x94\xf4\x90\xbcd\x84\xb0\xa4\xa7Wmo\xc1VKq^\x17۰\x16\xce9?\x84\x12l\x9b\x93\x1f:\xfb\xdfO\x93\xbd\xf9\xe9e*\xa9\x8a\x95\x9e~\x9b\xa6V\x98\x18Q\xe7\xef_\xd2\xe5\xdbE\x99\xf3e\x7f\x04\x86?\x96\x15\xcat?)\xa6\xd9\xfb\xa4\x16\x8e>\xaf\x03\x98\xd1\x04\xe4\xe0\xe7a\x1c\xd7\ue7f0\x8d\x12\xd5-7\x8b\x95]\xfe\xcd\xe2\xf7\x00\xaf\xc5\xf1\xdf{\x04\x00\x00",
+	},
+
+	theme.IconNameVolumeDown: {
+		Name:     "audio-volume-low-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfflS\xdbn\x1aK\x10|\xe7+Js\x9e\xb7\xe9\xcb\\\x8fX,\x9d\x87\xf3\x05\xc9\aD\x98\xb0H\\,\x83\x00\xe5룞\x8d\x1dG\xb1\x90PmmwWu\xcd\xec\xea\xe9q<\xe0\xb6}\xbd\xecϧ1\bq\xc0\xf6\xb49?\xefO\xbb1|\xfd\xf2\xffP\xc3\xd3z\xb1\xba\xdcv\x98\xb6\xfb\xddt\x1d\x83\xe4\x97G\xc0m\xbf\xbd\xffw~\x8c\x81\xc1\x90\f\xc9\x01\xf7\xfd\xf3uz+x\x1c\x0f\xa7\xcb\x18\xa6\xeb\xf5\xe5\xdf\xe5\xf2~\xbf\xd3\xdd\xe8\xfc\xba[*3//\xb7]X/\x00`\xf5\xf2\xed:\xe1y\fG\x14\b1\x97*\x8a\r\x06&m\xb9\x96\xe4\x88\xd9\x1agG\xc9_'0\xf9\xff\xc0TrK&`\xb2$)\x1b\x0e\x18\x8c\xd48\xe6\x06\xa3\x1c9k\u0084A|\xa4\x107+\t\x8cA\xc1T\xa3?\f\n\xc5\r\xae\xc9\x10\xe2\xdaj\x8c`j\u00922\xb4\xff&\b\x0e\xf8{\xf2\x06L*>\xd5A\xea.\x99bS\xa9\xceX6\xad\x82?lv\a?p\x84RK\x85M\x1c\xd4>\xb6o-\xad\xa940\xb1H\x91攵8ws\x89:S)\xf7\x14\x94M\xba\x8d\x81)F\x89]\xdeLٴW\xd9\x1cV\xcbܬ\xceӝ\x83Pd\xb5\x18\xb1\x81\xbcM\x15\x8a\xea\xf1\xfef\x8c\xacf\xf7\xc0\x88T߅̗\xa8xW\xf4\xa3\x8a\x99[\xec\x9d\xee\b\x9f\t\xfd\xaa/o\x0e\xbbP\xce3\xa3\xb1G&d\xad\xc6\x0fV\xad7z<\xb5`x\x9f/\xa4\x1daP\xb2\xde\xf2\x812\xca\xd2s\xbf\xf4Xf\xfdA)Em\xb9a\xf8\xa4rN\xbeZj\xf1\xc3:ޝ\xa3_\aߺj\x15sT\xfc\x9cK\xe7f\xb3\xbd\x9b\xb5X4pǩ\xfb\x9dqM\xfdz\xf43gp\xc0\xf7\xfd\xe10\x86\x7ftk\xd1rX\xae\x17+\xff\x1e\u058b\x9f\x03\x00\x82\xbdO\xb0\x8e\x03\x00\x00",
+	},
+
+	theme.IconNameVolumeMute: {
+		Name:     "audio-volume-muted-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xa4Sݎ\x9b<\x10\xbd\xcfS\x1c\xf9\xbb\xb6\x99\xf1\x1f\xf8\x13d\xa5^\xf4\t\xda\a\xa8\x12\nH\xe4G\v\x82\xa8O_\xd9K\x12\xb6\x8b\xaa\xaaU\xa40\x9c\x19\x9fsf<\x94/\xb7S\x8f\xa9~\x1d\xba˹\x12\xacH\xa0>\x1f.\xc7\xee\xdcT\xe2\xeb\x97ϲ\x10/\xfb]9L\rںkڱ\x12\xec\xaf7\x81\xa9\xab\xe7O\x97[%\b\x04\xf6`/0wǱ\xbd\x17\xdcN\xfdy\xa8D;\x8e\xd7\xff\xb3l\x9eg5\x1buym2MD\xd905b\xbf\x03\x80\xb2\xc1\xf7\xae\xef+\U0005fb8d5~\x81S\xea\xfamlq\xac\xc4\t9X\x11\xe5\x05k\x1c I\xe9\xe0\x8b\xdcň\xc8\x04\xf21r1\xed@*\xfeKR\xb9\x0f\xce0H\x19\xc7\xce\x1b\xf4\x90FiC\xd6\a\x18\xe5-y\xed\xd0Br\xa4dE\xc1\xe4\x0e\x04\xa9A\xaa\xb0\xf1EjhL\x88\x9a\x14\r\x14\xa1\xb0\x16\xa4\x02\x13;\x0f\x9d~-\x18=>2\x1f@Jsd\x8d\x81K.I٠\xb9\x88\x88\xf1F\x17\x8cw6\x93\x83\x1f8\x81@\"\xdb\x1c\x04\x13\xdc2\x02\xef\xa2\f\xa5\xd6y\xe1`r\xd1D\xec\x9erJ\x90\x0e:\xf8\xf0v&\x9aH#Z\x82'Ċ\xb4\xb1\xd1+XY\x8e-\xa0\a\xdfO?\x82\x1e\xf2\x11\xf3?p\x0f+\xf4Y\xb7$i--W\xda[\xd2\x1f\x95\x7f\xcb<\xac\x1c>\v!\x1f%\xeb\x0e7\xb5\xe5\x96\xf8\xd6\x006\xe9ӬxY\xde\xfbӦ\xe4\xdbb\xeb\xf0\xee\x02\x9f\xd8\xf0\x877\xbdyE\xdb]\xfd\xbd\x99_\xb6\xb4̚\xfd\xae\x8c\x9f\xf5~\xf7s\x00\x89A\xe6\xf7U\x04\x00\x00",
+	},
+
+	theme.IconNameVolumeUp: {
+		Name:     "audio-volume-high-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffdT[n+7\f\xfd\xcf*\x88\xe9\xf7\xd0|\x89\x92\x8a8\x17\xe8GW\xd0.\xa0H\\ۀ\xf3\xc0\xb5a\a]}AI\x13;\xd7\b\x10h\x18>\x0e\xcf9\xcc\xe3\x8f\xcf\xd7\x03\x9c7?\x8f\xfb\xf7\xb7\xf5\xc4H\x13lޞ\xdf_\xf6o\xdb\xf5\xf4\xf7_\x7f\xcee\xfa\xf1\xf4\xf0x<oa\xb7\xd9ow\xa7\xf5\xc4\xfe\xf19\xc1y\xbf\xb9\xfc\xf1\xfe\xb9\x9e\b\b\u0601}\x82\xcb\xfe\xe5\xb4[\x12>_\x0fo\xc7\xf5\xb4;\x9d>~_\xad.\x97\v^\x14\xdf\x7fnWBD\xab\xe3y;==\x00\x00<~\xfcs\xda\xc1\xcbzz\x85\f\x8cD\xb9\xb0\xc03̄R\xbd\xe4\x14/\"\xad\xe4\xf1J\xf1\xe7\x04\x84\xf1{&\xcc^\x932\x10j\xe2\xe4\n\a\x98\x15Eɼ\x82\xa2\x1b\xb9$\xd8\xc1\xccђ\x91\xaa\xe6\x04\x04\xb3\x00a\xb1\xf8\x98\x05\x04\xce\x103\t\x18\xa9\xd4b\x06\x84\x95\x89\x93\x83\xb4\x9f\x1d0\x1c\xe0\xbe\xf33\x10\nG\xd7x\xa4\x86\x92Ъp\x89\x88\xbaJa\xf8\x06\xb3!\xf8\x0f^\xc1ќ\xaa\x96\x98\xd5\x1fmk\xael\x94\xbfo\xad\xc53W \xa4d^J\x84R*\xa9\x1a\x10\xb2\xe7\xea\xb5\xd7Zʤ\x12c\x06=_tU\x8d\xc9\x11\x92\x80\"\xc0\xa8u\xac\xc0XĄ+\bfʤ|\x8d8\x8a\x96\xb6\x02\x14\xac\x96t\xd1F\xa9D\xbf\xaf\x91\x01<\xc4b\xa4e\xe4\xfd\xa0\x91\xcd0\xea\xed6}\x94\x8f\xe4\x05(7|\xacm\xff\x99у\x1d\a^\x90\xce_R\\C\t;\xc9\xc7\xce\x00\x05\xe8Y\xb1\x92\xa8\x19\xcc\xf7\x89\x83\xf7\xa4\xdc\x19*\xe1\xa5V\xcc\xdc\xe6\x12v\xab\x10\x16\x95V:\"\xa1\xe3\xach\xa1\xb7\x83 \xf1\xc0\x1cmD\xbc\xb7\x19\xa6n\x02)\x8b5!\xdb\xfe3\xa1\x9bukDc\x19`Ĺ\vεJG\xa0u\xe4\xa5o_\x85\x9a#\xceמ\xc13\xf1W}㋐r\x13\x94\xb0\x1d\xc0җ0\xb5\xc3i,\x8f\x14Fk\x97w\x8d\xe8\xa2\n\x81a\xe1 |p֕\x8b\xc0\xd5\x02\x84jyl\xfe\xeb\x98\x06s\x98!\x12;xB\x8e;\x11 tJ\xe6\x15\xee\x17l\xee\xb1v\x1a\x84\xdaU`$\xf7\x1e\x91&A\xf3O1-7\xb3\x9f\x97\x1e\x19\xe6Q\xe0\xc0(\xc3\x03\x82\xed2\xf2MH\xd1\xe9j \x1b\xb6\x11L\xc1Ks\xe1MfC>\xd8(:4\x15s\xea/s+f\xfd\x86\xa5t\x7f夵_\xf8\x00\x1b.\"\xa0\t\xfe\xdd\x1f\x0e\xeb\xe97٨\xa9O\xab\xa7\x87\xc7\xf8O\xf9\xf4\xf0\xff\x00]\x99\xfc|\xa8\x05\x00\x00",
+	},
+
+	theme.IconNameWarning: {
+		Name:     "dialog-warning-symbolic.svg",
+		Symbolic: true,
+		Data:     "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\x92ݮ\x9b0\x10\x84\xef\xf3\x14#\xf7\xdaˮ\xff\xc0Uȑz\xd1'h\x1f\xa0J( \x91\x1f\x1d\x10\xa0>}e\x93\xe4p7\xacg>\xcfZ\x1c?\xd6뀹\xf9\x1c\xfb\xfb\xadVB\xac\xd0\xdc\xce\xf7K\x7fkk\xf5\xfb\xd7O]\xa9\x8f\xd3\xe18\xce-\xba\xa6o\xbb\xa9V\x12\x1e\xab\xc2\xdc7ˏ\xfbZ+\x06C\x02$(,\xfde\xea^\x86\xf5:\xdc\xc6Zu\xd3\xf4\xf8^\x14˲\xd0b\xe9\xfe\xd9\x16\x86\x99\x8bqn\xd5\xe9\x00\x00\xc7ǟ\xa9åVW\x94\x149\x18\x0f&\x8e\xb6\xf48C3ySZ\xe7\x92\xe2\xa4l\x05-\xc469\xc1d*1>M\\\n0\xc5P\x95\x1e\x03t \xe3!B~G\xb2^|\xc8&W9\x97\xaeq\xd9n\xc8\xe6\xb8<\xef}}w\x10K\xe2S\xa5s\x8aU\xecB\x04C(\xb2ɥ\x84$p\x82\n\x19I(\xfd\xca\xe6\nv+'\xe4\xb3\xca-l\xac\xf2\x12L\xc19o%\xa9\xe8\xc4qȋ\xe5͒ڪ\xe5]S\xf6\x1f\xae\xef~\xf6\xf9N3\xb6b\xcce%\x06\xfb\xb7r\xa5\t\xa9\x16\xb4@0n\xe8\xec\xfa:\xd4\xf9t\x86\xde\xe8ZPf\x9c\xf7\xc9\t\x86\x80ɹh$\xe2I\xd9}\xbf\xc1\xbb\xe9F\x1c\xb1\x1f\xe4Q\xe23X\xe1o?\f\xb5\xfaf\x1a\xeblP\xc5\xe9pL\xbf\xc2\xe9\xf0\x7f\x00\xf7u7K\x89\x02\x00\x00",
+	},
 }
@@ -0,0 +1,71 @@
+package theme
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/x/fyne/theme/iconpack"
+)
+
+// the icons of an app that uses a handful of them
+var adwaitaHandful = []fyne.ThemeIconName{
+	theme.IconNameHome, theme.IconNameSettings, theme.IconNameSearch, theme.IconNameMenu, theme.IconNameCancel,
+}
+
+// adwaitaPlainIcons returns the Adwaita icons without recoloring the symbolic ones, which needs an app.
+func adwaitaPlainIcons() iconpack.IconPack {
+	assets := make(map[fyne.ThemeIconName]iconpack.Asset, len(adwaitaIconAssets))
+	for name, asset := range adwaitaIconAssets {
+		asset.Symbolic = false
+		assets[name] = asset
+	}
+	return iconpack.NewBundled("Adwaita", assets)
+}
+
+func TestAdwaitaIcons(t *testing.T) {
+	icons := adwaitaPlainIcons()
+	for name, asset := range adwaitaIconAssets {
+		content := icons.Icon(name).Content()
+		if strings.HasSuffix(asset.Name, ".svg") {
+			assert.Contains(t, string(content), "<svg", name)
+		} else {
+			assert.True(t, bytes.HasPrefix(content, []byte("\x89PNG")), name)
+		}
+	}
+
+	assert.Same(t, AdwaitaIcons().Icon(theme.IconNameHome), AdwaitaTheme().Icon(theme.IconNameHome))
+	assert.Equal(t, "foreground_user-home-symbolic.svg", AdwaitaTheme().Icon(theme.IconNameHome).Name())
+}
+
+// BenchmarkAdwaitaIcons_All holds the content of all the icons, as bundling them uncompressed did.
+func BenchmarkAdwaitaIcons_All(b *testing.B) {
+	b.ReportAllocs()
+	held := 0
+	for i := 0; i < b.N; i++ {
+		held = 0
+		icons := adwaitaPlainIcons()
+		for name := range adwaitaIconAssets {
+			held += len(icons.Icon(name).Content())
+		}
+	}
+	b.ReportMetric(float64(held), "B-held")
+}
+
+// BenchmarkAdwaitaIcons_Handful holds the content of the icons that an app uses.
+func BenchmarkAdwaitaIcons_Handful(b *testing.B) {
+	b.ReportAllocs()
+	held := 0
+	for i := 0; i < b.N; i++ {
+		held = 0
+		icons := adwaitaPlainIcons()
+		for _, name := range adwaitaHandful {
+			held += len(icons.Icon(name).Content())
+		}
+	}
+	b.ReportMetric(float64(held), "B-held")
+}
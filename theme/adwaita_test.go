@@ -0,0 +1,25 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdwaita_DerivedColors(t *testing.T) {
+	th := AdwaitaTheme()
+	for _, name := range []fyne.ThemeColorName{
+		theme.ColorNameHover, theme.ColorNamePressed, theme.ColorNameDisabled,
+		theme.ColorNameSeparator, theme.ColorNameHeaderBackground, theme.ColorNameInputBorder,
+	} {
+		assert.Contains(t, adwaitaLightScheme, name)
+		assert.Contains(t, adwaitaDarkScheme, name)
+	}
+
+	// the foreground with the alpha multiplied by 0.07, as gtkalpha() does
+	assert.Equal(t, color.NRGBA{A: 0x0e}, th.Color(theme.ColorNameHover, theme.VariantLight))
+	assert.Equal(t, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x12}, th.Color(theme.ColorNameHover, theme.VariantDark))
+}
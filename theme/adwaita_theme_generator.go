@@ -6,10 +6,12 @@ This tool generates the Adwaita theme for Fyne.
 
 It takes the colors from the Adwaita page: https://gnome.pages.gitlab.gnome.org/libadwaita/doc/1.0/named-colors.html
 and the icons from the Adwaita icon theme: https://gitlab.gnome.org/GNOME/adwaita-icon-theme
+The colors are parsed by the themegen package, which creates themes from GTK stylesheets at runtime.
 
 There are 2 outputs:
 - adwaita_colors.go: the colors for the theme as map[fyne.ThemeColorName]color.Color
-- adwaita_icons.go: the icons for the theme as fyne.Resource (themed for symbolic icons)
+- adwaita_icons.go: the icons for the theme, gzip compressed, which are loaded as fyne.Resource when first used
+  (themed for symbolic icons)
 
 Usage:
 go generate ./theme/...
@@ -20,6 +22,7 @@ package main
 import (
 	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"fmt"
 	"go/format"
 	"image/color"
@@ -34,6 +37,7 @@ import (
 	"text/template"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/x/fyne/theme/themegen"
 )
 
 const (
@@ -60,13 +64,13 @@ import (
 
 var adwaitaDarkScheme = map[fyne.ThemeColorName]color.Color{
 {{- range $key, $value := .DarkScheme }}
-    {{ $key }}: {{ printf "color.NRGBA{R: 0x%02x, G: 0x%02x, B: 0x%02x, A: 0x%02x}" $value.Col.R $value.Col.G $value.Col.B $value.Col.A }}, // Adwaita color name @{{$value.AdwName}}
+    {{ $key }}: {{ printf "color.NRGBA{R: 0x%02x, G: 0x%02x, B: 0x%02x, A: 0x%02x}" $value.Col.R $value.Col.G $value.Col.B $value.Col.A }}, // Adwaita color name @{{$value.AdwName}}{{if $value.Derived}} with {{$value.Derived}}{{end}}
 {{- end }}
 }
 
 var adwaitaLightScheme = map[fyne.ThemeColorName]color.Color{
 {{- range $key, $value := .LightScheme }}
-    {{ $key }}: {{ printf "color.NRGBA{R: 0x%02x, G: 0x%02X, B: 0x%02x, A: 0x%02x}" $value.Col.R $value.Col.G $value.Col.B $value.Col.A }}, // Adwaita color name @{{$value.AdwName}}
+    {{ $key }}: {{ printf "color.NRGBA{R: 0x%02x, G: 0x%02X, B: 0x%02x, A: 0x%02x}" $value.Col.R $value.Col.G $value.Col.B $value.Col.A }}, // Adwaita color name @{{$value.AdwName}}{{if $value.Derived}} with {{$value.Derived}}{{end}}
 {{- end }}
 }`
 	// the template where to bundle the icons in a map
@@ -84,22 +88,19 @@ var adwaitaLightScheme = map[fyne.ThemeColorName]color.Color{
 import (
     "fyne.io/fyne/v2"
     "fyne.io/fyne/v2/theme"
+
+    "fyne.io/x/fyne/theme/iconpack"
 )
 
-var adwaitaIcons = map[fyne.ThemeIconName]fyne.Resource{
+// the icons are gzip compressed, see iconpack.NewBundled
+var adwaitaIconAssets = map[fyne.ThemeIconName]iconpack.Asset{
 {{range $name, $icon := .Icons}}
-{{ if contains $icon.StaticName "symbolic" }}
-    theme.{{$name}}: theme.NewThemedResource(&fyne.StaticResource{
-        StaticName: "{{$icon.StaticName}}",
-        StaticContent: []byte({{ printf "%q" $icon.Content}}),
-    }),
-{{else}}
-    theme.{{$name}}: &fyne.StaticResource{
-        StaticName: "{{$icon.StaticName}}",
-        StaticContent: []byte({{ printf "%q" $icon.Content}}),
+    theme.{{$name}}: {
+        Name: "{{$icon.StaticName}}",
+        Symbolic: {{ contains $icon.StaticName "symbolic" }},
+        Data: {{ printf "%q" $icon.Data}},
     },
 {{end}}
-{{end}}
 }
 `
 )
@@ -126,6 +127,18 @@ var (
 		"theme.ColorNameSuccess":           "success_bg_color",
 		"theme.ColorNameWarning":           "warning_bg_color", // Adwaita doesn't have "orange_x" color for "dark"
 		"theme.ColorNameError":             "error_bg_color",
+		"theme.ColorNameHeaderBackground":  "headerbar_bg_color",
+	}
+
+	// colors that the libadwaita stylesheet derives from the foreground color with gtkalpha(), which multiplies
+	// the alpha of the color by a factor: the light @window_fg_color has an alpha of 0.8, so the alpha of the
+	// light hover color is 0.8 * 0.07 = 0.056 (0x0e), while the dark one is 1 * 0.07 = 0.07 (0x12)
+	derivedColorToGet = map[string]derivedColor{
+		"theme.ColorNameHover":       {"window_fg_color", 0.07}, // $hover_color: gtkalpha(currentColor, .07)
+		"theme.ColorNamePressed":     {"window_fg_color", 0.16}, // $active_color: gtkalpha(currentColor, .16)
+		"theme.ColorNameDisabled":    {"window_fg_color", 0.5},  // $disabled_opacity: .5
+		"theme.ColorNameSeparator":   {"window_fg_color", 0.15}, // $border_color: gtkalpha(currentColor, .15)
+		"theme.ColorNameInputBorder": {"window_fg_color", 0.15}, // entries are outlined with $border_color
 	}
 
 	// and standard color names:
@@ -262,10 +275,16 @@ var (
 type colorInfo struct {
 	Col     color.Color // go formated color (color.RGBA{0x00, 0x00, 0x00, 0x00})
 	AdwName string      // Adwaita color name from the documentation without the "@"
+	Derived string      // how the color is derived from the Adwaita color, if it is
+}
+
+type derivedColor struct {
+	AdwName string  // Adwaita color name from the documentation without the "@"
+	Alpha   float64 // the factor of the alpha of the color, as for gtkalpha()
 }
 type iconInfo struct {
 	StaticName string // the theme name of the icon for Fyne
-	Content    string // the content of the icon, SVG content
+	Data       string // the gzip compressed content of the icon, SVG or PNG content
 }
 
 func main() {
@@ -302,7 +321,7 @@ func generateColorScheme() error {
 	rows = tableRowMatcher.FindAllStringSubmatch(string(htpage), -1)
 
 	// inline function, to get the color for a specific name and variant
-	getWidgetColorFor := func(name, variant string) (col color.RGBA, err error) {
+	getWidgetColorFor := func(name, variant string) (col color.NRGBA, err error) {
 		for _, row := range rows {
 			// check if the row is for "@success_color" (@ is html encoded)
 			if strings.Contains(row[0], "&#64;"+name) || strings.Contains(row[0], "@"+name) {
@@ -320,7 +339,7 @@ func generateColorScheme() error {
 		return
 	}
 
-	getStandardColorFor := func(name string) (col color.RGBA, err error) {
+	getStandardColorFor := func(name string) (col color.NRGBA, err error) {
 		for _, row := range rows {
 			// check if the row is for "@success_color" (@ is html encoded)
 			if strings.Contains(row[0], "&#64;"+name) || strings.Contains(row[0], "@"+name) {
@@ -352,6 +371,29 @@ func generateColorScheme() error {
 		}
 	}
 
+	for colname, derived := range derivedColorToGet {
+		lcol, err := getWidgetColorFor(derived.AdwName, "light")
+		if err != nil {
+			return fmt.Errorf("failed to get light color for %s: %w", derived.AdwName, err)
+		}
+		dcol, err := getWidgetColorFor(derived.AdwName, "dark")
+		if err != nil {
+			return fmt.Errorf("failed to get dark color for %s: %w", derived.AdwName, err)
+		}
+		lcol.A = uint8(float64(lcol.A)*derived.Alpha + .5)
+		dcol.A = uint8(float64(dcol.A)*derived.Alpha + .5)
+		lightScheme[colname] = colorInfo{
+			Col:     lcol,
+			AdwName: derived.AdwName,
+			Derived: fmt.Sprintf("gtkalpha(%g)", derived.Alpha),
+		}
+		darkScheme[colname] = colorInfo{
+			Col:     dcol,
+			AdwName: derived.AdwName,
+			Derived: fmt.Sprintf("gtkalpha(%g)", derived.Alpha),
+		}
+	}
+
 	for colname, color := range standardColorToGet {
 		lightColorName := color
 		darkColorName := color
@@ -477,11 +519,14 @@ func generateIcons() error {
 			continue
 		}
 
-		filecontent := res.Content()
+		data, err := compress(res.Content())
+		if err != nil {
+			return fmt.Errorf("failed to compress %s: %w", iconPath, err)
+		}
 
 		icons[name] = iconInfo{
 			StaticName: filepath.Base(iconPath),
-			Content:    string(filecontent),
+			Data:       data,
 		}
 	}
 
@@ -511,6 +556,22 @@ func generateIcons() error {
 	}
 }
 
+// compress returns the content of an icon compressed with gzip, which is decompressed when the icon is used.
+func compress(content []byte) (string, error) {
+	buffer := bytes.NewBuffer(nil)
+	w, err := gzip.NewWriterLevel(buffer, gzip.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(content); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
 // svgToPng converts an SVG file to a PNG file using inkscape.
 //
 // TODO: fix oksvg to support all SVG files.
@@ -529,19 +590,11 @@ func svgToPng(filename string) error {
 	return cmd.Run()
 }
 
-// stringToColor converts a string to a color.RGBA
-func stringToColor(s string) (c color.RGBA, err error) {
-	c.A = 0xff
-	switch len(s) {
-	case 7:
-		_, err = fmt.Sscanf(s, "#%02x%02x%02x", &c.R, &c.G, &c.B)
-	case 9:
-		_, err = fmt.Sscanf(s, "#%02x%02x%02x%02x", &c.R, &c.G, &c.B, &c.A)
-	default:
-		// rgba(...) format
-		var a float32
-		_, err = fmt.Sscanf(s, "rgba(%d, %d, %d, %f)", &c.R, &c.G, &c.B, &a)
-		c.A = uint8(a * 255)
+// stringToColor converts a string to a color.NRGBA
+func stringToColor(s string) (color.NRGBA, error) {
+	c, err := themegen.ParseColor(s)
+	if err != nil {
+		return color.NRGBA{}, err
 	}
-	return
+	return c.(color.NRGBA), nil
 }
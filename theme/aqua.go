@@ -0,0 +1,188 @@
+package theme
+
+import (
+	"image/color"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+var _ fyne.Theme = (*aqua)(nil)
+
+// the accent colors that macOS offers, by their value of AppleAccentColor, for the light and dark variants
+var aquaAccents = map[int][2]color.NRGBA{
+	-1: {{R: 0x8e, G: 0x8e, B: 0x93, A: 0xff}, {R: 0x98, G: 0x98, B: 0x9d, A: 0xff}}, // graphite
+	0:  {{R: 0xff, G: 0x3b, B: 0x30, A: 0xff}, {R: 0xff, G: 0x45, B: 0x3a, A: 0xff}}, // red
+	1:  {{R: 0xff, G: 0x95, B: 0x00, A: 0xff}, {R: 0xff, G: 0x9f, B: 0x0a, A: 0xff}}, // orange
+	2:  {{R: 0xff, G: 0xcc, B: 0x00, A: 0xff}, {R: 0xff, G: 0xd6, B: 0x0a, A: 0xff}}, // yellow
+	3:  {{R: 0x34, G: 0xc7, B: 0x59, A: 0xff}, {R: 0x30, G: 0xd1, B: 0x58, A: 0xff}}, // green
+	4:  {{R: 0x00, G: 0x7a, B: 0xff, A: 0xff}, {R: 0x0a, G: 0x84, B: 0xff, A: 0xff}}, // blue
+	5:  {{R: 0xaf, G: 0x52, B: 0xde, A: 0xff}, {R: 0xbf, G: 0x5a, B: 0xf2, A: 0xff}}, // purple
+	6:  {{R: 0xff, G: 0x2d, B: 0x55, A: 0xff}, {R: 0xff, G: 0x37, B: 0x5f, A: 0xff}}, // pink
+}
+
+// aquaDefaultAccent is the blue used where the user has not chosen an accent color.
+const aquaDefaultAccent = 4
+
+var aquaLightScheme = map[fyne.ThemeColorName]color.Color{
+	theme.ColorNameBackground:          color.NRGBA{R: 0xec, G: 0xec, B: 0xec, A: 0xff}, // windowBackgroundColor
+	theme.ColorNameButton:              color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameDisabledButton:      color.NRGBA{R: 0xf5, G: 0xf5, B: 0xf5, A: 0xff},
+	theme.ColorNameDisabled:            color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x40}, // tertiaryLabelColor
+	theme.ColorNameError:               color.NRGBA{R: 0xff, G: 0x3b, B: 0x30, A: 0xff}, // systemRed
+	theme.ColorNameForeground:          color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xd8}, // labelColor
+	theme.ColorNameForegroundOnError:   color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnPrimary: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnSuccess: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnWarning: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xd8},
+	theme.ColorNameHeaderBackground:    color.NRGBA{R: 0xf5, G: 0xf5, B: 0xf5, A: 0xff},
+	theme.ColorNameHover:               color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x0d},
+	theme.ColorNameInputBackground:     color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, // textBackgroundColor
+	theme.ColorNameInputBorder:         color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x1a},
+	theme.ColorNameMenuBackground:      color.NRGBA{R: 0xf6, G: 0xf6, B: 0xf6, A: 0xff},
+	theme.ColorNameOverlayBackground:   color.NRGBA{R: 0xf6, G: 0xf6, B: 0xf6, A: 0xff},
+	theme.ColorNamePlaceHolder:         color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x40}, // placeholderTextColor
+	theme.ColorNamePressed:             color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x1a},
+	theme.ColorNameScrollBar:           color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x66},
+	theme.ColorNameSeparator:           color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x1a}, // separatorColor
+	theme.ColorNameShadow:              color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x33},
+	theme.ColorNameSuccess:             color.NRGBA{R: 0x34, G: 0xc7, B: 0x59, A: 0xff}, // systemGreen
+	theme.ColorNameWarning:             color.NRGBA{R: 0xff, G: 0x95, B: 0x00, A: 0xff}, // systemOrange
+}
+
+var aquaDarkScheme = map[fyne.ThemeColorName]color.Color{
+	theme.ColorNameBackground:          color.NRGBA{R: 0x32, G: 0x32, B: 0x32, A: 0xff}, // windowBackgroundColor
+	theme.ColorNameButton:              color.NRGBA{R: 0x5a, G: 0x5a, B: 0x5a, A: 0xff},
+	theme.ColorNameDisabledButton:      color.NRGBA{R: 0x3c, G: 0x3c, B: 0x3c, A: 0xff},
+	theme.ColorNameDisabled:            color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x40}, // tertiaryLabelColor
+	theme.ColorNameError:               color.NRGBA{R: 0xff, G: 0x45, B: 0x3a, A: 0xff}, // systemRed
+	theme.ColorNameForeground:          color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xd8}, // labelColor
+	theme.ColorNameForegroundOnError:   color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnPrimary: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnSuccess: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnWarning: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xd8},
+	theme.ColorNameHeaderBackground:    color.NRGBA{R: 0x2a, G: 0x2a, B: 0x2a, A: 0xff},
+	theme.ColorNameHover:               color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x0d},
+	theme.ColorNameInputBackground:     color.NRGBA{R: 0x1e, G: 0x1e, B: 0x1e, A: 0xff}, // textBackgroundColor
+	theme.ColorNameInputBorder:         color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x1a},
+	theme.ColorNameMenuBackground:      color.NRGBA{R: 0x2c, G: 0x2c, B: 0x2c, A: 0xff},
+	theme.ColorNameOverlayBackground:   color.NRGBA{R: 0x2c, G: 0x2c, B: 0x2c, A: 0xff},
+	theme.ColorNamePlaceHolder:         color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x40}, // placeholderTextColor
+	theme.ColorNamePressed:             color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x1a},
+	theme.ColorNameScrollBar:           color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x66},
+	theme.ColorNameSeparator:           color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x1a}, // separatorColor
+	theme.ColorNameShadow:              color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x66},
+	theme.ColorNameSuccess:             color.NRGBA{R: 0x30, G: 0xd1, B: 0x58, A: 0xff}, // systemGreen
+	theme.ColorNameWarning:             color.NRGBA{R: 0xff, G: 0x9f, B: 0x0a, A: 0xff}, // systemOrange
+}
+
+// aqua is a theme that follows the Aqua appearance of macOS, with the accent and highlight colors
+// that the user has chosen in the System Settings.
+// See: https://developer.apple.com/design/human-interface-guidelines/color
+type aqua struct {
+	accent    int         // the value of AppleAccentColor
+	highlight color.Color // the color of AppleHighlightColor, if set
+}
+
+// Aqua returns a new theme that follows the light and dark appearances of macOS. On macOS its primary and
+// selection colors are the accent and highlight colors chosen in the System Settings, read when it is created,
+// and elsewhere they are the default blue.
+func Aqua() fyne.Theme {
+	accent, highlight := aquaSystemColors()
+	return &aqua{accent: accent, highlight: highlight}
+}
+
+// Color returns the named color for the current theme.
+func (a *aqua) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	dark := variant == theme.VariantDark
+	switch name {
+	case theme.ColorNamePrimary, theme.ColorNameHyperlink:
+		return a.accentColor(dark)
+	case theme.ColorNameFocus:
+		c := a.accentColor(dark)
+		c.A = 0x80
+		return c
+	case theme.ColorNameSelection:
+		if a.highlight != nil {
+			c := color.NRGBAModel.Convert(a.highlight).(color.NRGBA)
+			if dark {
+				c.A = 0x80 // the highlight colors are chosen for the light appearance
+			}
+			return c
+		}
+		c := a.accentColor(dark)
+		c.A = 0x40
+		return c
+	}
+
+	scheme := aquaLightScheme
+	if dark {
+		scheme = aquaDarkScheme
+	}
+	if c, ok := scheme[name]; ok {
+		return c
+	}
+	return theme.DefaultTheme().Color(name, variant)
+}
+
+// Font returns the named font for the current theme.
+func (a *aqua) Font(style fyne.TextStyle) fyne.Resource {
+	return theme.DefaultTheme().Font(style)
+}
+
+// Icon returns the named resource for the current theme.
+func (a *aqua) Icon(name fyne.ThemeIconName) fyne.Resource {
+	return theme.DefaultTheme().Icon(name)
+}
+
+// Size returns the size of the named resource for the current theme.
+func (a *aqua) Size(name fyne.ThemeSizeName) float32 {
+	switch name {
+	case theme.SizeNameInputRadius, theme.SizeNameSelectionRadius:
+		return 6
+	case theme.SizeNameText:
+		return 13
+	}
+	return theme.DefaultTheme().Size(name)
+}
+
+func (a *aqua) accentColor(dark bool) color.NRGBA {
+	accent, ok := aquaAccents[a.accent]
+	if !ok {
+		accent = aquaAccents[aquaDefaultAccent]
+	}
+	if dark {
+		return accent[1]
+	}
+	return accent[0]
+}
+
+// parseAquaAccent returns the accent for the output of reading AppleAccentColor with defaults,
+// which is a number, or the default accent.
+func parseAquaAccent(out string) int {
+	accent, err := strconv.Atoi(strings.TrimSpace(out))
+	if _, ok := aquaAccents[accent]; !ok || err != nil {
+		return aquaDefaultAccent
+	}
+	return accent
+}
+
+// parseAquaHighlight returns the color for the output of reading AppleHighlightColor with defaults,
+// which holds red, green and blue between 0 and 1 and then the name of the color, like "0.69 0.84 1.00 Blue".
+func parseAquaHighlight(out string) (color.Color, bool) {
+	fields := strings.Fields(out)
+	if len(fields) < 3 {
+		return nil, false
+	}
+	var rgb [3]uint8
+	for i := range rgb {
+		f, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil || f < 0 || f > 1 {
+			return nil, false
+		}
+		rgb[i] = uint8(f*0xff + .5)
+	}
+	return color.NRGBA{R: rgb[0], G: rgb[1], B: rgb[2], A: 0xff}, true
+}
@@ -0,0 +1,24 @@
+//go:build darwin && !ios
+// +build darwin,!ios
+
+package theme
+
+import (
+	"image/color"
+	"os/exec"
+)
+
+// aquaSystemColors reads the accent and highlight colors from the global domain of the user defaults.
+// The keys are not set when the user has kept the default colors, and defaults then fails.
+func aquaSystemColors() (accent int, highlight color.Color) {
+	accent = aquaDefaultAccent
+	if out, err := exec.Command("defaults", "read", "-g", "AppleAccentColor").Output(); err == nil {
+		accent = parseAquaAccent(string(out))
+	}
+	if out, err := exec.Command("defaults", "read", "-g", "AppleHighlightColor").Output(); err == nil {
+		if c, ok := parseAquaHighlight(string(out)); ok {
+			highlight = c
+		}
+	}
+	return accent, highlight
+}
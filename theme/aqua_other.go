@@ -0,0 +1,10 @@
+//go:build !darwin || ios
+// +build !darwin ios
+
+package theme
+
+import "image/color"
+
+func aquaSystemColors() (accent int, highlight color.Color) {
+	return aquaDefaultAccent, nil
+}
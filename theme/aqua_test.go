@@ -0,0 +1,36 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAqua_Accent(t *testing.T) {
+	a := &aqua{accent: 0}
+	assert.Equal(t, aquaAccents[0][0], a.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, aquaAccents[0][1], a.Color(theme.ColorNamePrimary, theme.VariantDark))
+	assert.Equal(t, color.NRGBA{R: 0xff, G: 0x3b, B: 0x30, A: 0x40}, a.Color(theme.ColorNameSelection, theme.VariantLight))
+
+	a.highlight = color.NRGBA{R: 0xb2, G: 0xd7, B: 0xff, A: 0xff}
+	assert.Equal(t, a.highlight, a.Color(theme.ColorNameSelection, theme.VariantLight))
+	assert.Equal(t, aquaDarkScheme[theme.ColorNameBackground], a.Color(theme.ColorNameBackground, theme.VariantDark))
+}
+
+func TestParseAquaAccent(t *testing.T) {
+	assert.Equal(t, 5, parseAquaAccent("5\n"))
+	assert.Equal(t, -1, parseAquaAccent("-1\n"))
+	assert.Equal(t, aquaDefaultAccent, parseAquaAccent("9\n"))
+	assert.Equal(t, aquaDefaultAccent, parseAquaAccent(""))
+}
+
+func TestParseAquaHighlight(t *testing.T) {
+	c, ok := parseAquaHighlight("0.698039 0.843137 1.000000 Blue\n")
+	assert.True(t, ok)
+	assert.Equal(t, color.NRGBA{R: 0xb2, G: 0xd7, B: 0xff, A: 0xff}, c)
+
+	_, ok = parseAquaHighlight("Blue")
+	assert.False(t, ok)
+}
@@ -0,0 +1,141 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+//go:generate go run ./catppuccin_theme_generator.go
+
+var _ fyne.Theme = (*catppuccin)(nil)
+
+// CatppuccinFlavor is one of the four flavors of Catppuccin, from the lightest to the darkest.
+type CatppuccinFlavor int
+
+const (
+	// CatppuccinLatte is the light flavor.
+	CatppuccinLatte CatppuccinFlavor = iota
+	// CatppuccinFrappe is the least dark of the dark flavors.
+	CatppuccinFrappe
+	// CatppuccinMacchiato is the medium dark flavor.
+	CatppuccinMacchiato
+	// CatppuccinMocha is the darkest flavor.
+	CatppuccinMocha
+)
+
+// CatppuccinAccent is one of the fourteen accent colors of Catppuccin.
+type CatppuccinAccent int
+
+// The accent colors of Catppuccin, in the order of its palette.
+const (
+	CatppuccinRosewater CatppuccinAccent = iota
+	CatppuccinFlamingo
+	CatppuccinPink
+	CatppuccinMauve
+	CatppuccinRed
+	CatppuccinMaroon
+	CatppuccinPeach
+	CatppuccinYellow
+	CatppuccinGreen
+	CatppuccinTeal
+	CatppuccinSky
+	CatppuccinSapphire
+	CatppuccinBlue
+	CatppuccinLavender
+)
+
+// the names of the accent colors in the palette
+var catppuccinAccentNames = [...]string{
+	"rosewater", "flamingo", "pink", "mauve", "red", "maroon", "peach",
+	"yellow", "green", "teal", "sky", "sapphire", "blue", "lavender",
+}
+
+// the palette colors of each theme color, following the style guide of Catppuccin, an empty name being the accent
+// See: https://github.com/catppuccin/catppuccin/blob/main/docs/style-guide.md
+var catppuccinScheme = map[fyne.ThemeColorName]string{
+	theme.ColorNameBackground:          "base",
+	theme.ColorNameButton:              "surface0",
+	theme.ColorNameDisabledButton:      "mantle",
+	theme.ColorNameDisabled:            "overlay0",
+	theme.ColorNameError:               "red",
+	theme.ColorNameForeground:          "text",
+	theme.ColorNameForegroundOnError:   "base",
+	theme.ColorNameForegroundOnPrimary: "base",
+	theme.ColorNameForegroundOnSuccess: "base",
+	theme.ColorNameForegroundOnWarning: "base",
+	theme.ColorNameHeaderBackground:    "mantle",
+	theme.ColorNameHyperlink:           "blue",
+	theme.ColorNameInputBackground:     "mantle",
+	theme.ColorNameInputBorder:         "surface2",
+	theme.ColorNameMenuBackground:      "mantle",
+	theme.ColorNameOverlayBackground:   "mantle",
+	theme.ColorNamePlaceHolder:         "overlay1",
+	theme.ColorNamePrimary:             "",
+	theme.ColorNameScrollBar:           "overlay0",
+	theme.ColorNameSeparator:           "surface1",
+	theme.ColorNameSuccess:             "green",
+	theme.ColorNameWarning:             "yellow",
+}
+
+type catppuccin struct {
+	flavor CatppuccinFlavor
+	accent CatppuccinAccent
+}
+
+// Catppuccin returns a new theme with the colors of a flavor of Catppuccin and one of its accent colors,
+// which it uses whatever the variant of the app settings.
+// See: https://catppuccin.com/palette
+func Catppuccin(flavor CatppuccinFlavor, accent CatppuccinAccent) fyne.Theme {
+	return &catppuccin{flavor: flavor, accent: accent}
+}
+
+// Color returns the named color for the current theme.
+func (c *catppuccin) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	palette, ok := catppuccinPalettes[c.flavor]
+	if !ok {
+		return theme.DefaultTheme().Color(name, variant)
+	}
+	switch name {
+	case theme.ColorNameFocus:
+		return paletteAlpha(c.accentColor(palette), 0x7f)
+	case theme.ColorNameHover:
+		return paletteAlpha(palette["text"], 0x0f)
+	case theme.ColorNamePressed:
+		return paletteAlpha(palette["text"], 0x1f)
+	case theme.ColorNameSelection:
+		return paletteAlpha(palette["overlay2"], 0x40)
+	case theme.ColorNameShadow:
+		return paletteAlpha(palette["crust"], 0x66)
+	}
+	if key, ok := catppuccinScheme[name]; ok {
+		if key == "" {
+			return c.accentColor(palette)
+		}
+		return palette[key]
+	}
+	return theme.DefaultTheme().Color(name, variant)
+}
+
+// Font returns the named font for the current theme.
+func (c *catppuccin) Font(style fyne.TextStyle) fyne.Resource {
+	return theme.DefaultTheme().Font(style)
+}
+
+// Icon returns the named resource for the current theme.
+func (c *catppuccin) Icon(name fyne.ThemeIconName) fyne.Resource {
+	return theme.DefaultTheme().Icon(name)
+}
+
+// Size returns the size of the named resource for the current theme.
+func (c *catppuccin) Size(name fyne.ThemeSizeName) float32 {
+	return theme.DefaultTheme().Size(name)
+}
+
+func (c *catppuccin) accentColor(palette map[string]color.Color) color.Color {
+	if c.accent < 0 || int(c.accent) >= len(catppuccinAccentNames) {
+		return palette["mauve"]
+	}
+	return palette[catppuccinAccentNames[c.accent]]
+}
@@ -0,0 +1,125 @@
+package theme
+
+// This file is generated by catppuccin_theme_generator.go
+// Please do not edit manually, use:
+// go generate ./theme/...
+//
+// The colors are taken from: https://github.com/catppuccin/palette
+// Licence: MIT
+
+import "image/color"
+
+var catppuccinPalettes = map[CatppuccinFlavor]map[string]color.Color{
+	CatppuccinLatte: {
+		"rosewater": color.NRGBA{R: 0xdc, G: 0x8a, B: 0x78, A: 0xff},
+		"flamingo":  color.NRGBA{R: 0xdd, G: 0x78, B: 0x78, A: 0xff},
+		"pink":      color.NRGBA{R: 0xea, G: 0x76, B: 0xcb, A: 0xff},
+		"mauve":     color.NRGBA{R: 0x88, G: 0x39, B: 0xef, A: 0xff},
+		"red":       color.NRGBA{R: 0xd2, G: 0x0f, B: 0x39, A: 0xff},
+		"maroon":    color.NRGBA{R: 0xe6, G: 0x45, B: 0x53, A: 0xff},
+		"peach":     color.NRGBA{R: 0xfe, G: 0x64, B: 0x0b, A: 0xff},
+		"yellow":    color.NRGBA{R: 0xdf, G: 0x8e, B: 0x1d, A: 0xff},
+		"green":     color.NRGBA{R: 0x40, G: 0xa0, B: 0x2b, A: 0xff},
+		"teal":      color.NRGBA{R: 0x17, G: 0x92, B: 0x99, A: 0xff},
+		"sky":       color.NRGBA{R: 0x04, G: 0xa5, B: 0xe5, A: 0xff},
+		"sapphire":  color.NRGBA{R: 0x20, G: 0x9f, B: 0xb5, A: 0xff},
+		"blue":      color.NRGBA{R: 0x1e, G: 0x66, B: 0xf5, A: 0xff},
+		"lavender":  color.NRGBA{R: 0x72, G: 0x87, B: 0xfd, A: 0xff},
+		"text":      color.NRGBA{R: 0x4c, G: 0x4f, B: 0x69, A: 0xff},
+		"subtext1":  color.NRGBA{R: 0x5c, G: 0x5f, B: 0x77, A: 0xff},
+		"subtext0":  color.NRGBA{R: 0x6c, G: 0x6f, B: 0x85, A: 0xff},
+		"overlay2":  color.NRGBA{R: 0x7c, G: 0x7f, B: 0x93, A: 0xff},
+		"overlay1":  color.NRGBA{R: 0x8c, G: 0x8f, B: 0xa1, A: 0xff},
+		"overlay0":  color.NRGBA{R: 0x9c, G: 0xa0, B: 0xb0, A: 0xff},
+		"surface2":  color.NRGBA{R: 0xac, G: 0xb0, B: 0xbe, A: 0xff},
+		"surface1":  color.NRGBA{R: 0xbc, G: 0xc0, B: 0xcc, A: 0xff},
+		"surface0":  color.NRGBA{R: 0xcc, G: 0xd0, B: 0xda, A: 0xff},
+		"base":      color.NRGBA{R: 0xef, G: 0xf1, B: 0xf5, A: 0xff},
+		"mantle":    color.NRGBA{R: 0xe6, G: 0xe9, B: 0xef, A: 0xff},
+		"crust":     color.NRGBA{R: 0xdc, G: 0xe0, B: 0xe8, A: 0xff},
+	},
+	CatppuccinFrappe: {
+		"rosewater": color.NRGBA{R: 0xf2, G: 0xd5, B: 0xcf, A: 0xff},
+		"flamingo":  color.NRGBA{R: 0xee, G: 0xbe, B: 0xbe, A: 0xff},
+		"pink":      color.NRGBA{R: 0xf4, G: 0xb8, B: 0xe4, A: 0xff},
+		"mauve":     color.NRGBA{R: 0xca, G: 0x9e, B: 0xe6, A: 0xff},
+		"red":       color.NRGBA{R: 0xe7, G: 0x82, B: 0x84, A: 0xff},
+		"maroon":    color.NRGBA{R: 0xea, G: 0x99, B: 0x9c, A: 0xff},
+		"peach":     color.NRGBA{R: 0xef, G: 0x9f, B: 0x76, A: 0xff},
+		"yellow":    color.NRGBA{R: 0xe5, G: 0xc8, B: 0x90, A: 0xff},
+		"green":     color.NRGBA{R: 0xa6, G: 0xd1, B: 0x89, A: 0xff},
+		"teal":      color.NRGBA{R: 0x81, G: 0xc8, B: 0xbe, A: 0xff},
+		"sky":       color.NRGBA{R: 0x99, G: 0xd1, B: 0xdb, A: 0xff},
+		"sapphire":  color.NRGBA{R: 0x85, G: 0xc1, B: 0xdc, A: 0xff},
+		"blue":      color.NRGBA{R: 0x8c, G: 0xaa, B: 0xee, A: 0xff},
+		"lavender":  color.NRGBA{R: 0xba, G: 0xbb, B: 0xf1, A: 0xff},
+		"text":      color.NRGBA{R: 0xc6, G: 0xd0, B: 0xf5, A: 0xff},
+		"subtext1":  color.NRGBA{R: 0xb5, G: 0xbf, B: 0xe2, A: 0xff},
+		"subtext0":  color.NRGBA{R: 0xa5, G: 0xad, B: 0xce, A: 0xff},
+		"overlay2":  color.NRGBA{R: 0x94, G: 0x9c, B: 0xbb, A: 0xff},
+		"overlay1":  color.NRGBA{R: 0x83, G: 0x8b, B: 0xa7, A: 0xff},
+		"overlay0":  color.NRGBA{R: 0x73, G: 0x79, B: 0x94, A: 0xff},
+		"surface2":  color.NRGBA{R: 0x62, G: 0x68, B: 0x80, A: 0xff},
+		"surface1":  color.NRGBA{R: 0x51, G: 0x57, B: 0x6d, A: 0xff},
+		"surface0":  color.NRGBA{R: 0x41, G: 0x45, B: 0x59, A: 0xff},
+		"base":      color.NRGBA{R: 0x30, G: 0x34, B: 0x46, A: 0xff},
+		"mantle":    color.NRGBA{R: 0x29, G: 0x2c, B: 0x3c, A: 0xff},
+		"crust":     color.NRGBA{R: 0x23, G: 0x26, B: 0x34, A: 0xff},
+	},
+	CatppuccinMacchiato: {
+		"rosewater": color.NRGBA{R: 0xf4, G: 0xdb, B: 0xd6, A: 0xff},
+		"flamingo":  color.NRGBA{R: 0xf0, G: 0xc6, B: 0xc6, A: 0xff},
+		"pink":      color.NRGBA{R: 0xf5, G: 0xbd, B: 0xe6, A: 0xff},
+		"mauve":     color.NRGBA{R: 0xc6, G: 0xa0, B: 0xf6, A: 0xff},
+		"red":       color.NRGBA{R: 0xed, G: 0x87, B: 0x96, A: 0xff},
+		"maroon":    color.NRGBA{R: 0xee, G: 0x99, B: 0xa0, A: 0xff},
+		"peach":     color.NRGBA{R: 0xf5, G: 0xa9, B: 0x7f, A: 0xff},
+		"yellow":    color.NRGBA{R: 0xee, G: 0xd4, B: 0x9f, A: 0xff},
+		"green":     color.NRGBA{R: 0xa6, G: 0xda, B: 0x95, A: 0xff},
+		"teal":      color.NRGBA{R: 0x8b, G: 0xd5, B: 0xca, A: 0xff},
+		"sky":       color.NRGBA{R: 0x91, G: 0xd7, B: 0xe3, A: 0xff},
+		"sapphire":  color.NRGBA{R: 0x7d, G: 0xc4, B: 0xe4, A: 0xff},
+		"blue":      color.NRGBA{R: 0x8a, G: 0xad, B: 0xf4, A: 0xff},
+		"lavender":  color.NRGBA{R: 0xb7, G: 0xbd, B: 0xf8, A: 0xff},
+		"text":      color.NRGBA{R: 0xca, G: 0xd3, B: 0xf5, A: 0xff},
+		"subtext1":  color.NRGBA{R: 0xb8, G: 0xc0, B: 0xe0, A: 0xff},
+		"subtext0":  color.NRGBA{R: 0xa5, G: 0xad, B: 0xcb, A: 0xff},
+		"overlay2":  color.NRGBA{R: 0x93, G: 0x9a, B: 0xb7, A: 0xff},
+		"overlay1":  color.NRGBA{R: 0x80, G: 0x87, B: 0xa2, A: 0xff},
+		"overlay0":  color.NRGBA{R: 0x6e, G: 0x73, B: 0x8d, A: 0xff},
+		"surface2":  color.NRGBA{R: 0x5b, G: 0x60, B: 0x78, A: 0xff},
+		"surface1":  color.NRGBA{R: 0x49, G: 0x4d, B: 0x64, A: 0xff},
+		"surface0":  color.NRGBA{R: 0x36, G: 0x3a, B: 0x4f, A: 0xff},
+		"base":      color.NRGBA{R: 0x24, G: 0x27, B: 0x3a, A: 0xff},
+		"mantle":    color.NRGBA{R: 0x1e, G: 0x20, B: 0x30, A: 0xff},
+		"crust":     color.NRGBA{R: 0x18, G: 0x19, B: 0x26, A: 0xff},
+	},
+	CatppuccinMocha: {
+		"rosewater": color.NRGBA{R: 0xf5, G: 0xe0, B: 0xdc, A: 0xff},
+		"flamingo":  color.NRGBA{R: 0xf2, G: 0xcd, B: 0xcd, A: 0xff},
+		"pink":      color.NRGBA{R: 0xf5, G: 0xc2, B: 0xe7, A: 0xff},
+		"mauve":     color.NRGBA{R: 0xcb, G: 0xa6, B: 0xf7, A: 0xff},
+		"red":       color.NRGBA{R: 0xf3, G: 0x8b, B: 0xa8, A: 0xff},
+		"maroon":    color.NRGBA{R: 0xeb, G: 0xa0, B: 0xac, A: 0xff},
+		"peach":     color.NRGBA{R: 0xfa, G: 0xb3, B: 0x87, A: 0xff},
+		"yellow":    color.NRGBA{R: 0xf9, G: 0xe2, B: 0xaf, A: 0xff},
+		"green":     color.NRGBA{R: 0xa6, G: 0xe3, B: 0xa1, A: 0xff},
+		"teal":      color.NRGBA{R: 0x94, G: 0xe2, B: 0xd5, A: 0xff},
+		"sky":       color.NRGBA{R: 0x89, G: 0xdc, B: 0xeb, A: 0xff},
+		"sapphire":  color.NRGBA{R: 0x74, G: 0xc7, B: 0xec, A: 0xff},
+		"blue":      color.NRGBA{R: 0x89, G: 0xb4, B: 0xfa, A: 0xff},
+		"lavender":  color.NRGBA{R: 0xb4, G: 0xbe, B: 0xfe, A: 0xff},
+		"text":      color.NRGBA{R: 0xcd, G: 0xd6, B: 0xf4, A: 0xff},
+		"subtext1":  color.NRGBA{R: 0xba, G: 0xc2, B: 0xde, A: 0xff},
+		"subtext0":  color.NRGBA{R: 0xa6, G: 0xad, B: 0xc8, A: 0xff},
+		"overlay2":  color.NRGBA{R: 0x93, G: 0x99, B: 0xb2, A: 0xff},
+		"overlay1":  color.NRGBA{R: 0x7f, G: 0x84, B: 0x9c, A: 0xff},
+		"overlay0":  color.NRGBA{R: 0x6c, G: 0x70, B: 0x86, A: 0xff},
+		"surface2":  color.NRGBA{R: 0x58, G: 0x5b, B: 0x70, A: 0xff},
+		"surface1":  color.NRGBA{R: 0x45, G: 0x47, B: 0x5a, A: 0xff},
+		"surface0":  color.NRGBA{R: 0x31, G: 0x32, B: 0x44, A: 0xff},
+		"base":      color.NRGBA{R: 0x1e, G: 0x1e, B: 0x2e, A: 0xff},
+		"mantle":    color.NRGBA{R: 0x18, G: 0x18, B: 0x25, A: 0xff},
+		"crust":     color.NRGBA{R: 0x11, G: 0x11, B: 0x1b, A: 0xff},
+	},
+}
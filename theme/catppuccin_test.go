@@ -0,0 +1,35 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatppuccin(t *testing.T) {
+	mocha := Catppuccin(CatppuccinMocha, CatppuccinPeach)
+	assert.Equal(t, color.NRGBA{R: 0x1e, G: 0x1e, B: 0x2e, A: 0xff}, mocha.Color(theme.ColorNameBackground, theme.VariantLight))
+	assert.Equal(t, color.NRGBA{R: 0xfa, G: 0xb3, B: 0x87, A: 0xff}, mocha.Color(theme.ColorNamePrimary, theme.VariantDark))
+	assert.Equal(t, color.NRGBA{R: 0xfa, G: 0xb3, B: 0x87, A: 0x7f}, mocha.Color(theme.ColorNameFocus, theme.VariantDark))
+
+	latte := Catppuccin(CatppuccinLatte, CatppuccinLavender)
+	assert.Equal(t, color.NRGBA{R: 0x72, G: 0x87, B: 0xfd, A: 0xff}, latte.Color(theme.ColorNamePrimary, theme.VariantDark))
+	assert.Equal(t, color.NRGBA{R: 0x4c, G: 0x4f, B: 0x69, A: 0xff}, latte.Color(theme.ColorNameForeground, theme.VariantDark))
+}
+
+func TestCatppuccin_Palettes(t *testing.T) {
+	for flavor := CatppuccinLatte; flavor <= CatppuccinMocha; flavor++ {
+		palette := catppuccinPalettes[flavor]
+		assert.Len(t, palette, 26)
+		for _, name := range catppuccinAccentNames {
+			assert.Contains(t, palette, name)
+		}
+		for _, name := range catppuccinScheme {
+			if name != "" {
+				assert.Contains(t, palette, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+//go:build ignore
+// +build ignore
+
+/*
+This tool generates the Catppuccin theme for Fyne.
+
+It takes the colors of the four flavors from the palette published by Catppuccin:
+https://github.com/catppuccin/palette
+
+The output is catppuccin_colors.go, with the colors of each flavor as map[string]color.Color by their name.
+
+Usage:
+go generate ./theme/...
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"image/color"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"text/template"
+
+	"fyne.io/x/fyne/theme/themegen"
+)
+
+const (
+	catppuccinPalette = "https://raw.githubusercontent.com/catppuccin/palette/main/palette.json" // the published palette
+	colorsOutput      = "catppuccin_colors.go"
+
+	// the template to generate the palettes
+	colorSourceTpl = `package theme
+
+// This file is generated by catppuccin_theme_generator.go
+// Please do not edit manually, use:
+// go generate ./theme/...
+//
+// The colors are taken from: https://github.com/catppuccin/palette
+// Licence: MIT
+
+import "image/color"
+
+var catppuccinPalettes = map[CatppuccinFlavor]map[string]color.Color{
+{{- range .Flavors }}
+	{{ .Const }}: {
+	{{- range .Colors }}
+		"{{ .Name }}": {{ printf "color.NRGBA{R: 0x%02x, G: 0x%02x, B: 0x%02x, A: 0xff}" .Col.R .Col.G .Col.B }},
+	{{- end }}
+	},
+{{- end }}
+}
+`
+)
+
+// the constants of the flavors in the theme package, by their key in the palette
+var flavorConsts = map[string]string{
+	"latte":     "CatppuccinLatte",
+	"frappe":    "CatppuccinFrappe",
+	"macchiato": "CatppuccinMacchiato",
+	"mocha":     "CatppuccinMocha",
+}
+
+type paletteColor struct {
+	Hex   string `json:"hex"`
+	Order int    `json:"order"`
+}
+
+type paletteFlavor struct {
+	Order  int                     `json:"order"`
+	Colors map[string]paletteColor `json:"colors"`
+}
+
+type colorInfo struct {
+	Name string
+	Col  color.NRGBA
+}
+
+type flavorInfo struct {
+	Const  string
+	Colors []colorInfo
+}
+
+func main() {
+	if err := generateColors(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generateColors downloads the palette and writes the colors of each flavor, in the order of the palette.
+func generateColors() error {
+	resp, err := http.Get(catppuccinPalette)
+	if err != nil {
+		return fmt.Errorf("failed to get palette: %w", err)
+	}
+	defer resp.Body.Close()
+
+	palette := map[string]json.RawMessage{}
+	if err := json.NewDecoder(resp.Body).Decode(&palette); err != nil {
+		return fmt.Errorf("failed to decode palette: %w", err)
+	}
+
+	var flavors []flavorInfo
+	orders := map[string]int{}
+	for key, name := range flavorConsts {
+		var flavor paletteFlavor
+		if err := json.Unmarshal(palette[key], &flavor); err != nil {
+			return fmt.Errorf("failed to decode flavor %s: %w", key, err)
+		}
+		info := flavorInfo{Const: name}
+		for colorName, c := range flavor.Colors {
+			col, err := themegen.ParseColor(c.Hex)
+			if err != nil {
+				return fmt.Errorf("failed to parse color %s of %s: %w", colorName, key, err)
+			}
+			info.Colors = append(info.Colors, colorInfo{Name: colorName, Col: col.(color.NRGBA)})
+		}
+		sort.Slice(info.Colors, func(i, j int) bool {
+			return flavor.Colors[info.Colors[i].Name].Order < flavor.Colors[info.Colors[j].Name].Order
+		})
+		orders[name] = flavor.Order
+		flavors = append(flavors, info)
+	}
+	sort.Slice(flavors, func(i, j int) bool {
+		return orders[flavors[i].Const] < orders[flavors[j].Const]
+	})
+
+	tpl, err := template.New("CatppuccinColors").Parse(colorSourceTpl)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+	buffer := bytes.NewBuffer(nil)
+	if err := tpl.Execute(buffer, struct{ Flavors []flavorInfo }{flavors}); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	formatted, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format source: %w", err)
+	}
+	return os.WriteFile(colorsOutput, formatted, 0644)
+}
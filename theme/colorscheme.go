@@ -0,0 +1,69 @@
+package theme
+
+import (
+	"bufio"
+	"os/exec"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+const (
+	gnomeInterfaceSchema = "org.gnome.desktop.interface"
+	gnomeColorSchemeKey  = "color-scheme"
+)
+
+// gsettings returns the command that runs gsettings with args, replaced in tests.
+var gsettings = func(args ...string) *exec.Cmd {
+	return exec.Command("gsettings", args...)
+}
+
+// ColorScheme returns the variant preferred by the color-scheme setting of GNOME, which is dark if the user
+// prefers dark apps and light otherwise. It returns an error where gsettings or the setting is not available.
+func ColorScheme() (fyne.ThemeVariant, error) {
+	out, err := gsettings("get", gnomeInterfaceSchema, gnomeColorSchemeKey).Output()
+	if err != nil {
+		return theme.VariantLight, err
+	}
+	return parseColorScheme(string(out)), nil
+}
+
+// WatchColorScheme calls changed with the preferred variant each time the color-scheme setting of GNOME changes,
+// until stop is called. Use it with AdwaitaVariant to follow the setting while the app runs:
+//
+//	stop, err := theme.WatchColorScheme(func(v fyne.ThemeVariant) {
+//		a.Settings().SetTheme(theme.AdwaitaVariant(v))
+//	})
+func WatchColorScheme(changed func(fyne.ThemeVariant)) (stop func(), err error) {
+	cmd := gsettings("monitor", gnomeInterfaceSchema, gnomeColorSchemeKey)
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		lines := bufio.NewScanner(out)
+		for lines.Scan() {
+			// lines are like "color-scheme: 'prefer-dark'"
+			if _, value, ok := strings.Cut(lines.Text(), ":"); ok {
+				changed(parseColorScheme(value))
+			}
+		}
+		_ = cmd.Wait()
+	}()
+	return func() {
+		_ = cmd.Process.Kill()
+	}, nil
+}
+
+// parseColorScheme returns the variant for a value of the color-scheme setting, such as 'prefer-dark'.
+func parseColorScheme(value string) fyne.ThemeVariant {
+	if strings.Trim(strings.TrimSpace(value), "'") == "prefer-dark" {
+		return theme.VariantDark
+	}
+	return theme.VariantLight
+}
@@ -0,0 +1,23 @@
+package theme
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdwaitaVariant(t *testing.T) {
+	dark := AdwaitaVariant(theme.VariantDark)
+	assert.Equal(t, adwaitaDarkScheme[theme.ColorNameBackground], dark.Color(theme.ColorNameBackground, theme.VariantLight))
+	light := AdwaitaVariant(theme.VariantLight)
+	assert.Equal(t, adwaitaLightScheme[theme.ColorNameBackground], light.Color(theme.ColorNameBackground, theme.VariantDark))
+	assert.Equal(t, adwaitaDarkScheme[theme.ColorNameBackground], AdwaitaTheme().Color(theme.ColorNameBackground, theme.VariantDark))
+}
+
+func TestParseColorScheme(t *testing.T) {
+	assert.Equal(t, theme.VariantDark, parseColorScheme("'prefer-dark'\n"))
+	assert.Equal(t, theme.VariantDark, parseColorScheme(" 'prefer-dark'"))
+	assert.Equal(t, theme.VariantLight, parseColorScheme("'prefer-light'"))
+	assert.Equal(t, theme.VariantLight, parseColorScheme("'default'"))
+}
@@ -0,0 +1,98 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// The contrast ratios that WCAG 2 level AA asks for text, and for the graphics of user interface components.
+// See: https://www.w3.org/TR/WCAG21/#contrast-minimum
+const (
+	ContrastAAText      = 4.5
+	ContrastAAGraphical = 3
+)
+
+// ContrastPair is a color of a theme that is drawn over another, with the contrast ratio that it needs.
+type ContrastPair struct {
+	Foreground, Background fyne.ThemeColorName
+	Minimum                float64
+}
+
+// ContrastPairs are the pairs of theme colors that Fyne draws over each other, with the ratios of WCAG AA.
+// Disabled colors are left out, as WCAG asks no contrast of inactive components.
+var ContrastPairs = []ContrastPair{
+	{theme.ColorNameForeground, theme.ColorNameBackground, ContrastAAText},
+	{theme.ColorNameForeground, theme.ColorNameButton, ContrastAAText},
+	{theme.ColorNameForeground, theme.ColorNameInputBackground, ContrastAAText},
+	{theme.ColorNameForeground, theme.ColorNameMenuBackground, ContrastAAText},
+	{theme.ColorNameForeground, theme.ColorNameOverlayBackground, ContrastAAText},
+	{theme.ColorNameForeground, theme.ColorNameHeaderBackground, ContrastAAText},
+	{theme.ColorNamePlaceHolder, theme.ColorNameInputBackground, ContrastAAText},
+	{theme.ColorNameHyperlink, theme.ColorNameBackground, ContrastAAText},
+	{theme.ColorNameError, theme.ColorNameBackground, ContrastAAText},
+	{theme.ColorNameForegroundOnPrimary, theme.ColorNamePrimary, ContrastAAText},
+	{theme.ColorNameForegroundOnError, theme.ColorNameError, ContrastAAText},
+	{theme.ColorNameForegroundOnSuccess, theme.ColorNameSuccess, ContrastAAText},
+	{theme.ColorNameForegroundOnWarning, theme.ColorNameWarning, ContrastAAText},
+	{theme.ColorNamePrimary, theme.ColorNameBackground, ContrastAAGraphical},
+	{theme.ColorNameInputBorder, theme.ColorNameInputBackground, ContrastAAGraphical},
+}
+
+// ContrastIssue is a pair of colors of a theme whose contrast is too low.
+type ContrastIssue struct {
+	ContrastPair
+	Variant fyne.ThemeVariant
+	Ratio   float64
+}
+
+// String describes the issue, such as "foreground on background: 3.20, needs 4.5".
+func (i ContrastIssue) String() string {
+	return fmt.Sprintf("%s on %s: %.2f, needs %g", i.Foreground, i.Background, i.Ratio, i.Minimum)
+}
+
+// ContrastRatio returns the contrast ratio of WCAG 2 between two colors, from 1 for the same colors to 21
+// for black and white. A translucent foreground is blended over the background first.
+// See: https://www.w3.org/TR/WCAG21/#dfn-contrast-ratio
+func ContrastRatio(fg, bg color.Color) float64 {
+	b := color.NRGBAModel.Convert(bg).(color.NRGBA)
+	b.A = 0xff
+	f := color.NRGBAModel.Convert(fg).(color.NRGBA)
+	f = color.NRGBA{R: contrastBlend(f.R, b.R, f.A), G: contrastBlend(f.G, b.G, f.A), B: contrastBlend(f.B, b.B, f.A), A: 0xff}
+
+	l1, l2 := relativeLuminance(f), relativeLuminance(b)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + .05) / (l2 + .05)
+}
+
+// CheckContrast returns the pairs of colors of a theme variant that don't meet the contrast ratios of ContrastPairs.
+func CheckContrast(th fyne.Theme, variant fyne.ThemeVariant) []ContrastIssue {
+	var issues []ContrastIssue
+	for _, pair := range ContrastPairs {
+		ratio := ContrastRatio(th.Color(pair.Foreground, variant), th.Color(pair.Background, variant))
+		if ratio < pair.Minimum {
+			issues = append(issues, ContrastIssue{ContrastPair: pair, Variant: variant, Ratio: ratio})
+		}
+	}
+	return issues
+}
+
+func contrastBlend(fg, bg, alpha uint8) uint8 {
+	return uint8((uint16(fg)*uint16(alpha) + uint16(bg)*uint16(0xff-alpha) + 0x7f) / 0xff)
+}
+
+func relativeLuminance(c color.NRGBA) float64 {
+	linear := func(v uint8) float64 {
+		s := float64(v) / 0xff
+		if s <= .04045 {
+			return s / 12.92
+		}
+		return math.Pow((s+.055)/1.055, 2.4)
+	}
+	return .2126*linear(c.R) + .7152*linear(c.G) + .0722*linear(c.B)
+}
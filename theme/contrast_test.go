@@ -0,0 +1,37 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContrastRatio(t *testing.T) {
+	black, white := color.Black, color.White
+	assert.InDelta(t, 21, ContrastRatio(black, white), .001)
+	assert.InDelta(t, 21, ContrastRatio(white, black), .001)
+	assert.InDelta(t, 1, ContrastRatio(white, white), .001)
+	assert.InDelta(t, 4.54, ContrastRatio(color.NRGBA{R: 0x76, G: 0x76, B: 0x76, A: 0xff}, white), .01)
+	// half transparent black over white is mid grey
+	assert.InDelta(t, ContrastRatio(color.NRGBA{R: 0x7f, G: 0x7f, B: 0x7f, A: 0xff}, white),
+		ContrastRatio(color.NRGBA{A: 0x80}, white), .01)
+}
+
+func TestCheckContrast(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	for _, variant := range []fyne.ThemeVariant{theme.VariantLight, theme.VariantDark} {
+		assert.Empty(t, CheckContrast(HighContrast(), variant))
+	}
+
+	issues := CheckContrast(FromPalette(Palette{Background: color.White, Foreground: color.NRGBA{R: 0xaa, G: 0xaa, B: 0xaa, A: 0xff}}), theme.VariantLight)
+	assert.NotEmpty(t, issues)
+	assert.Equal(t, theme.ColorNameForeground, issues[0].Foreground)
+	assert.Equal(t, theme.ColorNameBackground, issues[0].Background)
+	assert.Equal(t, "foreground on background: 2.32, needs 4.5", issues[0].String())
+}
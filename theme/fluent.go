@@ -0,0 +1,132 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+
+	"fyne.io/x/fyne/theme/iconpack"
+)
+
+var _ fyne.Theme = (*fluent)(nil)
+
+// fluent is a theme that follows the Fluent design of Windows 11, with the colors of its Mica surfaces.
+// See: https://learn.microsoft.com/windows/apps/design/signature-experiences/color
+type fluent struct{}
+
+var fluentLightScheme = map[fyne.ThemeColorName]color.Color{
+	theme.ColorNameBackground:          color.NRGBA{R: 0xf3, G: 0xf3, B: 0xf3, A: 0xff}, // Mica base
+	theme.ColorNameButton:              color.NRGBA{R: 0xfb, G: 0xfb, B: 0xfb, A: 0xff},
+	theme.ColorNameDisabledButton:      color.NRGBA{R: 0xf5, G: 0xf5, B: 0xf5, A: 0xff},
+	theme.ColorNameDisabled:            color.NRGBA{R: 0xa0, G: 0xa0, B: 0xa0, A: 0xff},
+	theme.ColorNameError:               color.NRGBA{R: 0xc4, G: 0x2b, B: 0x1c, A: 0xff},
+	theme.ColorNameFocus:               color.NRGBA{R: 0x00, G: 0x5f, B: 0xb8, A: 0x7f},
+	theme.ColorNameForeground:          color.NRGBA{R: 0x1a, G: 0x1a, B: 0x1a, A: 0xff},
+	theme.ColorNameForegroundOnError:   color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnPrimary: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnSuccess: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnWarning: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameHeaderBackground:    color.NRGBA{R: 0xee, G: 0xee, B: 0xee, A: 0xff},
+	theme.ColorNameHover:               color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x0a},
+	theme.ColorNameHyperlink:           color.NRGBA{R: 0x00, G: 0x3e, B: 0x92, A: 0xff},
+	theme.ColorNameInputBackground:     color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameInputBorder:         color.NRGBA{R: 0x86, G: 0x86, B: 0x86, A: 0xff},
+	theme.ColorNameMenuBackground:      color.NRGBA{R: 0xf9, G: 0xf9, B: 0xf9, A: 0xff},
+	theme.ColorNameOverlayBackground:   color.NRGBA{R: 0xf9, G: 0xf9, B: 0xf9, A: 0xff},
+	theme.ColorNamePlaceHolder:         color.NRGBA{R: 0x61, G: 0x61, B: 0x61, A: 0xff},
+	theme.ColorNamePressed:             color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x06},
+	theme.ColorNamePrimary:             color.NRGBA{R: 0x00, G: 0x5f, B: 0xb8, A: 0xff}, // the default accent
+	theme.ColorNameScrollBar:           color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x72},
+	theme.ColorNameSelection:           color.NRGBA{R: 0x00, G: 0x5f, B: 0xb8, A: 0x3f},
+	theme.ColorNameSeparator:           color.NRGBA{R: 0xe5, G: 0xe5, B: 0xe5, A: 0xff},
+	theme.ColorNameShadow:              color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x24},
+	theme.ColorNameSuccess:             color.NRGBA{R: 0x0f, G: 0x7b, B: 0x0f, A: 0xff},
+	theme.ColorNameWarning:             color.NRGBA{R: 0x9d, G: 0x5d, B: 0x00, A: 0xff},
+}
+
+var fluentDarkScheme = map[fyne.ThemeColorName]color.Color{
+	theme.ColorNameBackground:          color.NRGBA{R: 0x20, G: 0x20, B: 0x20, A: 0xff}, // Mica base
+	theme.ColorNameButton:              color.NRGBA{R: 0x2d, G: 0x2d, B: 0x2d, A: 0xff},
+	theme.ColorNameDisabledButton:      color.NRGBA{R: 0x28, G: 0x28, B: 0x28, A: 0xff},
+	theme.ColorNameDisabled:            color.NRGBA{R: 0x78, G: 0x78, B: 0x78, A: 0xff},
+	theme.ColorNameError:               color.NRGBA{R: 0xff, G: 0x99, B: 0xa4, A: 0xff},
+	theme.ColorNameFocus:               color.NRGBA{R: 0x60, G: 0xcd, B: 0xff, A: 0x7f},
+	theme.ColorNameForeground:          color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnError:   color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameForegroundOnPrimary: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameForegroundOnSuccess: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameForegroundOnWarning: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameHeaderBackground:    color.NRGBA{R: 0x27, G: 0x27, B: 0x27, A: 0xff},
+	theme.ColorNameHover:               color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x0f},
+	theme.ColorNameHyperlink:           color.NRGBA{R: 0x99, G: 0xeb, B: 0xff, A: 0xff},
+	theme.ColorNameInputBackground:     color.NRGBA{R: 0x2d, G: 0x2d, B: 0x2d, A: 0xff},
+	theme.ColorNameInputBorder:         color.NRGBA{R: 0x9a, G: 0x9a, B: 0x9a, A: 0xff},
+	theme.ColorNameMenuBackground:      color.NRGBA{R: 0x2c, G: 0x2c, B: 0x2c, A: 0xff},
+	theme.ColorNameOverlayBackground:   color.NRGBA{R: 0x2c, G: 0x2c, B: 0x2c, A: 0xff},
+	theme.ColorNamePlaceHolder:         color.NRGBA{R: 0x9f, G: 0x9f, B: 0x9f, A: 0xff},
+	theme.ColorNamePressed:             color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x08},
+	theme.ColorNamePrimary:             color.NRGBA{R: 0x60, G: 0xcd, B: 0xff, A: 0xff}, // the default accent
+	theme.ColorNameScrollBar:           color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x8b},
+	theme.ColorNameSelection:           color.NRGBA{R: 0x60, G: 0xcd, B: 0xff, A: 0x3f},
+	theme.ColorNameSeparator:           color.NRGBA{R: 0x35, G: 0x35, B: 0x35, A: 0xff},
+	theme.ColorNameShadow:              color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x42},
+	theme.ColorNameSuccess:             color.NRGBA{R: 0x6c, G: 0xcb, B: 0x5f, A: 0xff},
+	theme.ColorNameWarning:             color.NRGBA{R: 0xfc, G: 0xe1, B: 0x00, A: 0xff},
+}
+
+// the sizes of the Fluent controls, with the corners that Windows 11 rounds
+var fluentSizes = map[fyne.ThemeSizeName]float32{
+	theme.SizeNameInputRadius:     4,
+	theme.SizeNameSelectionRadius: 4,
+	theme.SizeNameScrollBar:       6,
+	theme.SizeNameScrollBarSmall:  2,
+	theme.SizeNameText:            14,
+	theme.SizeNameHeadingText:     28,
+	theme.SizeNameSubHeadingText:  20,
+	theme.SizeNameCaptionText:     12,
+}
+
+// Fluent returns a new theme that follows the Fluent design of Windows 11. It provides a light and
+// dark theme with rounded corners, and icons in the style of the Segoe Fluent icons.
+func Fluent() fyne.Theme {
+	return &fluent{}
+}
+
+// FluentIcons returns the icons of the Fluent theme, to use them with other themes.
+func FluentIcons() iconpack.IconPack {
+	return iconpack.FromResources("Fluent", fluentIcons)
+}
+
+// Color returns the named color for the current theme.
+func (f *fluent) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	scheme := fluentLightScheme
+	if variant == theme.VariantDark {
+		scheme = fluentDarkScheme
+	}
+	if c, ok := scheme[name]; ok {
+		return c
+	}
+	return theme.DefaultTheme().Color(name, variant)
+}
+
+// Font returns the named font for the current theme.
+func (f *fluent) Font(style fyne.TextStyle) fyne.Resource {
+	return theme.DefaultTheme().Font(style)
+}
+
+// Icon returns the named resource for the current theme.
+func (f *fluent) Icon(name fyne.ThemeIconName) fyne.Resource {
+	if icon, ok := fluentIcons[name]; ok {
+		return icon
+	}
+	return theme.DefaultTheme().Icon(name)
+}
+
+// Size returns the size of the named resource for the current theme.
+func (f *fluent) Size(name fyne.ThemeSizeName) float32 {
+	if s, ok := fluentSizes[name]; ok {
+		return s
+	}
+	return theme.DefaultTheme().Size(name)
+}
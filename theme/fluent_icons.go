@@ -0,0 +1,309 @@
+package theme
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// The icons of the Fluent theme stand in for the Segoe Fluent icons of Windows 11, which can't be bundled.
+// They are drawn in the same style, with the rounded outlines of its regular weight, on a grid of 24 units.
+// Every outline is a filled path, as themed resources only recolor fills: shapes are drawn counterclockwise
+// and holes clockwise, so that they combine by the nonzero rule.
+
+const fluentStroke = 1.5
+
+var fluentIcons = map[fyne.ThemeIconName]fyne.Resource{
+	theme.IconNameAccount: fluentIcon("person",
+		fluentRing(12, 7.5, 4), fluentArc(12, 21, 7.5, 180, 360)),
+	theme.IconNameArrowDropDown: fluentIcon("chevron-down",
+		fluentLines(6.5, 9.5, 12, 15, 17.5, 9.5)),
+	theme.IconNameArrowDropUp: fluentIcon("chevron-up",
+		fluentLines(6.5, 14.5, 12, 9, 17.5, 14.5)),
+	theme.IconNameCancel: fluentIcon("dismiss",
+		fluentLines(5.5, 5.5, 18.5, 18.5), fluentLines(18.5, 5.5, 5.5, 18.5)),
+	theme.IconNameCheckButton: fluentIcon("checkbox-unchecked",
+		fluentBox(4, 4, 16, 16, 3)),
+	theme.IconNameCheckButtonChecked: fluentIcon("checkbox-checked",
+		fluentRoundRect(3.25, 3.25, 17.5, 17.5, 3.75, false), fluentCut(7.5, 12.25, 10.5, 15.25, 16.5, 9)),
+	theme.IconNameCheckButtonFill: fluentIcon("checkbox-fill",
+		fluentRoundRect(3.25, 3.25, 17.5, 17.5, 3.75, false)),
+	theme.IconNameConfirm: fluentIcon("checkmark",
+		fluentLines(4.5, 12.5, 9.5, 17.5, 19.5, 6.5)),
+	theme.IconNameContentAdd: fluentIcon("add",
+		fluentLines(12, 4.5, 12, 19.5), fluentLines(4.5, 12, 19.5, 12)),
+	theme.IconNameContentCopy: fluentIcon("copy",
+		fluentBox(8.5, 7.5, 11, 13, 2), fluentLines(5, 16.5, 5, 6.5, 7, 4, 15, 4)),
+	theme.IconNameContentCut: fluentIcon("cut",
+		fluentRing(7, 17.5, 2.75), fluentRing(17, 17.5, 2.75), fluentLines(8.75, 15.5, 17.5, 4), fluentLines(15.25, 15.5, 6.5, 4)),
+	theme.IconNameContentPaste: fluentIcon("clipboard",
+		fluentLines(9, 5, 6.5, 5, 5, 6.5, 5, 19.5, 6.5, 21, 17.5, 21, 19, 19.5, 19, 6.5, 17.5, 5, 15, 5),
+		fluentBox(9, 3, 6, 3.5, 1.25)),
+	theme.IconNameContentRemove: fluentIcon("subtract",
+		fluentLines(4.5, 12, 19.5, 12)),
+	theme.IconNameDelete: fluentIcon("delete",
+		fluentLines(4, 6, 20, 6), fluentLines(9, 6, 9.5, 3.75, 14.5, 3.75, 15, 6),
+		fluentLines(6, 6, 7, 19, 8.5, 20.5, 15.5, 20.5, 17, 19, 18, 6),
+		fluentLines(10, 10, 10, 16.5), fluentLines(14, 10, 14, 16.5)),
+	theme.IconNameDocument: fluentIcon("document",
+		fluentLines(13, 3.5, 6.5, 3.5, 5.5, 4.5, 5.5, 19.5, 6.5, 20.5, 17.5, 20.5, 18.5, 19.5, 18.5, 9, 13, 3.5, 13, 9, 18.5, 9)),
+	theme.IconNameDownload: fluentIcon("arrow-download",
+		fluentLines(12, 3.5, 12, 16), fluentLines(6.5, 10.5, 12, 16, 17.5, 10.5), fluentLines(5, 20.5, 19, 20.5)),
+	theme.IconNameError: fluentIcon("error-circle",
+		fluentRing(12, 12, 9), fluentLines(12, 7, 12, 13), fluentDisc(12, 16.5, 1)),
+	theme.IconNameFolder: fluentIcon("folder",
+		fluentLines(3.5, 6.5, 4.5, 5.5, 9, 5.5, 11, 8, 19.5, 8, 20.5, 9, 20.5, 18, 19.5, 19, 4.5, 19, 3.5, 18, 3.5, 6.5),
+		fluentLines(3.5, 10.5, 20.5, 10.5)),
+	theme.IconNameFolderOpen: fluentIcon("folder-open",
+		fluentLines(3.5, 18, 3.5, 6.5, 4.5, 5.5, 9, 5.5, 11, 8, 17, 8, 18, 9, 18, 11),
+		fluentLines(3.5, 18, 6.5, 11, 20.5, 11, 17.5, 19, 4.5, 19, 3.5, 18)),
+	theme.IconNameGrid: fluentIcon("grid",
+		fluentBox(4, 4, 6.5, 6.5, 1.5), fluentBox(13.5, 4, 6.5, 6.5, 1.5),
+		fluentBox(4, 13.5, 6.5, 6.5, 1.5), fluentBox(13.5, 13.5, 6.5, 6.5, 1.5)),
+	theme.IconNameHelp: fluentIcon("question-circle",
+		fluentRing(12, 12, 9), fluentArc(12, 9.5, 2.5, 180, 405), fluentLines(13.77, 11.27, 12, 12.75, 12, 13.5),
+		fluentDisc(12, 16.75, 1)),
+	theme.IconNameHome: fluentIcon("home",
+		fluentLines(3.5, 10.5, 12, 3.5, 20.5, 10.5), fluentLines(6, 9, 6, 20, 18, 20, 18, 9),
+		fluentLines(10, 20, 10, 14.5, 14, 14.5, 14, 20)),
+	theme.IconNameInfo: fluentIcon("info",
+		fluentRing(12, 12, 9), fluentLines(12, 11, 12, 16.5), fluentDisc(12, 7.75, 1)),
+	theme.IconNameList: fluentIcon("text-bullet-list",
+		fluentDisc(5, 6.5, 1), fluentDisc(5, 12, 1), fluentDisc(5, 17.5, 1),
+		fluentLines(9, 6.5, 20, 6.5), fluentLines(9, 12, 20, 12), fluentLines(9, 17.5, 20, 17.5)),
+	theme.IconNameMediaPause: fluentIcon("pause",
+		fluentBox(6, 4.5, 3.5, 15, 1.25), fluentBox(14.5, 4.5, 3.5, 15, 1.25)),
+	theme.IconNameMediaPlay: fluentIcon("play",
+		fluentLines(7, 4.5, 7, 19.5, 19, 12, 7, 4.5)),
+	theme.IconNameMediaStop: fluentIcon("stop",
+		fluentBox(5.5, 5.5, 13, 13, 2)),
+	theme.IconNameMenu: fluentIcon("navigation",
+		fluentLines(3.5, 6, 20.5, 6), fluentLines(3.5, 12, 20.5, 12), fluentLines(3.5, 18, 20.5, 18)),
+	theme.IconNameMenuExpand: fluentIcon("chevron-right",
+		fluentLines(9.5, 6.5, 15, 12, 9.5, 17.5)),
+	theme.IconNameMoreHorizontal: fluentIcon("more-horizontal",
+		fluentDisc(6, 12, 1.25), fluentDisc(12, 12, 1.25), fluentDisc(18, 12, 1.25)),
+	theme.IconNameMoreVertical: fluentIcon("more-vertical",
+		fluentDisc(12, 6, 1.25), fluentDisc(12, 12, 1.25), fluentDisc(12, 18, 1.25)),
+	theme.IconNameMoveDown: fluentIcon("arrow-down",
+		fluentLines(12, 4, 12, 20), fluentLines(5.5, 13.5, 12, 20, 18.5, 13.5)),
+	theme.IconNameMoveUp: fluentIcon("arrow-up",
+		fluentLines(12, 20, 12, 4), fluentLines(5.5, 10.5, 12, 4, 18.5, 10.5)),
+	theme.IconNameNavigateBack: fluentIcon("chevron-left",
+		fluentLines(15, 4.5, 7.5, 12, 15, 19.5)),
+	theme.IconNameNavigateNext: fluentIcon("chevron-right",
+		fluentLines(9, 4.5, 16.5, 12, 9, 19.5)),
+	theme.IconNameQuestion: fluentIcon("question-circle",
+		fluentRing(12, 12, 9), fluentArc(12, 9.5, 2.5, 180, 405), fluentLines(13.77, 11.27, 12, 12.75, 12, 13.5),
+		fluentDisc(12, 16.75, 1)),
+	theme.IconNameRadioButton: fluentIcon("radio-button",
+		fluentRing(12, 12, 8)),
+	theme.IconNameRadioButtonChecked: fluentIcon("radio-button-checked",
+		fluentRing(12, 12, 8), fluentDisc(12, 12, 4.5)),
+	theme.IconNameRadioButtonFill: fluentIcon("radio-button-fill",
+		fluentDisc(12, 12, 8.75)),
+	theme.IconNameSearch: fluentIcon("search",
+		fluentRing(10, 10, 6), fluentLines(14.5, 14.5, 20, 20)),
+	theme.IconNameSettings: fluentIcon("settings",
+		fluentRing(12, 12, 3), fluentRing(12, 12, 7.25), fluentTeeth(12, 12, 7.25, 9.75, 8)),
+	theme.IconNameUpload: fluentIcon("arrow-upload",
+		fluentLines(12, 16, 12, 3.5), fluentLines(6.5, 9, 12, 3.5, 17.5, 9), fluentLines(5, 20.5, 19, 20.5)),
+	theme.IconNameViewRefresh: fluentIcon("arrow-sync",
+		fluentArc(12, 12, 7.5, -60, 230), fluentLines(19.13, 4.59, 15.75, 5.5, 16.66, 8.88)),
+	theme.IconNameViewZoomIn: fluentIcon("zoom-in",
+		fluentRing(10, 10, 6), fluentLines(14.5, 14.5, 20, 20), fluentLines(10, 7.5, 10, 12.5), fluentLines(7.5, 10, 12.5, 10)),
+	theme.IconNameViewZoomOut: fluentIcon("zoom-out",
+		fluentRing(10, 10, 6), fluentLines(14.5, 14.5, 20, 20), fluentLines(7.5, 10, 12.5, 10)),
+	theme.IconNameVisibility: fluentIcon("eye",
+		fluentArc(12, 21, 12, 228.6, 311.4), fluentArc(12, 3, 12, 48.6, 131.4), fluentRing(12, 12, 3)),
+	theme.IconNameVisibilityOff: fluentIcon("eye-off",
+		fluentArc(12, 21, 12, 228.6, 311.4), fluentArc(12, 3, 12, 48.6, 131.4), fluentRing(12, 12, 3),
+		fluentLines(4, 4, 20, 20)),
+	theme.IconNameWarning: fluentIcon("warning",
+		fluentLines(12, 3.5, 21, 19.5, 3, 19.5, 12, 3.5), fluentLines(12, 9, 12, 13.5), fluentDisc(12, 16.5, 1)),
+	theme.IconNameWindowClose: fluentIcon("window-close",
+		fluentLines(7, 7, 17, 17), fluentLines(17, 7, 7, 17)),
+	theme.IconNameWindowMaximize: fluentIcon("window-maximize",
+		fluentBox(6, 6, 12, 12, 2)),
+	theme.IconNameWindowMinimize: fluentIcon("window-minimize",
+		fluentLines(6, 12, 18, 12)),
+}
+
+// fluentIcon returns a themed icon of the shapes, which are the data of paths.
+func fluentIcon(name string, shapes ...string) fyne.Resource {
+	svg := `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24"><path d="` +
+		strings.Join(shapes, " ") + `" fill="#000000"/></svg>`
+	return theme.NewThemedResource(&fyne.StaticResource{StaticName: "fluent-" + name + ".svg", StaticContent: []byte(svg)})
+}
+
+// fluentLines returns a line through the points, given as pairs of coordinates, with round ends and joins.
+func fluentLines(points ...float64) string {
+	var d []string
+	for i := 0; i+3 < len(points); i += 2 {
+		d = append(d, fluentCapsule(points[i], points[i+1], points[i+2], points[i+3]))
+	}
+	return strings.Join(d, " ")
+}
+
+func fluentCapsule(x1, y1, x2, y2 float64) string {
+	h := fluentStroke / 2
+	length := math.Hypot(x2-x1, y2-y1)
+	if length == 0 {
+		return fluentDisc(x1, y1, h)
+	}
+	nx, ny := -(y2-y1)/length*h, (x2-x1)/length*h
+	return "M" + fluentPoint(x1+nx, y1+ny) + " L" + fluentPoint(x2+nx, y2+ny) +
+		" A" + fluentArcTo(h, false, false, x2-nx, y2-ny) +
+		" L" + fluentPoint(x1-nx, y1-ny) +
+		" A" + fluentArcTo(h, false, false, x1+nx, y1+ny) + " Z"
+}
+
+// fluentCut returns the outline of a line through the points, with mitred joins, drawn as a hole.
+func fluentCut(points ...float64) string {
+	h := fluentStroke / 2
+	n := len(points) / 2
+	left, right := make([][2]float64, n), make([][2]float64, n)
+	normal := func(i int) (float64, float64) {
+		dx, dy := points[i*2+2]-points[i*2], points[i*2+3]-points[i*2+1]
+		length := math.Hypot(dx, dy)
+		return -dy / length, dx / length
+	}
+	for i := 0; i < n; i++ {
+		var nx, ny float64
+		switch i {
+		case 0:
+			nx, ny = normal(0)
+		case n - 1:
+			nx, ny = normal(n - 2)
+		default:
+			ax, ay := normal(i - 1)
+			bx, by := normal(i)
+			mx, my := ax+bx, ay+by
+			scale := 2 / (mx*mx + my*my) // the length of the mitre, for the offset to meet both sides
+			nx, ny = mx*scale, my*scale
+		}
+		x, y := points[i*2], points[i*2+1]
+		left[i] = [2]float64{x + nx*h, y + ny*h}
+		right[i] = [2]float64{x - nx*h, y - ny*h}
+	}
+	outline := left
+	for i := n - 1; i >= 0; i-- {
+		outline = append(outline, right[i])
+	}
+	return fluentPolygon(outline, true)
+}
+
+// fluentPolygon returns a closed path through the points, as a hole or a shape.
+func fluentPolygon(points [][2]float64, hole bool) string {
+	area := 0.0
+	for i, p := range points {
+		q := points[(i+1)%len(points)]
+		area += p[0]*q[1] - q[0]*p[1]
+	}
+	// a shape is drawn counterclockwise, which has a negative area as y increases downwards
+	if (area < 0) == hole {
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+	d := make([]string, len(points))
+	for i, p := range points {
+		d[i] = "L" + fluentPoint(p[0], p[1])
+	}
+	return "M" + strings.TrimPrefix(strings.Join(d, " "), "L") + " Z"
+}
+
+func fluentDisc(cx, cy, r float64) string {
+	return fluentCircle(cx, cy, r, false)
+}
+
+func fluentCircle(cx, cy, r float64, hole bool) string {
+	return "M" + fluentPoint(cx+r, cy) +
+		" A" + fluentArcTo(r, false, hole, cx-r, cy) +
+		" A" + fluentArcTo(r, false, hole, cx+r, cy) + " Z"
+}
+
+func fluentRing(cx, cy, r float64) string {
+	h := fluentStroke / 2
+	return fluentCircle(cx, cy, r+h, false) + " " + fluentCircle(cx, cy, r-h, true)
+}
+
+// fluentArc returns an arc of a circle from one angle to another, in degrees clockwise from the right,
+// with round ends.
+func fluentArc(cx, cy, r, from, to float64) string {
+	h := fluentStroke / 2
+	at := func(radius, angle float64) (float64, float64) {
+		rad := angle * math.Pi / 180
+		return cx + radius*math.Cos(rad), cy + radius*math.Sin(rad)
+	}
+	large := to-from > 180
+	ox1, oy1 := at(r+h, to)
+	ox0, oy0 := at(r+h, from)
+	ix0, iy0 := at(r-h, from)
+	ix1, iy1 := at(r-h, to)
+	return "M" + fluentPoint(ox1, oy1) +
+		" A" + fluentArcTo(r+h, large, false, ox0, oy0) +
+		" A" + fluentArcTo(h, false, false, ix0, iy0) +
+		" A" + fluentArcTo(r-h, large, true, ix1, iy1) +
+		" A" + fluentArcTo(h, false, false, ox1, oy1) + " Z"
+}
+
+// fluentBox returns the outline of a rectangle with rounded corners.
+func fluentBox(x, y, w, h, radius float64) string {
+	s := fluentStroke / 2
+	return fluentRoundRect(x-s, y-s, w+s*2, h+s*2, radius+s, false) + " " +
+		fluentRoundRect(x+s, y+s, w-s*2, h-s*2, math.Max(radius-s, 0), true)
+}
+
+func fluentRoundRect(x, y, w, h, r float64, hole bool) string {
+	corners := [][2]float64{{x + w - r, y}, {x + r, y}, {x, y + r}, {x, y + h - r}, {x + r, y + h}, {x + w - r, y + h},
+		{x + w, y + h - r}, {x + w, y + r}}
+	if hole {
+		for i, j := 0, len(corners)-1; i < j; i, j = i+1, j-1 {
+			corners[i], corners[j] = corners[j], corners[i]
+		}
+	}
+	d := "M" + fluentPoint(corners[0][0], corners[0][1])
+	for i := 1; i < len(corners); i++ {
+		if i%2 == 1 {
+			d += " L" + fluentPoint(corners[i][0], corners[i][1])
+		} else {
+			d += " A" + fluentArcTo(r, false, hole, corners[i][0], corners[i][1])
+		}
+	}
+	return d + " A" + fluentArcTo(r, false, hole, corners[0][0], corners[0][1]) + " Z"
+}
+
+// fluentTeeth returns count short lines around a circle, from one radius to another, for a gear.
+func fluentTeeth(cx, cy, from, to float64, count int) string {
+	var d []string
+	for i := 0; i < count; i++ {
+		a := float64(i) * 2 * math.Pi / float64(count)
+		cos, sin := math.Cos(a), math.Sin(a)
+		d = append(d, fluentCapsule(cx+from*cos, cy+from*sin, cx+to*cos, cy+to*sin))
+	}
+	return strings.Join(d, " ")
+}
+
+// fluentArcTo returns the arguments of an arc command to a point, turning clockwise if not counterclockwise.
+func fluentArcTo(r float64, large, clockwise bool, x, y float64) string {
+	flag := func(b bool) string {
+		if b {
+			return "1"
+		}
+		return "0"
+	}
+	radius := fluentNumber(r)
+	return radius + " " + radius + " 0 " + flag(large) + " " + flag(clockwise) + " " + fluentPoint(x, y)
+}
+
+func fluentPoint(x, y float64) string {
+	return fluentNumber(x) + " " + fluentNumber(y)
+}
+
+func fluentNumber(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
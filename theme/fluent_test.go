@@ -0,0 +1,40 @@
+package theme
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFluent(t *testing.T) {
+	f := Fluent()
+	assert.Equal(t, fluentLightScheme[theme.ColorNamePrimary], f.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, fluentDarkScheme[theme.ColorNameBackground], f.Color(theme.ColorNameBackground, theme.VariantDark))
+	assert.Equal(t, float32(4), f.Size(theme.SizeNameInputRadius))
+	assert.Equal(t, theme.DefaultTheme().Size(theme.SizeNamePadding), f.Size(theme.SizeNamePadding))
+	assert.Equal(t, "foreground_fluent-dismiss.svg", f.Icon(theme.IconNameCancel).Name())
+	assert.Equal(t, theme.DefaultTheme().Icon(theme.IconNameMailSend).Name(), f.Icon(theme.IconNameMailSend).Name())
+}
+
+func TestFluentIcons(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	for name, icon := range fluentIcons {
+		var svg struct {
+			Path struct {
+				D string `xml:"d,attr"`
+			} `xml:"path"`
+		}
+		assert.NoError(t, xml.Unmarshal(icon.Content(), &svg), name)
+		assert.NotEmpty(t, svg.Path.D, name)
+		assert.NotContains(t, svg.Path.D, "NaN", name)
+	}
+}
+
+func TestFluentLines(t *testing.T) {
+	assert.Equal(t, "M0 0.75 L10 0.75 A0.75 0.75 0 0 0 10 -0.75 L0 -0.75 A0.75 0.75 0 0 0 0 0.75 Z", fluentLines(0, 0, 10, 0))
+}
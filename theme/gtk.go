@@ -0,0 +1,95 @@
+package theme
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+
+	"fyne.io/x/fyne/theme/internal/gtkcss"
+)
+
+const gnomeGTKThemeKey = "gtk-theme"
+
+// gtkEnv is where FromGTK finds the GTK theme that the user runs.
+type gtkEnv struct {
+	theme     string            // the name of the theme, such as "Yaru-dark"
+	variant   fyne.ThemeVariant // the variant of the color-scheme setting
+	themeDirs []string          // the directories that themes are installed in, by priority
+	configDir string            // the directory of the user's GTK settings, such as ~/.config
+}
+
+// FromGTK returns a theme with the colors of the GTK theme that the user runs, such as Adwaita, Yaru or Pop,
+// as set by the gtk-theme and color-scheme settings of GNOME. The colors are read from the stylesheet of the
+// installed theme, with those of the user's own gtk.css over them, and anything they don't define comes from
+// the Adwaita theme. The variant is that of the settings; call FromGTK again when they change.
+// Stylesheets imported from files are followed, but themes that import their stylesheet from a GResource
+// bundle, such as `@import url("resource:///...")` in some Yaru and Pop versions, can't be read, so they
+// get the Adwaita colors apart from any that their files or the user's gtk.css define.
+// Where GTK settings are not available, such as on other systems than Linux and BSD, it returns the Adwaita theme.
+func FromGTK() fyne.Theme {
+	env, err := gtkEnvironment()
+	if err != nil {
+		return AdwaitaTheme()
+	}
+	return fromGTK(env)
+}
+
+func fromGTK(env *gtkEnv) fyne.Theme {
+	variant := env.variant
+	if strings.HasSuffix(strings.ToLower(env.theme), "-dark") {
+		variant = theme.VariantDark
+	}
+
+	css, version := gtkThemeCSS(env.themeDirs, env.theme, variant)
+	if user, err := gtkcss.Load(filepath.Join(env.configDir, version, "gtk.css")); err == nil {
+		css = append(append(css, '\n'), user...)
+	}
+	light, dark, err := gtkcss.Parse(css)
+	if err != nil {
+		if !errors.Is(err, gtkcss.ErrNoColors) {
+			fyne.LogError("Failed to read the colors of GTK theme "+env.theme, err)
+		}
+		return AdwaitaVariant(variant)
+	}
+	colors := light
+	if variant == theme.VariantDark && dark != nil {
+		colors = dark
+	}
+
+	var opts []Option
+	for _, mapping := range []map[fyne.ThemeColorName]string{gtkcss.GTK3Mapping, gtkcss.AdwaitaMapping} {
+		for name, css := range mapping {
+			if c, ok := colors[css]; ok {
+				opts = append(opts, WithColor(name, c))
+			}
+		}
+	}
+	if len(opts) == 0 {
+		return AdwaitaVariant(variant)
+	}
+	return Override(AdwaitaVariant(variant), opts...)
+}
+
+// gtkThemeCSS returns the stylesheet of an installed theme with the files it imports, the dark one of the
+// variant if the theme has it, and the GTK version directory it was found in, "gtk-4.0" or "gtk-3.0".
+// Adwaita is built into GTK and, like themes that are not installed, has no stylesheet; the version is
+// then "gtk-4.0".
+func gtkThemeCSS(themeDirs []string, name string, variant fyne.ThemeVariant) ([]byte, string) {
+	files := []string{"gtk.css"}
+	if variant == theme.VariantDark {
+		files = []string{"gtk-dark.css", "gtk.css"}
+	}
+	for _, dir := range themeDirs {
+		for _, version := range []string{"gtk-4.0", "gtk-3.0"} {
+			for _, file := range files {
+				if css, err := gtkcss.Load(filepath.Join(dir, name, version, file)); err == nil {
+					return css, version
+				}
+			}
+		}
+	}
+	return nil, "gtk-4.0"
+}
@@ -0,0 +1,10 @@
+//go:build !(linux || freebsd || netbsd || openbsd) || android
+// +build !linux,!freebsd,!netbsd,!openbsd android
+
+package theme
+
+import "errors"
+
+func gtkEnvironment() (*gtkEnv, error) {
+	return nil, errors.New("GTK settings are not available on this platform")
+}
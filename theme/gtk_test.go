@@ -0,0 +1,108 @@
+package theme
+
+import (
+	"image/color"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeGTKFile(t *testing.T, path, css string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(css), 0644))
+}
+
+func TestFromGTK_Adwaita(t *testing.T) {
+	env := &gtkEnv{theme: "Adwaita", variant: theme.VariantDark, themeDirs: []string{t.TempDir()}, configDir: t.TempDir()}
+	th := fromGTK(env)
+	assert.Equal(t, adwaitaDarkScheme[theme.ColorNameBackground], th.Color(theme.ColorNameBackground, theme.VariantLight))
+
+	env.theme, env.variant = "Adwaita-dark", theme.VariantLight
+	th = fromGTK(env)
+	assert.Equal(t, adwaitaDarkScheme[theme.ColorNameBackground], th.Color(theme.ColorNameBackground, theme.VariantLight))
+}
+
+func TestFromGTK_GTK3Theme(t *testing.T) {
+	themes := t.TempDir()
+	writeGTKFile(t, filepath.Join(themes, "Yaru", "gtk-3.0", "gtk.css"), `
+@define-color theme_bg_color #fafafa;
+@define-color theme_fg_color #3d3d3d;
+@define-color theme_selected_bg_color #e95420;`)
+	writeGTKFile(t, filepath.Join(themes, "Yaru", "gtk-3.0", "gtk-dark.css"), `
+@define-color theme_bg_color #2c2c2c;
+@define-color theme_selected_bg_color #e95420;`)
+
+	env := &gtkEnv{theme: "Yaru", variant: theme.VariantLight, themeDirs: []string{t.TempDir(), themes}, configDir: t.TempDir()}
+	th := fromGTK(env)
+	assert.Equal(t, color.NRGBA{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff}, th.Color(theme.ColorNameBackground, theme.VariantDark))
+	assert.Equal(t, color.NRGBA{R: 0x3d, G: 0x3d, B: 0x3d, A: 0xff}, th.Color(theme.ColorNameForeground, theme.VariantLight))
+	assert.Equal(t, color.NRGBA{R: 0xe9, G: 0x54, B: 0x20, A: 0xff}, th.Color(theme.ColorNamePrimary, theme.VariantLight))
+	// colors that the theme doesn't define come from Adwaita
+	assert.Equal(t, adwaitaLightScheme[theme.ColorNameShadow], th.Color(theme.ColorNameShadow, theme.VariantLight))
+
+	env.variant = theme.VariantDark
+	th = fromGTK(env)
+	assert.Equal(t, color.NRGBA{R: 0x2c, G: 0x2c, B: 0x2c, A: 0xff}, th.Color(theme.ColorNameBackground, theme.VariantLight))
+	assert.Equal(t, adwaitaDarkScheme[theme.ColorNameForeground], th.Color(theme.ColorNameForeground, theme.VariantLight))
+}
+
+func TestFromGTK_GTK4Theme(t *testing.T) {
+	themes, config := t.TempDir(), t.TempDir()
+	writeGTKFile(t, filepath.Join(themes, "Pop", "gtk-4.0", "gtk.css"), `
+:root { --window-bg-color: #f6f6f6; --accent-bg-color: #48b9c7; }
+@media (prefers-color-scheme: dark) {
+	:root { --window-bg-color: #333333; }
+}`)
+	writeGTKFile(t, filepath.Join(config, "gtk-4.0", "gtk.css"), `@define-color accent_bg_color #e66100;`)
+
+	env := &gtkEnv{theme: "Pop", variant: theme.VariantDark, themeDirs: []string{themes}, configDir: config}
+	th := fromGTK(env)
+	assert.Equal(t, color.NRGBA{R: 0x33, G: 0x33, B: 0x33, A: 0xff}, th.Color(theme.ColorNameBackground, theme.VariantLight))
+	// the user's own stylesheet is over that of the theme
+	assert.Equal(t, color.NRGBA{R: 0xe6, G: 0x61, A: 0xff}, th.Color(theme.ColorNamePrimary, theme.VariantDark))
+}
+
+func TestFromGTK_Imports(t *testing.T) {
+	themes := t.TempDir()
+	writeGTKFile(t, filepath.Join(themes, "Yaru", "gtk-3.0", "gtk.css"), `
+@import url("resource:///com/ubuntu/themes/Yaru/3.0/gtk.css");
+@import url("colors/base.css");
+@define-color theme_selected_bg_color @accent;`)
+	writeGTKFile(t, filepath.Join(themes, "Yaru", "gtk-3.0", "colors", "base.css"), `
+@import "accent.css";
+@import "base.css";
+@define-color theme_bg_color #fafafa;`)
+	writeGTKFile(t, filepath.Join(themes, "Yaru", "gtk-3.0", "colors", "accent.css"), `@define-color accent #e95420;`)
+
+	env := &gtkEnv{theme: "Yaru", variant: theme.VariantLight, themeDirs: []string{themes}, configDir: t.TempDir()}
+	th := fromGTK(env)
+	assert.Equal(t, color.NRGBA{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff}, th.Color(theme.ColorNameBackground, theme.VariantLight))
+	assert.Equal(t, color.NRGBA{R: 0xe9, G: 0x54, B: 0x20, A: 0xff}, th.Color(theme.ColorNamePrimary, theme.VariantLight))
+	// the resource can't be read, so what the files don't define comes from Adwaita
+	assert.Equal(t, adwaitaLightScheme[theme.ColorNameForeground], th.Color(theme.ColorNameForeground, theme.VariantLight))
+}
+
+func TestFromGTK_Settings(t *testing.T) {
+	defer func(cmd func(...string) *exec.Cmd) { gsettings = cmd }(gsettings)
+	gsettings = func(args ...string) *exec.Cmd {
+		if args[len(args)-1] == gnomeColorSchemeKey {
+			return exec.Command("echo", "'prefer-dark'")
+		}
+		return exec.Command("echo", "'Unknown'")
+	}
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("XDG_DATA_DIRS", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	if _, err := gtkEnvironment(); err != nil {
+		t.Skip("GTK settings are not available on this platform")
+	}
+	th := FromGTK()
+	assert.Equal(t, adwaitaDarkScheme[theme.ColorNameBackground], th.Color(theme.ColorNameBackground, theme.VariantLight))
+}
@@ -0,0 +1,43 @@
+//go:build (linux || freebsd || netbsd || openbsd) && !android
+// +build linux freebsd netbsd openbsd
+// +build !android
+
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func gtkEnvironment() (*gtkEnv, error) {
+	out, err := gsettings("get", gnomeInterfaceSchema, gnomeGTKThemeKey).Output()
+	if err != nil {
+		return nil, err
+	}
+	variant, _ := ColorScheme() // older versions of GNOME have no color-scheme setting
+	env := &gtkEnv{theme: strings.Trim(strings.TrimSpace(string(out)), "'"), variant: variant}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		dataHome = filepath.Join(home, ".local", "share")
+	}
+	env.themeDirs = append(env.themeDirs, filepath.Join(dataHome, "themes"), filepath.Join(home, ".themes"))
+	dataDirs := os.Getenv("XDG_DATA_DIRS")
+	if dataDirs == "" {
+		dataDirs = "/usr/local/share:/usr/share"
+	}
+	for _, dir := range filepath.SplitList(dataDirs) {
+		env.themeDirs = append(env.themeDirs, filepath.Join(dir, "themes"))
+	}
+
+	env.configDir = os.Getenv("XDG_CONFIG_HOME")
+	if env.configDir == "" {
+		env.configDir = filepath.Join(home, ".config")
+	}
+	return env, nil
+}
@@ -0,0 +1,229 @@
+package theme
+
+import (
+	"image/color"
+	"math"
+)
+
+// hct is a color in the HCT color space of Material Design 3: the hue and chroma of CAM16 and the tone,
+// which is the L* of CIELAB. Colors of the same tone have the same contrast with others, whatever their hue.
+// See: https://material.io/blog/science-of-color-design
+type hct struct {
+	hue, chroma, tone float64
+}
+
+// the CAM16 viewing conditions of sRGB, as Material uses them: a D65 white point, an adapting luminance
+// of 200/π × Y(L* 50), a background of L* 50 and an average surround
+var cam16 = newCAM16Conditions()
+
+type cam16Conditions struct {
+	aw, nbb, ncb, c, nc, n, fl, z float64
+	rgbD                          [3]float64
+}
+
+func newCAM16Conditions() cam16Conditions {
+	white := [3]float64{95.047, 100, 108.883}
+	adaptingLuminance := 200 / math.Pi * yFromLstar(50) / 100
+	rW, gW, bW := cam16Cone(white[0], white[1], white[2])
+
+	f := 1.0 // an average surround
+	c := .69
+	d := f * (1 - 1/3.6*math.Exp((-adaptingLuminance-42)/92))
+	d = math.Max(0, math.Min(1, d))
+	rgbD := [3]float64{d*100/rW + 1 - d, d*100/gW + 1 - d, d*100/bW + 1 - d}
+
+	k := 1 / (5*adaptingLuminance + 1)
+	k4 := k * k * k * k
+	fl := k4*adaptingLuminance + .1*(1-k4)*(1-k4)*math.Cbrt(5*adaptingLuminance)
+	n := yFromLstar(50) / white[1]
+	z := 1.48 + math.Sqrt(n)
+	nbb := .725 / math.Pow(n, .2)
+
+	adapted := func(v float64) float64 {
+		p := math.Pow(fl*v/100, .42)
+		return 400 * p / (p + 27.13)
+	}
+	aw := (2*adapted(rgbD[0]*rW) + adapted(rgbD[1]*gW) + .05*adapted(rgbD[2]*bW)) * nbb
+	return cam16Conditions{aw: aw, nbb: nbb, ncb: nbb, c: c, nc: f, n: n, fl: fl, z: z, rgbD: rgbD}
+}
+
+// hctFromColor returns the HCT of a color, ignoring its opacity.
+func hctFromColor(col color.Color) hct {
+	x, y, z := xyzFromColor(col)
+	hue, chroma := cam16.hueAndChroma(x, y, z)
+	return hct{hue: hue, chroma: chroma, tone: lstarFromY(y)}
+}
+
+// color returns the sRGB color of an HCT. Where the chroma is out of the sRGB gamut for the hue and tone,
+// the color has the highest chroma of the gamut instead, keeping the hue and tone.
+func (h hct) color() color.NRGBA {
+	if h.tone <= 0 {
+		return color.NRGBA{A: 0xff}
+	}
+	if h.tone >= 100 {
+		return color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	}
+	if r, g, b, ok := h.linearRGB(h.chroma); ok {
+		return colorFromLinear(r, g, b)
+	}
+
+	low, high := 0.0, h.chroma
+	for i := 0; i < 24; i++ {
+		mid := (low + high) / 2
+		if _, _, _, ok := h.linearRGB(mid); ok {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	r, g, b, _ := h.linearRGB(low)
+	return colorFromLinear(r, g, b)
+}
+
+// linearRGB returns the linear sRGB of the hue and tone with a chroma, and whether it is in the gamut.
+// The lightness J of CAM16 that gives the tone is found by bisection, as Y grows with J.
+func (h hct) linearRGB(chroma float64) (r, g, b float64, ok bool) {
+	y := yFromLstar(h.tone)
+	low, high := 0.0, 100.0
+	var x, z float64
+	for i := 0; i < 32; i++ {
+		j := (low + high) / 2
+		var yj float64
+		x, yj, z = cam16.xyz(j, chroma, h.hue)
+		if yj < y {
+			low = j
+		} else {
+			high = j
+		}
+	}
+	x, _, z = cam16.xyz((low+high)/2, chroma, h.hue)
+
+	r = (3.2413774792388685*x - 1.5376652402851851*y - .49885366846268053*z) / 100
+	g = (-.9691452513005321*x + 1.8758853451067872*y + .04156585605867105*z) / 100
+	b = (.05562093689691305*x - .20395524564742123*y + 1.0571799111220335*z) / 100
+	const e = 1e-4
+	ok = r >= -e && r <= 1+e && g >= -e && g <= 1+e && b >= -e && b <= 1+e
+	return r, g, b, ok
+}
+
+// hueAndChroma returns the CAM16 hue and chroma of a color in CIE XYZ, with Y from 0 to 100.
+func (v cam16Conditions) hueAndChroma(x, y, z float64) (hue, chroma float64) {
+	rC, gC, bC := cam16Cone(x, y, z)
+	adapted := func(c, d float64) float64 {
+		p := math.Pow(v.fl*math.Abs(c*d)/100, .42)
+		return math.Copysign(400*p/(p+27.13), c*d)
+	}
+	rA, gA, bA := adapted(rC, v.rgbD[0]), adapted(gC, v.rgbD[1]), adapted(bC, v.rgbD[2])
+
+	a := (11*rA - 12*gA + bA) / 11
+	b := (rA + gA - 2*bA) / 9
+	u := (20*rA + 20*gA + 21*bA) / 20
+	p2 := (40*rA + 20*gA + bA) / 20
+	hue = sanitizeDegrees(math.Atan2(b, a) * 180 / math.Pi)
+
+	j := 100 * math.Pow(p2*v.nbb/v.aw, v.c*v.z)
+	huePrime := hue
+	if huePrime < 20.14 {
+		huePrime += 360
+	}
+	eHue := .25 * (math.Cos(huePrime*math.Pi/180+2) + 3.8)
+	p1 := 50000. / 13 * eHue * v.nc * v.ncb
+	t := p1 * math.Hypot(a, b) / (u + .305)
+	alpha := math.Pow(1.64-math.Pow(.29, v.n), .73) * math.Pow(t, .9)
+	return hue, alpha * math.Sqrt(j/100)
+}
+
+// xyz returns the CIE XYZ, with Y from 0 to 100, of a CAM16 lightness, chroma and hue.
+func (v cam16Conditions) xyz(j, chroma, hue float64) (x, y, z float64) {
+	alpha := 0.0
+	if chroma != 0 && j != 0 {
+		alpha = chroma / math.Sqrt(j/100)
+	}
+	t := math.Pow(alpha/math.Pow(1.64-math.Pow(.29, v.n), .73), 1/.9)
+	hRad := hue * math.Pi / 180
+	eHue := .25 * (math.Cos(hRad+2) + 3.8)
+	ac := v.aw * math.Pow(j/100, 1/v.c/v.z)
+	p1 := eHue * 50000 / 13 * v.nc * v.ncb
+	p2 := ac / v.nbb
+	hSin, hCos := math.Sin(hRad), math.Cos(hRad)
+
+	gamma := 23 * (p2 + .305) * t / (23*p1 + 11*t*hCos + 108*t*hSin)
+	a, b := gamma*hCos, gamma*hSin
+	rA := (460*p2 + 451*a + 288*b) / 1403
+	gA := (460*p2 - 891*a - 261*b) / 1403
+	bA := (460*p2 - 220*a - 6300*b) / 1403
+
+	unadapted := func(c, d float64) float64 {
+		base := math.Max(0, 27.13*math.Abs(c)/(400-math.Abs(c)))
+		return math.Copysign(100/v.fl*math.Pow(base, 1/.42), c) / d
+	}
+	rF, gF, bF := unadapted(rA, v.rgbD[0]), unadapted(gA, v.rgbD[1]), unadapted(bA, v.rgbD[2])
+	x = 1.86206786*rF - 1.01125463*gF + .14918677*bF
+	y = .38752654*rF + .62144744*gF - .00897398*bF
+	z = -.01584150*rF - .03412294*gF + 1.04996444*bF
+	return x, y, z
+}
+
+// cam16Cone returns the cone responses of CAM16 for a color in CIE XYZ.
+func cam16Cone(x, y, z float64) (r, g, b float64) {
+	r = .401288*x + .650173*y - .051461*z
+	g = -.250268*x + 1.204414*y + .045854*z
+	b = -.002079*x + .048952*y + .953127*z
+	return r, g, b
+}
+
+func xyzFromColor(col color.Color) (x, y, z float64) {
+	c := color.NRGBAModel.Convert(col).(color.NRGBA)
+	linear := func(v uint8) float64 {
+		s := float64(v) / 0xff
+		if s <= .04045 {
+			return s / 12.92 * 100
+		}
+		return math.Pow((s+.055)/1.055, 2.4) * 100
+	}
+	r, g, b := linear(c.R), linear(c.G), linear(c.B)
+	x = .41233895*r + .35762064*g + .18051042*b
+	y = .2126*r + .7152*g + .0722*b
+	z = .01932141*r + .11916382*g + .95034478*b
+	return x, y, z
+}
+
+func colorFromLinear(r, g, b float64) color.NRGBA {
+	channel := func(v float64) uint8 {
+		v = math.Max(0, math.Min(1, v))
+		if v <= .0031308 {
+			v *= 12.92
+		} else {
+			v = 1.055*math.Pow(v, 1/2.4) - .055
+		}
+		return uint8(math.Round(v * 0xff))
+	}
+	return color.NRGBA{R: channel(r), G: channel(g), B: channel(b), A: 0xff}
+}
+
+// lstarFromY returns the L* of CIELAB, from 0 to 100, of a luminance Y from 0 to 100.
+func lstarFromY(y float64) float64 {
+	t := y / 100
+	if t > 216./24389 {
+		return 116*math.Cbrt(t) - 16
+	}
+	return 24389. / 27 * t
+}
+
+// yFromLstar returns the luminance Y, from 0 to 100, of an L* of CIELAB.
+func yFromLstar(lstar float64) float64 {
+	ft := (lstar + 16) / 116
+	if ft*ft*ft > 216./24389 {
+		return 100 * ft * ft * ft
+	}
+	return 100 * lstar * 27 / 24389
+}
+
+// sanitizeDegrees returns an angle in degrees within [0, 360).
+func sanitizeDegrees(degrees float64) float64 {
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees
+}
@@ -0,0 +1,111 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+var _ fyne.Theme = (*highContrast)(nil)
+
+var highContrastLightScheme = map[fyne.ThemeColorName]color.Color{
+	theme.ColorNameBackground:          color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameButton:              color.NRGBA{R: 0xe6, G: 0xe6, B: 0xe6, A: 0xff},
+	theme.ColorNameDisabledButton:      color.NRGBA{R: 0xf2, G: 0xf2, B: 0xf2, A: 0xff},
+	theme.ColorNameDisabled:            color.NRGBA{R: 0x6e, G: 0x6e, B: 0x6e, A: 0xff},
+	theme.ColorNameError:               color.NRGBA{R: 0xb0, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameFocus:               color.NRGBA{R: 0x00, G: 0x00, B: 0x9f, A: 0xff},
+	theme.ColorNameForeground:          color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameForegroundOnError:   color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnPrimary: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnSuccess: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnWarning: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameHeaderBackground:    color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameHover:               color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x1f},
+	theme.ColorNameHyperlink:           color.NRGBA{R: 0x00, G: 0x00, B: 0x9f, A: 0xff},
+	theme.ColorNameInputBackground:     color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameInputBorder:         color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameMenuBackground:      color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameOverlayBackground:   color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNamePlaceHolder:         color.NRGBA{R: 0x4d, G: 0x4d, B: 0x4d, A: 0xff},
+	theme.ColorNamePressed:             color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x33},
+	theme.ColorNamePrimary:             color.NRGBA{R: 0x00, G: 0x00, B: 0x9f, A: 0xff},
+	theme.ColorNameScrollBar:           color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xb0},
+	theme.ColorNameSelection:           color.NRGBA{R: 0x00, G: 0x00, B: 0x9f, A: 0x40},
+	theme.ColorNameSeparator:           color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameShadow:              color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x66},
+	theme.ColorNameSuccess:             color.NRGBA{R: 0x00, G: 0x64, B: 0x00, A: 0xff},
+	theme.ColorNameWarning:             color.NRGBA{R: 0x8a, G: 0x4b, B: 0x00, A: 0xff},
+}
+
+var highContrastDarkScheme = map[fyne.ThemeColorName]color.Color{
+	theme.ColorNameBackground:          color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameButton:              color.NRGBA{R: 0x24, G: 0x24, B: 0x24, A: 0xff},
+	theme.ColorNameDisabledButton:      color.NRGBA{R: 0x12, G: 0x12, B: 0x12, A: 0xff},
+	theme.ColorNameDisabled:            color.NRGBA{R: 0x9a, G: 0x9a, B: 0x9a, A: 0xff},
+	theme.ColorNameError:               color.NRGBA{R: 0xff, G: 0x6b, B: 0x6b, A: 0xff},
+	theme.ColorNameFocus:               color.NRGBA{R: 0x1a, G: 0xeb, B: 0xff, A: 0xff},
+	theme.ColorNameForeground:          color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameForegroundOnError:   color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameForegroundOnPrimary: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameForegroundOnSuccess: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameForegroundOnWarning: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameHeaderBackground:    color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameHover:               color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x1f},
+	theme.ColorNameHyperlink:           color.NRGBA{R: 0xff, G: 0xff, B: 0x00, A: 0xff},
+	theme.ColorNameInputBackground:     color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameInputBorder:         color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameMenuBackground:      color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNameOverlayBackground:   color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	theme.ColorNamePlaceHolder:         color.NRGBA{R: 0xbf, G: 0xbf, B: 0xbf, A: 0xff},
+	theme.ColorNamePressed:             color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x33},
+	theme.ColorNamePrimary:             color.NRGBA{R: 0x1a, G: 0xeb, B: 0xff, A: 0xff},
+	theme.ColorNameScrollBar:           color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xb0},
+	theme.ColorNameSelection:           color.NRGBA{R: 0x1a, G: 0xeb, B: 0xff, A: 0x40},
+	theme.ColorNameSeparator:           color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	theme.ColorNameShadow:              color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x00},
+	theme.ColorNameSuccess:             color.NRGBA{R: 0x3f, G: 0xf2, B: 0x3f, A: 0xff},
+	theme.ColorNameWarning:             color.NRGBA{R: 0xff, G: 0xff, B: 0x00, A: 0xff},
+}
+
+// highContrast is a theme for accessibility, with the black and white colors of the high contrast
+// themes of Windows and thicker borders.
+type highContrast struct{}
+
+// HighContrast returns a new theme for accessibility, with light and dark variants whose colors meet
+// the contrast ratios of WCAG AA and more, with borders and separators that are easier to see.
+func HighContrast() fyne.Theme {
+	return &highContrast{}
+}
+
+// Color returns the named color for the current theme.
+func (h *highContrast) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	scheme := highContrastLightScheme
+	if variant == theme.VariantDark {
+		scheme = highContrastDarkScheme
+	}
+	if c, ok := scheme[name]; ok {
+		return c
+	}
+	return theme.DefaultTheme().Color(name, variant)
+}
+
+// Font returns the named font for the current theme.
+func (h *highContrast) Font(style fyne.TextStyle) fyne.Resource {
+	return theme.DefaultTheme().Font(style)
+}
+
+// Icon returns the named resource for the current theme.
+func (h *highContrast) Icon(name fyne.ThemeIconName) fyne.Resource {
+	return theme.DefaultTheme().Icon(name)
+}
+
+// Size returns the size of the named resource for the current theme.
+func (h *highContrast) Size(name fyne.ThemeSizeName) float32 {
+	switch name {
+	case theme.SizeNameInputBorder, theme.SizeNameSeparatorThickness:
+		return 2
+	}
+	return theme.DefaultTheme().Size(name)
+}
@@ -0,0 +1,91 @@
+package iconpack
+
+import (
+	"compress/gzip"
+	"io"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Asset is an icon bundled as gzip compressed data, as written by the icon generators.
+type Asset struct {
+	Name     string
+	Symbolic bool   // whether the icon is recolored as the foreground, like the symbolic icons of GTK
+	Data     string // the gzip compressed content, a string so that it stays in the read-only data of the binary
+}
+
+var _ IconPack = (*bundled)(nil)
+
+// bundled is a pack of bundled icons whose resources are only created when they are first asked for,
+// and whose content is only decompressed when it is first read. Apps that use a handful of the icons
+// don't hold the others in memory.
+type bundled struct {
+	name   string
+	assets map[fyne.ThemeIconName]Asset
+
+	mu    sync.Mutex
+	icons map[fyne.ThemeIconName]fyne.Resource
+}
+
+// NewBundled returns an icon pack of bundled icons, which are decompressed as they are used.
+func NewBundled(name string, assets map[fyne.ThemeIconName]Asset) IconPack {
+	return &bundled{name: name, assets: assets, icons: map[fyne.ThemeIconName]fyne.Resource{}}
+}
+
+// Icon returns the named icon, or nil if the pack doesn't have it. It always returns the same resource for a name.
+func (b *bundled) Icon(name fyne.ThemeIconName) fyne.Resource {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if icon, ok := b.icons[name]; ok {
+		return icon
+	}
+	asset, ok := b.assets[name]
+	if !ok {
+		return nil
+	}
+
+	var icon fyne.Resource = &lazyResource{name: asset.Name, data: asset.Data}
+	if asset.Symbolic {
+		icon = theme.NewThemedResource(icon)
+	}
+	b.icons[name] = icon
+	return icon
+}
+
+// Name returns the name of the icon pack.
+func (b *bundled) Name() string {
+	return b.name
+}
+
+// lazyResource is a resource whose gzip compressed content is decompressed when it is first read.
+type lazyResource struct {
+	name string
+	data string
+
+	once    sync.Once
+	content []byte
+}
+
+// Content returns the decompressed content of the resource.
+func (r *lazyResource) Content() []byte {
+	r.once.Do(func() {
+		zr, err := gzip.NewReader(strings.NewReader(r.data))
+		if err != nil {
+			fyne.LogError("Failed to decompress icon "+r.name, err)
+			return
+		}
+		r.content, err = io.ReadAll(zr)
+		if err != nil {
+			fyne.LogError("Failed to decompress icon "+r.name, err)
+		}
+	})
+	return r.content
+}
+
+// Name returns the name of the resource, without decompressing it.
+func (r *lazyResource) Name() string {
+	return r.name
+}
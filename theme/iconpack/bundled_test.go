@@ -0,0 +1,35 @@
+package iconpack
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBundled(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	w := gzip.NewWriter(buffer)
+	_, err := w.Write([]byte("<svg/>"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	icons := NewBundled("Test", map[fyne.ThemeIconName]Asset{
+		theme.IconNameHome: {Name: "home.svg", Symbolic: true, Data: buffer.String()},
+		theme.IconNameFile: {Name: "file.png", Data: buffer.String()},
+	})
+	assert.Equal(t, "Test", icons.Name())
+	home := icons.Icon(theme.IconNameHome)
+	assert.Same(t, home, icons.Icon(theme.IconNameHome))
+	assert.Equal(t, "foreground_home.svg", home.Name())
+	assert.Nil(t, icons.Icon(theme.IconNameFolder))
+
+	file := icons.Icon(theme.IconNameFile).(*lazyResource)
+	assert.Equal(t, "file.png", file.Name())
+	assert.Nil(t, file.content, "the content should be decompressed when it is read")
+	assert.Equal(t, []byte("<svg/>"), file.Content())
+}
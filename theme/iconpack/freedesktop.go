@@ -0,0 +1,276 @@
+package iconpack
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// FreedesktopNames are the names of the freedesktop icons for the icon names of Fyne themes, as named
+// by icon themes such as Adwaita, Papirus and Breeze. A name that ends with "-symbolic" falls back
+// to the icon without the suffix in themes that have no symbolic icons.
+// See: https://specifications.freedesktop.org/icon-naming-spec/latest/
+var FreedesktopNames = map[fyne.ThemeIconName]string{
+	theme.IconNameCancel:        "window-close-symbolic",
+	theme.IconNameConfirm:       "object-select-symbolic",
+	theme.IconNameDelete:        "edit-delete-symbolic",
+	theme.IconNameSearch:        "edit-find-symbolic",
+	theme.IconNameSearchReplace: "edit-find-replace-symbolic",
+	theme.IconNameMenu:          "open-menu-symbolic",
+	theme.IconNameMenuExpand:    "pan-end-symbolic",
+
+	theme.IconNameCheckButton:        "checkbox-symbolic",
+	theme.IconNameCheckButtonChecked: "checkbox-checked-symbolic",
+	theme.IconNameRadioButton:        "radio-symbolic",
+	theme.IconNameRadioButtonChecked: "radio-checked-symbolic",
+
+	theme.IconNameContentAdd:    "list-add-symbolic",
+	theme.IconNameContentClear:  "edit-clear-symbolic",
+	theme.IconNameContentRemove: "list-remove-symbolic",
+	theme.IconNameContentCut:    "edit-cut-symbolic",
+	theme.IconNameContentCopy:   "edit-copy-symbolic",
+	theme.IconNameContentPaste:  "edit-paste-symbolic",
+	theme.IconNameContentRedo:   "edit-redo-symbolic",
+	theme.IconNameContentUndo:   "edit-undo-symbolic",
+
+	theme.IconNameColorPalette: "applications-graphics-symbolic",
+
+	theme.IconNameDocument:       "text-x-generic-symbolic",
+	theme.IconNameDocumentCreate: "document-new-symbolic",
+	theme.IconNameDocumentPrint:  "document-print-symbolic",
+	theme.IconNameDocumentSave:   "document-save-symbolic",
+
+	theme.IconNameMoreHorizontal: "view-more-horizontal-symbolic",
+	theme.IconNameMoreVertical:   "view-more-symbolic",
+
+	theme.IconNameInfo:     "dialog-information-symbolic",
+	theme.IconNameQuestion: "dialog-question-symbolic",
+	theme.IconNameWarning:  "dialog-warning-symbolic",
+	theme.IconNameError:    "dialog-error-symbolic",
+
+	theme.IconNameMailAttachment: "mail-attachment-symbolic",
+	theme.IconNameMailCompose:    "mail-message-new-symbolic",
+	theme.IconNameMailForward:    "mail-forward-symbolic",
+	theme.IconNameMailReply:      "mail-reply-sender-symbolic",
+	theme.IconNameMailReplyAll:   "mail-reply-all-symbolic",
+	theme.IconNameMailSend:       "mail-send-symbolic",
+
+	theme.IconNameMediaMusic:        "audio-x-generic-symbolic",
+	theme.IconNameMediaPhoto:        "image-x-generic-symbolic",
+	theme.IconNameMediaVideo:        "video-x-generic-symbolic",
+	theme.IconNameMediaFastForward:  "media-seek-forward-symbolic",
+	theme.IconNameMediaFastRewind:   "media-seek-backward-symbolic",
+	theme.IconNameMediaPause:        "media-playback-pause-symbolic",
+	theme.IconNameMediaPlay:         "media-playback-start-symbolic",
+	theme.IconNameMediaRecord:       "media-record-symbolic",
+	theme.IconNameMediaReplay:       "media-seek-backward-symbolic",
+	theme.IconNameMediaSkipNext:     "media-skip-forward-symbolic",
+	theme.IconNameMediaSkipPrevious: "media-skip-backward-symbolic",
+	theme.IconNameMediaStop:         "media-playback-stop-symbolic",
+
+	theme.IconNameNavigateBack:  "go-previous-symbolic",
+	theme.IconNameMoveDown:      "go-down-symbolic",
+	theme.IconNameNavigateNext:  "go-next-symbolic",
+	theme.IconNameMoveUp:        "go-up-symbolic",
+	theme.IconNameArrowDropDown: "pan-down-symbolic",
+	theme.IconNameArrowDropUp:   "pan-up-symbolic",
+
+	theme.IconNameFile:            "application-x-generic",
+	theme.IconNameFileApplication: "application-x-executable",
+	theme.IconNameFileAudio:       "audio-x-generic",
+	theme.IconNameFileImage:       "image-x-generic",
+	theme.IconNameFileText:        "text-x-generic",
+	theme.IconNameFileVideo:       "video-x-generic",
+	theme.IconNameFolder:          "folder",
+	theme.IconNameFolderNew:       "folder-new-symbolic",
+	theme.IconNameFolderOpen:      "folder-open-symbolic",
+	theme.IconNameHelp:            "help-about-symbolic",
+	theme.IconNameHistory:         "document-open-recent-symbolic",
+	theme.IconNameHome:            "user-home-symbolic",
+	theme.IconNameSettings:        "applications-system-symbolic",
+
+	theme.IconNameViewFullScreen: "view-fullscreen-symbolic",
+	theme.IconNameViewRefresh:    "view-refresh-symbolic",
+	theme.IconNameViewRestore:    "view-restore-symbolic",
+	theme.IconNameViewZoomFit:    "zoom-fit-best-symbolic",
+	theme.IconNameViewZoomIn:     "zoom-in-symbolic",
+	theme.IconNameViewZoomOut:    "zoom-out-symbolic",
+
+	theme.IconNameVisibility:    "view-reveal-symbolic",
+	theme.IconNameVisibilityOff: "view-conceal-symbolic",
+
+	theme.IconNameVolumeDown: "audio-volume-low-symbolic",
+	theme.IconNameVolumeMute: "audio-volume-muted-symbolic",
+	theme.IconNameVolumeUp:   "audio-volume-high-symbolic",
+
+	theme.IconNameDownload: "folder-download-symbolic",
+	theme.IconNameComputer: "computer-symbolic",
+	theme.IconNameDesktop:  "user-desktop-symbolic",
+	theme.IconNameStorage:  "drive-harddisk-symbolic",
+	theme.IconNameUpload:   "send-to-symbolic",
+
+	theme.IconNameAccount: "avatar-default-symbolic",
+	theme.IconNameLogout:  "system-log-out-symbolic",
+
+	theme.IconNameList: "view-list-symbolic",
+	theme.IconNameGrid: "view-grid-symbolic",
+
+	theme.IconNameWindowClose:    "window-close-symbolic",
+	theme.IconNameWindowMaximize: "window-maximize-symbolic",
+	theme.IconNameWindowMinimize: "window-minimize-symbolic",
+}
+
+// FindIcons returns the paths of the icons of a freedesktop icon theme directory, such as /usr/share/icons/Papirus,
+// for the icon names of Fyne themes that it has. Of the sizes of an icon, the scalable and symbolic ones are
+// preferred, then the largest, and SVG files are preferred to PNG files.
+func FindIcons(dir string) (map[fyne.ThemeIconName]string, error) {
+	found := map[string]string{} // the best path for each file name without extension
+	scores := map[string]int{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		ext := filepath.Ext(path)
+		if d.IsDir() || (ext != ".svg" && ext != ".png") {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		name := strings.TrimSuffix(d.Name(), ext)
+		score := iconScore(filepath.Dir(rel), ext)
+		if best, ok := scores[name]; !ok || score > best {
+			found[name], scores[name] = path, score
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	paths := map[fyne.ThemeIconName]string{}
+	for name, icon := range FreedesktopNames {
+		if path, ok := found[icon]; ok {
+			paths[name] = path
+		} else if path, ok := found[strings.TrimSuffix(icon, "-symbolic")]; ok {
+			paths[name] = path
+		}
+	}
+	return paths, nil
+}
+
+// FromDir returns the icons of a freedesktop icon theme directory, such as /usr/share/icons/Papirus.
+// The directory is searched when an icon is first asked for, and the icons are read as they are used.
+// A pack of a directory that doesn't exist has no icons.
+func FromDir(name, dir string) IconPack {
+	return &dirPack{name: name, dir: dir, icons: map[fyne.ThemeIconName]fyne.Resource{}}
+}
+
+var _ IconPack = (*dirPack)(nil)
+
+type dirPack struct {
+	name, dir string
+
+	once  sync.Once
+	paths map[fyne.ThemeIconName]string
+
+	mu    sync.Mutex
+	icons map[fyne.ThemeIconName]fyne.Resource
+}
+
+// Icon returns the named icon, or nil if the directory doesn't have it.
+func (p *dirPack) Icon(name fyne.ThemeIconName) fyne.Resource {
+	p.once.Do(func() {
+		if p.dir == "" {
+			return
+		}
+		paths, err := FindIcons(p.dir)
+		if err != nil {
+			fyne.LogError("Failed to find the icons of "+p.name, err)
+			return
+		}
+		p.paths = paths
+	})
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if icon, ok := p.icons[name]; ok {
+		return icon
+	}
+	path, ok := p.paths[name]
+	if !ok {
+		return nil
+	}
+	icon, err := fyne.LoadResourceFromPath(path)
+	if err != nil {
+		fyne.LogError("Failed to load icon "+path, err)
+		return nil
+	}
+	if strings.Contains(icon.Name(), "-symbolic") {
+		icon = theme.NewThemedResource(icon)
+	}
+	p.icons[name] = icon
+	return icon
+}
+
+// Name returns the name of the icon pack.
+func (p *dirPack) Name() string {
+	return p.name
+}
+
+// iconScore returns how much an icon in a directory of an icon theme, such as "16x16/actions" or
+// "actions/22", is preferred to the same icon in other directories.
+func iconScore(dir, ext string) int {
+	score := 0
+	for _, part := range strings.Split(filepath.ToSlash(dir), "/") {
+		switch {
+		case part == "scalable" || part == "symbolic":
+			score = 10000
+		case score < 10000:
+			size, _ := strconv.Atoi(strings.SplitN(strings.SplitN(part, "x", 2)[0], "@", 2)[0])
+			if size > score {
+				score = size
+			}
+		}
+	}
+	score *= 2
+	if ext == ".svg" {
+		score++
+	}
+	return score
+}
+
+// installedThemeDir returns the directory of an icon theme installed on the system, in the data directories
+// of the XDG base directory specification, or "" if it is not installed.
+func installedThemeDir(name string) string {
+	var dirs []string
+	if home, err := os.UserHomeDir(); err == nil {
+		dataHome := os.Getenv("XDG_DATA_HOME")
+		if dataHome == "" {
+			dataHome = filepath.Join(home, ".local", "share")
+		}
+		dirs = append(dirs, filepath.Join(dataHome, "icons"), filepath.Join(home, ".icons"))
+	}
+	dataDirs := os.Getenv("XDG_DATA_DIRS")
+	if dataDirs == "" {
+		dataDirs = "/usr/local/share:/usr/share"
+	}
+	for _, dir := range filepath.SplitList(dataDirs) {
+		dirs = append(dirs, filepath.Join(dir, "icons"))
+	}
+
+	for _, dir := range dirs {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			return path
+		}
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+package iconpack
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeIcon(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestFindIcons(t *testing.T) {
+	dir := t.TempDir()
+	writeIcon(t, filepath.Join(dir, "16x16", "places", "folder.svg"), "small")
+	writeIcon(t, filepath.Join(dir, "48x48", "places", "folder.svg"), "large")
+	writeIcon(t, filepath.Join(dir, "48x48", "places", "folder.png"), "large png")
+	writeIcon(t, filepath.Join(dir, "actions", "22", "window-close.svg"), "breeze style")
+	writeIcon(t, filepath.Join(dir, "symbolic", "places", "user-home-symbolic.svg"), "symbolic")
+	writeIcon(t, filepath.Join(dir, "64x64", "places", "user-home.svg"), "full color")
+
+	paths, err := FindIcons(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "48x48", "places", "folder.svg"), paths[theme.IconNameFolder])
+	assert.Equal(t, filepath.Join(dir, "actions", "22", "window-close.svg"), paths[theme.IconNameCancel])
+	assert.Equal(t, filepath.Join(dir, "symbolic", "places", "user-home-symbolic.svg"), paths[theme.IconNameHome])
+	assert.NotContains(t, paths, theme.IconNameSearch)
+
+	_, err = FindIcons(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestFromDir(t *testing.T) {
+	dir := t.TempDir()
+	writeIcon(t, filepath.Join(dir, "symbolic", "places", "user-home-symbolic.svg"), "<svg/>")
+	writeIcon(t, filepath.Join(dir, "scalable", "places", "folder.svg"), "<svg/>")
+
+	icons := FromDir("Test", dir)
+	assert.Equal(t, "Test", icons.Name())
+	home := icons.Icon(theme.IconNameHome)
+	assert.Equal(t, "foreground_user-home-symbolic.svg", home.Name())
+	assert.Same(t, home, icons.Icon(theme.IconNameHome))
+	assert.Equal(t, "folder.svg", icons.Icon(theme.IconNameFolder).Name())
+	assert.Nil(t, icons.Icon(theme.IconNameSearch))
+
+	assert.Nil(t, FromDir("Missing", "").Icon(theme.IconNameHome))
+}
+
+func TestInstalledThemeDir(t *testing.T) {
+	data := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(data, "icons", "Papirus"), 0755))
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("XDG_DATA_DIRS", data)
+
+	assert.Equal(t, filepath.Join(data, "icons", "Papirus"), installedThemeDir("Papirus"))
+	assert.Equal(t, "", installedThemeDir("breeze"))
+}
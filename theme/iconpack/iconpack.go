@@ -0,0 +1,77 @@
+// Package iconpack provides sets of icons for the icon names of Fyne themes, so that any theme can use
+// the icons of another, such as the Fluent theme with the Papirus icons.
+package iconpack
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// IconPack is a set of icons for the icon names of Fyne themes.
+type IconPack interface {
+	// Icon returns the named icon, or nil if the pack doesn't have it.
+	Icon(fyne.ThemeIconName) fyne.Resource
+	// Name returns the name of the pack, such as "Papirus".
+	Name() string
+}
+
+// Papirus returns the icons of the Papirus icon theme installed on the system. Where it is not installed,
+// such as on macOS and Windows, the pack has no icons and Theme takes them from the next pack or the base theme.
+// The icons are GPL-3.0 licensed and not bundled with this package, an app whose licence allows can bundle them
+// into its own package with iconpack_generator.go and use the pack it writes instead.
+// See: https://github.com/PapirusDevelopmentTeam/papirus-icon-theme
+func Papirus() IconPack {
+	return FromDir("Papirus", installedThemeDir("Papirus"))
+}
+
+// Breeze returns the icons of the Breeze icon theme of KDE installed on the system. Where it is not installed
+// the pack has no icons, as for Papirus. The icons are LGPL-3.0 licensed and can be bundled into an app's own
+// package with iconpack_generator.go.
+// See: https://invent.kde.org/frameworks/breeze-icons
+func Breeze() IconPack {
+	return FromDir("Breeze", installedThemeDir("breeze"))
+}
+
+// FromResources returns an icon pack of resources, such as those that an app bundles with fyne bundle.
+func FromResources(name string, icons map[fyne.ThemeIconName]fyne.Resource) IconPack {
+	return &resources{name: name, icons: icons}
+}
+
+// Theme returns a theme that is the base theme with the icons of the packs. Each icon comes from the
+// first pack that has it, or from the base theme if none has. A nil base is the default theme.
+func Theme(base fyne.Theme, packs ...IconPack) fyne.Theme {
+	if base == nil {
+		base = theme.DefaultTheme()
+	}
+	return &packTheme{Theme: base, packs: packs}
+}
+
+var _ fyne.Theme = (*packTheme)(nil)
+
+type packTheme struct {
+	fyne.Theme
+	packs []IconPack
+}
+
+// Icon returns the named resource for the current theme.
+func (t *packTheme) Icon(name fyne.ThemeIconName) fyne.Resource {
+	for _, pack := range t.packs {
+		if icon := pack.Icon(name); icon != nil {
+			return icon
+		}
+	}
+	return t.Theme.Icon(name)
+}
+
+type resources struct {
+	name  string
+	icons map[fyne.ThemeIconName]fyne.Resource
+}
+
+func (r *resources) Icon(name fyne.ThemeIconName) fyne.Resource {
+	return r.icons[name]
+}
+
+func (r *resources) Name() string {
+	return r.name
+}
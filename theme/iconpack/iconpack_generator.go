@@ -0,0 +1,256 @@
+//go:build ignore
+// +build ignore
+
+/*
+This tool bundles the icons of a freedesktop icon theme as an icon pack for Fyne.
+
+It downloads the icon theme from its repository, or uses a copy on disk given with -dir, and finds the icons
+for the icon names of Fyne themes with iconpack.FindIcons. The icons are gzip compressed and decompressed
+when they are first used.
+
+The packs are not bundled with the iconpack package, whose Papirus and Breeze functions use the icon themes
+installed on the system. An app whose licence allows it can bundle them into its own package instead, which
+writes one file per pack, such as papirus_icons.go with a papirusIcons variable of type iconpack.IconPack.
+The Papirus icons are GPL-3.0 licensed and the Breeze icons LGPL-3.0.
+
+Usage, from the directory of the package to write the files to:
+go run fyne.io/x/fyne/theme/iconpack/iconpack_generator.go -pkg main -pack papirus
+go run fyne.io/x/fyne/theme/iconpack/iconpack_generator.go -pkg main -pack breeze -dir /usr/share/icons/breeze
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"fyne.io/x/fyne/theme/iconpack"
+)
+
+// the template of the bundled icons of a pack
+const iconSourceTpl = `package {{.Package}}
+
+// This file is generated by iconpack_generator.go
+// Please do not edit manually, use:
+// go run fyne.io/x/fyne/theme/iconpack/iconpack_generator.go -pkg {{.Package}} -pack {{.Key}}
+//
+// These icons come from "{{.Project}}"
+// Repository: {{.Repository}}
+// Licence: {{.Licence}}
+
+import (
+    "fyne.io/fyne/v2"
+
+    "fyne.io/x/fyne/theme/iconpack"
+)
+
+// {{.Var}} is the {{.Name}} icon pack, the icons are gzip compressed and decompressed as they are used.
+var {{.Var}} = iconpack.NewBundled("{{.Name}}", map[fyne.ThemeIconName]iconpack.Asset{
+{{range $name, $icon := .Icons}}
+    {{ printf "%q" $name }}: {
+        Name: "{{$icon.Name}}",
+        Symbolic: {{ contains $icon.Name "-symbolic" }},
+        Data: {{ printf "%q" $icon.Data}},
+    },
+{{end}}
+})
+`
+
+type pack struct {
+	Name       string // the name of the pack, as returned by its Name method
+	Var        string // the name of the variable of the pack
+	Project    string
+	Repository string
+	Licence    string
+	archive    string // the URL of the tar.gz archive of the repository
+	root       string // the directory of the icon theme in the archive
+	output     string
+}
+
+var packs = map[string]pack{
+	"papirus": {
+		Name:       "Papirus",
+		Var:        "papirusIcons",
+		Project:    "Papirus Development Team",
+		Repository: "https://github.com/PapirusDevelopmentTeam/papirus-icon-theme",
+		Licence:    "GPL-3.0, see: https://github.com/PapirusDevelopmentTeam/papirus-icon-theme/blob/master/LICENSE",
+		archive:    "https://github.com/PapirusDevelopmentTeam/papirus-icon-theme/archive/refs/heads/master.tar.gz",
+		root:       "papirus-icon-theme-master/Papirus",
+		output:     "papirus_icons.go",
+	},
+	"breeze": {
+		Name:       "Breeze",
+		Var:        "breezeIcons",
+		Project:    "KDE",
+		Repository: "https://invent.kde.org/frameworks/breeze-icons",
+		Licence:    "LGPL-3.0, see: https://invent.kde.org/frameworks/breeze-icons/-/blob/master/COPYING-ICONS",
+		archive:    "https://invent.kde.org/frameworks/breeze-icons/-/archive/master/breeze-icons-master.tar.gz",
+		root:       "breeze-icons-master/icons",
+		output:     "breeze_icons.go",
+	},
+}
+
+type iconInfo struct {
+	Name string // the file name of the icon
+	Data string // the gzip compressed content of the icon, SVG or PNG content
+}
+
+func main() {
+	name := flag.String("pack", "", "the pack to generate: papirus or breeze")
+	pkg := flag.String("pkg", "main", "the package of the generated file")
+	dir := flag.String("dir", "", "the directory of the icon theme, downloaded if empty")
+	flag.Parse()
+
+	p, ok := packs[*name]
+	if !ok {
+		log.Fatalf("unknown pack %q, use papirus or breeze", *name)
+	}
+	if err := generate(*name, *pkg, p, *dir); err != nil {
+		log.Fatalf("failed to generate %s: %v", p.Name, err)
+	}
+}
+
+// generate writes the bundled icons of a pack in a package, from the icon theme in a directory or downloaded.
+func generate(key, pkg string, p pack, dir string) error {
+	if dir == "" {
+		tmpDir, err := os.MkdirTemp("", strings.ToLower(p.Name))
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+		if err := download(p.archive, tmpDir); err != nil {
+			return err
+		}
+		dir = filepath.Join(tmpDir, p.root)
+	}
+
+	paths, err := iconpack.FindIcons(dir)
+	if err != nil {
+		return err
+	}
+	icons := map[string]iconInfo{}
+	for name, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			log.Println("Error bundling", name, "from", path, ":", err)
+			continue
+		}
+		data, err := compress(content)
+		if err != nil {
+			return fmt.Errorf("failed to compress %s: %w", path, err)
+		}
+		icons[string(name)] = iconInfo{Name: filepath.Base(path), Data: data}
+	}
+
+	tpl := template.New(p.Name)
+	tpl = tpl.Funcs(template.FuncMap{
+		"contains": strings.Contains,
+	})
+	tpl, err = tpl.Parse(iconSourceTpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %w", err)
+	}
+	buffer := bytes.NewBuffer(nil)
+	err = tpl.Execute(buffer, struct {
+		pack
+		Key, Package string
+		Icons        map[string]iconInfo
+	}{
+		pack:    p,
+		Key:     key,
+		Package: pkg,
+		Icons:   icons,
+	})
+	if err != nil {
+		return fmt.Errorf("error executing template: %w", err)
+	}
+
+	formatted, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("error formatting source: %w", err)
+	}
+	return os.WriteFile(p.output, formatted, 0644)
+}
+
+// download extracts the tar.gz archive of a repository in a directory, with its symbolic links,
+// which icon themes use for the icons that have several names.
+func download(url, dir string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return err
+	}
+	tarReader := tar.NewReader(zr)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dir, header.Name)
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid path in archive: %s", header.Name)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			file, err := os.Create(target)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(file, tarReader)
+			file.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// compress returns the content of an icon compressed with gzip, which is decompressed when the icon is used.
+func compress(content []byte) (string, error) {
+	buffer := bytes.NewBuffer(nil)
+	w, err := gzip.NewWriterLevel(buffer, gzip.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(content); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
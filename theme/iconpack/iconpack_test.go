@@ -0,0 +1,35 @@
+package iconpack
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTheme(t *testing.T) {
+	first := FromResources("First", map[fyne.ThemeIconName]fyne.Resource{
+		theme.IconNameHome: theme.ComputerIcon(),
+	})
+	second := FromResources("Second", map[fyne.ThemeIconName]fyne.Resource{
+		theme.IconNameHome:   theme.FolderIcon(),
+		theme.IconNameSearch: theme.FileIcon(),
+	})
+	th := Theme(nil, first, second)
+
+	assert.Equal(t, theme.ComputerIcon(), th.Icon(theme.IconNameHome))
+	assert.Equal(t, theme.FileIcon(), th.Icon(theme.IconNameSearch))
+	assert.Equal(t, theme.DefaultTheme().Icon(theme.IconNameCancel), th.Icon(theme.IconNameCancel))
+	assert.Equal(t, theme.DefaultTheme().Size(theme.SizeNameText), th.Size(theme.SizeNameText))
+}
+
+func TestPapirus_NotInstalled(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("XDG_DATA_DIRS", t.TempDir())
+
+	icons := Papirus()
+	assert.Equal(t, "Papirus", icons.Name())
+	assert.Nil(t, icons.Icon(theme.IconNameHome))
+}
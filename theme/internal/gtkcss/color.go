@@ -0,0 +1,322 @@
+package gtkcss
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// named colors that stylesheets use besides their own definitions
+var cssNamedColors = map[string]color.NRGBA{
+	"transparent": {},
+	"black":       {A: 0xff},
+	"white":       {R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	"red":         {R: 0xff, A: 0xff},
+	"green":       {G: 0x80, A: 0xff},
+	"blue":        {B: 0xff, A: 0xff},
+	"gray":        {R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+	"grey":        {R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+}
+
+// ParseColor returns the color of a CSS color value, such as "#3584e4", "rgba(0, 0, 0, 0.5)" or "white".
+// Values that refer to other colors, like "@accent_color" or "var(--accent-color)", need a stylesheet
+// and are parsed by Parse.
+func ParseColor(value string) (color.Color, error) {
+	c, err := (&resolver{}).color(value)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// resolver evaluates color values, looking the colors they refer to up in the definitions of a stylesheet.
+type resolver struct {
+	defs      map[string]string
+	colors    map[string]color.NRGBA
+	resolving map[string]bool
+}
+
+func (r *resolver) lookup(name string) (color.NRGBA, error) {
+	name = NormalizeName(name)
+	if c, ok := r.colors[name]; ok {
+		return c, nil
+	}
+	value, ok := r.defs[name]
+	if !ok {
+		return color.NRGBA{}, fmt.Errorf("color %q is not defined", name)
+	}
+	if r.resolving[name] {
+		return color.NRGBA{}, fmt.Errorf("color %q refers to itself", name)
+	}
+	r.resolving[name] = true
+	c, err := r.color(value)
+	delete(r.resolving, name)
+	if err != nil {
+		return color.NRGBA{}, err
+	}
+	r.colors[name] = c
+	return c, nil
+}
+
+func (r *resolver) color(value string) (color.NRGBA, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return color.NRGBA{}, fmt.Errorf("empty color")
+	}
+	if value[0] == '@' {
+		return r.lookup(value[1:])
+	}
+	if value[0] == '#' {
+		return parseHex(value)
+	}
+	if c, ok := cssNamedColors[strings.ToLower(value)]; ok {
+		return c, nil
+	}
+
+	open := strings.IndexByte(value, '(')
+	if open < 0 || !strings.HasSuffix(value, ")") {
+		return color.NRGBA{}, fmt.Errorf("unknown color %q", value)
+	}
+	fn := strings.ToLower(strings.TrimSpace(value[:open]))
+	args := splitArgs(value[open+1 : len(value)-1])
+	switch fn {
+	case "rgb", "rgba":
+		return parseRGB(args)
+	case "var":
+		name := strings.TrimSpace(args[0])
+		c, err := r.lookup(name)
+		if err != nil && len(args) > 1 {
+			return r.color(strings.Join(args[1:], ","))
+		}
+		return c, err
+	case "alpha":
+		if len(args) != 2 {
+			break
+		}
+		c, err := r.color(args[0])
+		if err != nil {
+			return c, err
+		}
+		f, err := parseNumber(args[1])
+		c.A = clampByte(float64(c.A) * f)
+		return c, err
+	case "shade", "lighter", "darker":
+		factor := map[string]float64{"lighter": 1.3, "darker": .7}[fn]
+		if fn == "shade" {
+			if len(args) != 2 {
+				break
+			}
+			var err error
+			if factor, err = parseNumber(args[1]); err != nil {
+				return color.NRGBA{}, err
+			}
+		}
+		c, err := r.color(args[0])
+		return shade(c, factor), err
+	case "mix":
+		if len(args) != 3 {
+			break
+		}
+		a, err := r.color(args[0])
+		if err != nil {
+			return a, err
+		}
+		b, err := r.color(args[1])
+		if err != nil {
+			return b, err
+		}
+		f, err := parseNumber(args[2])
+		return mix(a, b, f), err
+	case "color-mix":
+		return r.colorMix(args)
+	}
+	return color.NRGBA{}, fmt.Errorf("unsupported color %q", value)
+}
+
+// colorMix evaluates color-mix(in srgb, a p%, b q%), which mixes a and b by the given percentages.
+func (r *resolver) colorMix(args []string) (color.NRGBA, error) {
+	if len(args) != 3 || strings.TrimSpace(args[0]) != "in srgb" {
+		return color.NRGBA{}, fmt.Errorf("unsupported color-mix(%s)", strings.Join(args, ","))
+	}
+	parts := [2]color.NRGBA{}
+	weights := [2]float64{-1, -1}
+	for i, arg := range args[1:] {
+		arg = strings.TrimSpace(arg)
+		if space := strings.LastIndexByte(arg, ' '); space > 0 && strings.HasSuffix(arg, "%") {
+			p, err := parseNumber(arg[space+1:])
+			if err != nil {
+				return color.NRGBA{}, err
+			}
+			weights[i], arg = p, arg[:space]
+		}
+		c, err := r.color(arg)
+		if err != nil {
+			return c, err
+		}
+		parts[i] = c
+	}
+	switch {
+	case weights[0] < 0 && weights[1] < 0:
+		weights = [2]float64{.5, .5}
+	case weights[0] < 0:
+		weights[0] = 1 - weights[1]
+	case weights[1] < 0:
+		weights[1] = 1 - weights[0]
+	}
+	return mix(parts[1], parts[0], weights[0]/(weights[0]+weights[1])), nil
+}
+
+// splitArgs splits the arguments of a function at the commas that are not within nested functions.
+func splitArgs(s string) []string {
+	var args []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(args, strings.TrimSpace(s[start:]))
+}
+
+func parseHex(s string) (color.NRGBA, error) {
+	hex := s[1:]
+	if len(hex) == 3 || len(hex) == 4 {
+		long := make([]byte, 0, len(hex)*2)
+		for i := 0; i < len(hex); i++ {
+			long = append(long, hex[i], hex[i])
+		}
+		hex = string(long)
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || len(hex) != 8 {
+		return color.NRGBA{}, fmt.Errorf("invalid color %q", s)
+	}
+	return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, nil
+}
+
+// parseRGB parses the arguments of rgb() or rgba(), separated by commas or, in the modern syntax,
+// by spaces with the alpha after a slash.
+func parseRGB(args []string) (color.NRGBA, error) {
+	if len(args) == 1 {
+		args = strings.Fields(strings.Replace(args[0], "/", " ", 1))
+	}
+	if len(args) != 3 && len(args) != 4 {
+		return color.NRGBA{}, fmt.Errorf("invalid rgb(%s)", strings.Join(args, ","))
+	}
+	var channels [3]uint8
+	for i := range channels {
+		arg := strings.TrimSpace(args[i])
+		f, err := parseNumber(arg)
+		if err != nil {
+			return color.NRGBA{}, err
+		}
+		if strings.HasSuffix(arg, "%") {
+			f *= 0xff
+		}
+		channels[i] = clampByte(f)
+	}
+	c := color.NRGBA{R: channels[0], G: channels[1], B: channels[2], A: 0xff}
+	if len(args) == 4 {
+		a, err := parseNumber(args[3])
+		if err != nil {
+			return c, err
+		}
+		c.A = clampByte(a * 0xff)
+	}
+	return c, nil
+}
+
+// parseNumber parses a number, or a percentage as a fraction.
+func parseNumber(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	scale := 1.0
+	if strings.HasSuffix(s, "%") {
+		s, scale = strings.TrimSuffix(s, "%"), .01
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return f * scale, nil
+}
+
+func clampByte(f float64) uint8 {
+	return uint8(math.Max(0, math.Min(0xff, math.Round(f))))
+}
+
+// mix returns a mixed with b by a fraction, as the mix() of GTK, with 0 being a and 1 being b.
+func mix(a, b color.NRGBA, f float64) color.NRGBA {
+	channel := func(x, y uint8) uint8 {
+		return clampByte(float64(x) + (float64(y)-float64(x))*f)
+	}
+	return color.NRGBA{R: channel(a.R, b.R), G: channel(a.G, b.G), B: channel(a.B, b.B), A: channel(a.A, b.A)}
+}
+
+// shade multiplies the lightness and saturation of a color by a factor, as the shade() of GTK.
+func shade(c color.NRGBA, factor float64) color.NRGBA {
+	r, g, b := float64(c.R)/0xff, float64(c.G)/0xff, float64(c.B)/0xff
+	max, min := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	h, s, l := 0.0, 0.0, (max+min)/2
+	if d := max - min; d > 0 {
+		if l <= .5 {
+			s = d / (max + min)
+		} else {
+			s = d / (2 - max - min)
+		}
+		switch max {
+		case r:
+			h = (g - b) / d
+		case g:
+			h = 2 + (b-r)/d
+		default:
+			h = 4 + (r-g)/d
+		}
+		h *= 60
+		if h < 0 {
+			h += 360
+		}
+	}
+	l = math.Min(l*factor, 1)
+	s = math.Min(s*factor, 1)
+
+	if s == 0 {
+		v := clampByte(l * 0xff)
+		return color.NRGBA{R: v, G: v, B: v, A: c.A}
+	}
+	var m2 float64
+	if l <= .5 {
+		m2 = l * (1 + s)
+	} else {
+		m2 = l + s - l*s
+	}
+	m1 := 2*l - m2
+	hue := func(h float64) uint8 {
+		h = math.Mod(h+360, 360)
+		var v float64
+		switch {
+		case h < 60:
+			v = m1 + (m2-m1)*h/60
+		case h < 180:
+			v = m2
+		case h < 240:
+			v = m1 + (m2-m1)*(240-h)/60
+		default:
+			v = m1
+		}
+		return clampByte(v * 0xff)
+	}
+	return color.NRGBA{R: hue(h + 120), G: hue(h), B: hue(h - 120), A: c.A}
+}
@@ -0,0 +1,111 @@
+// Package gtkcss parses the colors of GTK stylesheets, for the themes that follow GTK and libadwaita.
+package gtkcss
+
+import (
+	"errors"
+	"image/color"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ErrNoColors is returned for a stylesheet that defines no colors.
+var ErrNoColors = errors.New("the stylesheet defines no colors")
+
+var (
+	commentMatcher      = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	defineColorMatcher  = regexp.MustCompile(`@define-color\s+([\w-]+)\s+([^;]+);`)
+	variableMatcher     = regexp.MustCompile(`--([\w-]+)\s*:\s*([^;{}]+)`)
+	darkSchemeMatcher   = regexp.MustCompile(`@media[^{]*prefers-color-scheme\s*:\s*dark[^{]*\{`)
+	normalizeNameFormat = strings.NewReplacer("-", "_")
+)
+
+// Parse returns the colors that a stylesheet defines, with @define-color or as CSS variables, by name.
+// Those defined within a block for the dark color scheme, "@media (prefers-color-scheme: dark)", are
+// returned as dark, over the other colors; dark is nil if there are none. Colors may refer to others
+// and use the color functions of GTK, and colors whose values can't be evaluated are left out.
+func Parse(css []byte) (light, dark map[string]color.Color, err error) {
+	text := commentMatcher.ReplaceAllString(string(css), "")
+	var darkText string
+	for {
+		loc := darkSchemeMatcher.FindStringIndex(text)
+		if loc == nil {
+			break
+		}
+		end := matchingBrace(text, loc[1])
+		darkText += text[loc[1]:end]
+		text = text[:loc[0]] + text[min(end+1, len(text)):]
+	}
+
+	defs := definitions(text)
+	light = evaluate(defs)
+	if len(light) == 0 && darkText == "" {
+		return nil, nil, ErrNoColors
+	}
+	if darkText != "" {
+		for name, value := range definitions(darkText) {
+			defs[name] = value
+		}
+		dark = evaluate(defs)
+	}
+	return light, dark, nil
+}
+
+// NormalizeName returns the name of a color as Parse returns it: the name of @define-color, such as
+// "window_bg_color", with the dashes of CSS variables, such as "--window-bg-color", written as underscores.
+func NormalizeName(name string) string {
+	return normalizeNameFormat.Replace(strings.TrimPrefix(strings.TrimSpace(name), "--"))
+}
+
+// definitions returns the values of the colors defined in a stylesheet, by name. A color defined
+// several times has its last value, whether it is defined with @define-color or as a variable.
+func definitions(css string) map[string]string {
+	var matches [][]int
+	matches = append(matches, defineColorMatcher.FindAllStringSubmatchIndex(css, -1)...)
+	matches = append(matches, variableMatcher.FindAllStringSubmatchIndex(css, -1)...)
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i][0] < matches[j][0]
+	})
+
+	defs := map[string]string{}
+	for _, m := range matches {
+		defs[NormalizeName(css[m[2]:m[3]])] = strings.TrimSpace(css[m[4]:m[5]])
+	}
+	return defs
+}
+
+// evaluate returns the colors of the definitions that can be evaluated.
+func evaluate(defs map[string]string) map[string]color.Color {
+	r := &resolver{defs: defs, colors: map[string]color.NRGBA{}, resolving: map[string]bool{}}
+	colors := map[string]color.Color{}
+	for name := range defs {
+		if c, err := r.lookup(name); err == nil {
+			colors[name] = c
+		}
+	}
+	return colors
+}
+
+// matchingBrace returns the index of the brace that closes a block starting at start, or the end of s.
+func matchingBrace(s string, start int) int {
+	depth := 1
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(s)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
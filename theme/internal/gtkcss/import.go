@@ -0,0 +1,63 @@
+package gtkcss
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var importMatcher = regexp.MustCompile(`@import\s+(?:url\(\s*)?["']?([^"')\s;]+)["']?\s*\)?[^;]*;`)
+
+// Load reads the stylesheet at path with the stylesheets that it imports, such as `@import url("colors.css");`,
+// written in their place. Imports are relative to the file that has them, or file:// URLs. Those that can't be
+// read are left out, which includes the "resource:///" URLs of stylesheets compiled into a GResource bundle.
+func Load(path string) ([]byte, error) {
+	css, err := load(path, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(css), nil
+}
+
+func load(path string, loading map[string]bool) (string, error) {
+	css, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	loading[path] = true
+	defer delete(loading, path)
+
+	dir := filepath.Dir(path)
+	text := commentMatcher.ReplaceAllString(string(css), "")
+	return importMatcher.ReplaceAllStringFunc(text, func(rule string) string {
+		imported := importPath(dir, importMatcher.FindStringSubmatch(rule)[1])
+		if imported == "" || loading[imported] {
+			return ""
+		}
+		css, err := load(imported, loading)
+		if err != nil {
+			return ""
+		}
+		return css
+	}), nil
+}
+
+// importPath returns the file that an import refers to from a directory, or "" if it is not a file.
+func importPath(dir, ref string) string {
+	if strings.HasPrefix(ref, "file://") {
+		u, err := url.Parse(ref)
+		if err != nil {
+			return ""
+		}
+		return filepath.Clean(filepath.FromSlash(u.Path))
+	}
+	if filepath.IsAbs(ref) {
+		return filepath.Clean(ref)
+	}
+	if strings.Contains(ref, ":") {
+		return ""
+	}
+	return filepath.Join(dir, ref)
+}
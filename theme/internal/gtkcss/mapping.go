@@ -0,0 +1,49 @@
+package gtkcss
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// AdwaitaMapping gives the libadwaita named color of each theme color, as used by GTK 4 themes.
+// See: https://gnome.pages.gitlab.gnome.org/libadwaita/doc/main/named-colors.html
+var AdwaitaMapping = map[fyne.ThemeColorName]string{
+	theme.ColorNameBackground:          "window_bg_color",
+	theme.ColorNameButton:              "headerbar_bg_color",
+	theme.ColorNameError:               "error_bg_color",
+	theme.ColorNameForeground:          "window_fg_color",
+	theme.ColorNameForegroundOnError:   "error_fg_color",
+	theme.ColorNameForegroundOnPrimary: "accent_fg_color",
+	theme.ColorNameForegroundOnSuccess: "success_fg_color",
+	theme.ColorNameForegroundOnWarning: "warning_fg_color",
+	theme.ColorNameHeaderBackground:    "headerbar_bg_color",
+	theme.ColorNameHyperlink:           "accent_color",
+	theme.ColorNameInputBackground:     "view_bg_color",
+	theme.ColorNameMenuBackground:      "popover_bg_color",
+	theme.ColorNameOverlayBackground:   "view_bg_color",
+	theme.ColorNamePrimary:             "accent_bg_color",
+	theme.ColorNameSelection:           "headerbar_bg_color",
+	theme.ColorNameShadow:              "shade_color",
+	theme.ColorNameSuccess:             "success_bg_color",
+	theme.ColorNameWarning:             "warning_bg_color",
+}
+
+// GTK3Mapping gives the GTK 3 named color of each theme color, as defined by the GTK 3 themes
+// that predate libadwaita, such as Yaru, Pop and Arc.
+var GTK3Mapping = map[fyne.ThemeColorName]string{
+	theme.ColorNameBackground:          "theme_bg_color",
+	theme.ColorNameButton:              "theme_bg_color",
+	theme.ColorNameDisabled:            "insensitive_fg_color",
+	theme.ColorNameError:               "error_color",
+	theme.ColorNameForeground:          "theme_fg_color",
+	theme.ColorNameForegroundOnPrimary: "theme_selected_fg_color",
+	theme.ColorNameHyperlink:           "link_color",
+	theme.ColorNameInputBackground:     "theme_base_color",
+	theme.ColorNameInputBorder:         "borders",
+	theme.ColorNameMenuBackground:      "theme_base_color",
+	theme.ColorNameOverlayBackground:   "theme_base_color",
+	theme.ColorNamePrimary:             "theme_selected_bg_color",
+	theme.ColorNameSeparator:           "borders",
+	theme.ColorNameSuccess:             "success_color",
+	theme.ColorNameWarning:             "warning_color",
+}
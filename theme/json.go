@@ -0,0 +1,158 @@
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io"
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
+
+	"fyne.io/x/fyne/theme/iconpack"
+)
+
+// the icon sets that icons can be referred to in, as "set:name"
+var jsonIconSets = map[string]iconpack.IconPack{
+	"adwaita": AdwaitaIcons(),
+	"fluent":  FluentIcons(),
+}
+
+// jsonTheme is the data of a theme in JSON, with the schema of theme.FromJSON of Fyne,
+// whose icons may also refer to icons by name.
+type jsonTheme struct {
+	Colors      map[string]string  `json:"Colors,omitempty"`
+	LightColors map[string]string  `json:"Colors-light,omitempty"`
+	DarkColors  map[string]string  `json:"Colors-dark,omitempty"`
+	Sizes       map[string]float32 `json:"Sizes,omitempty"`
+	Fonts       map[string]string  `json:"Fonts,omitempty"`
+	Icons       map[string]string  `json:"Icons,omitempty"`
+}
+
+// ToJSON returns the colors of the light and dark variants of a theme and its sizes in JSON,
+// which FromJSONReader, and theme.FromJSON of Fyne, can load.
+// Icons from the sets of this package are referred to by name, such as "adwaita:home", and other icons are
+// left out, so that the default icons are used instead. Fonts are left out as they can't be referred to.
+func ToJSON(th fyne.Theme) ([]byte, error) {
+	data := jsonTheme{
+		LightColors: map[string]string{},
+		DarkColors:  map[string]string{},
+		Sizes:       map[string]float32{},
+		Icons:       map[string]string{},
+	}
+	for _, name := range ColorNames {
+		data.LightColors[string(name)] = jsonHexColor(th.Color(name, theme.VariantLight))
+		data.DarkColors[string(name)] = jsonHexColor(th.Color(name, theme.VariantDark))
+	}
+	for _, name := range SizeNames {
+		data.Sizes[string(name)] = th.Size(name)
+	}
+
+	for _, name := range IconNames {
+		if ref := jsonIconRef(name, th.Icon(name)); ref != "" {
+			data.Icons[string(name)] = ref
+		}
+	}
+	return json.MarshalIndent(data, "", "  ")
+}
+
+// FromJSONReader returns a theme from its JSON data, as written by ToJSON. Icons may be referred to by name,
+// such as "adwaita:home", or by URI as for theme.FromJSON of Fyne. Anything that the data leaves out,
+// or refers to but can't be loaded, comes from the default theme.
+func FromJSONReader(r io.Reader) (fyne.Theme, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return theme.DefaultTheme(), err
+	}
+	var data jsonTheme
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return theme.DefaultTheme(), err
+	}
+
+	// the colors, sizes and fonts are loaded by Fyne, which would try to load the icons as URIs
+	icons := data.Icons
+	data.Icons = nil
+	rest, err := json.Marshal(data)
+	if err != nil {
+		return theme.DefaultTheme(), err
+	}
+	base, err := theme.FromJSONReader(bytes.NewReader(rest))
+	if err != nil {
+		return base, err
+	}
+
+	var opts []Option
+	for name, ref := range icons {
+		if icon := jsonIcon(fyne.ThemeIconName(name), ref); icon != nil {
+			opts = append(opts, WithIcon(fyne.ThemeIconName(name), icon))
+		}
+	}
+	return Override(base, opts...), nil
+}
+
+// jsonIconRef returns the reference to the icon of a set that is the named icon, preferring the icon of
+// the same name, or "" for the default icon and icons that are not in a set.
+func jsonIconRef(name fyne.ThemeIconName, icon fyne.Resource) string {
+	if icon == nil {
+		return ""
+	}
+	if def := theme.DefaultTheme().Icon(name); def != nil && def.Name() == icon.Name() {
+		return ""
+	}
+
+	sets := make([]string, 0, len(jsonIconSets))
+	for set := range jsonIconSets {
+		sets = append(sets, set)
+	}
+	sort.Strings(sets)
+	for _, set := range sets {
+		if res := jsonIconSets[set].Icon(name); res != nil && res.Name() == icon.Name() {
+			return set + ":" + string(name)
+		}
+	}
+	// an icon may be used for several names, such as the Adwaita arrows
+	for _, set := range sets {
+		for _, other := range IconNames {
+			if res := jsonIconSets[set].Icon(other); res != nil && res.Name() == icon.Name() {
+				return set + ":" + string(other)
+			}
+		}
+	}
+	return ""
+}
+
+// jsonIcon returns the icon that a reference refers to, by name in an icon set, or by URI.
+func jsonIcon(name fyne.ThemeIconName, ref string) fyne.Resource {
+	set, iconName, ok := strings.Cut(ref, ":")
+	if !ok {
+		fyne.LogError("Failed to load icon "+string(name), fmt.Errorf("invalid icon reference %q", ref))
+		return nil
+	}
+	if icons, ok := jsonIconSets[set]; ok {
+		return icons.Icon(fyne.ThemeIconName(iconName))
+	}
+
+	uri, err := storage.ParseURI(ref)
+	if err != nil {
+		fyne.LogError("Failed to parse URI", err)
+		return nil
+	}
+	icon, err := storage.LoadResourceFromURI(uri)
+	if err != nil {
+		fyne.LogError("Failed to load resource from URI", err)
+		return nil
+	}
+	return icon
+}
+
+func jsonHexColor(c color.Color) string {
+	if c == nil {
+		return "#00000000"
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("#%02x%02x%02x%02x", n.R, n.G, n.B, n.A)
+}
@@ -0,0 +1,85 @@
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"image/color"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToJSON(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	data, err := ToJSON(AdwaitaTheme())
+	require.NoError(t, err)
+
+	var parsed jsonTheme
+	require.NoError(t, json.Unmarshal(data, &parsed))
+	assert.Equal(t, "#3584e4ff", parsed.LightColors[string(theme.ColorNamePrimary)])
+	assert.Len(t, parsed.DarkColors, len(ColorNames))
+	assert.Equal(t, theme.DefaultTheme().Size(theme.SizeNameText), parsed.Sizes[string(theme.SizeNameText)])
+	assert.Equal(t, "adwaita:home", parsed.Icons[string(theme.IconNameHome)])
+	assert.Empty(t, parsed.Fonts)
+
+	data, err = ToJSON(theme.DefaultTheme())
+	require.NoError(t, err)
+	var parsedDefault jsonTheme
+	require.NoError(t, json.Unmarshal(data, &parsedDefault))
+	assert.Empty(t, parsedDefault.Icons)
+}
+
+func TestFromJSONReader(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	for _, original := range []struct {
+		name string
+		data func() ([]byte, error)
+	}{
+		{"adwaita", func() ([]byte, error) { return ToJSON(AdwaitaTheme()) }},
+		{"fluent", func() ([]byte, error) { return ToJSON(Fluent()) }},
+	} {
+		t.Run(original.name, func(t *testing.T) {
+			data, err := original.data()
+			require.NoError(t, err)
+			th, err := FromJSONReader(bytes.NewReader(data))
+			require.NoError(t, err)
+
+			again, err := ToJSON(th)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(data), string(again))
+		})
+	}
+}
+
+func TestFromJSONReader_Fallback(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	th, err := FromJSONReader(strings.NewReader(`{
+		"Colors": {"primary": "#e66100"},
+		"Sizes": {"text": 15},
+		"Icons": {"home": "fluent:home", "cancel": "adwaita:missing", "delete": "nonsense"}
+	}`))
+	require.NoError(t, err)
+
+	orange := &color.NRGBA{R: 0xe6, G: 0x61, A: 0xff}
+	assert.Equal(t, orange, th.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, orange, th.Color(theme.ColorNamePrimary, theme.VariantDark))
+	assert.Equal(t, theme.DefaultTheme().Color(theme.ColorNameBackground, theme.VariantDark),
+		th.Color(theme.ColorNameBackground, theme.VariantDark))
+	assert.Equal(t, float32(15), th.Size(theme.SizeNameText))
+	assert.Equal(t, fluentIcons[theme.IconNameHome], th.Icon(theme.IconNameHome))
+	assert.Equal(t, theme.DefaultTheme().Icon(theme.IconNameCancel), th.Icon(theme.IconNameCancel))
+	assert.Equal(t, theme.DefaultTheme().Icon(theme.IconNameDelete), th.Icon(theme.IconNameDelete))
+
+	_, err = FromJSONReader(strings.NewReader("{"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,150 @@
+package theme
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+var _ fyne.Theme = (*materialYou)(nil)
+
+// the colors that Material has no roles for, which are harmonized with the seed as the custom colors of Material
+var (
+	materialSuccess = color.NRGBA{R: 0x43, G: 0xa0, B: 0x47, A: 0xff}
+	materialWarning = color.NRGBA{R: 0xff, G: 0xa0, B: 0x00, A: 0xff}
+)
+
+// tonalPalette is the colors of all tones of a hue and chroma, as Material picks the colors of a scheme from.
+type tonalPalette struct {
+	hue, chroma float64
+}
+
+func (p tonalPalette) tone(tone float64) color.Color {
+	return hct{hue: p.hue, chroma: p.chroma, tone: tone}.color()
+}
+
+// materialScheme is the tones of each role of a Material scheme, for one variant.
+type materialScheme struct {
+	primary, onPrimary, secondaryContainer, errorColor, onError, custom, onCustom float64
+
+	surface, surfaceContainer, surfaceContainerHigh, surfaceContainerHighest, onSurface float64
+	onSurfaceVariant, outline, outlineVariant                                           float64
+}
+
+// the tones of the roles of the light and dark schemes of Material 3
+// See: https://m3.material.io/styles/color/static/baseline
+var (
+	materialLight = materialScheme{
+		primary: 40, onPrimary: 100, secondaryContainer: 90, errorColor: 40, onError: 100, custom: 40, onCustom: 100,
+		surface: 98, surfaceContainer: 94, surfaceContainerHigh: 92, surfaceContainerHighest: 90, onSurface: 10,
+		onSurfaceVariant: 30, outline: 50, outlineVariant: 80,
+	}
+	materialDark = materialScheme{
+		primary: 80, onPrimary: 20, secondaryContainer: 30, errorColor: 80, onError: 20, custom: 80, onCustom: 20,
+		surface: 6, surfaceContainer: 12, surfaceContainerHigh: 17, surfaceContainerHighest: 22, onSurface: 90,
+		onSurfaceVariant: 80, outline: 60, outlineVariant: 30,
+	}
+)
+
+type materialYou struct {
+	light, dark map[fyne.ThemeColorName]color.Color
+}
+
+// MaterialYou returns a new theme whose colors are derived from a seed color by the dynamic color of
+// Material Design 3, such as the main color of an album art or of a wallpaper. The tonal palettes of
+// the "tonal spot" scheme are built in the HCT color space from the hue of the seed, and the light and
+// dark variants take their colors from the same palettes so that they keep the contrast of Material.
+// See: https://m3.material.io/styles/color/dynamic/choosing-a-source
+func MaterialYou(seed color.Color) fyne.Theme {
+	source := hctFromColor(seed)
+	palettes := materialPalettes{
+		primary:        tonalPalette{hue: source.hue, chroma: 36},
+		secondary:      tonalPalette{hue: source.hue, chroma: 16},
+		neutral:        tonalPalette{hue: source.hue, chroma: 6},
+		neutralVariant: tonalPalette{hue: source.hue, chroma: 8},
+		errorPalette:   tonalPalette{hue: 25, chroma: 84},
+		success:        harmonize(materialSuccess, source),
+		warning:        harmonize(materialWarning, source),
+	}
+	return &materialYou{light: palettes.colors(materialLight), dark: palettes.colors(materialDark)}
+}
+
+// Color returns the named color for the current theme.
+func (m *materialYou) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	scheme := m.light
+	if variant == theme.VariantDark {
+		scheme = m.dark
+	}
+	if c, ok := scheme[name]; ok {
+		return c
+	}
+	return theme.DefaultTheme().Color(name, variant)
+}
+
+// Font returns the named font for the current theme.
+func (m *materialYou) Font(style fyne.TextStyle) fyne.Resource {
+	return theme.DefaultTheme().Font(style)
+}
+
+// Icon returns the named resource for the current theme.
+func (m *materialYou) Icon(name fyne.ThemeIconName) fyne.Resource {
+	return theme.DefaultTheme().Icon(name)
+}
+
+// Size returns the size of the named resource for the current theme.
+func (m *materialYou) Size(name fyne.ThemeSizeName) float32 {
+	return theme.DefaultTheme().Size(name)
+}
+
+type materialPalettes struct {
+	primary, secondary, neutral, neutralVariant, errorPalette, success, warning tonalPalette
+}
+
+// colors returns the theme colors of the roles of a scheme, with the opacities of the states of Material.
+func (p materialPalettes) colors(s materialScheme) map[fyne.ThemeColorName]color.Color {
+	primary := p.primary.tone(s.primary)
+	onSurface := p.neutral.tone(s.onSurface)
+	return map[fyne.ThemeColorName]color.Color{
+		theme.ColorNameBackground:          p.neutral.tone(s.surface),
+		theme.ColorNameButton:              p.secondary.tone(s.secondaryContainer),
+		theme.ColorNameDisabledButton:      paletteAlpha(onSurface, 0x1f),
+		theme.ColorNameDisabled:            paletteAlpha(onSurface, 0x61),
+		theme.ColorNameError:               p.errorPalette.tone(s.errorColor),
+		theme.ColorNameFocus:               paletteAlpha(primary, 0x7f),
+		theme.ColorNameForeground:          onSurface,
+		theme.ColorNameForegroundOnError:   p.errorPalette.tone(s.onError),
+		theme.ColorNameForegroundOnPrimary: p.primary.tone(s.onPrimary),
+		theme.ColorNameForegroundOnSuccess: p.success.tone(s.onCustom),
+		theme.ColorNameForegroundOnWarning: p.warning.tone(s.onCustom),
+		theme.ColorNameHeaderBackground:    p.neutral.tone(s.surfaceContainer),
+		theme.ColorNameHover:               paletteAlpha(onSurface, 0x14),
+		theme.ColorNameHyperlink:           primary,
+		theme.ColorNameInputBackground:     p.neutral.tone(s.surfaceContainerHighest),
+		theme.ColorNameInputBorder:         p.neutralVariant.tone(s.outline),
+		theme.ColorNameMenuBackground:      p.neutral.tone(s.surfaceContainer),
+		theme.ColorNameOverlayBackground:   p.neutral.tone(s.surfaceContainerHigh),
+		theme.ColorNamePlaceHolder:         p.neutralVariant.tone(s.onSurfaceVariant),
+		theme.ColorNamePressed:             paletteAlpha(onSurface, 0x1a),
+		theme.ColorNamePrimary:             primary,
+		theme.ColorNameScrollBar:           p.neutralVariant.tone(s.outline),
+		theme.ColorNameSelection:           paletteAlpha(primary, 0x40),
+		theme.ColorNameSeparator:           p.neutralVariant.tone(s.outlineVariant),
+		theme.ColorNameShadow:              color.NRGBA{A: 0x66},
+		theme.ColorNameSuccess:             p.success.tone(s.custom),
+		theme.ColorNameWarning:             p.warning.tone(s.custom),
+	}
+}
+
+// harmonize returns the palette of a color with its hue rotated towards that of the source, by up to
+// 15 degrees, as Material does for custom colors so that they fit with the scheme.
+func harmonize(c color.Color, source hct) tonalPalette {
+	design := hctFromColor(c)
+	diff := 180 - math.Abs(math.Abs(design.hue-source.hue)-180)
+	rotation := math.Min(diff/2, 15)
+	if sanitizeDegrees(source.hue-design.hue) > 180 {
+		rotation = -rotation
+	}
+	return tonalPalette{hue: sanitizeDegrees(design.hue + rotation), chroma: design.chroma}
+}
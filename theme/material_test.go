@@ -0,0 +1,63 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHCT(t *testing.T) {
+	blue := hctFromColor(color.NRGBA{B: 0xff, A: 0xff})
+	assert.InDelta(t, 282.788, blue.hue, .001)
+	assert.InDelta(t, 87.231, blue.chroma, .001)
+	assert.InDelta(t, 32.303, blue.tone, .001)
+	assert.Equal(t, color.NRGBA{B: 0xff, A: 0xff}, blue.color())
+
+	red := hctFromColor(color.NRGBA{R: 0xff, A: 0xff})
+	assert.InDelta(t, 27.408, red.hue, .001)
+	assert.InDelta(t, 113.358, red.chroma, .001)
+	assert.InDelta(t, 53.233, red.tone, .001)
+
+	// chroma out of the gamut is reduced, keeping the tone
+	c := hct{hue: 120, chroma: 200, tone: 50}.color()
+	assert.InDelta(t, 50, hctFromColor(c).tone, .5)
+	assert.Equal(t, color.NRGBA{A: 0xff}, hct{hue: 120, chroma: 50, tone: 0}.color())
+}
+
+func TestMaterialYou(t *testing.T) {
+	// the colors of the baseline scheme of Material 3, whose seed is #6750a4
+	th := MaterialYou(color.NRGBA{R: 0x67, G: 0x50, B: 0xa4, A: 0xff})
+	assert.Equal(t, color.NRGBA{R: 0x65, G: 0x55, B: 0x8f, A: 0xff}, th.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, th.Color(theme.ColorNameForegroundOnPrimary, theme.VariantLight))
+	assert.Equal(t, color.NRGBA{R: 0xcf, G: 0xbd, B: 0xfe, A: 0xff}, th.Color(theme.ColorNamePrimary, theme.VariantDark))
+	assert.Equal(t, color.NRGBA{R: 0x65, G: 0x55, B: 0x8f, A: 0x40}, th.Color(theme.ColorNameSelection, theme.VariantLight))
+
+	for _, variant := range []fyne.ThemeVariant{theme.VariantLight, theme.VariantDark} {
+		for _, name := range ColorNames {
+			assert.NotNil(t, th.Color(name, variant), name)
+		}
+	}
+}
+
+func TestMaterialYou_Contrast(t *testing.T) {
+	for _, seed := range []color.Color{
+		color.NRGBA{R: 0x67, G: 0x50, B: 0xa4, A: 0xff},
+		color.NRGBA{R: 0xe6, G: 0x61, A: 0xff},
+		color.NRGBA{G: 0x80, B: 0x40, A: 0xff},
+		color.NRGBA{R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+	} {
+		th := MaterialYou(seed)
+		assert.Empty(t, CheckContrast(th, theme.VariantLight), seed)
+		assert.Empty(t, CheckContrast(th, theme.VariantDark), seed)
+	}
+}
+
+func TestHarmonize(t *testing.T) {
+	source := hct{hue: 300}
+	assert.InDelta(t, sanitizeDegrees(hctFromColor(materialSuccess).hue+15), harmonize(materialSuccess, source).hue, .001)
+	near := hct{hue: hctFromColor(materialWarning).hue - 10}
+	assert.InDelta(t, near.hue+5, harmonize(materialWarning, near).hue, .001)
+}
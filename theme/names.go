@@ -0,0 +1,58 @@
+package theme
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+var (
+	// ColorNames are the names of all the colors of a theme.
+	ColorNames = []fyne.ThemeColorName{
+		theme.ColorNameBackground, theme.ColorNameButton, theme.ColorNameDisabledButton, theme.ColorNameDisabled,
+		theme.ColorNameError, theme.ColorNameFocus, theme.ColorNameForeground, theme.ColorNameForegroundOnError,
+		theme.ColorNameForegroundOnPrimary, theme.ColorNameForegroundOnSuccess, theme.ColorNameForegroundOnWarning,
+		theme.ColorNameHeaderBackground, theme.ColorNameHover, theme.ColorNameHyperlink, theme.ColorNameInputBackground,
+		theme.ColorNameInputBorder, theme.ColorNameMenuBackground, theme.ColorNameOverlayBackground,
+		theme.ColorNamePlaceHolder, theme.ColorNamePressed, theme.ColorNamePrimary, theme.ColorNameScrollBar,
+		theme.ColorNameSelection, theme.ColorNameSeparator, theme.ColorNameShadow, theme.ColorNameSuccess,
+		theme.ColorNameWarning,
+	}
+
+	// SizeNames are the names of all the sizes of a theme.
+	SizeNames = []fyne.ThemeSizeName{
+		theme.SizeNameCaptionText, theme.SizeNameInlineIcon, theme.SizeNameInnerPadding, theme.SizeNameLineSpacing,
+		theme.SizeNamePadding, theme.SizeNameScrollBar, theme.SizeNameScrollBarSmall, theme.SizeNameSeparatorThickness,
+		theme.SizeNameText, theme.SizeNameHeadingText, theme.SizeNameSubHeadingText, theme.SizeNameInputBorder,
+		theme.SizeNameInputRadius, theme.SizeNameSelectionRadius,
+	}
+
+	// IconNames are the names of all the icons of a theme.
+	IconNames = []fyne.ThemeIconName{
+		theme.IconNameCancel, theme.IconNameConfirm, theme.IconNameDelete, theme.IconNameSearch,
+		theme.IconNameSearchReplace, theme.IconNameMenu, theme.IconNameMenuExpand, theme.IconNameCheckButton,
+		theme.IconNameCheckButtonChecked, theme.IconNameCheckButtonFill, theme.IconNameRadioButton,
+		theme.IconNameRadioButtonChecked, theme.IconNameRadioButtonFill, theme.IconNameColorAchromatic,
+		theme.IconNameColorChromatic, theme.IconNameColorPalette, theme.IconNameContentAdd, theme.IconNameContentRemove,
+		theme.IconNameContentCut, theme.IconNameContentCopy, theme.IconNameContentPaste, theme.IconNameContentClear,
+		theme.IconNameContentRedo, theme.IconNameContentUndo, theme.IconNameInfo, theme.IconNameQuestion,
+		theme.IconNameWarning, theme.IconNameError, theme.IconNameBrokenImage, theme.IconNameDocument,
+		theme.IconNameDocumentCreate, theme.IconNameDocumentPrint, theme.IconNameDocumentSave,
+		theme.IconNameDragCornerIndicator, theme.IconNameMoreHorizontal, theme.IconNameMoreVertical,
+		theme.IconNameMailAttachment, theme.IconNameMailCompose, theme.IconNameMailForward, theme.IconNameMailReply,
+		theme.IconNameMailReplyAll, theme.IconNameMailSend, theme.IconNameMediaMusic, theme.IconNameMediaPhoto,
+		theme.IconNameMediaVideo, theme.IconNameMediaFastForward, theme.IconNameMediaFastRewind,
+		theme.IconNameMediaPause, theme.IconNameMediaPlay, theme.IconNameMediaRecord, theme.IconNameMediaReplay,
+		theme.IconNameMediaSkipNext, theme.IconNameMediaSkipPrevious, theme.IconNameMediaStop, theme.IconNameMoveDown,
+		theme.IconNameMoveUp, theme.IconNameNavigateBack, theme.IconNameNavigateNext, theme.IconNameArrowDropDown,
+		theme.IconNameArrowDropUp, theme.IconNameFile, theme.IconNameFileApplication, theme.IconNameFileAudio,
+		theme.IconNameFileImage, theme.IconNameFileText, theme.IconNameFileVideo, theme.IconNameFolder,
+		theme.IconNameFolderNew, theme.IconNameFolderOpen, theme.IconNameHelp, theme.IconNameHistory,
+		theme.IconNameHome, theme.IconNameSettings, theme.IconNameStorage, theme.IconNameUpload,
+		theme.IconNameViewFullScreen, theme.IconNameViewRefresh, theme.IconNameViewZoomFit, theme.IconNameViewZoomIn,
+		theme.IconNameViewZoomOut, theme.IconNameViewRestore, theme.IconNameVisibility, theme.IconNameVisibilityOff,
+		theme.IconNameVolumeDown, theme.IconNameVolumeMute, theme.IconNameVolumeUp, theme.IconNameDownload,
+		theme.IconNameComputer, theme.IconNameDesktop, theme.IconNameAccount, theme.IconNameLogin,
+		theme.IconNameLogout, theme.IconNameList, theme.IconNameGrid, theme.IconNameWindowClose,
+		theme.IconNameWindowMaximize, theme.IconNameWindowMinimize,
+	}
+)
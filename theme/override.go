@@ -0,0 +1,124 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+var _ fyne.Theme = (*override)(nil)
+
+// Option replaces something of the theme given to Override.
+type Option func(*override)
+
+// anyVariant is the key of the colors that replace those of every variant.
+const anyVariant = fyne.ThemeVariant(0xff)
+
+type overrideColor struct {
+	name    fyne.ThemeColorName
+	variant fyne.ThemeVariant
+}
+
+type override struct {
+	base   fyne.Theme
+	colors map[overrideColor]color.Color
+	sizes  map[fyne.ThemeSizeName]float32
+	fonts  map[fyne.TextStyle]fyne.Resource
+	icons  map[fyne.ThemeIconName]fyne.Resource
+}
+
+// Override returns a new theme that is base with some of its colors, sizes, fonts and icons replaced.
+// Anything that the options don't replace comes from base, which may itself be an overridden theme:
+//
+//	th := theme.Override(theme.AdwaitaTheme(),
+//		theme.WithColor(fyneTheme.ColorNamePrimary, color.NRGBA{R: 0xe6, G: 0x61, A: 0xff}),
+//		theme.WithSize(fyneTheme.SizeNameText, 15))
+func Override(base fyne.Theme, opts ...Option) fyne.Theme {
+	o := &override{
+		base:   base,
+		colors: map[overrideColor]color.Color{},
+		sizes:  map[fyne.ThemeSizeName]float32{},
+		fonts:  map[fyne.TextStyle]fyne.Resource{},
+		icons:  map[fyne.ThemeIconName]fyne.Resource{},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithColor replaces a color of every variant.
+func WithColor(name fyne.ThemeColorName, c color.Color) Option {
+	return WithVariantColor(name, anyVariant, c)
+}
+
+// WithVariantColor replaces a color of one variant, over any that WithColor replaces for every variant.
+func WithVariantColor(name fyne.ThemeColorName, variant fyne.ThemeVariant, c color.Color) Option {
+	return func(o *override) {
+		o.colors[overrideColor{name: name, variant: variant}] = c
+	}
+}
+
+// WithSize replaces a size.
+func WithSize(name fyne.ThemeSizeName, size float32) Option {
+	return func(o *override) {
+		o.sizes[name] = size
+	}
+}
+
+// WithFont replaces the font of a text style.
+func WithFont(style fyne.TextStyle, font fyne.Resource) Option {
+	return func(o *override) {
+		o.fonts[style] = font
+	}
+}
+
+// WithIcon replaces an icon.
+func WithIcon(name fyne.ThemeIconName, icon fyne.Resource) Option {
+	return func(o *override) {
+		o.icons[name] = icon
+	}
+}
+
+// Color returns the named color for the current theme.
+func (o *override) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	if c, ok := o.colors[overrideColor{name: name, variant: variant}]; ok {
+		return c
+	}
+	if c, ok := o.colors[overrideColor{name: name, variant: anyVariant}]; ok {
+		return c
+	}
+	return o.baseTheme().Color(name, variant)
+}
+
+// Font returns the named font for the current theme.
+func (o *override) Font(style fyne.TextStyle) fyne.Resource {
+	if f, ok := o.fonts[style]; ok {
+		return f
+	}
+	return o.baseTheme().Font(style)
+}
+
+// Icon returns the named resource for the current theme.
+func (o *override) Icon(name fyne.ThemeIconName) fyne.Resource {
+	if i, ok := o.icons[name]; ok {
+		return i
+	}
+	return o.baseTheme().Icon(name)
+}
+
+// Size returns the size of the named resource for the current theme.
+func (o *override) Size(name fyne.ThemeSizeName) float32 {
+	if s, ok := o.sizes[name]; ok {
+		return s
+	}
+	return o.baseTheme().Size(name)
+}
+
+func (o *override) baseTheme() fyne.Theme {
+	if o.base == nil {
+		return theme.DefaultTheme()
+	}
+	return o.base
+}
@@ -0,0 +1,42 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverride(t *testing.T) {
+	orange := color.NRGBA{R: 0xe6, G: 0x61, A: 0xff}
+	black := color.NRGBA{A: 0xff}
+	th := Override(AdwaitaTheme(),
+		WithColor(theme.ColorNamePrimary, orange),
+		WithVariantColor(theme.ColorNameBackground, theme.VariantDark, black),
+		WithSize(theme.SizeNameText, 15),
+		WithIcon(theme.IconNameHome, theme.ComputerIcon()),
+		WithFont(fyne.TextStyle{Bold: true}, theme.TextItalicFont()))
+
+	assert.Equal(t, orange, th.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, orange, th.Color(theme.ColorNamePrimary, theme.VariantDark))
+	assert.Equal(t, black, th.Color(theme.ColorNameBackground, theme.VariantDark))
+	assert.Equal(t, adwaitaLightScheme[theme.ColorNameBackground], th.Color(theme.ColorNameBackground, theme.VariantLight))
+	assert.Equal(t, float32(15), th.Size(theme.SizeNameText))
+	assert.Equal(t, theme.DefaultTheme().Size(theme.SizeNamePadding), th.Size(theme.SizeNamePadding))
+	assert.Equal(t, theme.ComputerIcon(), th.Icon(theme.IconNameHome))
+	assert.Equal(t, adwaitaIcons.Icon(theme.IconNameCancel), th.Icon(theme.IconNameCancel))
+	assert.Equal(t, theme.TextItalicFont(), th.Font(fyne.TextStyle{Bold: true}))
+	assert.Equal(t, theme.DefaultTheme().Font(fyne.TextStyle{}), th.Font(fyne.TextStyle{}))
+}
+
+func TestOverride_Chained(t *testing.T) {
+	red, blue := color.NRGBA{R: 0xff, A: 0xff}, color.NRGBA{B: 0xff, A: 0xff}
+	first := Override(AdwaitaTheme(), WithColor(theme.ColorNamePrimary, red), WithColor(theme.ColorNameError, red))
+	second := Override(first, WithColor(theme.ColorNamePrimary, blue))
+
+	assert.Equal(t, blue, second.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, red, second.Color(theme.ColorNameError, theme.VariantLight))
+	assert.Equal(t, red, first.Color(theme.ColorNamePrimary, theme.VariantLight))
+}
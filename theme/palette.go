@@ -0,0 +1,200 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+var _ fyne.Theme = (*paletteTheme)(nil)
+
+// Palette is the handful of colors that a color scheme, such as those of text editors and terminals,
+// defines. FromPalette derives the other colors of a theme from them.
+type Palette struct {
+	Name string
+
+	Background color.Color
+	Surface    color.Color // for buttons, inputs, menus and headers
+	Foreground color.Color
+	Muted      color.Color // for placeholders and disabled text
+	Primary    color.Color
+
+	Error   color.Color
+	Success color.Color
+	Warning color.Color
+}
+
+var (
+	// PaletteNord is the dark Polar Night palette of Nord.
+	// See: https://www.nordtheme.com/docs/colors-and-palettes
+	PaletteNord = Palette{
+		Name:       "Nord",
+		Background: color.NRGBA{R: 0x2e, G: 0x34, B: 0x40, A: 0xff},
+		Surface:    color.NRGBA{R: 0x3b, G: 0x42, B: 0x52, A: 0xff},
+		Foreground: color.NRGBA{R: 0xec, G: 0xef, B: 0xf4, A: 0xff},
+		Muted:      color.NRGBA{R: 0x61, G: 0x6e, B: 0x88, A: 0xff},
+		Primary:    color.NRGBA{R: 0x88, G: 0xc0, B: 0xd0, A: 0xff},
+		Error:      color.NRGBA{R: 0xbf, G: 0x61, B: 0x6a, A: 0xff},
+		Success:    color.NRGBA{R: 0xa3, G: 0xbe, B: 0x8c, A: 0xff},
+		Warning:    color.NRGBA{R: 0xeb, G: 0xcb, B: 0x8b, A: 0xff},
+	}
+
+	// PaletteDracula is the palette of Dracula.
+	// See: https://draculatheme.com/contribute
+	PaletteDracula = Palette{
+		Name:       "Dracula",
+		Background: color.NRGBA{R: 0x28, G: 0x2a, B: 0x36, A: 0xff},
+		Surface:    color.NRGBA{R: 0x44, G: 0x47, B: 0x5a, A: 0xff},
+		Foreground: color.NRGBA{R: 0xf8, G: 0xf8, B: 0xf2, A: 0xff},
+		Muted:      color.NRGBA{R: 0x62, G: 0x72, B: 0xa4, A: 0xff},
+		Primary:    color.NRGBA{R: 0xbd, G: 0x93, B: 0xf9, A: 0xff},
+		Error:      color.NRGBA{R: 0xff, G: 0x55, B: 0x55, A: 0xff},
+		Success:    color.NRGBA{R: 0x50, G: 0xfa, B: 0x7b, A: 0xff},
+		Warning:    color.NRGBA{R: 0xff, G: 0xb8, B: 0x6c, A: 0xff},
+	}
+
+	// PaletteSolarizedDark is the dark palette of Solarized.
+	// See: https://ethanschoonover.com/solarized/
+	PaletteSolarizedDark = Palette{
+		Name:       "Solarized Dark",
+		Background: color.NRGBA{R: 0x00, G: 0x2b, B: 0x36, A: 0xff},
+		Surface:    color.NRGBA{R: 0x07, G: 0x36, B: 0x42, A: 0xff},
+		Foreground: color.NRGBA{R: 0x93, G: 0xa1, B: 0xa1, A: 0xff},
+		Muted:      color.NRGBA{R: 0x58, G: 0x6e, B: 0x75, A: 0xff},
+		Primary:    color.NRGBA{R: 0x26, G: 0x8b, B: 0xd2, A: 0xff},
+		Error:      color.NRGBA{R: 0xdc, G: 0x32, B: 0x2f, A: 0xff},
+		Success:    color.NRGBA{R: 0x85, G: 0x99, B: 0x00, A: 0xff},
+		Warning:    color.NRGBA{R: 0xb5, G: 0x89, B: 0x00, A: 0xff},
+	}
+
+	// PaletteSolarizedLight is the light palette of Solarized.
+	// See: https://ethanschoonover.com/solarized/
+	PaletteSolarizedLight = Palette{
+		Name:       "Solarized Light",
+		Background: color.NRGBA{R: 0xfd, G: 0xf6, B: 0xe3, A: 0xff},
+		Surface:    color.NRGBA{R: 0xee, G: 0xe8, B: 0xd5, A: 0xff},
+		Foreground: color.NRGBA{R: 0x58, G: 0x6e, B: 0x75, A: 0xff},
+		Muted:      color.NRGBA{R: 0x93, G: 0xa1, B: 0xa1, A: 0xff},
+		Primary:    color.NRGBA{R: 0x26, G: 0x8b, B: 0xd2, A: 0xff},
+		Error:      color.NRGBA{R: 0xdc, G: 0x32, B: 0x2f, A: 0xff},
+		Success:    color.NRGBA{R: 0x85, G: 0x99, B: 0x00, A: 0xff},
+		Warning:    color.NRGBA{R: 0xb5, G: 0x89, B: 0x00, A: 0xff},
+	}
+
+	// PaletteGruvboxDark is the dark palette of Gruvbox.
+	// See: https://github.com/morhetz/gruvbox
+	PaletteGruvboxDark = Palette{
+		Name:       "Gruvbox Dark",
+		Background: color.NRGBA{R: 0x28, G: 0x28, B: 0x28, A: 0xff},
+		Surface:    color.NRGBA{R: 0x3c, G: 0x38, B: 0x36, A: 0xff},
+		Foreground: color.NRGBA{R: 0xeb, G: 0xdb, B: 0xb2, A: 0xff},
+		Muted:      color.NRGBA{R: 0x92, G: 0x83, B: 0x74, A: 0xff},
+		Primary:    color.NRGBA{R: 0x83, G: 0xa5, B: 0x98, A: 0xff},
+		Error:      color.NRGBA{R: 0xfb, G: 0x49, B: 0x34, A: 0xff},
+		Success:    color.NRGBA{R: 0xb8, G: 0xbb, B: 0x26, A: 0xff},
+		Warning:    color.NRGBA{R: 0xfa, G: 0xbd, B: 0x2f, A: 0xff},
+	}
+
+	// PaletteGruvboxLight is the light palette of Gruvbox.
+	// See: https://github.com/morhetz/gruvbox
+	PaletteGruvboxLight = Palette{
+		Name:       "Gruvbox Light",
+		Background: color.NRGBA{R: 0xfb, G: 0xf1, B: 0xc7, A: 0xff},
+		Surface:    color.NRGBA{R: 0xeb, G: 0xdb, B: 0xb2, A: 0xff},
+		Foreground: color.NRGBA{R: 0x3c, G: 0x38, B: 0x36, A: 0xff},
+		Muted:      color.NRGBA{R: 0x92, G: 0x83, B: 0x74, A: 0xff},
+		Primary:    color.NRGBA{R: 0x07, G: 0x66, B: 0x78, A: 0xff},
+		Error:      color.NRGBA{R: 0x9d, G: 0x00, B: 0x06, A: 0xff},
+		Success:    color.NRGBA{R: 0x79, G: 0x74, B: 0x0e, A: 0xff},
+		Warning:    color.NRGBA{R: 0xb5, G: 0x76, B: 0x14, A: 0xff},
+	}
+)
+
+// Palettes are the bundled palettes, in the order to offer them in a theme picker.
+var Palettes = []Palette{
+	PaletteNord, PaletteDracula, PaletteSolarizedDark, PaletteSolarizedLight, PaletteGruvboxDark, PaletteGruvboxLight,
+}
+
+type paletteTheme struct {
+	colors map[fyne.ThemeColorName]color.Color
+}
+
+// FromPalette returns a new theme with the colors of a palette, which it uses whatever the variant
+// of the app settings.
+func FromPalette(p Palette) fyne.Theme {
+	return &paletteTheme{colors: map[fyne.ThemeColorName]color.Color{
+		theme.ColorNameBackground:          p.Background,
+		theme.ColorNameButton:              p.Surface,
+		theme.ColorNameDisabledButton:      paletteMix(p.Background, p.Surface, .5),
+		theme.ColorNameDisabled:            p.Muted,
+		theme.ColorNameError:               p.Error,
+		theme.ColorNameFocus:               paletteAlpha(p.Primary, 0x7f),
+		theme.ColorNameForeground:          p.Foreground,
+		theme.ColorNameForegroundOnError:   p.Background,
+		theme.ColorNameForegroundOnPrimary: p.Background,
+		theme.ColorNameForegroundOnSuccess: p.Background,
+		theme.ColorNameForegroundOnWarning: p.Background,
+		theme.ColorNameHeaderBackground:    p.Surface,
+		theme.ColorNameHover:               paletteAlpha(p.Foreground, 0x0f),
+		theme.ColorNameHyperlink:           p.Primary,
+		theme.ColorNameInputBackground:     p.Surface,
+		theme.ColorNameInputBorder:         paletteMix(p.Surface, p.Foreground, .3),
+		theme.ColorNameMenuBackground:      p.Surface,
+		theme.ColorNameOverlayBackground:   p.Surface,
+		theme.ColorNamePlaceHolder:         p.Muted,
+		theme.ColorNamePressed:             paletteAlpha(p.Foreground, 0x1f),
+		theme.ColorNamePrimary:             p.Primary,
+		theme.ColorNameScrollBar:           paletteAlpha(p.Foreground, 0x66),
+		theme.ColorNameSelection:           paletteAlpha(p.Primary, 0x40),
+		theme.ColorNameSeparator:           paletteMix(p.Background, p.Foreground, .15),
+		theme.ColorNameShadow:              color.NRGBA{A: 0x66},
+		theme.ColorNameSuccess:             p.Success,
+		theme.ColorNameWarning:             p.Warning,
+	}}
+}
+
+// Color returns the named color for the current theme.
+func (t *paletteTheme) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	if c, ok := t.colors[name]; ok && c != nil {
+		return c
+	}
+	return theme.DefaultTheme().Color(name, variant)
+}
+
+// Font returns the named font for the current theme.
+func (t *paletteTheme) Font(style fyne.TextStyle) fyne.Resource {
+	return theme.DefaultTheme().Font(style)
+}
+
+// Icon returns the named resource for the current theme.
+func (t *paletteTheme) Icon(name fyne.ThemeIconName) fyne.Resource {
+	return theme.DefaultTheme().Icon(name)
+}
+
+// Size returns the size of the named resource for the current theme.
+func (t *paletteTheme) Size(name fyne.ThemeSizeName) float32 {
+	return theme.DefaultTheme().Size(name)
+}
+
+// paletteAlpha returns c with an opacity, or nil if c is not set.
+func paletteAlpha(c color.Color, alpha uint8) color.Color {
+	if c == nil {
+		return nil
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	n.A = alpha
+	return n
+}
+
+// paletteMix returns a mixed with b by a fraction, with 0 being a and 1 being b, or nil if either is not set.
+func paletteMix(a, b color.Color, f float64) color.Color {
+	if a == nil || b == nil {
+		return nil
+	}
+	x, y := color.NRGBAModel.Convert(a).(color.NRGBA), color.NRGBAModel.Convert(b).(color.NRGBA)
+	channel := func(p, q uint8) uint8 {
+		return uint8(float64(p) + (float64(q)-float64(p))*f + .5)
+	}
+	return color.NRGBA{R: channel(x.R, y.R), G: channel(x.G, y.G), B: channel(x.B, y.B), A: channel(x.A, y.A)}
+}
@@ -0,0 +1,35 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromPalette(t *testing.T) {
+	th := FromPalette(PaletteNord)
+	for _, variant := range []fyne.ThemeVariant{theme.VariantLight, theme.VariantDark} {
+		assert.Equal(t, PaletteNord.Background, th.Color(theme.ColorNameBackground, variant))
+		assert.Equal(t, PaletteNord.Primary, th.Color(theme.ColorNamePrimary, variant))
+	}
+	assert.Equal(t, color.NRGBA{R: 0x88, G: 0xc0, B: 0xd0, A: 0x40}, th.Color(theme.ColorNameSelection, theme.VariantDark))
+	assert.Equal(t, color.NRGBA{R: 0x35, G: 0x3b, B: 0x49, A: 0xff}, th.Color(theme.ColorNameDisabledButton, theme.VariantDark))
+}
+
+func TestFromPalette_Partial(t *testing.T) {
+	th := FromPalette(Palette{Primary: color.NRGBA{R: 0xff, A: 0xff}})
+	assert.Equal(t, color.NRGBA{R: 0xff, A: 0xff}, th.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, theme.DefaultTheme().Color(theme.ColorNameBackground, theme.VariantLight), th.Color(theme.ColorNameBackground, theme.VariantLight))
+	assert.Equal(t, theme.DefaultTheme().Color(theme.ColorNameSeparator, theme.VariantDark), th.Color(theme.ColorNameSeparator, theme.VariantDark))
+}
+
+func TestPalettes(t *testing.T) {
+	for _, p := range Palettes {
+		assert.NotEmpty(t, p.Name)
+		assert.NotNil(t, p.Background, p.Name)
+		assert.NotNil(t, p.Foreground, p.Name)
+	}
+}
@@ -0,0 +1,123 @@
+// Package themegen creates Fyne themes from the colors of GTK 4 and libadwaita stylesheets, at runtime,
+// so that apps match the theme that a user has tweaked or that their distribution ships.
+package themegen
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+
+	xtheme "fyne.io/x/fyne/theme"
+	"fyne.io/x/fyne/theme/internal/gtkcss"
+)
+
+// ErrNoColors is returned for a stylesheet that defines no colors.
+var ErrNoColors = gtkcss.ErrNoColors
+
+// Colors are the named colors of a stylesheet. The names are those of @define-color, such as
+// "window_bg_color", with the dashes of CSS variables, such as "--window-bg-color", written as underscores.
+type Colors map[string]color.Color
+
+// Mapping gives the name of the stylesheet color for each theme color.
+type Mapping map[fyne.ThemeColorName]string
+
+// AdwaitaMapping is the mapping of the libadwaita named colors, as used by the Adwaita theme.
+// See: https://gnome.pages.gitlab.gnome.org/libadwaita/doc/main/named-colors.html
+var AdwaitaMapping = Mapping(gtkcss.AdwaitaMapping)
+
+// ParseCSS returns the colors that a stylesheet defines, with @define-color or as CSS variables.
+// Those defined within a block for the dark color scheme, "@media (prefers-color-scheme: dark)", as in
+// libadwaita 1.6 and later, are returned as dark, over the other colors; dark is nil if there are none.
+// Colors may refer to others and use the color functions of GTK, such as alpha(), shade() and mix(),
+// and colors whose values can't be evaluated are left out.
+func ParseCSS(css []byte) (light, dark Colors, err error) {
+	l, d, err := gtkcss.Parse(css)
+	if err != nil {
+		return nil, nil, err
+	}
+	if d != nil {
+		dark = Colors(d)
+	}
+	return Colors(l), dark, nil
+}
+
+// ParseColor returns the color of a CSS color value, such as "#3584e4", "rgba(0, 0, 0, 0.5)" or "white".
+// Values that refer to other colors, like "@accent_color" or "var(--accent-color)", need a stylesheet
+// and are parsed by ParseCSS.
+func ParseColor(value string) (color.Color, error) {
+	return gtkcss.ParseColor(value)
+}
+
+// LoadCSS returns the colors of the stylesheet at path, as ParseCSS, such as ~/.config/gtk-4.0/gtk.css.
+// Stylesheets that it imports from files are read as well.
+func LoadCSS(path string) (light, dark Colors, err error) {
+	css, err := gtkcss.Load(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ParseCSS(css)
+}
+
+// Scheme returns the theme colors of the stylesheet colors given by a mapping, leaving out those
+// that the stylesheet doesn't define.
+func (c Colors) Scheme(m Mapping) map[fyne.ThemeColorName]color.Color {
+	scheme := make(map[fyne.ThemeColorName]color.Color, len(m))
+	for name, css := range m {
+		if col, ok := c[gtkcss.NormalizeName(css)]; ok {
+			scheme[name] = col
+		}
+	}
+	return scheme
+}
+
+// Theme is a theme whose colors come from stylesheets, and whose other colors, fonts, icons and sizes
+// come from a base theme.
+type Theme struct {
+	Light, Dark map[fyne.ThemeColorName]color.Color
+	// Base is the theme used for anything else, the Adwaita theme if nil.
+	Base fyne.Theme
+}
+
+// NewTheme returns a theme with the colors of the light and dark stylesheets, mapped by AdwaitaMapping.
+// If dark is nil the light colors are used for both variants.
+func NewTheme(light, dark Colors) *Theme {
+	if dark == nil {
+		dark = light
+	}
+	return &Theme{Light: light.Scheme(AdwaitaMapping), Dark: dark.Scheme(AdwaitaMapping)}
+}
+
+// Color returns the named color for the current theme.
+func (t *Theme) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	scheme := t.Light
+	if variant == theme.VariantDark {
+		scheme = t.Dark
+	}
+	if c, ok := scheme[name]; ok {
+		return c
+	}
+	return t.base().Color(name, variant)
+}
+
+// Font returns the named font for the current theme.
+func (t *Theme) Font(style fyne.TextStyle) fyne.Resource {
+	return t.base().Font(style)
+}
+
+// Icon returns the named resource for the current theme.
+func (t *Theme) Icon(name fyne.ThemeIconName) fyne.Resource {
+	return t.base().Icon(name)
+}
+
+// Size returns the size of the named resource for the current theme.
+func (t *Theme) Size(name fyne.ThemeSizeName) float32 {
+	return t.base().Size(name)
+}
+
+func (t *Theme) base() fyne.Theme {
+	if t.Base == nil {
+		return xtheme.AdwaitaTheme()
+	}
+	return t.Base
+}
@@ -0,0 +1,84 @@
+package themegen
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCSS = `
+/* a tweaked Adwaita */
+@define-color accent_bg_color #e66100;
+@define-color accent_color @accent_bg_color;
+@define-color window_bg_color rgb(250, 250, 250);
+@define-color window_fg_color alpha(black, 0.8);
+@define-color headerbar_bg_color shade(@window_bg_color, 0.9);
+@define-color view_bg_color mix(@window_bg_color, white, 0.5);
+@define-color broken_color gtkmix(red);
+
+:root {
+	--popover-bg-color: var(--view-bg-color);
+	--border-opacity: 15%;
+}
+
+@media (prefers-color-scheme: dark) {
+	:root {
+		--window-bg-color: #242424;
+		--window-fg-color: #fff;
+	}
+}
+`
+
+func TestParseCSS(t *testing.T) {
+	light, dark, err := ParseCSS([]byte(testCSS))
+	assert.NoError(t, err)
+
+	assert.Equal(t, color.NRGBA{R: 0xe6, G: 0x61, A: 0xff}, light["accent_color"])
+	assert.Equal(t, color.NRGBA{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff}, light["window_bg_color"])
+	assert.Equal(t, color.NRGBA{A: 0xcc}, light["window_fg_color"])
+	assert.Equal(t, color.NRGBA{R: 0xe1, G: 0xe1, B: 0xe1, A: 0xff}, light["headerbar_bg_color"])
+	assert.Equal(t, color.NRGBA{R: 0xfd, G: 0xfd, B: 0xfd, A: 0xff}, light["view_bg_color"])
+	assert.Equal(t, light["view_bg_color"], light["popover_bg_color"])
+	assert.NotContains(t, light, "broken_color")
+	assert.NotContains(t, light, "border_opacity")
+
+	assert.Equal(t, color.NRGBA{R: 0x24, G: 0x24, B: 0x24, A: 0xff}, dark["window_bg_color"])
+	assert.Equal(t, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, dark["window_fg_color"])
+	assert.Equal(t, light["accent_color"], dark["accent_color"])
+	assert.Equal(t, color.NRGBA{R: 0x20, G: 0x20, B: 0x20, A: 0xff}, dark["headerbar_bg_color"])
+
+	_, _, err = ParseCSS([]byte("button { color: red; }"))
+	assert.Equal(t, ErrNoColors, err)
+}
+
+func TestParseColor(t *testing.T) {
+	for value, expected := range map[string]color.NRGBA{
+		"#3584e4":                           {R: 0x35, G: 0x84, B: 0xe4, A: 0xff},
+		"#fff8":                             {R: 0xff, G: 0xff, B: 0xff, A: 0x88},
+		"rgba(0, 0, 6, 0.5)":                {B: 6, A: 0x80},
+		"rgb(100% 0% 0% / 50%)":             {R: 0xff, A: 0x80},
+		"color-mix(in srgb, red 25%, blue)": {R: 0x40, B: 0xbf, A: 0xff},
+		"white":                             {R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	} {
+		c, err := ParseColor(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, c, value)
+	}
+
+	_, err := ParseColor("@accent_color")
+	assert.Error(t, err)
+	_, err = ParseColor("#12345")
+	assert.Error(t, err)
+}
+
+func TestNewTheme(t *testing.T) {
+	light, dark, _ := ParseCSS([]byte(testCSS))
+	th := NewTheme(light, dark)
+	assert.Equal(t, color.NRGBA{R: 0xe6, G: 0x61, A: 0xff}, th.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, color.NRGBA{R: 0x24, G: 0x24, B: 0x24, A: 0xff}, th.Color(theme.ColorNameBackground, theme.VariantDark))
+	// not in the stylesheet, so from the Adwaita theme
+	assert.NotNil(t, th.Color(theme.ColorNameSuccess, theme.VariantLight))
+	assert.Equal(t, theme.DefaultTheme().Size(theme.SizeNamePadding), th.Size(theme.SizeNamePadding))
+}
@@ -0,0 +1,32 @@
+// Package themetest provides helpers for testing themes, such as the custom themes of apps.
+package themetest
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+
+	xtheme "fyne.io/x/fyne/theme"
+)
+
+// AssertContrast checks that the colors of the light and dark variants of a theme meet the contrast ratios
+// of WCAG AA, failing the test with each pair of colors that doesn't. It returns whether all of them do.
+func AssertContrast(t testing.TB, th fyne.Theme) bool {
+	t.Helper()
+	ok := true
+	for _, variant := range []fyne.ThemeVariant{theme.VariantLight, theme.VariantDark} {
+		for _, issue := range xtheme.CheckContrast(th, variant) {
+			t.Errorf("%s variant: %s", variantName(variant), issue)
+			ok = false
+		}
+	}
+	return ok
+}
+
+func variantName(variant fyne.ThemeVariant) string {
+	if variant == theme.VariantDark {
+		return "dark"
+	}
+	return "light"
+}
@@ -0,0 +1,38 @@
+package themetest
+
+import (
+	"fmt"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+
+	xtheme "fyne.io/x/fyne/theme"
+)
+
+// recorder is a test that records its errors instead of failing.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Helper() {
+}
+
+func TestAssertContrast(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	assert.True(t, AssertContrast(t, xtheme.HighContrast()))
+
+	low := xtheme.PaletteNord
+	low.Foreground = low.Surface
+	r := &recorder{}
+	assert.False(t, AssertContrast(r, xtheme.FromPalette(low)))
+	assert.Contains(t, r.errors, "light variant: foreground on background: 1.24, needs 4.5")
+	assert.Contains(t, r.errors, "dark variant: foreground on background: 1.24, needs 4.5")
+}
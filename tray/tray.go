@@ -0,0 +1,208 @@
+// Package tray builds the system tray menu of an app from items that can change while it runs.
+package tray // import "fyne.io/x/fyne/tray"
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+)
+
+// Item is an entry of a tray menu. Changing it with its Set methods updates the menu.
+type Item struct {
+	Label    string
+	Icon     fyne.Resource
+	Disabled bool
+	// Checkable items toggle Checked and call OnChecked when tapped, rather than calling Action.
+	Checkable bool
+	Checked   bool
+	// Items are shown as a submenu of this item.
+	Items []*Item
+
+	Action    func()             `json:"-"`
+	OnChecked func(checked bool) `json:"-"`
+
+	separator bool
+	tray      *Tray
+}
+
+// NewItem creates an item that calls action when tapped.
+func NewItem(label string, action func()) *Item {
+	return &Item{Label: label, Action: action}
+}
+
+// NewCheckItem creates an item that toggles a check mark when tapped, calling changed with its new state.
+func NewCheckItem(label string, checked bool, changed func(bool)) *Item {
+	return &Item{Label: label, Checkable: true, Checked: checked, OnChecked: changed}
+}
+
+// NewSeparator creates a line between groups of items.
+func NewSeparator() *Item {
+	return &Item{separator: true}
+}
+
+// NewSubmenu creates an item that opens a submenu of items.
+func NewSubmenu(label string, items ...*Item) *Item {
+	return &Item{Label: label, Items: items}
+}
+
+// SetChecked checks or unchecks the item, without calling OnChecked.
+func (i *Item) SetChecked(checked bool) {
+	i.update(func() { i.Checked = checked })
+}
+
+// SetDisabled disables or enables the item.
+func (i *Item) SetDisabled(disabled bool) {
+	i.update(func() { i.Disabled = disabled })
+}
+
+// SetIcon changes the icon shown beside the label.
+func (i *Item) SetIcon(icon fyne.Resource) {
+	i.update(func() { i.Icon = icon })
+}
+
+// SetItems replaces the items of the submenu.
+func (i *Item) SetItems(items ...*Item) {
+	i.update(func() { i.Items = items })
+}
+
+// SetLabel changes the text of the item, such as to show a live status.
+func (i *Item) SetLabel(label string) {
+	i.update(func() { i.Label = label })
+}
+
+func (i *Item) tapped() {
+	if !i.Checkable {
+		if i.Action != nil {
+			i.Action()
+		}
+		return
+	}
+
+	checked := false
+	i.update(func() {
+		i.Checked = !i.Checked
+		checked = i.Checked
+	})
+	if i.OnChecked != nil {
+		i.OnChecked(checked)
+	}
+}
+
+func (i *Item) update(change func()) {
+	t := i.tray
+	if t == nil {
+		change()
+		return
+	}
+	t.lock.Lock()
+	change()
+	t.lock.Unlock()
+	t.Refresh()
+}
+
+// Tray is the system tray icon and menu of an app.
+// Clicking the icon opens the menu on all platforms, as Fyne's tray support has no separate click events.
+type Tray struct {
+	app    desktop.App
+	lock   sync.Mutex
+	items  []*Item
+	icons  map[string]fyne.Resource
+	icon   fyne.Resource
+	status string
+}
+
+// NewTray creates the tray menu of a desktop app, which is the fyne.App on desktop platforms:
+//
+//	if desk, ok := a.(desktop.App); ok {
+//		t := tray.NewTray(desk, tray.NewItem("Show", w.Show))
+//	}
+func NewTray(app desktop.App, items ...*Item) *Tray {
+	t := &Tray{app: app, icons: make(map[string]fyne.Resource)}
+	t.SetItems(items...)
+	return t
+}
+
+// AddStatusIcon adds an icon to show while the app is in a status, such as "syncing" or "offline".
+func (t *Tray) AddStatusIcon(status string, icon fyne.Resource) {
+	t.lock.Lock()
+	t.icons[status] = icon
+	current := t.status == status
+	t.lock.Unlock()
+	if current {
+		t.SetStatus(status)
+	}
+}
+
+// Items returns the items of the menu.
+func (t *Tray) Items() []*Item {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.items
+}
+
+// Refresh updates the tray menu, after items have been changed directly rather than with their Set methods.
+func (t *Tray) Refresh() {
+	t.lock.Lock()
+	menu := fyne.NewMenu("", t.menuItems(t.items)...)
+	t.lock.Unlock()
+	t.app.SetSystemTrayMenu(menu)
+}
+
+// SetIcon shows an icon in the tray, replacing any status icon.
+func (t *Tray) SetIcon(icon fyne.Resource) {
+	t.lock.Lock()
+	t.icon, t.status = icon, ""
+	t.lock.Unlock()
+	t.app.SetSystemTrayIcon(icon)
+}
+
+// SetItems replaces the items of the menu.
+func (t *Tray) SetItems(items ...*Item) {
+	t.lock.Lock()
+	t.items = items
+	t.lock.Unlock()
+	t.Refresh()
+}
+
+// SetStatus shows the icon added for a status, or the icon set with SetIcon for an unknown status.
+func (t *Tray) SetStatus(status string) {
+	t.lock.Lock()
+	t.status = status
+	icon, ok := t.icons[status]
+	if !ok {
+		icon = t.icon
+	}
+	t.lock.Unlock()
+	if icon != nil {
+		t.app.SetSystemTrayIcon(icon)
+	}
+}
+
+// Status returns the status set with SetStatus.
+func (t *Tray) Status() string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.status
+}
+
+// menuItems converts items to the menu items of the tray, the lock must be held.
+func (t *Tray) menuItems(items []*Item) []*fyne.MenuItem {
+	ret := make([]*fyne.MenuItem, len(items))
+	for n, i := range items {
+		i.tray = t
+		if i.separator {
+			ret[n] = fyne.NewMenuItemSeparator()
+			continue
+		}
+		item := fyne.NewMenuItem(i.Label, i.tapped)
+		item.Icon = i.Icon
+		item.Disabled = i.Disabled
+		item.Checked = i.Checkable && i.Checked
+		if len(i.Items) > 0 {
+			item.ChildMenu = fyne.NewMenu("", t.menuItems(i.Items)...)
+		}
+		ret[n] = item
+	}
+	return ret
+}
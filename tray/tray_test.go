@@ -0,0 +1,82 @@
+package tray
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDesktopApp struct {
+	menu *fyne.Menu
+	icon fyne.Resource
+}
+
+func (a *testDesktopApp) SetSystemTrayMenu(menu *fyne.Menu) {
+	a.menu = menu
+}
+
+func (a *testDesktopApp) SetSystemTrayIcon(icon fyne.Resource) {
+	a.icon = icon
+}
+
+func TestTray_Menu(t *testing.T) {
+	a := &testDesktopApp{}
+	shown := false
+	notify := NewCheckItem("Notifications", true, nil)
+	status := NewItem("Idle", nil)
+	status.Disabled = true
+	NewTray(a,
+		status,
+		NewSeparator(),
+		NewItem("Show", func() { shown = true }),
+		NewSubmenu("Options", notify),
+	)
+
+	items := a.menu.Items
+	assert.Len(t, items, 4)
+	assert.Equal(t, "Idle", items[0].Label)
+	assert.True(t, items[0].Disabled)
+	assert.True(t, items[1].IsSeparator)
+	items[2].Action()
+	assert.True(t, shown)
+	assert.True(t, items[3].ChildMenu.Items[0].Checked)
+
+	status.SetLabel("Syncing 3 files")
+	assert.Equal(t, "Syncing 3 files", a.menu.Items[0].Label)
+}
+
+func TestTray_CheckItem(t *testing.T) {
+	a := &testDesktopApp{}
+	var changes []bool
+	dark := NewCheckItem("Dark mode", false, func(on bool) {
+		changes = append(changes, on)
+	})
+	NewTray(a, dark)
+	assert.False(t, a.menu.Items[0].Checked)
+
+	a.menu.Items[0].Action()
+	assert.True(t, dark.Checked)
+	assert.True(t, a.menu.Items[0].Checked)
+	a.menu.Items[0].Action()
+	assert.Equal(t, []bool{true, false}, changes)
+
+	dark.SetChecked(true)
+	assert.True(t, a.menu.Items[0].Checked)
+	assert.Len(t, changes, 2)
+}
+
+func TestTray_Status(t *testing.T) {
+	a := &testDesktopApp{}
+	tr := NewTray(a)
+	tr.SetIcon(theme.FyneLogo())
+	tr.AddStatusIcon("offline", theme.ErrorIcon())
+	assert.Equal(t, theme.FyneLogo(), a.icon)
+
+	tr.SetStatus("offline")
+	assert.Equal(t, "offline", tr.Status())
+	assert.Equal(t, theme.ErrorIcon(), a.icon)
+	tr.SetStatus("online")
+	assert.Equal(t, theme.FyneLogo(), a.icon)
+}
@@ -0,0 +1,231 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"strconv"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"golang.org/x/image/vector"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*AnalogClock)(nil)
+
+// AnalogClock is a clock face with hour, minute and second hands, drawn in the colours of the theme.
+// It runs while it is shown.
+type AnalogClock struct {
+	widget.BaseWidget
+
+	// Location is the time zone shown. The local time zone is used if nil.
+	Location *time.Location
+	// Smooth sweeps the second hand instead of ticking it once a second.
+	Smooth      bool
+	HideSeconds bool
+	// Alarms are times of day, as durations since midnight, marked around the edge of the face.
+	Alarms []time.Duration
+
+	now func() time.Time
+}
+
+// NewAnalogClock returns a clock showing the local time.
+func NewAnalogClock() *AnalogClock {
+	c := &AnalogClock{now: time.Now}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (c *AnalogClock) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+	r := &analogClockRenderer{clock: c}
+	r.raster = canvas.NewRaster(r.draw)
+	for i := 1; i <= 12; i++ {
+		r.numerals = append(r.numerals, canvas.NewText(strconv.Itoa(i), color.Transparent))
+	}
+	r.Refresh()
+	r.start()
+	return r
+}
+
+// SetLocation changes the time zone shown.
+func (c *AnalogClock) SetLocation(loc *time.Location) {
+	c.Location = loc
+	c.Refresh()
+}
+
+// Time returns the current time in the time zone of the clock.
+func (c *AnalogClock) Time() time.Time {
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+	loc := c.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	return now().In(loc)
+}
+
+type analogClockRenderer struct {
+	clock    *AnalogClock
+	raster   *canvas.Raster
+	numerals []*canvas.Text
+
+	shown time.Time
+	tick  *fyne.Animation
+}
+
+func (r *analogClockRenderer) Destroy() {
+	if r.tick != nil {
+		r.tick.Stop()
+		r.tick = nil
+	}
+}
+
+func (r *analogClockRenderer) Layout(size fyne.Size) {
+	r.raster.Resize(size)
+	center, radius := r.geometry(size)
+	for i, t := range r.numerals {
+		t.Resize(t.MinSize())
+		x, y := compassPoint(center, radius*.76, float64(i+1)*30)
+		t.Move(fyne.NewPos(x-t.Size().Width/2, y-t.Size().Height/2))
+	}
+}
+
+func (r *analogClockRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(theme.IconInlineSize() * 6)
+}
+
+func (r *analogClockRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.raster}
+	for _, t := range r.numerals {
+		objects = append(objects, t)
+	}
+	return objects
+}
+
+func (r *analogClockRenderer) Refresh() {
+	r.shown = r.clock.Time()
+	for _, t := range r.numerals {
+		t.TextSize = theme.CaptionTextSize()
+		t.Color = theme.Color(theme.ColorNameForeground)
+	}
+	r.Layout(r.clock.Size())
+	canvas.Refresh(r.clock)
+}
+
+func (r *analogClockRenderer) draw(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	c := r.clock
+	size := c.Size()
+	if size.Width <= 0 {
+		return img
+	}
+	scale := float32(w) / size.Width
+	center, radius := r.geometry(size)
+	center, radius = fyne.NewPos(center.X*scale, center.Y*scale), radius*scale
+
+	bounds := img.Bounds()
+	fill := func(col color.Color, add func(*vector.Rasterizer)) {
+		v := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+		add(v)
+		v.Draw(img, bounds, image.NewUniform(col), image.Point{})
+	}
+	point := func(radius float32, degrees float64) fyne.Position {
+		x, y := compassPoint(center, radius, degrees)
+		return fyne.NewPos(x, y)
+	}
+	hand := func(col color.Color, degrees float64, length, width float32) {
+		fill(col, func(v *vector.Rasterizer) {
+			addSegment(v, point(-radius*.12, degrees), width/2, point(length, degrees), width/2)
+		})
+	}
+
+	fill(theme.Color(theme.ColorNameInputBorder), func(v *vector.Rasterizer) {
+		addCircle(v, center, radius)
+	})
+	fill(theme.Color(theme.ColorNameInputBackground), func(v *vector.Rasterizer) {
+		addCircle(v, center, radius-theme.InputBorderSize()*scale)
+	})
+	fill(theme.Color(theme.ColorNameForeground), func(v *vector.Rasterizer) {
+		for m := 0; m < 60; m++ {
+			length, width := radius*.05, scale
+			if m%5 == 0 {
+				length, width = radius*.1, scale*2
+			}
+			addSegment(v, point(radius*.95-length, float64(m*6)), width/2, point(radius*.95, float64(m*6)), width/2)
+		}
+	})
+	if len(c.Alarms) > 0 {
+		fill(theme.Color(theme.ColorNameWarning), func(v *vector.Rasterizer) {
+			for _, alarm := range c.Alarms {
+				a := analogClockHourAngle(alarm)
+				tip, left, right := point(radius*.84, a), point(radius*.98, a-5), point(radius*.98, a+5)
+				v.MoveTo(tip.X, tip.Y)
+				v.LineTo(left.X, left.Y)
+				v.LineTo(right.X, right.Y)
+				v.ClosePath()
+			}
+		})
+	}
+
+	hour, minute, second := analogClockAngles(r.shown, c.Smooth)
+	fg := theme.Color(theme.ColorNameForeground)
+	hand(fg, hour, radius*.5, scale*5)
+	hand(fg, minute, radius*.75, scale*3)
+	accent := theme.Color(theme.ColorNamePrimary)
+	if !c.HideSeconds {
+		hand(accent, second, radius*.85, scale*1.5)
+	}
+	fill(accent, func(v *vector.Rasterizer) {
+		addCircle(v, center, scale*4)
+	})
+	return img
+}
+
+// geometry returns the centre and radius of the face of a clock of a size.
+func (r *analogClockRenderer) geometry(size fyne.Size) (fyne.Position, float32) {
+	radius := fyne.Min(size.Width, size.Height)/2 - theme.Padding()
+	return fyne.NewPos(size.Width/2, size.Height/2), radius
+}
+
+// start redraws the hands as time passes, each frame if smooth or else when the second changes.
+func (r *analogClockRenderer) start() {
+	r.tick = fyne.NewAnimation(time.Second, func(float32) {
+		c := r.clock
+		now := c.Time()
+		if !c.Smooth && now.Unix() == r.shown.Unix() && now.Location() == r.shown.Location() {
+			return
+		}
+		r.shown = now
+		r.raster.Refresh()
+	})
+	r.tick.Curve = fyne.AnimationLinear
+	r.tick.RepeatCount = fyne.AnimationRepeatForever
+	r.tick.Start()
+}
+
+// analogClockAngles returns the angles of the hands of a clock at a time, in degrees clockwise from 12.
+// The second hand moves between seconds only if smooth.
+func analogClockAngles(t time.Time, smooth bool) (hour, minute, second float64) {
+	since := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+	seconds := float64(t.Second())
+	if smooth {
+		since += time.Duration(t.Nanosecond())
+		seconds += float64(t.Nanosecond()) / float64(time.Second)
+	}
+	return analogClockHourAngle(since), float64(since%time.Hour) / float64(time.Hour) * 360, seconds * 6
+}
+
+// analogClockHourAngle returns the angle of the hour hand at a time of day, in degrees clockwise from 12.
+func analogClockHourAngle(since time.Duration) float64 {
+	return float64(since%(12*time.Hour)) / float64(12*time.Hour) * 360
+}
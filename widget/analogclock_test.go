@@ -0,0 +1,53 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalogClock(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	now := time.Date(2024, 3, 1, 15, 0, 30, 0, time.UTC)
+	c := NewAnalogClock()
+	c.now = func() time.Time { return now }
+	c.Location = time.UTC
+	c.Resize(fyne.NewSquareSize(200))
+	r := test.WidgetRenderer(c).(*analogClockRenderer)
+	assert.Equal(t, 15, r.shown.Hour())
+	twelve := r.numerals[11]
+	assert.Equal(t, "12", twelve.Text)
+	assert.InDelta(t, 100, twelve.Position().X+twelve.Size().Width/2, 1e-3)
+
+	// at three o'clock the hour hand points right, the minute hand up and the second hand down
+	img := r.draw(400, 400)
+	fg := color.RGBAModel.Convert(theme.Color(theme.ColorNameForeground))
+	assert.Equal(t, fg, img.At(280, 200))
+	assert.Equal(t, fg, img.At(202, 160))
+	assert.NotEqual(t, fg, img.At(120, 200))
+	assert.Equal(t, color.RGBAModel.Convert(theme.Color(theme.ColorNamePrimary)), img.At(200, 320))
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	c.SetLocation(tokyo)
+	assert.Equal(t, 0, r.shown.Hour())
+}
+
+func TestAnalogClock_Angles(t *testing.T) {
+	tm := time.Date(2024, 3, 1, 21, 30, 15, int(time.Second/2), time.UTC)
+	hour, minute, second := analogClockAngles(tm, false)
+	assert.InDelta(t, 285.125, hour, 1e-9)
+	assert.InDelta(t, 181.5, minute, 1e-9)
+	assert.InDelta(t, 90, second, 1e-9)
+
+	_, _, second = analogClockAngles(tm, true)
+	assert.InDelta(t, 93, second, 1e-9)
+
+	assert.InDelta(t, 225, analogClockHourAngle(7*time.Hour+30*time.Minute), 1e-9)
+}
@@ -0,0 +1,509 @@
+package widget
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"hash/fnv"
+	"image"
+	"image/color"
+	_ "image/jpeg" // avatars are commonly JPEG photos
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"golang.org/x/image/draw"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Avatar)(nil)
+var _ fyne.Widget = (*AvatarGroup)(nil)
+
+// AvatarSize is the diameter of an avatar, the presets suit common uses.
+type AvatarSize float32
+
+const (
+	// AvatarSmall suits lists and inline mentions.
+	AvatarSmall AvatarSize = 24
+	// AvatarMedium suits list rows with two lines of text, it is the default.
+	AvatarMedium AvatarSize = 40
+	// AvatarLarge suits headers and cards.
+	AvatarLarge AvatarSize = 64
+	// AvatarXLarge suits profile pages.
+	AvatarXLarge AvatarSize = 96
+)
+
+// AvatarShape is the outline an avatar is clipped to.
+type AvatarShape int
+
+const (
+	// AvatarCircle clips the avatar to a circle, this is the default.
+	AvatarCircle AvatarShape = iota
+	// AvatarRounded clips the avatar to a square with rounded corners.
+	AvatarRounded
+)
+
+// AvatarStatus is the presence shown as a dot at the bottom trailing edge of an avatar.
+type AvatarStatus int
+
+const (
+	// AvatarStatusNone shows no dot, this is the default.
+	AvatarStatusNone AvatarStatus = iota
+	// AvatarStatusOnline shows a dot in the success color.
+	AvatarStatusOnline
+	// AvatarStatusAway shows a dot in the warning color.
+	AvatarStatusAway
+	// AvatarStatusBusy shows a dot in the error color.
+	AvatarStatusBusy
+	// AvatarStatusOffline shows a dot in the disabled color.
+	AvatarStatusOffline
+)
+
+// avatarImageSize is the resolution that avatar images are cached at.
+const avatarImageSize = 192
+
+var avatarCache = struct {
+	sync.Mutex
+	images map[string]image.Image
+}{images: map[string]image.Image{}}
+
+// Avatar shows a picture of a person or entity, loaded in the background from a URI and cached
+// in memory and in the app storage. Until the image is loaded, or if there is none, the initials of
+// the name are shown on a background color picked from the name.
+type Avatar struct {
+	widget.BaseWidget
+
+	Name string
+	URI  fyne.URI
+	// Diameter is the size of the avatar, one of the presets such as AvatarMedium or any other value.
+	Diameter AvatarSize
+	Shape    AvatarShape
+	// Status shows a dot for the presence of the person, such as whether they are online.
+	Status AvatarStatus
+
+	imageLock sync.Mutex
+	image     image.Image
+	loading   fyne.URI
+}
+
+// NewAvatar creates a new avatar showing the initials of the name.
+func NewAvatar(name string) *Avatar {
+	a := &Avatar{Name: name, Diameter: AvatarMedium}
+	a.ExtendBaseWidget(a)
+	return a
+}
+
+// NewAvatarWithURI creates a new avatar showing the image at the URI, with the initials of the name as a fallback.
+func NewAvatarWithURI(name string, u fyne.URI) *Avatar {
+	a := NewAvatar(name)
+	a.SetURI(u)
+	return a
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (a *Avatar) CreateRenderer() fyne.WidgetRenderer {
+	a.ExtendBaseWidget(a)
+	a.load()
+	initials := canvas.NewText("", color.White)
+	initials.Alignment = fyne.TextAlignCenter
+	initials.TextStyle.Bold = true
+	img := canvas.NewImageFromImage(nil)
+	img.FillMode = canvas.ImageFillContain
+	r := &avatarRenderer{avatar: a, bg: canvas.NewRectangle(color.Transparent), initials: initials, image: img,
+		status: canvas.NewCircle(color.Transparent)}
+	r.Refresh()
+	return r
+}
+
+// Initials returns up to two letters from the start of the first and last words of the name.
+func (a *Avatar) Initials() string {
+	words := strings.FieldsFunc(a.Name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+	initials := string([]rune(words[0])[:1])
+	if len(words) > 1 {
+		initials += string([]rune(words[len(words)-1])[:1])
+	}
+	return strings.ToUpper(initials)
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (a *Avatar) MinSize() fyne.Size {
+	a.ExtendBaseWidget(a)
+	return a.BaseWidget.MinSize()
+}
+
+// SetURI changes the image to load, a nil URI shows the initials.
+func (a *Avatar) SetURI(u fyne.URI) {
+	var cached image.Image
+	if u != nil {
+		cached = cachedAvatarImage(u)
+	}
+	a.imageLock.Lock()
+	a.URI = u
+	a.image = cached
+	a.imageLock.Unlock()
+	// refresh before loading, which refreshes the avatar again when the image arrives
+	a.Refresh()
+	a.load()
+}
+
+// SetStatus changes the presence shown as a dot on the avatar.
+func (a *Avatar) SetStatus(status AvatarStatus) {
+	a.Status = status
+	a.Refresh()
+}
+
+func (a *Avatar) currentImage() image.Image {
+	a.imageLock.Lock()
+	defer a.imageLock.Unlock()
+	return a.image
+}
+
+// load fetches the image in the background, the avatar is refreshed once it arrives.
+func (a *Avatar) load() {
+	u := a.URI
+	if u == nil {
+		return
+	}
+	if img := cachedAvatarImage(u); img != nil {
+		a.imageLock.Lock()
+		a.image = img
+		a.imageLock.Unlock()
+		return
+	}
+
+	a.imageLock.Lock()
+	if a.loading != nil && a.loading.String() == u.String() {
+		a.imageLock.Unlock()
+		return
+	}
+	a.loading = u
+	a.imageLock.Unlock()
+
+	go func() {
+		defer a.loaded(u)
+		img, err := loadAvatarImage(u)
+		if err != nil {
+			fyne.LogError("Failed to load avatar image "+u.String(), err)
+			return
+		}
+		a.imageLock.Lock()
+		if a.URI == nil || a.URI.String() != u.String() {
+			a.imageLock.Unlock()
+			return // changed while loading
+		}
+		a.image = img
+		a.imageLock.Unlock()
+		a.Refresh()
+	}()
+}
+
+// loaded marks the load of u as finished, once the avatar has been refreshed with its image.
+func (a *Avatar) loaded(u fyne.URI) {
+	a.imageLock.Lock()
+	defer a.imageLock.Unlock()
+	if a.loading != nil && a.loading.String() == u.String() {
+		a.loading = nil
+	}
+}
+
+// AvatarGroup shows avatars overlapping each other in a row, with a count of any that do not fit.
+type AvatarGroup struct {
+	widget.BaseWidget
+
+	Avatars []*Avatar
+	// Max is how many avatars are shown before the rest are summarized as "+N", all are shown if zero.
+	Max      int
+	Diameter AvatarSize
+}
+
+// NewAvatarGroup creates a new group showing at most max of the avatars.
+func NewAvatarGroup(max int, avatars ...*Avatar) *AvatarGroup {
+	g := &AvatarGroup{Avatars: avatars, Max: max, Diameter: AvatarMedium}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (g *AvatarGroup) CreateRenderer() fyne.WidgetRenderer {
+	g.ExtendBaseWidget(g)
+	r := &avatarGroupRenderer{group: g, more: NewAvatar("")}
+	r.Refresh()
+	return r
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (g *AvatarGroup) MinSize() fyne.Size {
+	g.ExtendBaseWidget(g)
+	return g.BaseWidget.MinSize()
+}
+
+type avatarRenderer struct {
+	avatar   *Avatar
+	bg       *canvas.Rectangle
+	initials *canvas.Text
+	image    *canvas.Image
+	status   *canvas.Circle
+}
+
+func (r *avatarRenderer) Destroy() {
+}
+
+func (r *avatarRenderer) Layout(size fyne.Size) {
+	side := fyne.Min(size.Width, size.Height)
+	pos := fyne.NewPos((size.Width-side)/2, (size.Height-side)/2)
+	r.bg.Resize(fyne.NewSquareSize(side))
+	r.bg.Move(pos)
+	r.bg.CornerRadius = r.cornerRadius(side)
+	r.image.Resize(fyne.NewSquareSize(side))
+	r.image.Move(pos)
+	r.initials.TextSize = side * 0.4
+	r.initials.Resize(fyne.NewSquareSize(side))
+	r.initials.Move(pos)
+
+	// the dot sits on the outline at the bottom trailing edge, where a circle is 45 degrees round
+	dot := fyne.Max(side*0.28, 8)
+	edge := side - dot/2
+	if r.avatar.Shape == AvatarCircle {
+		edge = side/2 + side/2*math.Sqrt2/2
+	}
+	r.status.StrokeWidth = fyne.Max(side/20, 1.5)
+	r.status.Resize(fyne.NewSquareSize(dot))
+	r.status.Move(pos.Add(fyne.NewPos(edge-dot/2, edge-dot/2)))
+}
+
+func (r *avatarRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(float32(r.avatar.Diameter))
+}
+
+func (r *avatarRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg, r.initials, r.image, r.status}
+}
+
+func (r *avatarRenderer) Refresh() {
+	a := r.avatar
+	img := a.currentImage()
+	r.bg.FillColor = avatarColor(a.Name)
+	r.initials.Text = a.Initials()
+	r.image.Hidden = img == nil
+	if img != nil {
+		r.image.Image = clipAvatarImage(img, a.Shape)
+	}
+	r.status.Hidden = a.Status == AvatarStatusNone
+	r.status.FillColor = avatarStatusColor(a.Status)
+	r.status.StrokeColor = theme.Color(theme.ColorNameBackground)
+	r.Layout(a.Size())
+	r.bg.Refresh()
+	r.initials.Refresh()
+	r.image.Refresh()
+	r.status.Refresh()
+}
+
+func (r *avatarRenderer) cornerRadius(side float32) float32 {
+	if r.avatar.Shape == AvatarRounded {
+		return side / 5
+	}
+	return side / 2
+}
+
+type avatarGroupRenderer struct {
+	group   *AvatarGroup
+	more    *Avatar
+	objects []fyne.CanvasObject
+}
+
+func (r *avatarGroupRenderer) Destroy() {
+}
+
+func (r *avatarGroupRenderer) Layout(size fyne.Size) {
+	side := float32(r.group.Diameter)
+	step := side * 2 / 3
+	for i, o := range r.objects {
+		o.Resize(fyne.NewSquareSize(side))
+		o.Move(fyne.NewPos(float32(i)*step, (size.Height-side)/2))
+	}
+}
+
+func (r *avatarGroupRenderer) MinSize() fyne.Size {
+	side := float32(r.group.Diameter)
+	if len(r.objects) == 0 {
+		return fyne.NewSize(0, side)
+	}
+	return fyne.NewSize(side+float32(len(r.objects)-1)*side*2/3, side)
+}
+
+func (r *avatarGroupRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *avatarGroupRenderer) Refresh() {
+	g := r.group
+	shown := g.Avatars
+	if g.Max > 0 && len(shown) > g.Max {
+		shown = shown[:g.Max]
+	}
+	r.objects = r.objects[:0]
+	for _, a := range shown {
+		a.Diameter = g.Diameter
+		r.objects = append(r.objects, a)
+	}
+	if hidden := len(g.Avatars) - len(shown); hidden > 0 {
+		r.more.Name = "+" + strconv.Itoa(hidden)
+		r.more.Diameter = g.Diameter
+		r.objects = append(r.objects, r.more)
+	}
+	r.Layout(g.Size())
+	for _, o := range r.objects {
+		o.Refresh()
+	}
+}
+
+// avatarColor picks a background color for the initials that is the same each time for a name.
+func avatarColor(name string) color.Color {
+	if name == "" || strings.HasPrefix(name, "+") {
+		return theme.Color(theme.ColorNameDisabled)
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	colors := DefaultColorPalette[:12] // skip the greys
+	return colors[h.Sum32()%uint32(len(colors))]
+}
+
+func avatarStatusColor(status AvatarStatus) color.Color {
+	switch status {
+	case AvatarStatusOnline:
+		return theme.Color(theme.ColorNameSuccess)
+	case AvatarStatusAway:
+		return theme.Color(theme.ColorNameWarning)
+	case AvatarStatusBusy:
+		return theme.Color(theme.ColorNameError)
+	case AvatarStatusOffline:
+		return theme.Color(theme.ColorNameDisabled)
+	}
+	return color.Transparent
+}
+
+func avatarCacheName(u fyne.URI) string {
+	sum := sha1.Sum([]byte(u.String()))
+	return hex.EncodeToString(sum[:]) + ".png"
+}
+
+func cachedAvatarImage(u fyne.URI) image.Image {
+	avatarCache.Lock()
+	defer avatarCache.Unlock()
+	return avatarCache.images[u.String()]
+}
+
+// clipAvatarImage masks the image to the avatar shape, anti-aliasing the edge.
+func clipAvatarImage(img image.Image, shape AvatarShape) image.Image {
+	b := img.Bounds()
+	side := b.Dx()
+	radius := float64(side) / 2
+	if shape == AvatarRounded {
+		radius = float64(side) / 5
+	}
+	out := image.NewNRGBA(image.Rect(0, 0, side, side))
+	for y := 0; y < side; y++ {
+		for x := 0; x < side; x++ {
+			c := color.NRGBAModel.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.NRGBA)
+			cover := roundedCoverage(float64(x)+0.5, float64(y)+0.5, float64(side), radius)
+			c.A = uint8(float64(c.A) * cover)
+			out.SetNRGBA(x, y, c)
+		}
+	}
+	return out
+}
+
+// loadAvatarImage reads an image from the disk cache or the URI, cropping it to a square.
+func loadAvatarImage(u fyne.URI) (image.Image, error) {
+	var cacheDir fyne.URI
+	if app := fyne.CurrentApp(); app != nil && app.Storage().RootURI() != nil {
+		cacheDir, _ = storage.Child(app.Storage().RootURI(), "avatars")
+	}
+	if cacheDir != nil {
+		if cached, err := storage.Child(cacheDir, avatarCacheName(u)); err == nil {
+			if img, err := readAvatarImage(cached); err == nil {
+				storeAvatarImage(u, img)
+				return img, nil
+			}
+		}
+	}
+
+	img, err := readAvatarImage(u)
+	if err != nil {
+		return nil, err
+	}
+	img = squareAvatarImage(img)
+	storeAvatarImage(u, img)
+
+	if cacheDir != nil {
+		_ = storage.CreateListable(cacheDir) // fails harmlessly if it exists
+		if cached, err := storage.Child(cacheDir, avatarCacheName(u)); err == nil {
+			if w, err := storage.Writer(cached); err == nil {
+				_ = png.Encode(w, img)
+				_ = w.Close()
+			}
+		}
+	}
+	return img, nil
+}
+
+func readAvatarImage(u fyne.URI) (image.Image, error) {
+	r, err := storage.Reader(u)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// roundedCoverage returns how much of the pixel at x, y lies inside a rounded square of the given size.
+func roundedCoverage(x, y, side, radius float64) float64 {
+	cx := math.Max(radius, math.Min(side-radius, x))
+	cy := math.Max(radius, math.Min(side-radius, y))
+	dist := math.Hypot(x-cx, y-cy)
+	return math.Max(0, math.Min(1, radius-dist+0.5))
+}
+
+// squareAvatarImage crops the centre square of an image and scales it to the cache resolution.
+func squareAvatarImage(img image.Image) image.Image {
+	b := img.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	crop := image.Rect(0, 0, side, side).Add(b.Min).Add(image.Pt((b.Dx()-side)/2, (b.Dy()-side)/2))
+	size := side
+	if size > avatarImageSize {
+		size = avatarImageSize
+	}
+	out := image.NewNRGBA(image.Rect(0, 0, size, size))
+	draw.CatmullRom.Scale(out, out.Bounds(), img, crop, draw.Src, nil)
+	return out
+}
+
+func storeAvatarImage(u fyne.URI, img image.Image) {
+	avatarCache.Lock()
+	defer avatarCache.Unlock()
+	avatarCache.images[u.String()] = img
+}
@@ -0,0 +1,98 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvatar_Initials(t *testing.T) {
+	assert.Equal(t, "AL", NewAvatar("Ada Lovelace").Initials())
+	assert.Equal(t, "GH", NewAvatar("grace b. hopper").Initials())
+	assert.Equal(t, "Z", NewAvatar("zed").Initials())
+	assert.Equal(t, "", NewAvatar("").Initials())
+	assert.Equal(t, avatarColor("Ada Lovelace"), avatarColor("Ada Lovelace"))
+}
+
+func TestAvatar_Load(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	path := filepath.Join(t.TempDir(), "face.png")
+	src := image.NewNRGBA(image.Rect(0, 0, 40, 20))
+	for i := range src.Pix {
+		src.Pix[i] = 0xff
+	}
+	f, err := os.Create(path)
+	assert.NoError(t, err)
+	assert.NoError(t, png.Encode(f, src))
+	assert.NoError(t, f.Close())
+
+	u := storage.NewFileURI(path)
+	a := NewAvatar("Ada Lovelace")
+	w := test.NewWindow(a)
+	defer w.Close()
+	assert.Equal(t, fyne.NewSquareSize(float32(AvatarMedium)), a.MinSize())
+	a.SetURI(u)
+
+	assert.Eventually(t, func() bool {
+		return !a.isLoading()
+	}, time.Second, 10*time.Millisecond)
+	assert.NotNil(t, a.currentImage())
+	assert.Equal(t, image.Rect(0, 0, 20, 20), a.currentImage().Bounds())
+	assert.NotNil(t, cachedAvatarImage(u))
+
+	clipped := clipAvatarImage(a.currentImage(), AvatarCircle)
+	assert.Equal(t, uint8(0), clipped.At(0, 0).(color.NRGBA).A)
+	assert.Equal(t, uint8(0xff), clipped.At(10, 10).(color.NRGBA).A)
+}
+
+func TestAvatarGroup(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	g := NewAvatarGroup(2, NewAvatar("A"), NewAvatar("B"), NewAvatar("C"), NewAvatar("D"))
+	g.Diameter = AvatarSmall
+	r := test.WidgetRenderer(g).(*avatarGroupRenderer)
+	g.Refresh()
+	assert.Len(t, r.Objects(), 3)
+	assert.Equal(t, "+2", r.more.Name)
+	assert.Equal(t, fyne.NewSize(24+2*16, 24), g.MinSize())
+}
+
+func TestAvatar_Status(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	a := NewAvatar("Ada Lovelace")
+	a.Resize(a.MinSize())
+	r := test.WidgetRenderer(a).(*avatarRenderer)
+	assert.True(t, r.status.Hidden)
+
+	a.SetStatus(AvatarStatusOnline)
+	assert.False(t, r.status.Hidden)
+	assert.Equal(t, theme.Color(theme.ColorNameSuccess), r.status.FillColor)
+	dot := r.status.Size().Width
+	end := r.status.Position().Add(r.status.Size())
+	assert.Less(t, end.X, float32(AvatarMedium)+dot/2, "the dot should overlap the edge")
+	assert.Greater(t, end.X, float32(AvatarMedium)-dot/2)
+
+	a.SetStatus(AvatarStatusBusy)
+	assert.Equal(t, theme.Color(theme.ColorNameError), r.status.FillColor)
+}
+
+func (a *Avatar) isLoading() bool {
+	a.imageLock.Lock()
+	defer a.imageLock.Unlock()
+	return a.loading != nil
+}
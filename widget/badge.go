@@ -0,0 +1,366 @@
+package widget
+
+import (
+	"strconv"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Badge)(nil)
+var _ fyne.Layout = (*badgeLayout)(nil)
+
+const (
+	badgePopDuration  = 250 * time.Millisecond
+	badgeShowDuration = 150 * time.Millisecond
+)
+
+// BadgeCorner is the corner of a decorated object that a badge is anchored to.
+type BadgeCorner int
+
+const (
+	// BadgeTopTrailing anchors the badge to the top right corner, this is the default.
+	BadgeTopTrailing BadgeCorner = iota
+	// BadgeTopLeading anchors the badge to the top left corner.
+	BadgeTopLeading
+	// BadgeBottomTrailing anchors the badge to the bottom right corner.
+	BadgeBottomTrailing
+	// BadgeBottomLeading anchors the badge to the bottom left corner.
+	BadgeBottomLeading
+)
+
+// Badge is a small pill showing a count or short status text, such as an unread count, or a dot.
+// It shrinks away when the count is zero and there is no text, or when it is hidden, and grows back in.
+// Use WithBadge to anchor a badge to the corner of another object.
+type Badge struct {
+	widget.BaseWidget
+
+	// Count is shown when there is no Text, changes to it are animated.
+	Count int
+	// MaxCount is the largest count that is shown, larger ones are shown as "99+", 99 if 0.
+	MaxCount int
+	// Text is a short status such as "new", it takes precedence over Count.
+	Text string
+	// Dot shows a small dot instead of the count or text, it is shown until the badge is hidden.
+	Dot bool
+	// Corner is where WithBadge anchors the badge.
+	Corner BadgeCorner
+	// Importance sets the colour of the badge: the error colour by default, the primary colour for
+	// widget.HighImportance, or the success or warning colours.
+	Importance widget.Importance
+
+	hiding   bool   // shrinking away before it is hidden
+	relayout func() // set by WithBadge to reposition the badge when its size changes
+}
+
+// NewBadge creates a new badge showing the given count.
+func NewBadge(count int) *Badge {
+	b := &Badge{Count: count}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// NewDotBadge creates a new badge showing a small dot, such as to mark something unread.
+func NewDotBadge() *Badge {
+	b := &Badge{Dot: true}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// NewTextBadge creates a new badge showing a short status text.
+func NewTextBadge(text string) *Badge {
+	b := &Badge{Text: text}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// WithBadge decorates an object with a badge anchored to one of its corners.
+// The returned container has the minimum size of the object, the badge overlaps its edges.
+func WithBadge(obj fyne.CanvasObject, badge *Badge) *fyne.Container {
+	l := &badgeLayout{badge: badge}
+	c := container.New(l, obj, badge)
+	badge.relayout = func() {
+		l.Layout(c.Objects, c.Size())
+	}
+	return c
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (b *Badge) CreateRenderer() fyne.WidgetRenderer {
+	b.ExtendBaseWidget(b)
+	text := canvas.NewText("", theme.Color(theme.ColorNameForegroundOnError))
+	text.TextSize = theme.CaptionTextSize()
+	text.TextStyle.Bold = true
+	text.Alignment = fyne.TextAlignCenter
+	r := &badgeRenderer{badge: b, pill: canvas.NewRectangle(theme.Color(theme.ColorNameError)), text: text, scale: 1}
+	r.count = b.Count
+	if b.shown() {
+		r.presence, r.target = 1, 1
+	}
+	r.Refresh()
+	return r
+}
+
+// Hide shrinks the badge away and then hides it.
+func (b *Badge) Hide() {
+	if !b.Visible() || b.hiding {
+		return
+	}
+	b.hiding = true
+	b.Refresh()
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (b *Badge) MinSize() fyne.Size {
+	b.ExtendBaseWidget(b)
+	return b.BaseWidget.MinSize()
+}
+
+// Refresh updates the badge, moving it if its size changed while anchored by WithBadge.
+func (b *Badge) Refresh() {
+	b.BaseWidget.Refresh()
+	if b.relayout != nil && b.Size() != b.MinSize() {
+		b.relayout()
+	}
+}
+
+// Show shows the badge again after it was hidden, growing it in.
+func (b *Badge) Show() {
+	b.hiding = false
+	if b.Visible() {
+		b.Refresh()
+		return
+	}
+	b.BaseWidget.Show()
+}
+
+// SetCount changes the count shown, with a short animation.
+func (b *Badge) SetCount(count int) {
+	b.Count = count
+	b.Refresh()
+}
+
+// SetText changes the status text shown, an empty string shows the count again.
+func (b *Badge) SetText(text string) {
+	b.Text = text
+	b.Refresh()
+}
+
+func (b *Badge) colors() (fill, text fyne.ThemeColorName) {
+	switch b.Importance {
+	case widget.HighImportance:
+		return theme.ColorNamePrimary, theme.ColorNameForegroundOnPrimary
+	case widget.SuccessImportance:
+		return theme.ColorNameSuccess, theme.ColorNameForegroundOnSuccess
+	case widget.WarningImportance:
+		return theme.ColorNameWarning, theme.ColorNameForegroundOnWarning
+	}
+	return theme.ColorNameError, theme.ColorNameForegroundOnError
+}
+
+func (b *Badge) empty() bool {
+	return !b.Dot && b.Text == "" && b.Count == 0
+}
+
+func (b *Badge) label() string {
+	switch {
+	case b.Dot:
+		return ""
+	case b.Text != "":
+		return b.Text
+	}
+	max := b.MaxCount
+	if max <= 0 {
+		max = 99
+	}
+	if b.Count > max {
+		return strconv.Itoa(max) + "+"
+	}
+	return strconv.Itoa(b.Count)
+}
+
+// shown returns whether the badge should be drawn, rather than shrunk away.
+func (b *Badge) shown() bool {
+	return !b.empty() && !b.hiding && b.Visible()
+}
+
+type badgeRenderer struct {
+	badge *Badge
+	pill  *canvas.Rectangle
+	text  *canvas.Text
+
+	count int
+	scale float32
+	anim  *fyne.Animation
+
+	label            string  // the last one shown, kept while the badge shrinks away
+	presence, target float32 // 0 when shrunk away and 1 when shown
+	showAnim         *fyne.Animation
+}
+
+func (r *badgeRenderer) Destroy() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+	if r.showAnim != nil {
+		r.showAnim.Stop()
+	}
+}
+
+func (r *badgeRenderer) Layout(size fyne.Size) {
+	scale := r.scale * r.presence
+	scaled := fyne.NewSize(size.Width*scale, size.Height*scale)
+	pos := fyne.NewPos((size.Width-scaled.Width)/2, (size.Height-scaled.Height)/2)
+	r.pill.Resize(scaled)
+	r.pill.Move(pos)
+	r.pill.CornerRadius = scaled.Height / 2
+	r.text.TextSize = theme.CaptionTextSize() * scale
+	r.text.Resize(scaled)
+	r.text.Move(pos)
+}
+
+func (r *badgeRenderer) MinSize() fyne.Size {
+	if r.badge.empty() && r.presence == 0 {
+		return fyne.NewSize(0, 0)
+	}
+	if r.badge.Dot {
+		return fyne.NewSquareSize(theme.Padding() * 2)
+	}
+	text := fyne.MeasureText(r.label, theme.CaptionTextSize(), fyne.TextStyle{Bold: true})
+	height := text.Height + theme.Padding()
+	return fyne.NewSize(fyne.Max(text.Width+theme.Padding()*2, height), height)
+}
+
+func (r *badgeRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.pill, r.text}
+}
+
+func (r *badgeRenderer) Refresh() {
+	b := r.badge
+	if b.Text == "" && !b.Dot && b.Count != r.count && r.count != 0 && b.Count != 0 && r.presence == 1 {
+		r.pop()
+	}
+	r.count = b.Count
+	if !b.empty() {
+		r.label = b.label()
+	}
+	target := float32(0)
+	if b.shown() {
+		target = 1
+	}
+	if target != r.target {
+		r.target = target
+		r.animatePresence()
+	} else if b.hiding && r.presence == 0 {
+		r.hidden()
+	}
+
+	r.pill.Hidden = r.presence == 0
+	r.text.Hidden = r.presence == 0 || b.Dot
+	r.text.Text = r.label
+	fill, text := b.colors()
+	r.pill.FillColor = theme.Color(fill)
+	r.text.Color = theme.Color(text)
+	r.Layout(b.Size())
+	r.pill.Refresh()
+	r.text.Refresh()
+}
+
+// pop briefly grows the badge to draw attention to a new count.
+func (r *badgeRenderer) pop() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+	r.anim = fyne.NewAnimation(badgePopDuration, func(done float32) {
+		if done < 0.5 {
+			r.scale = 1 + done*0.6
+		} else {
+			r.scale = 1 + (1-done)*0.6
+		}
+		r.Layout(r.badge.Size())
+		canvas.Refresh(r.badge)
+	})
+	r.anim.Curve = fyne.AnimationEaseOut
+	r.anim.Start()
+}
+
+// animatePresence grows the badge in or shrinks it away.
+func (r *badgeRenderer) animatePresence() {
+	if r.showAnim != nil {
+		r.showAnim.Stop()
+	}
+	start, end := r.presence, r.target
+	if start == 0 {
+		r.pill.Show()
+		r.text.Hidden = r.badge.Dot
+	}
+	r.showAnim = fyne.NewAnimation(badgeShowDuration, func(done float32) {
+		r.presence = start + (end-start)*done
+		r.Layout(r.badge.Size())
+		canvas.Refresh(r.badge)
+		if done == 1 && end == 0 {
+			r.pill.Hide()
+			r.text.Hide()
+			if r.badge.hiding {
+				r.hidden()
+			}
+			if r.badge.relayout != nil {
+				r.badge.relayout()
+			}
+		}
+	})
+	r.showAnim.Curve = fyne.AnimationEaseOut
+	r.showAnim.Start()
+}
+
+// hidden hides the badge once it shrank away.
+func (r *badgeRenderer) hidden() {
+	r.badge.hiding = false
+	r.badge.BaseWidget.Hide()
+}
+
+type badgeLayout struct {
+	badge *Badge
+}
+
+func (l *badgeLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	for _, o := range objects {
+		if o == l.badge {
+			continue
+		}
+		o.Resize(size)
+		o.Move(fyne.NewPos(0, 0))
+	}
+
+	badge := l.badge.MinSize()
+	l.badge.Resize(badge)
+	// the badge centre sits a quarter of its size inside the corner
+	x := size.Width - badge.Width*3/4
+	y := -badge.Height / 4
+	switch l.badge.Corner {
+	case BadgeTopLeading:
+		x = -badge.Width / 4
+	case BadgeBottomTrailing:
+		y = size.Height - badge.Height*3/4
+	case BadgeBottomLeading:
+		x, y = -badge.Width/4, size.Height-badge.Height*3/4
+	}
+	l.badge.Move(fyne.NewPos(x, y))
+}
+
+func (l *badgeLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	min := fyne.NewSize(0, 0)
+	for _, o := range objects {
+		if o == l.badge {
+			continue
+		}
+		min = min.Max(o.MinSize())
+	}
+	return min
+}
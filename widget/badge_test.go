@@ -0,0 +1,98 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBadge_Empty(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	b := NewBadge(0)
+	assert.Equal(t, fyne.NewSize(0, 0), b.MinSize())
+
+	b.SetCount(3)
+	assert.NotEqual(t, fyne.NewSize(0, 0), b.MinSize())
+	small := b.MinSize()
+	b.SetText("new")
+	assert.Greater(t, b.MinSize().Width, small.Width)
+}
+
+func TestWithBadge(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	button := widget.NewButton("Inbox", nil)
+	badge := NewBadge(5)
+	c := WithBadge(button, badge)
+	assert.Equal(t, button.MinSize(), c.MinSize())
+
+	c.Resize(fyne.NewSize(100, 40))
+	assert.Equal(t, fyne.NewSize(100, 40), button.Size())
+	size := badge.Size()
+	assert.Equal(t, badge.MinSize(), size)
+	assert.Equal(t, fyne.NewPos(100-size.Width*3/4, -size.Height/4), badge.Position())
+
+	badge.Corner = BadgeBottomLeading
+	badge.SetCount(120)
+	size = badge.Size()
+	assert.Equal(t, badge.MinSize(), size, "size should follow the count")
+	assert.Equal(t, fyne.NewPos(-size.Width/4, 40-size.Height*3/4), badge.Position())
+}
+
+func TestBadge_Label(t *testing.T) {
+	b := NewBadge(99)
+	assert.Equal(t, "99", b.label())
+	b.Count = 100
+	assert.Equal(t, "99+", b.label())
+	b.MaxCount = 9
+	assert.Equal(t, "9+", b.label())
+
+	dot := NewDotBadge()
+	assert.False(t, dot.empty())
+	assert.Equal(t, "", dot.label())
+}
+
+func TestBadge_Importance(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	b := NewBadge(1)
+	r := test.WidgetRenderer(b).(*badgeRenderer)
+	assert.Equal(t, theme.Color(theme.ColorNameError), r.pill.FillColor)
+
+	b.Importance = widget.HighImportance
+	b.Refresh()
+	assert.Equal(t, theme.Color(theme.ColorNamePrimary), r.pill.FillColor)
+	assert.Equal(t, theme.Color(theme.ColorNameForegroundOnPrimary), r.text.Color)
+}
+
+func TestBadge_HideShow(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	b := NewDotBadge()
+	c := WithBadge(widget.NewIcon(theme.MailComposeIcon()), b)
+	c.Resize(fyne.NewSize(40, 40))
+	r := test.WidgetRenderer(b).(*badgeRenderer)
+	assert.Equal(t, float32(1), r.presence)
+	assert.False(t, r.pill.Hidden)
+	assert.True(t, r.text.Hidden, "a dot has no text")
+
+	b.Hide()
+	assert.False(t, b.Visible())
+	assert.Equal(t, float32(0), r.presence)
+	assert.True(t, r.pill.Hidden)
+
+	b.Show()
+	assert.True(t, b.Visible())
+	assert.Equal(t, float32(1), r.presence)
+	assert.False(t, r.pill.Hidden)
+	assert.Equal(t, b.MinSize(), b.Size())
+}
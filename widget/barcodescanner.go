@@ -0,0 +1,282 @@
+package widget
+
+import (
+	"errors"
+	"image"
+	"math"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/makiuchi-d/gozxing"
+	"github.com/makiuchi-d/gozxing/aztec"
+	"github.com/makiuchi-d/gozxing/datamatrix"
+	"github.com/makiuchi-d/gozxing/oned"
+	"github.com/makiuchi-d/gozxing/qrcode"
+
+	"fyne.io/x/fyne/camera"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*BarcodeScanner)(nil)
+
+// ErrNoBarcode is returned by DecodeBarcode for an image without a barcode that can be read.
+var ErrNoBarcode = errors.New("no barcode found")
+
+// barcodeReaders are the decoders tried in turn for each format they read, most common first.
+var barcodeReaders = []struct {
+	formats []gozxing.BarcodeFormat
+	reader  func() gozxing.Reader
+}{
+	{[]gozxing.BarcodeFormat{gozxing.BarcodeFormat_QR_CODE}, qrcode.NewQRCodeReader},
+	{[]gozxing.BarcodeFormat{gozxing.BarcodeFormat_EAN_13, gozxing.BarcodeFormat_EAN_8,
+		gozxing.BarcodeFormat_UPC_A, gozxing.BarcodeFormat_UPC_E}, func() gozxing.Reader {
+		return oned.NewMultiFormatUPCEANReader(nil)
+	}},
+	{[]gozxing.BarcodeFormat{gozxing.BarcodeFormat_CODE_128}, oned.NewCode128Reader},
+	{[]gozxing.BarcodeFormat{gozxing.BarcodeFormat_CODE_39}, oned.NewCode39Reader},
+	{[]gozxing.BarcodeFormat{gozxing.BarcodeFormat_DATA_MATRIX}, func() gozxing.Reader {
+		return datamatrix.NewDataMatrixReader()
+	}},
+	{[]gozxing.BarcodeFormat{gozxing.BarcodeFormat_AZTEC}, func() gozxing.Reader {
+		return aztec.NewAztecReader()
+	}},
+	{[]gozxing.BarcodeFormat{gozxing.BarcodeFormat_CODE_93}, oned.NewCode93Reader},
+	{[]gozxing.BarcodeFormat{gozxing.BarcodeFormat_ITF}, oned.NewITFReader},
+	{[]gozxing.BarcodeFormat{gozxing.BarcodeFormat_CODABAR}, oned.NewCodaBarReader},
+}
+
+// DecodeBarcode reads the QR code or barcode in an image, returning its content and format, such as "QR_CODE".
+// Formats limits the formats that are tried, all those supported are tried if it is empty:
+// QR_CODE, EAN_13, EAN_8, UPC_A, UPC_E, CODE_128, CODE_39, CODE_93, DATA_MATRIX, AZTEC, ITF and CODABAR.
+func DecodeBarcode(img image.Image, formats ...string) (content, format string, err error) {
+	result, err := decodeBarcode(img, formats, true)
+	if err != nil {
+		return "", "", err
+	}
+	return result.GetText(), result.GetBarcodeFormat().String(), nil
+}
+
+func decodeBarcode(img image.Image, formats []string, tryHarder bool) (*gozxing.Result, error) {
+	bitmap, err := gozxing.NewBinaryBitmap(gozxing.NewHybridBinarizer(gozxing.NewLuminanceSourceFromImage(img)))
+	if err != nil {
+		return nil, err
+	}
+	hints := map[gozxing.DecodeHintType]interface{}{}
+	if tryHarder {
+		hints[gozxing.DecodeHintType_TRY_HARDER] = true
+	}
+	for _, r := range barcodeReaders {
+		if !barcodeFormatAllowed(r.formats, formats) {
+			continue
+		}
+		if result, err := r.reader().Decode(bitmap, hints); err == nil {
+			return result, nil
+		}
+	}
+	return nil, ErrNoBarcode
+}
+
+func barcodeFormatAllowed(read []gozxing.BarcodeFormat, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, f := range read {
+		for _, a := range allowed {
+			if f.String() == a {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// BarcodeScanner decodes QR codes and barcodes shown to a camera, or in an image it is given.
+// The code found is outlined and OnScanned is called with its content.
+type BarcodeScanner struct {
+	widget.BaseWidget
+
+	// Formats limits the formats decoded, all that DecodeBarcode supports are decoded if it is empty.
+	Formats []string
+	// OnScanned is called when a code is found, and again only once it has been out of view for a second.
+	OnScanned func(content, format string) `json:"-"`
+
+	camera *Camera
+	still  *canvas.Image
+	box    *canvas.Rectangle
+
+	lock     sync.Mutex
+	scanning bool
+	outline  image.Rectangle // of the code, in image pixels
+	size     image.Point     // of the image scanned
+	last     string
+	lastSeen time.Time
+}
+
+// NewBarcodeScanner creates a scanner that calls scanned for each code it finds.
+func NewBarcodeScanner(scanned func(content, format string)) *BarcodeScanner {
+	s := &BarcodeScanner{OnScanned: scanned, camera: NewCamera(),
+		still: &canvas.Image{FillMode: canvas.ImageFillContain}}
+	s.still.Hide()
+	s.box = canvas.NewRectangle(theme.Color(theme.ColorNameSelection))
+	s.box.StrokeColor = theme.Color(theme.ColorNamePrimary)
+	s.box.StrokeWidth = 3
+	s.box.CornerRadius = theme.InputRadiusSize()
+	s.box.Hide()
+	s.camera.OnFrame = s.frame
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// Camera returns the camera preview that frames are scanned from, to set its options and OnError callback.
+func (s *BarcodeScanner) Camera() *Camera {
+	return s.camera
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (s *BarcodeScanner) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	return &barcodeScannerRenderer{scanner: s}
+}
+
+// ScanImage shows an image in place of the camera, which is stopped, and scans it.
+// It returns whether a code was found.
+func (s *BarcodeScanner) ScanImage(img image.Image) bool {
+	s.camera.Stop()
+	s.camera.Hide()
+	s.still.Image = img
+	s.still.Show()
+	s.still.Refresh()
+	result, _ := decodeBarcode(img, s.Formats, true)
+	s.lock.Lock()
+	s.lastSeen = time.Time{} // a new image is scanned afresh
+	s.lock.Unlock()
+	return s.found(result, img.Bounds())
+}
+
+// Start shows the preview of a camera and scans its frames.
+func (s *BarcodeScanner) Start(d camera.Device) error {
+	s.still.Hide()
+	s.camera.Show()
+	return s.camera.Start(d)
+}
+
+// Stop closes the camera.
+func (s *BarcodeScanner) Stop() {
+	s.camera.Stop()
+}
+
+// frame scans a frame of the camera, skipping frames while the previous one is scanned.
+func (s *BarcodeScanner) frame(img image.Image) {
+	s.lock.Lock()
+	if s.scanning {
+		s.lock.Unlock()
+		return
+	}
+	s.scanning = true
+	s.lock.Unlock()
+
+	go func() {
+		result, _ := decodeBarcode(img, s.Formats, false)
+		s.lock.Lock()
+		s.scanning = false
+		s.lock.Unlock()
+		s.found(result, img.Bounds())
+	}()
+}
+
+// found outlines a decoded code and reports it, or hides the outline if result is nil.
+func (s *BarcodeScanner) found(result *gozxing.Result, bounds image.Rectangle) bool {
+	now := time.Now()
+	s.lock.Lock()
+	if result == nil {
+		if now.Sub(s.lastSeen) > time.Second/2 {
+			s.outline = image.Rectangle{}
+		}
+		s.lock.Unlock()
+		s.Refresh()
+		return false
+	}
+
+	content, format := result.GetText(), result.GetBarcodeFormat().String()
+	report := content != s.last || now.Sub(s.lastSeen) > time.Second
+	s.last, s.lastSeen = content, now
+	s.outline = barcodeOutline(result.GetResultPoints()).Sub(bounds.Min)
+	s.size = bounds.Size()
+	s.lock.Unlock()
+	s.Refresh()
+
+	if f := s.OnScanned; report && f != nil {
+		f(content, format)
+	}
+	return true
+}
+
+// barcodeOutline returns a box around the points that a code was located by, such as the corners of a QR code.
+// The points of a barcode are at the ends of a row across it, so the box is given some height.
+func barcodeOutline(points []gozxing.ResultPoint) image.Rectangle {
+	if len(points) == 0 {
+		return image.Rectangle{}
+	}
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, p := range points {
+		minX, maxX = math.Min(minX, p.GetX()), math.Max(maxX, p.GetX())
+		minY, maxY = math.Min(minY, p.GetY()), math.Max(maxY, p.GetY())
+	}
+	// the points of 2D codes are the centres of finder patterns, 3.5 modules in from the edges of 21 or more
+	pad := math.Max(maxX-minX, maxY-minY) * .25
+	padX, padY := pad, pad
+	if maxY-minY < (maxX-minX)/10 { // a row across a barcode, which is usually about a third as high as wide
+		padX, padY = (maxX-minX)*.05, (maxX-minX)*.15
+	}
+	return image.Rect(int(minX-padX), int(minY-padY), int(maxX+padX), int(maxY+padY))
+}
+
+type barcodeScannerRenderer struct {
+	scanner *BarcodeScanner
+}
+
+func (r *barcodeScannerRenderer) Destroy() {
+}
+
+func (r *barcodeScannerRenderer) Layout(size fyne.Size) {
+	s := r.scanner
+	s.camera.Resize(size)
+	s.still.Resize(size)
+
+	s.lock.Lock()
+	found, imgSize := s.outline, s.size
+	s.lock.Unlock()
+	if found.Empty() || imgSize.X == 0 || imgSize.Y == 0 {
+		s.box.Hide()
+		return
+	}
+	// images are shown to fit the scanner, centred
+	scale := fyne.Min(size.Width/float32(imgSize.X), size.Height/float32(imgSize.Y))
+	offset := fyne.NewPos((size.Width-float32(imgSize.X)*scale)/2, (size.Height-float32(imgSize.Y)*scale)/2)
+	s.box.Move(offset.AddXY(float32(found.Min.X)*scale, float32(found.Min.Y)*scale))
+	s.box.Resize(fyne.NewSize(float32(found.Dx())*scale, float32(found.Dy())*scale))
+	s.box.Show()
+}
+
+func (r *barcodeScannerRenderer) MinSize() fyne.Size {
+	return r.scanner.camera.MinSize()
+}
+
+func (r *barcodeScannerRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.scanner.camera, r.scanner.still, r.scanner.box}
+}
+
+func (r *barcodeScannerRenderer) Refresh() {
+	s := r.scanner
+	s.box.StrokeColor = theme.Color(theme.ColorNamePrimary)
+	s.box.FillColor = theme.Color(theme.ColorNameSelection)
+	r.Layout(s.Size())
+	canvas.Refresh(s.box)
+}
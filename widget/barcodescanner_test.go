@@ -0,0 +1,90 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"github.com/makiuchi-d/gozxing"
+	"github.com/makiuchi-d/gozxing/oned"
+	"github.com/makiuchi-d/gozxing/qrcode"
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/x/fyne/camera"
+)
+
+// codeImage draws a generated code on a larger white image, at an offset.
+func codeImage(t *testing.T, code image.Image, at image.Point) image.Image {
+	img := image.NewGray(image.Rect(0, 0, 400, 300))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(img, code.Bounds().Add(at), code, image.Point{}, draw.Src)
+	return img
+}
+
+func TestDecodeBarcode(t *testing.T) {
+	qr, err := qrcode.NewQRCodeWriter().Encode("https://fyne.io", gozxing.BarcodeFormat_QR_CODE, 120, 120, nil)
+	assert.NoError(t, err)
+	content, format, err := DecodeBarcode(codeImage(t, qr, image.Pt(20, 20)))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://fyne.io", content)
+	assert.Equal(t, "QR_CODE", format)
+
+	_, _, err = DecodeBarcode(codeImage(t, qr, image.Pt(20, 20)), "EAN_13")
+	assert.Equal(t, ErrNoBarcode, err)
+
+	ean, err := oned.NewEAN13Writer().Encode("4006381333931", gozxing.BarcodeFormat_EAN_13, 200, 60, nil)
+	assert.NoError(t, err)
+	content, format, err = DecodeBarcode(codeImage(t, ean, image.Pt(100, 100)))
+	assert.NoError(t, err)
+	assert.Equal(t, "4006381333931", content)
+	assert.Equal(t, "EAN_13", format)
+
+	_, _, err = DecodeBarcode(image.NewGray(image.Rect(0, 0, 50, 50)))
+	assert.Equal(t, ErrNoBarcode, err)
+}
+
+func TestBarcodeScanner_ScanImage(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	var scanned []string
+	s := NewBarcodeScanner(func(content, format string) {
+		scanned = append(scanned, format+" "+content)
+	})
+	w := test.NewWindow(s)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(200, 150))
+
+	qr, _ := qrcode.NewQRCodeWriter().Encode("hello", gozxing.BarcodeFormat_QR_CODE, 100, 100, nil)
+	assert.True(t, s.ScanImage(codeImage(t, qr, image.Pt(200, 0))))
+	assert.Equal(t, []string{"QR_CODE hello"}, scanned)
+	// the image is shown at half size, the code is in the top right
+	assert.True(t, s.box.Visible())
+	assert.Greater(t, s.box.Position().X, float32(100))
+	assert.Less(t, s.box.Position().Y, float32(25))
+	assert.InDelta(t, 31.5, s.box.Size().Width, 3) // 21 modules of 3 pixels, without the quiet zone
+
+	assert.False(t, s.ScanImage(image.NewGray(image.Rect(0, 0, 40, 30))))
+	assert.False(t, s.box.Visible())
+	assert.Len(t, scanned, 1)
+}
+
+func TestBarcodeScanner_Camera(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+	f := useFakeCamera(t)
+
+	scanned := make(chan string, 2)
+	s := NewBarcodeScanner(func(content, _ string) {
+		scanned <- content
+	})
+	qr, _ := qrcode.NewQRCodeWriter().Encode("from camera", gozxing.BarcodeFormat_QR_CODE, 100, 100, nil)
+	assert.NoError(t, s.Start(camera.Device{ID: "0"}))
+	f.frames <- codeImage(t, qr, image.Pt(10, 10))
+	assert.Equal(t, "from camera", <-scanned)
+	s.Stop()
+}
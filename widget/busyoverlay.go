@@ -0,0 +1,261 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"golang.org/x/image/vector"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*BusyOverlay)(nil)
+var _ fyne.Tappable = (*busyBlocker)(nil)
+var _ fyne.Draggable = (*busyBlocker)(nil)
+var _ fyne.Scrollable = (*busyBlocker)(nil)
+var _ desktop.Hoverable = (*busyBlocker)(nil)
+var _ desktop.Mouseable = (*busyBlocker)(nil)
+
+const busySpinnerDuration = time.Second
+
+// BusyOverlay wraps content that can be dimmed and blocked from input, with a spinner in the centre,
+// while an operation runs. An optional message is shown below the spinner, and a cancel button if
+// OnCancel is set.
+type BusyOverlay struct {
+	widget.BaseWidget
+
+	Content fyne.CanvasObject
+	Message string
+
+	OnCancel func() `json:"-"`
+
+	busy   bool
+	binder *boolBinder
+}
+
+// WithBusyOverlay returns a wrapper of obj that can be made busy.
+func WithBusyOverlay(obj fyne.CanvasObject) *BusyOverlay {
+	b := &BusyOverlay{Content: obj}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// Bind connects whether the content is busy to a data source.
+func (b *BusyOverlay) Bind(data binding.Bool) {
+	b.Unbind()
+	b.binder = newBoolBinder(data, b.SetBusy)
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (b *BusyOverlay) CreateRenderer() fyne.WidgetRenderer {
+	b.ExtendBaseWidget(b)
+	blocker := &busyBlocker{shade: canvas.NewRectangle(color.Transparent)}
+	blocker.ExtendBaseWidget(blocker)
+	r := &busyOverlayRenderer{overlay: b, blocker: blocker, message: widget.NewLabel("")}
+	r.message.Alignment = fyne.TextAlignCenter
+	r.message.Wrapping = fyne.TextWrapWord
+	r.spinner = canvas.NewRaster(r.drawSpinner)
+	r.cancel = widget.NewButton("", func() {
+		if f := b.OnCancel; f != nil {
+			f()
+		}
+	})
+	r.Refresh()
+	return r
+}
+
+// IsBusy returns whether the content is dimmed and blocked.
+func (b *BusyOverlay) IsBusy() bool {
+	return b.busy
+}
+
+// SetBusy dims and blocks the content, or shows it again.
+func (b *BusyOverlay) SetBusy(busy bool) {
+	if busy == b.busy {
+		return
+	}
+	b.busy = busy
+	b.Refresh()
+}
+
+// SetMessage changes the message shown below the spinner.
+func (b *BusyOverlay) SetMessage(message string) {
+	b.Message = message
+	b.Refresh()
+}
+
+// Unbind disconnects whether the content is busy from its data source.
+func (b *BusyOverlay) Unbind() {
+	b.binder.unbind()
+	b.binder = nil
+}
+
+type busyOverlayRenderer struct {
+	overlay *BusyOverlay
+	blocker *busyBlocker
+	spinner *canvas.Raster
+	message *widget.Label
+	cancel  *widget.Button
+
+	spin  *fyne.Animation
+	angle float64
+}
+
+func (r *busyOverlayRenderer) Destroy() {
+	r.stopSpinner()
+}
+
+func (r *busyOverlayRenderer) Layout(size fyne.Size) {
+	r.overlay.Content.Resize(size)
+	r.overlay.Content.Move(fyne.NewPos(0, 0))
+	r.blocker.Resize(size)
+	r.blocker.Move(fyne.NewPos(0, 0))
+
+	pad := theme.Padding()
+	spinner := r.spinnerSize()
+	width := fyne.Min(size.Width-pad*2, fyne.Max(r.message.MinSize().Width, theme.IconInlineSize()*10))
+	var message, cancel fyne.Size
+	height := spinner
+	if r.message.Visible() {
+		message = fyne.NewSize(width, r.message.MinSize().Height)
+		height += pad + message.Height
+	}
+	if r.cancel.Visible() {
+		cancel = r.cancel.MinSize()
+		height += pad + cancel.Height
+	}
+
+	y := (size.Height - height) / 2
+	r.spinner.Resize(fyne.NewSquareSize(spinner))
+	r.spinner.Move(fyne.NewPos((size.Width-spinner)/2, y))
+	y += spinner + pad
+	if r.message.Visible() {
+		r.message.Resize(message)
+		r.message.Move(fyne.NewPos((size.Width-width)/2, y))
+		y += message.Height + pad
+	}
+	r.cancel.Resize(cancel)
+	r.cancel.Move(fyne.NewPos((size.Width-cancel.Width)/2, y))
+}
+
+func (r *busyOverlayRenderer) MinSize() fyne.Size {
+	return r.overlay.Content.MinSize()
+}
+
+func (r *busyOverlayRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.overlay.Content, r.blocker, r.spinner, r.message, r.cancel}
+}
+
+func (r *busyOverlayRenderer) Refresh() {
+	b := r.overlay
+	bg := color.NRGBAModel.Convert(theme.Color(theme.ColorNameBackground)).(color.NRGBA)
+	bg.A = 0xb0
+	r.blocker.shade.FillColor = bg
+
+	r.message.SetText(b.Message)
+	r.cancel.SetText(i18n.L("Cancel"))
+	if b.busy {
+		r.blocker.Show()
+		r.spinner.Show()
+		r.message.Hidden = b.Message == ""
+		r.cancel.Hidden = b.OnCancel == nil
+		r.startSpinner()
+	} else {
+		r.blocker.Hide()
+		r.spinner.Hide()
+		r.message.Hide()
+		r.cancel.Hide()
+		r.stopSpinner()
+	}
+	r.Layout(b.Size())
+	canvas.Refresh(b)
+}
+
+func (r *busyOverlayRenderer) drawSpinner(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	radius := float32(fyne.Min(float32(w), float32(h))) / 2
+	thickness := radius / 5
+	v := vector.NewRasterizer(w, h)
+	addArcBand(v, fyne.NewPos(float32(w)/2, float32(h)/2), r.angle, r.angle+math.Pi*3/2, radius-thickness, radius)
+	v.Draw(img, img.Bounds(), image.NewUniform(theme.Color(theme.ColorNamePrimary)), image.Point{})
+	return img
+}
+
+func (r *busyOverlayRenderer) spinnerSize() float32 {
+	return theme.IconInlineSize() * 2
+}
+
+func (r *busyOverlayRenderer) startSpinner() {
+	if r.spin != nil {
+		return
+	}
+	r.spin = fyne.NewAnimation(busySpinnerDuration, func(done float32) {
+		// turning clockwise, as angles increase anticlockwise
+		r.angle = -2 * math.Pi * float64(done)
+		r.spinner.Refresh()
+	})
+	r.spin.Curve = fyne.AnimationLinear
+	r.spin.RepeatCount = fyne.AnimationRepeatForever
+	r.spin.Start()
+}
+
+func (r *busyOverlayRenderer) stopSpinner() {
+	if r.spin != nil {
+		r.spin.Stop()
+		r.spin = nil
+	}
+}
+
+// busyBlocker dims the content of a busy overlay and takes the pointer events meant for it.
+type busyBlocker struct {
+	widget.BaseWidget
+	shade *canvas.Rectangle
+}
+
+func (b *busyBlocker) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(b.shade)
+}
+
+func (b *busyBlocker) DoubleTapped(*fyne.PointEvent) {
+}
+
+func (b *busyBlocker) DragEnd() {
+}
+
+func (b *busyBlocker) Dragged(*fyne.DragEvent) {
+}
+
+func (b *busyBlocker) MouseDown(*desktop.MouseEvent) {
+}
+
+func (b *busyBlocker) MouseIn(*desktop.MouseEvent) {
+}
+
+func (b *busyBlocker) MouseMoved(*desktop.MouseEvent) {
+}
+
+func (b *busyBlocker) MouseOut() {
+}
+
+func (b *busyBlocker) MouseUp(*desktop.MouseEvent) {
+}
+
+func (b *busyBlocker) Scrolled(*fyne.ScrollEvent) {
+}
+
+func (b *busyBlocker) Tapped(*fyne.PointEvent) {
+}
+
+func (b *busyBlocker) TappedSecondary(*fyne.PointEvent) {
+}
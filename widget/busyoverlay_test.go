@@ -0,0 +1,72 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBusyOverlay(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	tapped := 0
+	button := widget.NewButton("Save", func() { tapped++ })
+	b := WithBusyOverlay(button)
+	w := test.NewWindow(b)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(300, 200))
+	r := test.WidgetRenderer(b).(*busyOverlayRenderer)
+	assert.False(t, r.blocker.Visible())
+
+	test.TapCanvas(w.Canvas(), fyne.NewPos(10, 10))
+	assert.Equal(t, 1, tapped)
+
+	b.SetBusy(true)
+	assert.True(t, b.IsBusy())
+	assert.True(t, r.blocker.Visible())
+	assert.True(t, r.spinner.Visible())
+	assert.False(t, r.message.Visible())
+	assert.False(t, r.cancel.Visible())
+	assert.NotNil(t, r.spin)
+	test.TapCanvas(w.Canvas(), fyne.NewPos(10, 10))
+	assert.Equal(t, 1, tapped)
+
+	cancelled := false
+	b.OnCancel = func() { cancelled = true }
+	b.SetMessage("Saving…")
+	assert.True(t, r.message.Visible())
+	assert.Equal(t, "Saving…", r.message.Text)
+	assert.Less(t, r.spinner.Position().Y, r.message.Position().Y)
+	assert.Less(t, r.message.Position().Y, r.cancel.Position().Y)
+	test.Tap(r.cancel)
+	assert.True(t, cancelled)
+
+	b.SetBusy(false)
+	assert.False(t, r.blocker.Visible())
+	assert.Nil(t, r.spin)
+	test.TapCanvas(w.Canvas(), fyne.NewPos(10, 10))
+	assert.Equal(t, 2, tapped)
+}
+
+func TestBusyOverlay_Binding(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	busy := binding.NewBool()
+	b := WithBusyOverlay(widget.NewLabel("Content"))
+	b.Bind(busy)
+	_ = busy.Set(true)
+	waitForBinding()
+	assert.True(t, b.IsBusy())
+
+	b.Unbind()
+	_ = busy.Set(false)
+	waitForBinding()
+	assert.True(t, b.IsBusy())
+}
@@ -11,6 +11,8 @@ import (
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
 )
 
 // Declare conformity with Layout interface
@@ -101,63 +103,75 @@ type Calendar struct {
 	monthPrevious *widget.Button
 	monthNext     *widget.Button
 	monthLabel    *widget.Label
-
-	dates *fyne.Container
+	viewButton    *widget.Button
+
+	content *fyne.Container // of the current view
+	dates   *fyne.Container
+	week    *calendarWeek
+
+	// View is whether a month, a year or a week is shown. Call Refresh after changing it.
+	View CalendarView
+	// FirstWeekday is the day that weeks start on, the one of the region of the locale by default.
+	FirstWeekday time.Weekday
+	// System is the calendar that the dates are shown in, the Gregorian calendar if nil.
+	System CalendarSystem
+
+	// Events supplies the events shown in the days, if set. Call Refresh after they change.
+	Events EventProvider
+	// OnEventTapped is called with the day and the event when an event shown in a day is tapped.
+	OnEventTapped func(day time.Time, event CalendarEvent) `json:"-"`
+
+	// SelectionMode is whether a single day or a range of days is selected.
+	SelectionMode CalendarSelectionMode
+	// Min and Max are the first and the last days that can be selected, there is no limit if zero.
+	Min, Max time.Time
+	// DayDisabled returns whether a day can't be selected, such as a day that is fully booked.
+	DayDisabled func(day time.Time) bool `json:"-"`
+	// OnRangeSelected is called with the first and the last day of a range once it is selected.
+	OnRangeSelected func(start, end time.Time) `json:"-"`
 
 	onSelected func(time.Time)
+
+	selStart, selEnd time.Time
+	pending          bool // the start of a range is tapped, but not its end
+	dragging         bool
 }
 
 func (c *Calendar) daysOfMonth() []fyne.CanvasObject {
-	start := time.Date(c.currentTime.Year(), c.currentTime.Month(), 1, 0, 0, 0, 0, c.currentTime.Location())
+	start := c.monthStart(c.currentTime, 0)
+	end := c.monthStart(c.currentTime, 1)
 	buttons := []fyne.CanvasObject{}
 
-	//account for Go time pkg starting on sunday at index 0
-	dayIndex := int(start.Weekday())
-	if dayIndex == 0 {
-		dayIndex += daysPerWeek
-	}
-
-	//add spacers if week doesn't start on Monday
-	for i := 0; i < dayIndex-1; i++ {
+	//add spacers for the days of the first week before the month starts
+	for i := 0; i < c.weekdayColumn(start.Weekday()); i++ {
 		buttons = append(buttons, layout.NewSpacer())
 	}
 
-	for d := start; d.Month() == start.Month(); d = d.AddDate(0, 0, 1) {
-
-		dayNum := d.Day()
-		s := strconv.Itoa(dayNum)
-		b := widget.NewButton(s, func() {
-
-			selectedDate := c.dateForButton(dayNum)
-
-			c.onSelected(selectedDate)
-		})
-		b.Importance = widget.LowImportance
-
-		buttons = append(buttons, b)
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		var events []CalendarEvent
+		if c.Events != nil {
+			events = c.Events.EventsForDay(d)
+		}
+		buttons = append(buttons, newCalendarDay(c, d, events))
 	}
 
 	return buttons
 }
 
-func (c *Calendar) dateForButton(dayNum int) time.Time {
+func (c *Calendar) dateForButton(day time.Time) time.Time {
 	oldName, off := c.currentTime.Zone()
-	return time.Date(c.currentTime.Year(), c.currentTime.Month(), dayNum, c.currentTime.Hour(), c.currentTime.Minute(), 0, 0, time.FixedZone(oldName, off)).In(c.currentTime.Location())
+	return time.Date(day.Year(), day.Month(), day.Day(), c.currentTime.Hour(), c.currentTime.Minute(), 0, 0, time.FixedZone(oldName, off)).In(c.currentTime.Location())
 }
 
 func (c *Calendar) monthYear() string {
-	return c.currentTime.Format("January 2006")
+	year, month, _ := c.system().Date(c.currentTime)
+	return c.system().MonthName(year, month) + " " + strconv.Itoa(year)
 }
 
 func (c *Calendar) calendarObjects() []fyne.CanvasObject {
 	columnHeadings := []fyne.CanvasObject{}
 	for i := 0; i < daysPerWeek; i++ {
-		j := i + 1
-		if j == daysPerWeek {
-			j = 0
-		}
-
-		t := widget.NewLabel(strings.ToUpper(time.Weekday(j).String()[:3]))
+		t := widget.NewLabel(strings.ToUpper(i18n.ShortWeekdayName(c.columnWeekday(i))))
 		t.Alignment = fyne.TextAlignCenter
 		columnHeadings = append(columnHeadings, t)
 	}
@@ -169,39 +183,82 @@ func (c *Calendar) calendarObjects() []fyne.CanvasObject {
 // CreateRenderer returns a new WidgetRenderer for this widget.
 // This should not be called by regular code, it is used internally to render a widget.
 func (c *Calendar) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
 	c.monthPrevious = widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
-		c.currentTime = c.currentTime.AddDate(0, -1, 0)
-		// Dates are 'normalised', forcing date to start from the start of the month ensures move from March to February
-		c.currentTime = time.Date(c.currentTime.Year(), c.currentTime.Month(), 1, 0, 0, 0, 0, c.currentTime.Location())
-		c.monthLabel.SetText(c.monthYear())
-		c.dates.Objects = c.calendarObjects()
+		c.move(-1)
 	})
 	c.monthPrevious.Importance = widget.LowImportance
 
 	c.monthNext = widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() {
-		c.currentTime = c.currentTime.AddDate(0, 1, 0)
-		c.monthLabel.SetText(c.monthYear())
-		c.dates.Objects = c.calendarObjects()
+		c.move(1)
 	})
 	c.monthNext.Importance = widget.LowImportance
 
 	c.monthLabel = widget.NewLabel(c.monthYear())
+	c.viewButton = widget.NewButtonWithIcon("", theme.MenuDropDownIcon(), c.showViewMenu)
+	c.viewButton.Importance = widget.LowImportance
 
 	nav := container.New(layout.NewBorderLayout(nil, nil, c.monthPrevious, c.monthNext),
-		c.monthPrevious, c.monthNext, container.NewCenter(c.monthLabel))
-
-	c.dates = container.New(newCalendarLayout(), c.calendarObjects()...)
+		c.monthPrevious, c.monthNext, container.NewCenter(container.NewHBox(c.monthLabel, c.viewButton)))
 
-	dateContainer := container.NewBorder(nav, nil, nil, nil, c.dates)
+	c.dates = container.New(newCalendarLayout())
+	c.content = container.NewStack()
+	c.update()
+	dateContainer := container.NewBorder(nav, nil, nil, nil, c.content)
 
 	return widget.NewSimpleRenderer(dateContainer)
 }
 
+// Refresh updates the days of the calendar and their events.
+func (c *Calendar) Refresh() {
+	if c.content != nil {
+		c.update()
+	}
+	c.BaseWidget.Refresh()
+}
+
+// update shows the current view of the period of the current time.
+func (c *Calendar) update() {
+	c.monthLabel.SetText(c.periodLabel())
+	switch c.View {
+	case CalendarYearView:
+		c.content.Objects = []fyne.CanvasObject{c.yearGrid()}
+	case CalendarWeekView:
+		if c.week == nil {
+			c.week = newCalendarWeek(c)
+		}
+		c.week.update()
+		c.content.Objects = []fyne.CanvasObject{c.week}
+	default:
+		c.dates.Objects = c.calendarObjects()
+		c.dates.Refresh()
+		c.content.Objects = []fyne.CanvasObject{c.dates}
+	}
+	c.content.Refresh()
+	c.updateNav()
+}
+
+// updateNav disables moving to the periods before Min or after Max.
+func (c *Calendar) updateNav() {
+	first, last := c.period()
+	if !c.Min.IsZero() && first.AddDate(0, 0, -1).Before(c.day(c.Min)) {
+		c.monthPrevious.Disable()
+	} else {
+		c.monthPrevious.Enable()
+	}
+	if !c.Max.IsZero() && last.AddDate(0, 0, 1).After(c.day(c.Max)) {
+		c.monthNext.Disable()
+	} else {
+		c.monthNext.Enable()
+	}
+}
+
 // NewCalendar creates a calendar instance
 func NewCalendar(cT time.Time, onSelected func(time.Time)) *Calendar {
 	c := &Calendar{
-		currentTime: cT,
-		onSelected:  onSelected,
+		currentTime:  cT,
+		onSelected:   onSelected,
+		FirstWeekday: i18n.FirstWeekday(i18n.Locale()),
 	}
 
 	c.ExtendBaseWidget(c)
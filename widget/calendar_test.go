@@ -1,14 +1,18 @@
 package widget
 
 import (
+	"image/color"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 )
 
@@ -32,9 +36,9 @@ func TestNewCalendar_ButtonDate(t *testing.T) {
 	last := endNextMonth.AddDate(0, 0, -1)
 
 	firstDate := firstDateButton(c.dates)
-	assert.Equal(t, "1", firstDate.Text)
-	lastDate := c.dates.Objects[len(c.dates.Objects)-1].(*widget.Button)
-	assert.Equal(t, strconv.Itoa(last.Day()), lastDate.Text)
+	assert.Equal(t, "1", dayText(firstDate))
+	lastDate := c.dates.Objects[len(c.dates.Objects)-1].(*calendarDay)
+	assert.Equal(t, strconv.Itoa(last.Day()), dayText(lastDate))
 }
 
 func TestNewCalendar_Next(t *testing.T) {
@@ -84,12 +88,335 @@ func TestNewCalendar_Resize(t *testing.T) {
 	assert.Greater(t, layout.cellSize.Height, min.Height)
 }
 
-func firstDateButton(c *fyne.Container) *widget.Button {
+func firstDateButton(c *fyne.Container) *calendarDay {
 	for _, b := range c.Objects {
-		if nonBlank, ok := b.(*widget.Button); ok {
+		if nonBlank, ok := b.(*calendarDay); ok {
 			return nonBlank
 		}
 	}
 
 	return nil
 }
+
+func dayText(d *calendarDay) string {
+	return test.WidgetRenderer(d).(*calendarDayRenderer).number.Text
+}
+
+type testEvents map[int][]CalendarEvent
+
+func (e testEvents) EventsForDay(day time.Time) []CalendarEvent {
+	return e[day.Day()]
+}
+
+func TestCalendar_Events(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	var selected time.Time
+	c := NewCalendar(date, func(d time.Time) { selected = d })
+	c.Events = testEvents{
+		5:  {{Label: "Dentist", Color: color.NRGBA{R: 0xff, A: 0xff}, Data: 42}},
+		12: {{Label: "Standup"}, {Label: "Review"}, {Label: "Retro"}, {Label: "Demo"}, {Label: "Party"}},
+	}
+	var tappedDay time.Time
+	var tapped CalendarEvent
+	c.OnEventTapped = func(day time.Time, e CalendarEvent) {
+		tappedDay, tapped = day, e
+	}
+	w := test.NewWindow(c)
+	defer w.Close()
+	w.Resize(fyne.NewSize(700, 600))
+
+	day5 := calendarDayOf(c, 5)
+	r := test.WidgetRenderer(day5).(*calendarDayRenderer)
+	require.Len(t, r.labels, 1)
+	assert.True(t, r.labels[0].Visible())
+	assert.False(t, r.dots[0].Visible())
+	assert.Equal(t, "Dentist", r.labels[0].Text)
+
+	test.TapAt(day5, r.pills[0].Position().AddXY(2, 2))
+	assert.Equal(t, 42, tapped.Data)
+	assert.Equal(t, 5, tappedDay.Day())
+	assert.True(t, selected.IsZero())
+
+	test.TapAt(day5, r.number.Position().AddXY(2, 2))
+	assert.Equal(t, 5, selected.Day())
+
+	// too many events for the labels to fit are shown as dots
+	r = test.WidgetRenderer(calendarDayOf(c, 12)).(*calendarDayRenderer)
+	assert.Len(t, r.dots, calendarMaxDots)
+	assert.True(t, r.dots[0].Visible())
+	assert.False(t, r.labels[0].Visible())
+	assert.Equal(t, -1, calendarDayOf(c, 12).eventAt(fyne.NewPos(0, 0)))
+}
+
+func TestEllipsize(t *testing.T) {
+	size := theme.CaptionTextSize()
+	assert.Equal(t, "Meeting", ellipsize("Meeting", size, 1000))
+	short := ellipsize("A very long meeting title", size, 40)
+	assert.True(t, strings.HasSuffix(short, "…"))
+	assert.LessOrEqual(t, fyne.MeasureText(short, size, fyne.TextStyle{}).Width, float32(40))
+	assert.Equal(t, "", ellipsize("Meeting", size, 1))
+}
+
+func calendarDayOf(c *Calendar, day int) *calendarDay {
+	for _, o := range c.dates.Objects {
+		if d, ok := o.(*calendarDay); ok && d.day.Day() == day {
+			return d
+		}
+	}
+	return nil
+}
+
+func TestCalendar_RangeTwoTaps(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	c := NewCalendar(date, nil)
+	c.SelectionMode = CalendarRange
+	var start, end time.Time
+	c.OnRangeSelected = func(s, e time.Time) {
+		start, end = s, e
+	}
+	w := test.NewWindow(c)
+	defer w.Close()
+	w.Resize(fyne.NewSize(400, 400))
+
+	test.Tap(calendarDayOf(c, 20))
+	assert.True(t, start.IsZero(), "the range is not selected until its end is tapped")
+	s, e := c.Range()
+	assert.Equal(t, 20, s.Day())
+	assert.Equal(t, 20, e.Day())
+
+	test.Tap(calendarDayOf(c, 10))
+	assert.Equal(t, time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC), end)
+
+	r := test.WidgetRenderer(calendarDayOf(c, 15)).(*calendarDayRenderer)
+	assert.Equal(t, theme.Color(theme.ColorNameSelection), r.bg.FillColor)
+	r = test.WidgetRenderer(calendarDayOf(c, 10)).(*calendarDayRenderer)
+	assert.Equal(t, theme.Color(theme.ColorNamePrimary), r.bg.FillColor)
+
+	// a third tap starts a new range
+	test.Tap(calendarDayOf(c, 25))
+	s, e = c.Range()
+	assert.Equal(t, 25, s.Day())
+	assert.Equal(t, 25, e.Day())
+}
+
+func TestCalendar_RangeDrag(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCalendar(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), nil)
+	c.SelectionMode = CalendarRange
+	var start, end time.Time
+	c.OnRangeSelected = func(s, e time.Time) {
+		start, end = s, e
+	}
+	w := test.NewWindow(c)
+	defer w.Close()
+	w.Resize(fyne.NewSize(400, 400))
+
+	from, to := calendarDayOf(c, 4), calendarDayOf(c, 13)
+	offset := to.Position().Subtract(from.Position()).AddXY(5, 5)
+	from.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: offset}})
+	from.DragEnd()
+	assert.Equal(t, 4, start.Day())
+	assert.Equal(t, 13, end.Day())
+}
+
+func TestCalendar_Constraints(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCalendar(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), nil)
+	c.SelectionMode = CalendarRange
+	c.Min = time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	c.Max = time.Date(2024, time.March, 28, 0, 0, 0, 0, time.UTC)
+	c.DayDisabled = func(day time.Time) bool {
+		return day.Day() == 15
+	}
+	selected := false
+	c.OnRangeSelected = func(time.Time, time.Time) {
+		selected = true
+	}
+	w := test.NewWindow(c)
+	defer w.Close()
+	w.Resize(fyne.NewSize(400, 400))
+
+	assert.True(t, c.monthPrevious.Disabled())
+	assert.True(t, c.monthNext.Disabled())
+
+	test.Tap(calendarDayOf(c, 4))
+	start, _ := c.Range()
+	assert.True(t, start.IsZero(), "days before Min can't be selected")
+
+	r := test.WidgetRenderer(calendarDayOf(c, 15)).(*calendarDayRenderer)
+	assert.Equal(t, theme.Color(theme.ColorNameDisabled), r.number.Color)
+
+	// a range over a disabled day starts a new range instead
+	test.Tap(calendarDayOf(c, 10))
+	test.Tap(calendarDayOf(c, 20))
+	assert.False(t, selected)
+	start, _ = c.Range()
+	assert.Equal(t, 20, start.Day())
+
+	test.Tap(calendarDayOf(c, 28))
+	assert.True(t, selected)
+
+	c.SetRange(time.Date(2024, time.March, 7, 0, 0, 0, 0, time.Local), time.Date(2024, time.March, 6, 0, 0, 0, 0, time.Local))
+	start, end := c.Range()
+	assert.Equal(t, time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC), end)
+}
+
+func TestCalendar_YearView(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCalendar(time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC), nil)
+	c.Max = time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+	w := test.NewWindow(c)
+	defer w.Close()
+
+	c.SetView(CalendarYearView)
+	assert.Equal(t, "2024", c.monthLabel.Text)
+	assert.True(t, c.monthNext.Disabled())
+	test.Tap(c.monthPrevious)
+	assert.Equal(t, "2023", c.monthLabel.Text)
+	test.Tap(c.monthNext)
+
+	months := c.content.Objects[0].(*fyne.Container).Objects
+	require.Len(t, months, 12)
+	assert.Equal(t, widget.HighImportance, months[2].(*widget.Button).Importance)
+	assert.True(t, months[10].(*widget.Button).Disabled())
+
+	test.Tap(months[6].(*widget.Button))
+	assert.Equal(t, CalendarMonthView, c.View)
+	assert.Equal(t, "July 2024", c.monthLabel.Text)
+	assert.Equal(t, c.dates, c.content.Objects[0])
+}
+
+func TestCalendar_WeekView(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	date := time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC)
+	var selected time.Time
+	c := NewCalendar(date, func(d time.Time) { selected = d })
+	c.View = CalendarWeekView
+	c.FirstWeekday = time.Monday
+	c.Events = testEvents{
+		1: {{Label: "Holiday"}, {Label: "Standup", Start: time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC),
+			End: time.Date(2024, time.March, 1, 9, 30, 0, 0, time.UTC), Data: 7}},
+	}
+	var tapped CalendarEvent
+	c.OnEventTapped = func(_ time.Time, e CalendarEvent) {
+		tapped = e
+	}
+	w := test.NewWindow(c)
+	defer w.Close()
+	w.Resize(fyne.NewSize(700, 600))
+
+	assert.Equal(t, "26 February – 3 March 2024", c.monthLabel.Text)
+	require.Len(t, c.week.days, daysPerWeek)
+	assert.Equal(t, "26", dayText(c.week.days[0]))
+	friday := c.week.days[4]
+	assert.Len(t, friday.events, 1, "only all day events are shown in the days")
+	assert.Equal(t, "Holiday", friday.events[0].Label)
+
+	require.Len(t, c.week.hours.eventAreas, 1)
+	area := c.week.hours.eventAreas[0]
+	assert.Equal(t, 4, area.day)
+	row := c.week.hours.rowHeight()
+	assert.Equal(t, row*9, area.min.Y)
+	assert.InDelta(t, row/2-1, area.max.Y-area.min.Y, 0.01)
+	test.TapAt(c.week.hours, area.min.AddXY(2, 2))
+	assert.Equal(t, 7, tapped.Data)
+
+	test.TapAt(c.week.hours, fyne.NewPos(area.min.X, row*12))
+	assert.Equal(t, 1, selected.Day())
+	assert.Equal(t, time.March, selected.Month())
+	test.Tap(c.week.days[1])
+	start, _ := c.Range()
+	assert.Equal(t, 27, start.Day())
+
+	test.Tap(c.monthNext)
+	assert.Equal(t, "4 – 10 March 2024", c.monthLabel.Text)
+}
+
+func TestEventLanes(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2024, time.March, 1, h, 0, 0, 0, time.UTC)
+	}
+	lanes, count := eventLanes([]CalendarEvent{
+		{Start: at(10), End: at(12)},
+		{Start: at(9)},
+		{Start: at(11)},
+		{Start: at(12)},
+	})
+	assert.Equal(t, []int{0, 0, 1, 0}, lanes)
+	assert.Equal(t, 2, count)
+}
+
+func TestCalendar_FirstWeekday(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCalendar(time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC), nil)
+	c.FirstWeekday = time.Sunday
+	_ = test.WidgetRenderer(c)
+	assert.Equal(t, "SUN", c.dates.Objects[0].(*widget.Label).Text)
+	assert.Equal(t, "1", dayText(c.dates.Objects[daysPerWeek+5].(*calendarDay)), "1 March 2024 is a Friday")
+
+	c.FirstWeekday = time.Saturday
+	c.SetView(CalendarWeekView)
+	assert.Equal(t, "9 – 15 March 2024", c.monthLabel.Text)
+}
+
+func TestCalendar_System(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCalendar(time.Date(2024, time.March, 21, 0, 0, 0, 0, time.UTC), nil)
+	c.System = PersianSystem{}
+	c.FirstWeekday = time.Saturday
+	_ = test.WidgetRenderer(c)
+	c.Refresh()
+	assert.Equal(t, "Farvardin 1403", c.monthLabel.Text)
+	first := firstDateButton(c.dates)
+	assert.Equal(t, "1", dayText(first))
+	assert.Equal(t, time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC), first.day)
+	assert.Equal(t, 31, len(c.dayCells()))
+
+	test.Tap(c.monthPrevious)
+	assert.Equal(t, "Esfand 1402", c.monthLabel.Text)
+	assert.Equal(t, 29, len(c.dayCells()))
+
+	c.System = BuddhistSystem{}
+	c.SetView(CalendarYearView)
+	assert.Equal(t, "2567", c.monthLabel.Text)
+}
+
+func TestPersianSystem(t *testing.T) {
+	p := PersianSystem{}
+	for _, tt := range []struct {
+		date             time.Time
+		year, month, day int
+	}{
+		{time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC), 1403, 1, 1},
+		{time.Date(2024, time.March, 19, 23, 0, 0, 0, time.UTC), 1402, 12, 29},
+		{time.Date(2023, time.March, 21, 0, 0, 0, 0, time.UTC), 1402, 1, 1},
+		{time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC), 1403, 12, 30},
+		{time.Date(2024, time.September, 22, 0, 0, 0, 0, time.UTC), 1403, 7, 1},
+		{time.Date(1979, time.February, 11, 0, 0, 0, 0, time.UTC), 1357, 11, 22},
+	} {
+		y, m, d := p.Date(tt.date)
+		assert.Equal(t, []int{tt.year, tt.month, tt.day}, []int{y, m, d}, tt.date.String())
+		back := p.Time(y, m, d, time.UTC)
+		assert.Equal(t, tt.date.Truncate(24*time.Hour), back)
+	}
+}
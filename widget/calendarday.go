@@ -0,0 +1,251 @@
+package widget
+
+import (
+	"image/color"
+	"strconv"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Tappable = (*calendarDay)(nil)
+var _ desktop.Hoverable = (*calendarDay)(nil)
+var _ fyne.Draggable = (*calendarDay)(nil)
+
+// the most dots shown in a day that is too small for the labels of its events
+const calendarMaxDots = 4
+
+// CalendarEvent is something that happens on a day of a Calendar.
+type CalendarEvent struct {
+	// Label is shown in the day when there is room for it, else the event is a dot.
+	Label string
+	// Color is the color of the dot or label, the primary color if nil.
+	Color color.Color
+	// Data is any value of the app, such as the ID of the event.
+	Data interface{}
+	// Start and End are the times of the event, which is shown in the hourly rows of the week view.
+	// An event with no Start lasts all day, and one with no End lasts an hour.
+	Start, End time.Time
+}
+
+// EventProvider supplies the events that a Calendar shows in its days.
+type EventProvider interface {
+	// EventsForDay returns the events of a day, given at midnight.
+	EventsForDay(day time.Time) []CalendarEvent
+}
+
+// calendarDay is the cell of a day of a Calendar, with the dots or labels of its events.
+type calendarDay struct {
+	widget.BaseWidget
+	cal    *Calendar
+	day    time.Time // at midnight
+	events []CalendarEvent
+
+	hovered    bool
+	eventAreas []fyne.Position // the top left and bottom right corners of each event shown
+}
+
+func newCalendarDay(cal *Calendar, day time.Time, events []CalendarEvent) *calendarDay {
+	d := &calendarDay{cal: cal, day: day, events: events}
+	d.ExtendBaseWidget(d)
+	return d
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (d *calendarDay) CreateRenderer() fyne.WidgetRenderer {
+	d.ExtendBaseWidget(d)
+	number := canvas.NewText(strconv.Itoa(d.cal.dayNumber(d.day)), theme.Color(theme.ColorNameForeground))
+	number.Alignment = fyne.TextAlignCenter
+	r := &calendarDayRenderer{d: d, bg: canvas.NewRectangle(color.Transparent), number: number}
+	r.Refresh()
+	return r
+}
+
+// Dragged selects the range from this day to the day being dragged over, in the range selection mode.
+func (d *calendarDay) Dragged(ev *fyne.DragEvent) {
+	d.cal.dragged(d.day, d.Position().Add(ev.Position))
+}
+
+// DragEnd completes the range being dragged.
+func (d *calendarDay) DragEnd() {
+	d.cal.dragEnd()
+}
+
+// MouseIn highlights the day.
+func (d *calendarDay) MouseIn(*desktop.MouseEvent) {
+	d.hovered = true
+	d.Refresh()
+}
+
+// MouseMoved is called when the mouse moves over the day.
+func (d *calendarDay) MouseMoved(*desktop.MouseEvent) {
+}
+
+// MouseOut removes the highlight of the day.
+func (d *calendarDay) MouseOut() {
+	d.hovered = false
+	d.Refresh()
+}
+
+// Tapped calls OnEventTapped of the calendar for the event at the position if there is one,
+// otherwise it selects the day.
+func (d *calendarDay) Tapped(ev *fyne.PointEvent) {
+	if i := d.eventAt(ev.Position); i >= 0 && d.cal.OnEventTapped != nil {
+		d.cal.OnEventTapped(d.cal.dateForButton(d.day), d.events[i])
+		return
+	}
+	d.cal.dayTapped(d.day)
+}
+
+// eventAt returns the index of the event shown at a position, or -1.
+func (d *calendarDay) eventAt(pos fyne.Position) int {
+	for i := 0; i+1 < len(d.eventAreas); i += 2 {
+		min, max := d.eventAreas[i], d.eventAreas[i+1]
+		if pos.X >= min.X && pos.X <= max.X && pos.Y >= min.Y && pos.Y <= max.Y {
+			return i / 2
+		}
+	}
+	return -1
+}
+
+type calendarDayRenderer struct {
+	d      *calendarDay
+	bg     *canvas.Rectangle
+	number *canvas.Text
+
+	dots   []*canvas.Circle
+	pills  []*canvas.Rectangle
+	labels []*canvas.Text
+}
+
+func (r *calendarDayRenderer) Destroy() {
+}
+
+func (r *calendarDayRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	pad := theme.Padding()
+	numberSize := r.number.MinSize()
+	r.number.Move(fyne.NewPos(0, pad))
+	r.number.Resize(fyne.NewSize(size.Width, numberSize.Height))
+
+	r.d.eventAreas = r.d.eventAreas[:0]
+	top := pad + numberSize.Height
+	lineHeight := fyne.MeasureText("Ag", theme.CaptionTextSize(), fyne.TextStyle{}).Height + 2
+	showLabels := len(r.labels) > 0 && size.Height-top-pad >= lineHeight*float32(len(r.labels))
+	for i, l := range r.labels {
+		l.Hidden = !showLabels
+		r.pills[i].Hidden = !showLabels
+		if !showLabels {
+			continue
+		}
+		pos := fyne.NewPos(pad/2, top+lineHeight*float32(i))
+		pill := fyne.NewSize(size.Width-pad, lineHeight-1)
+		r.pills[i].Move(pos)
+		r.pills[i].Resize(pill)
+		l.Text = ellipsize(r.d.events[i].Label, l.TextSize, pill.Width-pad)
+		l.Move(pos.AddXY(pad/2, 0))
+		l.Resize(fyne.NewSize(pill.Width-pad, pill.Height))
+		r.d.eventAreas = append(r.d.eventAreas, pos, pos.Add(pill))
+	}
+
+	dot := theme.Padding() * 1.5
+	gap := dot / 2
+	width := float32(len(r.dots))*(dot+gap) - gap
+	x := (size.Width - width) / 2
+	y := top + (fyne.Min(size.Height-top, lineHeight)-dot)/2
+	for i, c := range r.dots {
+		c.Hidden = showLabels
+		if showLabels {
+			continue
+		}
+		pos := fyne.NewPos(x+float32(i)*(dot+gap), y)
+		c.Move(pos)
+		c.Resize(fyne.NewSquareSize(dot))
+		// a dot is small, so tapping near it is enough
+		r.d.eventAreas = append(r.d.eventAreas, pos.SubtractXY(gap/2, dot), pos.AddXY(dot+gap/2, dot*2))
+	}
+}
+
+func (r *calendarDayRenderer) MinSize() fyne.Size {
+	return r.number.MinSize().AddWidthHeight(theme.Padding()*2, theme.Padding()*2)
+}
+
+func (r *calendarDayRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.bg, r.number}
+	for i := range r.labels {
+		objects = append(objects, r.pills[i], r.labels[i])
+	}
+	for _, c := range r.dots {
+		objects = append(objects, c)
+	}
+	return objects
+}
+
+func (r *calendarDayRenderer) Refresh() {
+	d := r.d
+	end, between := d.cal.dayState(d.day)
+	r.bg.FillColor = color.Transparent
+	r.number.Color = theme.Color(theme.ColorNameForeground)
+	switch {
+	case !d.cal.selectable(d.day):
+		r.number.Color = theme.Color(theme.ColorNameDisabled)
+	case end:
+		r.bg.FillColor = theme.Color(theme.ColorNamePrimary)
+		r.number.Color = theme.Color(theme.ColorNameForegroundOnPrimary)
+	case between:
+		r.bg.FillColor = theme.Color(theme.ColorNameSelection)
+	case d.hovered:
+		r.bg.FillColor = theme.Color(theme.ColorNameHover)
+	}
+	r.bg.CornerRadius = theme.InputRadiusSize()
+	r.number.Text = strconv.Itoa(d.cal.dayNumber(d.day))
+	r.number.TextSize = theme.TextSize()
+
+	r.pills, r.labels, r.dots = r.pills[:0], r.labels[:0], r.dots[:0]
+	for i, e := range d.events {
+		c := e.Color
+		if c == nil {
+			c = theme.Color(theme.ColorNamePrimary)
+		}
+		pill := canvas.NewRectangle(calendarEventFill(c))
+		pill.CornerRadius = theme.InputRadiusSize()
+		label := canvas.NewText(e.Label, theme.Color(theme.ColorNameForeground))
+		label.TextSize = theme.CaptionTextSize()
+		r.pills = append(r.pills, pill)
+		r.labels = append(r.labels, label)
+		if i < calendarMaxDots {
+			r.dots = append(r.dots, canvas.NewCircle(c))
+		}
+	}
+
+	r.Layout(d.Size())
+	canvas.Refresh(d)
+}
+
+// calendarEventFill returns the color of the background of the label of an event, light enough for the text.
+func calendarEventFill(c color.Color) color.Color {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	n.A = 0x50
+	return n
+}
+
+// ellipsize shortens text with an ellipsis until it fits in a width at a text size.
+func ellipsize(text string, size, width float32) string {
+	if fyne.MeasureText(text, size, fyne.TextStyle{}).Width <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if s := string(runes) + "…"; fyne.MeasureText(s, size, fyne.TextStyle{}).Width <= width {
+			return s
+		}
+	}
+	return ""
+}
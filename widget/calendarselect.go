@@ -0,0 +1,185 @@
+package widget
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// CalendarSelectionMode is how the days of a Calendar are selected.
+type CalendarSelectionMode int
+
+const (
+	// CalendarSingleDay selects the day that is tapped, this is the default.
+	CalendarSingleDay CalendarSelectionMode = iota
+	// CalendarRange selects the days from a start to an end, by tapping both or by dragging from one to the other.
+	CalendarRange
+)
+
+// SetRange selects the days from start to end, which are swapped if given in the wrong order.
+// A zero start clears the selection.
+func (c *Calendar) SetRange(start, end time.Time) {
+	if start.IsZero() {
+		c.selStart, c.selEnd = time.Time{}, time.Time{}
+	} else {
+		if end.IsZero() {
+			end = start
+		}
+		c.selStart, c.selEnd = orderDays(c.day(start), c.day(end))
+	}
+	c.pending = false
+	c.refreshDays()
+}
+
+// Range returns the first and the last day selected, which are the same for a single day
+// and zero if no day is selected.
+func (c *Calendar) Range() (start, end time.Time) {
+	if c.pending {
+		return c.selStart, c.selStart
+	}
+	return orderDays(c.selStart, c.selEnd)
+}
+
+// selectable returns whether a day can be selected, within Min and Max and not disabled.
+func (c *Calendar) selectable(day time.Time) bool {
+	if !c.Min.IsZero() && day.Before(truncateDay(c.Min)) {
+		return false
+	}
+	if !c.Max.IsZero() && day.After(truncateDay(c.Max)) {
+		return false
+	}
+	return c.DayDisabled == nil || !c.DayDisabled(day)
+}
+
+// rangeSelectable returns whether each day from start to end can be selected.
+func (c *Calendar) rangeSelectable(start, end time.Time) bool {
+	start, end = orderDays(start, end)
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if !c.selectable(d) {
+			return false
+		}
+	}
+	return true
+}
+
+// dayTapped selects a day: in range mode the first tap starts a range and the second ends it.
+func (c *Calendar) dayTapped(day time.Time) {
+	if !c.selectable(day) {
+		return
+	}
+	if c.SelectionMode == CalendarRange && c.pending && c.rangeSelectable(c.selStart, day) {
+		c.selEnd = day
+		c.pending = false
+		c.refreshDays()
+		c.rangeSelected()
+	} else {
+		c.selStart, c.selEnd = day, day
+		c.pending = c.SelectionMode == CalendarRange
+		c.refreshDays()
+	}
+
+	if c.onSelected != nil {
+		c.onSelected(c.dateForButton(day))
+	}
+}
+
+// dragged extends the range being dragged from a day to the day under a position of the dates.
+func (c *Calendar) dragged(from time.Time, pos fyne.Position) {
+	if c.SelectionMode != CalendarRange {
+		return
+	}
+	if !c.dragging {
+		if !c.selectable(from) {
+			return
+		}
+		c.dragging = true
+		c.selStart, c.selEnd = from, from
+		c.pending = false
+	}
+	if over := c.dayAt(pos); over != nil && c.rangeSelectable(c.selStart, over.day) {
+		c.selEnd = over.day
+	}
+	c.refreshDays()
+}
+
+func (c *Calendar) dragEnd() {
+	if !c.dragging {
+		return
+	}
+	c.dragging = false
+	c.rangeSelected()
+}
+
+func (c *Calendar) rangeSelected() {
+	start, end := c.Range()
+	if f := c.OnRangeSelected; f != nil {
+		f(start, end)
+	}
+}
+
+// dayAt returns the day shown at a position of the dates, or nil.
+func (c *Calendar) dayAt(pos fyne.Position) *calendarDay {
+	for _, d := range c.dayCells() {
+		p, s := d.Position(), d.Size()
+		if pos.X >= p.X && pos.X < p.X+s.Width && pos.Y >= p.Y && pos.Y < p.Y+s.Height {
+			return d
+		}
+	}
+	return nil
+}
+
+// dayState returns how a day is selected: whether it starts or ends the selection, or is between them.
+func (c *Calendar) dayState(day time.Time) (end, between bool) {
+	start, last := c.Range()
+	if start.IsZero() {
+		return false, false
+	}
+	if day.Equal(start) || day.Equal(last) {
+		return true, false
+	}
+	return false, day.After(start) && day.Before(last)
+}
+
+func (c *Calendar) refreshDays() {
+	for _, d := range c.dayCells() {
+		d.Refresh()
+	}
+	if c.View == CalendarWeekView && c.week != nil {
+		c.week.hours.Refresh()
+	}
+}
+
+// dayCells returns the cells of the days that the current view shows.
+func (c *Calendar) dayCells() []*calendarDay {
+	switch c.View {
+	case CalendarWeekView:
+		if c.week == nil {
+			return nil
+		}
+		return c.week.days
+	case CalendarMonthView:
+		if c.dates == nil {
+			return nil
+		}
+		var days []*calendarDay
+		for _, o := range c.dates.Objects {
+			if d, ok := o.(*calendarDay); ok {
+				days = append(days, d)
+			}
+		}
+		return days
+	}
+	return nil
+}
+
+// day returns the midnight of the date of t in the location of the calendar.
+func (c *Calendar) day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.currentTime.Location())
+}
+
+func orderDays(a, b time.Time) (time.Time, time.Time) {
+	if b.Before(a) {
+		return b, a
+	}
+	return a, b
+}
@@ -0,0 +1,190 @@
+package widget
+
+import (
+	"time"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ CalendarSystem = GregorianSystem{}
+var _ CalendarSystem = BuddhistSystem{}
+var _ CalendarSystem = PersianSystem{}
+
+// CalendarSystem converts between times and the dates of a calendar, so that a Calendar can show
+// calendars other than the Gregorian one, such as the Persian, Hebrew or Buddhist calendars.
+type CalendarSystem interface {
+	// Date returns the year, the month from 1 and the day from 1 of a time, in its location.
+	Date(t time.Time) (year, month, day int)
+	// Time returns the midnight of a date in a location.
+	Time(year, month, day int, loc *time.Location) time.Time
+	// MonthsInYear returns how many months a year has, such as 13 in the leap years of the Hebrew calendar.
+	MonthsInYear(year int) int
+	// MonthName returns the name of a month of a year in the current locale.
+	MonthName(year, month int) string
+}
+
+// GregorianSystem is the Gregorian calendar, which a Calendar shows by default.
+type GregorianSystem struct{}
+
+// Date returns the year, the month from 1 and the day from 1 of a time, in its location.
+func (GregorianSystem) Date(t time.Time) (year, month, day int) {
+	y, m, d := t.Date()
+	return y, int(m), d
+}
+
+// Time returns the midnight of a date in a location.
+func (GregorianSystem) Time(year, month, day int, loc *time.Location) time.Time {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+// MonthsInYear returns 12.
+func (GregorianSystem) MonthsInYear(int) int {
+	return 12
+}
+
+// MonthName returns the name of a month in the current locale.
+func (GregorianSystem) MonthName(_, month int) string {
+	return i18n.MonthName(time.Month(month))
+}
+
+// the years that the Buddhist era is ahead of the common era
+const buddhistEraOffset = 543
+
+// BuddhistSystem is the Thai solar calendar, which has the months of the Gregorian calendar
+// and counts years in the Buddhist era.
+type BuddhistSystem struct{}
+
+// Date returns the year, the month from 1 and the day from 1 of a time, in its location.
+func (BuddhistSystem) Date(t time.Time) (year, month, day int) {
+	y, m, d := t.Date()
+	return y + buddhistEraOffset, int(m), d
+}
+
+// Time returns the midnight of a date in a location.
+func (BuddhistSystem) Time(year, month, day int, loc *time.Location) time.Time {
+	return time.Date(year-buddhistEraOffset, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+// MonthsInYear returns 12.
+func (BuddhistSystem) MonthsInYear(int) int {
+	return 12
+}
+
+// MonthName returns the name of a month in the current locale.
+func (BuddhistSystem) MonthName(_, month int) string {
+	return i18n.MonthName(time.Month(month))
+}
+
+var persianMonths = []string{"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar",
+	"Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand"}
+
+// the years that the leap cycles of the Persian calendar change at
+var persianBreaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192,
+	2262, 2324, 2394, 2456, 3178}
+
+// PersianSystem is the Solar Hijri calendar used in Iran and Afghanistan, valid until the year 3177.
+// Its months have 31 days for the first six, 30 for the next five and 29 or 30 for the last.
+// Month names are the English transliterations, which can be translated with the i18n package.
+type PersianSystem struct{}
+
+// Date returns the year, the month from 1 and the day from 1 of a time, in its location.
+func (PersianSystem) Date(t time.Time) (year, month, day int) {
+	day0 := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	year = t.Year() - 621
+	start := persianNewYear(year)
+	if day0.Before(start) {
+		year--
+		start = persianNewYear(year)
+	}
+
+	days := int(day0.Sub(start).Hours()+12) / 24
+	if days < 6*31 {
+		return year, days/31 + 1, days%31 + 1
+	}
+	days -= 6 * 31
+	return year, days/30 + 7, days%30 + 1
+}
+
+// Time returns the midnight of a date in a location.
+func (PersianSystem) Time(year, month, day int, loc *time.Location) time.Time {
+	days := (month-1)*31 + day - 1
+	if month > 6 {
+		days = 6*31 + (month-7)*30 + day - 1
+	}
+	start := persianNewYear(year)
+	return time.Date(start.Year(), start.Month(), start.Day()+days, 0, 0, 0, 0, loc)
+}
+
+// MonthsInYear returns 12.
+func (PersianSystem) MonthsInYear(int) int {
+	return 12
+}
+
+// MonthName returns the name of a month in the current locale.
+func (PersianSystem) MonthName(_, month int) string {
+	return i18n.L(persianMonths[month-1])
+}
+
+// persianNewYear returns the Gregorian date of the first day of a Persian year, Nowruz, in UTC.
+// This is the algorithm of Kazimierz M. Borkowski, as used by jalaali-js.
+func persianNewYear(year int) time.Time {
+	gregorian := year + 621
+	leapJ, jp, jump := -14, persianBreaks[0], 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gregorian/4 - (gregorian/100+1)*3/4 - 150
+	return time.Date(gregorian, time.March, 20+leapJ-leapG, 0, 0, 0, 0, time.UTC)
+}
+
+func (c *Calendar) system() CalendarSystem {
+	if c.System == nil {
+		return GregorianSystem{}
+	}
+	return c.System
+}
+
+// monthStart returns the midnight of the first day of the month of t, moved by a number of months.
+func (c *Calendar) monthStart(t time.Time, months int) time.Time {
+	s := c.system()
+	year, month, _ := s.Date(t)
+	month += months
+	for month < 1 {
+		year--
+		month += s.MonthsInYear(year)
+	}
+	for month > s.MonthsInYear(year) {
+		month -= s.MonthsInYear(year)
+		year++
+	}
+	return s.Time(year, month, 1, t.Location())
+}
+
+// yearStart returns the midnight of the first day of the year of t, moved by a number of years.
+func (c *Calendar) yearStart(t time.Time, years int) time.Time {
+	year, _, _ := c.system().Date(t)
+	return c.system().Time(year+years, 1, 1, t.Location())
+}
+
+// dayNumber returns the day of the month of a day in the calendar system.
+func (c *Calendar) dayNumber(day time.Time) int {
+	_, _, d := c.system().Date(day)
+	return d
+}
+
+// monthName returns the name of the month of a day in the calendar system.
+func (c *Calendar) monthName(day time.Time) string {
+	year, month, _ := c.system().Date(day)
+	return c.system().MonthName(year, month)
+}
@@ -0,0 +1,150 @@
+package widget
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// CalendarView is the period of time that a Calendar shows.
+type CalendarView int
+
+const (
+	// CalendarMonthView shows the days of a month, this is the default.
+	CalendarMonthView CalendarView = iota
+	// CalendarYearView shows the months of a year, tapping one shows its days.
+	CalendarYearView
+	// CalendarWeekView shows the days of a week above hourly rows with the events that have a time.
+	CalendarWeekView
+)
+
+// the columns of the months of the year view
+const calendarYearColumns = 3
+
+// SetView shows a month, a year or a week of the calendar, around the current date.
+func (c *Calendar) SetView(view CalendarView) {
+	c.View = view
+	c.Refresh()
+}
+
+// move shows the month, year or week before or after the one shown, by a number of them.
+func (c *Calendar) move(delta int) {
+	t := c.currentTime
+	var day time.Time
+	switch c.View {
+	case CalendarYearView:
+		s := c.system()
+		year, month, _ := s.Date(t)
+		year += delta
+		if n := s.MonthsInYear(year); month > n {
+			month = n
+		}
+		day = s.Time(year, month, 1, t.Location())
+	case CalendarWeekView:
+		day = t.AddDate(0, 0, delta*daysPerWeek)
+	default:
+		// Dates are 'normalised', forcing date to start from the start of the month ensures move from March to February
+		day = c.monthStart(t, delta)
+	}
+	c.currentTime = time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, t.Location())
+	c.update()
+}
+
+// period returns the first and the last day of the month, year or week shown.
+func (c *Calendar) period() (first, last time.Time) {
+	switch c.View {
+	case CalendarYearView:
+		first = c.yearStart(c.currentTime, 0)
+		return first, c.yearStart(c.currentTime, 1).AddDate(0, 0, -1)
+	case CalendarWeekView:
+		first = c.weekStart(c.currentTime)
+		return first, first.AddDate(0, 0, daysPerWeek-1)
+	}
+	return c.monthStart(c.currentTime, 0), c.monthStart(c.currentTime, 1).AddDate(0, 0, -1)
+}
+
+// periodLabel returns the title of the month, year or week shown, such as "4 – 10 March 2024" for a week.
+func (c *Calendar) periodLabel() string {
+	switch c.View {
+	case CalendarYearView:
+		year, _, _ := c.system().Date(c.currentTime)
+		return strconv.Itoa(year)
+	case CalendarWeekView:
+		first, last := c.period()
+		firstYear, firstMonth, firstDay := c.system().Date(first)
+		lastYear, lastMonth, lastDay := c.system().Date(last)
+		end := fmt.Sprintf("%d %s %d", lastDay, c.monthName(last), lastYear)
+		switch {
+		case firstYear == lastYear && firstMonth == lastMonth:
+			return fmt.Sprintf("%d – %s", firstDay, end)
+		case firstYear == lastYear:
+			return fmt.Sprintf("%d %s – %s", firstDay, c.monthName(first), end)
+		}
+		return fmt.Sprintf("%d %s %d – %s", firstDay, c.monthName(first), firstYear, end)
+	}
+	return c.monthYear()
+}
+
+// weekStart returns the midnight of the first day of the week of a day.
+func (c *Calendar) weekStart(t time.Time) time.Time {
+	day := c.day(t)
+	return day.AddDate(0, 0, -c.weekdayColumn(day.Weekday()))
+}
+
+// weekdayColumn returns the column of a day of the week, from 0 for the first weekday.
+func (c *Calendar) weekdayColumn(d time.Weekday) int {
+	return (int(d) - int(c.FirstWeekday) + daysPerWeek) % daysPerWeek
+}
+
+// columnWeekday returns the day of the week of a column, from 0 for the first weekday.
+func (c *Calendar) columnWeekday(column int) time.Weekday {
+	return time.Weekday((int(c.FirstWeekday) + column) % daysPerWeek)
+}
+
+// showViewMenu pops up the views to choose from, below the view button.
+func (c *Calendar) showViewMenu() {
+	item := func(label string, view CalendarView) *fyne.MenuItem {
+		i := fyne.NewMenuItem(i18n.L(label), func() {
+			c.SetView(view)
+		})
+		i.Checked = c.View == view
+		return i
+	}
+	menu := fyne.NewMenu("", item("Year", CalendarYearView), item("Month", CalendarMonthView),
+		item("Week", CalendarWeekView))
+
+	d := fyne.CurrentApp().Driver()
+	pos := d.AbsolutePositionForObject(c.viewButton).AddXY(0, c.viewButton.Size().Height)
+	widget.ShowPopUpMenuAtPosition(menu, d.CanvasForObject(c.viewButton), pos)
+}
+
+// yearGrid returns the months of the year shown, tapping one shows its days.
+func (c *Calendar) yearGrid() fyne.CanvasObject {
+	s, t := c.system(), c.currentTime
+	year, current, _ := s.Date(t)
+	count := s.MonthsInYear(year)
+	months := make([]fyne.CanvasObject, 0, count)
+	for m := 1; m <= count; m++ {
+		first := s.Time(year, m, 1, t.Location())
+		b := widget.NewButton(s.MonthName(year, m), func() {
+			c.currentTime = time.Date(first.Year(), first.Month(), first.Day(), t.Hour(), t.Minute(), 0, 0, t.Location())
+			c.SetView(CalendarMonthView)
+		})
+		b.Importance = widget.LowImportance
+		if m == current {
+			b.Importance = widget.HighImportance
+		}
+		if (!c.Min.IsZero() && c.monthStart(first, 1).AddDate(0, 0, -1).Before(c.day(c.Min))) ||
+			(!c.Max.IsZero() && first.After(c.day(c.Max))) {
+			b.Disable()
+		}
+		months = append(months, b)
+	}
+	return container.NewGridWithColumns(calendarYearColumns, months...)
+}
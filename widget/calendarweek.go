@@ -0,0 +1,369 @@
+package widget
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*calendarWeek)(nil)
+var _ fyne.Tappable = (*calendarHours)(nil)
+
+const (
+	hoursPerDay = 24
+	// the hour that the rows of a week view are scrolled to at first
+	calendarWeekFirstHour = 8
+	// the lines of event labels that the days of a week view have room for
+	calendarWeekDayLines = 2
+)
+
+// calendarWeek is the week view of a Calendar: the days with their all day events,
+// above hourly rows with the events that have a time.
+type calendarWeek struct {
+	widget.BaseWidget
+	cal *Calendar
+
+	days  []*calendarDay
+	hours *calendarHours
+}
+
+func newCalendarWeek(cal *Calendar) *calendarWeek {
+	w := &calendarWeek{cal: cal, hours: newCalendarHours(cal)}
+	w.ExtendBaseWidget(w)
+	return w
+}
+
+// update shows the days and events of the week of the current time of the calendar.
+func (w *calendarWeek) update() {
+	start := w.cal.weekStart(w.cal.currentTime)
+	w.days = make([]*calendarDay, daysPerWeek)
+	for i := range w.hours.events {
+		day := start.AddDate(0, 0, i)
+		var allDay, timed []CalendarEvent
+		if w.cal.Events != nil {
+			for _, e := range w.cal.Events.EventsForDay(day) {
+				if e.Start.IsZero() {
+					allDay = append(allDay, e)
+				} else {
+					timed = append(timed, e)
+				}
+			}
+		}
+		w.days[i] = newCalendarDay(w.cal, day, allDay)
+		w.hours.events[i] = timed
+	}
+	w.hours.start = start
+	w.Refresh()
+	w.hours.Refresh()
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (w *calendarWeek) CreateRenderer() fyne.WidgetRenderer {
+	w.ExtendBaseWidget(w)
+	r := &calendarWeekRenderer{w: w, scroll: container.NewVScroll(w.hours)}
+	for i := 0; i < daysPerWeek; i++ {
+		l := widget.NewLabel("")
+		l.Alignment = fyne.TextAlignCenter
+		r.headings = append(r.headings, l)
+	}
+	r.scroll.Offset = fyne.NewPos(0, calendarWeekFirstHour*w.hours.rowHeight())
+	return r
+}
+
+type calendarWeekRenderer struct {
+	w        *calendarWeek
+	headings []*widget.Label
+	scroll   *container.Scroll
+}
+
+func (r *calendarWeekRenderer) Destroy() {
+}
+
+func (r *calendarWeekRenderer) Layout(size fyne.Size) {
+	gutter := r.w.hours.gutterWidth()
+	column := (size.Width - gutter) / daysPerWeek
+	heading := r.headings[0].MinSize().Height
+	dayHeight := r.dayHeight()
+	for i, l := range r.headings {
+		l.Move(fyne.NewPos(gutter+column*float32(i), 0))
+		l.Resize(fyne.NewSize(column, heading))
+	}
+	for i, d := range r.w.days {
+		d.Move(fyne.NewPos(gutter+column*float32(i), heading))
+		d.Resize(fyne.NewSize(column, dayHeight))
+	}
+	r.scroll.Move(fyne.NewPos(0, heading+dayHeight))
+	r.scroll.Resize(fyne.NewSize(size.Width, size.Height-heading-dayHeight))
+}
+
+func (r *calendarWeekRenderer) MinSize() fyne.Size {
+	width := r.w.hours.gutterWidth() + r.headings[0].MinSize().Width*daysPerWeek
+	height := r.headings[0].MinSize().Height + r.dayHeight() + r.w.hours.rowHeight()*4
+	return fyne.NewSize(width, height)
+}
+
+// dayHeight returns the height of the days, with room for the labels of their all day events.
+func (r *calendarWeekRenderer) dayHeight() float32 {
+	lineHeight := fyne.MeasureText("Ag", theme.CaptionTextSize(), fyne.TextStyle{}).Height + 2
+	return fyne.MeasureText("22", theme.TextSize(), fyne.TextStyle{}).Height + theme.Padding()*2 +
+		lineHeight*calendarWeekDayLines
+}
+
+func (r *calendarWeekRenderer) Objects() []fyne.CanvasObject {
+	objects := make([]fyne.CanvasObject, 0, len(r.headings)+len(r.w.days)+1)
+	for _, l := range r.headings {
+		objects = append(objects, l)
+	}
+	for _, d := range r.w.days {
+		objects = append(objects, d)
+	}
+	return append(objects, r.scroll)
+}
+
+func (r *calendarWeekRenderer) Refresh() {
+	for i, l := range r.headings {
+		l.SetText(strings.ToUpper(i18n.ShortWeekdayName(r.w.cal.columnWeekday(i))))
+	}
+	r.Layout(r.w.Size())
+	canvas.Refresh(r.w)
+}
+
+// calendarHours are the hourly rows of a week view, with the events that have a time.
+type calendarHours struct {
+	widget.BaseWidget
+	cal    *Calendar
+	start  time.Time // the midnight of the first day of the week
+	events [daysPerWeek][]CalendarEvent
+
+	eventAreas []calendarEventArea
+}
+
+// calendarEventArea is where an event is shown in the hourly rows.
+type calendarEventArea struct {
+	day      int
+	event    CalendarEvent
+	min, max fyne.Position
+}
+
+func newCalendarHours(cal *Calendar) *calendarHours {
+	h := &calendarHours{cal: cal}
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (h *calendarHours) CreateRenderer() fyne.WidgetRenderer {
+	h.ExtendBaseWidget(h)
+	r := &calendarHoursRenderer{h: h}
+	for i := 0; i < daysPerWeek; i++ {
+		r.columns = append(r.columns, canvas.NewRectangle(color.Transparent))
+	}
+	for i := 0; i < hoursPerDay; i++ {
+		label := canvas.NewText(fmt.Sprintf("%02d:00", i), theme.Color(theme.ColorNameForeground))
+		label.TextSize = theme.CaptionTextSize()
+		label.Alignment = fyne.TextAlignTrailing
+		r.labels = append(r.labels, label)
+		r.lines = append(r.lines, canvas.NewLine(theme.Color(theme.ColorNameSeparator)))
+	}
+	r.Refresh()
+	return r
+}
+
+// Tapped calls OnEventTapped of the calendar for the event at the position if there is one,
+// otherwise it selects the day of the column.
+func (h *calendarHours) Tapped(ev *fyne.PointEvent) {
+	for _, a := range h.eventAreas {
+		if ev.Position.X >= a.min.X && ev.Position.X <= a.max.X && ev.Position.Y >= a.min.Y && ev.Position.Y <= a.max.Y {
+			if h.cal.OnEventTapped != nil {
+				h.cal.OnEventTapped(h.cal.dateForButton(h.start.AddDate(0, 0, a.day)), a.event)
+				return
+			}
+			break
+		}
+	}
+
+	gutter := h.gutterWidth()
+	if ev.Position.X < gutter {
+		return
+	}
+	day := int((ev.Position.X - gutter) / ((h.Size().Width - gutter) / daysPerWeek))
+	if day >= 0 && day < daysPerWeek {
+		h.cal.dayTapped(h.start.AddDate(0, 0, day))
+	}
+}
+
+// gutterWidth returns the width of the hour labels at the left of the rows.
+func (h *calendarHours) gutterWidth() float32 {
+	return fyne.MeasureText("00:00", theme.CaptionTextSize(), fyne.TextStyle{}).Width + theme.Padding()*2
+}
+
+// rowHeight returns the height of an hour.
+func (h *calendarHours) rowHeight() float32 {
+	return fyne.MeasureText("Ag", theme.CaptionTextSize(), fyne.TextStyle{}).Height * 2.5
+}
+
+// eventHours returns the hours of the day that an event starts and ends at, at least a quarter of an hour apart.
+func (h *calendarHours) eventHours(day time.Time, e CalendarEvent) (start, end float32) {
+	start = float32(e.Start.Sub(day).Hours())
+	if e.End.IsZero() {
+		end = start + 1
+	} else {
+		end = float32(e.End.Sub(day).Hours())
+	}
+	start = fyne.Max(0, fyne.Min(start, hoursPerDay-0.25))
+	end = fyne.Min(hoursPerDay, fyne.Max(end, start+0.25))
+	return start, end
+}
+
+// eventLanes returns the lane of each event of a day so that events at the same time are side by side,
+// and how many lanes there are.
+func eventLanes(events []CalendarEvent) ([]int, int) {
+	order := make([]int, len(events))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return events[order[i]].Start.Before(events[order[j]].Start)
+	})
+
+	lanes := make([]int, len(events))
+	var ends []time.Time // of the last event of each lane
+	for _, i := range order {
+		e := events[i]
+		end := e.End
+		if end.IsZero() {
+			end = e.Start.Add(time.Hour)
+		}
+		lane := 0
+		for lane < len(ends) && ends[lane].After(e.Start) {
+			lane++
+		}
+		if lane == len(ends) {
+			ends = append(ends, end)
+		} else {
+			ends[lane] = end
+		}
+		lanes[i] = lane
+	}
+	return lanes, len(ends)
+}
+
+type calendarHoursRenderer struct {
+	h       *calendarHours
+	columns []*canvas.Rectangle // the backgrounds of the days, to show the selection
+	labels  []*canvas.Text
+	lines   []*canvas.Line
+
+	pills  []*canvas.Rectangle
+	titles []*canvas.Text
+}
+
+func (r *calendarHoursRenderer) Destroy() {
+}
+
+func (r *calendarHoursRenderer) Layout(size fyne.Size) {
+	h := r.h
+	pad := theme.Padding()
+	gutter := h.gutterWidth()
+	row := h.rowHeight()
+	column := (size.Width - gutter) / daysPerWeek
+	for i, c := range r.columns {
+		c.Move(fyne.NewPos(gutter+column*float32(i), 0))
+		c.Resize(fyne.NewSize(column, size.Height))
+	}
+	for i, l := range r.labels {
+		y := row * float32(i)
+		l.Move(fyne.NewPos(0, y))
+		l.Resize(fyne.NewSize(gutter-pad, l.MinSize().Height))
+		r.lines[i].Position1 = fyne.NewPos(gutter, y)
+		r.lines[i].Position2 = fyne.NewPos(size.Width, y)
+	}
+
+	h.eventAreas = h.eventAreas[:0]
+	n := 0
+	for day, events := range h.events {
+		date := h.start.AddDate(0, 0, day)
+		lanes, count := eventLanes(events)
+		width := (column - pad) / fyne.Max(1, float32(count))
+		for i, e := range events {
+			start, end := h.eventHours(date, e)
+			pos := fyne.NewPos(gutter+column*float32(day)+pad/2+width*float32(lanes[i]), row*start)
+			pill := fyne.NewSize(width-1, row*(end-start)-1)
+			r.pills[n].Move(pos)
+			r.pills[n].Resize(pill)
+			t := r.titles[n]
+			t.Text = ellipsize(e.Label, t.TextSize, pill.Width-pad)
+			t.Move(pos.AddXY(pad/2, 0))
+			t.Resize(fyne.NewSize(pill.Width-pad, t.MinSize().Height))
+			h.eventAreas = append(h.eventAreas, calendarEventArea{day: day, event: e, min: pos, max: pos.Add(pill)})
+			n++
+		}
+	}
+}
+
+func (r *calendarHoursRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(r.h.gutterWidth(), r.h.rowHeight()*hoursPerDay)
+}
+
+func (r *calendarHoursRenderer) Objects() []fyne.CanvasObject {
+	objects := make([]fyne.CanvasObject, 0, len(r.columns)+len(r.labels)*2+len(r.pills)*2)
+	for _, c := range r.columns {
+		objects = append(objects, c)
+	}
+	for i := range r.labels {
+		objects = append(objects, r.lines[i], r.labels[i])
+	}
+	for i := range r.pills {
+		objects = append(objects, r.pills[i], r.titles[i])
+	}
+	return objects
+}
+
+func (r *calendarHoursRenderer) Refresh() {
+	h := r.h
+	for i, c := range r.columns {
+		c.FillColor = color.Transparent
+		if end, between := h.cal.dayState(h.start.AddDate(0, 0, i)); end || between {
+			c.FillColor = theme.Color(theme.ColorNameSelection)
+		}
+	}
+	for i, l := range r.labels {
+		l.Color = theme.Color(theme.ColorNameForeground)
+		l.TextSize = theme.CaptionTextSize()
+		r.lines[i].StrokeColor = theme.Color(theme.ColorNameSeparator)
+	}
+
+	r.pills, r.titles = r.pills[:0], r.titles[:0]
+	for _, events := range h.events {
+		for _, e := range events {
+			c := e.Color
+			if c == nil {
+				c = theme.Color(theme.ColorNamePrimary)
+			}
+			pill := canvas.NewRectangle(calendarEventFill(c))
+			pill.CornerRadius = theme.InputRadiusSize()
+			pill.StrokeColor = c
+			pill.StrokeWidth = 1
+			title := canvas.NewText(e.Label, theme.Color(theme.ColorNameForeground))
+			title.TextSize = theme.CaptionTextSize()
+			r.pills = append(r.pills, pill)
+			r.titles = append(r.titles, title)
+		}
+	}
+
+	r.Layout(h.Size())
+	canvas.Refresh(h)
+}
@@ -0,0 +1,126 @@
+package widget
+
+import (
+	"errors"
+	"image"
+	"io"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/camera"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Camera)(nil)
+
+// Camera shows a live preview of a camera, such as a webcam, and can take snapshots of it.
+// The cameras of the system are listed by camera.Devices.
+type Camera struct {
+	widget.BaseWidget
+
+	// Options are the size and rate of the frames captured, they apply from the next call to Start.
+	Options camera.Options
+	// OnFrame is called in the capture goroutine with each frame, such as to scan it for codes.
+	OnFrame func(frame image.Image) `json:"-"`
+	// OnError is called if the capture stops with an error, such as when the camera is unplugged.
+	OnError func(err error) `json:"-"`
+
+	lock    sync.Mutex
+	device  camera.Device
+	stream  camera.Stream
+	latest  image.Image
+	preview *canvas.Image
+	icon    *widget.Icon
+}
+
+// NewCamera creates a camera preview that shows nothing until it is started.
+func NewCamera() *Camera {
+	c := &Camera{preview: &canvas.Image{FillMode: canvas.ImageFillContain}, icon: widget.NewIcon(theme.MediaVideoIcon())}
+	c.preview.SetMinSize(fyne.NewSize(160, 120))
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (c *Camera) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+	bg := canvas.NewRectangle(theme.Color(theme.ColorNameInputBackground))
+	return widget.NewSimpleRenderer(container.NewStack(bg, container.NewCenter(c.icon), c.preview))
+}
+
+// Device returns the camera that is shown, if it has been started.
+func (c *Camera) Device() camera.Device {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.device
+}
+
+// Start opens a camera and shows its preview, stopping any camera that was shown.
+// Cameras should be stopped when the preview is no longer needed, to release them.
+func (c *Camera) Start(d camera.Device) error {
+	c.Stop()
+	s, err := camera.Open(d, c.Options)
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	c.device, c.stream = d, s
+	c.lock.Unlock()
+	go c.capture(s)
+	return nil
+}
+
+// Stop closes the camera, leaving the last frame shown.
+func (c *Camera) Stop() {
+	c.lock.Lock()
+	s := c.stream
+	c.stream = nil
+	c.lock.Unlock()
+	if s != nil {
+		_ = s.Close()
+	}
+}
+
+// TakeSnapshot returns the latest frame of the camera, or nil if it has not captured one.
+// Later frames do not change the image returned.
+func (c *Camera) TakeSnapshot() image.Image {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.latest
+}
+
+func (c *Camera) capture(s camera.Stream) {
+	for {
+		frame, err := s.Read()
+		c.lock.Lock()
+		current := c.stream == s
+		if err == nil && current {
+			c.latest = frame
+		}
+		c.lock.Unlock()
+		if !current {
+			return // stopped
+		}
+
+		if err != nil {
+			c.Stop()
+			if f := c.OnError; f != nil && !errors.Is(err, io.EOF) {
+				f(err)
+			}
+			return
+		}
+		c.icon.Hide()
+		c.preview.Image = frame
+		c.preview.Refresh()
+		if f := c.OnFrame; f != nil {
+			f(frame)
+		}
+	}
+}
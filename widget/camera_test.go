@@ -0,0 +1,103 @@
+package widget
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/x/fyne/camera"
+)
+
+// fakeCamera sends the frames written to its channel, until it is closed.
+type fakeCamera struct {
+	frames chan image.Image
+	opened camera.Options
+}
+
+func (f *fakeCamera) Devices() ([]camera.Device, error) {
+	return []camera.Device{{ID: "0", Name: "Test camera"}}, nil
+}
+
+func (f *fakeCamera) Open(d camera.Device, o camera.Options) (camera.Stream, error) {
+	f.opened = o
+	f.frames = make(chan image.Image)
+	return &fakeStream{frames: f.frames, closed: make(chan struct{})}, nil
+}
+
+type fakeStream struct {
+	frames chan image.Image
+	closed chan struct{}
+}
+
+func (s *fakeStream) Close() error {
+	close(s.closed)
+	return nil
+}
+
+func (s *fakeStream) Read() (image.Image, error) {
+	select {
+	case img, ok := <-s.frames:
+		if !ok {
+			return nil, errors.New("unplugged")
+		}
+		return img, nil
+	case <-s.closed:
+		return nil, io.EOF
+	}
+}
+
+func useFakeCamera(t *testing.T) *fakeCamera {
+	f := &fakeCamera{}
+	camera.SetDriver(f)
+	t.Cleanup(func() {
+		camera.SetDriver(nil)
+	})
+	return f
+}
+
+func TestCamera(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+	f := useFakeCamera(t)
+
+	c := NewCamera()
+	w := test.NewWindow(c)
+	defer w.Close()
+	assert.Nil(t, c.TakeSnapshot())
+
+	devices, _ := camera.Devices()
+	assert.NoError(t, c.Start(devices[0]))
+	assert.Equal(t, "Test camera", c.Device().Name)
+	assert.Equal(t, 640, f.opened.Width)
+
+	frames := make(chan image.Image, 2)
+	c.OnFrame = func(img image.Image) { frames <- img }
+	red := image.NewUniform(color.RGBA{R: 0xff, A: 0xff})
+	f.frames <- red
+	assert.Equal(t, red, <-frames)
+	f.frames <- image.NewUniform(color.White)
+	<-frames
+	c.Stop()
+	assert.Equal(t, image.NewUniform(color.White), c.TakeSnapshot())
+	assert.Equal(t, c.TakeSnapshot(), c.preview.Image)
+	assert.False(t, c.icon.Visible())
+}
+
+func TestCamera_Error(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+	f := useFakeCamera(t)
+
+	c := NewCamera()
+	errs := make(chan error)
+	c.OnError = func(err error) { errs <- err }
+	assert.NoError(t, c.Start(camera.Device{ID: "0"}))
+	close(f.frames)
+	assert.EqualError(t, <-errs, "unplugged")
+	c.Stop()
+}
@@ -0,0 +1,166 @@
+package widget
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/software"
+)
+
+// CaptureImage renders obj and the objects within it to an image, offscreen, at a scale of pixels for each unit
+// of the canvas. It is drawn at its current size, or its minimum size if it has not been sized, with a
+// transparent background, in the current theme.
+// This can export a chart as a PNG, or check the rendering of widgets in tests.
+func CaptureImage(obj fyne.CanvasObject, scale float32) image.Image {
+	size, pos := obj.Size(), obj.Position()
+	if size.IsZero() {
+		size = obj.MinSize()
+	}
+
+	c := software.NewTransparentCanvas()
+	c.SetPadded(false)
+	c.SetScale(scale)
+	c.SetContent(obj)
+	c.Resize(size)
+	img := c.Capture()
+
+	// the canvas moves its content to its corner, put it back for the window it belongs to
+	obj.Resize(size)
+	obj.Move(pos)
+	return img
+}
+
+// ExportSVG writes obj and the objects within it as an SVG document, in the units of the canvas.
+// Rectangles, circles, lines and text are written as their SVG elements, and other objects, such as images
+// and rasters, are embedded as PNG images. Widgets are drawn from a renderer of their own, in the current theme.
+func ExportSVG(obj fyne.CanvasObject, w io.Writer) error {
+	size := obj.Size()
+	if size.IsZero() {
+		size = obj.MinSize()
+		obj.Resize(size)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%g" height="%g" viewBox="0 0 %g %g">`+"\n",
+		size.Width, size.Height, size.Width, size.Height)
+	var err error
+	walkPrimitives(obj, fyne.NewPos(0, 0).Subtract(obj.Position()), func(o fyne.CanvasObject, pos fyne.Position) {
+		if err == nil {
+			err = writeSVGElement(&buf, o, pos)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	buf.WriteString("</svg>\n")
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// walkPrimitives calls f with each visible canvas primitive within o, and its position offset by parent.
+// Widgets are walked through a renderer of their own, as those that draw them are private to Fyne.
+func walkPrimitives(o fyne.CanvasObject, parent fyne.Position, f func(o fyne.CanvasObject, pos fyne.Position)) {
+	if !o.Visible() {
+		return
+	}
+	pos := parent.Add(o.Position())
+	switch o := o.(type) {
+	case *fyne.Container:
+		for _, child := range o.Objects {
+			walkPrimitives(child, pos, f)
+		}
+	case fyne.Widget:
+		r := o.CreateRenderer()
+		r.Layout(o.Size())
+		for _, child := range r.Objects() {
+			walkPrimitives(child, pos, f)
+		}
+		r.Destroy()
+	default:
+		f(o, pos)
+	}
+}
+
+func writeSVGElement(w io.Writer, o fyne.CanvasObject, pos fyne.Position) error {
+	size := o.Size()
+	var err error
+	switch o := o.(type) {
+	case *canvas.Rectangle:
+		_, err = fmt.Fprintf(w, `<rect x="%g" y="%g" width="%g" height="%g" rx="%g"%s%s/>`+"\n",
+			pos.X, pos.Y, size.Width, size.Height, o.CornerRadius,
+			svgPaint("fill", o.FillColor), svgStroke(o.StrokeColor, o.StrokeWidth))
+	case *canvas.Circle:
+		_, err = fmt.Fprintf(w, `<ellipse cx="%g" cy="%g" rx="%g" ry="%g"%s%s/>`+"\n",
+			pos.X+size.Width/2, pos.Y+size.Height/2, size.Width/2, size.Height/2,
+			svgPaint("fill", o.FillColor), svgStroke(o.StrokeColor, o.StrokeWidth))
+	case *canvas.Line:
+		// the ends of a line are relative to its parent, like its position
+		p1 := pos.Subtract(o.Position()).Add(o.Position1)
+		p2 := pos.Subtract(o.Position()).Add(o.Position2)
+		_, err = fmt.Fprintf(w, `<line x1="%g" y1="%g" x2="%g" y2="%g"%s/>`+"\n",
+			p1.X, p1.Y, p2.X, p2.Y, svgStroke(o.StrokeColor, o.StrokeWidth))
+	case *canvas.Text:
+		x, anchor := pos.X, "start"
+		switch o.Alignment {
+		case fyne.TextAlignCenter:
+			x, anchor = pos.X+size.Width/2, "middle"
+		case fyne.TextAlignTrailing:
+			x, anchor = pos.X+size.Width, "end"
+		}
+		style := ""
+		if o.TextStyle.Bold {
+			style += ` font-weight="bold"`
+		}
+		if o.TextStyle.Italic {
+			style += ` font-style="italic"`
+		}
+		family := "sans-serif"
+		if o.TextStyle.Monospace {
+			family = "monospace"
+		}
+		_, err = fmt.Fprintf(w, `<text x="%g" y="%g" font-family="%s" font-size="%g" text-anchor="%s" dominant-baseline="middle"%s%s>%s</text>`+"\n",
+			x, pos.Y+size.Height/2, family, o.TextSize, anchor, style, svgPaint("fill", o.Color), html.EscapeString(o.Text))
+	default:
+		if size.IsZero() {
+			return nil
+		}
+		var data bytes.Buffer
+		if err = png.Encode(&data, CaptureImage(o, 1)); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, `<image x="%g" y="%g" width="%g" height="%g" href="data:image/png;base64,%s"/>`+"\n",
+			pos.X, pos.Y, size.Width, size.Height, base64.StdEncoding.EncodeToString(data.Bytes()))
+	}
+	return err
+}
+
+// svgPaint returns the attributes that paint a colour, as the fill or stroke attribute, or none if transparent.
+func svgPaint(attr string, c color.Color) string {
+	if c == nil {
+		return fmt.Sprintf(` %s="none"`, attr)
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	if n.A == 0 {
+		return fmt.Sprintf(` %s="none"`, attr)
+	}
+	paint := fmt.Sprintf(` %s="#%02x%02x%02x"`, attr, n.R, n.G, n.B)
+	if n.A < 0xff {
+		paint += fmt.Sprintf(` %s-opacity="%.3g"`, attr, float64(n.A)/0xff)
+	}
+	return paint
+}
+
+func svgStroke(c color.Color, width float32) string {
+	if width <= 0 {
+		return ""
+	}
+	return svgPaint("stroke", c) + fmt.Sprintf(` stroke-width="%g"`, width)
+}
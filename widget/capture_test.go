@@ -0,0 +1,79 @@
+package widget
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureImage(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	red := color.NRGBA{R: 0xff, A: 0xff}
+	rect := canvas.NewRectangle(red)
+	rect.SetMinSize(fyne.NewSize(20, 10))
+	led := NewLED("On")
+	led.SetState(LEDOn)
+	content := container.NewHBox(rect, led)
+	w := test.NewWindow(container.NewVBox(NewLED("Above"), content))
+	defer w.Close()
+	pos, size := content.Position(), content.Size()
+	assert.NotZero(t, pos.Y)
+
+	img := CaptureImage(content, 2)
+	assert.InDelta(t, size.Width*2, img.Bounds().Dx(), 1)
+	assert.InDelta(t, size.Height*2, img.Bounds().Dy(), 1)
+	assert.Equal(t, red, color.NRGBAModel.Convert(img.At(10, 10)))
+	_, _, _, a := img.At(img.Bounds().Dx()-1, 0).RGBA()
+	assert.Zero(t, a, "the background is transparent")
+	// the light of the LED is drawn
+	found := false
+	success := color.NRGBAModel.Convert(theme.Color(theme.ColorNameSuccess))
+	for x := 40; x < img.Bounds().Dx() && !found; x++ {
+		found = color.NRGBAModel.Convert(img.At(x, img.Bounds().Dy()/2)) == success
+	}
+	assert.True(t, found)
+
+	assert.Equal(t, pos, content.Position())
+	assert.Equal(t, size, content.Size())
+}
+
+func TestExportSVG(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	rect := canvas.NewRectangle(color.NRGBA{R: 0xff, A: 0x80})
+	rect.CornerRadius = 3
+	rect.Resize(fyne.NewSize(40, 20))
+	line := canvas.NewLine(color.Black)
+	line.Position1, line.Position2 = fyne.NewPos(10, 30), fyne.NewPos(0, 40)
+	text := canvas.NewText("a < b", color.Black)
+	text.Move(fyne.NewPos(50, 0))
+	text.Resize(fyne.NewSize(40, 20))
+	raster := canvas.NewRasterFromImage(image.NewUniform(color.White))
+	raster.Move(fyne.NewPos(0, 50))
+	raster.Resize(fyne.NewSize(10, 10))
+	c := container.NewWithoutLayout(rect, line, text, raster, NewSkeletonCircle(10))
+	c.Resize(fyne.NewSize(100, 60))
+	c.Move(fyne.NewPos(5, 5))
+
+	var out bytes.Buffer
+	assert.NoError(t, ExportSVG(c, &out))
+	svg := out.String()
+	assert.Contains(t, svg, `<svg xmlns="http://www.w3.org/2000/svg" width="100" height="60" viewBox="0 0 100 60">`)
+	assert.Contains(t, svg, `<rect x="0" y="0" width="40" height="20" rx="3" fill="#ff0000" fill-opacity="0.502"/>`)
+	assert.Contains(t, svg, `<line x1="10" y1="30" x2="0" y2="40" stroke="#000000" stroke-width="1"/>`)
+	assert.Contains(t, svg, `text-anchor="start" dominant-baseline="middle" fill="#000000">a &lt; b</text>`)
+	assert.Contains(t, svg, `<image x="0" y="50" width="10" height="10" href="data:image/png;base64,`)
+	assert.Contains(t, svg, `<ellipse `)
+	assert.Contains(t, svg, "</svg>\n")
+}
@@ -0,0 +1,200 @@
+package widget
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Chip)(nil)
+var _ fyne.Tappable = (*Chip)(nil)
+var _ fyne.Disableable = (*Chip)(nil)
+var _ desktop.Hoverable = (*Chip)(nil)
+
+// Chip is a small rounded label with an optional icon, such as a tag or a filter. It is filled in when Selected,
+// and shows a close icon if OnClosed is set.
+type Chip struct {
+	widget.DisableableWidget
+
+	Text     string
+	Icon     fyne.Resource
+	Selected bool
+
+	OnTapped func() `json:"-"`
+	// OnClosed is called when the close icon is tapped, the icon is only shown if it is set.
+	OnClosed func() `json:"-"`
+
+	hovered bool
+}
+
+// NewChip creates a new chip with a text and a tap handler.
+func NewChip(text string, tapped func()) *Chip {
+	c := &Chip{Text: text, OnTapped: tapped}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// NewChipWithIcon creates a new chip with a text, an icon before it and a tap handler.
+func NewChipWithIcon(text string, icon fyne.Resource, tapped func()) *Chip {
+	c := &Chip{Text: text, Icon: icon, OnTapped: tapped}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (c *Chip) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+	r := &chipRenderer{
+		chip:  c,
+		bg:    canvas.NewRectangle(color.Transparent),
+		text:  canvas.NewText("", color.Transparent),
+		icon:  canvas.NewImageFromResource(nil),
+		close: canvas.NewImageFromResource(nil),
+	}
+	r.icon.FillMode = canvas.ImageFillContain
+	r.Refresh()
+	return r
+}
+
+// MouseIn is called when a desktop pointer enters the widget.
+//
+// Implements: desktop.Hoverable
+func (c *Chip) MouseIn(*desktop.MouseEvent) {
+	c.hovered = true
+	c.Refresh()
+}
+
+// MouseMoved is called when a desktop pointer hovers over the widget.
+//
+// Implements: desktop.Hoverable
+func (c *Chip) MouseMoved(*desktop.MouseEvent) {
+}
+
+// MouseOut is called when a desktop pointer exits the widget.
+//
+// Implements: desktop.Hoverable
+func (c *Chip) MouseOut() {
+	c.hovered = false
+	c.Refresh()
+}
+
+// SetSelected changes whether the chip is filled in as selected.
+func (c *Chip) SetSelected(selected bool) {
+	if selected == c.Selected {
+		return
+	}
+	c.Selected = selected
+	c.Refresh()
+}
+
+// Tapped calls OnClosed if the close icon is tapped, or else OnTapped.
+//
+// Implements: fyne.Tappable
+func (c *Chip) Tapped(ev *fyne.PointEvent) {
+	if c.Disabled() {
+		return
+	}
+	size := c.Size()
+	if f := c.OnClosed; f != nil && ev.Position.X >= size.Width-size.Height {
+		f()
+		return
+	}
+	if f := c.OnTapped; f != nil {
+		f()
+	}
+}
+
+type chipRenderer struct {
+	chip        *Chip
+	bg          *canvas.Rectangle
+	text        *canvas.Text
+	icon, close *canvas.Image
+}
+
+func (r *chipRenderer) Destroy() {
+}
+
+func (r *chipRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	r.bg.CornerRadius = size.Height / 2
+
+	text := r.text.MinSize()
+	iconSize := text.Height
+	x := theme.InnerPadding()
+	if r.icon.Visible() {
+		r.icon.Resize(fyne.NewSquareSize(iconSize))
+		r.icon.Move(fyne.NewPos(x, (size.Height-iconSize)/2))
+		x += iconSize + theme.Padding()
+	}
+	r.text.Resize(text)
+	r.text.Move(fyne.NewPos(x, (size.Height-text.Height)/2))
+	r.close.Resize(fyne.NewSquareSize(iconSize))
+	r.close.Move(fyne.NewPos(size.Width-iconSize-theme.Padding()*1.5, (size.Height-iconSize)/2))
+}
+
+func (r *chipRenderer) MinSize() fyne.Size {
+	text := r.text.MinSize()
+	width := theme.InnerPadding() + text.Width + theme.InnerPadding()
+	if r.icon.Visible() {
+		width += text.Height + theme.Padding()
+	}
+	if r.close.Visible() {
+		// the close icon takes the place of the padding at the end
+		width += theme.Padding() + text.Height + theme.Padding()*1.5 - theme.InnerPadding()
+	}
+	return fyne.NewSize(width, text.Height+theme.Padding()*2)
+}
+
+func (r *chipRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg, r.icon, r.text, r.close}
+}
+
+func (r *chipRenderer) Refresh() {
+	c := r.chip
+	r.text.Text = c.Text
+	r.text.TextSize = theme.TextSize()
+
+	r.bg.StrokeWidth = theme.InputBorderSize()
+	r.bg.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	r.bg.FillColor = color.Transparent
+	r.text.Color = theme.Color(theme.ColorNameForeground)
+	icon, closeIcon := c.Icon, theme.CancelIcon()
+	switch {
+	case c.Disabled():
+		r.text.Color = theme.Color(theme.ColorNameDisabled)
+		if c.Selected {
+			r.bg.FillColor = theme.Color(theme.ColorNameDisabledButton)
+		}
+		if icon != nil {
+			icon = theme.NewDisabledResource(icon)
+		}
+		closeIcon = theme.NewDisabledResource(closeIcon)
+	case c.Selected:
+		r.bg.FillColor = theme.Color(theme.ColorNamePrimary)
+		r.bg.StrokeColor = r.bg.FillColor
+		r.text.Color = theme.Color(theme.ColorNameForegroundOnPrimary)
+		if icon != nil {
+			icon = theme.NewInvertedThemedResource(icon)
+		}
+		closeIcon = theme.NewInvertedThemedResource(closeIcon)
+	case c.hovered:
+		r.bg.FillColor = theme.Color(theme.ColorNameHover)
+	}
+
+	r.icon.Resource = icon
+	r.icon.Hidden = icon == nil
+	r.close.Resource = closeIcon
+	r.close.Hidden = c.OnClosed == nil
+
+	r.bg.Refresh()
+	r.text.Refresh()
+	r.icon.Refresh()
+	r.close.Refresh()
+	r.Layout(c.Size())
+}
@@ -0,0 +1,58 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChip_Tapped(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	tapped, closed := 0, 0
+	c := NewChipWithIcon("Music", theme.MediaMusicIcon(), func() {
+		tapped++
+	})
+	w := test.NewWindow(c)
+	defer w.Close()
+	plain := c.MinSize()
+
+	c.OnClosed = func() {
+		closed++
+	}
+	c.Refresh()
+	c.Resize(c.MinSize())
+	assert.Greater(t, c.MinSize().Width, plain.Width, "the close icon takes space")
+
+	test.TapAt(c, fyne.NewPos(5, 5))
+	assert.Equal(t, 1, tapped)
+	test.TapAt(c, fyne.NewPos(c.Size().Width-5, 5))
+	assert.Equal(t, 1, tapped)
+	assert.Equal(t, 1, closed)
+
+	c.Disable()
+	test.TapAt(c, fyne.NewPos(5, 5))
+	assert.Equal(t, 1, tapped)
+}
+
+func TestChip_Selected(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewChip("Jazz", nil)
+	w := test.NewWindow(c)
+	defer w.Close()
+
+	r := test.WidgetRenderer(c).(*chipRenderer)
+	assert.True(t, r.icon.Hidden)
+	assert.True(t, r.close.Hidden)
+	assert.Equal(t, theme.Color(theme.ColorNameForeground), r.text.Color)
+
+	c.SetSelected(true)
+	assert.Equal(t, theme.Color(theme.ColorNamePrimary), r.bg.FillColor)
+	assert.Equal(t, theme.Color(theme.ColorNameForegroundOnPrimary), r.text.Color)
+}
@@ -0,0 +1,188 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*ChipGroup)(nil)
+var _ fyne.Disableable = (*ChipGroup)(nil)
+
+// ChipGroup shows options as chips that wrap onto more rows, such as the filters of a list. Tapping a chip
+// selects it and unselects the others, unless MultiSelect is set so that each tap toggles a chip.
+type ChipGroup struct {
+	widget.DisableableWidget
+
+	// Options are the texts of the chips, call Refresh after changing them.
+	Options []string
+	// MultiSelect lets any number of the chips be selected, as filters.
+	MultiSelect bool
+	// Selected are the selected options, use SetSelected to change them.
+	Selected []string
+
+	// OnChanged is called with the selected options, in the order of Options, when the selection changes.
+	OnChanged func(selected []string) `json:"-"`
+
+	chips []*Chip
+}
+
+// NewChipGroup creates a new group of chips for options, with a change handler.
+func NewChipGroup(options []string, changed func(selected []string)) *ChipGroup {
+	g := &ChipGroup{Options: options, OnChanged: changed}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (g *ChipGroup) CreateRenderer() fyne.WidgetRenderer {
+	g.ExtendBaseWidget(g)
+	r := &chipGroupRenderer{group: g}
+	r.Refresh()
+	return r
+}
+
+// IsSelected returns whether an option is selected.
+func (g *ChipGroup) IsSelected(option string) bool {
+	for _, s := range g.Selected {
+		if s == option {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSelected selects the given options and unselects the others. Only the first is selected unless MultiSelect
+// is set.
+func (g *ChipGroup) SetSelected(options []string) {
+	var selected []string
+	for _, option := range g.Options {
+		for _, s := range options {
+			if s == option {
+				selected = append(selected, option)
+				break
+			}
+		}
+	}
+	if !g.MultiSelect && len(selected) > 1 {
+		for _, s := range options {
+			if containsString(g.Options, s) {
+				selected = []string{s}
+				break
+			}
+		}
+	}
+	if stringsEqual(selected, g.Selected) {
+		return
+	}
+	g.Selected = selected
+	g.Refresh()
+	if f := g.OnChanged; f != nil {
+		f(selected)
+	}
+}
+
+// toggle selects an option, or toggles it if MultiSelect is set.
+func (g *ChipGroup) toggle(option string) {
+	if g.Disabled() {
+		return
+	}
+	if !g.MultiSelect {
+		g.SetSelected([]string{option})
+		return
+	}
+
+	var selected []string
+	for _, s := range g.Selected {
+		if s != option {
+			selected = append(selected, s)
+		}
+	}
+	if len(selected) == len(g.Selected) {
+		selected = append(selected, option)
+	}
+	g.SetSelected(selected)
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+type chipGroupRenderer struct {
+	group *ChipGroup
+}
+
+func (r *chipGroupRenderer) Destroy() {
+}
+
+func (r *chipGroupRenderer) Layout(size fyne.Size) {
+	flowChips(r.group.chips, size.Width, true)
+}
+
+func (r *chipGroupRenderer) MinSize() fyne.Size {
+	width := float32(0)
+	for _, chip := range r.group.chips {
+		width = fyne.Max(width, chip.MinSize().Width)
+	}
+	return fyne.NewSize(width, flowChips(r.group.chips, r.group.Size().Width, false))
+}
+
+func (r *chipGroupRenderer) Objects() []fyne.CanvasObject {
+	objects := make([]fyne.CanvasObject, len(r.group.chips))
+	for i, chip := range r.group.chips {
+		objects[i] = chip
+	}
+	return objects
+}
+
+func (r *chipGroupRenderer) Refresh() {
+	g := r.group
+	for len(g.chips) < len(g.Options) {
+		chip := NewChip("", nil)
+		g.chips = append(g.chips, chip)
+	}
+	g.chips = g.chips[:len(g.Options)]
+	for i, option := range g.Options {
+		option := option
+		chip := g.chips[i]
+		chip.Text = option
+		chip.Selected = g.IsSelected(option)
+		chip.OnTapped = func() {
+			g.toggle(option)
+		}
+		if g.Disabled() {
+			chip.Disable()
+		} else {
+			chip.Enable()
+		}
+		chip.Refresh()
+	}
+	r.Layout(g.Size())
+}
+
+// flowChips places chips in rows of a width, wrapping them onto the next row when they don't fit, if place is
+// set, and returns the height of the rows.
+func flowChips(chips []*Chip, width float32, place bool) float32 {
+	pad := theme.Padding()
+	x, y, rowHeight := float32(0), float32(0), float32(0)
+	for _, chip := range chips {
+		size := chip.MinSize()
+		if width > 0 && x > 0 && x+size.Width > width {
+			x, y, rowHeight = 0, y+rowHeight+pad, 0
+		}
+		if place {
+			chip.Resize(size)
+			chip.Move(fyne.NewPos(x, y))
+		}
+		x += size.Width + pad
+		rowHeight = fyne.Max(rowHeight, size.Height)
+	}
+	return y + rowHeight
+}
@@ -0,0 +1,72 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChipGroup_Select(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	var changed []string
+	g := NewChipGroup([]string{"Rock", "Jazz", "Pop"}, func(selected []string) {
+		changed = selected
+	})
+	w := test.NewWindow(g)
+	defer w.Close()
+
+	test.Tap(g.chips[1])
+	assert.Equal(t, []string{"Jazz"}, g.Selected)
+	assert.Equal(t, []string{"Jazz"}, changed)
+	test.Tap(g.chips[0])
+	assert.Equal(t, []string{"Rock"}, g.Selected)
+	assert.True(t, g.chips[0].Selected)
+	assert.False(t, g.chips[1].Selected)
+
+	g.SetSelected([]string{"Pop", "Rock", "Blues"})
+	assert.Equal(t, []string{"Pop"}, g.Selected, "only the first is selected")
+
+	g.Disable()
+	test.Tap(g.chips[1])
+	assert.Equal(t, []string{"Pop"}, g.Selected)
+}
+
+func TestChipGroup_MultiSelect(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	g := NewChipGroup([]string{"Rock", "Jazz", "Pop"}, nil)
+	g.MultiSelect = true
+	w := test.NewWindow(g)
+	defer w.Close()
+
+	test.Tap(g.chips[2])
+	test.Tap(g.chips[0])
+	assert.Equal(t, []string{"Rock", "Pop"}, g.Selected, "in the order of the options")
+	test.Tap(g.chips[2])
+	assert.Equal(t, []string{"Rock"}, g.Selected)
+	assert.True(t, g.IsSelected("Rock"))
+	assert.False(t, g.IsSelected("Pop"))
+}
+
+func TestChipGroup_Wrap(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	g := NewChipGroup([]string{"Alternative", "Blues", "Classical", "Country", "Electronic"}, nil)
+	w := test.NewWindow(g)
+	defer w.Close()
+	oneRow := g.chips[0].MinSize().Height
+
+	g.Resize(fyne.NewSize(200, 100))
+	assert.Greater(t, g.MinSize().Height, oneRow)
+	assert.Equal(t, float32(0), g.chips[0].Position().X)
+	assert.Greater(t, g.chips[4].Position().Y, g.chips[0].Position().Y)
+	for _, chip := range g.chips {
+		assert.LessOrEqual(t, chip.Position().X+chip.Size().Width, float32(200))
+	}
+}
@@ -0,0 +1,390 @@
+package widget
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*ColorButton)(nil)
+var _ fyne.Tappable = (*ColorButton)(nil)
+var _ fyne.Disableable = (*ColorButton)(nil)
+var _ desktop.Hoverable = (*ColorButton)(nil)
+
+const (
+	colorRecentsKey = "fyne-x_color_recents"
+	colorRecentsMax = 8
+)
+
+// DefaultColorPalette is the list of colors offered by a ColorButton that has no Palette set.
+var DefaultColorPalette = []color.Color{
+	color.NRGBA{R: 0xf4, G: 0x43, B: 0x36, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0x98, B: 0x00, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0xeb, B: 0x3b, A: 0xff},
+	color.NRGBA{R: 0x8b, G: 0xc3, B: 0x4a, A: 0xff},
+	color.NRGBA{R: 0x4c, G: 0xaf, B: 0x50, A: 0xff},
+	color.NRGBA{R: 0x00, G: 0x96, B: 0x88, A: 0xff},
+	color.NRGBA{R: 0x00, G: 0xbc, B: 0xd4, A: 0xff},
+	color.NRGBA{R: 0x21, G: 0x96, B: 0xf3, A: 0xff},
+	color.NRGBA{R: 0x3f, G: 0x51, B: 0xb5, A: 0xff},
+	color.NRGBA{R: 0x9c, G: 0x27, B: 0xb0, A: 0xff},
+	color.NRGBA{R: 0xe9, G: 0x1e, B: 0x63, A: 0xff},
+	color.NRGBA{R: 0x79, G: 0x55, B: 0x48, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	color.NRGBA{R: 0xbd, G: 0xbd, B: 0xbd, A: 0xff},
+	color.NRGBA{R: 0x75, G: 0x75, B: 0x75, A: 0xff},
+	color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+}
+
+// ColorButton is a small color well showing the current color.
+// Tapping it opens a compact picker with a palette, recently used colors
+// and a hex entry. Recent colors are shared by all color buttons in the app
+// and stored in the app preferences.
+type ColorButton struct {
+	widget.DisableableWidget
+
+	Color     color.Color
+	OnChanged func(color.Color) `json:"-"`
+	// Palette is the list of colors offered in the picker, DefaultColorPalette is used if empty.
+	Palette []color.Color
+
+	hovered bool
+	popUp   *widget.PopUp
+	binder  *colorBinder
+}
+
+// NewColorButton creates a new color button showing the given color.
+func NewColorButton(c color.Color, changed func(color.Color)) *ColorButton {
+	b := &ColorButton{Color: c, OnChanged: changed}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// NewColorButtonWithData creates a new color button bound to a data item holding a color.Color.
+func NewColorButtonWithData(data binding.Untyped) *ColorButton {
+	b := NewColorButton(color.Transparent, nil)
+	b.Bind(data)
+	return b
+}
+
+// Bind connects the button to a data item holding a color.Color.
+func (b *ColorButton) Bind(data binding.Untyped) {
+	b.Unbind()
+	b.binder = &colorBinder{data: data}
+	b.binder.listener = binding.NewDataListener(func() {
+		v, err := data.Get()
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		if c, ok := v.(color.Color); ok {
+			b.setColor(c, false)
+		}
+	})
+	data.AddListener(b.binder.listener)
+}
+
+// Unbind disconnects any configured data source.
+func (b *ColorButton) Unbind() {
+	if b.binder == nil {
+		return
+	}
+	b.binder.data.RemoveListener(b.binder.listener)
+	b.binder = nil
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (b *ColorButton) CreateRenderer() fyne.WidgetRenderer {
+	b.ExtendBaseWidget(b)
+	border := canvas.NewRectangle(color.Transparent)
+	border.StrokeWidth = theme.InputBorderSize()
+	border.CornerRadius = theme.InputRadiusSize()
+	well := canvas.NewRectangle(b.Color)
+	well.CornerRadius = theme.InputRadiusSize()
+	r := &colorButtonRenderer{button: b, border: border, well: well}
+	r.Refresh()
+	return r
+}
+
+// HidePicker closes the color picker if it is open.
+func (b *ColorButton) HidePicker() {
+	if b.popUp != nil {
+		b.popUp.Hide()
+		b.popUp = nil
+	}
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (b *ColorButton) MinSize() fyne.Size {
+	b.ExtendBaseWidget(b)
+	return b.BaseWidget.MinSize()
+}
+
+// MouseIn is called when a desktop pointer enters the widget.
+//
+// Implements: desktop.Hoverable
+func (b *ColorButton) MouseIn(*desktop.MouseEvent) {
+	b.hovered = true
+	b.Refresh()
+}
+
+// MouseMoved is called when a desktop pointer hovers over the widget.
+//
+// Implements: desktop.Hoverable
+func (b *ColorButton) MouseMoved(*desktop.MouseEvent) {
+}
+
+// MouseOut is called when a desktop pointer exits the widget.
+//
+// Implements: desktop.Hoverable
+func (b *ColorButton) MouseOut() {
+	b.hovered = false
+	b.Refresh()
+}
+
+// SetColor changes the current color, calling OnChanged and updating any bound data.
+func (b *ColorButton) SetColor(c color.Color) {
+	b.setColor(c, true)
+}
+
+// ShowPicker opens the compact color picker below the button.
+func (b *ColorButton) ShowPicker() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(b)
+	if c == nil || b.Disabled() {
+		return
+	}
+
+	palette := b.Palette
+	if len(palette) == 0 {
+		palette = DefaultColorPalette
+	}
+	items := container.NewGridWrap(fyne.NewSquareSize(theme.IconInlineSize() + theme.Padding()))
+	for _, col := range palette {
+		items.Add(b.newChoice(col))
+	}
+	content := container.NewVBox(items)
+
+	if recents := ColorRecents(); len(recents) > 0 {
+		recent := container.NewGridWrap(fyne.NewSquareSize(theme.IconInlineSize() + theme.Padding()))
+		for _, col := range recents {
+			recent.Add(b.newChoice(col))
+		}
+		content.Add(widget.NewLabelWithStyle(i18n.L("Recent"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+		content.Add(recent)
+	}
+
+	hex := widget.NewEntry()
+	hex.SetPlaceHolder("#rrggbb")
+	hex.SetText(ColorToHex(b.Color))
+	hex.Validator = func(s string) error {
+		_, err := ParseHexColor(s)
+		return err
+	}
+	hex.OnSubmitted = func(s string) {
+		if col, err := ParseHexColor(s); err == nil {
+			b.choose(col)
+		}
+	}
+	content.Add(hex)
+
+	width := float32(len(DefaultColorPalette)/2) * (theme.IconInlineSize() + theme.Padding()*2)
+	b.popUp = widget.NewPopUp(container.NewPadded(content), c)
+	b.popUp.Resize(fyne.NewSize(width, b.popUp.MinSize().Height))
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(b)
+	b.popUp.ShowAtPosition(pos.AddXY(0, b.Size().Height))
+}
+
+// Tapped opens the color picker.
+//
+// Implements: fyne.Tappable
+func (b *ColorButton) Tapped(*fyne.PointEvent) {
+	b.ShowPicker()
+}
+
+func (b *ColorButton) choose(c color.Color) {
+	b.HidePicker()
+	AddColorRecent(c)
+	b.SetColor(c)
+}
+
+func (b *ColorButton) newChoice(c color.Color) fyne.CanvasObject {
+	return newColorSwatch(c, func() {
+		b.choose(c)
+	})
+}
+
+func (b *ColorButton) setColor(c color.Color, notifyBinding bool) {
+	if colorsEqual(c, b.Color) {
+		return
+	}
+	b.Color = c
+	b.Refresh()
+
+	if notifyBinding && b.binder != nil {
+		if err := b.binder.data.Set(c); err != nil {
+			fyne.LogError("Error setting current data value", err)
+		}
+	}
+	if f := b.OnChanged; f != nil {
+		f(c)
+	}
+}
+
+// AddColorRecent adds a color to the front of the recently used colors list.
+func AddColorRecent(c color.Color) {
+	hex := ColorToHex(c)
+	recents := []string{hex}
+	for _, r := range colorRecentHexes() {
+		if r != hex && len(recents) < colorRecentsMax {
+			recents = append(recents, r)
+		}
+	}
+	if app := fyne.CurrentApp(); app != nil {
+		app.Preferences().SetString(colorRecentsKey, strings.Join(recents, "|"))
+	}
+}
+
+// ColorRecents returns the recently chosen colors, newest first.
+func ColorRecents() []color.Color {
+	var recents []color.Color
+	for _, hex := range colorRecentHexes() {
+		if c, err := ParseHexColor(hex); err == nil {
+			recents = append(recents, c)
+		}
+	}
+	return recents
+}
+
+// ColorToHex formats a color as "#rrggbb", or "#rrggbbaa" if it is not opaque.
+func ColorToHex(c color.Color) string {
+	if c == nil {
+		return ""
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	if n.A == 0xff {
+		return fmt.Sprintf("#%02x%02x%02x", n.R, n.G, n.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", n.R, n.G, n.B, n.A)
+}
+
+// ParseHexColor parses colors in the "#rgb", "#rrggbb" or "#rrggbbaa" formats, the "#" is optional.
+func ParseHexColor(s string) (color.NRGBA, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	c := color.NRGBA{A: 0xff}
+	var err error
+	switch len(s) {
+	case 3:
+		_, err = fmt.Sscanf(s, "%1x%1x%1x", &c.R, &c.G, &c.B)
+		c.R, c.G, c.B = c.R*0x11, c.G*0x11, c.B*0x11
+	case 6:
+		_, err = fmt.Sscanf(s, "%02x%02x%02x", &c.R, &c.G, &c.B)
+	case 8:
+		_, err = fmt.Sscanf(s, "%02x%02x%02x%02x", &c.R, &c.G, &c.B, &c.A)
+	default:
+		err = fmt.Errorf("invalid color %q", s)
+	}
+	return c, err
+}
+
+func colorRecentHexes() []string {
+	app := fyne.CurrentApp()
+	if app == nil {
+		return nil
+	}
+	stored := app.Preferences().String(colorRecentsKey)
+	if stored == "" {
+		return nil
+	}
+	return strings.Split(stored, "|")
+}
+
+func colorsEqual(a, b color.Color) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	r1, g1, b1, a1 := a.RGBA()
+	r2, g2, b2, a2 := b.RGBA()
+	return r1 == r2 && g1 == g2 && b1 == b2 && a1 == a2
+}
+
+type colorBinder struct {
+	data     binding.Untyped
+	listener binding.DataListener
+}
+
+type colorButtonRenderer struct {
+	button       *ColorButton
+	border, well *canvas.Rectangle
+}
+
+func (r *colorButtonRenderer) Destroy() {
+}
+
+func (r *colorButtonRenderer) Layout(size fyne.Size) {
+	r.border.Resize(size)
+	inset := theme.Padding()
+	r.well.Move(fyne.NewPos(inset, inset))
+	r.well.Resize(size.SubtractWidthHeight(inset*2, inset*2))
+}
+
+func (r *colorButtonRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(theme.IconInlineSize() + theme.InnerPadding()*2)
+}
+
+func (r *colorButtonRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.border, r.well}
+}
+
+func (r *colorButtonRenderer) Refresh() {
+	b := r.button
+	r.well.FillColor = b.Color
+	r.border.FillColor = theme.Color(theme.ColorNameInputBackground)
+	r.border.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	if b.hovered && !b.Disabled() {
+		r.border.FillColor = theme.Color(theme.ColorNameHover)
+	}
+	if b.Disabled() {
+		r.border.StrokeColor = theme.Color(theme.ColorNameDisabled)
+	}
+	r.border.Refresh()
+	r.well.Refresh()
+}
+
+// colorSwatch is a tappable square of color used in the picker.
+type colorSwatch struct {
+	widget.BaseWidget
+	color    color.Color
+	onTapped func()
+}
+
+func newColorSwatch(c color.Color, tapped func()) *colorSwatch {
+	s := &colorSwatch{color: c, onTapped: tapped}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+func (s *colorSwatch) CreateRenderer() fyne.WidgetRenderer {
+	rect := canvas.NewRectangle(s.color)
+	rect.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	rect.StrokeWidth = 1
+	rect.CornerRadius = theme.InputRadiusSize()
+	return widget.NewSimpleRenderer(rect)
+}
+
+func (s *colorSwatch) Tapped(*fyne.PointEvent) {
+	if s.onTapped != nil {
+		s.onTapped()
+	}
+}
@@ -0,0 +1,101 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorButton_SetColor(t *testing.T) {
+	var changed color.Color
+	b := NewColorButton(color.White, func(c color.Color) {
+		changed = c
+	})
+
+	b.SetColor(color.Black)
+	assert.Equal(t, color.Black, b.Color)
+	assert.Equal(t, color.Black, changed)
+
+	changed = nil
+	b.SetColor(color.NRGBA{A: 0xff})
+	assert.Nil(t, changed, "equal colors should not trigger a change")
+}
+
+func TestColorButton_Picker(t *testing.T) {
+	a := test.NewApp()
+	defer test.NewApp()
+	a.Preferences().RemoveValue(colorRecentsKey)
+
+	b := NewColorButton(color.White, nil)
+	w := test.NewWindow(b)
+	defer w.Close()
+
+	test.Tap(b)
+	assert.NotNil(t, b.popUp)
+	assert.True(t, b.popUp.Visible())
+
+	b.choose(DefaultColorPalette[0])
+	assert.Nil(t, b.popUp)
+	assert.True(t, colorsEqual(DefaultColorPalette[0], b.Color))
+	assert.Len(t, ColorRecents(), 1)
+
+	b.Disable()
+	test.Tap(b)
+	assert.Nil(t, b.popUp)
+}
+
+func TestColorButton_Recents(t *testing.T) {
+	a := test.NewApp()
+	defer test.NewApp()
+	a.Preferences().RemoveValue(colorRecentsKey)
+
+	for i := 0; i < colorRecentsMax+2; i++ {
+		AddColorRecent(DefaultColorPalette[i])
+	}
+	AddColorRecent(DefaultColorPalette[3])
+
+	recents := ColorRecents()
+	assert.Len(t, recents, colorRecentsMax)
+	assert.True(t, colorsEqual(DefaultColorPalette[3], recents[0]))
+	assert.True(t, colorsEqual(DefaultColorPalette[colorRecentsMax+1], recents[1]))
+}
+
+func TestColorButton_Binding(t *testing.T) {
+	data := binding.NewUntyped()
+	_ = data.Set(color.Black)
+	b := NewColorButtonWithData(data)
+	waitForBinding()
+	assert.Equal(t, color.Black, b.Color)
+
+	b.SetColor(color.White)
+	waitForBinding()
+	v, _ := data.Get()
+	assert.Equal(t, color.White, v)
+
+	b.Unbind()
+	_ = data.Set(color.Black)
+	waitForBinding()
+	assert.Equal(t, color.White, b.Color)
+}
+
+func TestParseHexColor(t *testing.T) {
+	c, err := ParseHexColor("#f80")
+	assert.NoError(t, err)
+	assert.Equal(t, color.NRGBA{R: 0xff, G: 0x88, A: 0xff}, c)
+
+	c, err = ParseHexColor("102030")
+	assert.NoError(t, err)
+	assert.Equal(t, color.NRGBA{R: 0x10, G: 0x20, B: 0x30, A: 0xff}, c)
+
+	c, err = ParseHexColor("#10203040")
+	assert.NoError(t, err)
+	assert.Equal(t, "#10203040", ColorToHex(c))
+
+	_, err = ParseHexColor("#12")
+	assert.Error(t, err)
+	_, err = ParseHexColor("#zzzzzz")
+	assert.Error(t, err)
+}
@@ -0,0 +1,255 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"golang.org/x/image/vector"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Compass)(nil)
+
+// HeadingProvider reports the heading of a device, such as from its magnetometer or the track of its GPS.
+type HeadingProvider interface {
+	// Watch calls changed with each new heading, in degrees clockwise from north, until Stop is called.
+	Watch(changed func(heading float64)) error
+	// Stop ends the updates started by Watch.
+	Stop()
+}
+
+// Compass shows a compass rose turned to a heading, which is marked at the top.
+// It can also point to a target, such as the bearing to a destination on a map.
+// Changes of the heading are animated.
+type Compass struct {
+	widget.BaseWidget
+
+	// Heading is the direction faced, in degrees clockwise from north.
+	Heading float64
+	// Bearing is the direction of a target, in degrees clockwise from north, marked if ShowBearing is set.
+	Bearing     float64
+	ShowBearing bool
+
+	provider HeadingProvider
+}
+
+// NewCompass returns a compass facing north.
+func NewCompass() *Compass {
+	c := &Compass{}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (c *Compass) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+	r := &compassRenderer{compass: c, shown: c.Heading, target: c.Heading, heading: canvas.NewText("", color.Transparent)}
+	r.raster = canvas.NewRaster(r.draw)
+	r.heading.TextStyle.Bold = true
+	for _, name := range []string{"N", "E", "S", "W"} {
+		t := canvas.NewText(name, color.Transparent)
+		t.TextStyle.Bold = true
+		r.cardinals = append(r.cardinals, t)
+	}
+	r.Refresh()
+	return r
+}
+
+// SetBearing marks the direction of a target, in degrees clockwise from north.
+func (c *Compass) SetBearing(bearing float64) {
+	c.Bearing = bearing
+	c.ShowBearing = true
+	c.Refresh()
+}
+
+// SetHeading turns the compass to a heading, in degrees clockwise from north.
+func (c *Compass) SetHeading(heading float64) {
+	c.Heading = heading
+	c.Refresh()
+}
+
+// SetProvider follows the headings of a provider, stopping any provider that was followed.
+// A nil provider stops following.
+func (c *Compass) SetProvider(p HeadingProvider) error {
+	if c.provider != nil {
+		c.provider.Stop()
+	}
+	c.provider = p
+	if p == nil {
+		return nil
+	}
+	return p.Watch(c.SetHeading)
+}
+
+type compassRenderer struct {
+	compass   *Compass
+	raster    *canvas.Raster
+	cardinals []*canvas.Text
+	heading   *canvas.Text
+
+	shown, target float64
+	anim          *fyne.Animation
+}
+
+func (r *compassRenderer) Destroy() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+}
+
+func (r *compassRenderer) Layout(size fyne.Size) {
+	r.raster.Resize(size)
+	center, radius := r.geometry(size)
+	labelRadius := radius * .68
+	for i, t := range r.cardinals {
+		t.Resize(t.MinSize())
+		x, y := compassPoint(center, labelRadius, float64(i*90)-r.shown)
+		t.Move(fyne.NewPos(x-t.Size().Width/2, y-t.Size().Height/2))
+	}
+	r.heading.Resize(r.heading.MinSize())
+	r.heading.Move(center.SubtractXY(r.heading.Size().Width/2, -radius*.2))
+}
+
+func (r *compassRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(theme.IconInlineSize() * 6)
+}
+
+func (r *compassRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.raster, r.heading}
+	for _, t := range r.cardinals {
+		objects = append(objects, t)
+	}
+	return objects
+}
+
+func (r *compassRenderer) Refresh() {
+	c := r.compass
+	if c.Heading != r.target {
+		r.target = c.Heading
+		r.animate()
+	}
+
+	names := []string{"N", "E", "S", "W"}
+	for i, t := range r.cardinals {
+		t.Text = i18n.L(names[i])
+		t.TextSize = theme.TextSubHeadingSize()
+		t.Color = theme.Color(theme.ColorNameForeground)
+	}
+	r.cardinals[0].Color = theme.Color(theme.ColorNameError)
+	r.heading.Text = strconv.Itoa(int(math.Round(compassNormalize(c.Heading)))%360) + "°"
+	r.heading.TextSize = theme.CaptionTextSize()
+	r.heading.Color = theme.Color(theme.ColorNamePlaceHolder)
+	r.Layout(c.Size())
+	canvas.Refresh(c)
+}
+
+// animate turns the rose the short way round to the new heading.
+func (r *compassRenderer) animate() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+	start := r.shown
+	delta := compassTurn(start, r.target)
+	r.anim = fyne.NewAnimation(canvas.DurationStandard, func(done float32) {
+		r.shown = compassNormalize(start + delta*float64(done))
+		r.Layout(r.compass.Size())
+		canvas.Refresh(r.compass)
+	})
+	r.anim.Curve = fyne.AnimationEaseOut
+	r.anim.Start()
+}
+
+func (r *compassRenderer) draw(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	c := r.compass
+	size := c.Size()
+	if size.Width <= 0 {
+		return img
+	}
+	scale := float32(w) / size.Width
+	center, radius := r.geometry(size)
+	center, radius = fyne.NewPos(center.X*scale, center.Y*scale), radius*scale
+
+	bounds := img.Bounds()
+	fill := func(col color.Color, add func(*vector.Rasterizer)) {
+		v := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+		add(v)
+		v.Draw(img, bounds, image.NewUniform(col), image.Point{})
+	}
+	point := func(radius float32, angle float64) fyne.Position {
+		x, y := compassPoint(center, radius, angle)
+		return fyne.NewPos(x, y)
+	}
+
+	fill(theme.Color(theme.ColorNameInputBackground), func(v *vector.Rasterizer) {
+		addCircle(v, center, radius)
+	})
+	fg := theme.Color(theme.ColorNameForeground)
+	fill(fg, func(v *vector.Rasterizer) {
+		for d := 0; d < 360; d += 10 {
+			length, width := radius*.08, scale
+			if d%30 == 0 {
+				length, width = radius*.14, scale*1.5
+			}
+			a := float64(d) - r.shown
+			addSegment(v, point(radius-length, a), width/2, point(radius, a), width/2)
+		}
+	})
+
+	if c.ShowBearing {
+		a := c.Bearing - r.shown
+		fill(theme.Color(theme.ColorNamePrimary), func(v *vector.Rasterizer) {
+			tip, left, right := point(radius*.8, a), point(radius*.98, a-6), point(radius*.98, a+6)
+			v.MoveTo(tip.X, tip.Y)
+			v.LineTo(left.X, left.Y)
+			v.LineTo(right.X, right.Y)
+			v.ClosePath()
+		})
+	}
+
+	// the lubber line marks the heading at the top
+	fill(theme.Color(theme.ColorNameError), func(v *vector.Rasterizer) {
+		top := center.SubtractXY(0, radius)
+		v.MoveTo(top.X, top.Y+radius*.12)
+		v.LineTo(top.X-radius*.06, top.Y-scale)
+		v.LineTo(top.X+radius*.06, top.Y-scale)
+		v.ClosePath()
+	})
+	return img
+}
+
+// geometry returns the centre and radius of the rose of a compass of a size.
+func (r *compassRenderer) geometry(size fyne.Size) (fyne.Position, float32) {
+	radius := fyne.Min(size.Width, size.Height)/2 - theme.Padding()
+	return fyne.NewPos(size.Width/2, size.Height/2), radius
+}
+
+// compassNormalize returns a heading from 0 to less than 360 degrees.
+func compassNormalize(heading float64) float64 {
+	heading = math.Mod(heading, 360)
+	if heading < 0 {
+		heading += 360
+	}
+	return heading
+}
+
+// compassTurn returns the shortest turn from one heading to another, in degrees clockwise.
+func compassTurn(from, to float64) float64 {
+	return math.Mod(compassNormalize(to)-compassNormalize(from)+540, 360) - 180
+}
+
+// compassPoint returns the point at a distance from the centre in a direction, in degrees clockwise from up.
+func compassPoint(center fyne.Position, radius float32, degrees float64) (float32, float32) {
+	a := degrees * math.Pi / 180
+	return center.X + radius*float32(math.Sin(a)), center.Y - radius*float32(math.Cos(a))
+}
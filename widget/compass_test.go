@@ -0,0 +1,88 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeHeadingProvider struct {
+	changed func(float64)
+	stopped bool
+}
+
+func (p *fakeHeadingProvider) Watch(changed func(float64)) error {
+	p.changed = changed
+	return nil
+}
+
+func (p *fakeHeadingProvider) Stop() {
+	p.stopped = true
+}
+
+func TestCompass(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCompass()
+	c.Resize(fyne.NewSquareSize(200))
+	r := test.WidgetRenderer(c).(*compassRenderer)
+	assert.Equal(t, "0°", r.heading.Text)
+	north := r.cardinals[0]
+	assert.Equal(t, theme.Color(theme.ColorNameError), north.Color)
+	assert.InDelta(t, 100, north.Position().X+north.Size().Width/2, 1e-3)
+	assert.Less(t, north.Position().Y, float32(100))
+
+	c.SetHeading(-90)
+	assert.Equal(t, "270°", r.heading.Text)
+	assert.InDelta(t, 270, r.shown, 1e-9) // the test driver completes animations at once
+	// facing west, north is on the right
+	assert.Greater(t, north.Position().X, float32(100))
+	assert.InDelta(t, 100, north.Position().Y+north.Size().Height/2, 1e-3)
+}
+
+func TestCompass_Bearing(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCompass()
+	c.Resize(fyne.NewSquareSize(200))
+	r := test.WidgetRenderer(c).(*compassRenderer)
+	_, _, b, _ := r.draw(200, 200).At(100, 190).RGBA()
+	primary := theme.Color(theme.ColorNamePrimary)
+	_, _, pb, _ := primary.RGBA()
+	assert.NotEqual(t, pb, b)
+
+	c.SetBearing(180)
+	assert.True(t, c.ShowBearing)
+	_, _, b, _ = r.draw(200, 200).At(100, 190).RGBA()
+	assert.Equal(t, pb, b)
+}
+
+func TestCompass_Provider(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewCompass()
+	first, second := &fakeHeadingProvider{}, &fakeHeadingProvider{}
+	assert.NoError(t, c.SetProvider(first))
+	first.changed(45)
+	assert.Equal(t, 45.0, c.Heading)
+
+	assert.NoError(t, c.SetProvider(second))
+	assert.True(t, first.stopped)
+	second.changed(90)
+	assert.Equal(t, 90.0, c.Heading)
+
+	assert.NoError(t, c.SetProvider(nil))
+	assert.True(t, second.stopped)
+}
+
+func TestCompass_Turn(t *testing.T) {
+	assert.Equal(t, 20.0, compassTurn(350, 10))
+	assert.Equal(t, -20.0, compassTurn(10, -10))
+	assert.Equal(t, -90.0, compassTurn(90, 360))
+}
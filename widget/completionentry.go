@@ -1,22 +1,80 @@
 package widget
 
 import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 )
 
+// DefaultCompletionDebounce is the delay used by a CompletionEntry that has no Debounce set.
+const DefaultCompletionDebounce = 300 * time.Millisecond
+
+// SuggestFunc returns the options to complete a text with. It is called in a goroutine, so it can take
+// time such as to ask a remote service, and ctx is cancelled when the text changes before it returns.
+type SuggestFunc func(ctx context.Context, text string) ([]string, error)
+
+// CompletionItem is an option of a CompletionEntry that has more than its text, such as an icon and
+// a payload of the app.
+type CompletionItem struct {
+	// Text is put in the entry when the option is chosen.
+	Text string
+	// Detail is secondary text that is shown after the text, such as a description or a count.
+	Detail string
+	Icon   fyne.Resource
+	// Data is the value of the app that the option stands for, such as a record of a search result.
+	Data interface{}
+}
+
 // CompletionEntry is an Entry with options displayed in a PopUpMenu.
+// The options are set with SetOptions or SetItems, or by Suggest once typing pauses for the Debounce delay.
 type CompletionEntry struct {
 	widget.Entry
 	popupMenu     *widget.PopUp
 	navigableList *navigableList
 	Options       []string
+	items         []CompletionItem
 	pause         bool
 	itemHeight    float32
 
+	// CreateItem returns a new template object for an option, the icon, text and detail are shown if it is nil.
+	CreateItem func() fyne.CanvasObject `json:"-"`
+	// UpdateItem shows an option in an object returned by CreateItem.
+	UpdateItem func(item CompletionItem, object fyne.CanvasObject) `json:"-"`
+	// OnSelected is called with the option that is chosen, after its text is put in the entry.
+	OnSelected func(item CompletionItem) `json:"-"`
+
+	// Candidates are filtered as the text is typed, if it is set, to offer the ones that have its characters
+	// in order, best matches first, with the matched characters in bold.
+	Candidates []CompletionItem
+	// MaxMatches is how many of the Candidates are offered at most, all of them if 0.
+	MaxMatches int
+	matched    [][]int // the runes of the options that match the text, for Candidates
+
+	// Deprecated: use CreateItem instead.
 	CustomCreate func() fyne.CanvasObject
+	// Deprecated: use UpdateItem instead, which is given the option rather than its index.
 	CustomUpdate func(id widget.ListItemID, object fyne.CanvasObject)
+
+	// Suggest returns the options for the typed text, which are shown when it returns.
+	// An activity indicator is shown while it runs.
+	Suggest SuggestFunc `json:"-"`
+	// Debounce is how long typing must pause before Suggest is called, DefaultCompletionDebounce is used if zero.
+	Debounce time.Duration
+	// OnSuggestError is called when Suggest fails, the error is logged if it is not set.
+	OnSuggestError func(error) `json:"-"`
+
+	loading *widget.Activity
+
+	suggestLock sync.Mutex
+	timer       *time.Timer
+	cancel      context.CancelFunc
+	suggestion  uint64 // counts the Suggest calls, so that a call only clears timer and cancel if they are its own
 }
 
 // NewCompletionEntry creates a new CompletionEntry which creates a popup menu that responds to keystrokes to navigate through the items without losing the editing ability of the text input.
@@ -26,8 +84,40 @@ func NewCompletionEntry(options []string) *CompletionEntry {
 	return c
 }
 
-// HideCompletion hides the completion menu.
+// NewFuzzyCompletionEntry creates a new CompletionEntry that offers the candidates that fuzzily match the typed
+// text, so that "nwy" offers "New York".
+func NewFuzzyCompletionEntry(candidates []string) *CompletionEntry {
+	c := &CompletionEntry{Candidates: make([]CompletionItem, len(candidates))}
+	for i, text := range candidates {
+		c.Candidates[i].Text = text
+	}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// NewCompletionEntryWithSuggest creates a new CompletionEntry that shows the options that suggest returns
+// for the typed text, such as the results of a remote search.
+func NewCompletionEntryWithSuggest(suggest SuggestFunc) *CompletionEntry {
+	c := &CompletionEntry{Suggest: suggest}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (c *CompletionEntry) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+	if c.Suggest != nil && c.ActionItem == nil {
+		c.loading = widget.NewActivity()
+		c.loading.Hide()
+		c.ActionItem = c.loading
+	}
+	return c.Entry.CreateRenderer()
+}
+
+// HideCompletion hides the completion menu, and cancels the Suggest call that is pending or running.
 func (c *CompletionEntry) HideCompletion() {
+	c.cancelSuggest()
 	if c.popupMenu != nil {
 		c.popupMenu.Hide()
 	}
@@ -44,11 +134,33 @@ func (c *CompletionEntry) Move(pos fyne.Position) {
 	}
 }
 
+// Items returns the options, with the ones of Options that SetItems didn't set made of their text.
+func (c *CompletionEntry) Items() []CompletionItem {
+	if len(c.items) == len(c.Options) {
+		same := true
+		for i, item := range c.items {
+			if item.Text != c.Options[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return c.items
+		}
+	}
+
+	items := make([]CompletionItem, len(c.Options))
+	for i, text := range c.Options {
+		items[i] = CompletionItem{Text: text}
+	}
+	return items
+}
+
 // Refresh the list to update the options to display.
 func (c *CompletionEntry) Refresh() {
 	c.Entry.Refresh()
 	if c.navigableList != nil {
-		c.navigableList.SetOptions(c.Options)
+		c.navigableList.SetOptions(c.Items())
 	}
 }
 
@@ -61,8 +173,54 @@ func (c *CompletionEntry) Resize(size fyne.Size) {
 	}
 }
 
+// TypedKey asks for new options if the key changes the text, such as Backspace.
+//
+// Implements: fyne.Focusable
+func (c *CompletionEntry) TypedKey(key *fyne.KeyEvent) {
+	text := c.Text
+	c.Entry.TypedKey(key)
+	if c.Text != text {
+		c.textChanged()
+	}
+}
+
+// TypedRune asks for new options for the text with the typed character.
+//
+// Implements: fyne.Focusable
+func (c *CompletionEntry) TypedRune(r rune) {
+	text := c.Text
+	c.Entry.TypedRune(r)
+	if c.Text != text {
+		c.textChanged()
+	}
+}
+
+// TypedShortcut asks for new options if the shortcut changes the text, such as Paste.
+//
+// Implements: fyne.Shortcutable
+func (c *CompletionEntry) TypedShortcut(shortcut fyne.Shortcut) {
+	text := c.Text
+	c.Entry.TypedShortcut(shortcut)
+	if c.Text != text {
+		c.textChanged()
+	}
+}
+
+// SetItems sets the options with their icons, details and payloads, and updates the view.
+func (c *CompletionEntry) SetItems(items []CompletionItem) {
+	c.items = items
+	c.matched = nil
+	c.Options = make([]string, len(items))
+	for i, item := range items {
+		c.Options[i] = item.Text
+	}
+	c.Refresh()
+}
+
 // SetOptions set the completion list with itemList and update the view.
 func (c *CompletionEntry) SetOptions(itemList []string) {
+	c.items = nil
+	c.matched = nil
 	c.Options = itemList
 	c.Refresh()
 }
@@ -78,8 +236,8 @@ func (c *CompletionEntry) ShowCompletion() {
 	}
 
 	if c.navigableList == nil {
-		c.navigableList = newNavigableList(c.Options, &c.Entry, c.setTextFromMenu, c.HideCompletion,
-			c.CustomCreate, c.CustomUpdate)
+		c.navigableList = newNavigableList(c.Items(), c, c.setTextFromMenu, c.HideCompletion,
+			c.createItem, c.updateItem)
 	} else {
 		c.navigableList.UnselectAll()
 		c.navigableList.selected = -1
@@ -122,30 +280,205 @@ func (c *CompletionEntry) popUpPos() fyne.Position {
 	return entryPos.Add(fyne.NewPos(0, c.Size().Height))
 }
 
+func (c *CompletionEntry) createItem() fyne.CanvasObject {
+	switch {
+	case c.CreateItem != nil:
+		return c.CreateItem()
+	case c.CustomCreate != nil:
+		return c.CustomCreate()
+	}
+	return newCompletionItemRow()
+}
+
+func (c *CompletionEntry) updateItem(id widget.ListItemID, object fyne.CanvasObject) {
+	switch {
+	case c.UpdateItem != nil:
+		c.UpdateItem(c.navigableList.items[id], object)
+	case c.CustomUpdate != nil:
+		c.CustomUpdate(id, object)
+	default:
+		var matched []int
+		if id < len(c.matched) {
+			matched = c.matched[id]
+		}
+		object.(*completionItemRow).update(c.navigableList.items[id], matched)
+	}
+}
+
 // Prevent the menu to open when the user validate value from the menu.
-func (c *CompletionEntry) setTextFromMenu(s string) {
+func (c *CompletionEntry) setTextFromMenu(item CompletionItem) {
 	c.pause = true
-	c.Entry.SetText(s)
-	c.Entry.CursorColumn = len([]rune(s))
+	c.Entry.SetText(item.Text)
+	c.Entry.CursorColumn = len([]rune(item.Text))
 	c.Entry.Refresh()
 	c.pause = false
 	c.popupMenu.Hide()
+	if f := c.OnSelected; f != nil {
+		f(item)
+	}
+}
+
+// cancelSuggest stops the Suggest call that is waiting for the debounce delay or running.
+func (c *CompletionEntry) cancelSuggest() {
+	c.suggestLock.Lock()
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+	c.setLoading(false)
+	c.suggestLock.Unlock()
+}
+
+// setLoading shows or hides the activity indicator, it is called with suggestLock held so that a call that
+// was cancelled cannot show it after it is hidden.
+func (c *CompletionEntry) setLoading(loading bool) {
+	if c.loading == nil {
+		return
+	}
+	if loading {
+		c.loading.Show()
+		c.loading.Start()
+	} else {
+		c.loading.Stop()
+		c.loading.Hide()
+	}
+}
+
+// suggest calls Suggest for a text and shows the options, unless the text changed meanwhile.
+func (c *CompletionEntry) suggest(ctx context.Context, suggestion uint64, text string) {
+	c.suggestLock.Lock()
+	if ctx.Err() != nil || c.suggestion != suggestion {
+		c.suggestLock.Unlock()
+		return
+	}
+	c.setLoading(true)
+	c.suggestLock.Unlock()
+
+	options, err := c.Suggest(ctx, text)
+	c.suggestLock.Lock()
+	current := ctx.Err() == nil && c.suggestion == suggestion
+	if current {
+		c.timer, c.cancel = nil, nil
+	}
+	if current || c.cancel == nil { // a newer call shows the indicator until it returns
+		c.setLoading(false)
+	}
+	c.suggestLock.Unlock()
+	if !current {
+		return
+	}
+
+	if err != nil {
+		if f := c.OnSuggestError; f != nil {
+			f(err)
+		} else {
+			fyne.LogError("Failed to get completions", err)
+		}
+		c.HideCompletion()
+		return
+	}
+	c.SetOptions(options)
+	if fyne.CurrentApp().Driver().CanvasForObject(c) == nil {
+		return
+	}
+	c.ShowCompletion()
+}
+
+// textChanged calls Suggest for the text once typing pauses, cancelling the call for the text before.
+func (c *CompletionEntry) textChanged() {
+	if c.pause {
+		return
+	}
+	if c.Candidates != nil {
+		c.filterCandidates()
+		return
+	}
+	if c.Suggest == nil {
+		return
+	}
+	c.cancelSuggest()
+	text := c.Text
+	if text == "" {
+		c.HideCompletion()
+		return
+	}
+
+	delay := c.Debounce
+	if delay <= 0 {
+		delay = DefaultCompletionDebounce
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.suggestLock.Lock()
+	defer c.suggestLock.Unlock()
+	c.suggestion++
+	suggestion := c.suggestion
+	c.cancel = cancel
+	c.timer = time.AfterFunc(delay, func() {
+		c.suggest(ctx, suggestion, text)
+	})
+}
+
+// filterCandidates offers the Candidates that match the text, best first.
+func (c *CompletionEntry) filterCandidates() {
+	if c.Text == "" {
+		c.HideCompletion()
+		return
+	}
+	type match struct {
+		item      CompletionItem
+		score     int
+		positions []int
+	}
+	var matches []match
+	for _, item := range c.Candidates {
+		if score, positions, ok := fuzzyMatch(c.Text, item.Text); ok {
+			matches = append(matches, match{item, score, positions})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return len(matches[i].item.Text) < len(matches[j].item.Text)
+	})
+	if c.MaxMatches > 0 && len(matches) > c.MaxMatches {
+		matches = matches[:c.MaxMatches]
+	}
+
+	c.items = make([]CompletionItem, len(matches))
+	c.Options = make([]string, len(matches))
+	c.matched = make([][]int, len(matches))
+	for i, m := range matches {
+		c.items[i], c.Options[i], c.matched[i] = m.item, m.item.Text, m.positions
+	}
+	c.Refresh()
+	if len(matches) == 0 {
+		c.HideCompletion()
+		return
+	}
+	if fyne.CurrentApp().Driver().CanvasForObject(c) != nil {
+		c.ShowCompletion()
+	}
 }
 
 type navigableList struct {
 	widget.List
-	entry           *widget.Entry
+	entry           fyne.Focusable
 	selected        int
-	setTextFromMenu func(string)
+	setTextFromMenu func(CompletionItem)
 	hide            func()
 	navigating      bool
-	items           []string
+	items           []CompletionItem
 
 	customCreate func() fyne.CanvasObject
 	customUpdate func(id widget.ListItemID, object fyne.CanvasObject)
 }
 
-func newNavigableList(items []string, entry *widget.Entry, setTextFromMenu func(string), hide func(),
+func newNavigableList(items []CompletionItem, entry fyne.Focusable, setTextFromMenu func(CompletionItem), hide func(),
 	create func() fyne.CanvasObject, update func(id widget.ListItemID, object fyne.CanvasObject)) *navigableList {
 	n := &navigableList{
 		entry:           entry,
@@ -172,7 +505,7 @@ func newNavigableList(items []string, entry *widget.Entry, setTextFromMenu func(
 				fn(i, o)
 				return
 			}
-			o.(*widget.Label).SetText(n.items[i])
+			o.(*widget.Label).SetText(n.items[i].Text)
 		},
 		OnSelected: func(id widget.ListItemID) {
 			if !n.navigating && id > -1 {
@@ -193,7 +526,7 @@ func (n *navigableList) FocusGained() {
 func (n *navigableList) FocusLost() {
 }
 
-func (n *navigableList) SetOptions(items []string) {
+func (n *navigableList) SetOptions(items []CompletionItem) {
 	n.Unselect(n.selected)
 	n.items = items
 	n.Refresh()
@@ -238,3 +571,68 @@ func (n *navigableList) TypedKey(event *fyne.KeyEvent) {
 func (n *navigableList) TypedRune(r rune) {
 	n.entry.TypedRune(r)
 }
+
+// completionItemRow shows the icon, text and detail of an option, with the characters that match the text
+// of the entry in bold.
+type completionItemRow struct {
+	widget.BaseWidget
+	icon   *widget.Icon
+	text   *widget.RichText
+	detail *widget.Label
+}
+
+func newCompletionItemRow() *completionItemRow {
+	r := &completionItemRow{icon: widget.NewIcon(nil), text: widget.NewRichText(), detail: widget.NewLabel("")}
+	r.icon.Hide()
+	r.text.Truncation = fyne.TextTruncateEllipsis
+	r.detail.Importance = widget.LowImportance
+	r.detail.Hide()
+	r.ExtendBaseWidget(r)
+	return r
+}
+
+func (r *completionItemRow) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewBorder(nil, nil, r.icon, r.detail, r.text))
+}
+
+func (r *completionItemRow) update(item CompletionItem, matched []int) {
+	r.icon.SetResource(item.Icon)
+	if item.Icon == nil {
+		r.icon.Hide()
+	} else {
+		r.icon.Show()
+	}
+	r.text.Segments = completionSegments(item.Text, matched)
+	r.text.Refresh()
+	r.detail.SetText(item.Detail)
+	if item.Detail == "" {
+		r.detail.Hide()
+	} else {
+		r.detail.Show()
+	}
+	r.Refresh()
+}
+
+// completionSegments returns the text of an option, with runs of the matched runes in bold.
+func completionSegments(text string, matched []int) []widget.RichTextSegment {
+	bold := make(map[int]bool, len(matched))
+	for _, i := range matched {
+		bold[i] = true
+	}
+
+	var segments []widget.RichTextSegment
+	runes := []rune(text)
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		if i < len(runes) && bold[i] == bold[start] {
+			continue
+		}
+		style := widget.RichTextStyleInline
+		if bold[start] {
+			style = widget.RichTextStyleStrong
+		}
+		segments = append(segments, &widget.TextSegment{Style: style, Text: string(runes[start:i])})
+		start = i
+	}
+	return segments
+}
@@ -1,10 +1,15 @@
 package widget
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 	"github.com/stretchr/testify/assert"
 )
@@ -228,3 +233,200 @@ func TestCompletionEntry_DoubleSubmissionIssue(t *testing.T) {
 	win.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn}) // OnSubmitted should be called
 	assert.True(t, submitted)
 }
+
+// Ask for options once typing pauses, cancelling the request for the text before.
+func TestCompletionEntry_Suggest(t *testing.T) {
+	var lock sync.Mutex
+	var queries []string
+	cancelled := 0
+	entry := NewCompletionEntryWithSuggest(func(ctx context.Context, text string) ([]string, error) {
+		lock.Lock()
+		queries = append(queries, text)
+		lock.Unlock()
+		if text == "fo" {
+			<-ctx.Done()
+			lock.Lock()
+			cancelled++
+			lock.Unlock()
+			return nil, ctx.Err()
+		}
+		return []string{text + "o", text + "x"}, nil
+	})
+	entry.Debounce = 20 * time.Millisecond
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+	win.Canvas().Focus(entry)
+
+	test.Type(entry, "fo")
+	assert.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(queries) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"fo"}, queries, "the first letter is debounced")
+	assert.True(t, entry.loading.Visible())
+
+	win.Canvas().Focused().TypedRune('o')
+	assert.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(queries) == 2 && cancelled == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return entry.popupMenu != nil && entry.popupMenu.Visible()
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"fooo", "foox"}, entry.Options)
+	assert.False(t, entry.loading.Visible())
+}
+
+func TestCompletionEntry_SuggestCleared(t *testing.T) {
+	started := make(chan string, 2)
+	entry := NewCompletionEntryWithSuggest(func(ctx context.Context, text string) ([]string, error) {
+		started <- text
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	entry.Debounce = 20 * time.Millisecond
+	win := test.NewWindow(entry)
+	defer win.Close()
+	win.Canvas().Focus(entry)
+
+	// cleared before the debounce delay, Suggest is not called
+	test.Type(entry, "a")
+	win.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	time.Sleep(entry.Debounce * 3)
+	assert.Empty(t, entry.Text)
+	assert.Empty(t, started)
+	assert.False(t, entry.loading.Visible())
+
+	// cleared while Suggest runs
+	test.Type(entry, "b")
+	select {
+	case text := <-started:
+		assert.Equal(t, "b", text)
+	case <-time.After(time.Second):
+		t.Fatal("Suggest was not called")
+	}
+	win.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	assert.False(t, entry.loading.Visible())
+	time.Sleep(entry.Debounce * 3)
+	assert.False(t, entry.loading.Visible())
+	assert.Nil(t, entry.popupMenu)
+}
+
+func TestCompletionEntry_SuggestError(t *testing.T) {
+	errc := make(chan error, 1)
+	entry := NewCompletionEntryWithSuggest(func(context.Context, string) ([]string, error) {
+		return nil, errors.New("offline")
+	})
+	entry.Debounce = time.Millisecond
+	entry.OnSuggestError = func(err error) {
+		errc <- err
+	}
+	win := test.NewWindow(entry)
+	defer win.Close()
+
+	test.Type(entry, "a")
+	select {
+	case err := <-errc:
+		assert.EqualError(t, err, "offline")
+	case <-time.After(time.Second):
+		t.Error("OnSuggestError was not called")
+	}
+	assert.Nil(t, entry.popupMenu)
+}
+
+// Choose an option with an icon, detail and payload.
+func TestCompletionEntry_Items(t *testing.T) {
+	entry := NewCompletionEntry(nil)
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+
+	var chosen CompletionItem
+	entry.OnSelected = func(item CompletionItem) {
+		chosen = item
+	}
+	entry.SetItems([]CompletionItem{
+		{Text: "Paris", Detail: "France", Icon: theme.HomeIcon(), Data: 75},
+		{Text: "Berlin", Detail: "Germany", Data: 10},
+	})
+	assert.Equal(t, []string{"Paris", "Berlin"}, entry.Options)
+	entry.ShowCompletion()
+
+	row := entry.createItem().(*completionItemRow)
+	entry.updateItem(0, row)
+	assert.Equal(t, "Paris", row.text.String())
+	assert.Equal(t, "France", row.detail.Text)
+	assert.True(t, row.icon.Visible())
+	entry.updateItem(1, row)
+	assert.False(t, row.icon.Visible())
+
+	win.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	win.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	win.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, "Berlin", entry.Text)
+	assert.Equal(t, 10, chosen.Data)
+
+	entry.SetOptions([]string{"Rome"})
+	assert.Equal(t, []CompletionItem{{Text: "Rome"}}, entry.Items())
+}
+
+// Render the options with CreateItem and UpdateItem.
+func TestCompletionEntry_CreateItem(t *testing.T) {
+	entry := NewCompletionEntry(nil)
+	entry.CreateItem = func() fyne.CanvasObject {
+		return widget.NewCheck("", nil)
+	}
+	entry.UpdateItem = func(item CompletionItem, o fyne.CanvasObject) {
+		check := o.(*widget.Check)
+		check.Text = item.Text
+		check.Checked = item.Data.(bool)
+		check.Refresh()
+	}
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+
+	entry.SetItems([]CompletionItem{{Text: "done", Data: true}})
+	entry.ShowCompletion()
+	scroll := test.WidgetRenderer(entry.navigableList).Objects()[0].(fyne.Widget)
+	list := test.WidgetRenderer(scroll).Objects()[0].(*fyne.Container).Objects[0].(fyne.Widget)
+	check := test.WidgetRenderer(list).Objects()[1].(*widget.Check)
+	assert.Equal(t, "done", check.Text)
+	assert.True(t, check.Checked)
+}
+
+// Offer the candidates that match the typed text, best first.
+func TestCompletionEntry_Fuzzy(t *testing.T) {
+	entry := NewFuzzyCompletionEntry([]string{"Amsterdam", "New York", "Newark", "Sydney"})
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+	win.Canvas().Focus(entry)
+
+	test.Type(entry, "ny")
+	assert.Equal(t, []string{"New York", "Sydney"}, entry.Options)
+	assert.True(t, entry.popupMenu.Visible())
+	assert.Equal(t, []int{0, 4}, entry.matched[0])
+
+	row := entry.createItem().(*completionItemRow)
+	entry.updateItem(0, row)
+	assert.Equal(t, "New York", row.text.String())
+	assert.Len(t, row.text.Segments, 4)
+	assert.True(t, row.text.Segments[0].(*widget.TextSegment).Style.TextStyle.Bold)
+	assert.False(t, row.text.Segments[1].(*widget.TextSegment).Style.TextStyle.Bold)
+
+	win.Canvas().Focused().TypedRune('q')
+	assert.Empty(t, entry.Options)
+	assert.False(t, entry.popupMenu.Visible())
+}
+
+func TestCompletionSegments(t *testing.T) {
+	segments := completionSegments("abcd", []int{1, 2})
+	assert.Len(t, segments, 3)
+	assert.Equal(t, "bc", segments[1].(*widget.TextSegment).Text)
+	assert.True(t, segments[1].(*widget.TextSegment).Style.TextStyle.Bold)
+	assert.Empty(t, completionSegments("", nil))
+}
@@ -0,0 +1,150 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*ContextMenu)(nil)
+var _ fyne.SecondaryTappable = (*ContextMenu)(nil)
+
+// ContextMenu attaches a menu to any object, which is shown where the object is tapped with the secondary
+// mouse button or long pressed on touch screens. Content that handles secondary taps itself, such as an Entry,
+// keeps its own menu.
+//
+// The items can have icons, separators and submenus, and NewCheckMenuItem and NewRadioMenuItems create items
+// with check marks. Set Build to create the menu each time it is shown, such as to disable items that don't
+// apply to the current state.
+type ContextMenu struct {
+	widget.BaseWidget
+
+	Content fyne.CanvasObject
+	Menu    *fyne.Menu
+	// Build returns the menu each time it is about to be shown, it takes precedence over Menu.
+	Build func() *fyne.Menu `json:"-"`
+}
+
+// NewContextMenu creates a new context menu for content showing the items of a menu.
+func NewContextMenu(content fyne.CanvasObject, menu *fyne.Menu) *ContextMenu {
+	m := &ContextMenu{Content: content, Menu: menu}
+	m.ExtendBaseWidget(m)
+	return m
+}
+
+// NewContextMenuWithBuilder creates a new context menu for content showing the menu returned by build
+// each time it is shown.
+func NewContextMenuWithBuilder(content fyne.CanvasObject, build func() *fyne.Menu) *ContextMenu {
+	m := &ContextMenu{Content: content, Build: build}
+	m.ExtendBaseWidget(m)
+	return m
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (m *ContextMenu) CreateRenderer() fyne.WidgetRenderer {
+	m.ExtendBaseWidget(m)
+	return &contextMenuRenderer{menu: m}
+}
+
+// ShowAtPosition shows the menu at a position relative to the top left of the content.
+func (m *ContextMenu) ShowAtPosition(pos fyne.Position) {
+	c := fyne.CurrentApp().Driver().CanvasForObject(m)
+	if c == nil {
+		return
+	}
+	menu := m.menu()
+	if menu == nil || len(menu.Items) == 0 {
+		return
+	}
+	widget.ShowPopUpMenuAtRelativePosition(menu, c, pos, m)
+}
+
+// TappedSecondary shows the menu where the content was tapped.
+//
+// Implements: fyne.SecondaryTappable
+func (m *ContextMenu) TappedSecondary(ev *fyne.PointEvent) {
+	m.ShowAtPosition(ev.Position)
+}
+
+func (m *ContextMenu) menu() *fyne.Menu {
+	if m.Build != nil {
+		return m.Build()
+	}
+	return m.Menu
+}
+
+// NewCheckMenuItem creates a menu item that toggles a check mark when tapped, calling changed with its new state.
+func NewCheckMenuItem(label string, checked bool, changed func(bool)) *fyne.MenuItem {
+	item := &fyne.MenuItem{Label: label, Checked: checked}
+	item.Action = func() {
+		item.Checked = !item.Checked
+		if changed != nil {
+			changed(item.Checked)
+		}
+	}
+	return item
+}
+
+// NewMenuItemWithIcon creates a menu item with an icon before its label that calls action when tapped.
+func NewMenuItemWithIcon(label string, icon fyne.Resource, action func()) *fyne.MenuItem {
+	return &fyne.MenuItem{Label: label, Icon: icon, Action: action}
+}
+
+// NewRadioMenuItems creates a menu item for each of the options, where only the selected one is checked.
+// Tapping an option checks it instead and calls changed with it.
+func NewRadioMenuItems(options []string, selected string, changed func(string)) []*fyne.MenuItem {
+	items := make([]*fyne.MenuItem, len(options))
+	for i, option := range options {
+		option := option
+		items[i] = &fyne.MenuItem{Label: option, Checked: option == selected}
+		items[i].Action = func() {
+			for j, item := range items {
+				item.Checked = options[j] == option
+			}
+			if changed != nil {
+				changed(option)
+			}
+		}
+	}
+	return items
+}
+
+// NewSubmenuItem creates a menu item that opens a submenu of items.
+func NewSubmenuItem(label string, icon fyne.Resource, items ...*fyne.MenuItem) *fyne.MenuItem {
+	return &fyne.MenuItem{Label: label, Icon: icon, ChildMenu: fyne.NewMenu("", items...)}
+}
+
+type contextMenuRenderer struct {
+	menu *ContextMenu
+}
+
+func (r *contextMenuRenderer) Destroy() {
+}
+
+func (r *contextMenuRenderer) Layout(size fyne.Size) {
+	if c := r.menu.Content; c != nil {
+		c.Resize(size)
+	}
+}
+
+func (r *contextMenuRenderer) MinSize() fyne.Size {
+	if c := r.menu.Content; c != nil {
+		return c.MinSize()
+	}
+	return fyne.NewSize(0, 0)
+}
+
+func (r *contextMenuRenderer) Objects() []fyne.CanvasObject {
+	if c := r.menu.Content; c != nil {
+		return []fyne.CanvasObject{c}
+	}
+	return nil
+}
+
+func (r *contextMenuRenderer) Refresh() {
+	if c := r.menu.Content; c != nil {
+		r.Layout(r.menu.Size())
+		c.Refresh()
+	}
+}
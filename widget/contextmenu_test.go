@@ -0,0 +1,77 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextMenu_Show(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	label := widget.NewLabel("Right click me")
+	builds := 0
+	m := NewContextMenuWithBuilder(label, func() *fyne.Menu {
+		builds++
+		return fyne.NewMenu("",
+			NewMenuItemWithIcon("Copy", theme.ContentCopyIcon(), nil),
+			fyne.NewMenuItemSeparator(),
+			NewSubmenuItem("Sort", nil, NewRadioMenuItems([]string{"Name", "Date"}, "Name", nil)...),
+		)
+	})
+	w := test.NewWindow(m)
+	defer w.Close()
+	assert.Equal(t, label.MinSize(), m.MinSize())
+
+	test.TapSecondary(m)
+	assert.Equal(t, 1, builds)
+	assert.NotNil(t, w.Canvas().Overlays().Top())
+
+	w.Canvas().Overlays().Top().(fyne.Tappable).Tapped(&fyne.PointEvent{})
+	assert.Nil(t, w.Canvas().Overlays().Top(), "tapping outside should dismiss the menu")
+	test.TapSecondary(m)
+	assert.Equal(t, 2, builds, "the menu should be built each time it is shown")
+}
+
+func TestContextMenu_EmptyMenu(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := NewContextMenu(widget.NewLabel("No menu"), fyne.NewMenu(""))
+	w := test.NewWindow(m)
+	defer w.Close()
+
+	test.TapSecondary(m)
+	assert.Nil(t, w.Canvas().Overlays().Top())
+}
+
+func TestNewCheckMenuItem(t *testing.T) {
+	var changes []bool
+	item := NewCheckMenuItem("Word wrap", false, func(on bool) {
+		changes = append(changes, on)
+	})
+	item.Action()
+	assert.True(t, item.Checked)
+	item.Action()
+	assert.False(t, item.Checked)
+	assert.Equal(t, []bool{true, false}, changes)
+}
+
+func TestNewRadioMenuItems(t *testing.T) {
+	selected := ""
+	items := NewRadioMenuItems([]string{"Small", "Medium", "Large"}, "Medium", func(s string) {
+		selected = s
+	})
+	assert.True(t, items[1].Checked)
+
+	items[2].Action()
+	assert.Equal(t, "Large", selected)
+	assert.False(t, items[0].Checked)
+	assert.False(t, items[1].Checked)
+	assert.True(t, items[2].Checked)
+}
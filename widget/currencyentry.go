@@ -0,0 +1,48 @@
+package widget
+
+import (
+	"fyne.io/x/fyne/i18n"
+)
+
+// CurrencyEntry is an entry for an amount of money, with the decimals of its currency. It shows the amount
+// with the currency symbol and the separators of the locale, such as "$1,234.50" or "1.234,50 €", and only the
+// number while it is edited. Value returns the amount, however it is shown.
+type CurrencyEntry struct {
+	NumericalEntry
+	currency string
+}
+
+// NewCurrencyEntry returns an entry for an amount of a currency, given by its ISO 4217 code such as "EUR".
+func NewCurrencyEntry(currency string) *CurrencyEntry {
+	e := &CurrencyEntry{}
+	e.AllowNegative = true
+	e.Localized = true
+	e.ExtendBaseWidget(e)
+	e.SetCurrency(currency)
+	return e
+}
+
+// Currency returns the ISO 4217 code of the currency of the amount.
+func (e *CurrencyEntry) Currency() string {
+	return e.currency
+}
+
+// SetCurrency changes the currency of the amount, given by its ISO 4217 code, keeping its value.
+func (e *CurrencyEntry) SetCurrency(currency string) {
+	v, err := e.Value()
+	e.currency = currency
+	digits := i18n.CurrencyDigits(currency)
+	e.AllowFloat = digits > 0
+	e.Precision = digits
+
+	locale := i18n.Locale()
+	symbol := i18n.CurrencySymbol(locale, currency)
+	if i18n.CurrencySymbolAfter(locale) {
+		e.prefix, e.suffix = "", " "+symbol
+	} else {
+		e.prefix, e.suffix = symbol, ""
+	}
+	if err == nil {
+		e.SetValue(v)
+	}
+}
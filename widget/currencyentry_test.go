@@ -0,0 +1,72 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+func useLocale(t *testing.T, locale string) {
+	previous := i18n.Locale()
+	i18n.SetLocale(locale)
+	t.Cleanup(func() {
+		i18n.SetLocale(previous)
+	})
+}
+
+func TestCurrencyEntry(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+	useLocale(t, "en")
+
+	e := NewCurrencyEntry("USD")
+	e.SetValue(1234.5)
+	assert.Equal(t, "$1,234.50", e.Text)
+	v, err := e.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, 1234.5, v)
+
+	w := test.NewWindow(e)
+	defer w.Close()
+	w.Canvas().Focus(e)
+	assert.Equal(t, "1,234.50", e.Text, "only the number is edited")
+	e.SetText("")
+	test.Type(e, "-2000.256")
+	w.Canvas().Unfocus()
+	assert.Equal(t, "-$2,000.26", e.Text)
+	v, _ = e.Value()
+	assert.Equal(t, -2000.256, v, "the value isn't rounded by its text while it is kept")
+
+	e.SetCurrency("JPY")
+	assert.False(t, e.AllowFloat)
+	assert.Equal(t, "-¥2,000", e.Text)
+}
+
+func TestCurrencyEntry_Locale(t *testing.T) {
+	useLocale(t, "de")
+
+	e := NewCurrencyEntry("EUR")
+	e.SetValue(1234.5)
+	assert.Equal(t, "1.234,50 €", e.Text)
+	e.SetText("98.765,4 €")
+	v, err := e.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, 98765.4, v)
+}
+
+func TestNumericalEntry_Localized(t *testing.T) {
+	useLocale(t, "de")
+
+	e := NewNumericalEntry()
+	e.AllowFloat = true
+	e.Localized = true
+	e.SetValue(1234567.25)
+	assert.Equal(t, "1.234.567,25", e.Text)
+	e.CursorColumn = len([]rune(e.Text))
+	test.Type(e, "5")
+	v, _ := e.Value()
+	assert.Equal(t, 1234567.255, v)
+}
@@ -0,0 +1,319 @@
+package widget
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*DateRangeEntry)(nil)
+var _ fyne.Focusable = (*DateRangeEntry)(nil)
+
+const dateRangeSeparator = " – "
+
+var errDateRangeFormat = errors.New("expected a date range such as \"start – end\"")
+
+// DateRangePreset is a named, commonly used range offered next to the calendars.
+type DateRangePreset struct {
+	// Label is translated to the current locale with i18n.L when it is shown.
+	Label string
+	Range func(now time.Time) (start, end time.Time)
+}
+
+// DefaultDateRangePresets are the presets used by a DateRangeEntry that has none set.
+var DefaultDateRangePresets = []DateRangePreset{
+	{"Today", func(now time.Time) (time.Time, time.Time) {
+		d := truncateDay(now)
+		return d, d
+	}},
+	{"Last 7 days", func(now time.Time) (time.Time, time.Time) {
+		d := truncateDay(now)
+		return d.AddDate(0, 0, -6), d
+	}},
+	{"Last 30 days", func(now time.Time) (time.Time, time.Time) {
+		d := truncateDay(now)
+		return d.AddDate(0, 0, -29), d
+	}},
+	{"This month", func(now time.Time) (time.Time, time.Time) {
+		first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return first, first.AddDate(0, 1, -1)
+	}},
+	{"Last month", func(now time.Time) (time.Time, time.Time) {
+		first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return first.AddDate(0, -1, 0), first.AddDate(0, 0, -1)
+	}},
+}
+
+// DateRangeEntry is an entry showing a "start – end" date range.
+// The range can be typed directly or picked from a popup with two calendars and a list of presets.
+type DateRangeEntry struct {
+	widget.Entry
+
+	Start, End time.Time
+	OnChanged  func(start, end time.Time) `json:"-"`
+
+	// DateFormat is the layout used to show and parse dates, "2006-01-02" is used if empty.
+	DateFormat string
+	// Presets are shown beside the calendars, DefaultDateRangePresets is used if nil.
+	Presets []DateRangePreset
+
+	popUp       *widget.PopUp
+	pending     time.Time
+	pendingInfo *widget.Label
+	binder      *dateRangeBinder
+}
+
+// NewDateRangeEntry creates a new entry for the given range, a zero start and end leave it empty.
+func NewDateRangeEntry(start, end time.Time, changed func(start, end time.Time)) *DateRangeEntry {
+	e := &DateRangeEntry{Start: start, End: end, OnChanged: changed}
+	e.ExtendBaseWidget(e)
+	e.ActionItem = widget.NewButtonWithIcon("", theme.MenuDropDownIcon(), e.ShowPicker)
+	e.Validator = func(s string) error {
+		_, _, err := e.parse(s)
+		return err
+	}
+	e.SetPlaceHolder(e.format(time.Now()) + dateRangeSeparator + e.format(time.Now()))
+	e.updateText()
+	return e
+}
+
+// NewDateRangeEntryWithData creates a new entry bound to two data items holding time.Time values.
+func NewDateRangeEntryWithData(start, end binding.Untyped) *DateRangeEntry {
+	e := NewDateRangeEntry(time.Time{}, time.Time{}, nil)
+	e.Bind(start, end)
+	return e
+}
+
+// Bind connects the start and end of the range to data items holding time.Time values.
+func (e *DateRangeEntry) Bind(start, end binding.Untyped) {
+	e.Unbind()
+	e.binder = &dateRangeBinder{start: start, end: end}
+	e.binder.listener = binding.NewDataListener(func() {
+		s, err := getBoundTime(start)
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		en, err := getBoundTime(end)
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		e.setRange(s, en, false)
+	})
+	start.AddListener(e.binder.listener)
+	end.AddListener(e.binder.listener)
+}
+
+// Unbind disconnects any configured data source.
+func (e *DateRangeEntry) Unbind() {
+	if e.binder == nil {
+		return
+	}
+	e.binder.start.RemoveListener(e.binder.listener)
+	e.binder.end.RemoveListener(e.binder.listener)
+	e.binder = nil
+}
+
+// FocusLost parses any typed range when the entry loses focus.
+//
+// Implements: fyne.Focusable
+func (e *DateRangeEntry) FocusLost() {
+	e.commitText()
+	e.Entry.FocusLost()
+}
+
+// HidePicker closes the calendar popup if it is open.
+func (e *DateRangeEntry) HidePicker() {
+	if e.popUp != nil {
+		e.popUp.Hide()
+		e.popUp = nil
+	}
+}
+
+// SetRange changes the selected range, calling OnChanged and updating any bound data.
+// The start and end are swapped if given in the wrong order.
+func (e *DateRangeEntry) SetRange(start, end time.Time) {
+	e.setRange(start, end, true)
+}
+
+// ShowPicker opens the popup with presets and two consecutive months.
+// The first date tapped starts a new range and the second one completes it.
+func (e *DateRangeEntry) ShowPicker() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(e)
+	if c == nil || e.Disabled() {
+		return
+	}
+
+	month := e.Start
+	if month.IsZero() {
+		month = time.Now()
+	}
+	month = time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	e.pending = time.Time{}
+	e.pendingInfo = widget.NewLabel(i18n.L("Select the first date"))
+	calendars := container.NewGridWithColumns(2,
+		NewCalendar(month, e.dateTapped), NewCalendar(month.AddDate(0, 1, 0), e.dateTapped))
+
+	presets := e.Presets
+	if presets == nil {
+		presets = DefaultDateRangePresets
+	}
+	list := container.NewVBox()
+	for _, p := range presets {
+		p := p
+		b := widget.NewButton(i18n.L(p.Label), func() {
+			e.HidePicker()
+			e.SetRange(p.Range(time.Now()))
+		})
+		b.Alignment = widget.ButtonAlignLeading
+		b.Importance = widget.LowImportance
+		list.Add(b)
+	}
+
+	content := container.NewBorder(nil, e.pendingInfo, list, nil, calendars)
+	e.popUp = widget.NewPopUp(content, c)
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(e)
+	e.popUp.ShowAtPosition(pos.AddXY(0, e.Size().Height))
+}
+
+// TypedKey applies a typed range when Return or Enter is pressed.
+//
+// Implements: fyne.Focusable
+func (e *DateRangeEntry) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyReturn, fyne.KeyEnter:
+		e.commitText()
+	case fyne.KeyEscape:
+		e.HidePicker()
+	default:
+		e.Entry.TypedKey(key)
+	}
+}
+
+func (e *DateRangeEntry) commitText() {
+	if strings.TrimSpace(e.Text) == "" {
+		e.setRange(time.Time{}, time.Time{}, true)
+		return
+	}
+	start, end, err := e.parse(e.Text)
+	if err != nil {
+		return
+	}
+	e.setRange(start, end, true)
+	e.updateText()
+}
+
+func (e *DateRangeEntry) dateTapped(d time.Time) {
+	d = truncateDay(d)
+	if e.pending.IsZero() {
+		e.pending = d
+		e.pendingInfo.SetText(e.format(d) + dateRangeSeparator + "…")
+		return
+	}
+
+	start := e.pending
+	e.pending = time.Time{}
+	e.HidePicker()
+	e.SetRange(start, d)
+}
+
+func (e *DateRangeEntry) format(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(e.layout())
+}
+
+func (e *DateRangeEntry) layout() string {
+	if e.DateFormat == "" {
+		return "2006-01-02"
+	}
+	return e.DateFormat
+}
+
+// parse reads a range in the form "start – end", also accepting "-" or "to" as separators.
+// A single date is read as a range of one day.
+func (e *DateRangeEntry) parse(s string) (time.Time, time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, time.Time{}, nil
+	}
+	for _, sep := range []string{"–", " - ", " to "} {
+		if parts := strings.SplitN(s, sep, 2); len(parts) == 2 {
+			start, err := time.ParseInLocation(e.layout(), strings.TrimSpace(parts[0]), time.Local)
+			if err != nil {
+				return start, start, errDateRangeFormat
+			}
+			end, err := time.ParseInLocation(e.layout(), strings.TrimSpace(parts[1]), time.Local)
+			if err != nil {
+				return start, end, errDateRangeFormat
+			}
+			return start, end, nil
+		}
+	}
+
+	d, err := time.ParseInLocation(e.layout(), s, time.Local)
+	if err != nil {
+		return d, d, errDateRangeFormat
+	}
+	return d, d, nil
+}
+
+func (e *DateRangeEntry) setRange(start, end time.Time, notifyBinding bool) {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	if start.Equal(e.Start) && end.Equal(e.End) {
+		return
+	}
+	e.Start, e.End = start, end
+	e.updateText()
+
+	if notifyBinding && e.binder != nil {
+		if err := e.binder.start.Set(start); err != nil {
+			fyne.LogError("Error setting current data value", err)
+		}
+		if err := e.binder.end.Set(end); err != nil {
+			fyne.LogError("Error setting current data value", err)
+		}
+	}
+	if f := e.OnChanged; f != nil {
+		f(start, end)
+	}
+}
+
+func (e *DateRangeEntry) updateText() {
+	if e.Start.IsZero() && e.End.IsZero() {
+		e.SetText("")
+		return
+	}
+	e.SetText(e.format(e.Start) + dateRangeSeparator + e.format(e.End))
+}
+
+type dateRangeBinder struct {
+	start, end binding.Untyped
+	listener   binding.DataListener
+}
+
+func getBoundTime(data binding.Untyped) (time.Time, error) {
+	v, err := data.Get()
+	if err != nil || v == nil {
+		return time.Time{}, err
+	}
+	t, _ := v.(time.Time)
+	return t, nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,98 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateRangeEntry_SetRange(t *testing.T) {
+	var start, end time.Time
+	e := NewDateRangeEntry(time.Time{}, time.Time{}, func(s, en time.Time) {
+		start, end = s, en
+	})
+	assert.Equal(t, "", e.Text)
+
+	d1 := time.Date(2024, 3, 10, 0, 0, 0, 0, time.Local)
+	d2 := time.Date(2024, 3, 2, 0, 0, 0, 0, time.Local)
+	e.SetRange(d1, d2)
+	assert.Equal(t, d2, start)
+	assert.Equal(t, d1, end)
+	assert.Equal(t, "2024-03-02 – 2024-03-10", e.Text)
+}
+
+func TestDateRangeEntry_Typing(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewDateRangeEntry(time.Time{}, time.Time{}, nil)
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	test.Type(e, "2024-01-05 to 2024-01-09")
+	e.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, time.Date(2024, 1, 5, 0, 0, 0, 0, time.Local), e.Start)
+	assert.Equal(t, time.Date(2024, 1, 9, 0, 0, 0, 0, time.Local), e.End)
+	assert.Equal(t, "2024-01-05 – 2024-01-09", e.Text)
+
+	e.SetText("not a date")
+	e.FocusLost()
+	assert.Equal(t, time.Date(2024, 1, 5, 0, 0, 0, 0, time.Local), e.Start)
+	assert.Error(t, e.Validate())
+
+	e.SetText("2024-02-01")
+	e.FocusLost()
+	assert.Equal(t, e.Start, e.End)
+}
+
+func TestDateRangeEntry_Picker(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewDateRangeEntry(time.Time{}, time.Time{}, nil)
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	e.ShowPicker()
+	assert.NotNil(t, e.popUp)
+	e.dateTapped(time.Date(2024, 5, 20, 14, 0, 0, 0, time.Local))
+	assert.NotNil(t, e.popUp)
+	e.dateTapped(time.Date(2024, 5, 12, 9, 0, 0, 0, time.Local))
+	assert.Nil(t, e.popUp)
+	assert.Equal(t, time.Date(2024, 5, 12, 0, 0, 0, 0, time.Local), e.Start)
+	assert.Equal(t, time.Date(2024, 5, 20, 0, 0, 0, 0, time.Local), e.End)
+}
+
+func TestDateRangePresets(t *testing.T) {
+	now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+	start, end := DefaultDateRangePresets[1].Range(now)
+	assert.Equal(t, time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = DefaultDateRangePresets[3].Range(now)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = DefaultDateRangePresets[4].Range(now)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), end)
+}
+
+func TestDateRangeEntry_Binding(t *testing.T) {
+	start, end := binding.NewUntyped(), binding.NewUntyped()
+	d := time.Date(2024, 6, 1, 0, 0, 0, 0, time.Local)
+	_ = start.Set(d)
+	_ = end.Set(d.AddDate(0, 0, 3))
+	e := NewDateRangeEntryWithData(start, end)
+	waitForBinding()
+	assert.Equal(t, "2024-06-01 – 2024-06-04", e.Text)
+
+	e.SetRange(d, d)
+	waitForBinding()
+	v, _ := end.Get()
+	assert.Equal(t, d, v)
+}
@@ -0,0 +1,399 @@
+package widget
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"golang.org/x/image/vector"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*DrawingPad)(nil)
+var _ fyne.Draggable = (*DrawingPad)(nil)
+var _ fyne.Tappable = (*DrawingPad)(nil)
+
+const (
+	drawingPadMinDistance = 1.5
+	drawingPadCircleSteps = 16
+)
+
+// StrokePoint is a point of a stroke with the pressure it was drawn with, from 0 to 1.
+type StrokePoint struct {
+	fyne.Position
+	Pressure float32
+}
+
+// Stroke is a single freehand line drawn on a DrawingPad.
+type Stroke struct {
+	Color  color.Color
+	Width  float32
+	Points []StrokePoint
+}
+
+// DrawingPad is a canvas capturing smoothed freehand strokes, for signatures and quick sketches.
+// Fyne pointer events carry no pen pressure, so when SimulatePressure is set the width of a stroke
+// varies with the drawing speed, as it would with ink.
+type DrawingPad struct {
+	widget.BaseWidget
+
+	// StrokeColor is used for new strokes, the theme foreground color is used if nil.
+	StrokeColor color.Color
+	// StrokeWidth is the width of new strokes at full pressure.
+	StrokeWidth float32
+	// SimulatePressure makes fast movements draw thinner lines.
+	SimulatePressure bool
+	// Background fills exported images, they are transparent if nil.
+	Background color.Color
+
+	OnChanged func() `json:"-"`
+
+	strokes  []Stroke
+	current  *Stroke
+	lastMove time.Time
+}
+
+// NewDrawingPad creates a new, empty drawing pad.
+func NewDrawingPad() *DrawingPad {
+	p := &DrawingPad{StrokeWidth: 3, SimulatePressure: true}
+	p.ExtendBaseWidget(p)
+	return p
+}
+
+// Clear removes all strokes.
+func (p *DrawingPad) Clear() {
+	if len(p.strokes) == 0 && p.current == nil {
+		return
+	}
+	p.strokes = nil
+	p.current = nil
+	p.changed()
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (p *DrawingPad) CreateRenderer() fyne.WidgetRenderer {
+	p.ExtendBaseWidget(p)
+	bg := canvas.NewRectangle(theme.Color(theme.ColorNameInputBackground))
+	bg.CornerRadius = theme.InputRadiusSize()
+	r := &drawingPadRenderer{pad: p, bg: bg}
+	r.raster = canvas.NewRaster(r.draw)
+	return r
+}
+
+// DragEnd finishes the stroke being drawn.
+//
+// Implements: fyne.Draggable
+func (p *DrawingPad) DragEnd() {
+	if p.current == nil {
+		return
+	}
+	p.strokes = append(p.strokes, *p.current)
+	p.current = nil
+	p.changed()
+}
+
+// Dragged adds the pointer position to the stroke being drawn, starting one if needed.
+//
+// Implements: fyne.Draggable
+func (p *DrawingPad) Dragged(ev *fyne.DragEvent) {
+	now := time.Now()
+	if p.current == nil {
+		p.current = p.newStroke()
+		p.addPoint(ev.Position.Subtract(ev.Dragged), 1)
+		p.lastMove = now
+	}
+
+	last := p.current.Points[len(p.current.Points)-1]
+	dist := distance(last.Position, ev.Position)
+	if dist < drawingPadMinDistance {
+		return
+	}
+
+	pressure := float32(1)
+	if p.SimulatePressure {
+		elapsed := float32(now.Sub(p.lastMove).Seconds())
+		speed := dist / fyne.Max(elapsed, 0.001) // points per second
+		target := float32(math.Max(0.35, math.Min(1, 1.3-float64(speed)/2000)))
+		pressure = last.Pressure*0.6 + target*0.4 // ease changes so the width does not jump
+	}
+	p.lastMove = now
+	p.addPoint(ev.Position, pressure)
+	p.Refresh()
+}
+
+// Image renders the drawing to an image of the given size, scaling it to fill the image.
+func (p *DrawingPad) Image(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	if p.Background != nil {
+		fillImage(img, p.Background)
+	}
+	size := p.Size()
+	if size.Width <= 0 || size.Height <= 0 {
+		return img
+	}
+	scale := float32(math.Min(float64(float32(width)/size.Width), float64(float32(height)/size.Height)))
+	p.drawStrokes(img, scale)
+	return img
+}
+
+// IsEmpty returns true if nothing has been drawn.
+func (p *DrawingPad) IsEmpty() bool {
+	return len(p.strokes) == 0 && p.current == nil
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (p *DrawingPad) MinSize() fyne.Size {
+	p.ExtendBaseWidget(p)
+	return p.BaseWidget.MinSize()
+}
+
+// SetStrokes replaces the drawing, for example to restore a saved one.
+func (p *DrawingPad) SetStrokes(strokes []Stroke) {
+	p.strokes = append([]Stroke{}, strokes...)
+	p.current = nil
+	p.Refresh()
+}
+
+// Strokes returns the completed strokes, oldest first.
+func (p *DrawingPad) Strokes() []Stroke {
+	return append([]Stroke{}, p.strokes...)
+}
+
+// Tapped draws a dot, such as the one over an "i".
+//
+// Implements: fyne.Tappable
+func (p *DrawingPad) Tapped(ev *fyne.PointEvent) {
+	p.current = p.newStroke()
+	p.addPoint(ev.Position, 1)
+	p.DragEnd()
+}
+
+// Undo removes the most recent stroke.
+func (p *DrawingPad) Undo() {
+	if len(p.strokes) == 0 {
+		return
+	}
+	p.strokes = p.strokes[:len(p.strokes)-1]
+	p.changed()
+}
+
+// WritePNG encodes the drawing as a PNG image at the given scale of the widget size.
+func (p *DrawingPad) WritePNG(w io.Writer, scale float32) error {
+	size := p.Size()
+	return png.Encode(w, p.Image(int(size.Width*scale), int(size.Height*scale)))
+}
+
+// WriteSVG encodes the drawing as an SVG image the size of the widget.
+func (p *DrawingPad) WriteSVG(w io.Writer) error {
+	size := p.Size()
+	if _, err := fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%g" height="%g" viewBox="0 0 %g %g">`+"\n",
+		size.Width, size.Height, size.Width, size.Height); err != nil {
+		return err
+	}
+	if p.Background != nil {
+		if _, err := fmt.Fprintf(w, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", svgColor(p.Background)); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range p.strokes {
+		col := svgColor(p.strokeColor(s))
+		points := smoothStroke(s.Points)
+		if len(points) == 1 {
+			pt := points[0]
+			if _, err := fmt.Fprintf(w, `<circle cx="%.2f" cy="%.2f" r="%.2f" fill="%s"/>`+"\n",
+				pt.X, pt.Y, s.Width*pt.Pressure/2, col); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := fmt.Fprintf(w, `<g stroke="%s" stroke-linecap="round" fill="none">`+"\n", col); err != nil {
+			return err
+		}
+		for i := 1; i < len(points); i++ {
+			a, b := points[i-1], points[i]
+			if _, err := fmt.Fprintf(w, `<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke-width="%.2f"/>`+"\n",
+				a.X, a.Y, b.X, b.Y, s.Width*(a.Pressure+b.Pressure)/2); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, "</g>\n"); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "</svg>\n")
+	return err
+}
+
+func (p *DrawingPad) addPoint(pos fyne.Position, pressure float32) {
+	p.current.Points = append(p.current.Points, StrokePoint{Position: pos, Pressure: pressure})
+}
+
+func (p *DrawingPad) changed() {
+	p.Refresh()
+	if f := p.OnChanged; f != nil {
+		f()
+	}
+}
+
+func (p *DrawingPad) drawStrokes(img *image.RGBA, scale float32) {
+	strokes := p.strokes
+	if p.current != nil {
+		strokes = append(append([]Stroke{}, strokes...), *p.current)
+	}
+	bounds := img.Bounds()
+	for _, s := range strokes {
+		r := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+		points := smoothStroke(s.Points)
+		for i, pt := range points {
+			radius := s.Width * pt.Pressure * scale / 2
+			center := fyne.NewPos(pt.X*scale, pt.Y*scale)
+			addCircle(r, center, radius)
+			if i == 0 {
+				continue
+			}
+			prev := points[i-1]
+			addSegment(r, fyne.NewPos(prev.X*scale, prev.Y*scale), s.Width*prev.Pressure*scale/2, center, radius)
+		}
+		r.Draw(img, bounds, image.NewUniform(p.strokeColor(s)), image.Point{})
+	}
+}
+
+func (p *DrawingPad) newStroke() *Stroke {
+	return &Stroke{Color: p.StrokeColor, Width: p.StrokeWidth}
+}
+
+func (p *DrawingPad) strokeColor(s Stroke) color.Color {
+	if s.Color == nil {
+		return theme.Color(theme.ColorNameForeground)
+	}
+	return s.Color
+}
+
+type drawingPadRenderer struct {
+	pad    *DrawingPad
+	bg     *canvas.Rectangle
+	raster *canvas.Raster
+}
+
+func (r *drawingPadRenderer) Destroy() {
+}
+
+func (r *drawingPadRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	r.raster.Resize(size)
+}
+
+func (r *drawingPadRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(theme.IconInlineSize()*10, theme.IconInlineSize()*4)
+}
+
+func (r *drawingPadRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg, r.raster}
+}
+
+func (r *drawingPadRenderer) Refresh() {
+	r.bg.FillColor = theme.Color(theme.ColorNameInputBackground)
+	r.bg.Refresh()
+	r.raster.Refresh()
+}
+
+func (r *drawingPadRenderer) draw(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	size := r.pad.Size()
+	if size.Width > 0 {
+		r.pad.drawStrokes(img, float32(w)/size.Width)
+	}
+	return img
+}
+
+// addCircle adds a counter-clockwise polygon approximating a circle to the rasterizer.
+func addCircle(r *vector.Rasterizer, c fyne.Position, radius float32) {
+	r.MoveTo(c.X+radius, c.Y)
+	for i := 1; i < drawingPadCircleSteps; i++ {
+		a := 2 * math.Pi * float64(i) / drawingPadCircleSteps
+		r.LineTo(c.X+radius*float32(math.Cos(a)), c.Y-radius*float32(math.Sin(a)))
+	}
+	r.ClosePath()
+}
+
+// addSegment adds the quad joining two circles to the rasterizer, wound the same way as addCircle
+// so that overlapping shapes add up instead of cancelling out.
+func addSegment(r *vector.Rasterizer, a fyne.Position, ra float32, b fyne.Position, rb float32) {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	length := float32(math.Hypot(float64(dx), float64(dy)))
+	if length == 0 {
+		return
+	}
+	nx, ny := -dy/length, dx/length
+	quad := [4]fyne.Position{
+		{X: a.X + nx*ra, Y: a.Y + ny*ra},
+		{X: b.X + nx*rb, Y: b.Y + ny*rb},
+		{X: b.X - nx*rb, Y: b.Y - ny*rb},
+		{X: a.X - nx*ra, Y: a.Y - ny*ra},
+	}
+	area := float32(0)
+	for i := range quad {
+		j := (i + 1) % len(quad)
+		area += quad[i].X*quad[j].Y - quad[j].X*quad[i].Y
+	}
+	if area > 0 { // clockwise on screen, reverse to match the circles
+		quad[1], quad[3] = quad[3], quad[1]
+	}
+	r.MoveTo(quad[0].X, quad[0].Y)
+	for _, pt := range quad[1:] {
+		r.LineTo(pt.X, pt.Y)
+	}
+	r.ClosePath()
+}
+
+func distance(a, b fyne.Position) float32 {
+	return float32(math.Hypot(float64(b.X-a.X), float64(b.Y-a.Y)))
+}
+
+func fillImage(img *image.RGBA, c color.Color) {
+	r, g, b, a := c.RGBA()
+	fill := color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: uint8(a >> 8)}
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = fill.R, fill.G, fill.B, fill.A
+	}
+}
+
+// smoothStroke rounds off the corners of a stroke using two passes of Chaikin's algorithm.
+func smoothStroke(points []StrokePoint) []StrokePoint {
+	for pass := 0; pass < 2 && len(points) > 2; pass++ {
+		smooth := make([]StrokePoint, 0, len(points)*2)
+		smooth = append(smooth, points[0])
+		for i := 0; i < len(points)-1; i++ {
+			a, b := points[i], points[i+1]
+			smooth = append(smooth, lerpStrokePoint(a, b, 0.25), lerpStrokePoint(a, b, 0.75))
+		}
+		points = append(smooth, points[len(points)-1])
+	}
+	return points
+}
+
+func lerpStrokePoint(a, b StrokePoint, t float32) StrokePoint {
+	return StrokePoint{
+		Position: fyne.NewPos(a.X+(b.X-a.X)*t, a.Y+(b.Y-a.Y)*t),
+		Pressure: a.Pressure + (b.Pressure-a.Pressure)*t,
+	}
+}
+
+func svgColor(c color.Color) string {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	if n.A == 0xff {
+		return fmt.Sprintf("#%02x%02x%02x", n.R, n.G, n.B)
+	}
+	return fmt.Sprintf("rgba(%d,%d,%d,%.3f)", n.R, n.G, n.B, float32(n.A)/0xff)
+}
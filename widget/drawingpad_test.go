@@ -0,0 +1,91 @@
+package widget
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func drawLine(p *DrawingPad, from, to fyne.Position) {
+	steps := 10
+	prev := from
+	for i := 1; i <= steps; i++ {
+		t := float32(i) / float32(steps)
+		pos := fyne.NewPos(from.X+(to.X-from.X)*t, from.Y+(to.Y-from.Y)*t)
+		p.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: pos}, Dragged: fyne.NewDelta(pos.X-prev.X, pos.Y-prev.Y)})
+		prev = pos
+	}
+	p.DragEnd()
+}
+
+func TestDrawingPad_Strokes(t *testing.T) {
+	changes := 0
+	p := NewDrawingPad()
+	p.OnChanged = func() {
+		changes++
+	}
+	assert.True(t, p.IsEmpty())
+
+	drawLine(p, fyne.NewPos(10, 10), fyne.NewPos(90, 10))
+	assert.False(t, p.IsEmpty())
+	assert.Len(t, p.Strokes(), 1)
+	assert.Equal(t, fyne.NewPos(10, 10), p.Strokes()[0].Points[0].Position)
+	assert.Equal(t, 1, changes)
+
+	p.Tapped(&fyne.PointEvent{Position: fyne.NewPos(50, 50)})
+	assert.Len(t, p.Strokes(), 2)
+	assert.Len(t, p.Strokes()[1].Points, 1)
+
+	p.Undo()
+	assert.Len(t, p.Strokes(), 1)
+	p.Clear()
+	assert.True(t, p.IsEmpty())
+	assert.Equal(t, 4, changes)
+}
+
+func TestDrawingPad_Export(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	p := NewDrawingPad()
+	p.StrokeColor = color.NRGBA{R: 0xff, A: 0xff}
+	p.StrokeWidth = 4
+	p.SimulatePressure = false
+	p.Resize(fyne.NewSize(100, 50))
+	drawLine(p, fyne.NewPos(10, 25), fyne.NewPos(90, 25))
+
+	img := p.Image(200, 100)
+	assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, img.At(100, 50))
+	assert.Equal(t, color.RGBA{}, img.At(100, 10))
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, p.WritePNG(buf, 1))
+	decoded, err := png.Decode(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, decoded.Bounds().Dx())
+
+	buf.Reset()
+	assert.NoError(t, p.WriteSVG(buf))
+	svg := buf.String()
+	assert.True(t, strings.HasPrefix(svg, "<svg"))
+	assert.Contains(t, svg, `stroke="#ff0000"`)
+	assert.Contains(t, svg, `stroke-width="4.00"`)
+}
+
+func TestSmoothStroke(t *testing.T) {
+	points := []StrokePoint{
+		{Position: fyne.NewPos(0, 0), Pressure: 1},
+		{Position: fyne.NewPos(10, 0), Pressure: 1},
+		{Position: fyne.NewPos(10, 10), Pressure: 1},
+	}
+	smooth := smoothStroke(points)
+	assert.Greater(t, len(smooth), len(points))
+	assert.Equal(t, points[0], smooth[0])
+	assert.Equal(t, points[2], smooth[len(smooth)-1])
+}
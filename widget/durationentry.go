@@ -0,0 +1,329 @@
+package widget
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*DurationEntry)(nil)
+var _ fyne.Focusable = (*DurationEntry)(nil)
+
+var errDurationFormat = errors.New("expected a duration such as \"1h 30m\" or \"90:00\"")
+
+// DurationEntry is an entry for time.Duration values.
+// It accepts forms such as "1h 30m", "1.5h", "90:00" (minutes and seconds), "1:30:00" or a plain
+// number of minutes, and normalizes the text when focus is lost.
+// The step buttons and the Up and Down keys change the unit under the cursor.
+type DurationEntry struct {
+	widget.Entry
+
+	Duration  time.Duration
+	OnChanged func(time.Duration) `json:"-"`
+
+	binder *durationBinder
+}
+
+// NewDurationEntry creates a new entry showing the given duration.
+func NewDurationEntry(d time.Duration, changed func(time.Duration)) *DurationEntry {
+	e := &DurationEntry{Duration: d, OnChanged: changed}
+	e.ExtendBaseWidget(e)
+	up := widget.NewButtonWithIcon("", theme.MoveUpIcon(), func() { e.Step(1) })
+	down := widget.NewButtonWithIcon("", theme.MoveDownIcon(), func() { e.Step(-1) })
+	up.Importance, down.Importance = widget.LowImportance, widget.LowImportance
+	e.ActionItem = container.NewGridWithColumns(2, down, up)
+	e.Validator = func(s string) error {
+		_, err := ParseDuration(s)
+		return err
+	}
+	e.SetPlaceHolder("0h 0m")
+	e.SetText(FormatDuration(d))
+	return e
+}
+
+// NewDurationEntryWithData creates a new entry bound to a data item holding a time.Duration.
+func NewDurationEntryWithData(data binding.Untyped) *DurationEntry {
+	e := NewDurationEntry(0, nil)
+	e.Bind(data)
+	return e
+}
+
+// Bind connects the entry to a data item holding a time.Duration.
+func (e *DurationEntry) Bind(data binding.Untyped) {
+	e.Unbind()
+	e.binder = &durationBinder{data: data}
+	e.binder.listener = binding.NewDataListener(func() {
+		v, err := data.Get()
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		if d, ok := v.(time.Duration); ok {
+			e.setDuration(d, false)
+		}
+	})
+	data.AddListener(e.binder.listener)
+}
+
+// Unbind disconnects any configured data source.
+func (e *DurationEntry) Unbind() {
+	if e.binder == nil {
+		return
+	}
+	e.binder.data.RemoveListener(e.binder.listener)
+	e.binder = nil
+}
+
+// FocusLost parses and normalizes the text when the entry loses focus.
+//
+// Implements: fyne.Focusable
+func (e *DurationEntry) FocusLost() {
+	e.commitText()
+	e.Entry.FocusLost()
+}
+
+// SetDuration changes the duration, calling OnChanged and updating any bound data.
+func (e *DurationEntry) SetDuration(d time.Duration) {
+	e.setDuration(d, true)
+}
+
+// Step adds delta units of the field under the cursor to the duration, never going below zero.
+// The unit is hours, minutes or seconds depending on the cursor position and defaults to minutes.
+func (e *DurationEntry) Step(delta int) {
+	if e.textEdited() {
+		if d, err := ParseDuration(e.Text); err == nil {
+			e.setDuration(d, true)
+		}
+	}
+	unit := e.unitAtCursor()
+	d := e.Duration + time.Duration(delta)*unit
+	if d < 0 {
+		d = 0
+	}
+	col := e.CursorColumn
+	e.setDuration(d, true)
+	if col > len([]rune(e.Text)) {
+		col = len([]rune(e.Text))
+	}
+	e.CursorColumn = col
+	e.Refresh()
+}
+
+// TypedKey handles Up and Down to step the focused unit and Return to apply the text.
+//
+// Implements: fyne.Focusable
+func (e *DurationEntry) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyUp:
+		e.Step(1)
+	case fyne.KeyDown:
+		e.Step(-1)
+	case fyne.KeyReturn, fyne.KeyEnter:
+		e.commitText()
+	default:
+		e.Entry.TypedKey(key)
+	}
+}
+
+// commitText applies the text if the user edited it. The text shows the duration rounded to seconds,
+// so parsing text that was not edited would round the duration.
+func (e *DurationEntry) commitText() {
+	if !e.textEdited() {
+		return
+	}
+	d, err := ParseDuration(e.Text)
+	if err != nil {
+		return
+	}
+	e.setDuration(d, true)
+	e.SetText(FormatDuration(d))
+}
+
+// textEdited returns true if the text is not the duration as it was formatted.
+func (e *DurationEntry) textEdited() bool {
+	return e.Text != FormatDuration(e.Duration)
+}
+
+func (e *DurationEntry) setDuration(d time.Duration, notifyBinding bool) {
+	if d == e.Duration {
+		return
+	}
+	e.Duration = d
+	e.SetText(FormatDuration(d))
+
+	if notifyBinding && e.binder != nil {
+		if err := e.binder.data.Set(d); err != nil {
+			fyne.LogError("Error setting current data value", err)
+		}
+	}
+	if f := e.OnChanged; f != nil {
+		f(d)
+	}
+}
+
+// unitAtCursor looks for the unit of the number under the cursor.
+func (e *DurationEntry) unitAtCursor() time.Duration {
+	text := []rune(e.Text)
+	col := e.CursorColumn
+	if col > len(text) {
+		col = len(text)
+	}
+
+	if strings.ContainsRune(e.Text, ':') {
+		parts := strings.Count(e.Text, ":") + 1
+		index := strings.Count(string(text[:col]), ":")
+		units := []time.Duration{time.Minute, time.Second}
+		if parts == 3 {
+			units = []time.Duration{time.Hour, time.Minute, time.Second}
+		}
+		if index < len(units) {
+			return units[index]
+		}
+		return time.Second
+	}
+
+	// skip back to the start of the number, then forward to its unit
+	for col > 0 && !unicode.IsSpace(text[col-1]) && !unicode.IsLetter(text[col-1]) {
+		col--
+	}
+	for i := col; i < len(text); i++ {
+		if unicode.IsLetter(text[i]) {
+			if unit, ok := durationUnit(string(text[i:])); ok {
+				return unit
+			}
+			break
+		}
+	}
+	return time.Minute
+}
+
+type durationBinder struct {
+	data     binding.Untyped
+	listener binding.DataListener
+}
+
+// FormatDuration formats a duration as hours, minutes and seconds, such as "1h 30m" or "45s".
+func FormatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	if d == 0 {
+		return "0m"
+	}
+	var parts []string
+	if d < 0 {
+		parts = append(parts, "-")
+		d = -d
+	}
+	h, m, s := d/time.Hour, (d%time.Hour)/time.Minute, (d%time.Minute)/time.Second
+	if h > 0 {
+		parts = append(parts, strconv.Itoa(int(h))+"h")
+	}
+	if m > 0 {
+		parts = append(parts, strconv.Itoa(int(m))+"m")
+	}
+	if s > 0 {
+		parts = append(parts, strconv.Itoa(int(s))+"s")
+	}
+	return strings.Replace(strings.Join(parts, " "), "- ", "-", 1)
+}
+
+// ParseDuration reads durations written as units ("1h 30m", "1.5 hours", "2m30s"),
+// clock values ("90:00" for minutes and seconds, "1:30:00" for hours, minutes and seconds)
+// or a plain number of minutes. A leading "-" makes the duration negative and an empty string is zero.
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(strings.ToLower(s))
+	if strings.HasPrefix(s, "-") {
+		s = strings.TrimSpace(s[1:])
+		if s == "" || s[0] == '-' {
+			return 0, errDurationFormat
+		}
+		d, err := parseUnsignedDuration(s)
+		return -d, err
+	}
+	return parseUnsignedDuration(s)
+}
+
+func parseUnsignedDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if strings.ContainsRune(s, ':') {
+		return parseClockDuration(s)
+	}
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(n * float64(time.Minute)), nil
+	}
+
+	var total time.Duration
+	rest := s
+	for rest != "" {
+		rest = strings.TrimLeft(rest, " ,")
+		i := 0
+		for i < len(rest) && (rest[i] == '.' || (rest[i] >= '0' && rest[i] <= '9')) {
+			i++
+		}
+		n, err := strconv.ParseFloat(rest[:i], 64)
+		if err != nil {
+			return 0, errDurationFormat
+		}
+		rest = strings.TrimLeft(rest[i:], " ")
+		j := 0
+		for j < len(rest) && unicode.IsLetter(rune(rest[j])) {
+			j++
+		}
+		unit, ok := durationUnit(rest[:j])
+		if !ok || j == 0 {
+			return 0, errDurationFormat
+		}
+		total += time.Duration(n * float64(unit))
+		rest = rest[j:]
+	}
+	return total, nil
+}
+
+func parseClockDuration(s string) (time.Duration, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, errDurationFormat
+	}
+	units := []time.Duration{time.Minute, time.Second}
+	if len(parts) == 3 {
+		units = []time.Duration{time.Hour, time.Minute, time.Second}
+	}
+	var total time.Duration
+	for i, p := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil || n < 0 {
+			return 0, errDurationFormat
+		}
+		total += time.Duration(n) * units[i]
+	}
+	return total, nil
+}
+
+// durationUnit returns the unit that a word such as "h", "mins" or "seconds" starts with.
+func durationUnit(word string) (time.Duration, bool) {
+	end := 0
+	for end < len(word) && unicode.IsLetter(rune(word[end])) {
+		end++
+	}
+	switch strings.ToLower(word[:end]) {
+	case "h", "hr", "hrs", "hour", "hours":
+		return time.Hour, true
+	case "m", "min", "mins", "minute", "minutes":
+		return time.Minute, true
+	case "s", "sec", "secs", "second", "seconds":
+		return time.Second, true
+	case "ms":
+		return time.Millisecond, true
+	}
+	return 0, false
+}
@@ -0,0 +1,138 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	for in, want := range map[string]time.Duration{
+		"":            0,
+		"1h 30m":      90 * time.Minute,
+		"1.5h":        90 * time.Minute,
+		"2m30s":       150 * time.Second,
+		"1 hour, 5 m": 65 * time.Minute,
+		"90:00":       90 * time.Minute,
+		"1:30:15":     time.Hour + 30*time.Minute + 15*time.Second,
+		"45":          45 * time.Minute,
+		"-1h 30m":     -90 * time.Minute,
+		"- 90:00":     -90 * time.Minute,
+		"-45":         -45 * time.Minute,
+	} {
+		d, err := ParseDuration(in)
+		assert.NoError(t, err, in)
+		assert.Equal(t, want, d, in)
+	}
+
+	for _, in := range []string{"abc", "1x", "1:2:3:4", "h", "-", "--5"} {
+		_, err := ParseDuration(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	assert.Equal(t, "0m", FormatDuration(0))
+	assert.Equal(t, "1h 30m", FormatDuration(90*time.Minute))
+	assert.Equal(t, "2m 5s", FormatDuration(125*time.Second))
+	assert.Equal(t, "-1h", FormatDuration(-time.Hour))
+	assert.Equal(t, "0m", FormatDuration(400*time.Millisecond))
+	assert.Equal(t, "0m", FormatDuration(-400*time.Millisecond))
+}
+
+func TestFormatDuration_RoundTrip(t *testing.T) {
+	for _, d := range []time.Duration{0, 90 * time.Minute, -90 * time.Minute, -5 * time.Second, 25*time.Hour + time.Second} {
+		parsed, err := ParseDuration(FormatDuration(d))
+		assert.NoError(t, err, d)
+		assert.Equal(t, d, parsed, d)
+	}
+	assert.Equal(t, "-1h 30m", FormatDuration(-90*time.Minute))
+}
+
+func TestDurationEntry_Normalize(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	var changed time.Duration
+	e := NewDurationEntry(0, func(d time.Duration) {
+		changed = d
+	})
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	w.Canvas().Focus(e)
+	e.SetText("")
+	test.Type(e, "90:00")
+	w.Canvas().Unfocus()
+	assert.Equal(t, 90*time.Minute, changed)
+	assert.Equal(t, "1h 30m", e.Text)
+
+	e.SetText("rubbish")
+	e.FocusLost()
+	assert.Equal(t, 90*time.Minute, e.Duration)
+	assert.Error(t, e.Validate())
+}
+
+func TestDurationEntry_KeepsPrecision(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	data := binding.NewUntyped()
+	_ = data.Set(1500 * time.Millisecond)
+	e := NewDurationEntryWithData(data)
+	w := test.NewWindow(e)
+	defer w.Close()
+	waitForBinding()
+	assert.Equal(t, "2s", e.Text)
+
+	w.Canvas().Focus(e)
+	w.Canvas().Unfocus()
+	waitForBinding()
+	assert.Equal(t, 1500*time.Millisecond, e.Duration)
+	v, _ := data.Get()
+	assert.Equal(t, 1500*time.Millisecond, v)
+
+	e.CursorColumn = 0
+	e.Step(1)
+	assert.Equal(t, 2500*time.Millisecond, e.Duration)
+}
+
+func TestDurationEntry_Step(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewDurationEntry(90*time.Minute, nil)
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	e.CursorColumn = 0
+	e.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	assert.Equal(t, 150*time.Minute, e.Duration)
+	assert.Equal(t, "2h 30m", e.Text)
+
+	e.CursorColumn = 4
+	e.Step(-1)
+	assert.Equal(t, 149*time.Minute, e.Duration)
+
+	e.SetDuration(time.Minute)
+	e.CursorColumn = 0
+	e.Step(-3)
+	assert.Equal(t, time.Duration(0), e.Duration)
+}
+
+func TestDurationEntry_Binding(t *testing.T) {
+	data := binding.NewUntyped()
+	_ = data.Set(10 * time.Minute)
+	e := NewDurationEntryWithData(data)
+	waitForBinding()
+	assert.Equal(t, "10m", e.Text)
+
+	e.SetDuration(time.Hour)
+	waitForBinding()
+	v, _ := data.Get()
+	assert.Equal(t, time.Hour, v)
+}
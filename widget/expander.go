@@ -0,0 +1,405 @@
+package widget
+
+import (
+	"image/color"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Expander)(nil)
+var _ fyne.Widget = (*expanderHeader)(nil)
+var _ fyne.Tappable = (*expanderHeader)(nil)
+var _ fyne.Focusable = (*expanderHeader)(nil)
+var _ desktop.Hoverable = (*expanderHeader)(nil)
+
+// Expander is a collapsible panel with a header that shows or hides its content when tapped.
+// The header has a title, an optional icon and optional widgets on its trailing edge.
+// Opening and closing is animated, and the state can be persisted with SetPreferenceKey.
+// The header can also be toggled with Space or Return when it has keyboard focus.
+type Expander struct {
+	widget.BaseWidget
+
+	Title   string
+	Icon    fyne.Resource
+	Content fyne.CanvasObject
+	// Trailing widgets are shown at the end of the header, they handle their own taps.
+	Trailing []fyne.CanvasObject
+	Expanded bool
+	// Card draws the expander on a rounded background with a border, so that it stands apart from its
+	// surroundings when it is not in a list of expanders.
+	Card bool
+
+	OnChanged func(expanded bool) `json:"-"`
+
+	preferenceKey string
+	group         *ExpanderGroup
+}
+
+// NewExpander creates a new collapsed expander with a title and content.
+func NewExpander(title string, content fyne.CanvasObject) *Expander {
+	e := &Expander{Title: title, Content: content}
+	e.ExtendBaseWidget(e)
+	return e
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (e *Expander) CreateRenderer() fyne.WidgetRenderer {
+	e.ExtendBaseWidget(e)
+	clip := container.NewScroll(e.Content)
+	clip.Direction = container.ScrollNone
+	r := &expanderRenderer{expander: e, header: newExpanderHeader(e), clip: clip, expanded: e.Expanded,
+		card: canvas.NewRectangle(color.Transparent)}
+	if e.Expanded {
+		r.progress = 1
+	}
+	r.Refresh()
+	return r
+}
+
+// Close collapses the expander, hiding its content.
+func (e *Expander) Close() {
+	e.SetExpanded(false)
+}
+
+// Open expands the expander, showing its content.
+// If it is in an exclusive ExpanderGroup the other members are closed.
+func (e *Expander) Open() {
+	e.SetExpanded(true)
+}
+
+// SetExpanded opens or closes the expander.
+func (e *Expander) SetExpanded(expanded bool) {
+	if e.Expanded == expanded {
+		return
+	}
+	e.Expanded = expanded
+	if e.preferenceKey != "" {
+		fyne.CurrentApp().Preferences().SetBool(e.preferenceKey, expanded)
+	}
+	if expanded && e.group != nil {
+		e.group.opened(e)
+	}
+	e.Refresh()
+
+	if f := e.OnChanged; f != nil {
+		f(expanded)
+	}
+}
+
+// SetPreferenceKey restores the expanded state saved in the app preferences under key,
+// and saves it there whenever it changes.
+func (e *Expander) SetPreferenceKey(key string) {
+	e.preferenceKey = key
+	if key == "" {
+		return
+	}
+	expanded := fyne.CurrentApp().Preferences().BoolWithFallback(key, e.Expanded)
+	if expanded != e.Expanded {
+		e.Expanded = expanded
+		if expanded && e.group != nil {
+			e.group.opened(e)
+		}
+		e.Refresh()
+	}
+}
+
+// Toggle opens the expander if it is closed and closes it otherwise.
+func (e *Expander) Toggle() {
+	e.SetExpanded(!e.Expanded)
+}
+
+// ExpanderGroup links expanders so that opening one closes the others, like an accordion.
+type ExpanderGroup struct {
+	lock      sync.Mutex
+	expanders []*Expander
+}
+
+// NewExpanderGroup creates a group of expanders where only one can be open at a time.
+// If several are open already only the first stays open.
+func NewExpanderGroup(expanders ...*Expander) *ExpanderGroup {
+	g := &ExpanderGroup{}
+	for _, e := range expanders {
+		g.Add(e)
+	}
+	return g
+}
+
+// Add puts an expander in the group, closing it if another member is open.
+func (g *ExpanderGroup) Add(e *Expander) {
+	g.lock.Lock()
+	g.expanders = append(g.expanders, e)
+	others := g.openExcept(e)
+	g.lock.Unlock()
+
+	e.group = g
+	if len(others) > 0 {
+		e.Close()
+	}
+}
+
+// Expanded returns the open member of the group, or nil if all are closed.
+func (g *ExpanderGroup) Expanded() *Expander {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	for _, e := range g.expanders {
+		if e.Expanded {
+			return e
+		}
+	}
+	return nil
+}
+
+// Remove takes an expander out of the group.
+func (g *ExpanderGroup) Remove(e *Expander) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	for i, member := range g.expanders {
+		if member == e {
+			g.expanders = append(g.expanders[:i], g.expanders[i+1:]...)
+			e.group = nil
+			return
+		}
+	}
+}
+
+func (g *ExpanderGroup) opened(e *Expander) {
+	g.lock.Lock()
+	others := g.openExcept(e)
+	g.lock.Unlock()
+
+	for _, other := range others {
+		other.Close()
+	}
+}
+
+func (g *ExpanderGroup) openExcept(e *Expander) []*Expander {
+	var open []*Expander
+	for _, member := range g.expanders {
+		if member != e && member.Expanded {
+			open = append(open, member)
+		}
+	}
+	return open
+}
+
+type expanderRenderer struct {
+	expander *Expander
+	header   *expanderHeader
+	clip     *container.Scroll
+	card     *canvas.Rectangle
+
+	expanded bool
+	progress float32
+	anim     *fyne.Animation
+}
+
+func (r *expanderRenderer) Destroy() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+}
+
+func (r *expanderRenderer) Layout(size fyne.Size) {
+	r.card.Resize(size)
+	header := r.header.MinSize().Height
+	r.header.Resize(fyne.NewSize(size.Width, header))
+	inset := r.cardInset()
+	r.clip.Move(fyne.NewPos(inset, header))
+	r.clip.Resize(fyne.NewSize(size.Width-inset*2, fyne.Max(0, size.Height-header-inset*r.progress)))
+}
+
+func (r *expanderRenderer) MinSize() fyne.Size {
+	min := r.header.MinSize()
+	if c := r.expander.Content; c != nil && r.progress > 0 {
+		inset := r.cardInset()
+		content := c.MinSize()
+		min = fyne.NewSize(fyne.Max(min.Width, content.Width+inset*2),
+			min.Height+(content.Height+inset)*r.progress)
+	}
+	return min
+}
+
+func (r *expanderRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.card, r.clip, r.header}
+}
+
+func (r *expanderRenderer) Refresh() {
+	e := r.expander
+	if r.clip.Content != e.Content {
+		r.clip.Content = e.Content
+		r.clip.Refresh()
+	}
+	if e.Expanded != r.expanded {
+		r.expanded = e.Expanded
+		r.animate()
+	}
+	r.updateClip()
+	r.card.Hidden = !e.Card
+	r.card.FillColor = theme.Color(theme.ColorNameInputBackground)
+	r.card.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	r.card.StrokeWidth = theme.InputBorderSize()
+	r.card.CornerRadius = theme.InputRadiusSize()
+	r.card.Refresh()
+	r.header.Refresh()
+	r.Layout(e.Size())
+}
+
+// cardInset is the padding around the content of a card.
+func (r *expanderRenderer) cardInset() float32 {
+	if !r.expander.Card {
+		return 0
+	}
+	return theme.Padding()
+}
+
+// animate grows or shrinks the visible part of the content towards the expanded state.
+func (r *expanderRenderer) animate() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+	from := r.progress
+	to := float32(0)
+	if r.expanded {
+		to = 1
+	}
+	r.anim = fyne.NewAnimation(canvas.DurationShort, func(done float32) {
+		r.progress = from + (to-from)*done
+		r.updateClip()
+		r.Layout(r.expander.Size())
+		canvas.Refresh(r.expander)
+	})
+	r.anim.Curve = fyne.AnimationEaseInOut
+	r.anim.Start()
+}
+
+// updateClip hides the content once it is fully collapsed.
+func (r *expanderRenderer) updateClip() {
+	if r.expander.Content == nil || r.progress == 0 {
+		r.clip.Hide()
+	} else {
+		r.clip.Show()
+	}
+}
+
+// expanderHeader is the tappable title row of an Expander.
+type expanderHeader struct {
+	widget.BaseWidget
+	expander *Expander
+	focused  bool
+	hovered  bool
+}
+
+func newExpanderHeader(e *Expander) *expanderHeader {
+	h := &expanderHeader{expander: e}
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+func (h *expanderHeader) CreateRenderer() fyne.WidgetRenderer {
+	bg := canvas.NewRectangle(color.Transparent)
+	bg.CornerRadius = theme.InputRadiusSize()
+	chevron := widget.NewIcon(theme.MenuExpandIcon())
+	icon := widget.NewIcon(nil)
+	title := widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+	title.Truncation = fyne.TextTruncateEllipsis
+	trailing := container.NewHBox()
+	r := &expanderHeaderRenderer{
+		header: h, bg: bg, chevron: chevron, icon: icon, title: title, trailing: trailing,
+		content: container.NewBorder(nil, nil, container.NewHBox(chevron, icon), trailing, title),
+	}
+	r.Refresh()
+	return r
+}
+
+func (h *expanderHeader) FocusGained() {
+	h.focused = true
+	h.Refresh()
+}
+
+func (h *expanderHeader) FocusLost() {
+	h.focused = false
+	h.Refresh()
+}
+
+func (h *expanderHeader) MouseIn(*desktop.MouseEvent) {
+	h.hovered = true
+	h.Refresh()
+}
+
+func (h *expanderHeader) MouseMoved(*desktop.MouseEvent) {
+}
+
+func (h *expanderHeader) MouseOut() {
+	h.hovered = false
+	h.Refresh()
+}
+
+func (h *expanderHeader) Tapped(*fyne.PointEvent) {
+	h.expander.Toggle()
+}
+
+func (h *expanderHeader) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeySpace, fyne.KeyReturn, fyne.KeyEnter:
+		h.expander.Toggle()
+	}
+}
+
+func (h *expanderHeader) TypedRune(rune) {
+}
+
+type expanderHeaderRenderer struct {
+	header        *expanderHeader
+	bg            *canvas.Rectangle
+	chevron, icon *widget.Icon
+	title         *widget.Label
+	trailing      *fyne.Container
+	content       *fyne.Container
+}
+
+func (r *expanderHeaderRenderer) Destroy() {
+}
+
+func (r *expanderHeaderRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	r.content.Resize(size)
+}
+
+func (r *expanderHeaderRenderer) MinSize() fyne.Size {
+	return r.content.MinSize()
+}
+
+func (r *expanderHeaderRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg, r.content}
+}
+
+func (r *expanderHeaderRenderer) Refresh() {
+	e := r.header.expander
+	r.bg.FillColor = color.Transparent
+	if r.header.focused {
+		r.bg.FillColor = theme.Color(theme.ColorNameFocus)
+	} else if r.header.hovered {
+		r.bg.FillColor = theme.Color(theme.ColorNameHover)
+	}
+	r.bg.Refresh()
+
+	if e.Expanded {
+		r.chevron.SetResource(theme.MenuDropDownIcon())
+	} else {
+		r.chevron.SetResource(theme.MenuExpandIcon())
+	}
+	r.icon.SetResource(e.Icon)
+	r.icon.Hidden = e.Icon == nil
+	r.title.SetText(e.Title)
+	r.trailing.Objects = e.Trailing
+	r.trailing.Refresh()
+	r.content.Refresh()
+}
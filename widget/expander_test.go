@@ -0,0 +1,127 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpander_Toggle(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	changes := []bool{}
+	content := widget.NewLabel("Details\nMore details")
+	e := NewExpander("Advanced", content)
+	e.OnChanged = func(expanded bool) {
+		changes = append(changes, expanded)
+	}
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	header := test.WidgetRenderer(e).(*expanderRenderer).header
+	closed := e.MinSize()
+	assert.Equal(t, header.MinSize().Height, closed.Height)
+
+	test.Tap(header)
+	assert.True(t, e.Expanded)
+	time.Sleep(canvas.DurationShort + 100*time.Millisecond)
+	assert.InDelta(t, closed.Height+content.MinSize().Height, e.MinSize().Height, 0.01)
+
+	e.Close()
+	assert.False(t, e.Expanded)
+	assert.Equal(t, []bool{true, false}, changes)
+}
+
+func TestExpander_Group(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	a := NewExpander("A", widget.NewLabel("a"))
+	b := NewExpander("B", widget.NewLabel("b"))
+	a.Open()
+	b.Open()
+	g := NewExpanderGroup(a, b)
+	assert.True(t, a.Expanded)
+	assert.False(t, b.Expanded)
+
+	b.Open()
+	assert.False(t, a.Expanded)
+	assert.Equal(t, b, g.Expanded())
+
+	g.Remove(b)
+	a.Open()
+	assert.True(t, b.Expanded)
+}
+
+func TestExpander_SetPreferenceKey(t *testing.T) {
+	a := test.NewApp()
+	defer test.NewApp()
+
+	a.Preferences().SetBool("panel", true)
+	e := NewExpander("Saved", widget.NewLabel("content"))
+	e.SetPreferenceKey("panel")
+	assert.True(t, e.Expanded)
+
+	e.Close()
+	assert.False(t, a.Preferences().Bool("panel"))
+}
+
+func TestExpander_Trailing(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	tapped := false
+	e := NewExpander("Title", widget.NewLabel("content"))
+	e.Trailing = []fyne.CanvasObject{widget.NewButton("Edit", func() { tapped = true })}
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	test.Tap(e.Trailing[0].(*widget.Button))
+	assert.True(t, tapped)
+	assert.False(t, e.Expanded)
+}
+
+func TestExpander_Card(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	content := widget.NewLabel("content")
+	e := NewExpander("Card", content)
+	e.Card = true
+	e.Open()
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	r := test.WidgetRenderer(e).(*expanderRenderer)
+	assert.False(t, r.card.Hidden)
+	pad := theme.Padding()
+	assert.Equal(t, r.header.MinSize().Height+content.MinSize().Height+pad, e.MinSize().Height)
+
+	e.Resize(e.MinSize())
+	assert.Equal(t, fyne.NewPos(pad, r.header.MinSize().Height), r.clip.Position())
+	assert.Equal(t, e.Size().Width-pad*2, r.clip.Size().Width)
+}
+
+func TestExpander_Keyboard(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewExpander("Keys", widget.NewLabel("content"))
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	header := test.WidgetRenderer(e).(*expanderRenderer).header
+	w.Canvas().Focus(header)
+	assert.True(t, header.focused)
+	header.TypedKey(&fyne.KeyEvent{Name: fyne.KeySpace})
+	assert.True(t, e.Expanded)
+	header.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.False(t, e.Expanded)
+}
@@ -0,0 +1,318 @@
+package widget
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*FloatingActionButton)(nil)
+var _ fyne.Layout = (*fabLayout)(nil)
+var _ fyne.Tappable = (*fabButton)(nil)
+
+const (
+	fabSize       = 56
+	fabActionSize = 40
+)
+
+// FloatingAction is a secondary action of a speed-dial FloatingActionButton.
+type FloatingAction struct {
+	Label    string
+	Icon     fyne.Resource
+	OnTapped func() `json:"-"`
+}
+
+// FloatingActionButton is a round, raised button in the primary colour for the main action of a screen.
+// If it has Actions it is a speed-dial: tapping it shows the actions, with labels, above it.
+// Use WithFloatingActionButton to anchor it to the bottom trailing corner of some content.
+type FloatingActionButton struct {
+	widget.BaseWidget
+
+	Icon    fyne.Resource
+	Actions []*FloatingAction
+
+	OnTapped func() `json:"-"`
+
+	expanded bool
+	relayout func() // set by WithFloatingActionButton to reposition the button when its size changes
+}
+
+// NewFloatingActionButton creates a new button that calls tapped when it is tapped.
+func NewFloatingActionButton(icon fyne.Resource, tapped func()) *FloatingActionButton {
+	f := &FloatingActionButton{Icon: icon, OnTapped: tapped}
+	f.ExtendBaseWidget(f)
+	return f
+}
+
+// NewSpeedDial creates a new button that shows the given actions when it is tapped.
+func NewSpeedDial(icon fyne.Resource, actions ...*FloatingAction) *FloatingActionButton {
+	f := &FloatingActionButton{Icon: icon, Actions: actions}
+	f.ExtendBaseWidget(f)
+	return f
+}
+
+// WithFloatingActionButton anchors a floating action button to the bottom trailing corner of content.
+func WithFloatingActionButton(content fyne.CanvasObject, fab *FloatingActionButton) *fyne.Container {
+	l := &fabLayout{fab: fab}
+	c := container.New(l, content, fab)
+	fab.relayout = func() {
+		l.Layout(c.Objects, c.Size())
+	}
+	return c
+}
+
+// Collapse hides the actions of a speed-dial.
+func (f *FloatingActionButton) Collapse() {
+	if !f.expanded {
+		return
+	}
+	f.expanded = false
+	f.Refresh()
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (f *FloatingActionButton) CreateRenderer() fyne.WidgetRenderer {
+	f.ExtendBaseWidget(f)
+	main := &fabButton{size: fabSize, tapped: f.tapped}
+	main.ExtendBaseWidget(main)
+	r := &fabRenderer{fab: f, main: main}
+	r.Refresh()
+	return r
+}
+
+// Expand shows the actions of a speed-dial.
+func (f *FloatingActionButton) Expand() {
+	if f.expanded || len(f.Actions) == 0 {
+		return
+	}
+	f.expanded = true
+	f.Refresh()
+}
+
+// Refresh updates the button, moving it if its size changed while anchored by WithFloatingActionButton.
+func (f *FloatingActionButton) Refresh() {
+	f.BaseWidget.Refresh()
+	if f.relayout != nil && f.Size() != f.MinSize() {
+		f.relayout()
+	}
+}
+
+// HideOnScroll hides the button while scroll is scrolled down and shows it again when scrolled up.
+// Any existing OnScrolled callback of the scroll container is still called.
+func (f *FloatingActionButton) HideOnScroll(scroll *container.Scroll) {
+	last := scroll.Offset
+	previous := scroll.OnScrolled
+	scroll.OnScrolled = func(offset fyne.Position) {
+		if offset.Y > last.Y && f.Visible() {
+			f.Collapse()
+			f.Hide()
+		} else if offset.Y < last.Y && !f.Visible() {
+			f.Show()
+		}
+		last = offset
+		if previous != nil {
+			previous(offset)
+		}
+	}
+}
+
+// IsExpanded returns whether the actions of a speed-dial are shown.
+func (f *FloatingActionButton) IsExpanded() bool {
+	return f.expanded
+}
+
+func (f *FloatingActionButton) tapped() {
+	if len(f.Actions) > 0 {
+		if f.expanded {
+			f.Collapse()
+		} else {
+			f.Expand()
+		}
+		return
+	}
+	if t := f.OnTapped; t != nil {
+		t()
+	}
+}
+
+type fabRenderer struct {
+	fab     *FloatingActionButton
+	main    *fabButton
+	actions []fyne.CanvasObject
+}
+
+func (r *fabRenderer) Destroy() {
+}
+
+func (r *fabRenderer) Layout(size fyne.Size) {
+	r.main.Resize(fyne.NewSquareSize(fabSize))
+	r.main.Move(fyne.NewPos(size.Width-fabSize, size.Height-fabSize))
+
+	y := size.Height - fabSize
+	for i := len(r.actions) - 1; i >= 0; i-- {
+		row := r.actions[i]
+		min := row.MinSize()
+		y -= min.Height + theme.Padding()
+		row.Resize(fyne.NewSize(size.Width, min.Height))
+		row.Move(fyne.NewPos(0, y))
+	}
+}
+
+func (r *fabRenderer) MinSize() fyne.Size {
+	min := fyne.NewSquareSize(fabSize)
+	for _, row := range r.actions {
+		size := row.MinSize()
+		min = fyne.NewSize(fyne.Max(min.Width, size.Width), min.Height+size.Height+theme.Padding())
+	}
+	return min
+}
+
+func (r *fabRenderer) Objects() []fyne.CanvasObject {
+	return append([]fyne.CanvasObject{r.main}, r.actions...)
+}
+
+func (r *fabRenderer) Refresh() {
+	f := r.fab
+	r.main.icon = f.Icon
+	if f.expanded {
+		r.main.icon = theme.CancelIcon()
+	}
+	r.main.Refresh()
+
+	r.actions = nil
+	if f.expanded {
+		for _, a := range f.Actions {
+			r.actions = append(r.actions, r.makeAction(a))
+		}
+	}
+	r.Layout(f.Size())
+}
+
+func (r *fabRenderer) makeAction(a *FloatingAction) fyne.CanvasObject {
+	tapped := func() {
+		r.fab.Collapse()
+		if a.OnTapped != nil {
+			a.OnTapped()
+		}
+	}
+	button := &fabButton{size: fabActionSize, icon: a.Icon, tapped: tapped}
+	button.ExtendBaseWidget(button)
+	if a.Label == "" {
+		return container.NewHBox(layout.NewSpacer(), button, fabIndent())
+	}
+
+	text := canvas.NewText(a.Label, theme.Color(theme.ColorNameForeground))
+	bg := canvas.NewRectangle(theme.Color(theme.ColorNameOverlayBackground))
+	bg.CornerRadius = theme.InputRadiusSize()
+	label := container.NewStack(bg, container.NewPadded(text))
+	return container.NewHBox(layout.NewSpacer(), container.NewCenter(label), button, fabIndent())
+}
+
+// fabIndent centres the smaller action buttons above the main button.
+func fabIndent() fyne.CanvasObject {
+	r := canvas.NewRectangle(color.Transparent)
+	r.SetMinSize(fyne.NewSize((fabSize-fabActionSize)/2-theme.Padding(), 0))
+	return r
+}
+
+// fabButton is a raised circular button in the primary colour.
+type fabButton struct {
+	widget.BaseWidget
+	size   float32
+	icon   fyne.Resource
+	tapped func()
+}
+
+func (b *fabButton) CreateRenderer() fyne.WidgetRenderer {
+	shadow := canvas.NewCircle(theme.Color(theme.ColorNameShadow))
+	circle := canvas.NewCircle(theme.Color(theme.ColorNamePrimary))
+	icon := canvas.NewImageFromResource(nil)
+	icon.FillMode = canvas.ImageFillContain
+	r := &fabButtonRenderer{button: b, shadow: shadow, circle: circle, icon: icon}
+	r.Refresh()
+	return r
+}
+
+func (b *fabButton) Tapped(*fyne.PointEvent) {
+	if b.tapped != nil {
+		b.tapped()
+	}
+}
+
+type fabButtonRenderer struct {
+	button         *fabButton
+	shadow, circle *canvas.Circle
+	icon           *canvas.Image
+}
+
+func (r *fabButtonRenderer) Destroy() {
+}
+
+func (r *fabButtonRenderer) Layout(size fyne.Size) {
+	elevation := theme.Padding() / 2
+	r.circle.Resize(fyne.NewSquareSize(r.button.size - elevation))
+	r.shadow.Resize(fyne.NewSquareSize(r.button.size - elevation))
+	r.shadow.Move(fyne.NewPos(0, elevation))
+	icon := r.button.size * 0.45
+	r.icon.Resize(fyne.NewSquareSize(icon))
+	r.icon.Move(fyne.NewPos((r.button.size-elevation-icon)/2, (r.button.size-elevation-icon)/2))
+}
+
+func (r *fabButtonRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(r.button.size)
+}
+
+func (r *fabButtonRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.shadow, r.circle, r.icon}
+}
+
+func (r *fabButtonRenderer) Refresh() {
+	r.shadow.FillColor = theme.Color(theme.ColorNameShadow)
+	r.circle.FillColor = theme.Color(theme.ColorNamePrimary)
+	r.icon.Resource = nil
+	if r.button.icon != nil {
+		r.icon.Resource = theme.NewColoredResource(r.button.icon, theme.ColorNameForegroundOnPrimary)
+	}
+	r.shadow.Refresh()
+	r.circle.Refresh()
+	r.icon.Refresh()
+	r.Layout(r.button.Size())
+}
+
+// fabLayout fills the space with the content and places the button in the bottom trailing corner.
+type fabLayout struct {
+	fab *FloatingActionButton
+}
+
+func (l *fabLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	margin := theme.Padding() * 4
+	for _, o := range objects {
+		if o == l.fab {
+			continue
+		}
+		o.Resize(size)
+		o.Move(fyne.NewPos(0, 0))
+	}
+
+	fab := l.fab.MinSize()
+	l.fab.Resize(fab)
+	l.fab.Move(fyne.NewPos(size.Width-fab.Width-margin, size.Height-fab.Height-margin))
+}
+
+func (l *fabLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	min := fyne.NewSize(0, 0)
+	for _, o := range objects {
+		if o == l.fab {
+			continue
+		}
+		min = min.Max(o.MinSize())
+	}
+	return min
+}
@@ -0,0 +1,77 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloatingActionButton_Tapped(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	tapped := false
+	fab := NewFloatingActionButton(theme.ContentAddIcon(), func() {
+		tapped = true
+	})
+	w := test.NewWindow(WithFloatingActionButton(widget.NewLabel("content"), fab))
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(300, 300))
+
+	main := test.WidgetRenderer(fab).(*fabRenderer).main
+	test.Tap(main)
+	assert.True(t, tapped)
+	assert.False(t, fab.IsExpanded())
+
+	margin := theme.Padding() * 4
+	assert.Equal(t, fyne.NewPos(300-fabSize-margin, 300-fabSize-margin), fab.Position())
+}
+
+func TestFloatingActionButton_SpeedDial(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	chosen := ""
+	fab := NewSpeedDial(theme.ContentAddIcon(),
+		&FloatingAction{Label: "Photo", Icon: theme.FileImageIcon(), OnTapped: func() { chosen = "photo" }},
+		&FloatingAction{Label: "Note", Icon: theme.DocumentIcon(), OnTapped: func() { chosen = "note" }},
+	)
+	w := test.NewWindow(WithFloatingActionButton(widget.NewLabel("content"), fab))
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(300, 400))
+
+	r := test.WidgetRenderer(fab).(*fabRenderer)
+	collapsed := fab.MinSize()
+	test.Tap(r.main)
+	assert.True(t, fab.IsExpanded())
+	assert.Len(t, r.actions, 2)
+	assert.Greater(t, fab.Size().Height, collapsed.Height)
+	assert.Equal(t, 400-theme.Padding()*4, fab.Position().Y+fab.Size().Height)
+
+	row := r.actions[1].(*fyne.Container)
+	test.Tap(row.Objects[2].(*fabButton))
+	assert.Equal(t, "note", chosen)
+	assert.False(t, fab.IsExpanded())
+	assert.Empty(t, r.actions)
+}
+
+func TestFloatingActionButton_HideOnScroll(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	fab := NewFloatingActionButton(theme.ContentAddIcon(), nil)
+	scroll := container.NewVScroll(widget.NewLabel("long\ncontent"))
+	fab.HideOnScroll(scroll)
+
+	scroll.OnScrolled(fyne.NewPos(0, 20))
+	assert.False(t, fab.Visible())
+	scroll.OnScrolled(fyne.NewPos(0, 10))
+	assert.True(t, fab.Visible())
+}
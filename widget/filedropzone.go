@@ -0,0 +1,353 @@
+package widget
+
+import (
+	"image/color"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*FileDropZone)(nil)
+var _ fyne.Tappable = (*FileDropZone)(nil)
+var _ fyne.Disableable = (*FileDropZone)(nil)
+var _ desktop.Hoverable = (*FileDropZone)(nil)
+
+var (
+	dropZones     = map[*FileDropZone]struct{}{}
+	dropZonesLock sync.RWMutex
+)
+
+// FileDropZone is an area that accepts files and folders dragged from the operating system.
+// It shows a dashed border and hint text, and opens a file dialog when tapped.
+// The window containing drop zones must be set up using EnableFileDrops.
+//
+// Fyne does not report files being dragged over a window before they are dropped, so the
+// zone is highlighted when the mouse pointer hovers over it rather than during a drag.
+type FileDropZone struct {
+	widget.DisableableWidget
+
+	// Text is the hint shown inside the dashed border.
+	Text string
+	// Extensions limits accepted files to those with one of these extensions, such as ".png".
+	Extensions []string
+	// MimeTypes limits accepted files to those with one of these types, such as "image/*".
+	MimeTypes []string
+	// AllowFolders accepts dropped folders, tapping then opens a folder dialog if no filters are set.
+	AllowFolders bool
+
+	OnDropped func([]fyne.URI) `json:"-"`
+	// OnRejected is called with any dropped items that did not match the filters.
+	OnRejected func([]fyne.URI) `json:"-"`
+
+	hovered bool
+}
+
+// NewFileDropZone creates a new drop zone with the given hint text.
+func NewFileDropZone(text string, dropped func([]fyne.URI)) *FileDropZone {
+	z := &FileDropZone{Text: text, OnDropped: dropped}
+	z.ExtendBaseWidget(z)
+	return z
+}
+
+// EnableFileDrops routes files dropped on the window to the FileDropZone under the pointer.
+// It replaces any callback previously set with SetOnDropped.
+func EnableFileDrops(w fyne.Window) {
+	w.SetOnDropped(fileDropHandler(w))
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (z *FileDropZone) CreateRenderer() fyne.WidgetRenderer {
+	z.ExtendBaseWidget(z)
+	dropZonesLock.Lock()
+	dropZones[z] = struct{}{}
+	dropZonesLock.Unlock()
+
+	bg := canvas.NewRectangle(color.Transparent)
+	bg.CornerRadius = theme.InputRadiusSize()
+	icon := canvas.NewImageFromResource(theme.UploadIcon())
+	icon.FillMode = canvas.ImageFillContain
+	text := widget.NewLabel(z.Text)
+	text.Alignment = fyne.TextAlignCenter
+	text.Wrapping = fyne.TextWrapWord
+	r := &fileDropZoneRenderer{zone: z, bg: bg, icon: icon, text: text}
+	r.Refresh()
+	return r
+}
+
+// Drop delivers dropped items to the zone, applying the filters.
+// It is called by the window handler installed with EnableFileDrops.
+func (z *FileDropZone) Drop(uris []fyne.URI) {
+	if z.Disabled() {
+		return
+	}
+	var accepted, rejected []fyne.URI
+	for _, u := range uris {
+		if z.accepts(u) {
+			accepted = append(accepted, u)
+		} else {
+			rejected = append(rejected, u)
+		}
+	}
+	z.hovered = false
+	z.Refresh()
+
+	if len(rejected) > 0 && z.OnRejected != nil {
+		z.OnRejected(rejected)
+	}
+	if len(accepted) > 0 && z.OnDropped != nil {
+		z.OnDropped(accepted)
+	}
+}
+
+// MouseIn is called when a desktop pointer enters the widget.
+//
+// Implements: desktop.Hoverable
+func (z *FileDropZone) MouseIn(*desktop.MouseEvent) {
+	z.hovered = true
+	z.Refresh()
+}
+
+// MouseMoved is called when a desktop pointer hovers over the widget.
+//
+// Implements: desktop.Hoverable
+func (z *FileDropZone) MouseMoved(*desktop.MouseEvent) {
+}
+
+// MouseOut is called when a desktop pointer exits the widget.
+//
+// Implements: desktop.Hoverable
+func (z *FileDropZone) MouseOut() {
+	z.hovered = false
+	z.Refresh()
+}
+
+// Tapped opens a file dialog, or a folder dialog if only folders make sense.
+//
+// Implements: fyne.Tappable
+func (z *FileDropZone) Tapped(*fyne.PointEvent) {
+	if z.Disabled() {
+		return
+	}
+	w := z.window()
+	if w == nil {
+		return
+	}
+
+	if z.AllowFolders && len(z.Extensions) == 0 && len(z.MimeTypes) == 0 {
+		dialog.ShowFolderOpen(func(u fyne.ListableURI, err error) {
+			if err != nil {
+				fyne.LogError("Error opening folder", err)
+				return
+			}
+			if u != nil {
+				z.Drop([]fyne.URI{u})
+			}
+		}, w)
+		return
+	}
+
+	d := dialog.NewFileOpen(func(r fyne.URIReadCloser, err error) {
+		if err != nil {
+			fyne.LogError("Error opening file", err)
+			return
+		}
+		if r == nil {
+			return
+		}
+		_ = r.Close()
+		z.Drop([]fyne.URI{r.URI()})
+	}, w)
+	if filter := z.filter(); filter != nil {
+		d.SetFilter(filter)
+	}
+	d.Show()
+}
+
+func (z *FileDropZone) accepts(u fyne.URI) bool {
+	if folder, err := storage.CanList(u); err == nil && folder {
+		return z.AllowFolders
+	}
+	filter := z.filter()
+	return filter == nil || filter.Matches(u)
+}
+
+func (z *FileDropZone) contains(pos fyne.Position) bool {
+	if !z.Visible() {
+		return false
+	}
+	abs := fyne.CurrentApp().Driver().AbsolutePositionForObject(z)
+	size := z.Size()
+	return pos.X >= abs.X && pos.Y >= abs.Y && pos.X < abs.X+size.Width && pos.Y < abs.Y+size.Height
+}
+
+func (z *FileDropZone) filter() storage.FileFilter {
+	var filters anyFileFilter
+	if len(z.Extensions) > 0 {
+		filters = append(filters, storage.NewExtensionFileFilter(z.Extensions))
+	}
+	if len(z.MimeTypes) > 0 {
+		filters = append(filters, storage.NewMimeTypeFileFilter(z.MimeTypes))
+	}
+	if len(filters) == 0 {
+		return nil
+	}
+	return filters
+}
+
+func (z *FileDropZone) window() fyne.Window {
+	c := fyne.CurrentApp().Driver().CanvasForObject(z)
+	for _, w := range fyne.CurrentApp().Driver().AllWindows() {
+		if w.Canvas() == c {
+			return w
+		}
+	}
+	return nil
+}
+
+func fileDropHandler(w fyne.Window) func(fyne.Position, []fyne.URI) {
+	return func(pos fyne.Position, uris []fyne.URI) {
+		dropZonesLock.RLock()
+		// zones can be nested, so pick the innermost one under the pointer
+		var target *FileDropZone
+		for z := range dropZones {
+			if fyne.CurrentApp().Driver().CanvasForObject(z) != w.Canvas() || !z.contains(pos) {
+				continue
+			}
+			if target == nil || z.Size().Width*z.Size().Height < target.Size().Width*target.Size().Height {
+				target = z
+			}
+		}
+		dropZonesLock.RUnlock()
+
+		if target != nil {
+			target.Drop(uris)
+		}
+	}
+}
+
+// anyFileFilter matches a URI that any of its filters match.
+type anyFileFilter []storage.FileFilter
+
+func (f anyFileFilter) Matches(u fyne.URI) bool {
+	for _, filter := range f {
+		if filter.Matches(u) {
+			return true
+		}
+	}
+	return false
+}
+
+type fileDropZoneRenderer struct {
+	zone   *FileDropZone
+	bg     *canvas.Rectangle
+	dashes []fyne.CanvasObject
+	icon   *canvas.Image
+	text   *widget.Label
+}
+
+func (r *fileDropZoneRenderer) Destroy() {
+	dropZonesLock.Lock()
+	delete(dropZones, r.zone)
+	dropZonesLock.Unlock()
+}
+
+func (r *fileDropZoneRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	r.layoutDashes(size)
+
+	pad := theme.InnerPadding()
+	iconSize := theme.IconInlineSize() * 2
+	textHeight := r.text.MinSize().Height
+	top := (size.Height - iconSize - textHeight) / 2
+	r.icon.Resize(fyne.NewSquareSize(iconSize))
+	r.icon.Move(fyne.NewPos((size.Width-iconSize)/2, top))
+	r.text.Resize(fyne.NewSize(size.Width-pad*2, textHeight))
+	r.text.Move(fyne.NewPos(pad, top+iconSize))
+}
+
+func (r *fileDropZoneRenderer) MinSize() fyne.Size {
+	pad := theme.InnerPadding()
+	iconSize := theme.IconInlineSize() * 2
+	text := widget.NewLabel(r.zone.Text).MinSize()
+	return fyne.NewSize(fyne.Max(text.Width, iconSize)+pad*2, iconSize+text.Height+pad*2)
+}
+
+func (r *fileDropZoneRenderer) Objects() []fyne.CanvasObject {
+	return append([]fyne.CanvasObject{r.bg, r.icon, r.text}, r.dashes...)
+}
+
+func (r *fileDropZoneRenderer) Refresh() {
+	z := r.zone
+	r.text.SetText(z.Text)
+
+	r.bg.FillColor = color.Transparent
+	if z.hovered && !z.Disabled() {
+		r.bg.FillColor = theme.Color(theme.ColorNameHover)
+	}
+	r.icon.Resource = theme.UploadIcon()
+	if z.Disabled() {
+		r.icon.Resource = theme.NewDisabledResource(theme.UploadIcon())
+		r.text.Importance = widget.LowImportance
+	} else {
+		r.text.Importance = widget.MediumImportance
+	}
+	for _, d := range r.dashes {
+		d.(*canvas.Line).StrokeColor = r.dashColor()
+	}
+
+	r.Layout(z.Size())
+	r.bg.Refresh()
+	r.icon.Refresh()
+	r.text.Refresh()
+	canvas.Refresh(z)
+}
+
+func (r *fileDropZoneRenderer) dashColor() color.Color {
+	z := r.zone
+	switch {
+	case z.Disabled():
+		return theme.Color(theme.ColorNameDisabled)
+	case z.hovered:
+		return theme.Color(theme.ColorNamePrimary)
+	default:
+		return theme.Color(theme.ColorNameInputBorder)
+	}
+}
+
+// layoutDashes draws the border as short line segments, reusing the existing lines where possible.
+func (r *fileDropZoneRenderer) layoutDashes(size fyne.Size) {
+	dash := theme.Padding() * 1.5
+	width := theme.InputBorderSize() * 1.5
+	half := width / 2
+	var segments [][2]fyne.Position
+	for x := half; x < size.Width-half; x += dash * 2 {
+		end := fyne.Min(x+dash, size.Width-half)
+		segments = append(segments,
+			[2]fyne.Position{{X: x, Y: half}, {X: end, Y: half}},
+			[2]fyne.Position{{X: x, Y: size.Height - half}, {X: end, Y: size.Height - half}})
+	}
+	for y := half; y < size.Height-half; y += dash * 2 {
+		end := fyne.Min(y+dash, size.Height-half)
+		segments = append(segments,
+			[2]fyne.Position{{X: half, Y: y}, {X: half, Y: end}},
+			[2]fyne.Position{{X: size.Width - half, Y: y}, {X: size.Width - half, Y: end}})
+	}
+
+	for len(r.dashes) < len(segments) {
+		r.dashes = append(r.dashes, canvas.NewLine(r.dashColor()))
+	}
+	r.dashes = r.dashes[:len(segments)]
+	for i, s := range segments {
+		l := r.dashes[i].(*canvas.Line)
+		l.StrokeWidth = width
+		l.Position1, l.Position2 = s[0], s[1]
+	}
+}
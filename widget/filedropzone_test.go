@@ -0,0 +1,111 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileDropZone_Filter(t *testing.T) {
+	var dropped, rejected []fyne.URI
+	z := NewFileDropZone("Drop images", func(uris []fyne.URI) {
+		dropped = uris
+	})
+	z.OnRejected = func(uris []fyne.URI) {
+		rejected = uris
+	}
+	z.Extensions = []string{".png"}
+	z.MimeTypes = []string{"image/jpeg"}
+
+	png := storage.NewFileURI("/tmp/a.png")
+	jpg := storage.NewFileURI("/tmp/b.jpg")
+	txt := storage.NewFileURI("/tmp/c.txt")
+	z.Drop([]fyne.URI{png, jpg, txt})
+	assert.Equal(t, []fyne.URI{png, jpg}, dropped)
+	assert.Equal(t, []fyne.URI{txt}, rejected)
+
+	dropped = nil
+	z.Disable()
+	z.Drop([]fyne.URI{png})
+	assert.Nil(t, dropped)
+}
+
+func TestFileDropZone_Folders(t *testing.T) {
+	var dropped []fyne.URI
+	z := NewFileDropZone("Drop a folder", func(uris []fyne.URI) {
+		dropped = uris
+	})
+	dir := storage.NewFileURI(t.TempDir())
+
+	z.Drop([]fyne.URI{dir})
+	assert.Nil(t, dropped)
+
+	z.AllowFolders = true
+	z.Drop([]fyne.URI{dir})
+	assert.Equal(t, []fyne.URI{dir}, dropped)
+}
+
+func TestFileDropZone_WindowDrop(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+	resetDropZones()
+
+	var dropped []fyne.URI
+	z := NewFileDropZone("Drop here", func(uris []fyne.URI) {
+		dropped = uris
+	})
+	w := test.NewWindow(z)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+
+	file := storage.NewFileURI("/tmp/file.txt")
+	drop := fileDropHandler(w)
+	drop(fyne.NewPos(-10, -10), []fyne.URI{file})
+	assert.Nil(t, dropped)
+
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(z)
+	drop(pos.AddXY(5, 5), []fyne.URI{file})
+	assert.Equal(t, []fyne.URI{file}, dropped)
+}
+
+func TestFileDropZone_NestedDrop(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+	resetDropZones()
+
+	var outerDropped, innerDropped []fyne.URI
+	outer := NewFileDropZone("Drop anywhere", func(uris []fyne.URI) {
+		outerDropped = uris
+	})
+	inner := NewFileDropZone("Drop here", func(uris []fyne.URI) {
+		innerDropped = uris
+	})
+	w := test.NewWindow(container.NewStack(outer, container.NewCenter(inner)))
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 300))
+
+	file := storage.NewFileURI("/tmp/file.txt")
+	drop := fileDropHandler(w)
+	for i := 0; i < 10; i++ {
+		innerDropped = nil
+		pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(inner)
+		drop(pos.AddXY(5, 5), []fyne.URI{file})
+		assert.Equal(t, []fyne.URI{file}, innerDropped)
+	}
+	assert.Nil(t, outerDropped)
+
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(outer)
+	drop(pos.AddXY(2, 2), []fyne.URI{file})
+	assert.Equal(t, []fyne.URI{file}, outerDropped)
+}
+
+// resetDropZones forgets the zones of earlier tests, as the test driver reports its latest canvas for every object.
+func resetDropZones() {
+	dropZonesLock.Lock()
+	dropZones = map[*FileDropZone]struct{}{}
+	dropZonesLock.Unlock()
+}
@@ -0,0 +1,100 @@
+package widget
+
+import (
+	"unicode"
+)
+
+// the scores of fuzzyMatch, which favour matches that start words and run on, as fzf does
+const (
+	fuzzyScoreMatch       = 16
+	fuzzyScoreGapStart    = -3
+	fuzzyScoreGapExtend   = -1
+	fuzzyBonusBoundary    = 8
+	fuzzyBonusConsecutive = 4
+	fuzzyBonusFirstChar   = 2
+)
+
+// fuzzyMatch returns whether the runes of a pattern appear in a text in order, ignoring case, how well they do
+// and the indexes of the runes of the text that they match. The match is the shortest run of the text that has
+// the pattern, so "fb" matches "foo bar" at 0 and 4 rather than further apart.
+func fuzzyMatch(pattern, text string) (score int, positions []int, ok bool) {
+	p := []rune(pattern)
+	t := []rune(text)
+	if len(p) == 0 {
+		return 0, nil, true
+	}
+
+	// find the first end of a match, then the last start of a match that ends there
+	end, j := -1, 0
+	for i, r := range t {
+		if fuzzyEqual(r, p[j]) {
+			j++
+			if j == len(p) {
+				end = i
+				break
+			}
+		}
+	}
+	if end < 0 {
+		return 0, nil, false
+	}
+	start := end
+	for j = len(p) - 1; ; start-- {
+		if fuzzyEqual(t[start], p[j]) {
+			if j == 0 {
+				break
+			}
+			j--
+		}
+	}
+
+	positions = make([]int, 0, len(p))
+	j = 0
+	runBonus := 0 // of the first rune of a run of matched runes, which the rest of the run gets too
+	for i := start; i <= end && j < len(p); i++ {
+		if !fuzzyEqual(t[i], p[j]) {
+			continue
+		}
+		score += fuzzyScoreMatch
+		if j == 0 && i == 0 {
+			score += fuzzyBonusFirstChar
+		}
+		bonus := 0
+		if fuzzyBoundary(t, i) {
+			bonus = fuzzyBonusBoundary
+		}
+		if j > 0 && i == positions[j-1]+1 {
+			if runBonus < fuzzyBonusConsecutive {
+				runBonus = fuzzyBonusConsecutive
+			}
+			if bonus < runBonus {
+				bonus = runBonus
+			}
+		} else {
+			runBonus = bonus
+			if j > 0 {
+				score += fuzzyScoreGapStart + (i-positions[j-1]-2)*fuzzyScoreGapExtend
+			}
+		}
+		score += bonus
+		positions = append(positions, i)
+		j++
+	}
+	return score, positions, true
+}
+
+// fuzzyBoundary returns whether a rune of a text starts a word, after a separator or as an upper case letter.
+func fuzzyBoundary(t []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+	prev := t[i-1]
+	if !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+		return true
+	}
+	return unicode.IsLower(prev) && unicode.IsUpper(t[i])
+}
+
+func fuzzyEqual(a, b rune) bool {
+	return a == b || unicode.ToLower(a) == unicode.ToLower(b)
+}
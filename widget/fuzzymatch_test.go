@@ -0,0 +1,29 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	score, positions, ok := fuzzyMatch("fb", "foo bar")
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 4}, positions)
+
+	_, positions, _ = fuzzyMatch("ab", "a xab")
+	assert.Equal(t, []int{3, 4}, positions, "the shortest run is matched")
+
+	_, _, ok = fuzzyMatch("xyz", "foo bar")
+	assert.False(t, ok)
+	_, _, ok = fuzzyMatch("OB", "foo bar")
+	assert.True(t, ok, "case is ignored")
+
+	consecutive, _, _ := fuzzyMatch("bar", "foo bar")
+	scattered, _, _ := fuzzyMatch("bar", "b a r")
+	assert.Greater(t, consecutive, scattered)
+	boundary, _, _ := fuzzyMatch("b", "fooBar")
+	inside, _, _ := fuzzyMatch("b", "foobar")
+	assert.Greater(t, boundary, inside)
+	assert.Greater(t, score, 0)
+}
@@ -0,0 +1,510 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"golang.org/x/image/vector"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Gauge)(nil)
+var _ fyne.Widget = (*LinearGauge)(nil)
+
+const (
+	gaugeSweep      = 270.0 // degrees of the arc of a radial gauge
+	gaugeStartAngle = 225.0 // degrees anticlockwise from 3 o'clock, where the arc starts at the lower left
+	gaugeArcStep    = 2.0   // degrees between the points of drawn arcs
+)
+
+// GaugeStyle is how a gauge marks its value.
+type GaugeStyle int
+
+const (
+	// GaugeNeedle points a needle, or a marker on a linear gauge, at the value. This is the default.
+	GaugeNeedle GaugeStyle = iota
+	// GaugeFilled fills the gauge from its minimum up to the value.
+	GaugeFilled
+)
+
+// GaugeZone colours a range of values on a gauge, such as red for 90 to 100.
+type GaugeZone struct {
+	From, To float64
+	Color    color.Color
+}
+
+// Gauge shows a value from Min to Max on an arc, such as the load of a server on a dashboard.
+// Changes of the value are animated.
+type Gauge struct {
+	widget.BaseWidget
+
+	Min, Max float64
+	Value    float64
+	Style    GaugeStyle
+	// Zones colour ranges of the arc. With GaugeFilled, the fill takes the colour of the zone the value is in.
+	Zones []GaugeZone
+	// Thresholds are values marked across the arc, such as a limit.
+	Thresholds []float64
+	// Label is shown below the value, such as a unit or what is measured.
+	Label string
+	// Format converts the value to the text shown, the default prints the number.
+	Format func(float64) string `json:"-"`
+
+	binder *floatBinder
+}
+
+// NewGauge returns a radial gauge covering min to max, showing min.
+func NewGauge(min, max float64) *Gauge {
+	g := &Gauge{Min: min, Max: max, Value: min}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+// NewGaugeWithData returns a radial gauge covering min to max, showing the value of a data item.
+func NewGaugeWithData(min, max float64, data binding.Float) *Gauge {
+	g := NewGauge(min, max)
+	g.Bind(data)
+	return g
+}
+
+// Bind connects the value of the gauge to a data source.
+func (g *Gauge) Bind(data binding.Float) {
+	g.Unbind()
+	g.binder = newFloatBinder(data, g.SetValue)
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (g *Gauge) CreateRenderer() fyne.WidgetRenderer {
+	g.ExtendBaseWidget(g)
+	r := &gaugeRenderer{gauge: g, shown: g.Value, target: g.Value,
+		value: canvas.NewText("", color.Transparent), label: canvas.NewText("", color.Transparent),
+		min: canvas.NewText("", color.Transparent), max: canvas.NewText("", color.Transparent)}
+	r.raster = canvas.NewRaster(r.draw)
+	r.value.TextStyle.Bold = true
+	r.value.Alignment = fyne.TextAlignCenter
+	r.label.Alignment = fyne.TextAlignCenter
+	r.Refresh()
+	return r
+}
+
+// SetValue changes the value shown, animating the gauge to it.
+func (g *Gauge) SetValue(v float64) {
+	g.Value = v
+	g.Refresh()
+}
+
+// Unbind disconnects the value of the gauge from its data source.
+func (g *Gauge) Unbind() {
+	g.binder.unbind()
+	g.binder = nil
+}
+
+// angle returns where a value is on the arc, in radians anticlockwise from 3 o'clock.
+func (g *Gauge) angle(v float64) float64 {
+	return (gaugeStartAngle - gaugeFraction(v, g.Min, g.Max)*gaugeSweep) * math.Pi / 180
+}
+
+type gaugeRenderer struct {
+	gauge         *Gauge
+	raster        *canvas.Raster
+	value, label  *canvas.Text
+	min, max      *canvas.Text
+	shown, target float64
+	anim          *fyne.Animation
+}
+
+func (r *gaugeRenderer) Destroy() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+}
+
+func (r *gaugeRenderer) Layout(size fyne.Size) {
+	r.raster.Resize(size)
+	center, radius := r.geometry(size)
+
+	valueSize := r.value.MinSize()
+	r.value.Resize(fyne.NewSize(size.Width, valueSize.Height))
+	r.value.Move(fyne.NewPos(0, center.Y-valueSize.Height/2))
+	r.label.Resize(fyne.NewSize(size.Width, r.label.MinSize().Height))
+	r.label.Move(fyne.NewPos(0, center.Y+valueSize.Height/2))
+
+	// the ends of the arc are at 45 degrees below the middle
+	end := radius * float32(math.Sqrt2/2)
+	for _, t := range []*canvas.Text{r.min, r.max} {
+		t.Resize(t.MinSize())
+	}
+	r.min.Move(fyne.NewPos(center.X-end, center.Y+end))
+	r.max.Move(fyne.NewPos(center.X+end-r.max.Size().Width, center.Y+end))
+}
+
+func (r *gaugeRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(theme.IconInlineSize() * 6)
+}
+
+func (r *gaugeRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.raster, r.value, r.label, r.min, r.max}
+}
+
+func (r *gaugeRenderer) Refresh() {
+	g := r.gauge
+	if g.Value != r.target {
+		r.target = g.Value
+		r.animate()
+	}
+
+	fg := theme.Color(theme.ColorNameForeground)
+	r.value.Text = gaugeFormat(g.Format, g.Value)
+	r.value.TextSize = theme.TextHeadingSize()
+	r.value.Color = fg
+	r.label.Text = g.Label
+	r.label.TextSize = theme.CaptionTextSize()
+	r.label.Color = theme.Color(theme.ColorNamePlaceHolder)
+	r.min.Text, r.max.Text = gaugeFormat(g.Format, g.Min), gaugeFormat(g.Format, g.Max)
+	for _, t := range []*canvas.Text{r.min, r.max} {
+		t.TextSize = theme.CaptionTextSize()
+		t.Color = r.label.Color
+	}
+	r.Layout(g.Size())
+	canvas.Refresh(g)
+}
+
+func (r *gaugeRenderer) animate() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+	start, end := r.shown, r.target
+	r.anim = fyne.NewAnimation(canvas.DurationStandard, func(done float32) {
+		r.shown = start + (end-start)*float64(done)
+		r.raster.Refresh()
+	})
+	r.anim.Curve = fyne.AnimationEaseOut
+	r.anim.Start()
+}
+
+func (r *gaugeRenderer) draw(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	g := r.gauge
+	size := g.Size()
+	if size.Width <= 0 || g.Max <= g.Min {
+		return img
+	}
+	scale := float32(w) / size.Width
+	center, radius := r.geometry(size)
+	center, radius = fyne.NewPos(center.X*scale, center.Y*scale), radius*scale
+	width := radius * .16
+	inner := radius - width
+
+	bounds := img.Bounds()
+	fill := func(c color.Color, add func(*vector.Rasterizer)) {
+		v := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+		add(v)
+		v.Draw(img, bounds, image.NewUniform(c), image.Point{})
+	}
+	arc := func(c color.Color, from, to float64, r0, r1 float32) {
+		fill(c, func(v *vector.Rasterizer) {
+			addArcBand(v, center, g.angle(from), g.angle(to), r0, r1)
+		})
+	}
+
+	arc(theme.Color(theme.ColorNameInputBackground), g.Min, g.Max, inner, radius)
+	valueColor := theme.Color(theme.ColorNamePrimary)
+	for _, z := range g.Zones {
+		if g.Style == GaugeFilled {
+			// a thin band outside the fill shows the zones
+			arc(z.Color, z.From, z.To, radius-width*.25, radius)
+			if r.shown >= z.From && r.shown <= z.To {
+				valueColor = z.Color
+			}
+		} else {
+			arc(z.Color, z.From, z.To, inner, radius)
+		}
+	}
+
+	fg := theme.Color(theme.ColorNameForeground)
+	for _, t := range g.Thresholds {
+		a := g.angle(t)
+		fill(fg, func(v *vector.Rasterizer) {
+			addArcBand(v, center, a+.01, a-.01, inner-width*.2, radius+width*.2)
+		})
+	}
+
+	shown := math.Max(g.Min, math.Min(g.Max, r.shown))
+	if g.Style == GaugeFilled {
+		if shown > g.Min {
+			arc(valueColor, g.Min, shown, inner, radius-width*.3)
+		}
+		return img
+	}
+
+	// the needle is a thin triangle from a hub in the centre to the arc
+	a := g.angle(shown)
+	sin, cos := float32(math.Sin(a)), float32(math.Cos(a))
+	hub := width * .6
+	fill(fg, func(v *vector.Rasterizer) {
+		v.MoveTo(center.X+cos*inner, center.Y-sin*inner)
+		v.LineTo(center.X-sin*hub*.6, center.Y-cos*hub*.6)
+		v.LineTo(center.X+sin*hub*.6, center.Y+cos*hub*.6)
+		v.ClosePath()
+	})
+	fill(fg, func(v *vector.Rasterizer) {
+		addCircle(v, center, hub)
+	})
+	return img
+}
+
+// geometry returns the centre and outer radius of the arc of a gauge of a size.
+func (r *gaugeRenderer) geometry(size fyne.Size) (fyne.Position, float32) {
+	radius := fyne.Min(size.Width, size.Height)/2 - theme.Padding()
+	return fyne.NewPos(size.Width/2, size.Height/2), radius
+}
+
+// LinearGauge shows a value from Min to Max on a horizontal bar, with the same options as a Gauge.
+// It is filled up to the value by default, GaugeNeedle marks the value instead.
+type LinearGauge struct {
+	widget.BaseWidget
+
+	Min, Max   float64
+	Value      float64
+	Style      GaugeStyle
+	Zones      []GaugeZone
+	Thresholds []float64
+	// Label is shown before the value, such as what is measured.
+	Label  string
+	Format func(float64) string `json:"-"`
+
+	binder *floatBinder
+}
+
+// NewLinearGauge returns a bar gauge covering min to max, showing min.
+func NewLinearGauge(min, max float64) *LinearGauge {
+	g := &LinearGauge{Min: min, Max: max, Value: min, Style: GaugeFilled}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+// NewLinearGaugeWithData returns a bar gauge covering min to max, showing the value of a data item.
+func NewLinearGaugeWithData(min, max float64, data binding.Float) *LinearGauge {
+	g := NewLinearGauge(min, max)
+	g.Bind(data)
+	return g
+}
+
+// Bind connects the value of the gauge to a data source.
+func (g *LinearGauge) Bind(data binding.Float) {
+	g.Unbind()
+	g.binder = newFloatBinder(data, g.SetValue)
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (g *LinearGauge) CreateRenderer() fyne.WidgetRenderer {
+	g.ExtendBaseWidget(g)
+	r := &linearGaugeRenderer{gauge: g, shown: g.Value, target: g.Value,
+		track: canvas.NewRectangle(color.Transparent), fill: canvas.NewRectangle(color.Transparent),
+		marker: canvas.NewRectangle(color.Transparent), label: canvas.NewText("", color.Transparent),
+		value: canvas.NewText("", color.Transparent)}
+	r.value.TextStyle.Bold = true
+	r.Refresh()
+	return r
+}
+
+// SetValue changes the value shown, animating the gauge to it.
+func (g *LinearGauge) SetValue(v float64) {
+	g.Value = v
+	g.Refresh()
+}
+
+// Unbind disconnects the value of the gauge from its data source.
+func (g *LinearGauge) Unbind() {
+	g.binder.unbind()
+	g.binder = nil
+}
+
+type linearGaugeRenderer struct {
+	gauge         *LinearGauge
+	track, fill   *canvas.Rectangle
+	marker        *canvas.Rectangle
+	zones         []*canvas.Rectangle
+	thresholds    []*canvas.Rectangle
+	label, value  *canvas.Text
+	shown, target float64
+	anim          *fyne.Animation
+}
+
+func (r *linearGaugeRenderer) Destroy() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+}
+
+func (r *linearGaugeRenderer) Layout(size fyne.Size) {
+	g := r.gauge
+	textHeight := fyne.Max(r.label.MinSize().Height, r.value.MinSize().Height)
+	r.label.Resize(r.label.MinSize())
+	r.label.Move(fyne.NewPos(0, 0))
+	r.value.Resize(r.value.MinSize())
+	r.value.Move(fyne.NewPos(size.Width-r.value.Size().Width, 0))
+
+	barHeight := theme.Padding() * 2
+	top := textHeight + theme.Padding()
+	r.track.Resize(fyne.NewSize(size.Width, barHeight))
+	r.track.Move(fyne.NewPos(0, top))
+	x := func(v float64) float32 {
+		return size.Width * float32(gaugeFraction(v, g.Min, g.Max))
+	}
+
+	zoneHeight := barHeight
+	if g.Style == GaugeFilled {
+		zoneHeight = barHeight / 4
+	}
+	for i, z := range g.Zones {
+		if i >= len(r.zones) {
+			break
+		}
+		r.zones[i].Move(fyne.NewPos(x(z.From), top+barHeight-zoneHeight))
+		r.zones[i].Resize(fyne.NewSize(x(z.To)-x(z.From), zoneHeight))
+	}
+	for i, t := range g.Thresholds {
+		if i >= len(r.thresholds) {
+			break
+		}
+		r.thresholds[i].Move(fyne.NewPos(x(t)-1, top-theme.Padding()/2))
+		r.thresholds[i].Resize(fyne.NewSize(2, barHeight+theme.Padding()))
+	}
+
+	shown := x(r.shown)
+	fillHeight := barHeight
+	if len(g.Zones) > 0 {
+		fillHeight -= zoneHeight // leave the zones visible below the fill
+	}
+	r.fill.Move(fyne.NewPos(0, top))
+	r.fill.Resize(fyne.NewSize(shown, fillHeight))
+	markerWidth := theme.Padding()
+	r.marker.Move(fyne.NewPos(shown-markerWidth/2, top-theme.Padding()/2))
+	r.marker.Resize(fyne.NewSize(markerWidth, barHeight+theme.Padding()))
+}
+
+func (r *linearGaugeRenderer) MinSize() fyne.Size {
+	textHeight := fyne.Max(r.label.MinSize().Height, r.value.MinSize().Height)
+	width := r.label.MinSize().Width + r.value.MinSize().Width + theme.Padding()
+	return fyne.NewSize(fyne.Max(width, theme.IconInlineSize()*6), textHeight+theme.Padding()*4)
+}
+
+func (r *linearGaugeRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.track}
+	for _, z := range r.zones {
+		objects = append(objects, z)
+	}
+	objects = append(objects, r.fill)
+	for _, t := range r.thresholds {
+		objects = append(objects, t)
+	}
+	return append(objects, r.marker, r.label, r.value)
+}
+
+func (r *linearGaugeRenderer) Refresh() {
+	g := r.gauge
+	if g.Value != r.target {
+		r.target = g.Value
+		r.animate()
+	}
+
+	for len(r.zones) < len(g.Zones) {
+		r.zones = append(r.zones, canvas.NewRectangle(color.Transparent))
+	}
+	r.zones = r.zones[:len(g.Zones)]
+	valueColor := theme.Color(theme.ColorNamePrimary)
+	for i, z := range g.Zones {
+		r.zones[i].FillColor = z.Color
+		if g.Style == GaugeFilled && r.shown >= z.From && r.shown <= z.To {
+			valueColor = z.Color
+		}
+	}
+	for len(r.thresholds) < len(g.Thresholds) {
+		r.thresholds = append(r.thresholds, canvas.NewRectangle(color.Transparent))
+	}
+	r.thresholds = r.thresholds[:len(g.Thresholds)]
+	fg := theme.Color(theme.ColorNameForeground)
+	for _, t := range r.thresholds {
+		t.FillColor = fg
+	}
+
+	radius := theme.Padding()
+	r.track.FillColor = theme.Color(theme.ColorNameInputBackground)
+	r.track.CornerRadius = radius
+	r.fill.FillColor = valueColor
+	r.fill.CornerRadius = radius
+	r.fill.Hidden = g.Style != GaugeFilled
+	r.marker.FillColor = fg
+	r.marker.CornerRadius = radius / 2
+	r.marker.Hidden = g.Style != GaugeNeedle
+
+	r.label.Text = g.Label
+	r.label.TextSize = theme.CaptionTextSize()
+	r.label.Color = theme.Color(theme.ColorNamePlaceHolder)
+	r.value.Text = gaugeFormat(g.Format, g.Value)
+	r.value.TextSize = theme.CaptionTextSize()
+	r.value.Color = fg
+	r.Layout(g.Size())
+	canvas.Refresh(g)
+}
+
+func (r *linearGaugeRenderer) animate() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+	start, end := r.shown, r.target
+	r.anim = fyne.NewAnimation(canvas.DurationStandard, func(done float32) {
+		r.shown = start + (end-start)*float64(done)
+		r.Layout(r.gauge.Size())
+		canvas.Refresh(r.fill)
+		canvas.Refresh(r.marker)
+	})
+	r.anim.Curve = fyne.AnimationEaseOut
+	r.anim.Start()
+}
+
+// addArcBand adds the band between two radii from one angle to another, in radians, to the rasterizer.
+func addArcBand(r *vector.Rasterizer, c fyne.Position, from, to float64, r0, r1 float32) {
+	steps := int(math.Ceil(math.Abs(to-from) * 180 / math.Pi / gaugeArcStep))
+	if steps < 1 {
+		steps = 1
+	}
+	point := func(a float64, radius float32) (float32, float32) {
+		return c.X + radius*float32(math.Cos(a)), c.Y - radius*float32(math.Sin(a))
+	}
+	r.MoveTo(point(from, r1))
+	for i := 1; i <= steps; i++ {
+		r.LineTo(point(from+(to-from)*float64(i)/float64(steps), r1))
+	}
+	for i := steps; i >= 0; i-- {
+		r.LineTo(point(from+(to-from)*float64(i)/float64(steps), r0))
+	}
+	r.ClosePath()
+}
+
+// gaugeFraction returns how far a value is from min to max, from 0 to 1.
+func gaugeFraction(v, min, max float64) float64 {
+	if max <= min {
+		return 0
+	}
+	return math.Max(0, math.Min(1, (v-min)/(max-min)))
+}
+
+func gaugeFormat(format func(float64) string, v float64) string {
+	if format != nil {
+		return format(v)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
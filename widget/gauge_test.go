@@ -0,0 +1,89 @@
+package widget
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGauge(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	g := NewGauge(0, 100)
+	g.Label = "CPU %"
+	g.Zones = []GaugeZone{{From: 80, To: 100, Color: color.NRGBA{R: 0xff, A: 0xff}}}
+	g.Thresholds = []float64{90}
+	g.Resize(fyne.NewSquareSize(200))
+	r := test.WidgetRenderer(g).(*gaugeRenderer)
+	assert.Equal(t, "0", r.value.Text)
+	assert.Equal(t, "100", r.max.Text)
+
+	g.SetValue(42.5)
+	assert.Equal(t, "42.5", r.value.Text)
+	assert.Equal(t, 42.5, r.shown) // the test driver completes animations at once
+
+	img := r.draw(200, 200)
+	_, _, _, a := img.At(100, 100).RGBA()
+	assert.NotZero(t, a, "the hub of the needle")
+	// the zone is on the right of the arc, at 80 to 100 percent of 270 degrees from the lower left
+	red, _, _, _ := img.At(100+90, 110).RGBA()
+	assert.Equal(t, uint32(0xffff), red)
+}
+
+func TestGauge_Binding(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	data := binding.NewFloat()
+	g := NewGaugeWithData(-10, 10, data)
+	g.Format = func(v float64) string { return "x" }
+	r := test.WidgetRenderer(g).(*gaugeRenderer)
+	_ = data.Set(5)
+	waitForBinding()
+	assert.Equal(t, 5.0, g.Value)
+	assert.Equal(t, "x", r.value.Text)
+
+	g.Unbind()
+	_ = data.Set(6)
+	waitForBinding()
+	assert.Equal(t, 5.0, g.Value)
+}
+
+func TestGauge_Angle(t *testing.T) {
+	g := NewGauge(0, 100)
+	assert.InDelta(t, 225, g.angle(0)*180/math.Pi, 1e-9)
+	assert.InDelta(t, 90, g.angle(50)*180/math.Pi, 1e-9)
+	assert.InDelta(t, -45, g.angle(150)*180/math.Pi, 1e-9)
+}
+
+func TestLinearGauge(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	g := NewLinearGauge(0, 200)
+	g.Zones = []GaugeZone{{From: 150, To: 200, Color: color.NRGBA{R: 0xff, A: 0xff}}}
+	g.Thresholds = []float64{100}
+	g.Resize(fyne.NewSize(200, 50))
+	r := test.WidgetRenderer(g).(*linearGaugeRenderer)
+	assert.True(t, r.marker.Hidden)
+
+	g.SetValue(50)
+	assert.Equal(t, float32(50), r.fill.Size().Width)
+	assert.Equal(t, float32(150), r.zones[0].Position().X)
+	assert.Equal(t, float32(99), r.thresholds[0].Position().X)
+
+	g.SetValue(180)
+	assert.Equal(t, color.NRGBA{R: 0xff, A: 0xff}, r.fill.FillColor)
+
+	g.Style = GaugeNeedle
+	g.Refresh()
+	assert.True(t, r.fill.Hidden)
+	assert.False(t, r.marker.Hidden)
+	assert.InDelta(t, 180, r.marker.Position().X+r.marker.Size().Width/2, 1e-3)
+}
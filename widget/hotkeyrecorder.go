@@ -0,0 +1,335 @@
+package widget
+
+import (
+	"errors"
+	"image/color"
+	"runtime"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*HotkeyRecorder)(nil)
+var _ fyne.Focusable = (*HotkeyRecorder)(nil)
+var _ fyne.Tappable = (*HotkeyRecorder)(nil)
+var _ fyne.Shortcutable = (*HotkeyRecorder)(nil)
+var _ desktop.Keyable = (*HotkeyRecorder)(nil)
+
+var (
+	// ErrReservedShortcut is reported when a recorded combination is in the Reserved list.
+	ErrReservedShortcut = errors.New("shortcut is reserved")
+	// ErrShortcutNeedsModifier is reported when a key other than a function key is pressed without modifiers.
+	ErrShortcutNeedsModifier = errors.New("shortcut needs a modifier key")
+)
+
+// DefaultReservedShortcuts are the clipboard, undo and select all shortcuts, which apps rarely want rebound.
+var DefaultReservedShortcuts = []fyne.KeyboardShortcut{
+	&desktop.CustomShortcut{KeyName: fyne.KeyC, Modifier: fyne.KeyModifierShortcutDefault},
+	&desktop.CustomShortcut{KeyName: fyne.KeyV, Modifier: fyne.KeyModifierShortcutDefault},
+	&desktop.CustomShortcut{KeyName: fyne.KeyX, Modifier: fyne.KeyModifierShortcutDefault},
+	&desktop.CustomShortcut{KeyName: fyne.KeyA, Modifier: fyne.KeyModifierShortcutDefault},
+	&desktop.CustomShortcut{KeyName: fyne.KeyZ, Modifier: fyne.KeyModifierShortcutDefault},
+}
+
+// HotkeyRecorder captures the next key combination pressed while it is focused, such as "Ctrl+Shift+K",
+// for apps that let users customize their shortcuts.
+// Escape cancels recording and Backspace or Delete, without modifiers, clears the shortcut.
+type HotkeyRecorder struct {
+	widget.DisableableWidget
+
+	// Shortcut is the recorded combination, or nil if there is none.
+	Shortcut *desktop.CustomShortcut
+	// PlaceHolder is shown when there is no shortcut.
+	PlaceHolder string
+	// Reserved combinations are rejected with ErrReservedShortcut.
+	Reserved []fyne.KeyboardShortcut
+	// Validator can reject a combination with an error that is shown in place of the shortcut.
+	Validator func(*desktop.CustomShortcut) error `json:"-"`
+
+	OnChanged func(*desktop.CustomShortcut) `json:"-"`
+
+	focused   bool
+	modifiers fyne.KeyModifier
+	err       error
+}
+
+// NewHotkeyRecorder creates a new recorder that calls changed when a valid combination is recorded.
+func NewHotkeyRecorder(changed func(*desktop.CustomShortcut)) *HotkeyRecorder {
+	h := &HotkeyRecorder{PlaceHolder: i18n.L("Click to record shortcut"), Reserved: DefaultReservedShortcuts, OnChanged: changed}
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (h *HotkeyRecorder) CreateRenderer() fyne.WidgetRenderer {
+	h.ExtendBaseWidget(h)
+	box := canvas.NewRectangle(color.Transparent)
+	box.CornerRadius = theme.InputRadiusSize()
+	border := canvas.NewRectangle(color.Transparent)
+	border.StrokeWidth = theme.InputBorderSize()
+	border.CornerRadius = theme.InputRadiusSize()
+	text := canvas.NewText("", theme.Color(theme.ColorNameForeground))
+	text.Alignment = fyne.TextAlignCenter
+	r := &hotkeyRecorderRenderer{recorder: h, box: box, border: border, text: text}
+	r.Refresh()
+	return r
+}
+
+// Error returns the reason the last combination was rejected, or nil.
+func (h *HotkeyRecorder) Error() error {
+	return h.err
+}
+
+// SetShortcut changes the shortcut shown, nil clears it.
+func (h *HotkeyRecorder) SetShortcut(s *desktop.CustomShortcut) {
+	h.Shortcut = s
+	h.err = nil
+	h.Refresh()
+}
+
+// FocusGained starts recording.
+//
+// Implements: fyne.Focusable
+func (h *HotkeyRecorder) FocusGained() {
+	h.focused = true
+	h.modifiers = 0
+	h.err = nil
+	h.Refresh()
+}
+
+// FocusLost stops recording.
+//
+// Implements: fyne.Focusable
+func (h *HotkeyRecorder) FocusLost() {
+	h.focused = false
+	h.modifiers = 0
+	h.Refresh()
+}
+
+// KeyDown records modifiers as they are held and completes the combination on any other key.
+//
+// Implements: desktop.Keyable
+func (h *HotkeyRecorder) KeyDown(ev *fyne.KeyEvent) {
+	if h.Disabled() {
+		return
+	}
+	if mod := modifierForKey(ev.Name); mod != 0 {
+		h.modifiers |= mod
+		h.Refresh()
+		return
+	}
+
+	if h.modifiers == 0 {
+		switch ev.Name {
+		case fyne.KeyEscape:
+			h.unfocus()
+			return
+		case fyne.KeyBackspace, fyne.KeyDelete:
+			h.record(nil)
+			return
+		}
+	}
+	h.record(&desktop.CustomShortcut{KeyName: ev.Name, Modifier: h.modifiers})
+}
+
+// KeyUp releases a held modifier.
+//
+// Implements: desktop.Keyable
+func (h *HotkeyRecorder) KeyUp(ev *fyne.KeyEvent) {
+	if mod := modifierForKey(ev.Name); mod != 0 {
+		h.modifiers &^= mod
+		h.Refresh()
+	}
+}
+
+// Tapped focuses the recorder to start recording.
+//
+// Implements: fyne.Tappable
+func (h *HotkeyRecorder) Tapped(*fyne.PointEvent) {
+	if h.Disabled() {
+		return
+	}
+	if c := fyne.CurrentApp().Driver().CanvasForObject(h); c != nil {
+		c.Focus(h)
+	}
+}
+
+// TypedKey is ignored, keys are captured as they are pressed by KeyDown.
+//
+// Implements: fyne.Focusable
+func (h *HotkeyRecorder) TypedKey(*fyne.KeyEvent) {
+}
+
+// TypedRune is ignored, keys are captured as they are pressed by KeyDown.
+//
+// Implements: fyne.Focusable
+func (h *HotkeyRecorder) TypedRune(rune) {
+}
+
+// TypedShortcut swallows shortcuts while recording so they are not triggered.
+//
+// Implements: fyne.Shortcutable
+func (h *HotkeyRecorder) TypedShortcut(fyne.Shortcut) {
+}
+
+func (h *HotkeyRecorder) record(s *desktop.CustomShortcut) {
+	if err := h.validate(s); err != nil {
+		h.err = err
+		h.Refresh()
+		return
+	}
+
+	h.Shortcut = s
+	h.err = nil
+	h.unfocus()
+	if f := h.OnChanged; f != nil {
+		f(s)
+	}
+}
+
+func (h *HotkeyRecorder) unfocus() {
+	if c := fyne.CurrentApp().Driver().CanvasForObject(h); c != nil && c.Focused() == h {
+		c.Unfocus()
+		return
+	}
+	h.modifiers = 0
+	h.Refresh()
+}
+
+func (h *HotkeyRecorder) validate(s *desktop.CustomShortcut) error {
+	if s == nil {
+		return nil
+	}
+	if s.Modifier == 0 && !isFunctionKey(s.KeyName) {
+		return ErrShortcutNeedsModifier
+	}
+	for _, r := range h.Reserved {
+		if r.Key() == s.KeyName && r.Mod() == s.Modifier {
+			return ErrReservedShortcut
+		}
+	}
+	if h.Validator != nil {
+		return h.Validator(s)
+	}
+	return nil
+}
+
+func (h *HotkeyRecorder) label() string {
+	switch {
+	case h.err != nil:
+		return h.err.Error()
+	case h.focused:
+		if h.modifiers != 0 {
+			return modifierLabel(h.modifiers) + "+…"
+		}
+		return i18n.L("Press a shortcut…")
+	case h.Shortcut != nil:
+		return ShortcutLabel(h.Shortcut)
+	}
+	return h.PlaceHolder
+}
+
+// ShortcutLabel returns a readable name for a key combination, such as "Ctrl+Shift+K".
+func ShortcutLabel(s fyne.KeyboardShortcut) string {
+	if s.Mod() == 0 {
+		return string(s.Key())
+	}
+	return modifierLabel(s.Mod()) + "+" + string(s.Key())
+}
+
+func modifierLabel(mod fyne.KeyModifier) string {
+	ctrl, alt, super := i18n.L("Ctrl"), "Alt", "Super"
+	if runtime.GOOS == "darwin" {
+		alt, super = "Option", "Cmd"
+	}
+
+	var parts []string
+	if mod&fyne.KeyModifierControl != 0 {
+		parts = append(parts, ctrl)
+	}
+	if mod&fyne.KeyModifierAlt != 0 {
+		parts = append(parts, alt)
+	}
+	if mod&fyne.KeyModifierShift != 0 {
+		parts = append(parts, i18n.L("Shift"))
+	}
+	if mod&fyne.KeyModifierSuper != 0 {
+		parts = append(parts, super)
+	}
+	return strings.Join(parts, "+")
+}
+
+func modifierForKey(name fyne.KeyName) fyne.KeyModifier {
+	switch name {
+	case desktop.KeyShiftLeft, desktop.KeyShiftRight:
+		return fyne.KeyModifierShift
+	case desktop.KeyControlLeft, desktop.KeyControlRight:
+		return fyne.KeyModifierControl
+	case desktop.KeyAltLeft, desktop.KeyAltRight:
+		return fyne.KeyModifierAlt
+	case desktop.KeySuperLeft, desktop.KeySuperRight:
+		return fyne.KeyModifierSuper
+	}
+	return 0
+}
+
+func isFunctionKey(name fyne.KeyName) bool {
+	return len(name) > 1 && name[0] == 'F' && name[1] >= '1' && name[1] <= '9'
+}
+
+type hotkeyRecorderRenderer struct {
+	recorder    *HotkeyRecorder
+	box, border *canvas.Rectangle
+	text        *canvas.Text
+}
+
+func (r *hotkeyRecorderRenderer) Destroy() {
+}
+
+func (r *hotkeyRecorderRenderer) Layout(size fyne.Size) {
+	r.box.Resize(size)
+	r.border.Resize(size)
+	r.text.Resize(size)
+}
+
+func (r *hotkeyRecorderRenderer) MinSize() fyne.Size {
+	text := fyne.MeasureText("Ctrl+Shift+Super+W", theme.TextSize(), fyne.TextStyle{})
+	label := fyne.MeasureText(r.text.Text, theme.TextSize(), fyne.TextStyle{})
+	pad := theme.InnerPadding()
+	return fyne.NewSize(fyne.Max(text.Width, label.Width)+pad*2, text.Height+pad*2)
+}
+
+func (r *hotkeyRecorderRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.box, r.border, r.text}
+}
+
+func (r *hotkeyRecorderRenderer) Refresh() {
+	h := r.recorder
+	r.box.FillColor = theme.Color(theme.ColorNameInputBackground)
+	r.border.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	r.text.Color = theme.Color(theme.ColorNameForeground)
+	switch {
+	case h.err != nil:
+		r.border.StrokeColor = theme.Color(theme.ColorNameError)
+		r.text.Color = theme.Color(theme.ColorNameError)
+	case h.focused:
+		r.border.StrokeColor = theme.Color(theme.ColorNamePrimary)
+	case h.Disabled():
+		r.text.Color = theme.Color(theme.ColorNameDisabled)
+	case h.Shortcut == nil:
+		r.text.Color = theme.Color(theme.ColorNamePlaceHolder)
+	}
+	r.text.Text = h.label()
+	r.text.TextSize = theme.TextSize()
+	r.box.Refresh()
+	r.border.Refresh()
+	r.text.Refresh()
+}
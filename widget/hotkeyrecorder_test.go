@@ -0,0 +1,95 @@
+package widget
+
+import (
+	"errors"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHotkeyRecorder_Record(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	var recorded *desktop.CustomShortcut
+	h := NewHotkeyRecorder(func(s *desktop.CustomShortcut) {
+		recorded = s
+	})
+	w := test.NewWindow(h)
+	defer w.Close()
+
+	test.Tap(h)
+	assert.Equal(t, h, w.Canvas().Focused())
+
+	h.KeyDown(&fyne.KeyEvent{Name: desktop.KeyControlLeft})
+	h.KeyDown(&fyne.KeyEvent{Name: desktop.KeyShiftLeft})
+	assert.Equal(t, "Ctrl+Shift+…", h.label())
+	h.KeyDown(&fyne.KeyEvent{Name: fyne.KeyK})
+
+	assert.NotNil(t, recorded)
+	assert.Equal(t, fyne.KeyK, recorded.KeyName)
+	assert.Equal(t, fyne.KeyModifierControl|fyne.KeyModifierShift, recorded.Modifier)
+	assert.Equal(t, "Ctrl+Shift+K", ShortcutLabel(recorded))
+	assert.Nil(t, w.Canvas().Focused())
+	assert.Equal(t, "Ctrl+Shift+K", h.label())
+}
+
+func TestHotkeyRecorder_Validation(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	changed := 0
+	h := NewHotkeyRecorder(func(*desktop.CustomShortcut) {
+		changed++
+	})
+	errTaken := errors.New("already used")
+	h.Validator = func(s *desktop.CustomShortcut) error {
+		if s.KeyName == fyne.KeyS {
+			return errTaken
+		}
+		return nil
+	}
+	w := test.NewWindow(h)
+	defer w.Close()
+	w.Canvas().Focus(h)
+
+	h.KeyDown(&fyne.KeyEvent{Name: fyne.KeyK})
+	assert.Equal(t, ErrShortcutNeedsModifier, h.Error())
+
+	h.KeyDown(&fyne.KeyEvent{Name: desktop.KeyControlLeft})
+	h.KeyDown(&fyne.KeyEvent{Name: fyne.KeyC})
+	assert.Equal(t, ErrReservedShortcut, h.Error())
+	h.KeyDown(&fyne.KeyEvent{Name: fyne.KeyS})
+	assert.Equal(t, errTaken, h.Error())
+	assert.Equal(t, "already used", h.label())
+	assert.Zero(t, changed)
+
+	h.KeyUp(&fyne.KeyEvent{Name: desktop.KeyControlLeft})
+	h.KeyDown(&fyne.KeyEvent{Name: fyne.KeyF5})
+	assert.NoError(t, h.Error())
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, "F5", h.label())
+}
+
+func TestHotkeyRecorder_ClearAndCancel(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	h := NewHotkeyRecorder(nil)
+	h.SetShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyP, Modifier: fyne.KeyModifierAlt})
+	w := test.NewWindow(h)
+	defer w.Close()
+
+	w.Canvas().Focus(h)
+	h.KeyDown(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.Nil(t, w.Canvas().Focused())
+	assert.NotNil(t, h.Shortcut)
+
+	w.Canvas().Focus(h)
+	h.KeyDown(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	assert.Nil(t, h.Shortcut)
+	assert.Equal(t, h.PlaceHolder, h.label())
+}
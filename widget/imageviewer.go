@@ -0,0 +1,518 @@
+package widget
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	_ "image/gif"  // support gif in NewImageViewerFromURI
+	_ "image/jpeg" // support jpeg in NewImageViewerFromURI
+	_ "image/png"  // support png in NewImageViewerFromURI
+	"io"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/math/f64"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*ImageViewer)(nil)
+var _ fyne.Draggable = (*ImageViewer)(nil)
+var _ fyne.Scrollable = (*ImageViewer)(nil)
+var _ fyne.DoubleTappable = (*ImageViewer)(nil)
+
+// ImageViewerMode describes how an ImageViewer chooses the zoom level of its image.
+type ImageViewerMode int
+
+const (
+	// ImageViewerFit scales the image so that it is completely visible.
+	ImageViewerFit ImageViewerMode = iota
+	// ImageViewerFill scales the image so that it covers the whole viewer.
+	ImageViewerFill
+	// ImageViewerOriginal shows the image at 100%, one image pixel per screen pixel.
+	ImageViewerOriginal
+	// ImageViewerFree keeps the zoom level that was set by the user or by SetZoom.
+	ImageViewerFree
+)
+
+const (
+	imageViewerMinZoom    = 0.01
+	imageViewerMaxZoom    = 32
+	imageViewerZoomFactor = 1.1
+
+	// imageViewerExifLimit is how much of the start of a file is searched for EXIF data.
+	imageViewerExifLimit = 128 * 1024
+)
+
+// imageViewerMaxPixels is how many pixels of an image loaded from a URI are kept, larger images are downsampled.
+var imageViewerMaxPixels = 4096 * 4096
+
+// ImageViewer displays an image that the user can zoom, pan, rotate and flip.
+// The mouse wheel zooms towards the cursor, dragging pans and a double tap
+// toggles between fitting the image and showing it at 100%.
+//
+// Only the visible part of the image is rendered at screen resolution, and zoomed
+// out views are drawn from cached half-size copies. Images loaded from a URI that
+// have more than 16 megapixels are downsampled to that size once decoded, so a
+// 100 megapixel photo is kept in 64MB rather than 400MB, although it has to be
+// decoded whole first, as the image decoders cannot decode part of an image.
+// Zoom levels still refer to the pixels of the original image.
+type ImageViewer struct {
+	widget.BaseWidget
+
+	// OnZoomChanged is called when the zoom level changes, 1.0 is 100%.
+	OnZoomChanged func(float32) `json:"-"`
+
+	img    image.Image
+	full   image.Point   // size of the original image, which img may be a downsampled copy of
+	levels []image.Image // mip levels, levels[0] is img
+
+	mode   ImageViewerMode
+	zoom   float64
+	center [2]float64 // center of the view, in oriented image coordinates
+	orient [4]int     // 2x2 matrix applied to the image, composed of quarter turns and flips
+
+	pixelScale float64 // raster pixels per Fyne unit, updated on draw
+	raster     *canvas.Raster
+	pixels     *image.NRGBA
+}
+
+// NewImageViewer creates a new viewer for the given image, initially fitted to the available space.
+func NewImageViewer(img image.Image) *ImageViewer {
+	v := &ImageViewer{zoom: 1, pixelScale: 1}
+	v.ExtendBaseWidget(v)
+	v.SetImage(img)
+	return v
+}
+
+// NewImageViewerFromURI loads an image from a URI and creates a viewer for it.
+// JPEG images are displayed according to the orientation stored in their EXIF data.
+func NewImageViewerFromURI(u fyne.URI) (*ImageViewer, error) {
+	read, err := storage.Reader(u)
+	if err != nil {
+		return nil, err
+	}
+	defer read.Close()
+
+	// only the start of the file is kept for its EXIF data, the rest is decoded as it is read
+	head := make([]byte, imageViewerExifLimit)
+	n, err := io.ReadFull(read, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	head = head[:n]
+	img, _, err := image.Decode(io.MultiReader(bytes.NewReader(head), read))
+	if err != nil {
+		return nil, err
+	}
+
+	v := &ImageViewer{zoom: 1, pixelScale: 1}
+	v.ExtendBaseWidget(v)
+	v.setImage(downsampleImage(img, imageViewerMaxPixels), img.Bounds().Size())
+	v.orient = exifOrientationMatrix(exifOrientation(head))
+	v.center = v.orientedCenter()
+	return v, nil
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (v *ImageViewer) CreateRenderer() fyne.WidgetRenderer {
+	v.raster = canvas.NewRaster(v.draw)
+	return widget.NewSimpleRenderer(v.raster)
+}
+
+// DoubleTapped toggles between fitting the image and showing it at 100%.
+//
+// Implements: fyne.DoubleTappable
+func (v *ImageViewer) DoubleTapped(_ *fyne.PointEvent) {
+	if v.mode == ImageViewerFit {
+		v.SetMode(ImageViewerOriginal)
+	} else {
+		v.SetMode(ImageViewerFit)
+	}
+}
+
+// Dragged pans the image.
+//
+// Implements: fyne.Draggable
+func (v *ImageViewer) Dragged(e *fyne.DragEvent) {
+	if v.img == nil {
+		return
+	}
+	if v.mode != ImageViewerFree {
+		v.zoom = v.effectiveZoom(v.pixelSize())
+		v.mode = ImageViewerFree
+	}
+	v.center[0] -= float64(e.Dragged.DX) * v.pixelScale / v.zoom
+	v.center[1] -= float64(e.Dragged.DY) * v.pixelScale / v.zoom
+	v.clampCenter()
+	v.Refresh()
+}
+
+// DragEnd is called when a pan gesture completes.
+//
+// Implements: fyne.Draggable
+func (v *ImageViewer) DragEnd() {
+}
+
+// FlipHorizontal mirrors the image from left to right.
+func (v *ImageViewer) FlipHorizontal() {
+	v.applyOrientation([4]int{-1, 0, 0, 1})
+}
+
+// FlipVertical mirrors the image from top to bottom.
+func (v *ImageViewer) FlipVertical() {
+	v.applyOrientation([4]int{1, 0, 0, -1})
+}
+
+// Image returns the image currently being displayed.
+// For an image loaded from a URI this is a downsampled copy if the image has more than 16 megapixels.
+func (v *ImageViewer) Image() image.Image {
+	return v.img
+}
+
+// MinSize returns the smallest size this widget can shrink to.
+func (v *ImageViewer) MinSize() fyne.Size {
+	v.ExtendBaseWidget(v)
+	return fyne.NewSize(32, 32)
+}
+
+// Mode returns the current zoom mode of the viewer.
+func (v *ImageViewer) Mode() ImageViewerMode {
+	return v.mode
+}
+
+// Reset removes any rotation or flip and fits the image to the view.
+func (v *ImageViewer) Reset() {
+	v.orient = [4]int{1, 0, 0, 1}
+	v.center = v.orientedCenter()
+	v.SetMode(ImageViewerFit)
+}
+
+// RotateClockwise turns the image by 90 degrees clockwise.
+func (v *ImageViewer) RotateClockwise() {
+	v.applyOrientation([4]int{0, -1, 1, 0})
+}
+
+// RotateCounterClockwise turns the image by 90 degrees counter-clockwise.
+func (v *ImageViewer) RotateCounterClockwise() {
+	v.applyOrientation([4]int{0, 1, -1, 0})
+}
+
+// Scrolled zooms the image in or out, keeping the point under the cursor in place.
+//
+// Implements: fyne.Scrollable
+func (v *ImageViewer) Scrolled(e *fyne.ScrollEvent) {
+	if e.Scrolled.DY == 0 {
+		return
+	}
+
+	zoom := v.effectiveZoom(v.pixelSize())
+	if e.Scrolled.DY > 0 {
+		zoom *= imageViewerZoomFactor
+	} else {
+		zoom /= imageViewerZoomFactor
+	}
+	v.ZoomAt(float32(zoom), e.Position)
+}
+
+// SetImage changes the displayed image and fits it into the view.
+func (v *ImageViewer) SetImage(img image.Image) {
+	var full image.Point
+	if img != nil {
+		full = img.Bounds().Size()
+	}
+	v.setImage(img, full)
+}
+
+// setImage shows img as an image of the full size, which it is downsampled from if it is smaller.
+func (v *ImageViewer) setImage(img image.Image, full image.Point) {
+	v.img = img
+	v.full = full
+	v.levels = nil
+	if img != nil {
+		v.levels = []image.Image{img}
+	}
+	v.orient = [4]int{1, 0, 0, 1}
+	v.center = v.orientedCenter()
+	v.SetMode(ImageViewerFit)
+}
+
+// SetMode sets how the zoom level is chosen and re-centers the image.
+func (v *ImageViewer) SetMode(mode ImageViewerMode) {
+	v.mode = mode
+	if mode != ImageViewerFree {
+		v.center = v.orientedCenter()
+	}
+	v.Refresh()
+	v.zoomChanged()
+}
+
+// SetZoom sets the zoom level, where 1.0 shows the image at 100%, keeping the view centered.
+func (v *ImageViewer) SetZoom(zoom float32) {
+	v.zoom = clampZoom(float64(zoom))
+	v.mode = ImageViewerFree
+	v.clampCenter()
+	v.Refresh()
+	v.zoomChanged()
+}
+
+// Zoom returns the current zoom level, where 1.0 is 100%.
+func (v *ImageViewer) Zoom() float32 {
+	return float32(v.effectiveZoom(v.pixelSize()))
+}
+
+// ZoomAt sets the zoom level keeping the image point under the given position in place.
+func (v *ImageViewer) ZoomAt(zoom float32, pos fyne.Position) {
+	if v.img == nil {
+		return
+	}
+	size := v.pixelSize()
+	old := v.effectiveZoom(size)
+	newZoom := clampZoom(float64(zoom))
+
+	// the image point under the cursor must stay under the cursor
+	dx := float64(pos.X)*v.pixelScale - size[0]/2
+	dy := float64(pos.Y)*v.pixelScale - size[1]/2
+	v.center[0] += dx/old - dx/newZoom
+	v.center[1] += dy/old - dy/newZoom
+
+	v.zoom = newZoom
+	v.mode = ImageViewerFree
+	v.clampCenter()
+	v.Refresh()
+	v.zoomChanged()
+}
+
+func (v *ImageViewer) applyOrientation(m [4]int) {
+	oldCenter := v.orientedCenter()
+	o := v.orient
+	v.orient = [4]int{
+		m[0]*o[0] + m[1]*o[2], m[0]*o[1] + m[1]*o[3],
+		m[2]*o[0] + m[3]*o[2], m[2]*o[1] + m[3]*o[3],
+	}
+
+	// rotate the view center around the middle of the image
+	newCenter := v.orientedCenter()
+	x, y := v.center[0]-oldCenter[0], v.center[1]-oldCenter[1]
+	v.center = [2]float64{
+		float64(m[0])*x + float64(m[1])*y + newCenter[0],
+		float64(m[2])*x + float64(m[3])*y + newCenter[1],
+	}
+	v.Refresh()
+}
+
+func (v *ImageViewer) clampCenter() {
+	ow, oh := v.orientedSize()
+	v.center[0] = math.Max(0, math.Min(ow, v.center[0]))
+	v.center[1] = math.Max(0, math.Min(oh, v.center[1]))
+}
+
+func (v *ImageViewer) draw(w, h int) image.Image {
+	if v.pixels == nil || v.pixels.Bounds().Dx() != w || v.pixels.Bounds().Dy() != h {
+		v.pixels = image.NewNRGBA(image.Rect(0, 0, w, h))
+	} else {
+		draw.Draw(v.pixels, v.pixels.Bounds(), image.NewUniform(color.Transparent), image.Point{}, draw.Src)
+	}
+	if v.img == nil || w == 0 || h == 0 {
+		return v.pixels
+	}
+	if size := v.Size(); size.Width > 0 {
+		v.pixelScale = float64(w) / float64(size.Width)
+	}
+
+	zoom := v.effectiveZoom([2]float64{float64(w), float64(h)})
+	level, levelScale := v.levelFor(zoom)
+	src := v.levels[level]
+
+	// source pixel -> original image -> oriented image -> screen
+	ow, oh := v.orientedSize()
+	iw, ih := float64(v.full.X), float64(v.full.Y)
+	o := v.orient
+	a, b, c, d := float64(o[0]), float64(o[1]), float64(o[2]), float64(o[3])
+	tx := ow/2 - (a*iw/2 + b*ih/2)
+	ty := oh/2 - (c*iw/2 + d*ih/2)
+
+	s := zoom * levelScale
+	offX := float64(w)/2 - zoom*v.center[0]
+	offY := float64(h)/2 - zoom*v.center[1]
+	s2d := f64.Aff3{
+		s * a, s * b, zoom*tx + offX,
+		s * c, s * d, zoom*ty + offY,
+	}
+
+	var interp draw.Interpolator = draw.ApproxBiLinear
+	if zoom >= 2 {
+		interp = draw.NearestNeighbor
+	}
+	interp.Transform(v.pixels, s2d, src, src.Bounds(), draw.Over, nil)
+	return v.pixels
+}
+
+// effectiveZoom returns the zoom level for the current mode and view size in pixels.
+func (v *ImageViewer) effectiveZoom(size [2]float64) float64 {
+	if v.img == nil {
+		return 1
+	}
+
+	ow, oh := v.orientedSize()
+	switch v.mode {
+	case ImageViewerFit:
+		return clampZoom(math.Min(size[0]/ow, size[1]/oh))
+	case ImageViewerFill:
+		return clampZoom(math.Max(size[0]/ow, size[1]/oh))
+	case ImageViewerOriginal:
+		return 1
+	}
+	return v.zoom
+}
+
+// levelFor returns the index of the smallest mip level that still has enough detail
+// for the zoom level, along with the scale from that level to the original image.
+func (v *ImageViewer) levelFor(zoom float64) (int, float64) {
+	level := 0
+	scale := float64(v.full.X) / float64(v.levels[0].Bounds().Dx())
+	for zoom*scale*2 <= 1 {
+		if level+1 >= len(v.levels) {
+			prev := v.levels[level].Bounds()
+			if prev.Dx() < 64 || prev.Dy() < 64 {
+				break
+			}
+			half := image.NewNRGBA(image.Rect(0, 0, prev.Dx()/2, prev.Dy()/2))
+			draw.ApproxBiLinear.Scale(half, half.Bounds(), v.levels[level], prev, draw.Src, nil)
+			v.levels = append(v.levels, half)
+		}
+		level++
+		scale = float64(v.full.X) / float64(v.levels[level].Bounds().Dx())
+	}
+	return level, scale
+}
+
+func (v *ImageViewer) orientedCenter() [2]float64 {
+	ow, oh := v.orientedSize()
+	return [2]float64{ow / 2, oh / 2}
+}
+
+func (v *ImageViewer) orientedSize() (float64, float64) {
+	if v.img == nil {
+		return 1, 1
+	}
+	w, h := float64(v.full.X), float64(v.full.Y)
+	if v.orient[0] == 0 {
+		return h, w
+	}
+	return w, h
+}
+
+func (v *ImageViewer) pixelSize() [2]float64 {
+	s := v.Size()
+	return [2]float64{float64(s.Width) * v.pixelScale, float64(s.Height) * v.pixelScale}
+}
+
+func (v *ImageViewer) zoomChanged() {
+	if f := v.OnZoomChanged; f != nil {
+		f(v.Zoom())
+	}
+}
+
+// downsampleImage returns img scaled down to at most pixels in size, keeping its aspect ratio,
+// or img if it is not larger.
+func downsampleImage(img image.Image, pixels int) image.Image {
+	b := img.Bounds()
+	if b.Dx()*b.Dy() <= pixels {
+		return img
+	}
+	scale := math.Sqrt(float64(pixels) / float64(b.Dx()*b.Dy()))
+	w := math.Max(1, math.Floor(float64(b.Dx())*scale))
+	h := math.Max(1, math.Floor(float64(b.Dy())*scale))
+	small := image.NewRGBA(image.Rect(0, 0, int(w), int(h)))
+	draw.ApproxBiLinear.Scale(small, small.Bounds(), img, b, draw.Src, nil)
+	return small
+}
+
+func clampZoom(zoom float64) float64 {
+	return math.Max(imageViewerMinZoom, math.Min(imageViewerMaxZoom, zoom))
+}
+
+// exifOrientationMatrix converts an EXIF orientation value to the matrix that
+// displays the image the right way up.
+func exifOrientationMatrix(orientation int) [4]int {
+	switch orientation {
+	case 2: // mirrored
+		return [4]int{-1, 0, 0, 1}
+	case 3: // rotated 180
+		return [4]int{-1, 0, 0, -1}
+	case 4: // mirrored vertically
+		return [4]int{1, 0, 0, -1}
+	case 5: // transposed
+		return [4]int{0, 1, 1, 0}
+	case 6: // needs rotating clockwise
+		return [4]int{0, -1, 1, 0}
+	case 7: // transversed
+		return [4]int{0, -1, -1, 0}
+	case 8: // needs rotating counter-clockwise
+		return [4]int{0, 1, -1, 0}
+	}
+	return [4]int{1, 0, 0, 1}
+}
+
+// exifOrientation returns the orientation tag stored in the EXIF data of a JPEG file,
+// or 1 (normal) if there is none.
+func exifOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xff || data[1] != 0xd8 {
+		return 1
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xff {
+			return 1
+		}
+		marker := data[pos+1]
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if marker == 0xda || pos+2+length > len(data) { // start of scan, no more metadata
+			return 1
+		}
+		if marker == 0xe1 && length > 8 && string(data[pos+4:pos+10]) == "Exif\x00\x00" {
+			return tiffOrientation(data[pos+10 : pos+2+length])
+		}
+		pos += 2 + length
+	}
+	return 1
+}
+
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+	return 1
+}
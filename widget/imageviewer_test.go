@@ -0,0 +1,116 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageViewer_Modes(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+	v := NewImageViewer(img)
+	v.Resize(fyne.NewSize(100, 100))
+
+	assert.Equal(t, ImageViewerFit, v.Mode())
+	assert.Equal(t, float32(0.25), v.Zoom())
+	v.SetMode(ImageViewerFill)
+	assert.Equal(t, float32(0.5), v.Zoom())
+	v.SetMode(ImageViewerOriginal)
+	assert.Equal(t, float32(1), v.Zoom())
+
+	v.SetZoom(100)
+	assert.Equal(t, float32(imageViewerMaxZoom), v.Zoom())
+	assert.Equal(t, ImageViewerFree, v.Mode())
+}
+
+func TestImageViewer_Rotate(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+	v := NewImageViewer(img)
+	v.Resize(fyne.NewSize(100, 100))
+
+	v.RotateClockwise()
+	w, h := v.orientedSize()
+	assert.Equal(t, 200.0, w)
+	assert.Equal(t, 400.0, h)
+	assert.Equal(t, [2]float64{100, 200}, v.center)
+
+	v.RotateCounterClockwise()
+	assert.Equal(t, [4]int{1, 0, 0, 1}, v.orient)
+	v.FlipHorizontal()
+	v.FlipHorizontal()
+	assert.Equal(t, [4]int{1, 0, 0, 1}, v.orient)
+}
+
+func TestImageViewer_ZoomAt(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 400, 400))
+	v := NewImageViewer(img)
+	v.Resize(fyne.NewSize(100, 100))
+
+	v.SetZoom(1)
+	v.ZoomAt(2, fyne.NewPos(100, 100))
+	assert.Equal(t, [2]float64{225, 225}, v.center)
+}
+
+func TestImageViewer_Draw(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 1000, 1000))
+	img.Set(500, 500, color.White)
+	v := NewImageViewer(img)
+	w := test.NewWindow(v)
+	defer w.Close()
+	w.Resize(fyne.NewSize(100, 100))
+
+	out := v.draw(100, 100)
+	assert.Equal(t, 100, out.Bounds().Dx())
+	assert.Greater(t, len(v.levels), 1) // zoomed out, so mip levels were created
+}
+
+func TestExifOrientation(t *testing.T) {
+	tiff := []byte{'M', 'M', 0, 42, 0, 0, 0, 8, 0, 1, 0x01, 0x12, 0, 3, 0, 0, 0, 1, 0, 6, 0, 0}
+	app1 := append([]byte("Exif\x00\x00"), tiff...)
+	jpeg := []byte{0xff, 0xd8, 0xff, 0xe1, 0, byte(len(app1) + 2)}
+	jpeg = append(jpeg, app1...)
+	jpeg = append(jpeg, 0xff, 0xda, 0, 2)
+
+	assert.Equal(t, 6, exifOrientation(jpeg))
+	assert.Equal(t, 1, exifOrientation([]byte{0x89, 'P', 'N', 'G'}))
+	assert.Equal(t, [4]int{0, -1, 1, 0}, exifOrientationMatrix(6))
+}
+
+func TestImageViewer_Downsampled(t *testing.T) {
+	pixels := imageViewerMaxPixels
+	imageViewerMaxPixels = 100 * 50
+	defer func() { imageViewerMaxPixels = pixels }()
+
+	src := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+	for i := range src.Pix {
+		src.Pix[i] = 0xff
+	}
+	path := filepath.Join(t.TempDir(), "large.png")
+	f, err := os.Create(path)
+	assert.NoError(t, err)
+	assert.NoError(t, png.Encode(f, src))
+	assert.NoError(t, f.Close())
+
+	v, err := NewImageViewerFromURI(storage.NewFileURI(path))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), v.Image().Bounds())
+	v.Resize(fyne.NewSize(100, 100))
+	assert.Equal(t, float32(0.25), v.Zoom()) // of the original image
+	v.SetMode(ImageViewerOriginal)
+	level, scale := v.levelFor(1)
+	assert.Equal(t, 0, level)
+	assert.Equal(t, 4.0, scale)
+
+	out := v.draw(100, 100)
+	_, _, _, a := out.At(50, 50).RGBA()
+	assert.Equal(t, uint32(0xffff), a)
+}
@@ -0,0 +1,379 @@
+package widget
+
+import (
+	"image/color"
+	"math"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/driver/mobile"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Joystick)(nil)
+var _ fyne.Draggable = (*Joystick)(nil)
+var _ fyne.Widget = (*DPad)(nil)
+var _ desktop.Mouseable = (*DPad)(nil)
+var _ mobile.Touchable = (*DPad)(nil)
+
+const joystickMinSize = 120
+
+// Joystick is a touch stick that reports the direction and distance it is pushed from its centre
+// as a vector with components between -1 and 1, y increasing downwards.
+// OnMoved is called as the stick is dragged, or every PollInterval while it is held if that is set,
+// and with a zero vector when it springs back on release.
+type Joystick struct {
+	widget.BaseWidget
+
+	// PollInterval reports the vector at a fixed rate while the stick is held, if not zero.
+	PollInterval time.Duration
+	// DeadZone is the distance from the centre, from 0 to 1, that is reported as a zero vector.
+	DeadZone float32
+
+	OnMoved    func(x, y float32) `json:"-"`
+	OnReleased func()             `json:"-"`
+
+	lock   sync.RWMutex
+	x, y   float32
+	active bool
+	stop   chan struct{}
+}
+
+// NewJoystick creates a new joystick that calls moved with the vector as it is pushed.
+func NewJoystick(moved func(x, y float32)) *Joystick {
+	j := &Joystick{OnMoved: moved}
+	j.ExtendBaseWidget(j)
+	return j
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (j *Joystick) CreateRenderer() fyne.WidgetRenderer {
+	j.ExtendBaseWidget(j)
+	base := canvas.NewCircle(theme.Color(theme.ColorNameInputBackground))
+	base.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	base.StrokeWidth = theme.InputBorderSize()
+	knob := canvas.NewCircle(theme.Color(theme.ColorNamePrimary))
+	r := &joystickRenderer{joystick: j, base: base, knob: knob}
+	r.Refresh()
+	return r
+}
+
+// Dragged moves the stick towards the pointer, limited to the edge of the base.
+//
+// Implements: fyne.Draggable
+func (j *Joystick) Dragged(ev *fyne.DragEvent) {
+	size := j.Size()
+	radius := j.radius()
+	if radius <= 0 {
+		return
+	}
+	x := (ev.Position.X - size.Width/2) / radius
+	y := (ev.Position.Y - size.Height/2) / radius
+	if l := float32(math.Hypot(float64(x), float64(y))); l > 1 {
+		x, y = x/l, y/l
+	}
+
+	j.lock.Lock()
+	j.x, j.y = x, y
+	start := !j.active
+	j.active = true
+	if start && j.PollInterval > 0 {
+		j.stop = make(chan struct{})
+		go j.poll(j.PollInterval, j.stop)
+	}
+	polling := j.stop != nil
+	j.lock.Unlock()
+
+	j.Refresh()
+	if !polling {
+		j.moved()
+	}
+}
+
+// DragEnd springs the stick back to the centre.
+//
+// Implements: fyne.Draggable
+func (j *Joystick) DragEnd() {
+	j.lock.Lock()
+	j.x, j.y = 0, 0
+	j.active = false
+	if j.stop != nil {
+		close(j.stop)
+		j.stop = nil
+	}
+	j.lock.Unlock()
+
+	j.Refresh()
+	j.moved()
+	if f := j.OnReleased; f != nil {
+		f()
+	}
+}
+
+// Vector returns the current position of the stick, after applying the DeadZone.
+func (j *Joystick) Vector() (x, y float32) {
+	j.lock.RLock()
+	x, y = j.x, j.y
+	j.lock.RUnlock()
+
+	if float32(math.Hypot(float64(x), float64(y))) < j.DeadZone {
+		return 0, 0
+	}
+	return x, y
+}
+
+func (j *Joystick) moved() {
+	if f := j.OnMoved; f != nil {
+		f(j.Vector())
+	}
+}
+
+func (j *Joystick) poll(interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	j.moved()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			j.moved()
+		}
+	}
+}
+
+func (j *Joystick) radius() float32 {
+	size := j.Size()
+	return fyne.Min(size.Width, size.Height) / 2 * 0.65
+}
+
+type joystickRenderer struct {
+	joystick   *Joystick
+	base, knob *canvas.Circle
+}
+
+func (r *joystickRenderer) Destroy() {
+}
+
+func (r *joystickRenderer) Layout(size fyne.Size) {
+	diameter := fyne.Min(size.Width, size.Height)
+	r.base.Resize(fyne.NewSquareSize(diameter))
+	r.base.Move(fyne.NewPos((size.Width-diameter)/2, (size.Height-diameter)/2))
+
+	r.joystick.lock.RLock()
+	x, y := r.joystick.x, r.joystick.y
+	r.joystick.lock.RUnlock()
+	knob := diameter * 0.35
+	radius := r.joystick.radius()
+	r.knob.Resize(fyne.NewSquareSize(knob))
+	r.knob.Move(fyne.NewPos(size.Width/2+x*radius-knob/2, size.Height/2+y*radius-knob/2))
+}
+
+func (r *joystickRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(joystickMinSize)
+}
+
+func (r *joystickRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.base, r.knob}
+}
+
+func (r *joystickRenderer) Refresh() {
+	r.base.FillColor = theme.Color(theme.ColorNameInputBackground)
+	r.base.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	r.knob.FillColor = theme.Color(theme.ColorNamePrimary)
+	r.Layout(r.joystick.Size())
+	r.base.Refresh()
+	r.knob.Refresh()
+}
+
+// DPadDirection is a direction pressed on a DPad.
+type DPadDirection int
+
+const (
+	// DPadNone is reported when the centre of the pad is pressed.
+	DPadNone DPadDirection = iota
+	DPadUp
+	DPadDown
+	DPadLeft
+	DPadRight
+)
+
+// DPad is a directional pad with up, down, left and right arms.
+// OnPressed is called when an arm is pressed and OnReleased when it is let go,
+// so that held directions can drive continuous movement.
+type DPad struct {
+	widget.BaseWidget
+
+	OnPressed  func(DPadDirection) `json:"-"`
+	OnReleased func(DPadDirection) `json:"-"`
+
+	pressed DPadDirection
+}
+
+// NewDPad creates a new directional pad that calls pressed when an arm is pressed.
+func NewDPad(pressed func(DPadDirection)) *DPad {
+	d := &DPad{OnPressed: pressed}
+	d.ExtendBaseWidget(d)
+	return d
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (d *DPad) CreateRenderer() fyne.WidgetRenderer {
+	d.ExtendBaseWidget(d)
+	r := &dpadRenderer{dpad: d}
+	for i := range r.arms {
+		r.arms[i] = canvas.NewRectangle(color.Transparent)
+		r.icons[i] = canvas.NewImageFromResource(nil)
+	}
+	r.icons[0].Resource = theme.MoveUpIcon()
+	r.icons[1].Resource = theme.MoveDownIcon()
+	r.icons[2].Resource = theme.NavigateBackIcon()
+	r.icons[3].Resource = theme.NavigateNextIcon()
+	r.Refresh()
+	return r
+}
+
+// MouseDown presses the arm under the pointer.
+//
+// Implements: desktop.Mouseable
+func (d *DPad) MouseDown(ev *desktop.MouseEvent) {
+	d.press(d.directionAt(ev.Position))
+}
+
+// MouseUp releases the pressed arm.
+//
+// Implements: desktop.Mouseable
+func (d *DPad) MouseUp(*desktop.MouseEvent) {
+	d.release()
+}
+
+// TouchDown presses the arm under the touch.
+//
+// Implements: mobile.Touchable
+func (d *DPad) TouchDown(ev *mobile.TouchEvent) {
+	d.press(d.directionAt(ev.Position))
+}
+
+// TouchUp releases the pressed arm.
+//
+// Implements: mobile.Touchable
+func (d *DPad) TouchUp(*mobile.TouchEvent) {
+	d.release()
+}
+
+// TouchCancel releases the pressed arm.
+//
+// Implements: mobile.Touchable
+func (d *DPad) TouchCancel(*mobile.TouchEvent) {
+	d.release()
+}
+
+// Pressed returns the direction currently held, or DPadNone.
+func (d *DPad) Pressed() DPadDirection {
+	return d.pressed
+}
+
+func (d *DPad) press(dir DPadDirection) {
+	if dir == DPadNone {
+		return
+	}
+	d.release()
+	d.pressed = dir
+	d.Refresh()
+	if f := d.OnPressed; f != nil {
+		f(dir)
+	}
+}
+
+func (d *DPad) release() {
+	dir := d.pressed
+	if dir == DPadNone {
+		return
+	}
+	d.pressed = DPadNone
+	d.Refresh()
+	if f := d.OnReleased; f != nil {
+		f(dir)
+	}
+}
+
+// directionAt returns the arm at a position, the dominant axis wins outside of the centre third.
+func (d *DPad) directionAt(pos fyne.Position) DPadDirection {
+	size := d.Size()
+	x := pos.X - size.Width/2
+	y := pos.Y - size.Height/2
+	if absf(x) < size.Width/6 && absf(y) < size.Height/6 {
+		return DPadNone
+	}
+	if absf(x) > absf(y) {
+		if x < 0 {
+			return DPadLeft
+		}
+		return DPadRight
+	}
+	if y < 0 {
+		return DPadUp
+	}
+	return DPadDown
+}
+
+func absf(f float32) float32 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+type dpadRenderer struct {
+	dpad  *DPad
+	arms  [4]*canvas.Rectangle
+	icons [4]*canvas.Image
+}
+
+func (r *dpadRenderer) Destroy() {
+}
+
+func (r *dpadRenderer) Layout(size fyne.Size) {
+	cell := fyne.NewSize(size.Width/3, size.Height/3)
+	positions := [4]fyne.Position{
+		fyne.NewPos(cell.Width, 0),
+		fyne.NewPos(cell.Width, cell.Height*2),
+		fyne.NewPos(0, cell.Height),
+		fyne.NewPos(cell.Width*2, cell.Height),
+	}
+	icon := fyne.Min(cell.Width, cell.Height) * 0.6
+	for i, pos := range positions {
+		r.arms[i].Resize(cell)
+		r.arms[i].Move(pos)
+		r.icons[i].Resize(fyne.NewSquareSize(icon))
+		r.icons[i].Move(pos.Add(fyne.NewPos((cell.Width-icon)/2, (cell.Height-icon)/2)))
+	}
+}
+
+func (r *dpadRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(joystickMinSize)
+}
+
+func (r *dpadRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.arms[0], r.arms[1], r.arms[2], r.arms[3], r.icons[0], r.icons[1], r.icons[2], r.icons[3]}
+}
+
+func (r *dpadRenderer) Refresh() {
+	for i, arm := range r.arms {
+		arm.FillColor = theme.Color(theme.ColorNameButton)
+		if r.dpad.pressed == DPadDirection(i+1) {
+			arm.FillColor = theme.Color(theme.ColorNamePressed)
+		}
+		arm.CornerRadius = theme.InputRadiusSize()
+		arm.Refresh()
+		r.icons[i].Refresh()
+	}
+	r.Layout(r.dpad.Size())
+}
@@ -0,0 +1,119 @@
+package widget
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoystick_Dragged(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	var x, y float32
+	released := false
+	j := NewJoystick(func(dx, dy float32) {
+		x, y = dx, dy
+	})
+	j.OnReleased = func() {
+		released = true
+	}
+	w := test.NewWindow(j)
+	defer w.Close()
+	j.Resize(fyne.NewSquareSize(200))
+
+	radius := j.radius()
+	j.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(100+radius/2, 100)}})
+	assert.InDelta(t, 0.5, x, 0.001)
+	assert.InDelta(t, 0, y, 0.001)
+
+	j.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(100, 0)}})
+	assert.InDelta(t, 0, x, 0.001)
+	assert.InDelta(t, -1, y, 0.001)
+
+	j.DragEnd()
+	assert.Zero(t, x)
+	assert.Zero(t, y)
+	assert.True(t, released)
+}
+
+func TestJoystick_DeadZone(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	j := NewJoystick(nil)
+	j.DeadZone = 0.2
+	j.Resize(fyne.NewSquareSize(200))
+
+	radius := j.radius()
+	j.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(100+radius/10, 100)}})
+	x, y := j.Vector()
+	assert.Zero(t, x)
+	assert.Zero(t, y)
+}
+
+func TestJoystick_Poll(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	var lock sync.Mutex
+	calls := 0
+	j := NewJoystick(func(float32, float32) {
+		lock.Lock()
+		calls++
+		lock.Unlock()
+	})
+	j.PollInterval = 10 * time.Millisecond
+	j.Resize(fyne.NewSquareSize(200))
+
+	j.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(150, 100)}})
+	time.Sleep(55 * time.Millisecond)
+	j.DragEnd()
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.GreaterOrEqual(t, calls, 4)
+}
+
+func TestDPad_Pressed(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	var pressed, released []DPadDirection
+	d := NewDPad(func(dir DPadDirection) {
+		pressed = append(pressed, dir)
+	})
+	d.OnReleased = func(dir DPadDirection) {
+		released = append(released, dir)
+	}
+	w := test.NewWindow(d)
+	defer w.Close()
+	d.Resize(fyne.NewSquareSize(150))
+
+	d.MouseDown(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(75, 10)}})
+	assert.Equal(t, DPadUp, d.Pressed())
+	d.MouseUp(&desktop.MouseEvent{})
+	assert.Equal(t, DPadNone, d.Pressed())
+
+	d.MouseDown(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(140, 80)}})
+	d.MouseUp(&desktop.MouseEvent{})
+	d.MouseDown(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(75, 75)}})
+	d.MouseUp(&desktop.MouseEvent{})
+
+	assert.Equal(t, []DPadDirection{DPadUp, DPadRight}, pressed)
+	assert.Equal(t, []DPadDirection{DPadUp, DPadRight}, released)
+}
+
+func TestDPad_DirectionAt(t *testing.T) {
+	d := NewDPad(nil)
+	d.Resize(fyne.NewSquareSize(90))
+
+	assert.Equal(t, DPadNone, d.directionAt(fyne.NewPos(45, 45)))
+	assert.Equal(t, DPadLeft, d.directionAt(fyne.NewPos(5, 40)))
+	assert.Equal(t, DPadDown, d.directionAt(fyne.NewPos(50, 85)))
+}
@@ -0,0 +1,302 @@
+package widget
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*LED)(nil)
+
+// DefaultLEDBlinkInterval is how long a blinking LED stays on, and then off, if it has no BlinkInterval.
+const DefaultLEDBlinkInterval = time.Second / 2
+
+// LEDLevel is the status that an LED shows by its colour.
+type LEDLevel int
+
+const (
+	// LEDOK is shown in the success colour of the theme.
+	LEDOK LEDLevel = iota
+	// LEDWarning is shown in the warning colour of the theme.
+	LEDWarning
+	// LEDError is shown in the error colour of the theme.
+	LEDError
+)
+
+// LEDState is whether an LED is lit.
+type LEDState int
+
+const (
+	// LEDOff shows the LED dimmed.
+	LEDOff LEDState = iota
+	// LEDOn lights the LED.
+	LEDOn
+	// LEDBlinking lights the LED on and off.
+	LEDBlinking
+)
+
+// LED is a small status light, such as for the connection of a device, with an optional label.
+type LED struct {
+	widget.BaseWidget
+
+	Level LEDLevel
+	State LEDState
+	Label string
+	// Colors replaces the theme colour of levels.
+	Colors map[LEDLevel]color.Color
+	// BlinkInterval is how long a blinking LED stays on, and then off. DefaultLEDBlinkInterval is used if zero.
+	BlinkInterval time.Duration
+
+	levelBinder *intBinder
+	onBinder    *boolBinder
+}
+
+// NewLED returns an LED that is off, at the OK level.
+func NewLED(label string) *LED {
+	l := &LED{Label: label}
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// BindLevel connects the level of the LED to a data source, of LEDLevel values.
+func (l *LED) BindLevel(data binding.Int) {
+	l.UnbindLevel()
+	l.levelBinder = newIntBinder(data, func(v int) {
+		l.SetLevel(LEDLevel(v))
+	})
+}
+
+// BindOn connects whether the LED is on to a data source. It stays blinking while the data is true.
+func (l *LED) BindOn(data binding.Bool) {
+	l.UnbindOn()
+	l.onBinder = newBoolBinder(data, func(on bool) {
+		switch {
+		case !on:
+			l.SetState(LEDOff)
+		case l.State == LEDOff:
+			l.SetState(LEDOn)
+		}
+	})
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (l *LED) CreateRenderer() fyne.WidgetRenderer {
+	l.ExtendBaseWidget(l)
+	r := &ledRenderer{led: l, glow: canvas.NewCircle(color.Transparent), light: canvas.NewCircle(color.Transparent),
+		label: canvas.NewText("", color.Transparent), lit: true}
+	r.Refresh()
+	return r
+}
+
+// SetLevel changes the status shown by the colour of the LED.
+func (l *LED) SetLevel(level LEDLevel) {
+	l.Level = level
+	l.Refresh()
+}
+
+// SetState turns the LED on, off or blinking.
+func (l *LED) SetState(state LEDState) {
+	l.State = state
+	l.Refresh()
+}
+
+// UnbindLevel disconnects the level of the LED from its data source.
+func (l *LED) UnbindLevel() {
+	l.levelBinder.unbind()
+	l.levelBinder = nil
+}
+
+// UnbindOn disconnects whether the LED is on from its data source.
+func (l *LED) UnbindOn() {
+	l.onBinder.unbind()
+	l.onBinder = nil
+}
+
+func (l *LED) color() color.Color {
+	if c, ok := l.Colors[l.Level]; ok {
+		return c
+	}
+	switch l.Level {
+	case LEDWarning:
+		return theme.Color(theme.ColorNameWarning)
+	case LEDError:
+		return theme.Color(theme.ColorNameError)
+	}
+	return theme.Color(theme.ColorNameSuccess)
+}
+
+type ledRenderer struct {
+	led         *LED
+	glow, light *canvas.Circle
+	label       *canvas.Text
+
+	blink    *fyne.Animation
+	blinking time.Duration // interval of the running blink animation, or 0
+	lit      bool          // whether a blinking LED is in its on phase
+}
+
+func (r *ledRenderer) Destroy() {
+	r.stopBlink()
+}
+
+func (r *ledRenderer) Layout(size fyne.Size) {
+	diameter := r.diameter()
+	glow := diameter + theme.Padding()
+	top := (size.Height - glow) / 2
+	r.glow.Resize(fyne.NewSquareSize(glow))
+	r.glow.Move(fyne.NewPos(0, top))
+	r.light.Resize(fyne.NewSquareSize(diameter))
+	r.light.Move(fyne.NewPos((glow-diameter)/2, top+(glow-diameter)/2))
+
+	textSize := r.label.MinSize()
+	r.label.Resize(textSize)
+	r.label.Move(fyne.NewPos(glow+theme.Padding(), (size.Height-textSize.Height)/2))
+}
+
+func (r *ledRenderer) MinSize() fyne.Size {
+	glow := r.diameter() + theme.Padding()
+	if r.led.Label == "" {
+		return fyne.NewSquareSize(glow)
+	}
+	text := r.label.MinSize()
+	return fyne.NewSize(glow+theme.Padding()+text.Width, fyne.Max(glow, text.Height))
+}
+
+func (r *ledRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.glow, r.light, r.label}
+}
+
+func (r *ledRenderer) Refresh() {
+	l := r.led
+	interval := l.BlinkInterval
+	if interval <= 0 {
+		interval = DefaultLEDBlinkInterval
+	}
+	if l.State == LEDBlinking && r.blinking != interval {
+		r.startBlink(interval)
+	} else if l.State != LEDBlinking {
+		r.stopBlink()
+	}
+
+	r.label.Text = l.Label
+	r.label.TextSize = theme.TextSize()
+	r.label.Color = theme.Color(theme.ColorNameForeground)
+	r.updateLight()
+	r.Layout(l.Size())
+	canvas.Refresh(l)
+}
+
+func (r *ledRenderer) diameter() float32 {
+	return theme.IconInlineSize() * .6
+}
+
+func (r *ledRenderer) startBlink(interval time.Duration) {
+	r.stopBlink()
+	r.blinking = interval
+	r.blink = fyne.NewAnimation(interval*2, func(done float32) {
+		lit := done < .5
+		if lit != r.lit {
+			r.lit = lit
+			r.updateLight()
+			canvas.Refresh(r.glow)
+			canvas.Refresh(r.light)
+		}
+	})
+	r.blink.Curve = fyne.AnimationLinear
+	r.blink.RepeatCount = fyne.AnimationRepeatForever
+	r.blink.Start()
+}
+
+func (r *ledRenderer) stopBlink() {
+	if r.blink != nil {
+		r.blink.Stop()
+		r.blink = nil
+	}
+	r.blinking = 0
+	r.lit = true
+}
+
+func (r *ledRenderer) updateLight() {
+	l := r.led
+	c := l.color()
+	on := l.State == LEDOn || (l.State == LEDBlinking && r.lit)
+	if !on {
+		r.light.FillColor = ledDim(c, 0x50)
+		r.light.StrokeColor = ledDim(c, 0x90)
+		r.light.StrokeWidth = 1
+		r.glow.FillColor = color.Transparent
+		return
+	}
+	r.light.FillColor = c
+	r.light.StrokeWidth = 0
+	r.glow.FillColor = ledDim(c, 0x40)
+}
+
+// ledDim returns a colour with its opacity reduced to alpha, out of 0xff.
+func ledDim(c color.Color, alpha uint8) color.Color {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	n.A = uint8(uint16(n.A) * uint16(alpha) / 0xff)
+	return n
+}
+
+// intBinder connects a widget value to a binding.Int, ignoring nil receivers.
+type intBinder struct {
+	data     binding.Int
+	listener binding.DataListener
+}
+
+func newIntBinder(data binding.Int, changed func(int)) *intBinder {
+	b := &intBinder{data: data}
+	b.listener = binding.NewDataListener(func() {
+		v, err := data.Get()
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		changed(v)
+	})
+	data.AddListener(b.listener)
+	return b
+}
+
+func (b *intBinder) unbind() {
+	if b == nil {
+		return
+	}
+	b.data.RemoveListener(b.listener)
+}
+
+// boolBinder connects a widget value to a binding.Bool, ignoring nil receivers.
+type boolBinder struct {
+	data     binding.Bool
+	listener binding.DataListener
+}
+
+func newBoolBinder(data binding.Bool, changed func(bool)) *boolBinder {
+	b := &boolBinder{data: data}
+	b.listener = binding.NewDataListener(func() {
+		v, err := data.Get()
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		changed(v)
+	})
+	data.AddListener(b.listener)
+	return b
+}
+
+func (b *boolBinder) unbind() {
+	if b == nil {
+		return
+	}
+	b.data.RemoveListener(b.listener)
+}
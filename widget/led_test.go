@@ -0,0 +1,76 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLED(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	l := NewLED("Connected")
+	r := test.WidgetRenderer(l).(*ledRenderer)
+	assert.Equal(t, "Connected", r.label.Text)
+	assert.Equal(t, color.Transparent, r.glow.FillColor)
+	assert.Greater(t, l.MinSize().Width, r.label.MinSize().Width)
+
+	l.SetState(LEDOn)
+	assert.Equal(t, theme.Color(theme.ColorNameSuccess), r.light.FillColor)
+	l.SetLevel(LEDError)
+	assert.Equal(t, theme.Color(theme.ColorNameError), r.light.FillColor)
+
+	l.Colors = map[LEDLevel]color.Color{LEDError: color.NRGBA{B: 0xff, A: 0xff}}
+	l.Refresh()
+	assert.Equal(t, color.NRGBA{B: 0xff, A: 0xff}, r.light.FillColor)
+
+	l.SetState(LEDOff)
+	assert.Equal(t, color.NRGBA{B: 0xff, A: 0x50}, r.light.FillColor)
+}
+
+func TestLED_Blink(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	l := NewLED("")
+	r := test.WidgetRenderer(l).(*ledRenderer)
+	l.SetState(LEDBlinking)
+	assert.NotNil(t, r.blink)
+	// the test driver runs animations to their end, the off phase
+	assert.False(t, r.lit)
+	assert.Equal(t, color.Transparent, r.glow.FillColor)
+
+	l.SetState(LEDOn)
+	assert.Nil(t, r.blink)
+	assert.NotEqual(t, color.Transparent, r.glow.FillColor)
+}
+
+func TestLED_Binding(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	on, level := binding.NewBool(), binding.NewInt()
+	l := NewLED("Pump")
+	l.BindOn(on)
+	l.BindLevel(level)
+	_ = on.Set(true)
+	_ = level.Set(int(LEDWarning))
+	waitForBinding()
+	assert.Equal(t, LEDOn, l.State)
+	assert.Equal(t, LEDWarning, l.Level)
+
+	l.SetState(LEDBlinking)
+	_ = on.Set(false)
+	waitForBinding()
+	assert.Equal(t, LEDOff, l.State)
+
+	l.UnbindOn()
+	_ = on.Set(true)
+	waitForBinding()
+	assert.Equal(t, LEDOff, l.State)
+}
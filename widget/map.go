@@ -4,38 +4,58 @@ import (
 	"image"
 	"math"
 	"net/http"
-	"net/url"
+	"os"
+	"path/filepath"
 
 	"github.com/nfnt/resize"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
-	"fyne.io/fyne/v2/layout"
-	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
 	"golang.org/x/image/draw"
 )
 
-const tileSize = 256
+// Declare conformity with interfaces
+var _ fyne.Tappable = (*Map)(nil)
+
+const (
+	tileSize = 256
+	// how many zoom levels lower a tile is looked for to show until a tile is loaded
+	mapTileFallbackLevels = 4
+)
 
 // Map widget renders an interactive map using OpenStreetMap tile data.
 type Map struct {
 	widget.BaseWidget
 
+	// OnViewportChanged is called when the map is panned, zoomed or resized.
+	OnViewportChanged func() `json:"-"`
+
 	pixels     *image.NRGBA
 	w, h       int
 	zoom, x, y int
+	dx, dy     float64 // the offset of the center from the middle of the tile x, y, in pixels
 
-	cl *http.Client
+	cl     *http.Client
+	cache  *tileCache  // the tiles on disk, nil if disabled
+	loader *tileLoader // downloads the tiles, while the map is shown
 
-	tileSource       string // url to download xyz tiles (example: "https://tile.openstreetmap.org/%d/%d/%d.png")
+	tileSource       TileSource
 	hideAttribution  bool   // enable copyright attribution
 	attributionLabel string // label for attribution (example: "OpenStreetMap")
 	attributionURL   string // url for attribution (example: "https://openstreetmap.org")
 	hideZoomButtons  bool   // enable zoom buttons
 	hideMoveButtons  bool   // enable move map buttons
+	showScaleBar     bool
+	scaleUnits       MapScaleUnits
+	controlPositions [mapControlCount]MapControlPosition
+	search           *mapSearch // nil unless enabled
+
+	markers      []*MapMarker
+	noClustering bool
+	overlays     []*MapOverlay
 }
 
 // MapOption configures the provided map with different features.
@@ -44,17 +64,25 @@ type MapOption func(*Map)
 // WithOsmTiles configures the map to use osm tile source.
 func WithOsmTiles() MapOption {
 	return func(m *Map) {
-		m.tileSource = "https://tile.openstreetmap.org/%d/%d/%d.png"
-		m.attributionLabel = "OpenStreetMap"
-		m.attributionURL = "https://openstreetmap.org"
+		WithTiles(OSMTiles())(m)
 		m.hideAttribution = false
 	}
 }
 
-// WithTileSource configures the map to use a custom tile source.
+// WithTiles configures the map to use the tiles of a source, such as OSMTiles, OpenTopoMapTiles,
+// StamenTiles, CartoTiles or MapboxTiles, with its attribution.
+func WithTiles(source TileSource) MapOption {
+	return func(m *Map) {
+		m.tileSource = source
+		m.attributionLabel, m.attributionURL = source.Attribution()
+	}
+}
+
+// WithTileSource configures the map to use a custom tile source, given as the format of the tile addresses
+// with the zoom level and the tile coordinates (example: "https://tile.openstreetmap.org/%d/%d/%d.png").
 func WithTileSource(tileSource string) MapOption {
 	return func(m *Map) {
-		m.tileSource = tileSource
+		m.tileSource = &URLTileSource{URL: printfTileURL(tileSource)}
 	}
 }
 
@@ -88,9 +116,27 @@ func WithHTTPClient(client *http.Client) MapOption {
 	}
 }
 
+// WithDiskCache configures the map to keep the tiles it downloads in a directory, so that they are shown
+// again without the network, up to maxSize bytes after which the least recently used are evicted.
+// Tiles are revalidated with their ETag once the age that their service gives them has passed.
+// An empty directory is "fyne-x-map-tiles" in the user's cache directory, and a maxSize of 0 has no limit.
+func WithDiskCache(dir string, maxSize int64) MapOption {
+	return func(m *Map) {
+		if dir == "" {
+			cacheDir, err := os.UserCacheDir()
+			if err != nil {
+				fyne.LogError("Failed to find the cache directory for map tiles", err)
+				return
+			}
+			dir = filepath.Join(cacheDir, "fyne-x-map-tiles")
+		}
+		m.cache = newTileCache(dir, maxSize)
+	}
+}
+
 // NewMap creates a new instance of the map widget.
 func NewMap() *Map {
-	m := &Map{cl: &http.Client{}}
+	m := &Map{cl: &http.Client{}, controlPositions: defaultMapControlPositions}
 	WithOsmTiles()(m)
 	m.ExtendBaseWidget(m)
 	return m
@@ -116,29 +162,33 @@ func (m *Map) MinSize() fyne.Size {
 func (m *Map) PanEast() {
 	m.x++
 	m.Refresh()
+	m.viewportChanged()
 }
 
 // PanNorth will move the map to the North by 1 tile.
 func (m *Map) PanNorth() {
 	m.y--
 	m.Refresh()
+	m.viewportChanged()
 }
 
 // PanSouth will move the map to the South by 1 tile.
 func (m *Map) PanSouth() {
 	m.y++
 	m.Refresh()
+	m.viewportChanged()
 }
 
 // PanWest will move the map to the west by 1 tile.
 func (m *Map) PanWest() {
 	m.x--
 	m.Refresh()
+	m.viewportChanged()
 }
 
-// Zoom sets the zoom level to a specific value, between 0 and 19.
+// Zoom sets the zoom level to a specific value, between 0 and the maximum zoom level of the tile source.
 func (m *Map) Zoom(zoom int) {
-	if zoom < 0 || zoom > 19 {
+	if zoom < 0 || zoom > m.tileSource.MaxZoom() {
 		return
 	}
 	delta := zoom - m.zoom
@@ -152,15 +202,17 @@ func (m *Map) Zoom(zoom int) {
 		}
 	}
 	m.Refresh()
+	m.viewportChanged()
 }
 
 // ZoomIn steps the scale of this map to be one step zoomed in.
 func (m *Map) ZoomIn() {
-	if m.zoom >= 19 {
+	if m.zoom >= m.tileSource.MaxZoom() {
 		return
 	}
 	m.zoomInStep()
 	m.Refresh()
+	m.viewportChanged()
 }
 
 // ZoomOut steps the scale of this map to be one step zoomed out.
@@ -170,39 +222,21 @@ func (m *Map) ZoomOut() {
 	}
 	m.zoomOutStep()
 	m.Refresh()
+	m.viewportChanged()
 }
 
 // CreateRenderer returns the renderer for this widget.
-// A map renderer is simply the map Raster with user interface elements overlaid.
+// A map renderer is the map Raster with the overlays, markers and user interface elements overlaid.
 func (m *Map) CreateRenderer() fyne.WidgetRenderer {
-	var zoom fyne.CanvasObject
-	if !m.hideZoomButtons {
-		zoom = container.NewVBox(
-			newMapButton(theme.ZoomInIcon(), m.ZoomIn),
-			newMapButton(theme.ZoomOutIcon(), m.ZoomOut))
-	}
-
-	var move fyne.CanvasObject
-	if !m.hideMoveButtons {
-		buttonLayout := container.NewGridWithColumns(3, layout.NewSpacer(),
-			newMapButton(theme.MoveUpIcon(), m.PanNorth), layout.NewSpacer(),
-			newMapButton(theme.NavigateBackIcon(), m.PanWest), layout.NewSpacer(),
-			newMapButton(theme.NavigateNextIcon(), m.PanEast), layout.NewSpacer(),
-			newMapButton(theme.MoveDownIcon(), m.PanSouth), layout.NewSpacer())
-		move = container.NewVBox(buttonLayout)
-	}
-
-	var copyright fyne.CanvasObject
-	if !m.hideAttribution {
-		license, _ := url.Parse(m.attributionURL)
-		link := widget.NewHyperlink(m.attributionLabel, license)
-		copyright = container.NewHBox(layout.NewSpacer(), link)
-	}
-
-	overlay := container.NewBorder(nil, copyright, move, zoom)
-
-	c := container.NewStack(canvas.NewRaster(m.draw), container.NewPadded(overlay))
-	return widget.NewSimpleRenderer(c)
+	controls, positions := m.createControls()
+	overlay := container.New(&mapControlsLayout{positions: positions}, controls...)
+
+	r := &mapRenderer{m: m, raster: canvas.NewRaster(m.draw), shapes: canvas.NewRaster(m.drawOverlays),
+		overlay: overlay}
+	r.loader = newTileLoader(m.tileSource, m.cl, m.cache, r.raster.Refresh)
+	m.loader = r.loader
+	r.updateMarkers()
+	return r
 }
 
 func (m *Map) draw(w, h int) image.Image {
@@ -221,52 +255,173 @@ func (m *Map) draw(w, h int) image.Image {
 		m.pixels = image.NewNRGBA(image.Rect(0, 0, w, h))
 	}
 
-	midTileX := (w - tileSize*2) / 2
-	midTileY := (h - tileSize*2) / 2
-	if m.zoom == 0 {
-		midTileX += tileSize / 2
-		midTileY += tileSize / 2
-	}
+	cx, cy := m.center()
+	left := int(math.Round(cx*float64(scale))) - w/2
+	top := int(math.Round(cy*float64(scale))) - h/2
+	firstTileX := int(math.Floor(float64(left) / float64(tileSize)))
+	firstTileY := int(math.Floor(float64(top) / float64(tileSize)))
 
+	// the tiles in view are drawn and loaded first, then those of a ring around them are prefetched
+	var visible, prefetch []tileKey
 	count := 1 << m.zoom
-	mx := m.x + int(float32(count)/2-0.5)
-	my := m.y + int(float32(count)/2-0.5)
-	firstTileX := mx - int(math.Ceil(float64(midTileX)/float64(tileSize)))
-	firstTileY := my - int(math.Ceil(float64(midTileY)/float64(tileSize)))
-
-	for x := firstTileX; (x-firstTileX)*tileSize <= w+tileSize; x++ {
-		for y := firstTileY; (y-firstTileY)*tileSize <= h+tileSize; y++ {
-			if x < 0 || y < 0 || x >= int(count) || y >= int(count) {
+	for x := firstTileX - 1; (x-1)*tileSize-left < w; x++ {
+		for y := firstTileY - 1; (y-1)*tileSize-top < h; y++ {
+			if x < 0 || y < 0 || x >= count || y >= count {
 				continue
 			}
 
-			src, err := getTile(m.tileSource, x, y, m.zoom, m.cl)
-			if err != nil {
-				fyne.LogError("tile fetch error", err)
+			key := tileKey{x: x, y: y, zoom: m.zoom}
+			pos := image.Pt(x*tileSize-left, y*tileSize-top)
+			if pos.X+tileSize <= 0 || pos.Y+tileSize <= 0 || pos.X >= w || pos.Y >= h {
+				prefetch = append(prefetch, key)
 				continue
 			}
-
-			pos := image.Pt(midTileX+(x-mx)*tileSize,
-				midTileY+(y-my)*tileSize)
-			scaled := src
-			if scale > 1 {
-				scaled = resize.Resize(uint(tileSize), uint(tileSize), src, resize.Lanczos2)
-			}
-			draw.Copy(m.pixels, pos, scaled, image.Rect(0, 0, tileSize, tileSize), draw.Over, nil)
+			visible = append(visible, key)
+			m.drawTile(key, pos, tileSize, scale)
 		}
 	}
 
+	if m.loader != nil {
+		midX, midY := float64(left+w/2)/float64(tileSize), float64(top+h/2)/float64(tileSize)
+		sortTilesByDistance(visible, midX, midY)
+		sortTilesByDistance(prefetch, midX, midY)
+		m.loader.want(visible, prefetch)
+	}
 	return m.pixels
 }
 
+// drawTile draws a tile that is loaded at a position of the pixels. Until it is loaded, the part of a tile
+// of a lower zoom level that is loaded is scaled up in its place.
+func (m *Map) drawTile(key tileKey, pos image.Point, size, scale int) {
+	if src, ok := memoryTile(m.tileSource.TileURL(key.zoom, key.x, key.y)); ok {
+		if scale > 1 {
+			src = resize.Resize(uint(size), uint(size), src, resize.Lanczos2)
+		}
+		draw.Copy(m.pixels, pos, src, image.Rect(0, 0, size, size), draw.Over, nil)
+		return
+	}
+
+	for d := 1; d <= mapTileFallbackLevels && d <= key.zoom; d++ {
+		src, ok := memoryTile(m.tileSource.TileURL(key.zoom-d, key.x>>d, key.y>>d))
+		if !ok {
+			continue
+		}
+		b := src.Bounds()
+		part := b.Dx() >> d
+		ox, oy := key.x-key.x>>d<<d, key.y-key.y>>d<<d
+		sr := image.Rect(ox*part, oy*part, (ox+1)*part, (oy+1)*part).Add(b.Min)
+		draw.ApproxBiLinear.Scale(m.pixels, image.Rect(pos.X, pos.Y, pos.X+size, pos.Y+size), src, sr, draw.Over, nil)
+		return
+	}
+}
+
 func (m *Map) zoomInStep() {
+	x, y := m.center()
 	m.zoom++
-	m.x *= 2
-	m.y *= 2
+	m.centerOnWorld(x*2, y*2)
 }
 
 func (m *Map) zoomOutStep() {
+	x, y := m.center()
 	m.zoom--
-	m.x /= 2
-	m.y /= 2
+	m.centerOnWorld(x/2, y/2)
+}
+
+// center returns the position of the center of the map in the pixels of the whole map.
+func (m *Map) center() (x, y float64) {
+	half := float64(int(1)<<m.zoom) / 2
+	return (float64(m.x)+half)*tileSize + m.dx, (float64(m.y)+half)*tileSize + m.dy
+}
+
+// centerOnWorld moves the center of the map to a position in the pixels of the whole map.
+func (m *Map) centerOnWorld(x, y float64) {
+	half := float64(int(1)<<m.zoom) / 2
+	tileX, tileY := x/tileSize-half, y/tileSize-half
+	m.x, m.y = int(math.Round(tileX)), int(math.Round(tileY))
+	m.dx, m.dy = (tileX-float64(m.x))*tileSize, (tileY-float64(m.y))*tileSize
+}
+
+func (m *Map) viewportChanged() {
+	if f := m.OnViewportChanged; f != nil {
+		f()
+	}
+}
+
+// worldPixel returns the position of a latitude and longitude in the pixels of the whole map at a zoom level,
+// in the Web Mercator projection of the tiles.
+func worldPixel(lat, lon float64, zoom int) (x, y float64) {
+	size := float64(int(1)<<zoom) * tileSize
+	lat = math.Max(-85.05112878, math.Min(85.05112878, lat))
+	latRad := lat * math.Pi / 180
+	x = (lon + 180) / 360 * size
+	y = (1 - math.Log(math.Tan(latRad)+1/math.Cos(latRad))/math.Pi) / 2 * size
+	return x, y
+}
+
+type mapRenderer struct {
+	m       *Map
+	raster  *canvas.Raster
+	shapes  *canvas.Raster // the overlays
+	overlay fyne.CanvasObject
+	loader  *tileLoader
+
+	markers []fyne.CanvasObject // the views of the markers and clusters
+	objects []fyne.CanvasObject
+}
+
+func (r *mapRenderer) Destroy() {
+	r.loader.stop()
+}
+
+func (r *mapRenderer) Layout(s fyne.Size) {
+	r.raster.Resize(s)
+	r.shapes.Resize(s)
+	r.overlay.Resize(s)
+	r.layoutMarkers()
+}
+
+func (r *mapRenderer) MinSize() fyne.Size {
+	return r.m.MinSize()
+}
+
+func (r *mapRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *mapRenderer) Refresh() {
+	r.updateMarkers()
+	r.raster.Refresh()
+	r.shapes.Refresh()
+	r.overlay.Refresh()
+}
+
+// updateMarkers creates the views of the markers and clusters at the zoom level, and positions them.
+func (r *mapRenderer) updateMarkers() {
+	groups, _ := r.m.clusterMarkers()
+	r.markers = r.markers[:0]
+	for _, group := range groups {
+		if len(group) == 1 {
+			marker := group[0]
+			if marker.view == nil {
+				marker.view = newMapMarkerView(marker)
+			}
+			r.markers = append(r.markers, marker.view)
+		} else {
+			r.markers = append(r.markers, newMapClusterView(r.m, group))
+		}
+	}
+
+	r.objects = append(append([]fyne.CanvasObject{r.raster, r.shapes}, r.markers...), r.overlay)
+	r.layoutMarkers()
+}
+
+func (r *mapRenderer) layoutMarkers() {
+	_, positions := r.m.clusterMarkers()
+	for i, view := range r.markers {
+		if i >= len(positions) {
+			break
+		}
+		view.Resize(view.MinSize())
+		view.Move(positions[i].SubtractXY(mapMarkerSize/2, mapMarkerSize/2))
+	}
 }
@@ -42,6 +42,16 @@ func TestMap_Zoom(t *testing.T) {
 	assert.Equal(t, 5, m.zoom)
 }
 
+func TestMap_ZoomMax(t *testing.T) {
+	m := NewMapWithOptions(WithTiles(OpenTopoMapTiles()))
+	m.Zoom(17)
+	assert.Equal(t, 17, m.zoom)
+	m.ZoomIn()
+	assert.Equal(t, 17, m.zoom)
+	m.Zoom(18)
+	assert.Equal(t, 17, m.zoom)
+}
+
 func TestNewMap_WithDefaults(t *testing.T) {
 	// arrange
 	w := test.NewApp().NewWindow("TestMap")
@@ -49,7 +59,7 @@ func TestNewMap_WithDefaults(t *testing.T) {
 	// action
 	w.SetContent(m)
 	// verify
-	assert.Equal(t, "https://tile.openstreetmap.org/%d/%d/%d.png", m.tileSource)
+	assert.Equal(t, "https://tile.openstreetmap.org/1/2/3.png", m.tileSource.TileURL(1, 2, 3))
 	assert.Equal(t, "OpenStreetMap", m.attributionLabel)
 	assert.Equal(t, "https://openstreetmap.org", m.attributionURL)
 	assert.False(t, m.hideAttribution)
@@ -1,40 +1,277 @@
 package widget
 
 import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"image"
-	"image/png"
+	_ "image/jpeg" // some services serve JPEG tiles, such as satellite imagery
+	_ "image/png"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
-var tileMap = make(map[string]image.Image)
+// defaultTileMaxAge is how long a tile stays fresh when its response doesn't say.
+const defaultTileMaxAge = 24 * time.Hour
 
-func getTile(tileSource string, x, y, zoom int, cl *http.Client) (image.Image, error) {
-	if tileSource == "" {
+var (
+	tileMap     = make(map[string]image.Image)
+	tileMapLock sync.RWMutex
+)
+
+// memoryTile returns the tile at an address if it is loaded.
+func memoryTile(u string) (image.Image, bool) {
+	tileMapLock.RLock()
+	defer tileMapLock.RUnlock()
+	tile, ok := tileMap[u]
+	return tile, ok
+}
+
+// tileLoaded returns whether the tile at an address is loaded.
+func tileLoaded(u string) bool {
+	_, ok := memoryTile(u)
+	return ok
+}
+
+func getTile(ctx context.Context, tileSource TileSource, x, y, zoom int, cl *http.Client, cache *tileCache) (image.Image, error) {
+	if tileSource == nil {
 		return nil, errors.New("no tileSource provided")
 	}
 
-	u := fmt.Sprintf(tileSource, zoom, x, y)
-	if tile, ok := tileMap[u]; ok {
+	u := tileSource.TileURL(zoom, x, y)
+	if tile, ok := memoryTile(u); ok {
 		return tile, nil
 	}
 
-	req, err := http.NewRequest("GET", u, nil)
+	cached, meta := cache.get(u)
+	if cached != nil && time.Now().Before(meta.Expires) {
+		return decodeTile(u, cached)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("User-Agent", "Fyne-X Map Widget/0.1")
+	tileSource.PrepareRequest(req)
+	if cached != nil && meta.ETag != "" {
+		req.Header.Set("If-None-Match", meta.ETag)
+	}
 	res, err := cl.Do(req)
 	if err != nil {
+		if cached != nil && ctx.Err() == nil { // offline, the stale tile is better than none
+			return decodeTile(u, cached)
+		}
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	img, err := png.Decode(res.Body)
+	switch {
+	case res.StatusCode == http.StatusNotModified && cached != nil:
+		meta.Expires = tileExpiry(res.Header, time.Now())
+		cache.put(u, cached, meta)
+		return decodeTile(u, cached)
+	case res.StatusCode != http.StatusOK:
+		if cached != nil {
+			return decodeTile(u, cached)
+		}
+		return nil, fmt.Errorf("tile %s: %s", u, res.Status)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	img, err := decodeTile(u, data)
+	if err == nil {
+		cache.put(u, data, tileMeta{ETag: res.Header.Get("ETag"), Expires: tileExpiry(res.Header, time.Now())})
+	}
+	return img, err
+}
+
+func decodeTile(u string, data []byte) (image.Image, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
 	if err == nil {
+		tileMapLock.Lock()
 		tileMap[u] = img
+		tileMapLock.Unlock()
 	}
 	return img, err
 }
+
+// tileExpiry returns when a tile downloaded at now stops being fresh, from the max-age of its Cache-Control
+// header or its Expires header. A tile with no-cache is revalidated each time it is shown after it is loaded.
+func tileExpiry(header http.Header, now time.Time) time.Time {
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		directive = strings.TrimSpace(directive)
+		if directive == "no-cache" || directive == "no-store" {
+			return now
+		}
+		if strings.HasPrefix(directive, "max-age=") {
+			if seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age=")); err == nil {
+				return now.Add(time.Duration(seconds) * time.Second)
+			}
+		}
+	}
+	if expires, err := http.ParseTime(header.Get("Expires")); err == nil {
+		return expires
+	}
+	return now.Add(defaultTileMaxAge)
+}
+
+// tileMeta is what the cache keeps with a tile to know when to revalidate it.
+type tileMeta struct {
+	ETag    string    `json:"etag,omitempty"`
+	Expires time.Time `json:"expires"`
+}
+
+// tileCache keeps the downloaded tiles in a directory, so that they are shown without the network
+// the next time, evicting those least recently used once the tiles take more than maxSize bytes.
+// Each tile is a file named by the hash of its address, with its tileMeta in a JSON file beside it.
+type tileCache struct {
+	dir     string
+	maxSize int64
+
+	mu      sync.Mutex
+	loaded  bool
+	size    int64
+	lru     *list.List // of *tileCacheEntry, the most recently used first
+	entries map[string]*list.Element
+}
+
+type tileCacheEntry struct {
+	key  string
+	size int64
+}
+
+func newTileCache(dir string, maxSize int64) *tileCache {
+	return &tileCache{dir: dir, maxSize: maxSize, lru: list.New(), entries: map[string]*list.Element{}}
+}
+
+// get returns the content and the meta of a cached tile, or nil content if it is not cached.
+func (c *tileCache) get(u string) ([]byte, tileMeta) {
+	var meta tileMeta
+	if c == nil {
+		return nil, meta
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+
+	key := tileCacheKey(u)
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, meta
+	}
+	data, err := os.ReadFile(c.path(key, ".tile"))
+	if err != nil {
+		c.remove(elem)
+		return nil, meta
+	}
+	if raw, err := os.ReadFile(c.path(key, ".json")); err == nil {
+		_ = json.Unmarshal(raw, &meta)
+	}
+
+	now := time.Now()
+	_ = os.Chtimes(c.path(key, ".tile"), now, now)
+	c.lru.MoveToFront(elem)
+	return data, meta
+}
+
+// put stores a tile, then evicts the least recently used tiles until the cache fits in its size.
+func (c *tileCache) put(u string, data []byte, meta tileMeta) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return
+	}
+	key := tileCacheKey(u)
+	if err := os.WriteFile(c.path(key, ".tile"), data, 0o644); err != nil {
+		return
+	}
+	if raw, err := json.Marshal(meta); err == nil {
+		_ = os.WriteFile(c.path(key, ".json"), raw, 0o644)
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&tileCacheEntry{key: key, size: int64(len(data))})
+	c.size += int64(len(data))
+
+	for c.maxSize > 0 && c.size > c.maxSize && c.lru.Len() > 1 {
+		oldest := c.lru.Back()
+		entry := oldest.Value.(*tileCacheEntry)
+		c.remove(oldest)
+		_ = os.Remove(c.path(entry.key, ".tile"))
+		_ = os.Remove(c.path(entry.key, ".json"))
+	}
+}
+
+// load reads which tiles the directory has, from the least to the most recently used, when first used.
+func (c *tileCache) load() {
+	if c.loaded {
+		return
+	}
+	c.loaded = true
+
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+	type tileFile struct {
+		key     string
+		size    int64
+		modTime time.Time
+	}
+	var tiles []tileFile
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), ".tile") {
+			continue
+		}
+		key := strings.TrimSuffix(f.Name(), ".tile")
+		if info, err := f.Info(); err == nil {
+			tiles = append(tiles, tileFile{key: key, size: info.Size(), modTime: info.ModTime()})
+		}
+	}
+	sort.Slice(tiles, func(i, j int) bool {
+		return tiles[i].modTime.Before(tiles[j].modTime)
+	})
+	for _, t := range tiles {
+		c.entries[t.key] = c.lru.PushFront(&tileCacheEntry{key: t.key, size: t.size})
+		c.size += t.size
+	}
+}
+
+func (c *tileCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*tileCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+func (c *tileCache) path(key, ext string) string {
+	return filepath.Join(c.dir, key+ext)
+}
+
+func tileCacheKey(u string) string {
+	sum := sha1.Sum([]byte(u))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,114 @@
+package widget
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTile(t *testing.T, size int) []byte {
+	buf := &bytes.Buffer{}
+	require.NoError(t, png.Encode(buf, image.NewNRGBA(image.Rect(0, 0, size, size))))
+	return buf.Bytes()
+}
+
+func TestGetTile_DiskCache(t *testing.T) {
+	tile := testTile(t, 4)
+	requests, revalidations := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidations++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Cache-Control", "max-age=3600")
+		_, _ = w.Write(tile)
+	}))
+	defer server.Close()
+
+	source := &URLTileSource{URL: server.URL + "/{z}/{x}/{y}.png"}
+	cache := newTileCache(t.TempDir(), 0)
+	_, err := getTile(context.Background(), source, 0, 0, 0, server.Client(), cache)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	// a fresh tile is read from disk, as by an app that starts again
+	resetTileMap()
+	cache = newTileCache(cache.dir, 0)
+	img, err := getTile(context.Background(), source, 0, 0, 0, server.Client(), cache)
+	require.NoError(t, err)
+	assert.Equal(t, 4, img.Bounds().Dx())
+	assert.Equal(t, 1, requests)
+
+	// an expired tile is revalidated with its ETag
+	resetTileMap()
+	data, meta := cache.get(source.TileURL(0, 0, 0))
+	meta.Expires = time.Now().Add(-time.Minute)
+	cache.put(source.TileURL(0, 0, 0), data, meta)
+	_, err = getTile(context.Background(), source, 0, 0, 0, server.Client(), cache)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, revalidations)
+	_, meta = cache.get(source.TileURL(0, 0, 0))
+	assert.True(t, meta.Expires.After(time.Now()))
+}
+
+func TestGetTile_Offline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	source := &URLTileSource{URL: server.URL + "/{z}/{x}/{y}.png"}
+	server.Close()
+
+	cache := newTileCache(t.TempDir(), 0)
+	cache.put(source.TileURL(1, 0, 0), testTile(t, 2), tileMeta{Expires: time.Now().Add(-time.Hour)})
+	img, err := getTile(context.Background(), source, 0, 0, 1, http.DefaultClient, cache)
+	require.NoError(t, err)
+	assert.Equal(t, 2, img.Bounds().Dx())
+
+	_, err = getTile(context.Background(), source, 1, 0, 1, http.DefaultClient, cache)
+	assert.Error(t, err)
+}
+
+func TestTileCache_Eviction(t *testing.T) {
+	tile := testTile(t, 1)
+	size := int64(len(tile))
+	cache := newTileCache(t.TempDir(), 2*size)
+	cache.put("a", tile, tileMeta{})
+	cache.put("b", tile, tileMeta{})
+	data, _ := cache.get("a") // b is now the least recently used
+	assert.NotNil(t, data)
+	cache.put("c", tile, tileMeta{})
+
+	data, _ = cache.get("b")
+	assert.Nil(t, data)
+	_, err := os.Stat(cache.path(tileCacheKey("b"), ".tile"))
+	assert.True(t, os.IsNotExist(err))
+	data, _ = cache.get("a")
+	assert.NotNil(t, data)
+	assert.Equal(t, 2*size, cache.size)
+
+	// the recency is kept on disk for the next time
+	reloaded := newTileCache(cache.dir, 2*size)
+	reloaded.load()
+	assert.Equal(t, 2, reloaded.lru.Len())
+	assert.Equal(t, 2*size, reloaded.size)
+}
+
+func TestTileExpiry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, now.Add(time.Hour), tileExpiry(http.Header{"Cache-Control": {"public, max-age=3600"}}, now))
+	assert.Equal(t, now, tileExpiry(http.Header{"Cache-Control": {"no-cache"}}, now))
+	assert.Equal(t, now.Add(defaultTileMaxAge), tileExpiry(http.Header{}, now))
+	expires := now.Add(2 * time.Hour)
+	assert.Equal(t, expires, tileExpiry(http.Header{"Expires": {expires.Format(http.TimeFormat)}}, now))
+}
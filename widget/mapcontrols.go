@@ -0,0 +1,315 @@
+package widget
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"net/url"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// the longest that the scale bar is drawn
+const mapScaleBarMaxWidth = 100
+
+// MapControl is one of the controls shown over a Map.
+type MapControl int
+
+const (
+	// MapAttribution is the copyright notice of the tiles, which most tile services require to be shown.
+	MapAttribution MapControl = iota
+	// MapScaleBar shows how long a distance is at the center of the map.
+	MapScaleBar
+	// MapZoomButtons zoom the map in and out.
+	MapZoomButtons
+	// MapMoveButtons pan the map by a tile.
+	MapMoveButtons
+	// MapSearch is the search entry that WithSearch adds.
+	MapSearch
+
+	mapControlCount
+)
+
+// MapControlPosition is the corner of a Map where a control is shown.
+type MapControlPosition int
+
+const (
+	// MapTopLeft is the top left corner of the map.
+	MapTopLeft MapControlPosition = iota
+	// MapTopRight is the top right corner of the map.
+	MapTopRight
+	// MapBottomLeft is the bottom left corner of the map.
+	MapBottomLeft
+	// MapBottomRight is the bottom right corner of the map.
+	MapBottomRight
+)
+
+// MapScaleUnits are the units of the distance of a scale bar.
+type MapScaleUnits int
+
+const (
+	// MapMetric shows meters and kilometers.
+	MapMetric MapScaleUnits = iota
+	// MapImperial shows feet and miles.
+	MapImperial
+)
+
+var defaultMapControlPositions = [mapControlCount]MapControlPosition{
+	MapAttribution: MapBottomRight,
+	MapScaleBar:    MapBottomLeft,
+	MapZoomButtons: MapTopRight,
+	MapMoveButtons: MapTopLeft,
+	MapSearch:      MapTopLeft,
+}
+
+// WithScaleBar enables or disables a scale bar, in metric or imperial units.
+func WithScaleBar(enable bool, units MapScaleUnits) MapOption {
+	return func(m *Map) {
+		m.showScaleBar = enable
+		m.scaleUnits = units
+	}
+}
+
+// WithControlPosition moves a control to a corner of the map. Controls in the same corner are stacked.
+// By default the attribution is at the bottom right, the scale bar at the bottom left, the zoom buttons
+// at the top right and the search entry and move buttons at the top left.
+func WithControlPosition(control MapControl, position MapControlPosition) MapOption {
+	return func(m *Map) {
+		if control >= 0 && control < mapControlCount {
+			m.controlPositions[control] = position
+		}
+	}
+}
+
+// attribution returns the copyright notice to show, the one of the tile source unless it is configured.
+func (m *Map) attribution() (label, link string) {
+	if m.attributionLabel != "" {
+		return m.attributionLabel, m.attributionURL
+	}
+	return m.tileSource.Attribution()
+}
+
+// createControls returns the controls that are enabled, and the corners they are in.
+func (m *Map) createControls() (controls []fyne.CanvasObject, positions []MapControlPosition) {
+	add := func(control MapControl, obj fyne.CanvasObject) {
+		controls = append(controls, obj)
+		positions = append(positions, m.controlPositions[control])
+	}
+
+	if m.search != nil {
+		add(MapSearch, m.search.createEntry())
+	}
+	if !m.hideAttribution {
+		if label, link := m.attribution(); label != "" {
+			license, _ := url.Parse(link)
+			add(MapAttribution, newMapControlBackground(widget.NewHyperlink(label, license)))
+		}
+	}
+	if m.showScaleBar {
+		add(MapScaleBar, newMapScaleBar(m))
+	}
+	if !m.hideZoomButtons {
+		add(MapZoomButtons, container.NewVBox(
+			newMapButton(theme.ZoomInIcon(), m.ZoomIn),
+			newMapButton(theme.ZoomOutIcon(), m.ZoomOut)))
+	}
+	if !m.hideMoveButtons {
+		add(MapMoveButtons, container.NewGridWithColumns(3, layout.NewSpacer(),
+			newMapButton(theme.MoveUpIcon(), m.PanNorth), layout.NewSpacer(),
+			newMapButton(theme.NavigateBackIcon(), m.PanWest), layout.NewSpacer(),
+			newMapButton(theme.NavigateNextIcon(), m.PanEast), layout.NewSpacer(),
+			newMapButton(theme.MoveDownIcon(), m.PanSouth), layout.NewSpacer()))
+	}
+	return controls, positions
+}
+
+// mapControlsLayout stacks the controls of a map in their corners, inset by the padding.
+type mapControlsLayout struct {
+	positions []MapControlPosition
+}
+
+func (l *mapControlsLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	pad := theme.Padding()
+	var offsets [4]float32
+	for i, o := range objects {
+		if i >= len(l.positions) {
+			break
+		}
+		corner := l.positions[i]
+		min := o.MinSize()
+		o.Resize(min)
+
+		pos := fyne.NewPos(pad, pad+offsets[corner])
+		if corner == MapTopRight || corner == MapBottomRight {
+			pos.X = size.Width - min.Width - pad
+		}
+		if corner == MapBottomLeft || corner == MapBottomRight {
+			pos.Y = size.Height - min.Height - pad - offsets[corner]
+		}
+		o.Move(pos)
+		offsets[corner] += min.Height + pad
+	}
+}
+
+func (l *mapControlsLayout) MinSize([]fyne.CanvasObject) fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+// mapControlColor returns the background of the controls that are not buttons, so they can be read over the tiles.
+func mapControlColor() color.Color {
+	c := color.NRGBAModel.Convert(theme.Color(theme.ColorNameBackground)).(color.NRGBA)
+	c.A = 0xc0
+	return c
+}
+
+// newMapControlBackground returns content over a background of the color of the theme.
+func newMapControlBackground(content fyne.CanvasObject) fyne.CanvasObject {
+	return container.NewStack(newMapControlRectangle(), content)
+}
+
+// mapControlRectangle is a rectangle of the background of the controls, which follows theme changes.
+type mapControlRectangle struct {
+	widget.BaseWidget
+}
+
+func newMapControlRectangle() *mapControlRectangle {
+	r := &mapControlRectangle{}
+	r.ExtendBaseWidget(r)
+	return r
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (r *mapControlRectangle) CreateRenderer() fyne.WidgetRenderer {
+	r.ExtendBaseWidget(r)
+	bg := canvas.NewRectangle(mapControlColor())
+	bg.CornerRadius = theme.InputRadiusSize()
+	return &mapControlRectangleRenderer{WidgetRenderer: widget.NewSimpleRenderer(bg), bg: bg}
+}
+
+type mapControlRectangleRenderer struct {
+	fyne.WidgetRenderer
+	bg *canvas.Rectangle
+}
+
+func (r *mapControlRectangleRenderer) Refresh() {
+	r.bg.FillColor = mapControlColor()
+	r.bg.CornerRadius = theme.InputRadiusSize()
+	r.bg.Refresh()
+}
+
+// mapScaleBar shows a bar of the length of a round distance at the center of the map.
+type mapScaleBar struct {
+	widget.BaseWidget
+	m *Map
+}
+
+func newMapScaleBar(m *Map) *mapScaleBar {
+	s := &mapScaleBar{m: m}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (s *mapScaleBar) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	r := &mapScaleBarRenderer{s: s, bg: newMapControlRectangle(), label: canvas.NewText("", color.Black)}
+	for i := range r.lines {
+		r.lines[i] = canvas.NewLine(color.Black)
+	}
+	r.Refresh()
+	return r
+}
+
+// scale returns the width of the bar and the distance it shows.
+func (s *mapScaleBar) scale() (width float32, label string) {
+	return mapScaleBarLength(metersPerPixel(s.m.Center().Lat, s.m.zoom), mapScaleBarMaxWidth, s.m.scaleUnits)
+}
+
+// mapScaleBarLength returns the width, up to maxWidth, of the longest round distance that fits,
+// and the distance in units.
+func mapScaleBarLength(metersPerPixel, maxWidth float64, units MapScaleUnits) (float32, string) {
+	unit, unitMeters := "m", 1.0
+	bigUnit, bigUnitMeters := "km", 1000.0
+	if units == MapImperial {
+		unit, unitMeters = "ft", 0.3048
+		bigUnit, bigUnitMeters = "mi", 1609.344
+	}
+	max := maxWidth * metersPerPixel
+	if max >= bigUnitMeters {
+		unit, unitMeters = bigUnit, bigUnitMeters
+	}
+
+	distance := roundDistance(max / unitMeters)
+	return float32(distance * unitMeters / metersPerPixel), fmt.Sprintf("%g %s", distance, unit)
+}
+
+// roundDistance returns the highest 1, 2 or 5 times a power of ten that is not above max.
+func roundDistance(max float64) float64 {
+	if max <= 0 {
+		return 0
+	}
+	pow := math.Pow(10, math.Floor(math.Log10(max)))
+	for _, step := range []float64{5, 2} {
+		if step*pow <= max {
+			return step * pow
+		}
+	}
+	return pow
+}
+
+type mapScaleBarRenderer struct {
+	s     *mapScaleBar
+	bg    *mapControlRectangle
+	label *canvas.Text
+	lines [3]*canvas.Line // the bar and its ends
+
+	width float32
+}
+
+func (r *mapScaleBarRenderer) Destroy() {
+}
+
+func (r *mapScaleBarRenderer) Layout(s fyne.Size) {
+	r.bg.Resize(s)
+	pad := theme.Padding()
+	r.label.Move(fyne.NewPos(pad, 0))
+	r.label.Resize(r.label.MinSize())
+
+	bottom := s.Height - pad
+	tick := r.label.MinSize().Height / 3
+	r.lines[0].Position1, r.lines[0].Position2 = fyne.NewPos(pad, bottom), fyne.NewPos(pad+r.width, bottom)
+	r.lines[1].Position1, r.lines[1].Position2 = fyne.NewPos(pad, bottom-tick), fyne.NewPos(pad, bottom)
+	r.lines[2].Position1, r.lines[2].Position2 = fyne.NewPos(pad+r.width, bottom-tick), fyne.NewPos(pad+r.width, bottom)
+}
+
+func (r *mapScaleBarRenderer) MinSize() fyne.Size {
+	pad := theme.Padding()
+	text := r.label.MinSize()
+	return fyne.NewSize(fyne.Max(mapScaleBarMaxWidth, text.Width)+pad*2, text.Height+pad*2)
+}
+
+func (r *mapScaleBarRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg, r.label, r.lines[0], r.lines[1], r.lines[2]}
+}
+
+func (r *mapScaleBarRenderer) Refresh() {
+	r.width, r.label.Text = r.s.scale()
+	fg := theme.Color(theme.ColorNameForeground)
+	r.label.Color = fg
+	r.label.TextSize = theme.CaptionTextSize()
+	for _, l := range r.lines {
+		l.StrokeColor = fg
+		l.StrokeWidth = 2
+	}
+
+	r.Layout(r.s.Size())
+	r.bg.Refresh()
+	canvas.Refresh(r.s)
+}
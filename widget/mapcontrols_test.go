@@ -0,0 +1,88 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapScaleBarLength(t *testing.T) {
+	width, label := mapScaleBarLength(10, 100, MapMetric)
+	assert.Equal(t, float32(100), width)
+	assert.Equal(t, "1 km", label)
+
+	width, label = mapScaleBarLength(3, 100, MapMetric)
+	assert.Equal(t, float32(200)/3, width)
+	assert.Equal(t, "200 m", label)
+
+	width, label = mapScaleBarLength(0.3048, 100, MapImperial)
+	assert.Equal(t, float32(100), width)
+	assert.Equal(t, "100 ft", label)
+
+	_, label = mapScaleBarLength(200, 100, MapImperial)
+	assert.Equal(t, "10 mi", label)
+}
+
+func TestRoundDistance(t *testing.T) {
+	assert.Equal(t, 5.0, roundDistance(9.9))
+	assert.Equal(t, 2.0, roundDistance(4))
+	assert.Equal(t, 1.0, roundDistance(1.5))
+	assert.Equal(t, 500.0, roundDistance(500))
+	assert.Equal(t, 0.0, roundDistance(0))
+}
+
+func TestMap_Controls(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := NewMapWithOptions(WithScaleBar(true, MapImperial), WithControlPosition(MapZoomButtons, MapBottomRight),
+		WithScrollButtons(false))
+	controls, positions := m.createControls()
+	assert.Len(t, controls, 3)
+	assert.Equal(t, []MapControlPosition{MapBottomRight, MapBottomLeft, MapBottomRight}, positions)
+
+	size := fyne.NewSize(400, 300)
+	(&mapControlsLayout{positions: positions}).Layout(controls, size)
+	pad := theme.Padding()
+	attribution, zoom := controls[0], controls[2]
+	assert.Equal(t, size.Width-pad, attribution.Position().X+attribution.Size().Width)
+	assert.Equal(t, size.Height-pad, attribution.Position().Y+attribution.Size().Height)
+	assert.Equal(t, attribution.Position().Y-pad, zoom.Position().Y+zoom.Size().Height)
+	assert.Equal(t, fyne.NewPos(pad, size.Height-pad-controls[1].Size().Height), controls[1].Position())
+}
+
+func TestMap_AttributionFromTileSource(t *testing.T) {
+	source := &URLTileSource{URL: "http://tiles.invalid/{z}/{x}/{y}.png", AttributionLabel: "Tiles Inc"}
+	m := NewMap()
+	m.tileSource = source
+	m.attributionLabel = ""
+	label, _ := m.attribution()
+	assert.Equal(t, "Tiles Inc", label)
+
+	m = NewMapWithOptions(WithTiles(&URLTileSource{URL: "http://tiles.invalid/{z}/{x}/{y}.png"}))
+	controls, _ := m.createControls()
+	assert.Len(t, controls, 2) // no attribution to show
+}
+
+func TestMapScaleBar_Refresh(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := NewMapWithOptions(WithScaleBar(true, MapMetric))
+	m.Resize(fyne.NewSize(400, 300))
+	m.SetCenter(0, 0, 10)
+	bar := newMapScaleBar(m)
+	r := test.WidgetRenderer(bar).(*mapScaleBarRenderer)
+	assert.Equal(t, "10 km", r.label.Text)
+	assert.Equal(t, theme.Color(theme.ColorNameForeground), r.lines[0].StrokeColor)
+
+	m.ZoomOut()
+	bar.Refresh()
+	assert.Equal(t, "20 km", r.label.Text)
+	assert.IsType(t, &canvas.Text{}, r.Objects()[1])
+}
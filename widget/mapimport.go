@@ -0,0 +1,301 @@
+package widget
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// the opacity of the fill of imported polygons that have no style
+const mapImportFillAlpha = 0x40
+
+// LoadGPX adds the tracks and routes of a GPX file as lines, and its waypoints as markers,
+// then zooms the map to fit them.
+// See: https://www.topografix.com/gpx.asp
+func (m *Map) LoadGPX(r io.Reader) error {
+	type gpxPoint struct {
+		Lat float64 `xml:"lat,attr"`
+		Lon float64 `xml:"lon,attr"`
+	}
+	var doc struct {
+		Waypoints []gpxPoint `xml:"wpt"`
+		Routes    []struct {
+			Points []gpxPoint `xml:"rtept"`
+		} `xml:"rte"`
+		Tracks []struct {
+			Segments []struct {
+				Points []gpxPoint `xml:"trkpt"`
+			} `xml:"trkseg"`
+		} `xml:"trk"`
+	}
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return fmt.Errorf("invalid GPX: %w", err)
+	}
+
+	var lines [][]LatLon
+	toLine := func(points []gpxPoint) {
+		line := make([]LatLon, len(points))
+		for i, p := range points {
+			line[i] = LatLon{Lat: p.Lat, Lon: p.Lon}
+		}
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	for _, rte := range doc.Routes {
+		toLine(rte.Points)
+	}
+	for _, trk := range doc.Tracks {
+		for _, seg := range trk.Segments {
+			toLine(seg.Points)
+		}
+	}
+	if len(lines) == 0 && len(doc.Waypoints) == 0 {
+		return errors.New("the GPX file has no waypoints, routes or tracks")
+	}
+
+	b := newMapBounds()
+	for _, line := range lines {
+		m.overlays = append(m.overlays, &MapOverlay{Kind: MapPolyline, Points: line})
+		b.extend(line...)
+	}
+	for _, wpt := range doc.Waypoints {
+		m.markers = append(m.markers, &MapMarker{Lat: wpt.Lat, Lon: wpt.Lon})
+		b.extend(LatLon{Lat: wpt.Lat, Lon: wpt.Lon})
+	}
+	m.fitBounds(b)
+	return nil
+}
+
+// LoadGeoJSON adds the features of a GeoJSON document: points as markers, lines as lines and polygons
+// as filled polygons, then zooms the map to fit them. The "stroke", "stroke-width", "stroke-opacity",
+// "fill" and "fill-opacity" properties of the simplestyle specification give the style of a feature.
+// See: https://datatracker.ietf.org/doc/html/rfc7946
+func (m *Map) LoadGeoJSON(r io.Reader) error {
+	var doc geoJSONObject
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+
+	var markers []*MapMarker
+	var overlays []*MapOverlay
+	b := newMapBounds()
+	var add func(g *geoJSONObject, props map[string]interface{}) error
+	add = func(g *geoJSONObject, props map[string]interface{}) error {
+		switch g.Type {
+		case "FeatureCollection":
+			for i := range g.Features {
+				if err := add(&g.Features[i], nil); err != nil {
+					return err
+				}
+			}
+			return nil
+		case "Feature":
+			if g.Geometry == nil {
+				return nil
+			}
+			return add(g.Geometry, g.Properties)
+		case "GeometryCollection":
+			for i := range g.Geometries {
+				if err := add(&g.Geometries[i], props); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		var coords interface{}
+		if err := json.Unmarshal(g.Coordinates, &coords); err != nil {
+			return fmt.Errorf("invalid GeoJSON coordinates: %w", err)
+		}
+		addLines := func(kind MapOverlayKind, value interface{}) error {
+			points, err := geoJSONPoints(value)
+			if err != nil {
+				return err
+			}
+			o := &MapOverlay{Kind: kind, Points: points}
+			if kind == MapPolygon {
+				if len(points) > 1 && points[0] == points[len(points)-1] {
+					o.Points = points[:len(points)-1] // the ring is closed by repeating the first point
+				}
+				o.FillColor = mapFillColor(theme.Color(theme.ColorNamePrimary), mapImportFillAlpha)
+			}
+			applySimpleStyle(o, props)
+			overlays = append(overlays, o)
+			b.extend(points...)
+			return nil
+		}
+
+		switch g.Type {
+		case "Point", "MultiPoint":
+			if g.Type == "Point" {
+				coords = []interface{}{coords}
+			}
+			points, err := geoJSONPoints(coords)
+			if err != nil {
+				return err
+			}
+			for _, p := range points {
+				markers = append(markers, &MapMarker{Lat: p.Lat, Lon: p.Lon})
+			}
+			b.extend(points...)
+		case "LineString":
+			return addLines(MapPolyline, coords)
+		case "MultiLineString":
+			lines, ok := coords.([]interface{})
+			if !ok {
+				return errors.New("invalid GeoJSON coordinates")
+			}
+			for _, line := range lines {
+				if err := addLines(MapPolyline, line); err != nil {
+					return err
+				}
+			}
+		case "Polygon", "MultiPolygon":
+			polygons, ok := coords.([]interface{})
+			if !ok {
+				return errors.New("invalid GeoJSON coordinates")
+			}
+			if g.Type == "Polygon" {
+				polygons = []interface{}{coords}
+			}
+			for _, polygon := range polygons {
+				// the outer ring, holes are not drawn
+				rings, ok := polygon.([]interface{})
+				if !ok || len(rings) == 0 {
+					return errors.New("invalid GeoJSON polygon")
+				}
+				if err := addLines(MapPolygon, rings[0]); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("unknown GeoJSON type %q", g.Type)
+		}
+		return nil
+	}
+	if err := add(&doc, nil); err != nil {
+		return err
+	}
+
+	m.markers = append(m.markers, markers...)
+	m.overlays = append(m.overlays, overlays...)
+	m.fitBounds(b)
+	return nil
+}
+
+// fitBounds centers the map on bounds, at the highest zoom level at which they fit in the widget.
+func (m *Map) fitBounds(b MapBounds) {
+	if b.empty() {
+		m.Refresh()
+		return
+	}
+	size := m.Size()
+	if size.IsZero() {
+		size = fyne.NewSquareSize(tileSize)
+	}
+
+	zoom := m.tileSource.MaxZoom()
+	for ; zoom > 0; zoom-- {
+		x0, y0 := worldPixel(b.Max.Lat, b.Min.Lon, zoom)
+		x1, y1 := worldPixel(b.Min.Lat, b.Max.Lon, zoom)
+		if x1-x0 <= float64(size.Width) && y1-y0 <= float64(size.Height) {
+			break
+		}
+	}
+	m.zoom = zoom
+	x0, y0 := worldPixel(b.Max.Lat, b.Min.Lon, zoom)
+	x1, y1 := worldPixel(b.Min.Lat, b.Max.Lon, zoom)
+	m.centerOnWorld((x0+x1)/2, (y0+y1)/2)
+	m.Refresh()
+	m.viewportChanged()
+}
+
+// geoJSONObject is any object of a GeoJSON document, with the fields of its type set.
+type geoJSONObject struct {
+	Type        string                 `json:"type"`
+	Features    []geoJSONObject        `json:"features"`
+	Geometry    *geoJSONObject         `json:"geometry"`
+	Properties  map[string]interface{} `json:"properties"`
+	Geometries  []geoJSONObject        `json:"geometries"`
+	Coordinates json.RawMessage        `json:"coordinates"`
+}
+
+// geoJSONPoints returns the points of an array of GeoJSON positions, which are longitude first.
+func geoJSONPoints(value interface{}) ([]LatLon, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.New("invalid GeoJSON coordinates")
+	}
+	points := make([]LatLon, 0, len(items))
+	for _, item := range items {
+		pos, ok := item.([]interface{})
+		if !ok || len(pos) < 2 {
+			return nil, errors.New("invalid GeoJSON position")
+		}
+		lon, ok1 := pos[0].(float64)
+		lat, ok2 := pos[1].(float64)
+		if !ok1 || !ok2 {
+			return nil, errors.New("invalid GeoJSON position")
+		}
+		points = append(points, LatLon{Lat: lat, Lon: lon})
+	}
+	if len(points) == 0 {
+		return nil, errors.New("empty GeoJSON coordinates")
+	}
+	return points, nil
+}
+
+// applySimpleStyle sets the style of an overlay from the simplestyle properties of its feature.
+// See: https://github.com/mapbox/simplestyle-spec
+func applySimpleStyle(o *MapOverlay, props map[string]interface{}) {
+	opacity := func(key string) (uint8, bool) {
+		if v, ok := props[key].(float64); ok {
+			return uint8(math.Round(math.Max(0, math.Min(1, v)) * 0xff)), true
+		}
+		return 0, false
+	}
+	if c, ok := simpleStyleColor(props["stroke"]); ok {
+		if a, ok := opacity("stroke-opacity"); ok {
+			c.A = a
+		}
+		o.StrokeColor = c
+	}
+	if w, ok := props["stroke-width"].(float64); ok {
+		o.StrokeWidth = float32(w)
+	}
+	if o.Kind == MapPolyline {
+		return
+	}
+	if c, ok := simpleStyleColor(props["fill"]); ok {
+		c.A = mapImportFillAlpha
+		if a, ok := opacity("fill-opacity"); ok {
+			c.A = a
+		}
+		o.FillColor = c
+	}
+}
+
+// simpleStyleColor returns the color of a "#rrggbb" or "#rgb" property.
+func simpleStyleColor(value interface{}) (color.NRGBA, bool) {
+	s, ok := value.(string)
+	if !ok {
+		return color.NRGBA{}, false
+	}
+	c, err := ParseHexColor(s)
+	return c, err == nil
+}
+
+// mapFillColor returns c with an opacity.
+func mapFillColor(c color.Color, alpha uint8) color.Color {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	n.A = alpha
+	return n
+}
@@ -0,0 +1,103 @@
+package widget
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <wpt lat="48.8584" lon="2.2945"><name>Eiffel Tower</name></wpt>
+  <trk><name>Walk</name>
+    <trkseg>
+      <trkpt lat="48.8584" lon="2.2945"><ele>35</ele></trkpt>
+      <trkpt lat="48.8606" lon="2.3376"></trkpt>
+    </trkseg>
+    <trkseg>
+      <trkpt lat="48.8606" lon="2.3376"></trkpt>
+      <trkpt lat="48.8530" lon="2.3499"></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+const testGeoJSON = `{
+  "type": "FeatureCollection",
+  "features": [
+    {"type": "Feature", "properties": {"name": "Louvre"}, "geometry": {"type": "Point", "coordinates": [2.3376, 48.8606]}},
+    {"type": "Feature", "properties": {"stroke": "#f00", "stroke-width": 5}, "geometry": {"type": "LineString", "coordinates": [[2.2945, 48.8584], [2.3376, 48.8606]]}},
+    {"type": "Feature", "properties": {"fill": "#0000ff", "fill-opacity": 0.5}, "geometry": {"type": "Polygon",
+      "coordinates": [[[2.33, 48.85], [2.35, 48.85], [2.35, 48.86], [2.33, 48.85]], [[2.34, 48.851], [2.345, 48.851], [2.34, 48.852], [2.34, 48.851]]]}},
+    {"type": "Feature", "properties": null, "geometry": {"type": "GeometryCollection", "geometries": [
+      {"type": "MultiPoint", "coordinates": [[2.29, 48.87], [2.30, 48.87]]},
+      {"type": "MultiPolygon", "coordinates": [[[[2.31, 48.84], [2.32, 48.84], [2.32, 48.845], [2.31, 48.84]]]]}
+    ]}}
+  ]
+}`
+
+func TestMap_LoadGPX(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := NewMapWithOptions(WithTileSource(""))
+	m.Resize(fyne.NewSize(400, 400))
+	require.NoError(t, m.LoadGPX(strings.NewReader(testGPX)))
+
+	require.Len(t, m.Overlays(), 2)
+	assert.Equal(t, MapPolyline, m.Overlays()[0].Kind)
+	assert.Equal(t, []LatLon{{48.8606, 2.3376}, {48.8530, 2.3499}}, m.Overlays()[1].Points)
+	require.Len(t, m.Markers(), 1)
+	assert.Equal(t, 48.8584, m.Markers()[0].Lat)
+
+	// zoomed to fit the track, which is about 4 km wide
+	assert.Equal(t, 13, m.zoom)
+	for _, p := range []LatLon{{48.8584, 2.2945}, {48.8530, 2.3499}} {
+		pos := m.LatLonToScreen(p.Lat, p.Lon)
+		assert.True(t, pos.X >= -tileSize/2 && pos.X <= 400+tileSize/2, pos)
+	}
+
+	assert.Error(t, m.LoadGPX(strings.NewReader(`<gpx></gpx>`)))
+	assert.Error(t, m.LoadGPX(strings.NewReader(`not xml`)))
+}
+
+func TestMap_LoadGeoJSON(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := NewMapWithOptions(WithTileSource(""))
+	m.Resize(fyne.NewSize(400, 400))
+	require.NoError(t, m.LoadGeoJSON(strings.NewReader(testGeoJSON)))
+
+	assert.Len(t, m.Markers(), 3)
+	require.Len(t, m.Overlays(), 3)
+	line := m.Overlays()[0]
+	assert.Equal(t, MapPolyline, line.Kind)
+	assert.Equal(t, color.NRGBA{R: 0xff, A: 0xff}, line.StrokeColor)
+	assert.Equal(t, float32(5), line.StrokeWidth)
+	polygon := m.Overlays()[1]
+	assert.Equal(t, MapPolygon, polygon.Kind)
+	assert.Len(t, polygon.Points, 3)
+	assert.Equal(t, color.NRGBA{B: 0xff, A: 0x80}, polygon.FillColor)
+	assert.Equal(t, MapPolygon, m.Overlays()[2].Kind)
+	assert.NotNil(t, m.Overlays()[2].FillColor)
+	assert.Greater(t, m.zoom, 10)
+
+	assert.Error(t, m.LoadGeoJSON(strings.NewReader(`{"type": "Circle", "coordinates": [1, 2]}`)))
+	assert.Error(t, m.LoadGeoJSON(strings.NewReader(`{"type": "LineString", "coordinates": [1, 2]}`)))
+}
+
+func TestSimpleStyleColor(t *testing.T) {
+	c, ok := simpleStyleColor("#3584e4")
+	assert.True(t, ok)
+	assert.Equal(t, color.NRGBA{R: 0x35, G: 0x84, B: 0xe4, A: 0xff}, c)
+	_, ok = simpleStyleColor("red")
+	assert.False(t, ok)
+	_, ok = simpleStyleColor(12)
+	assert.False(t, ok)
+}
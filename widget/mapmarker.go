@@ -0,0 +1,225 @@
+package widget
+
+import (
+	"math"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Tappable = (*mapMarkerView)(nil)
+var _ fyne.Tappable = (*mapClusterView)(nil)
+
+const (
+	mapMarkerSize = 32
+	// markers closer than this on screen are shown as a cluster
+	mapClusterRadius = 40
+)
+
+// MapMarker is a point of a Map, shown at its coordinates whatever the map is panned or zoomed to.
+type MapMarker struct {
+	Lat, Lon float64
+	// Icon is drawn centered on the coordinates, a dot of the primary color if nil.
+	Icon     fyne.Resource
+	OnTapped func() `json:"-"`
+
+	view *mapMarkerView
+}
+
+// AddMarker adds a marker at a latitude and longitude, in degrees, with an icon and a function called
+// when it is tapped, either of which may be nil. Markers that are close at the current zoom level are
+// clustered, unless disabled with WithMarkerClustering, and tapping a cluster zooms in to its markers.
+func (m *Map) AddMarker(lat, lon float64, icon fyne.Resource, onTapped func()) *MapMarker {
+	marker := &MapMarker{Lat: lat, Lon: lon, Icon: icon, OnTapped: onTapped}
+	m.markers = append(m.markers, marker)
+	m.Refresh()
+	return marker
+}
+
+// RemoveMarker removes a marker that AddMarker returned.
+func (m *Map) RemoveMarker(marker *MapMarker) {
+	for i, mk := range m.markers {
+		if mk == marker {
+			m.markers = append(m.markers[:i], m.markers[i+1:]...)
+			m.Refresh()
+			return
+		}
+	}
+}
+
+// Markers returns the markers of the map, in the order they were added.
+func (m *Map) Markers() []*MapMarker {
+	return m.markers
+}
+
+// WithMarkerClustering enables or disables the clustering of the markers that are close at the zoom level.
+func WithMarkerClustering(enable bool) MapOption {
+	return func(m *Map) {
+		m.noClustering = !enable
+	}
+}
+
+// clusterMarkers returns the markers grouped by how close they are on screen, each group with its position.
+// A marker is in the group of the first marker that is within mapClusterRadius of it.
+func (m *Map) clusterMarkers() (groups [][]*MapMarker, positions []fyne.Position) {
+	cluster := !m.noClustering && m.zoom < m.tileSource.MaxZoom()
+	for _, marker := range m.markers {
+		pos := m.LatLonToScreen(marker.Lat, marker.Lon)
+		found := false
+		if cluster {
+			for i, p := range positions {
+				if math.Hypot(float64(pos.X-p.X), float64(pos.Y-p.Y)) <= mapClusterRadius {
+					groups[i] = append(groups[i], marker)
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			groups = append(groups, []*MapMarker{marker})
+			positions = append(positions, pos)
+		}
+	}
+	return groups, positions
+}
+
+// expandCluster zooms in until the markers of a cluster are apart, centered on them.
+func (m *Map) expandCluster(markers []*MapMarker) {
+	var lat, lon float64
+	for _, marker := range markers {
+		lat += marker.Lat
+		lon += marker.Lon
+	}
+	lat, lon = lat/float64(len(markers)), lon/float64(len(markers))
+
+	for m.zoom < m.tileSource.MaxZoom() {
+		m.zoomInStep()
+		cx, cy := worldPixel(lat, lon, m.zoom)
+		spread := 0.0
+		for _, marker := range markers {
+			x, y := worldPixel(marker.Lat, marker.Lon, m.zoom)
+			spread = math.Max(spread, math.Hypot(x-cx, y-cy))
+		}
+		if spread > mapClusterRadius {
+			break
+		}
+	}
+	m.centerOnWorld(worldPixel(lat, lon, m.zoom))
+	m.Refresh()
+	m.viewportChanged()
+}
+
+// mapMarkerView shows a marker.
+type mapMarkerView struct {
+	widget.BaseWidget
+	marker *MapMarker
+}
+
+func newMapMarkerView(marker *MapMarker) *mapMarkerView {
+	v := &mapMarkerView{marker: marker}
+	v.ExtendBaseWidget(v)
+	return v
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (v *mapMarkerView) CreateRenderer() fyne.WidgetRenderer {
+	v.ExtendBaseWidget(v)
+	if v.marker.Icon != nil {
+		return widget.NewSimpleRenderer(widget.NewIcon(v.marker.Icon))
+	}
+	dot := canvas.NewCircle(theme.Color(theme.ColorNamePrimary))
+	dot.StrokeColor = theme.Color(theme.ColorNameBackground)
+	dot.StrokeWidth = 2
+	return &mapDotRenderer{dot: dot}
+}
+
+// MinSize returns the size of the marker.
+func (v *mapMarkerView) MinSize() fyne.Size {
+	return fyne.NewSquareSize(mapMarkerSize)
+}
+
+// Tapped calls the OnTapped function of the marker.
+func (v *mapMarkerView) Tapped(*fyne.PointEvent) {
+	if f := v.marker.OnTapped; f != nil {
+		f()
+	}
+}
+
+// mapClusterView shows a cluster of markers with their count.
+type mapClusterView struct {
+	widget.BaseWidget
+	m       *Map
+	markers []*MapMarker
+}
+
+func newMapClusterView(m *Map, markers []*MapMarker) *mapClusterView {
+	v := &mapClusterView{m: m, markers: markers}
+	v.ExtendBaseWidget(v)
+	return v
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (v *mapClusterView) CreateRenderer() fyne.WidgetRenderer {
+	v.ExtendBaseWidget(v)
+	dot := canvas.NewCircle(theme.Color(theme.ColorNamePrimary))
+	dot.StrokeColor = theme.Color(theme.ColorNameBackground)
+	dot.StrokeWidth = 2
+	count := canvas.NewText(strconv.Itoa(len(v.markers)), theme.Color(theme.ColorNameForegroundOnPrimary))
+	count.TextStyle.Bold = true
+	count.Alignment = fyne.TextAlignCenter
+	return &mapDotRenderer{dot: dot, label: count}
+}
+
+// MinSize returns the size of the cluster.
+func (v *mapClusterView) MinSize() fyne.Size {
+	return fyne.NewSquareSize(mapMarkerSize)
+}
+
+// Tapped zooms the map in to the markers of the cluster.
+func (v *mapClusterView) Tapped(*fyne.PointEvent) {
+	v.m.expandCluster(v.markers)
+}
+
+type mapDotRenderer struct {
+	dot   *canvas.Circle
+	label *canvas.Text
+}
+
+func (r *mapDotRenderer) Destroy() {
+}
+
+func (r *mapDotRenderer) Layout(s fyne.Size) {
+	r.dot.Resize(s)
+	if r.label != nil {
+		min := r.label.MinSize()
+		r.label.Move(fyne.NewPos(0, (s.Height-min.Height)/2))
+		r.label.Resize(fyne.NewSize(s.Width, min.Height))
+	}
+}
+
+func (r *mapDotRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(mapMarkerSize)
+}
+
+func (r *mapDotRenderer) Objects() []fyne.CanvasObject {
+	if r.label == nil {
+		return []fyne.CanvasObject{r.dot}
+	}
+	return []fyne.CanvasObject{r.dot, r.label}
+}
+
+func (r *mapDotRenderer) Refresh() {
+	r.dot.FillColor = theme.Color(theme.ColorNamePrimary)
+	r.dot.StrokeColor = theme.Color(theme.ColorNameBackground)
+	r.dot.Refresh()
+	if r.label != nil {
+		r.label.Color = theme.Color(theme.ColorNameForegroundOnPrimary)
+		r.label.Refresh()
+	}
+}
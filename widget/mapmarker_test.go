@@ -0,0 +1,92 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorldPixel(t *testing.T) {
+	x, y := worldPixel(0, 0, 0)
+	assert.InDelta(t, 128, x, 1e-9)
+	assert.InDelta(t, 128, y, 1e-9)
+	x, y = worldPixel(85.05112878, -180, 1)
+	assert.InDelta(t, 0, x, 1e-9)
+	assert.InDelta(t, 0, y, 1e-3)
+}
+
+func TestMap_MarkerAnchored(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := NewMapWithOptions(WithTileSource(""))
+	m.Resize(fyne.NewSize(400, 400))
+	marker := m.AddMarker(0, 0, theme.HomeIcon(), nil)
+	w := test.NewWindow(m)
+	defer w.Close()
+	w.Resize(fyne.NewSize(400, 400))
+	m.Resize(fyne.NewSize(400, 400))
+
+	assert.Equal(t, fyne.NewPos(200, 200), m.LatLonToScreen(marker.Lat, marker.Lon))
+	assert.Equal(t, fyne.NewPos(200-mapMarkerSize/2, 200-mapMarkerSize/2), marker.view.Position())
+
+	m.Zoom(2)
+	m.PanEast()
+	assert.Equal(t, fyne.NewPos(200-tileSize, 200), m.LatLonToScreen(marker.Lat, marker.Lon))
+	assert.Equal(t, fyne.NewPos(200-tileSize-mapMarkerSize/2, 200-mapMarkerSize/2), marker.view.Position())
+}
+
+func TestMap_MarkerTapped(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := NewMapWithOptions(WithTileSource(""))
+	tapped := false
+	marker := m.AddMarker(10, 10, nil, func() { tapped = true })
+	w := test.NewWindow(m)
+	defer w.Close()
+
+	test.Tap(marker.view)
+	assert.True(t, tapped)
+
+	m.RemoveMarker(marker)
+	assert.Empty(t, m.Markers())
+}
+
+func TestMap_MarkerClustering(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := NewMapWithOptions(WithTileSource(""))
+	w := test.NewWindow(m)
+	defer w.Close()
+	m.AddMarker(48.85, 2.35, nil, nil)
+	m.AddMarker(48.86, 2.34, nil, nil)
+	m.AddMarker(-33.86, 151.2, nil, nil)
+
+	groups, _ := m.clusterMarkers()
+	assert.Len(t, groups, 2)
+	assert.Len(t, groups[0], 2)
+
+	r := test.WidgetRenderer(m).(*mapRenderer)
+	cluster, ok := r.markers[0].(*mapClusterView)
+	assert.True(t, ok)
+	test.Tap(cluster)
+	assert.Greater(t, m.zoom, 5)
+	groups, _ = m.clusterMarkers()
+	assert.Len(t, groups, 3)
+	// the map is centered on the markers
+	pos := m.LatLonToScreen(48.855, 2.345)
+	assert.InDelta(t, m.Size().Width/2, pos.X, tileSize)
+	assert.InDelta(t, m.Size().Height/2, pos.Y, tileSize)
+
+	m = NewMapWithOptions(WithTileSource(""), WithMarkerClustering(false))
+	m.AddMarker(48.85, 2.35, nil, nil)
+	m.AddMarker(48.86, 2.34, nil, nil)
+	groups, _ = m.clusterMarkers()
+	assert.Len(t, groups, 2)
+}
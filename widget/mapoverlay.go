@@ -0,0 +1,241 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+
+	"golang.org/x/image/vector"
+)
+
+const (
+	mapOverlayStrokeWidth = 3
+	// how far from a line a tap still hits it
+	mapOverlayTapTolerance = 6
+	// the points of the polygon of a circle
+	mapCircleSteps = 64
+	// the equatorial circumference of the Earth in meters, divided by the tile size
+	mapMetersPerPixelAtZoom0 = 40075016.686 / tileSize
+)
+
+// LatLon is a geographic coordinate, in degrees.
+type LatLon struct {
+	Lat, Lon float64
+}
+
+// MapOverlayKind is the shape of a MapOverlay.
+type MapOverlayKind int
+
+const (
+	// MapPolyline is a line through the points, such as a GPS track.
+	MapPolyline MapOverlayKind = iota
+	// MapPolygon is a closed shape of the points, such as a geofence.
+	MapPolygon
+	// MapCircle is a circle of a radius around the point, such as an accuracy or search radius.
+	MapCircle
+)
+
+// MapOverlay is a shape drawn over a Map at geographic coordinates.
+type MapOverlay struct {
+	Kind MapOverlayKind
+	// Points are the vertices of a line or polygon, or the center of a circle.
+	Points []LatLon
+	// Radius is the radius of a circle, in meters.
+	Radius float64
+
+	// StrokeColor is the color of the outline, the primary color if nil.
+	StrokeColor color.Color
+	// StrokeWidth is the width of the outline, 3 if 0.
+	StrokeWidth float32
+	// FillColor fills polygons and circles, which are not filled if it is nil.
+	FillColor color.Color
+
+	OnTapped func() `json:"-"`
+}
+
+// AddPolyline adds a line through points, such as a GPS track.
+func (m *Map) AddPolyline(points []LatLon, stroke color.Color, width float32, onTapped func()) *MapOverlay {
+	return m.AddOverlay(&MapOverlay{Kind: MapPolyline, Points: points, StrokeColor: stroke, StrokeWidth: width, OnTapped: onTapped})
+}
+
+// AddPolygon adds a closed shape of points, such as a geofence.
+func (m *Map) AddPolygon(points []LatLon, stroke, fill color.Color, onTapped func()) *MapOverlay {
+	return m.AddOverlay(&MapOverlay{Kind: MapPolygon, Points: points, StrokeColor: stroke, FillColor: fill, OnTapped: onTapped})
+}
+
+// AddCircle adds a circle of a radius in meters around a point.
+func (m *Map) AddCircle(center LatLon, radius float64, stroke, fill color.Color, onTapped func()) *MapOverlay {
+	return m.AddOverlay(&MapOverlay{Kind: MapCircle, Points: []LatLon{center}, Radius: radius,
+		StrokeColor: stroke, FillColor: fill, OnTapped: onTapped})
+}
+
+// AddOverlay adds an overlay, drawn over those added before it. Call Refresh after changing an overlay.
+func (m *Map) AddOverlay(o *MapOverlay) *MapOverlay {
+	m.overlays = append(m.overlays, o)
+	m.Refresh()
+	return o
+}
+
+// RemoveOverlay removes an overlay.
+func (m *Map) RemoveOverlay(o *MapOverlay) {
+	for i, overlay := range m.overlays {
+		if overlay == o {
+			m.overlays = append(m.overlays[:i], m.overlays[i+1:]...)
+			m.Refresh()
+			return
+		}
+	}
+}
+
+// Overlays returns the overlays of the map, from the bottom to the top.
+func (m *Map) Overlays() []*MapOverlay {
+	return m.overlays
+}
+
+// Tapped calls the OnTapped function of the top overlay at the position of the tap, if any.
+func (m *Map) Tapped(ev *fyne.PointEvent) {
+	if o := m.overlayAt(ev.Position); o != nil && o.OnTapped != nil {
+		o.OnTapped()
+	}
+}
+
+// overlayAt returns the top overlay at a position of the map widget, or nil.
+func (m *Map) overlayAt(pos fyne.Position) *MapOverlay {
+	for i := len(m.overlays) - 1; i >= 0; i-- {
+		o := m.overlays[i]
+		points := m.overlayPoints(o, 1)
+		if len(points) == 0 {
+			continue
+		}
+		tolerance := o.strokeWidth()/2 + mapOverlayTapTolerance
+		closed := o.Kind != MapPolyline
+		if closed && o.FillColor != nil && insidePolygon(pos, points) {
+			return o
+		}
+		for j := 1; j < len(points); j++ {
+			if distanceToSegment(pos, points[j-1], points[j]) <= tolerance {
+				return o
+			}
+		}
+		if closed && distanceToSegment(pos, points[len(points)-1], points[0]) <= tolerance {
+			return o
+		}
+		if len(points) == 1 && distance(pos, points[0]) <= tolerance {
+			return o
+		}
+	}
+	return nil
+}
+
+// overlayPoints returns the positions of the points of an overlay on the map widget, multiplied by a scale,
+// with a circle as a polygon.
+func (m *Map) overlayPoints(o *MapOverlay, scale float32) []fyne.Position {
+	if o.Kind == MapCircle {
+		if len(o.Points) == 0 {
+			return nil
+		}
+		c := o.Points[0]
+		center := m.LatLonToScreen(c.Lat, c.Lon)
+		radius := float32(o.Radius / metersPerPixel(c.Lat, m.zoom))
+		points := make([]fyne.Position, mapCircleSteps)
+		for i := range points {
+			a := 2 * math.Pi * float64(i) / mapCircleSteps
+			points[i] = fyne.NewPos((center.X+radius*float32(math.Cos(a)))*scale, (center.Y-radius*float32(math.Sin(a)))*scale)
+		}
+		return points
+	}
+
+	points := make([]fyne.Position, len(o.Points))
+	for i, p := range o.Points {
+		pos := m.LatLonToScreen(p.Lat, p.Lon)
+		points[i] = fyne.NewPos(pos.X*scale, pos.Y*scale)
+	}
+	return points
+}
+
+// drawOverlays draws the overlays for a raster of w by h pixels.
+func (m *Map) drawOverlays(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	size := m.Size()
+	if size.Width <= 0 || len(m.overlays) == 0 {
+		return img
+	}
+	scale := float32(w) / size.Width
+	bounds := img.Bounds()
+	for _, o := range m.overlays {
+		points := m.overlayPoints(o, scale)
+		if len(points) == 0 {
+			continue
+		}
+		closed := o.Kind != MapPolyline
+		if closed && o.FillColor != nil && len(points) > 2 {
+			r := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+			r.MoveTo(points[0].X, points[0].Y)
+			for _, p := range points[1:] {
+				r.LineTo(p.X, p.Y)
+			}
+			r.ClosePath()
+			r.Draw(img, bounds, image.NewUniform(o.FillColor), image.Point{})
+		}
+
+		r := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+		radius := o.strokeWidth() * scale / 2
+		if closed {
+			points = append(points, points[0])
+		}
+		for i, p := range points {
+			addCircle(r, p, radius)
+			if i > 0 {
+				addSegment(r, points[i-1], radius, p, radius)
+			}
+		}
+		r.Draw(img, bounds, image.NewUniform(o.strokeColor()), image.Point{})
+	}
+	return img
+}
+
+func (o *MapOverlay) strokeColor() color.Color {
+	if o.StrokeColor == nil {
+		return theme.Color(theme.ColorNamePrimary)
+	}
+	return o.StrokeColor
+}
+
+func (o *MapOverlay) strokeWidth() float32 {
+	if o.StrokeWidth <= 0 {
+		return mapOverlayStrokeWidth
+	}
+	return o.StrokeWidth
+}
+
+// metersPerPixel returns the distance on the ground of a pixel at a latitude and zoom level.
+func metersPerPixel(lat float64, zoom int) float64 {
+	return mapMetersPerPixelAtZoom0 * math.Cos(lat*math.Pi/180) / float64(int(1)<<zoom)
+}
+
+// distanceToSegment returns the distance of a position to the segment from a to b.
+func distanceToSegment(p, a, b fyne.Position) float32 {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	lengthSq := dx*dx + dy*dy
+	if lengthSq == 0 {
+		return distance(p, a)
+	}
+	t := ((p.X-a.X)*dx + (p.Y-a.Y)*dy) / lengthSq
+	t = float32(math.Max(0, math.Min(1, float64(t))))
+	return distance(p, fyne.NewPos(a.X+t*dx, a.Y+t*dy))
+}
+
+// insidePolygon returns whether a position is inside a polygon, by the even-odd rule.
+func insidePolygon(p fyne.Position, polygon []fyne.Position) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
@@ -0,0 +1,91 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newOverlayTestMap() *Map {
+	m := NewMapWithOptions(WithTileSource(""))
+	m.Resize(fyne.NewSize(256, 256))
+	return m
+}
+
+func TestMap_PolylineTapped(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := newOverlayTestMap()
+	tapped := 0
+	m.AddPolyline([]LatLon{{0, -90}, {0, 90}}, nil, 0, func() { tapped++ })
+
+	// the line goes through the middle of the world map at zoom 0
+	test.TapAt(m, fyne.NewPos(128, 130))
+	assert.Equal(t, 1, tapped)
+	test.TapAt(m, fyne.NewPos(128, 160))
+	assert.Equal(t, 1, tapped)
+	test.TapAt(m, fyne.NewPos(10, 128)) // beyond the end
+	assert.Equal(t, 1, tapped)
+}
+
+func TestMap_PolygonTapped(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := newOverlayTestMap()
+	square := []LatLon{{40, -40}, {40, 40}, {-40, 40}, {-40, -40}}
+	outline := 0
+	o := m.AddPolygon(square, nil, nil, func() { outline++ })
+	filled := 0
+	m.AddPolygon(square, nil, color.NRGBA{A: 0x80}, func() { filled++ })
+
+	test.TapAt(m, fyne.NewPos(128, 128))
+	assert.Equal(t, 1, filled)
+	assert.Equal(t, 0, outline)
+
+	m.RemoveOverlay(m.Overlays()[1])
+	test.TapAt(m, fyne.NewPos(128, 128)) // an outline is not hit inside
+	assert.Equal(t, 0, outline)
+	test.TapAt(m, m.LatLonToScreen(0, 40))
+	assert.Equal(t, 1, outline)
+	assert.Equal(t, []*MapOverlay{o}, m.Overlays())
+}
+
+func TestMap_CircleTapped(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := newOverlayTestMap()
+	tapped := false
+	// a quarter of the circumference of the equator is a quarter of the map width
+	m.AddCircle(LatLon{0, 0}, 40075016.686/4, nil, color.White, func() { tapped = true })
+	test.TapAt(m, fyne.NewPos(128+60, 128))
+	assert.True(t, tapped)
+
+	tapped = false
+	test.TapAt(m, fyne.NewPos(128+75, 128))
+	assert.False(t, tapped)
+}
+
+func TestMap_DrawOverlays(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	m := newOverlayTestMap()
+	red := color.NRGBA{R: 0xff, A: 0xff}
+	m.AddPolyline([]LatLon{{0, -90}, {0, 90}}, red, 4, nil)
+	m.AddCircle(LatLon{0, 0}, 40075016.686/8, nil, color.NRGBA{B: 0xff, A: 0xff}, nil)
+
+	img := m.drawOverlays(512, 512) // a scale of 2
+	assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, img.At(140, 256))
+	assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, img.At(380, 257))
+	assert.Equal(t, color.RGBA{}, img.At(140, 280))
+	// the circle is filled above the line
+	assert.Equal(t, color.RGBA{B: 0xff, A: 0xff}, img.At(256, 230))
+	assert.Equal(t, color.RGBA{}, img.At(256, 150))
+}
@@ -0,0 +1,232 @@
+package widget
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+const (
+	// how long a search for places may take
+	mapSearchTimeout = 10 * time.Second
+	// the width of the search entry of a map
+	mapSearchWidth = 250
+	// the zoom level that a place with no bounds is shown at
+	mapSearchZoom = 16
+)
+
+// GeocodeResult is a place that a Geocoder found.
+type GeocodeResult struct {
+	// Name describes the place, such as its full address.
+	Name string
+	LatLon
+	// Bounds is the extent of the place, zero if the geocoder doesn't know it.
+	Bounds MapBounds
+}
+
+// Geocoder finds the places that match an address or the name of a place.
+type Geocoder interface {
+	// Geocode returns the places that match a query, the best match first.
+	Geocode(ctx context.Context, query string) ([]GeocodeResult, error)
+}
+
+var _ Geocoder = (*NominatimGeocoder)(nil)
+
+// NominatimGeocoder finds places in OpenStreetMap with a Nominatim service.
+// Apps that use the public service need to follow its usage policy, which requires a user agent that
+// identifies the app and at most one search a second.
+// See: https://operations.osmfoundation.org/policies/nominatim/
+type NominatimGeocoder struct {
+	// URL is the address of the search endpoint, the public service if empty.
+	URL       string
+	UserAgent string
+	// Limit is how many places are returned at most, 5 if 0.
+	Limit  int
+	Client *http.Client
+}
+
+// NewNominatimGeocoder returns a geocoder of the public Nominatim service, with the user agent of the app.
+func NewNominatimGeocoder(userAgent string) *NominatimGeocoder {
+	return &NominatimGeocoder{UserAgent: userAgent}
+}
+
+// Geocode returns the places that match a query, the best match first.
+func (g *NominatimGeocoder) Geocode(ctx context.Context, query string) ([]GeocodeResult, error) {
+	endpoint := g.URL
+	if endpoint == "" {
+		endpoint = "https://nominatim.openstreetmap.org/search"
+	}
+	limit := g.Limit
+	if limit <= 0 {
+		limit = 5
+	}
+	params := url.Values{"q": {query}, "format": {"jsonv2"}, "limit": {strconv.Itoa(limit)}}
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if g.UserAgent != "" {
+		req.Header.Set("User-Agent", g.UserAgent)
+	}
+
+	cl := g.Client
+	if cl == nil {
+		cl = http.DefaultClient
+	}
+	res, err := cl.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("geocode %q: %s", query, res.Status)
+	}
+
+	var places []struct {
+		Name        string   `json:"display_name"`
+		Lat         string   `json:"lat"`
+		Lon         string   `json:"lon"`
+		BoundingBox []string `json:"boundingbox"` // south, north, west, east
+	}
+	if err := json.NewDecoder(res.Body).Decode(&places); err != nil {
+		return nil, fmt.Errorf("invalid Nominatim response: %w", err)
+	}
+	results := make([]GeocodeResult, 0, len(places))
+	for _, p := range places {
+		lat, err1 := strconv.ParseFloat(p.Lat, 64)
+		lon, err2 := strconv.ParseFloat(p.Lon, 64)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		result := GeocodeResult{Name: p.Name, LatLon: LatLon{Lat: lat, Lon: lon}}
+		if len(p.BoundingBox) == 4 {
+			var box [4]float64
+			ok := true
+			for i, v := range p.BoundingBox {
+				if box[i], err = strconv.ParseFloat(v, 64); err != nil {
+					ok = false
+				}
+			}
+			if ok {
+				result.Bounds = MapBounds{Min: LatLon{Lat: box[0], Lon: box[2]}, Max: LatLon{Lat: box[1], Lon: box[3]}}
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// WithSearch adds a search entry to the map, which shows the places that a geocoder finds for what is typed
+// as completions, and moves the map to the one that is chosen, or to the first when Return is pressed.
+func WithSearch(geocoder Geocoder) MapOption {
+	return func(m *Map) {
+		m.search = &mapSearch{m: m, geocoder: geocoder}
+	}
+}
+
+// ShowPlace moves the map to show a place that a Geocoder found.
+func (m *Map) ShowPlace(place GeocodeResult) {
+	if place.Bounds == (MapBounds{}) {
+		m.SetCenter(place.Lat, place.Lon, mapSearchZoom)
+		return
+	}
+	m.fitBounds(place.Bounds)
+}
+
+// mapSearch finds places for the search entry of a map.
+type mapSearch struct {
+	m        *Map
+	geocoder Geocoder
+
+	lock    sync.Mutex
+	results []GeocodeResult // of the last query
+	cancel  context.CancelFunc
+}
+
+func (s *mapSearch) createEntry() fyne.CanvasObject {
+	entry := NewSearchEntry(nil)
+	entry.PlaceHolder = i18n.L("Search places")
+	entry.Suggestions = s.suggestions
+	entry.OnSubmitted = func(query string) {
+		go s.submit(query)
+	}
+	return container.NewGridWrap(fyne.NewSize(mapSearchWidth, entry.MinSize().Height), entry)
+}
+
+// geocode returns the places of a query, cancelling the search of the query before.
+func (s *mapSearch) geocode(query string) ([]GeocodeResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), mapSearchTimeout)
+	defer cancel()
+	s.lock.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.cancel = cancel
+	s.lock.Unlock()
+
+	results, err := s.geocoder.Geocode(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	s.results = results
+	s.lock.Unlock()
+	return results, nil
+}
+
+// suggestions returns the names of the places of a query.
+func (s *mapSearch) suggestions(query string) []string {
+	if query == "" {
+		return nil
+	}
+	results, err := s.geocode(query)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			fyne.LogError("Failed to search for places", err)
+		}
+		return nil
+	}
+	names := make([]string, len(results))
+	for i, r := range results {
+		names[i] = r.Name
+	}
+	return names
+}
+
+// submit shows the place that was chosen, or else the first place of the query.
+func (s *mapSearch) submit(query string) {
+	if query == "" {
+		return
+	}
+	s.lock.Lock()
+	results := s.results
+	s.lock.Unlock()
+	for _, r := range results {
+		if r.Name == query {
+			s.m.ShowPlace(r)
+			return
+		}
+	}
+
+	results, err := s.geocode(query)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			fyne.LogError("Failed to search for places", err)
+		}
+		return
+	}
+	if len(results) > 0 {
+		s.m.ShowPlace(results[0])
+	}
+}
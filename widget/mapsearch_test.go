@@ -0,0 +1,101 @@
+package widget
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNominatimGeocoder_Geocode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Big Ben", r.URL.Query().Get("q"))
+		assert.Equal(t, "jsonv2", r.URL.Query().Get("format"))
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+		assert.Equal(t, "fyne-x test", r.Header.Get("User-Agent"))
+		_, _ = w.Write([]byte(`[
+			{"display_name": "Big Ben, London", "lat": "51.5007", "lon": "-0.1246",
+			 "boundingbox": ["51.5003", "51.5011", "-0.1250", "-0.1242"]},
+			{"display_name": "Big Ben Lane", "lat": "40.1", "lon": "-75.2"},
+			{"display_name": "Broken", "lat": "north", "lon": "0"}]`))
+	}))
+	defer server.Close()
+
+	g := NewNominatimGeocoder("fyne-x test")
+	g.URL = server.URL
+	results, err := g.Geocode(context.Background(), "Big Ben")
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "Big Ben, London", results[0].Name)
+	assert.Equal(t, LatLon{Lat: 51.5007, Lon: -0.1246}, results[0].LatLon)
+	assert.Equal(t, MapBounds{Min: LatLon{Lat: 51.5003, Lon: -0.1250}, Max: LatLon{Lat: 51.5011, Lon: -0.1242}},
+		results[0].Bounds)
+	assert.Equal(t, MapBounds{}, results[1].Bounds)
+}
+
+func TestNominatimGeocoder_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	g := &NominatimGeocoder{URL: server.URL}
+	_, err := g.Geocode(context.Background(), "anywhere")
+	assert.Error(t, err)
+}
+
+type testGeocoder struct {
+	sync.Mutex
+	queries []string
+	places  []GeocodeResult
+}
+
+func (g *testGeocoder) Geocode(_ context.Context, query string) ([]GeocodeResult, error) {
+	g.Lock()
+	defer g.Unlock()
+	g.queries = append(g.queries, query)
+	return g.places, nil
+}
+
+func TestMap_Search(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	geocoder := &testGeocoder{places: []GeocodeResult{
+		{Name: "Paris, France", LatLon: LatLon{Lat: 48.8566, Lon: 2.3522}},
+		{Name: "Paris, Texas", LatLon: LatLon{Lat: 33.6609, Lon: -95.5555}},
+	}}
+	m := NewMapWithOptions(WithTileSource(""), WithSearch(geocoder))
+	m.Resize(fyne.NewSize(400, 300))
+	controls, positions := m.createControls()
+	assert.Equal(t, MapTopLeft, positions[0])
+	assert.Equal(t, float32(mapSearchWidth), controls[0].MinSize().Width)
+
+	assert.Equal(t, []string{"Paris, France", "Paris, Texas"}, m.search.suggestions("Paris"))
+	m.search.submit("Paris, Texas")
+	assert.Equal(t, []string{"Paris"}, geocoder.queries, "a suggestion is shown without searching again")
+	assert.Equal(t, mapSearchZoom, m.ZoomLevel())
+	assert.InDelta(t, 33.6609, m.Center().Lat, 1e-9)
+
+	m.search.submit("Paris")
+	assert.Equal(t, []string{"Paris", "Paris"}, geocoder.queries)
+	assert.InDelta(t, 48.8566, m.Center().Lat, 1e-9)
+}
+
+func TestMap_ShowPlace(t *testing.T) {
+	m := NewMapWithOptions(WithTileSource(""))
+	m.Resize(fyne.NewSize(400, 300))
+	m.ShowPlace(GeocodeResult{LatLon: LatLon{Lat: 51.5, Lon: -0.12},
+		Bounds: MapBounds{Min: LatLon{Lat: 51.28, Lon: -0.51}, Max: LatLon{Lat: 51.69, Lon: 0.33}}})
+	assert.Equal(t, 9, m.ZoomLevel())
+	b := m.Bounds()
+	assert.True(t, b.Contains(LatLon{Lat: 51.28, Lon: -0.51}))
+	assert.True(t, b.Contains(LatLon{Lat: 51.69, Lon: 0.33}))
+}
@@ -0,0 +1,153 @@
+package widget
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+const (
+	// how many tiles are downloaded at the same time
+	mapTileWorkers = 4
+	// tiles that load close together are drawn with one refresh
+	mapTileRefreshDelay = 50 * time.Millisecond
+)
+
+// tileKey is the position of a tile at a zoom level.
+type tileKey struct {
+	x, y, zoom int
+}
+
+// tileLoader downloads the tiles that the map wants with a few workers, those in view before those
+// that are prefetched around them, and cancels the downloads of tiles that the map no longer wants.
+type tileLoader struct {
+	source TileSource
+	cl     *http.Client
+	cache  *tileCache
+	loaded func() // called once tiles are loaded, to draw them
+
+	mu             sync.Mutex
+	cond           *sync.Cond
+	queue          []tileKey // the tiles wanted and not downloading, the most wanted first
+	active         map[tileKey]context.CancelFunc
+	started        bool
+	stopped        bool
+	refreshPending bool
+}
+
+func newTileLoader(source TileSource, cl *http.Client, cache *tileCache, loaded func()) *tileLoader {
+	l := &tileLoader{source: source, cl: cl, cache: cache, loaded: loaded, active: map[tileKey]context.CancelFunc{}}
+	l.cond = sync.NewCond(&l.mu)
+	return l
+}
+
+// want replaces the tiles to load by those in view, then those to prefetch, each in order.
+// Downloads of tiles that are in neither are cancelled.
+func (l *tileLoader) want(visible, prefetch []tileKey) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.stopped {
+		return
+	}
+
+	wanted := make(map[tileKey]bool, len(visible)+len(prefetch))
+	l.queue = l.queue[:0]
+	for _, list := range [][]tileKey{visible, prefetch} {
+		for _, key := range list {
+			if wanted[key] {
+				continue
+			}
+			wanted[key] = true
+			if _, ok := l.active[key]; !ok && !tileLoaded(l.source.TileURL(key.zoom, key.x, key.y)) {
+				l.queue = append(l.queue, key)
+			}
+		}
+	}
+	for key, cancel := range l.active {
+		if !wanted[key] {
+			cancel()
+		}
+	}
+
+	if !l.started && len(l.queue) > 0 {
+		l.started = true
+		for i := 0; i < mapTileWorkers; i++ {
+			go l.work()
+		}
+	}
+	l.cond.Broadcast()
+}
+
+// stop cancels the downloads and ends the workers.
+func (l *tileLoader) stop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.stopped = true
+	l.queue = nil
+	for _, cancel := range l.active {
+		cancel()
+	}
+	l.cond.Broadcast()
+}
+
+func (l *tileLoader) work() {
+	for {
+		l.mu.Lock()
+		for len(l.queue) == 0 && !l.stopped {
+			l.cond.Wait()
+		}
+		if l.stopped {
+			l.mu.Unlock()
+			return
+		}
+		key := l.queue[0]
+		l.queue = l.queue[1:]
+		ctx, cancel := context.WithCancel(context.Background())
+		l.active[key] = cancel
+		l.mu.Unlock()
+
+		_, err := getTile(ctx, l.source, key.x, key.y, key.zoom, l.cl, l.cache)
+		cancelled := ctx.Err() != nil
+		cancel()
+
+		l.mu.Lock()
+		delete(l.active, key)
+		l.mu.Unlock()
+		if err == nil {
+			l.tileLoaded()
+		} else if !cancelled {
+			fyne.LogError("tile fetch error", err)
+		}
+	}
+}
+
+// tileLoaded calls loaded after a short delay, once for all the tiles loaded in the meantime.
+func (l *tileLoader) tileLoaded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.refreshPending || l.stopped {
+		return
+	}
+	l.refreshPending = true
+	time.AfterFunc(mapTileRefreshDelay, func() {
+		l.mu.Lock()
+		l.refreshPending = false
+		l.mu.Unlock()
+		l.loaded()
+	})
+}
+
+// sortTilesByDistance sorts tiles by how far they are from a tile position, so the center of the view loads first.
+func sortTilesByDistance(tiles []tileKey, x, y float64) {
+	dist := func(k tileKey) float64 {
+		dx, dy := float64(k.x)+0.5-x, float64(k.y)+0.5-y
+		return dx*dx + dy*dy
+	}
+	sort.SliceStable(tiles, func(i, j int) bool {
+		return dist(tiles[i]) < dist(tiles[j])
+	})
+}
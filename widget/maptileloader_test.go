@@ -0,0 +1,117 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTileLoader_PriorityAndCancel(t *testing.T) {
+	resetTileMap()
+	tile := testTile(t, 4)
+	var lock sync.Mutex
+	var requested []string
+	cancelled := 0
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requested = append(requested, r.URL.Path)
+		lock.Unlock()
+		select {
+		case <-r.Context().Done():
+			lock.Lock()
+			cancelled++
+			lock.Unlock()
+		case <-release:
+			_, _ = w.Write(tile)
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	source := &URLTileSource{URL: server.URL + "/{z}/{x}/{y}.png"}
+	loaded := make(chan struct{}, 1)
+	l := newTileLoader(source, server.Client(), nil, func() {
+		loaded <- struct{}{}
+	})
+	defer l.stop()
+
+	visible := []tileKey{{0, 0, 3}, {1, 0, 3}, {0, 1, 3}, {1, 1, 3}}
+	l.want(visible, []tileKey{{2, 0, 3}, {2, 1, 3}})
+	requests := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]string(nil), requested...)
+	}
+	require.Eventually(t, func() bool { return len(requests()) == mapTileWorkers }, time.Second, time.Millisecond)
+	assert.ElementsMatch(t, []string{"/3/0/0.png", "/3/1/0.png", "/3/0/1.png", "/3/1/1.png"}, requests())
+
+	// the view moved, so the tiles downloading are cancelled for those now in view
+	l.want([]tileKey{{5, 5, 3}}, nil)
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return cancelled == mapTileWorkers
+	}, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool { return len(requests()) == mapTileWorkers+1 }, time.Second, time.Millisecond)
+	assert.Equal(t, "/3/5/5.png", requests()[mapTileWorkers])
+
+	release <- struct{}{}
+	select {
+	case <-loaded:
+	case <-time.After(time.Second):
+		t.Fatal("the loaded tile was not drawn")
+	}
+	assert.True(t, tileLoaded(source.TileURL(3, 5, 5)))
+	assert.False(t, tileLoaded(source.TileURL(3, 0, 0)))
+}
+
+// resetTileMap forgets the tiles loaded in memory.
+func resetTileMap() {
+	tileMapLock.Lock()
+	tileMap = map[string]image.Image{}
+	tileMapLock.Unlock()
+}
+
+func TestSortTilesByDistance(t *testing.T) {
+	tiles := []tileKey{{0, 0, 2}, {3, 3, 2}, {1, 1, 2}, {2, 1, 2}}
+	sortTilesByDistance(tiles, 1.5, 1.5)
+	assert.Equal(t, []tileKey{{1, 1, 2}, {2, 1, 2}, {0, 0, 2}, {3, 3, 2}}, tiles)
+}
+
+func TestMap_DrawFallbackTile(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+	resetTileMap()
+	defer resetTileMap()
+
+	source := &URLTileSource{URL: "http://tiles.invalid/{z}/{x}/{y}.png"}
+	m := NewMapWithOptions(WithTiles(source))
+	m.Resize(fyne.NewSize(tileSize, tileSize))
+	m.Zoom(1)
+
+	// only the tile of zoom level 0 is loaded, its top-left quarter red
+	parent := image.NewNRGBA(image.Rect(0, 0, tileSize, tileSize))
+	for x := 0; x < tileSize/2; x++ {
+		for y := 0; y < tileSize/2; y++ {
+			parent.Set(x, y, color.NRGBA{R: 0xff, A: 0xff})
+		}
+	}
+	tileMapLock.Lock()
+	tileMap[source.TileURL(0, 0, 0)] = parent
+	tileMapLock.Unlock()
+
+	img := m.draw(tileSize, tileSize)
+	assert.Equal(t, color.NRGBA{R: 0xff, A: 0xff}, color.NRGBAModel.Convert(img.At(10, 10)))
+	assert.Equal(t, color.NRGBA{}, color.NRGBAModel.Convert(img.At(tileSize-10, tileSize-10)))
+}
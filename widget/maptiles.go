@@ -0,0 +1,153 @@
+package widget
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// TileSource provides the tiles that a Map shows, such as those of a web map service.
+type TileSource interface {
+	// TileURL returns the address of the tile at x, y of a zoom level.
+	TileURL(zoom, x, y int) string
+	// Attribution returns the label and the address of the copyright notice that the tiles need.
+	Attribution() (label, url string)
+	// MaxZoom returns the highest zoom level that the source has tiles for.
+	MaxZoom() int
+	// PrepareRequest adds what the source needs to a request for a tile, such as the header of an API key.
+	PrepareRequest(req *http.Request)
+}
+
+var _ TileSource = (*URLTileSource)(nil)
+
+// URLTileSource is a tile source whose tiles are downloaded from a URL template, as most web map services have.
+type URLTileSource struct {
+	Name string
+	// URL is the template of the tile addresses, where {z}, {x} and {y} are replaced by the zoom level
+	// and the tile coordinates, and {s} by one of Subdomains.
+	// Example: "https://tile.openstreetmap.org/{z}/{x}/{y}.png"
+	URL        string
+	Subdomains []string
+
+	AttributionLabel, AttributionURL string
+	// MaxZoomLevel is the highest zoom level that the service has tiles for, 19 if 0.
+	MaxZoomLevel int
+	// Header is added to each request, for services that take an API key in a header.
+	Header http.Header
+}
+
+// OSMTiles returns the standard tiles of OpenStreetMap. Apps that use them need to follow its tile usage policy.
+// See: https://operations.osmfoundation.org/policies/tiles/
+func OSMTiles() *URLTileSource {
+	return &URLTileSource{
+		Name:             "OpenStreetMap",
+		URL:              "https://tile.openstreetmap.org/{z}/{x}/{y}.png",
+		AttributionLabel: "OpenStreetMap",
+		AttributionURL:   "https://openstreetmap.org",
+		MaxZoomLevel:     19,
+	}
+}
+
+// OpenTopoMapTiles returns the topographic tiles of OpenTopoMap, which go up to zoom level 17.
+// See: https://opentopomap.org/about
+func OpenTopoMapTiles() *URLTileSource {
+	return &URLTileSource{
+		Name:             "OpenTopoMap",
+		URL:              "https://{s}.tile.opentopomap.org/{z}/{x}/{y}.png",
+		Subdomains:       []string{"a", "b", "c"},
+		AttributionLabel: "OpenTopoMap (CC-BY-SA)",
+		AttributionURL:   "https://opentopomap.org",
+		MaxZoomLevel:     17,
+	}
+}
+
+// StamenTiles returns the tiles of a Stamen map style, such as "toner", "terrain" or "watercolor",
+// which Stadia Maps serves. The API key of Stadia Maps is sent in a header.
+// See: https://docs.stadiamaps.com/themes/
+func StamenTiles(style, apiKey string) *URLTileSource {
+	maxZoom := 20
+	if style == "watercolor" {
+		maxZoom = 16
+	}
+	header := http.Header{}
+	if apiKey != "" {
+		header.Set("Authorization", "Stadia-Auth "+apiKey)
+	}
+	return &URLTileSource{
+		Name:             "Stamen " + style,
+		URL:              "https://tiles.stadiamaps.com/tiles/stamen_" + style + "/{z}/{x}/{y}.png",
+		AttributionLabel: "Stadia Maps, Stamen Design, OpenStreetMap",
+		AttributionURL:   "https://stadiamaps.com/attribution",
+		MaxZoomLevel:     maxZoom,
+		Header:           header,
+	}
+}
+
+// CartoTiles returns the tiles of a CARTO basemap style, such as "light_all", "dark_all" or "rastertiles/voyager".
+// See: https://github.com/CartoDB/basemap-styles
+func CartoTiles(style string) *URLTileSource {
+	return &URLTileSource{
+		Name:             "CARTO " + style,
+		URL:              "https://{s}.basemaps.cartocdn.com/" + style + "/{z}/{x}/{y}.png",
+		Subdomains:       []string{"a", "b", "c", "d"},
+		AttributionLabel: "CARTO, OpenStreetMap",
+		AttributionURL:   "https://carto.com/attributions",
+		MaxZoomLevel:     20,
+	}
+}
+
+// MapboxTiles returns the tiles of a Mapbox style, such as "mapbox/streets-v12", with an access token.
+// See: https://docs.mapbox.com/api/maps/static-tiles/
+func MapboxTiles(style, accessToken string) *URLTileSource {
+	return &URLTileSource{
+		Name:             "Mapbox " + style,
+		URL:              "https://api.mapbox.com/styles/v1/" + style + "/tiles/256/{z}/{x}/{y}?access_token=" + accessToken,
+		AttributionLabel: "Mapbox, OpenStreetMap",
+		AttributionURL:   "https://www.mapbox.com/about/maps/",
+		MaxZoomLevel:     22,
+	}
+}
+
+// TileURL returns the address of the tile at x, y of a zoom level.
+func (s *URLTileSource) TileURL(zoom, x, y int) string {
+	replacements := []string{
+		"{z}", strconv.Itoa(zoom),
+		"{x}", strconv.Itoa(x),
+		"{y}", strconv.Itoa(y),
+	}
+	if len(s.Subdomains) > 0 {
+		replacements = append(replacements, "{s}", s.Subdomains[(x+y)%len(s.Subdomains)])
+	}
+	return strings.NewReplacer(replacements...).Replace(s.URL)
+}
+
+// Attribution returns the label and the address of the copyright notice that the tiles need.
+func (s *URLTileSource) Attribution() (label, url string) {
+	return s.AttributionLabel, s.AttributionURL
+}
+
+// MaxZoom returns the highest zoom level that the source has tiles for.
+func (s *URLTileSource) MaxZoom() int {
+	if s.MaxZoomLevel <= 0 {
+		return 19
+	}
+	return s.MaxZoomLevel
+}
+
+// PrepareRequest adds the header of the source to a request for a tile.
+func (s *URLTileSource) PrepareRequest(req *http.Request) {
+	for key, values := range s.Header {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+}
+
+// printfTileURL returns the URL template of a tile address written for fmt.Sprintf, with the zoom level
+// and the tile coordinates as the three %d verbs, as WithTileSource takes it.
+func printfTileURL(format string) string {
+	for _, placeholder := range []string{"{z}", "{x}", "{y}"} {
+		format = strings.Replace(format, "%d", placeholder, 1)
+	}
+	return format
+}
@@ -0,0 +1,56 @@
+package widget
+
+import (
+	"context"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLTileSource_TileURL(t *testing.T) {
+	assert.Equal(t, "https://tile.openstreetmap.org/3/4/5.png", OSMTiles().TileURL(3, 4, 5))
+	topo := OpenTopoMapTiles()
+	assert.Equal(t, "https://b.tile.opentopomap.org/3/4/6.png", topo.TileURL(3, 4, 6))
+	assert.Equal(t, "https://c.tile.opentopomap.org/3/4/7.png", topo.TileURL(3, 4, 7))
+	assert.Equal(t, "https://api.mapbox.com/styles/v1/mapbox/streets-v12/tiles/256/1/0/1?access_token=key",
+		MapboxTiles("mapbox/streets-v12", "key").TileURL(1, 0, 1))
+	assert.Equal(t, 19, (&URLTileSource{}).MaxZoom())
+}
+
+func TestWithTileSource(t *testing.T) {
+	m := NewMapWithOptions(WithTileSource("https://example.com/%d/%d/%d.png"))
+	assert.Equal(t, "https://example.com/1/2/3.png", m.tileSource.TileURL(1, 2, 3))
+	assert.Equal(t, "OpenStreetMap", m.attributionLabel)
+
+	m = NewMapWithOptions(WithTiles(CartoTiles("dark_all")))
+	assert.Equal(t, "CARTO, OpenStreetMap", m.attributionLabel)
+	assert.Equal(t, "https://carto.com/attributions", m.attributionURL)
+}
+
+func TestGetTile_Header(t *testing.T) {
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = png.Encode(w, image.NewNRGBA(image.Rect(0, 0, 1, 1)))
+	}))
+	defer server.Close()
+
+	source := StamenTiles("toner", "secret")
+	source.URL = server.URL + "/{z}/{x}/{y}.png"
+	img, err := getTile(context.Background(), source, 1, 2, 3, server.Client(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, img.Bounds().Dx())
+	assert.Equal(t, "Stadia-Auth secret", auth)
+
+	_, err = getTile(context.Background(), &URLTileSource{URL: server.URL + "/missing"}, 0, 0, 0, server.Client(), nil)
+	assert.Error(t, err)
+}
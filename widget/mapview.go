@@ -0,0 +1,105 @@
+package widget
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// MapBounds is a box of latitudes and longitudes, from its south-west corner Min to its north-east corner Max.
+type MapBounds struct {
+	Min, Max LatLon
+}
+
+// Contains returns whether a point is inside the bounds.
+func (b MapBounds) Contains(p LatLon) bool {
+	return p.Lat >= b.Min.Lat && p.Lat <= b.Max.Lat && p.Lon >= b.Min.Lon && p.Lon <= b.Max.Lon
+}
+
+// newMapBounds returns the bounds of no points, which extend sets to the smallest box that contains its points.
+func newMapBounds() MapBounds {
+	return MapBounds{Min: LatLon{Lat: math.Inf(1), Lon: math.Inf(1)}, Max: LatLon{Lat: math.Inf(-1), Lon: math.Inf(-1)}}
+}
+
+func (b *MapBounds) empty() bool {
+	return b.Min.Lat > b.Max.Lat
+}
+
+func (b *MapBounds) extend(points ...LatLon) {
+	for _, p := range points {
+		b.Min.Lat, b.Max.Lat = math.Min(b.Min.Lat, p.Lat), math.Max(b.Max.Lat, p.Lat)
+		b.Min.Lon, b.Max.Lon = math.Min(b.Min.Lon, p.Lon), math.Max(b.Max.Lon, p.Lon)
+	}
+}
+
+// LatLonToScreen returns the position of a latitude and longitude on the map widget,
+// which is outside of its size if the point is not in view.
+func (m *Map) LatLonToScreen(lat, lon float64) fyne.Position {
+	x, y := worldPixel(lat, lon, m.zoom)
+	cx, cy := m.center()
+	size := m.Size()
+	return fyne.NewPos(float32(x-cx)+size.Width/2, float32(y-cy)+size.Height/2)
+}
+
+// ScreenToLatLon returns the latitude and longitude at a position of the map widget.
+func (m *Map) ScreenToLatLon(pos fyne.Position) (lat, lon float64) {
+	cx, cy := m.center()
+	size := m.Size()
+	return worldLatLon(cx+float64(pos.X-size.Width/2), cy+float64(pos.Y-size.Height/2), m.zoom)
+}
+
+// SetCenter moves the map to be centered on a latitude and longitude, at a zoom level between 0 and
+// the maximum zoom level of the tile source.
+func (m *Map) SetCenter(lat, lon float64, zoom int) {
+	if zoom < 0 {
+		zoom = 0
+	} else if max := m.tileSource.MaxZoom(); zoom > max {
+		zoom = max
+	}
+	m.zoom = zoom
+	m.centerOnWorld(worldPixel(lat, lon, zoom))
+	m.Refresh()
+	m.viewportChanged()
+}
+
+// Center returns the latitude and longitude at the center of the map.
+func (m *Map) Center() LatLon {
+	x, y := m.center()
+	lat, lon := worldLatLon(x, y, m.zoom)
+	return LatLon{Lat: lat, Lon: lon}
+}
+
+// ZoomLevel returns the current zoom level of the map.
+func (m *Map) ZoomLevel() int {
+	return m.zoom
+}
+
+// Bounds returns the latitudes and longitudes that are in view, within those of the whole map.
+func (m *Map) Bounds() MapBounds {
+	size := m.Size()
+	north, west := m.ScreenToLatLon(fyne.NewPos(0, 0))
+	south, east := m.ScreenToLatLon(fyne.NewPos(size.Width, size.Height))
+	return MapBounds{
+		Min: LatLon{Lat: south, Lon: math.Max(-180, west)},
+		Max: LatLon{Lat: north, Lon: math.Min(180, east)},
+	}
+}
+
+// Resize sets a new size for the map, calling OnViewportChanged as more or less of it is in view.
+func (m *Map) Resize(size fyne.Size) {
+	if size == m.Size() {
+		return
+	}
+	m.BaseWidget.Resize(size)
+	m.viewportChanged()
+}
+
+// worldLatLon returns the latitude and longitude of a position in the pixels of the whole map at a zoom level,
+// the inverse of worldPixel.
+func worldLatLon(x, y float64, zoom int) (lat, lon float64) {
+	size := float64(int(1)<<zoom) * tileSize
+	lon = x/size*360 - 180
+	y = math.Max(0, math.Min(size, y))
+	lat = math.Atan(math.Sinh(math.Pi*(1-2*y/size))) * 180 / math.Pi
+	return lat, lon
+}
@@ -0,0 +1,94 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap_SetCenter(t *testing.T) {
+	m := NewMap()
+	m.Resize(fyne.NewSize(400, 300))
+	m.SetCenter(48.8566, 2.3522, 12)
+
+	assert.Equal(t, 12, m.ZoomLevel())
+	center := m.Center()
+	assert.InDelta(t, 48.8566, center.Lat, 1e-9)
+	assert.InDelta(t, 2.3522, center.Lon, 1e-9)
+	pos := m.LatLonToScreen(48.8566, 2.3522)
+	assert.InDelta(t, 200, pos.X, 1e-3)
+	assert.InDelta(t, 150, pos.Y, 1e-3)
+
+	m.SetCenter(0, 0, 55)
+	assert.Equal(t, m.tileSource.MaxZoom(), m.ZoomLevel())
+}
+
+func TestMap_ScreenToLatLon(t *testing.T) {
+	m := NewMap()
+	m.Resize(fyne.NewSize(400, 300))
+	m.SetCenter(-33.8688, 151.2093, 9)
+
+	for _, pos := range []fyne.Position{{X: 0, Y: 0}, {X: 123, Y: 45}, {X: 400, Y: 300}} {
+		lat, lon := m.ScreenToLatLon(pos)
+		back := m.LatLonToScreen(lat, lon)
+		assert.InDelta(t, pos.X, back.X, 1e-3)
+		assert.InDelta(t, pos.Y, back.Y, 1e-3)
+	}
+
+	lat, lon := m.ScreenToLatLon(fyne.NewPos(200+tileSize, 150))
+	assert.InDelta(t, -33.8688, lat, 1e-9)
+	assert.InDelta(t, 151.2093+360.0/(1<<9), lon, 1e-9)
+}
+
+func TestMap_Bounds(t *testing.T) {
+	m := NewMap()
+	m.Resize(fyne.NewSize(400, 300))
+	m.SetCenter(51.5074, -0.1278, 10)
+
+	b := m.Bounds()
+	assert.Less(t, b.Min.Lat, 51.5074)
+	assert.Greater(t, b.Max.Lat, 51.5074)
+	assert.InDelta(t, 400.0/(tileSize<<10)*360, b.Max.Lon-b.Min.Lon, 1e-9)
+	assert.True(t, b.Contains(LatLon{Lat: 51.5074, Lon: -0.1278}))
+	assert.False(t, b.Contains(LatLon{Lat: 48.8566, Lon: 2.3522}))
+
+	m.SetCenter(0, 0, 0)
+	b = m.Bounds()
+	assert.Equal(t, -180.0, b.Min.Lon)
+	assert.Equal(t, 180.0, b.Max.Lon)
+	assert.InDelta(t, 85.05112878, b.Max.Lat, 1e-6)
+}
+
+func TestMap_OnViewportChanged(t *testing.T) {
+	m := NewMap()
+	changes := 0
+	m.OnViewportChanged = func() {
+		changes++
+	}
+
+	m.Resize(fyne.NewSize(400, 300))
+	assert.Equal(t, 1, changes)
+	m.Resize(fyne.NewSize(400, 300))
+	assert.Equal(t, 1, changes)
+
+	m.ZoomIn()
+	m.PanEast()
+	m.SetCenter(10, 10, 5)
+	assert.Equal(t, 4, changes)
+}
+
+func TestMap_ZoomKeepsCenter(t *testing.T) {
+	m := NewMap()
+	m.Resize(fyne.NewSize(400, 300))
+	m.SetCenter(40.7128, -74.0060, 8)
+
+	m.ZoomIn()
+	m.ZoomOut()
+	m.ZoomOut()
+	center := m.Center()
+	assert.Equal(t, 7, m.ZoomLevel())
+	assert.InDelta(t, 40.7128, center.Lat, 1e-9)
+	assert.InDelta(t, -74.0060, center.Lon, 1e-9)
+}
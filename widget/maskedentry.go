@@ -0,0 +1,323 @@
+package widget
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*MaskedEntry)(nil)
+var _ fyne.Focusable = (*MaskedEntry)(nil)
+
+var errMaskIncomplete = errors.New("incomplete")
+
+// Masks for common patterns of a MaskedEntry.
+const (
+	MaskPhoneUS    = "(999) 999-9999"
+	MaskCreditCard = "9999 9999 9999 9999"
+	MaskMAC        = "HH:HH:HH:HH:HH:HH"
+	MaskDate       = "9999-99-99"
+	MaskTime       = "99:99"
+)
+
+// MaskedEntry is an entry for text that follows a pattern, such as a phone number or a MAC address.
+// The Mask is made of these characters, which stand for one typed character each, and literals:
+//
+//	9  a digit
+//	a  a letter
+//	A  a letter, made upper case
+//	*  a letter or a digit
+//	h  a hexadecimal digit
+//	H  a hexadecimal digit, made upper case
+//	\  makes the next character a literal, such as \9
+//
+// The literals are inserted while typing, the positions that are not typed yet show the PlaceholderChar,
+// and pasted text is fitted to the mask, skipping the characters that don't fit. If it has more characters
+// than the mask, those at the start are left out, such as the country code of a phone number.
+type MaskedEntry struct {
+	widget.Entry
+
+	// Mask is the pattern of the text. Call SetValue after changing it.
+	Mask string
+	// PlaceholderChar is shown in the positions that are not typed yet, '_' if 0.
+	PlaceholderChar rune
+}
+
+// NewMaskedEntry returns an entry for text that follows a mask, such as MaskPhoneUS or "99.99.99".
+func NewMaskedEntry(mask string) *MaskedEntry {
+	e := &MaskedEntry{Mask: mask}
+	e.ExtendBaseWidget(e)
+	e.Validator = func(string) error {
+		if e.Value() != "" && !e.Complete() {
+			return errMaskIncomplete
+		}
+		return nil
+	}
+	e.SetPlaceHolder(e.render(nil, true))
+	return e
+}
+
+// Complete returns whether every position of the mask is typed.
+func (e *MaskedEntry) Complete() bool {
+	return len([]rune(e.Value())) == e.slots()
+}
+
+// FocusGained shows the mask with placeholders when the entry is empty.
+//
+// Implements: fyne.Focusable
+func (e *MaskedEntry) FocusGained() {
+	if e.Text == "" {
+		e.Entry.SetText(e.render(nil, true))
+		e.CursorColumn = e.cursorAfter(0)
+		e.Refresh()
+	}
+	e.Entry.FocusGained()
+}
+
+// FocusLost empties the entry if nothing is typed.
+//
+// Implements: fyne.Focusable
+func (e *MaskedEntry) FocusLost() {
+	if e.Value() == "" {
+		e.Entry.SetText("")
+	}
+	e.Entry.FocusLost()
+}
+
+// SetText fits a text to the mask and shows it.
+func (e *MaskedEntry) SetText(text string) {
+	e.SetValue(text)
+}
+
+// SetValue shows the characters of a value in the positions of the mask, skipping literals and the
+// characters that don't fit, so that "5551234567" is shown as "(555) 123-4567" with MaskPhoneUS.
+func (e *MaskedEntry) SetValue(value string) {
+	typed, _, _ := e.extract(value, 0)
+	e.Entry.SetText(e.render(typed, len(typed) > 0))
+}
+
+// TypedKey fits the text to the mask again after a key such as Backspace changes it.
+//
+// Implements: fyne.Focusable
+func (e *MaskedEntry) TypedKey(key *fyne.KeyEvent) {
+	// delete the typed character next to the literals at the cursor, rather than a literal
+	tokens := e.tokens()
+	if e.SelectedText() == "" && len([]rune(e.Text)) == len(tokens) {
+		switch key.Name {
+		case fyne.KeyBackspace:
+			for e.CursorColumn > 0 && tokens[e.CursorColumn-1].class == 0 {
+				e.CursorColumn--
+			}
+		case fyne.KeyDelete:
+			for e.CursorColumn < len(tokens) && tokens[e.CursorColumn].class == 0 {
+				e.CursorColumn++
+			}
+		}
+	}
+	e.Entry.TypedKey(key)
+	e.normalize()
+}
+
+// TypedRune inserts a character at the cursor if it fits the mask there.
+//
+// Implements: fyne.Focusable
+func (e *MaskedEntry) TypedRune(r rune) {
+	if e.Complete() && e.SelectedText() == "" {
+		return
+	}
+	e.Entry.TypedRune(r)
+	e.normalize()
+}
+
+// TypedShortcut fits pasted text to the mask, and the text to the mask again after it is cut.
+//
+// Implements: fyne.Shortcutable
+func (e *MaskedEntry) TypedShortcut(shortcut fyne.Shortcut) {
+	if paste, ok := shortcut.(*fyne.ShortcutPaste); ok && (e.Value() == "" || e.SelectedText() == e.Text) {
+		// fit the whole of the text from the start of the mask, to match its literals such as a country code
+		e.SetValue(e.trimOverflow(paste.Clipboard.Content()))
+		e.CursorColumn = e.cursorAfter(len([]rune(e.Value())))
+		e.Refresh()
+		return
+	}
+	e.Entry.TypedShortcut(shortcut)
+	e.normalize()
+}
+
+// Value returns the characters that are typed, without the literals of the mask.
+func (e *MaskedEntry) Value() string {
+	typed, _, _ := e.extract(e.Text, 0)
+	return string(typed)
+}
+
+// normalize fits the text to the mask after an edit, keeping the cursor after the same typed character.
+func (e *MaskedEntry) normalize() {
+	typed, before, _ := e.extract(e.Text, e.CursorColumn)
+	text := e.render(typed, true)
+	if text == e.Text {
+		return
+	}
+	e.Entry.SetText(text)
+	e.CursorColumn = e.cursorAfter(before)
+	e.Refresh()
+}
+
+// maskToken is a position of a mask: a literal or a class of characters that can be typed.
+type maskToken struct {
+	literal rune
+	class   rune // 0 for a literal
+}
+
+func (e *MaskedEntry) tokens() []maskToken {
+	var tokens []maskToken
+	escaped := false
+	for _, r := range e.Mask {
+		switch {
+		case escaped:
+			tokens = append(tokens, maskToken{literal: r})
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case strings.ContainsRune("9aA*hH", r):
+			tokens = append(tokens, maskToken{class: r})
+		default:
+			tokens = append(tokens, maskToken{literal: r})
+		}
+	}
+	return tokens
+}
+
+// slots returns how many characters can be typed.
+func (e *MaskedEntry) slots() int {
+	n := 0
+	for _, t := range e.tokens() {
+		if t.class != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func (e *MaskedEntry) placeholder() rune {
+	if e.PlaceholderChar == 0 {
+		return '_'
+	}
+	return e.PlaceholderChar
+}
+
+// extract returns the characters of a text that fit the positions of the mask in order, how many of them
+// come before a column of the text, and whether letters or digits are left over once the mask is full.
+func (e *MaskedEntry) extract(text string, column int) (typed []rune, before int, overflow bool) {
+	tokens := e.tokens()
+	i := 0
+	for col, r := range []rune(text) {
+		if col == column {
+			before = len(typed)
+		}
+		// a literal of the mask at this position, or a later one, is not typed
+		matched := false
+		for j := i; j < len(tokens); j++ {
+			if tokens[j].class != 0 {
+				break
+			}
+			if tokens[j].literal == r {
+				i, matched = j+1, true
+				break
+			}
+		}
+		if matched || r == e.placeholder() {
+			continue
+		}
+
+		for i < len(tokens) && tokens[i].class == 0 {
+			i++
+		}
+		if i == len(tokens) {
+			overflow = unicode.IsLetter(r) || unicode.IsDigit(r)
+			break
+		}
+		if c, ok := maskAccepts(tokens[i].class, r); ok {
+			typed = append(typed, c)
+			i++
+		}
+	}
+	if column >= len([]rune(text)) {
+		before = len(typed)
+	}
+	return typed, before, overflow
+}
+
+// trimOverflow drops characters from the start of a text that has more than fit the mask, so that a
+// pasted prefix such as a country code is left out rather than the end of the text.
+func (e *MaskedEntry) trimOverflow(text string) string {
+	runes := []rune(text)
+	for len(runes) > 0 {
+		if _, _, overflow := e.extract(string(runes), 0); !overflow {
+			break
+		}
+		runes = runes[1:]
+	}
+	return string(runes)
+}
+
+// render returns the text of the typed characters in the mask, with placeholders after them
+// if placeholders is set, or else ending after the last typed character.
+func (e *MaskedEntry) render(typed []rune, placeholders bool) string {
+	var b strings.Builder
+	n := 0
+	for _, t := range e.tokens() {
+		switch {
+		case t.class == 0:
+			if !placeholders && n == len(typed) {
+				return b.String()
+			}
+			b.WriteRune(t.literal)
+		case n < len(typed):
+			b.WriteRune(typed[n])
+			n++
+		case placeholders:
+			b.WriteRune(e.placeholder())
+		default:
+			return b.String()
+		}
+	}
+	return b.String()
+}
+
+// cursorAfter returns the column after a number of typed characters, and after the literals that follow them.
+func (e *MaskedEntry) cursorAfter(typed int) int {
+	n := 0
+	for col, t := range e.tokens() {
+		if t.class == 0 {
+			continue
+		}
+		if n == typed {
+			return col
+		}
+		n++
+	}
+	return len(e.tokens())
+}
+
+// maskAccepts returns whether a character can be typed in a position of a class, and the character to show.
+func maskAccepts(class, r rune) (rune, bool) {
+	switch class {
+	case '9':
+		return r, unicode.IsDigit(r)
+	case 'a':
+		return r, unicode.IsLetter(r)
+	case 'A':
+		return unicode.ToUpper(r), unicode.IsLetter(r)
+	case '*':
+		return r, unicode.IsLetter(r) || unicode.IsDigit(r)
+	case 'h':
+		return r, strings.ContainsRune("0123456789abcdefABCDEF", r)
+	case 'H':
+		return unicode.ToUpper(r), strings.ContainsRune("0123456789abcdefABCDEF", r)
+	}
+	return r, false
+}
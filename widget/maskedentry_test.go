@@ -0,0 +1,85 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskedEntry_Type(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewMaskedEntry(MaskPhoneUS)
+	assert.Equal(t, "(___) ___-____", e.PlaceHolder)
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	w.Canvas().Focus(e)
+	assert.Equal(t, "(___) ___-____", e.Text)
+	assert.Equal(t, 1, e.CursorColumn)
+
+	test.Type(e, "555x12")
+	assert.Equal(t, "(555) 12_-____", e.Text)
+	assert.Equal(t, 8, e.CursorColumn)
+	assert.Equal(t, "55512", e.Value())
+	assert.False(t, e.Complete())
+	assert.Error(t, e.Validate())
+
+	test.Type(e, "34567890")
+	assert.Equal(t, "(555) 123-4567", e.Text)
+	assert.True(t, e.Complete())
+	assert.NoError(t, e.Validate())
+
+	e.CursorColumn = 10
+	e.TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	assert.Equal(t, "(555) 124-567_", e.Text, "the literal is skipped and the rest moves back")
+	assert.Equal(t, 8, e.CursorColumn)
+
+	e.SetText("")
+	w.Canvas().Unfocus()
+	assert.Equal(t, "", e.Text)
+}
+
+func TestMaskedEntry_Paste(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewMaskedEntry("+1 " + MaskPhoneUS)
+	w := test.NewWindow(e)
+	defer w.Close()
+	w.Canvas().Focus(e)
+
+	clipboard := w.Clipboard()
+	clipboard.SetContent("1-555-123-4567")
+	e.TypedShortcut(&fyne.ShortcutPaste{Clipboard: clipboard})
+	assert.Equal(t, "+1 (555) 123-4567", e.Text)
+	assert.Equal(t, "5551234567", e.Value())
+
+	// a prefix that the mask doesn't have is left out rather than the end of the number
+	e = NewMaskedEntry(MaskPhoneUS)
+	w.SetContent(e)
+	w.Canvas().Focus(e)
+	clipboard.SetContent("+1 (555) 987-6543")
+	e.TypedShortcut(&fyne.ShortcutPaste{Clipboard: clipboard})
+	assert.Equal(t, "(555) 987-6543", e.Text)
+	assert.Equal(t, "5559876543", e.Value())
+}
+
+func TestMaskedEntry_Classes(t *testing.T) {
+	e := NewMaskedEntry(MaskMAC)
+	e.SetValue("00-1a-2b-3c-4d-5e")
+	assert.Equal(t, "00:1A:2B:3C:4D:5E", e.Text)
+
+	e = NewMaskedEntry(`aa\9-*`)
+	e.PlaceholderChar = '#'
+	e.SetValue("ab")
+	assert.Equal(t, "ab9-#", e.Text)
+	e.SetValue("ab9-7")
+	assert.Equal(t, "ab7", e.Value())
+
+	e.SetValue("")
+	assert.Equal(t, "", e.Text)
+}
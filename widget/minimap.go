@@ -0,0 +1,250 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"golang.org/x/image/vector"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Minimap)(nil)
+var _ fyne.Draggable = (*Minimap)(nil)
+var _ fyne.Scrollable = (*Minimap)(nil)
+var _ fyne.Tappable = (*Minimap)(nil)
+
+// Minimap shows a scaled-down overview of the content of a vertical scroll container, like the minimap of
+// a code editor, with an indicator of the part that is visible. Tapping or dragging scrolls to that part.
+// Text is drawn as bars and images as blocks. Widgets are drawn from a renderer of their own, so the
+// overview changes with them only when the content changes size or Redraw is called.
+type Minimap struct {
+	widget.BaseWidget
+
+	scroll *container.Scroll
+	shapes []minimapShape
+	drawn  fyne.Size // size of the content when its shapes were collected
+}
+
+// NewMinimap returns a minimap of the content of scroll.
+// Any existing OnScrolled callback of the scroll container is still called.
+func NewMinimap(scroll *container.Scroll) *Minimap {
+	m := &Minimap{scroll: scroll}
+	previous := scroll.OnScrolled
+	scroll.OnScrolled = func(offset fyne.Position) {
+		m.Refresh()
+		if previous != nil {
+			previous(offset)
+		}
+	}
+	m.ExtendBaseWidget(m)
+	return m
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (m *Minimap) CreateRenderer() fyne.WidgetRenderer {
+	m.ExtendBaseWidget(m)
+	r := &minimapRenderer{minimap: m, viewport: canvas.NewRectangle(color.Transparent)}
+	r.overview = canvas.NewRaster(r.draw)
+	r.Refresh()
+	return r
+}
+
+// DragEnd is called when a drag of the viewport indicator ends.
+//
+// Implements: fyne.Draggable
+func (m *Minimap) DragEnd() {
+}
+
+// Dragged moves the viewport indicator with the pointer, scrolling the content.
+//
+// Implements: fyne.Draggable
+func (m *Minimap) Dragged(ev *fyne.DragEvent) {
+	m.scrollTo(ev.Position.Y)
+}
+
+// Redraw collects the overview of the content again, after it changes.
+func (m *Minimap) Redraw() {
+	m.drawn = fyne.Size{}
+	m.Refresh()
+}
+
+// Scrolled passes scroll events to the scroll container.
+//
+// Implements: fyne.Scrollable
+func (m *Minimap) Scrolled(ev *fyne.ScrollEvent) {
+	m.scroll.Scrolled(ev)
+}
+
+// Tapped centres the viewport indicator on the tapped position, scrolling the content.
+//
+// Implements: fyne.Tappable
+func (m *Minimap) Tapped(ev *fyne.PointEvent) {
+	m.scrollTo(ev.Position.Y)
+}
+
+// geometry returns the scale of the overview and how far it is shifted up to keep the viewport in view.
+func (m *Minimap) geometry() (scale, shift float32) {
+	content := m.scroll.Content.Size()
+	if content.Width <= 0 {
+		return 0, 0
+	}
+	scale = m.Size().Width / content.Width
+	scrollRange := content.Height - m.scroll.Size().Height
+	maxShift := content.Height*scale - m.Size().Height
+	if scrollRange <= 0 || maxShift <= 0 {
+		return scale, 0
+	}
+	return scale, maxShift * m.scroll.Offset.Y / scrollRange
+}
+
+// scrollTo scrolls so that the viewport indicator is centred on y.
+func (m *Minimap) scrollTo(y float32) {
+	s := m.scroll
+	content := s.Content.Size()
+	scrollRange := content.Height - s.Size().Height
+	if scrollRange <= 0 || content.Width <= 0 {
+		return
+	}
+	scale := m.Size().Width / content.Width
+	maxShift := fyne.Max(content.Height*scale-m.Size().Height, 0)
+	// the indicator moves by the scale of the overview less its shift, for each unit of offset
+	rate := scale - maxShift/scrollRange
+	if rate <= 0 {
+		return
+	}
+	offset := (y - s.Size().Height*scale/2) / rate
+	offset = fyne.Max(0, fyne.Min(offset, scrollRange))
+	if offset == s.Offset.Y {
+		return
+	}
+	s.Offset.Y = offset
+	s.Refresh()
+	if f := s.OnScrolled; f != nil {
+		f(s.Offset)
+	}
+}
+
+// minimapShape is a primitive of the content, in content coordinates, simplified for the overview.
+type minimapShape struct {
+	pos   fyne.Position
+	size  fyne.Size
+	color color.Color
+	line  bool // drawn from pos to pos+size, instead of filled
+}
+
+// collect walks the visible objects of the content, widgets through a renderer of their own.
+func (m *Minimap) collect() {
+	m.shapes = nil
+	content := m.scroll.Content
+	m.drawn = content.Size()
+	// the content is moved by the scroll offset, which the overview does not follow
+	walkPrimitives(content, fyne.NewPos(0, 0).Subtract(content.Position()), m.collectObject)
+}
+
+func (m *Minimap) collectObject(o fyne.CanvasObject, pos fyne.Position) {
+	size := o.Size()
+	add := func(c color.Color) {
+		if c == nil {
+			return
+		}
+		if _, _, _, a := c.RGBA(); a == 0 {
+			return
+		}
+		m.shapes = append(m.shapes, minimapShape{pos: pos, size: size, color: c})
+	}
+
+	switch o := o.(type) {
+	case *canvas.Text:
+		width := fyne.Min(o.MinSize().Width, size.Width)
+		m.shapes = append(m.shapes, minimapShape{pos: pos.AddXY(0, size.Height/4),
+			size: fyne.NewSize(width, size.Height/2), color: ledDim(o.Color, 0x99)})
+	case *canvas.Rectangle:
+		add(o.FillColor)
+	case *canvas.Circle:
+		add(o.FillColor)
+	case *canvas.Line:
+		if o.StrokeColor != nil {
+			// the ends of a line are relative to its parent, like its position
+			m.shapes = append(m.shapes, minimapShape{pos: pos.Subtract(o.Position()).Add(o.Position1),
+				size:  fyne.NewSize(o.Position2.X-o.Position1.X, o.Position2.Y-o.Position1.Y),
+				color: o.StrokeColor, line: true})
+		}
+	case *canvas.Image, *canvas.Raster, *canvas.LinearGradient, *canvas.RadialGradient:
+		add(ledDim(theme.Color(theme.ColorNamePlaceHolder), 0x40))
+	}
+}
+
+type minimapRenderer struct {
+	minimap  *Minimap
+	overview *canvas.Raster
+	viewport *canvas.Rectangle
+}
+
+func (r *minimapRenderer) Destroy() {
+}
+
+func (r *minimapRenderer) Layout(size fyne.Size) {
+	r.overview.Resize(size)
+	m := r.minimap
+	scale, shift := m.geometry()
+	r.viewport.Resize(fyne.NewSize(size.Width, m.scroll.Size().Height*scale))
+	r.viewport.Move(fyne.NewPos(0, m.scroll.Offset.Y*scale-shift))
+}
+
+func (r *minimapRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(theme.IconInlineSize()*3, theme.IconInlineSize())
+}
+
+func (r *minimapRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.overview, r.viewport}
+}
+
+func (r *minimapRenderer) Refresh() {
+	m := r.minimap
+	if m.scroll.Content.Size() != m.drawn {
+		m.collect()
+	}
+	r.viewport.FillColor = theme.Color(theme.ColorNameHover)
+	r.viewport.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	r.viewport.StrokeWidth = theme.InputBorderSize()
+	r.Layout(m.Size())
+	r.overview.Refresh()
+	r.viewport.Refresh()
+}
+
+func (r *minimapRenderer) draw(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	m := r.minimap
+	size := m.Size()
+	if size.Width <= 0 {
+		return img
+	}
+	scale, shift := m.geometry()
+	pixels := float32(w) / size.Width
+	scale *= pixels
+	shift *= pixels
+
+	for _, s := range m.shapes {
+		x, y := s.pos.X*scale, s.pos.Y*scale-shift
+		if s.line {
+			v := vector.NewRasterizer(w, h)
+			addSegment(v, fyne.NewPos(x, y), pixels/2, fyne.NewPos(x+s.size.Width*scale, y+s.size.Height*scale), pixels/2)
+			v.Draw(img, img.Bounds(), image.NewUniform(s.color), image.Point{})
+			continue
+		}
+		rect := image.Rect(int(x), int(y), int(x+fyne.Max(s.size.Width*scale, 1)), int(y+fyne.Max(s.size.Height*scale, 1)))
+		if !rect.Overlaps(img.Bounds()) {
+			continue
+		}
+		draw.Draw(img, rect, image.NewUniform(s.color), image.Point{}, draw.Over)
+	}
+	return img
+}
@@ -0,0 +1,57 @@
+package widget
+
+import (
+	"strconv"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinimap(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	lines := container.NewVBox()
+	for i := 0; i < 100; i++ {
+		lines.Add(widget.NewLabel("Line " + strconv.Itoa(i)))
+	}
+	scroll := container.NewVScroll(lines)
+	w := test.NewWindow(scroll)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(200, 400))
+	var scrolled fyne.Position
+	scroll.OnScrolled = func(p fyne.Position) { scrolled = p }
+
+	m := NewMinimap(scroll)
+	m.Resize(fyne.NewSize(50, 400))
+	r := test.WidgetRenderer(m).(*minimapRenderer)
+	assert.Len(t, m.shapes, 100)
+	assert.Equal(t, theme.InnerPadding(), m.shapes[0].pos.X)
+	scale, shift := m.geometry()
+	assert.Equal(t, float32(.25), scale)
+	assert.Zero(t, shift)
+	assert.Equal(t, float32(100), r.viewport.Size().Height)
+	assert.Zero(t, r.viewport.Position().Y)
+	img := r.draw(50, 400)
+	bar := m.shapes[0]
+	_, _, _, a := img.At(int(bar.pos.X*scale)+1, int((bar.pos.Y+bar.size.Height/2)*scale)).RGBA()
+	assert.NotZero(t, a)
+
+	m.Tapped(&fyne.PointEvent{Position: fyne.NewPos(25, 250)})
+	assert.NotZero(t, scroll.Offset.Y)
+	assert.Equal(t, scroll.Offset, scrolled)
+	// the overview is taller than the minimap, so the indicator moves less than the content
+	assert.InDelta(t, 250, r.viewport.Position().Y+r.viewport.Size().Height/2, 1e-3)
+	_, shift = m.geometry()
+	assert.NotZero(t, shift)
+
+	m.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(25, -50)}})
+	assert.Zero(t, scroll.Offset.Y)
+	assert.Zero(t, r.viewport.Position().Y)
+}
@@ -1,13 +1,30 @@
 package widget
 
 import (
+	"errors"
+	"math"
 	"strconv"
+	"strings"
+	"unicode"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/driver/mobile"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
 )
 
+// Declare conformity with interfaces
+var _ fyne.Tappable = (*numericalSpinner)(nil)
+var _ fyne.Scrollable = (*numericalSpinner)(nil)
+var _ binding.String = (*numericalString)(nil)
+
+// the steps that the page up and page down keys move by
+const numericalPageSteps = 10
+
 // NumericalEntry is an extended entry that only allows numerical input.
 // Only integers are allowed by default. Support for floats can be enabled by setting AllowFloat.
 type NumericalEntry struct {
@@ -15,6 +32,26 @@ type NumericalEntry struct {
 	AllowFloat bool
 	// AllowNegative determines if negative numbers can be entered.
 	AllowNegative bool
+	// AllowScientific allows floats to be entered with an exponent, such as 1.5e-3.
+	AllowScientific bool
+	// Localized shows values with the thousands and decimal separators of the locale, such as 1.234,5 in German.
+	Localized bool
+
+	// Precision is how many decimals floats are shown with after a step, or as many as needed if 0.
+	Precision int
+	// Min and Max are the smallest and largest values, which the value is clamped to when the entry
+	// loses focus or steps. There is no limit if they are equal.
+	Min, Max float64
+	// Increment is how much a step changes the value, 1 if 0.
+	Increment float64
+	// ShowSpinner shows up and down buttons in the entry that step the value, and step it when scrolled over.
+	// It must be set before the entry is shown.
+	ShowSpinner bool
+
+	prefix, suffix string // shown around the value when the entry isn't focused
+	focused        bool
+	value          float64 // as set, which may have more decimals than are shown
+	shown          string  // the text that value was formatted to
 }
 
 // NewNumericalEntry returns an extended entry that only allows numerical input.
@@ -24,6 +61,147 @@ func NewNumericalEntry() *NumericalEntry {
 	return entry
 }
 
+// NewSpinEntry returns a numerical entry with spinner buttons, for values from min to max that step by step.
+// Floats are allowed if the step or min have decimals, and shown with as many decimals as the step.
+func NewSpinEntry(min, max, step float64) *NumericalEntry {
+	entry := &NumericalEntry{Min: min, Max: max, Increment: step, ShowSpinner: true, AllowNegative: min < 0}
+	entry.AllowFloat = step != math.Trunc(step) || min != math.Trunc(min)
+	if s := strconv.FormatFloat(step, 'f', -1, 64); strings.Contains(s, ".") {
+		entry.Precision = len(s) - strings.IndexByte(s, '.') - 1
+	}
+	entry.ExtendBaseWidget(entry)
+	entry.SetValue(min)
+	return entry
+}
+
+// BindFloat connects the value of the entry to a data source, formatted like the entry formats values.
+// A Validator set before binding is kept, after the check that the text is a number.
+func (e *NumericalEntry) BindFloat(data binding.Float) {
+	e.bindNumber(&numericalString{entry: e, item: data, get: data.Get, set: data.Set})
+}
+
+// BindInt connects the value of the entry to a data source of an integer.
+// A Validator set before binding is kept, after the check that the text is a number.
+func (e *NumericalEntry) BindInt(data binding.Int) {
+	e.bindNumber(&numericalString{entry: e, item: data,
+		get: func() (float64, error) {
+			v, err := data.Get()
+			return float64(v), err
+		},
+		set: func(v float64) error {
+			return data.Set(int(math.Round(v)))
+		}})
+}
+
+// bindNumber binds the entry to a number, adding the listener of the entry once Bind has returned,
+// as Entry.Bind sets the validator after adding it and the listener is called on another goroutine.
+func (e *NumericalEntry) bindNumber(s *numericalString) {
+	validator := e.Validator
+	e.Bind(s)
+	if converted := e.Validator; validator != nil {
+		e.Validator = func(text string) error {
+			if err := converted(text); err != nil {
+				return err
+			}
+			return validator(text)
+		}
+	}
+	s.bound = true
+	for _, l := range s.pending {
+		s.item.AddListener(l)
+	}
+	s.pending = nil
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (e *NumericalEntry) CreateRenderer() fyne.WidgetRenderer {
+	e.ExtendBaseWidget(e)
+	if e.ShowSpinner && e.ActionItem == nil {
+		e.ActionItem = newNumericalSpinner(e)
+	}
+	return e.Entry.CreateRenderer()
+}
+
+// FocusGained shows the value without the text around it, such as a currency symbol, while it is edited.
+//
+// Implements: fyne.Focusable
+func (e *NumericalEntry) FocusGained() {
+	e.focused = true
+	if v, err := e.Value(); err == nil && (e.prefix != "" || e.suffix != "") {
+		e.SetValue(v)
+	}
+	e.Entry.FocusGained()
+}
+
+// FocusLost clamps the value to Min and Max when the entry loses focus, and formats it if it is Localized.
+//
+// Implements: fyne.Focusable
+func (e *NumericalEntry) FocusLost() {
+	e.focused = false
+	if v, err := e.Value(); err == nil && (v != e.clamp(v) || e.Localized || e.prefix != "" || e.suffix != "") {
+		e.SetValue(v)
+	}
+	e.Entry.FocusLost()
+}
+
+// SetValue shows a value, clamped to Min and Max and formatted with Precision.
+// Value returns it without rounding while the text isn't changed.
+func (e *NumericalEntry) SetValue(v float64) {
+	v = e.clamp(v)
+	if !e.AllowFloat {
+		v = math.Round(v)
+	}
+	e.value, e.shown = v, e.format(v)
+	e.SetText(e.shown)
+}
+
+// Step adds delta increments to the value, which is 0 if the text isn't a number, clamped to Min and Max.
+// The spinner buttons, scrolling over them and the Up and Down keys step by one, Page Up and Page Down by ten.
+func (e *NumericalEntry) Step(delta int) {
+	if e.Disabled() {
+		return
+	}
+	increment := e.Increment
+	if increment == 0 {
+		increment = 1
+	}
+	v, _ := e.Value()
+	v += increment * float64(delta)
+	if e.AllowFloat && e.Precision > 0 {
+		// avoid the rounding errors of adding steps such as 0.1
+		scale := math.Pow(10, float64(e.Precision))
+		v = math.Round(v*scale) / scale
+	}
+	e.SetValue(v)
+}
+
+// Value returns the value that is shown, or an error if the text isn't a number.
+func (e *NumericalEntry) Value() (float64, error) {
+	if e.shown != "" && e.Text == e.shown {
+		return e.value, nil
+	}
+	return e.parse(e.Text)
+}
+
+// TypedKey steps the value with the up, down, page up and page down keys.
+//
+// Implements: fyne.Focusable
+func (e *NumericalEntry) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyUp:
+		e.Step(1)
+	case fyne.KeyDown:
+		e.Step(-1)
+	case fyne.KeyPageUp:
+		e.Step(numericalPageSteps)
+	case fyne.KeyPageDown:
+		e.Step(-numericalPageSteps)
+	default:
+		e.Entry.TypedKey(key)
+	}
+}
+
 // TypedRune is called when this item receives a char event.
 //
 // Implements: fyne.Focusable
@@ -48,6 +226,16 @@ func (e *NumericalEntry) TypedRune(r rune) {
 		e.Entry.TypedRune(r)
 		return
 	}
+
+	if e.AllowFloat && e.AllowScientific && e.CursorColumn > 0 {
+		before := []rune(e.Text)[e.CursorColumn-1]
+		switch {
+		case (r == 'e' || r == 'E') && !strings.ContainsAny(e.Text, "eE") && before >= '0' && before <= '9':
+			e.Entry.TypedRune(r)
+		case (r == '-' || r == '+') && (before == 'e' || before == 'E'):
+			e.Entry.TypedRune(r)
+		}
+	}
 }
 
 // TypedShortcut handles the registered shortcuts.
@@ -73,11 +261,199 @@ func (e *NumericalEntry) Keyboard() mobile.KeyboardType {
 }
 
 func (e *NumericalEntry) isNumber(content string) bool {
-	if e.AllowFloat {
-		_, err := strconv.ParseFloat(content, 64)
-		return err == nil
+	_, err := e.parse(content)
+	return err == nil
+}
+
+// clamp returns a value limited to Min and Max, if they differ.
+func (e *NumericalEntry) clamp(v float64) float64 {
+	if e.Min == e.Max {
+		return v
+	}
+	return math.Max(e.Min, math.Min(e.Max, v))
+}
+
+// format returns the text of a value: an integer, or a float with Precision decimals.
+func (e *NumericalEntry) format(v float64) string {
+	var text string
+	switch {
+	case !e.AllowFloat && e.Localized:
+		text = i18n.FormatNumber(i18n.Locale(), math.Round(v), 0)
+	case !e.AllowFloat:
+		text = strconv.FormatInt(int64(math.Round(v)), 10)
+	case e.Precision <= 0 && e.AllowScientific:
+		text = strconv.FormatFloat(v, 'g', -1, 64)
+		if e.Localized {
+			_, decimal := i18n.NumberSeparators(i18n.Locale())
+			text = strings.Replace(text, ".", string(decimal), 1)
+		}
+	case e.Localized:
+		decimals := e.Precision
+		if decimals <= 0 {
+			decimals = -1
+		}
+		text = i18n.FormatNumber(i18n.Locale(), v, decimals)
+	case e.Precision > 0:
+		text = strconv.FormatFloat(v, 'f', e.Precision, 64)
+	default:
+		text = strconv.FormatFloat(v, 'f', -1, 64)
 	}
 
-	_, err := strconv.Atoi(content)
-	return err == nil
+	if e.focused {
+		return text
+	}
+	if e.prefix != "" && strings.HasPrefix(text, "-") {
+		return "-" + e.prefix + text[1:] + e.suffix
+	}
+	return e.prefix + text + e.suffix
+}
+
+// parse returns the value of a text, which may have a decimal comma, or the separators of the locale
+// if the entry is Localized.
+func (e *NumericalEntry) parse(text string) (float64, error) {
+	if e.prefix != "" {
+		text = strings.Replace(text, e.prefix, "", 1)
+	}
+	if e.suffix != "" {
+		text = strings.Replace(text, e.suffix, "", 1)
+	}
+	if e.Localized {
+		group, decimal := i18n.NumberSeparators(i18n.Locale())
+		text = strings.Map(func(r rune) rune {
+			switch {
+			case r == group || unicode.IsSpace(r):
+				return -1
+			case r == decimal:
+				return '.'
+			}
+			return r
+		}, text)
+	} else {
+		text = strings.Replace(text, ",", ".", 1)
+	}
+
+	if !e.AllowFloat {
+		v, err := strconv.Atoi(text)
+		return float64(v), err
+	}
+	if !e.AllowScientific && strings.ContainsAny(text, "eE") {
+		return 0, errors.New("exponents are not allowed")
+	}
+	return strconv.ParseFloat(text, 64)
+}
+
+// numericalString adapts a number binding to the text of a NumericalEntry.
+type numericalString struct {
+	entry *NumericalEntry
+	item  binding.DataItem
+	get   func() (float64, error)
+	set   func(float64) error
+
+	bound   bool
+	pending []binding.DataListener // added once the entry is bound
+}
+
+func (s *numericalString) AddListener(l binding.DataListener) {
+	if !s.bound {
+		s.pending = append(s.pending, l)
+		return
+	}
+	s.item.AddListener(l)
+}
+
+func (s *numericalString) RemoveListener(l binding.DataListener) {
+	s.item.RemoveListener(l)
+}
+
+// Get returns the formatted value, or the text being typed if it has the same value, such as "1." for 1.
+func (s *numericalString) Get() (string, error) {
+	v, err := s.get()
+	if err != nil {
+		return "", err
+	}
+	if typed, err := s.entry.Value(); err == nil && typed == v {
+		return s.entry.Text, nil
+	}
+	return s.entry.format(v), nil
+}
+
+func (s *numericalString) Set(text string) error {
+	v, err := s.entry.parse(text)
+	if err != nil {
+		return err
+	}
+	return s.set(v)
+}
+
+// numericalSpinner is the pair of up and down buttons of a NumericalEntry.
+type numericalSpinner struct {
+	widget.BaseWidget
+	entry *NumericalEntry
+}
+
+func newNumericalSpinner(e *NumericalEntry) *numericalSpinner {
+	s := &numericalSpinner{entry: e}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (s *numericalSpinner) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	r := &numericalSpinnerRenderer{s: s, up: canvas.NewImageFromResource(nil), down: canvas.NewImageFromResource(nil)}
+	r.Refresh()
+	return r
+}
+
+// Scrolled steps the value up or down.
+func (s *numericalSpinner) Scrolled(ev *fyne.ScrollEvent) {
+	switch {
+	case ev.Scrolled.DY > 0:
+		s.entry.Step(1)
+	case ev.Scrolled.DY < 0:
+		s.entry.Step(-1)
+	}
+}
+
+// Tapped steps the value up in the top half of the spinner, or down in the bottom half.
+func (s *numericalSpinner) Tapped(ev *fyne.PointEvent) {
+	if ev.Position.Y < s.Size().Height/2 {
+		s.entry.Step(1)
+	} else {
+		s.entry.Step(-1)
+	}
+}
+
+type numericalSpinnerRenderer struct {
+	s        *numericalSpinner
+	up, down *canvas.Image
+}
+
+func (r *numericalSpinnerRenderer) Destroy() {
+}
+
+func (r *numericalSpinnerRenderer) Layout(size fyne.Size) {
+	half := fyne.NewSize(size.Width, size.Height/2)
+	r.up.Resize(half)
+	r.down.Move(fyne.NewPos(0, half.Height))
+	r.down.Resize(half)
+}
+
+func (r *numericalSpinnerRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(theme.IconInlineSize())
+}
+
+func (r *numericalSpinnerRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.up, r.down}
+}
+
+func (r *numericalSpinnerRenderer) Refresh() {
+	up, down := theme.MenuDropUpIcon(), theme.MenuDropDownIcon()
+	if r.s.entry.Disabled() {
+		up, down = theme.NewDisabledResource(up), theme.NewDisabledResource(down)
+	}
+	r.up.Resource, r.down.Resource = up, down
+	r.up.Refresh()
+	r.down.Refresh()
 }
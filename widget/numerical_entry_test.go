@@ -3,8 +3,11 @@ package widget
 import (
 	"testing"
 
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNumericalnEntry_Int(t *testing.T) {
@@ -86,3 +89,105 @@ func TestNumericalEntry_NegFloat(t *testing.T) {
 	assert.Equal(t, "-24.5", entry.Text)
 
 }
+
+func TestNumericalEntry_Scientific(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.AllowFloat = true
+	entry.AllowScientific = true
+
+	test.Type(entry, "e1.5e-e+3")
+	assert.Equal(t, "1.5e-3", entry.Text)
+	v, err := entry.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0015, v)
+
+	entry.AllowScientific = false
+	_, err = entry.Value()
+	assert.Error(t, err)
+}
+
+func TestNumericalEntry_Step(t *testing.T) {
+	entry := NewSpinEntry(-1, 1, 0.1)
+	assert.True(t, entry.AllowFloat)
+	assert.True(t, entry.AllowNegative)
+	assert.Equal(t, 1, entry.Precision)
+	assert.Equal(t, "-1.0", entry.Text)
+
+	for i := 0; i < 3; i++ {
+		entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	}
+	assert.Equal(t, "-0.7", entry.Text)
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyPageUp})
+	assert.Equal(t, "0.3", entry.Text)
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyPageUp})
+	assert.Equal(t, "1.0", entry.Text, "clamped to Max")
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, "0.9", entry.Text)
+
+	entry.SetText("5")
+	entry.FocusLost()
+	assert.Equal(t, "1.0", entry.Text)
+
+	entry.Disable()
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, "1.0", entry.Text)
+}
+
+func TestNumericalEntry_Spinner(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	entry := NewSpinEntry(0, 10, 2)
+	assert.False(t, entry.AllowFloat)
+	w := test.NewWindow(entry)
+	defer w.Close()
+
+	spinner, ok := entry.ActionItem.(*numericalSpinner)
+	require.True(t, ok)
+	test.TapAt(spinner, fyne.NewPos(1, 1))
+	assert.Equal(t, "2", entry.Text)
+	test.TapAt(spinner, fyne.NewPos(1, spinner.Size().Height-1))
+	assert.Equal(t, "0", entry.Text)
+	spinner.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 10)})
+	assert.Equal(t, "2", entry.Text)
+	spinner.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -10)})
+	assert.Equal(t, "0", entry.Text)
+}
+
+func TestNumericalEntry_Bind(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	f := binding.NewFloat()
+	entry := NewNumericalEntry()
+	entry.AllowFloat = true
+	entry.Precision = 2
+	entry.BindFloat(f)
+	waitForBinding()
+	w := test.NewWindow(entry)
+	defer w.Close()
+
+	require.NoError(t, f.Set(1.5))
+	waitForBinding()
+	assert.Equal(t, "1.50", entry.Text)
+
+	withBindings(func() {
+		entry.SetText("")
+		test.Type(entry, "2.")
+	})
+	waitForBinding()
+	v, _ := f.Get()
+	assert.Equal(t, 2.0, v)
+	assert.Equal(t, "2.", entry.Text, "the text being typed is kept")
+
+	i := binding.NewInt()
+	ints := NewNumericalEntry()
+	ints.BindInt(i)
+	waitForBinding()
+	withBindings(func() {
+		ints.SetText("42")
+	})
+	waitForBinding()
+	n, _ := i.Get()
+	assert.Equal(t, 42, n)
+}
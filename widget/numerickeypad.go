@@ -0,0 +1,245 @@
+package widget
+
+import (
+	"image/color"
+	"reflect"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*NumericKeypad)(nil)
+
+const (
+	keypadButtonSize = 56
+	keypadTextScale  = 1.6
+)
+
+// NumericKeypad is a touch friendly on-screen keypad with large buttons, for kiosk and point of sale apps.
+// Presses are typed into the Target, or the focused object of the canvas if there is no Target,
+// and are also passed to OnRune and OnKey so they can be handled directly.
+type NumericKeypad struct {
+	widget.BaseWidget
+
+	// Target receives the typed runes and keys, the focused object of the canvas is used if nil.
+	Target fyne.Focusable
+	// ShowDecimal adds a key for DecimalSeparator.
+	ShowDecimal bool
+	// DecimalSeparator is typed by the decimal key, '.' is used if zero.
+	DecimalSeparator rune
+	// ShowSign adds a key that toggles a leading minus sign.
+	ShowSign bool
+	// ShowEnter adds a key that types Return, submitting an entry.
+	ShowEnter bool
+
+	OnRune func(rune)           `json:"-"`
+	OnKey  func(*fyne.KeyEvent) `json:"-"`
+}
+
+// NewNumericKeypad creates a new keypad with digits, decimal and backspace keys.
+func NewNumericKeypad() *NumericKeypad {
+	k := &NumericKeypad{ShowDecimal: true}
+	k.ExtendBaseWidget(k)
+	return k
+}
+
+// NewNumericKeypadForEntry creates a new keypad that types into the given entry.
+func NewNumericKeypadForEntry(target fyne.Focusable) *NumericKeypad {
+	k := NewNumericKeypad()
+	k.Target = target
+	return k
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (k *NumericKeypad) CreateRenderer() fyne.WidgetRenderer {
+	k.ExtendBaseWidget(k)
+	r := &numericKeypadRenderer{keypad: k}
+	r.Refresh()
+	return r
+}
+
+// PressKey types a key, such as fyne.KeyBackspace, as if its button was tapped.
+func (k *NumericKeypad) PressKey(name fyne.KeyName) {
+	ev := &fyne.KeyEvent{Name: name}
+	if f := k.OnKey; f != nil {
+		f(ev)
+	}
+	if t := k.target(); t != nil {
+		t.TypedKey(ev)
+	}
+}
+
+// PressRune types a character as if its button was tapped.
+func (k *NumericKeypad) PressRune(r rune) {
+	if f := k.OnRune; f != nil {
+		f(r)
+	}
+	if t := k.target(); t != nil {
+		t.TypedRune(r)
+	}
+}
+
+func (k *NumericKeypad) decimal() rune {
+	if k.DecimalSeparator == 0 {
+		return '.'
+	}
+	return k.DecimalSeparator
+}
+
+func (k *NumericKeypad) target() fyne.Focusable {
+	if k.Target != nil {
+		return k.Target
+	}
+	if c := fyne.CurrentApp().Driver().CanvasForObject(k); c != nil {
+		return c.Focused()
+	}
+	return nil
+}
+
+// toggleSign adds or removes a leading minus sign.
+// Entries have no method to read their text, so the Text field is looked up on the target;
+// for any other target a '-' is typed at the start.
+func (k *NumericKeypad) toggleSign() {
+	if f := k.OnRune; f != nil {
+		f('-')
+	}
+	t := k.target()
+	if t == nil {
+		return
+	}
+
+	setter, ok := t.(interface{ SetText(string) })
+	text, found := focusableText(t)
+	if !ok || !found {
+		t.TypedKey(&fyne.KeyEvent{Name: fyne.KeyHome})
+		t.TypedRune('-')
+		t.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEnd})
+		return
+	}
+
+	if strings.HasPrefix(text, "-") {
+		setter.SetText(text[1:])
+	} else {
+		setter.SetText("-" + text)
+	}
+	t.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEnd})
+}
+
+func focusableText(f fyne.Focusable) (string, bool) {
+	v := reflect.ValueOf(f)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+	field := v.FieldByName("Text")
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return "", false
+	}
+	return field.String(), true
+}
+
+type numericKeypadRenderer struct {
+	keypad  *NumericKeypad
+	content *container.ThemeOverride
+}
+
+func (r *numericKeypadRenderer) Destroy() {
+}
+
+func (r *numericKeypadRenderer) Layout(size fyne.Size) {
+	r.content.Resize(size)
+}
+
+func (r *numericKeypadRenderer) MinSize() fyne.Size {
+	return r.content.MinSize()
+}
+
+func (r *numericKeypadRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.content}
+}
+
+func (r *numericKeypadRenderer) Refresh() {
+	k := r.keypad
+	key := func(label string, tapped func()) fyne.CanvasObject {
+		b := widget.NewButton(label, tapped)
+		min := canvas.NewRectangle(color.Transparent)
+		min.SetMinSize(fyne.NewSquareSize(keypadButtonSize))
+		return container.NewStack(min, b)
+	}
+	digit := func(d rune) fyne.CanvasObject {
+		return key(string(d), func() { k.PressRune(d) })
+	}
+
+	grid := container.NewGridWithColumns(3)
+	for _, d := range "789456123" {
+		grid.Add(digit(d))
+	}
+
+	var left, right fyne.CanvasObject = canvas.NewRectangle(color.Transparent), canvas.NewRectangle(color.Transparent)
+	if k.ShowSign {
+		left = key("±", k.toggleSign)
+	}
+	if k.ShowDecimal {
+		sep := k.decimal()
+		right = key(string(sep), func() { k.PressRune(sep) })
+	}
+	grid.Add(left)
+	grid.Add(digit('0'))
+	grid.Add(right)
+
+	back := widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
+		k.PressKey(fyne.KeyBackspace)
+	})
+	actions := container.NewGridWithColumns(1, back)
+	if k.ShowEnter {
+		enter := widget.NewButtonWithIcon("", theme.ConfirmIcon(), func() {
+			k.PressKey(fyne.KeyReturn)
+		})
+		enter.Importance = widget.HighImportance
+		actions = container.NewGridWithRows(2, back, enter)
+	}
+
+	content := container.NewBorder(nil, nil, nil, actions, grid)
+	if r.content == nil {
+		r.content = container.NewThemeOverride(content, &keypadTheme{})
+	} else {
+		r.content.Content = content
+		r.content.Refresh()
+	}
+	r.Layout(k.Size())
+}
+
+// keypadTheme enlarges the text and icons of the keypad buttons.
+type keypadTheme struct{}
+
+func (t *keypadTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	return fyne.CurrentApp().Settings().Theme().Color(n, v)
+}
+
+func (t *keypadTheme) Font(s fyne.TextStyle) fyne.Resource {
+	return fyne.CurrentApp().Settings().Theme().Font(s)
+}
+
+func (t *keypadTheme) Icon(n fyne.ThemeIconName) fyne.Resource {
+	return fyne.CurrentApp().Settings().Theme().Icon(n)
+}
+
+func (t *keypadTheme) Size(n fyne.ThemeSizeName) float32 {
+	size := fyne.CurrentApp().Settings().Theme().Size(n)
+	switch n {
+	case theme.SizeNameText, theme.SizeNameInlineIcon:
+		return size * keypadTextScale
+	}
+	return size
+}
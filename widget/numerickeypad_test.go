@@ -0,0 +1,78 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumericKeypad_Target(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	entry := NewNumericalEntry()
+	entry.AllowFloat = true
+	entry.AllowNegative = true
+	submitted := ""
+	entry.OnSubmitted = func(s string) {
+		submitted = s
+	}
+	k := NewNumericKeypadForEntry(entry)
+	k.ShowSign = true
+	w := test.NewWindow(container.NewVBox(entry, k))
+	defer w.Close()
+
+	for _, r := range "12.5" {
+		k.PressRune(r)
+	}
+	assert.Equal(t, "12.5", entry.Text)
+	k.PressKey(fyne.KeyBackspace)
+	assert.Equal(t, "12.", entry.Text)
+
+	k.toggleSign()
+	assert.Equal(t, "-12.", entry.Text)
+	k.toggleSign()
+	assert.Equal(t, "12.", entry.Text)
+
+	k.PressKey(fyne.KeyReturn)
+	assert.Equal(t, "12.", submitted)
+}
+
+func TestNumericKeypad_Focused(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	entry := widget.NewEntry()
+	k := NewNumericKeypad()
+	var runes []rune
+	k.OnRune = func(r rune) {
+		runes = append(runes, r)
+	}
+	w := test.NewWindow(container.NewVBox(entry, k))
+	defer w.Close()
+
+	k.PressRune('7')
+	assert.Equal(t, []rune{'7'}, runes)
+	assert.Equal(t, "", entry.Text, "nothing is focused")
+
+	w.Canvas().Focus(entry)
+	k.PressRune('8')
+	assert.Equal(t, "8", entry.Text)
+}
+
+func TestNumericKeypad_Layout(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	k := NewNumericKeypad()
+	min := k.MinSize()
+	assert.GreaterOrEqual(t, min.Height, float32(keypadButtonSize*4))
+
+	k.ShowEnter = true
+	k.Refresh()
+	assert.Greater(t, k.MinSize().Width, float32(keypadButtonSize*3))
+}
@@ -0,0 +1,333 @@
+package widget
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Particles)(nil)
+
+const (
+	// DefaultParticlesDuration is how long an effect plays if it is not given a duration.
+	DefaultParticlesDuration = 3 * time.Second
+
+	particlesFade = .6  // seconds over which an effect fades out at its end
+	particlesStep = .02 // the longest step of the simulation, in seconds
+)
+
+// ParticleEffect is a preset of particles played by Particles.
+type ParticleEffect int
+
+const (
+	// ParticleConfetti bursts coloured confetti upwards from the lower middle, which then flutters down.
+	ParticleConfetti ParticleEffect = iota
+	// ParticleSnow drifts snowflakes down from the top.
+	ParticleSnow
+	// ParticleFireworks bursts fireworks of sparks across the upper part.
+	ParticleFireworks
+)
+
+// Particles is a transparent overlay that plays a short particle effect, such as to celebrate the completion
+// of a task. It does not handle any input, so events pass to the objects beneath it.
+// Place it over content in a stack container and call Play when the effect should start.
+type Particles struct {
+	widget.BaseWidget
+
+	// OnFinished is called when an effect has finished playing.
+	OnFinished func() `json:"-"`
+
+	lock      sync.Mutex
+	effect    ParticleEffect
+	duration  float32 // seconds
+	elapsed   float32 // seconds
+	emitted   float32 // the time up to which particles have been emitted, in seconds
+	particles []particle
+	random    *rand.Rand
+	anim      *fyne.Animation
+	raster    *canvas.Raster
+}
+
+type particle struct {
+	pos, vel    fyne.Position
+	size        float32
+	color       color.NRGBA
+	angle, spin float32 // radians and radians per second
+	age, life   float32 // seconds
+	drag, fall  float32 // the slowing and the gravity of the particle
+	sway        float32 // the phase of the side to side drift of a snowflake, if not zero
+	square      bool
+}
+
+// NewParticles returns an overlay ready to play particle effects.
+func NewParticles() *Particles {
+	p := &Particles{random: rand.New(rand.NewSource(time.Now().UnixNano()))}
+	p.ExtendBaseWidget(p)
+	return p
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (p *Particles) CreateRenderer() fyne.WidgetRenderer {
+	p.ExtendBaseWidget(p)
+	p.raster = canvas.NewRaster(p.draw)
+	return widget.NewSimpleRenderer(p.raster)
+}
+
+// IsPlaying returns whether an effect is playing.
+func (p *Particles) IsPlaying() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.anim != nil
+}
+
+// Play starts an effect, replacing any that is playing, for duration or DefaultParticlesDuration if zero.
+func (p *Particles) Play(effect ParticleEffect, duration time.Duration) {
+	if duration <= 0 {
+		duration = DefaultParticlesDuration
+	}
+	p.Stop()
+
+	p.lock.Lock()
+	p.effect = effect
+	p.duration = float32(duration.Seconds())
+	p.elapsed, p.emitted = 0, 0
+	p.particles = nil
+	if effect == ParticleConfetti {
+		p.burstConfetti()
+	}
+	anim := fyne.NewAnimation(duration, p.tick)
+	anim.Curve = fyne.AnimationLinear
+	p.anim = anim
+	p.lock.Unlock()
+	anim.Start()
+}
+
+// Stop ends the effect that is playing, without calling OnFinished.
+func (p *Particles) Stop() {
+	p.lock.Lock()
+	anim := p.anim
+	p.anim = nil
+	p.particles = nil
+	p.lock.Unlock()
+	if anim != nil {
+		anim.Stop()
+	}
+	p.refreshRaster()
+}
+
+func (p *Particles) tick(done float32) {
+	p.lock.Lock()
+	if p.anim == nil {
+		p.lock.Unlock()
+		return
+	}
+	target := done * p.duration
+	for p.elapsed < target {
+		dt := float32(math.Min(particlesStep, float64(target-p.elapsed)))
+		p.emit(p.elapsed + dt)
+		p.step(dt)
+		p.elapsed += dt
+	}
+	finished := done >= 1
+	if finished {
+		p.particles = nil
+		p.anim = nil
+	}
+	p.lock.Unlock()
+
+	p.refreshRaster()
+	if finished {
+		if f := p.OnFinished; f != nil {
+			f()
+		}
+	}
+}
+
+func (p *Particles) refreshRaster() {
+	if p.raster != nil {
+		p.raster.Refresh()
+	}
+}
+
+// emit adds the particles that an effect releases over time, up to the time until.
+func (p *Particles) emit(until float32) {
+	size := p.Size()
+	switch p.effect {
+	case ParticleSnow:
+		const rate = 40 // flakes per second
+		for ; p.emitted < until && p.emitted < p.duration-particlesFade*2; p.emitted += 1. / rate {
+			p.particles = append(p.particles, particle{
+				pos:   fyne.NewPos(p.randomIn(0, size.Width), -4),
+				vel:   fyne.NewPos(0, p.randomIn(25, 70)),
+				size:  p.randomIn(1.5, 4),
+				color: particleColor(theme.Color(theme.ColorNameForeground), 0xa0),
+				life:  p.duration, sway: p.randomIn(1, 2*math.Pi),
+			})
+		}
+	case ParticleFireworks:
+		const interval = .45 // seconds between bursts
+		for ; p.emitted < until && p.emitted < p.duration-particlesFade*2; p.emitted += interval {
+			p.burstFirework(fyne.NewPos(p.randomIn(.2, .8)*size.Width, p.randomIn(.15, .5)*size.Height))
+		}
+	}
+}
+
+func (p *Particles) burstConfetti() {
+	size := p.Size()
+	origin := fyne.NewPos(size.Width/2, size.Height*.7)
+	colors := particlePalette()
+	for i := 0; i < 150; i++ {
+		angle := p.randomIn(math.Pi/6, math.Pi*5/6)
+		speed := p.randomIn(.6, 1.3) * size.Height
+		p.particles = append(p.particles, particle{
+			pos:   origin,
+			vel:   fyne.NewPos(speed*float32(math.Cos(float64(angle))), -speed*float32(math.Sin(float64(angle)))),
+			size:  p.randomIn(4, 8),
+			color: colors[p.random.Intn(len(colors))],
+			angle: p.randomIn(0, math.Pi), spin: p.randomIn(-12, 12),
+			life: p.duration, drag: 1.6, fall: size.Height * 1.2, square: true,
+		})
+	}
+}
+
+func (p *Particles) burstFirework(at fyne.Position) {
+	colors := particlePalette()
+	c := colors[p.random.Intn(len(colors))]
+	for i := 0; i < 60; i++ {
+		angle := float64(i)/60*2*math.Pi + float64(p.randomIn(0, .1))
+		speed := p.randomIn(80, 200)
+		p.particles = append(p.particles, particle{
+			pos:  at,
+			vel:  fyne.NewPos(speed*float32(math.Cos(angle)), speed*float32(math.Sin(angle))),
+			size: p.randomIn(1.5, 2.5), color: c,
+			life: p.randomIn(.9, 1.5), drag: 1.2, fall: 90,
+		})
+	}
+}
+
+// step moves the particles on by dt seconds, removing those that have expired or left the overlay.
+func (p *Particles) step(dt float32) {
+	height := p.Size().Height
+	kept := p.particles[:0]
+	for _, pt := range p.particles {
+		pt.age += dt
+		if pt.age >= pt.life || pt.pos.Y > height+10 {
+			continue
+		}
+		slow := 1 - pt.drag*dt
+		pt.vel = fyne.NewPos(pt.vel.X*slow, pt.vel.Y*slow+pt.fall*dt)
+		pt.pos = pt.pos.AddXY(pt.vel.X*dt, pt.vel.Y*dt)
+		if pt.sway != 0 {
+			pt.pos.X += float32(math.Sin(float64(pt.age*1.5+pt.sway))) * 15 * dt
+		}
+		pt.angle += pt.spin * dt
+		kept = append(kept, pt)
+	}
+	p.particles = kept
+}
+
+func (p *Particles) draw(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	size := p.Size()
+	if size.Width <= 0 {
+		return img
+	}
+	scale := float32(w) / size.Width
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	fade := float32(1)
+	if left := p.duration - p.elapsed; left < particlesFade {
+		fade = fyne.Max(left, 0) / particlesFade
+	}
+	for _, pt := range p.particles {
+		alpha := fade
+		if p.effect == ParticleFireworks {
+			alpha *= 1 - pt.age/pt.life
+		}
+		if pt.square {
+			particleDrawRect(img, pt.pos.X*scale, pt.pos.Y*scale, pt.size*scale, pt.size*scale*.5, pt.angle, pt.color, alpha)
+		} else {
+			particleDrawCircle(img, pt.pos.X*scale, pt.pos.Y*scale, pt.size*scale, pt.color, alpha)
+		}
+	}
+	return img
+}
+
+func (p *Particles) randomIn(min, max float32) float32 {
+	return min + p.random.Float32()*(max-min)
+}
+
+// particlePalette returns the named primary colours of the theme, for confetti and fireworks.
+func particlePalette() []color.NRGBA {
+	names := []string{theme.ColorRed, theme.ColorOrange, theme.ColorYellow, theme.ColorGreen, theme.ColorBlue, theme.ColorPurple}
+	colors := make([]color.NRGBA, len(names))
+	for i, name := range names {
+		colors[i] = particleColor(theme.PrimaryColorNamed(name), 0xff)
+	}
+	return colors
+}
+
+func particleColor(c color.Color, alpha uint8) color.NRGBA {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	n.A = uint8(uint16(n.A) * uint16(alpha) / 0xff)
+	return n
+}
+
+// particleBlend draws c over the pixel at x, y with its opacity multiplied by alpha.
+func particleBlend(img *image.NRGBA, x, y int, c color.NRGBA, alpha float32) {
+	if !(image.Point{X: x, Y: y}).In(img.Rect) || alpha <= 0 {
+		return
+	}
+	a := float32(c.A) / 0xff * fyne.Min(alpha, 1)
+	i := img.PixOffset(x, y)
+	px := img.Pix[i : i+4 : i+4]
+	below := float32(px[3]) / 0xff
+	out := a + below*(1-a)
+	if out <= 0 {
+		return
+	}
+	mix := func(top, bottom uint8) uint8 {
+		return uint8((float32(top)*a + float32(bottom)*below*(1-a)) / out)
+	}
+	px[0], px[1], px[2], px[3] = mix(c.R, px[0]), mix(c.G, px[1]), mix(c.B, px[2]), uint8(out*0xff)
+}
+
+// particleDrawCircle draws a circle of a radius centred on cx, cy, with antialiased edges.
+func particleDrawCircle(img *image.NRGBA, cx, cy, radius float32, c color.NRGBA, alpha float32) {
+	for y := int(cy - radius - 1); y <= int(cy+radius+1); y++ {
+		for x := int(cx - radius - 1); x <= int(cx+radius+1); x++ {
+			d := float32(math.Hypot(float64(float32(x)+.5-cx), float64(float32(y)+.5-cy)))
+			if cover := radius + .5 - d; cover > 0 {
+				particleBlend(img, x, y, c, alpha*fyne.Min(cover, 1))
+			}
+		}
+	}
+}
+
+// particleDrawRect draws a rectangle of a width and height centred on cx, cy and turned by angle radians.
+func particleDrawRect(img *image.NRGBA, cx, cy, width, height, angle float32, c color.NRGBA, alpha float32) {
+	sin, cos := float32(math.Sin(float64(angle))), float32(math.Cos(float64(angle)))
+	reach := (width + height) / 2
+	for y := int(cy - reach); y <= int(cy+reach); y++ {
+		for x := int(cx - reach); x <= int(cx+reach); x++ {
+			dx, dy := float32(x)+.5-cx, float32(y)+.5-cy
+			// the pixel in the coordinates of the rectangle
+			u, v := dx*cos+dy*sin, -dx*sin+dy*cos
+			if u >= -width/2 && u <= width/2 && v >= -height/2 && v <= height/2 {
+				particleBlend(img, x, y, c, alpha)
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package widget
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParticles_Play(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	p := NewParticles()
+	p.Resize(fyne.NewSize(400, 300))
+	test.WidgetRenderer(p)
+	finished := 0
+	p.OnFinished = func() { finished++ }
+	// the test driver runs animations to their end at once
+	p.Play(ParticleConfetti, time.Second)
+	assert.Equal(t, 1, finished)
+	assert.False(t, p.IsPlaying())
+	assert.Empty(t, p.particles)
+
+	var input interface{} = p
+	_, tappable := input.(fyne.Tappable)
+	assert.False(t, tappable, "taps pass to the objects beneath")
+}
+
+func TestParticles_Effects(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	p := NewParticles()
+	p.Resize(fyne.NewSize(400, 300))
+	start := func(effect ParticleEffect) {
+		p.effect, p.duration, p.elapsed, p.emitted, p.particles = effect, 3, 0, 0, nil
+		if effect == ParticleConfetti {
+			p.burstConfetti()
+		}
+		p.anim = fyne.NewAnimation(3*time.Second, p.tick)
+	}
+
+	start(ParticleConfetti)
+	assert.Len(t, p.particles, 150)
+	p.tick(.1)
+	assert.InDelta(t, .3, p.elapsed, 1e-4)
+	assert.Len(t, p.particles, 150)
+	assert.Less(t, p.particles[0].pos.Y, float32(210), "bursts upwards")
+	img := p.draw(400, 300).(*image.NRGBA)
+	lit := 0
+	for i := 3; i < len(img.Pix); i += 4 {
+		if img.Pix[i] > 0 {
+			lit++
+		}
+	}
+	assert.NotZero(t, lit)
+
+	start(ParticleSnow)
+	p.tick(.5)
+	assert.InDelta(t, 60, len(p.particles), 1)
+	for _, pt := range p.particles {
+		assert.Less(t, pt.pos.Y, float32(300))
+	}
+
+	start(ParticleFireworks)
+	p.tick(.1)
+	assert.Len(t, p.particles, 60)
+	p.tick(.2)
+	assert.Len(t, p.particles, 120)
+}
@@ -0,0 +1,461 @@
+package widget
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*RangeSlider)(nil)
+var _ fyne.Draggable = (*RangeSlider)(nil)
+var _ fyne.Focusable = (*RangeSlider)(nil)
+var _ fyne.Disableable = (*RangeSlider)(nil)
+var _ desktop.Hoverable = (*RangeSlider)(nil)
+
+// RangeSlider is a horizontal slider with two handles that select a sub-range
+// [Low, High] of [Min, Max]. Values snap to Step, if set, and the handles are kept
+// at least MinGap apart.
+//
+// When focused, the left and right arrow keys move the handle that was used last
+// and Space switches between the handles.
+type RangeSlider struct {
+	widget.DisableableWidget
+
+	Min, Max  float64
+	Low, High float64
+	Step      float64
+	// MinGap is the smallest allowed difference between Low and High.
+	MinGap float64
+	// ShowValues displays the current value above each handle.
+	ShowValues bool
+	// Format converts values to text when ShowValues is set, the default prints the number.
+	Format func(float64) string `json:"-"`
+
+	OnChanged     func(low, high float64) `json:"-"`
+	OnChangeEnded func(low, high float64) `json:"-"`
+
+	activeHigh       bool
+	dragging         bool
+	hovered, focused bool
+
+	lowBinder, highBinder *floatBinder
+}
+
+// NewRangeSlider returns a range slider covering min to max with both handles at the extremes.
+func NewRangeSlider(min, max float64) *RangeSlider {
+	s := &RangeSlider{Min: min, Max: max, Low: min, High: max}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// NewRangeSliderWithData returns a range slider whose ends are bound to the given data items.
+func NewRangeSliderWithData(min, max float64, low, high binding.Float) *RangeSlider {
+	s := NewRangeSlider(min, max)
+	s.BindLow(low)
+	s.BindHigh(high)
+	return s
+}
+
+// BindLow connects the lower handle to a data source.
+func (s *RangeSlider) BindLow(data binding.Float) {
+	s.UnbindLow()
+	s.lowBinder = newFloatBinder(data, func(v float64) {
+		s.setBoundValues(v, s.High)
+	})
+}
+
+// BindHigh connects the upper handle to a data source.
+func (s *RangeSlider) BindHigh(data binding.Float) {
+	s.UnbindHigh()
+	s.highBinder = newFloatBinder(data, func(v float64) {
+		s.setBoundValues(s.Low, v)
+	})
+}
+
+// UnbindLow disconnects the data source of the lower handle.
+func (s *RangeSlider) UnbindLow() {
+	s.lowBinder.unbind()
+	s.lowBinder = nil
+}
+
+// UnbindHigh disconnects the data source of the upper handle.
+func (s *RangeSlider) UnbindHigh() {
+	s.highBinder.unbind()
+	s.highBinder = nil
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (s *RangeSlider) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	r := &rangeSliderRenderer{
+		slider:    s,
+		track:     canvas.NewRectangle(color.Transparent),
+		active:    canvas.NewRectangle(color.Transparent),
+		lowThumb:  canvas.NewCircle(color.Transparent),
+		highThumb: canvas.NewCircle(color.Transparent),
+		focus:     canvas.NewCircle(color.Transparent),
+		lowText:   canvas.NewText("", color.Transparent),
+		highText:  canvas.NewText("", color.Transparent),
+	}
+	r.lowText.TextSize = theme.CaptionTextSize()
+	r.highText.TextSize = theme.CaptionTextSize()
+	r.Refresh()
+	return r
+}
+
+// DragEnd is called when the drag of a handle finishes.
+//
+// Implements: fyne.Draggable
+func (s *RangeSlider) DragEnd() {
+	s.dragging = false
+	if s.Disabled() {
+		return
+	}
+	if f := s.OnChangeEnded; f != nil {
+		f(s.Low, s.High)
+	}
+}
+
+// Dragged moves the handle closest to where the drag started.
+//
+// Implements: fyne.Draggable
+func (s *RangeSlider) Dragged(e *fyne.DragEvent) {
+	if s.Disabled() {
+		return
+	}
+	value := s.valueAt(e.Position.X)
+	if !s.dragging {
+		s.dragging = true
+		s.activeHigh = math.Abs(value-s.High) < math.Abs(value-s.Low) ||
+			(s.Low == s.High && value > s.High)
+	}
+	s.moveActive(value)
+}
+
+// FocusGained is called when this item gained the focus.
+//
+// Implements: fyne.Focusable
+func (s *RangeSlider) FocusGained() {
+	s.focused = true
+	s.Refresh()
+}
+
+// FocusLost is called when this item lost the focus.
+//
+// Implements: fyne.Focusable
+func (s *RangeSlider) FocusLost() {
+	s.focused = false
+	s.Refresh()
+}
+
+// MouseIn is called when a desktop pointer enters the widget.
+//
+// Implements: desktop.Hoverable
+func (s *RangeSlider) MouseIn(*desktop.MouseEvent) {
+	s.hovered = true
+	s.Refresh()
+}
+
+// MouseMoved is called when a desktop pointer hovers over the widget.
+//
+// Implements: desktop.Hoverable
+func (s *RangeSlider) MouseMoved(*desktop.MouseEvent) {
+}
+
+// MouseOut is called when a desktop pointer exits the widget.
+//
+// Implements: desktop.Hoverable
+func (s *RangeSlider) MouseOut() {
+	s.hovered = false
+	s.Refresh()
+}
+
+// SetValues updates both ends of the range, applying step and gap constraints.
+func (s *RangeSlider) SetValues(low, high float64) {
+	s.setValues(low, high, true)
+}
+
+// Tapped moves the closest handle to the tapped position and focuses the slider.
+//
+// Implements: fyne.Tappable
+func (s *RangeSlider) Tapped(e *fyne.PointEvent) {
+	if s.Disabled() {
+		return
+	}
+	if !fyne.CurrentDevice().IsMobile() {
+		if c := fyne.CurrentApp().Driver().CanvasForObject(s); c != nil {
+			c.Focus(s)
+		}
+	}
+	value := s.valueAt(e.Position.X)
+	s.activeHigh = math.Abs(value-s.High) < math.Abs(value-s.Low)
+	s.moveActive(value)
+	if f := s.OnChangeEnded; f != nil {
+		f(s.Low, s.High)
+	}
+}
+
+// TypedKey moves the active handle with the arrow keys.
+//
+// Implements: fyne.Focusable
+func (s *RangeSlider) TypedKey(key *fyne.KeyEvent) {
+	if s.Disabled() {
+		return
+	}
+	step := s.Step
+	if step <= 0 {
+		step = (s.Max - s.Min) / 100
+	}
+
+	current := s.Low
+	if s.activeHigh {
+		current = s.High
+	}
+	switch key.Name {
+	case fyne.KeyLeft, fyne.KeyDown:
+		s.moveActive(current - step)
+	case fyne.KeyRight, fyne.KeyUp:
+		s.moveActive(current + step)
+	case fyne.KeyHome:
+		s.moveActive(s.Min)
+	case fyne.KeyEnd:
+		s.moveActive(s.Max)
+	case fyne.KeySpace:
+		s.activeHigh = !s.activeHigh
+		s.Refresh()
+	default:
+		return
+	}
+}
+
+// TypedRune receives text input events when the slider is focused.
+//
+// Implements: fyne.Focusable
+func (s *RangeSlider) TypedRune(rune) {
+}
+
+func (s *RangeSlider) format(v float64) string {
+	if f := s.Format; f != nil {
+		return f(v)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func (s *RangeSlider) moveActive(value float64) {
+	if s.activeHigh {
+		s.setValues(s.Low, math.Max(value, s.Low+s.MinGap), true)
+	} else {
+		s.setValues(math.Min(value, s.High-s.MinGap), s.High, true)
+	}
+}
+
+// setBoundValues sets the values that a binding changed, writing them back to the bindings
+// if they were clamped, snapped or moved apart.
+func (s *RangeSlider) setBoundValues(low, high float64) {
+	s.setValues(low, high, false)
+	if s.Low != low {
+		s.lowBinder.set(s.Low)
+	}
+	if s.High != high {
+		s.highBinder.set(s.High)
+	}
+}
+
+func (s *RangeSlider) setValues(low, high float64, notify bool) {
+	low, high = s.snap(low), s.snap(high)
+	if low > high {
+		low, high = high, low
+	}
+	if high-low < s.MinGap {
+		if s.activeHigh {
+			high = math.Min(s.Max, low+s.MinGap)
+			low = math.Max(s.Min, high-s.MinGap)
+		} else {
+			low = math.Max(s.Min, high-s.MinGap)
+			high = math.Min(s.Max, low+s.MinGap)
+		}
+	}
+	if low == s.Low && high == s.High {
+		return
+	}
+
+	s.Low, s.High = low, high
+	s.Refresh()
+	if !notify {
+		return
+	}
+	s.lowBinder.set(low)
+	s.highBinder.set(high)
+	if f := s.OnChanged; f != nil {
+		f(low, high)
+	}
+}
+
+func (s *RangeSlider) snap(v float64) float64 {
+	if s.Step > 0 {
+		v = s.Min + math.Round((v-s.Min)/s.Step)*s.Step
+	}
+	return math.Max(s.Min, math.Min(s.Max, v))
+}
+
+func (s *RangeSlider) thumbDiameter() float32 {
+	return theme.IconInlineSize()
+}
+
+func (s *RangeSlider) valueAt(x float32) float64 {
+	d := s.thumbDiameter()
+	width := s.Size().Width - d
+	if width <= 0 || s.Max <= s.Min {
+		return s.Min
+	}
+	ratio := float64((x - d/2) / width)
+	return s.Min + ratio*(s.Max-s.Min)
+}
+
+func (s *RangeSlider) xFor(v float64) float32 {
+	d := s.thumbDiameter()
+	if s.Max <= s.Min {
+		return d / 2
+	}
+	return d/2 + float32((v-s.Min)/(s.Max-s.Min))*(s.Size().Width-d)
+}
+
+type rangeSliderRenderer struct {
+	slider *RangeSlider
+
+	track, active       *canvas.Rectangle
+	lowThumb, highThumb *canvas.Circle
+	focus               *canvas.Circle
+	lowText, highText   *canvas.Text
+}
+
+func (r *rangeSliderRenderer) Destroy() {
+}
+
+func (r *rangeSliderRenderer) Layout(size fyne.Size) {
+	s := r.slider
+	d := s.thumbDiameter()
+	trackHeight := theme.InputBorderSize() * 2
+	top := float32(0)
+	if s.ShowValues {
+		top = r.lowText.MinSize().Height
+	}
+	centerY := top + (size.Height-top)/2
+
+	r.track.Move(fyne.NewPos(d/2, centerY-trackHeight/2))
+	r.track.Resize(fyne.NewSize(size.Width-d, trackHeight))
+
+	lowX, highX := s.xFor(s.Low), s.xFor(s.High)
+	r.active.Move(fyne.NewPos(lowX, centerY-trackHeight/2))
+	r.active.Resize(fyne.NewSize(highX-lowX, trackHeight))
+
+	r.lowThumb.Move(fyne.NewPos(lowX-d/2, centerY-d/2))
+	r.lowThumb.Resize(fyne.NewSquareSize(d))
+	r.highThumb.Move(fyne.NewPos(highX-d/2, centerY-d/2))
+	r.highThumb.Resize(fyne.NewSquareSize(d))
+
+	focusX := lowX
+	if s.activeHigh {
+		focusX = highX
+	}
+	f := d * 2
+	r.focus.Move(fyne.NewPos(focusX-f/2, centerY-f/2))
+	r.focus.Resize(fyne.NewSquareSize(f))
+
+	lowSize, highSize := r.lowText.MinSize(), r.highText.MinSize()
+	r.lowText.Resize(lowSize)
+	r.lowText.Move(fyne.NewPos(fyne.Max(0, lowX-lowSize.Width/2), 0))
+	r.highText.Resize(highSize)
+	r.highText.Move(fyne.NewPos(fyne.Min(size.Width-highSize.Width, highX-highSize.Width/2), 0))
+}
+
+func (r *rangeSliderRenderer) MinSize() fyne.Size {
+	d := r.slider.thumbDiameter()
+	min := fyne.NewSize(d*4, d*2)
+	if r.slider.ShowValues {
+		min.Height += r.lowText.MinSize().Height
+	}
+	return min
+}
+
+func (r *rangeSliderRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.track, r.active, r.focus, r.lowThumb, r.highThumb, r.lowText, r.highText}
+}
+
+func (r *rangeSliderRenderer) Refresh() {
+	s := r.slider
+	r.track.FillColor = theme.Color(theme.ColorNameInputBorder)
+	thumb := theme.Color(theme.ColorNameForeground)
+	active := theme.Color(theme.ColorNamePrimary)
+	if s.Disabled() {
+		thumb = theme.Color(theme.ColorNameDisabled)
+		active = theme.Color(theme.ColorNameDisabled)
+	}
+	r.active.FillColor = active
+	r.lowThumb.FillColor = thumb
+	r.highThumb.FillColor = thumb
+
+	switch {
+	case s.focused && !s.Disabled():
+		r.focus.FillColor = theme.Color(theme.ColorNameFocus)
+	case s.hovered && !s.Disabled():
+		r.focus.FillColor = theme.Color(theme.ColorNameHover)
+	default:
+		r.focus.FillColor = color.Transparent
+	}
+
+	r.lowText.Text, r.highText.Text = s.format(s.Low), s.format(s.High)
+	r.lowText.Color = theme.Color(theme.ColorNameForeground)
+	r.highText.Color = r.lowText.Color
+	r.lowText.Hidden = !s.ShowValues
+	r.highText.Hidden = !s.ShowValues
+
+	r.Layout(s.Size())
+	canvas.Refresh(s)
+}
+
+// floatBinder connects a widget value to a binding.Float, ignoring nil receivers
+// so that optional bindings do not need to be checked at every use.
+type floatBinder struct {
+	data     binding.Float
+	listener binding.DataListener
+}
+
+func newFloatBinder(data binding.Float, changed func(float64)) *floatBinder {
+	b := &floatBinder{data: data}
+	b.listener = binding.NewDataListener(func() {
+		v, err := data.Get()
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		changed(v)
+	})
+	data.AddListener(b.listener)
+	return b
+}
+
+func (b *floatBinder) set(v float64) {
+	if b == nil {
+		return
+	}
+	if err := b.data.Set(v); err != nil {
+		fyne.LogError("Error setting current data value", err)
+	}
+}
+
+func (b *floatBinder) unbind() {
+	if b == nil {
+		return
+	}
+	b.data.RemoveListener(b.listener)
+}
@@ -0,0 +1,95 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeSlider_Constraints(t *testing.T) {
+	s := NewRangeSlider(0, 100)
+	s.Step = 5
+	s.MinGap = 10
+
+	s.SetValues(12, 61)
+	assert.Equal(t, 10.0, s.Low)
+	assert.Equal(t, 60.0, s.High)
+
+	s.SetValues(-20, 200)
+	assert.Equal(t, 0.0, s.Low)
+	assert.Equal(t, 100.0, s.High)
+
+	s.activeHigh = false
+	s.moveActive(95)
+	assert.Equal(t, 90.0, s.Low)
+	assert.Equal(t, 100.0, s.High)
+}
+
+func TestRangeSlider_Drag(t *testing.T) {
+	test.NewApp()
+	s := NewRangeSlider(0, 10)
+	w := test.NewWindow(s)
+	defer w.Close()
+	s.Resize(fyne.NewSize(100+s.thumbDiameter(), 40))
+
+	low, high := 0.0, 0.0
+	s.OnChanged = func(l, h float64) {
+		low, high = l, h
+	}
+	ended := false
+	s.OnChangeEnded = func(float64, float64) {
+		ended = true
+	}
+
+	d := s.thumbDiameter() / 2
+	s.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(d+80, 20)}})
+	s.DragEnd()
+	assert.InDelta(t, 0.0, low, 0.001)
+	assert.InDelta(t, 8.0, high, 0.001)
+	assert.True(t, ended)
+
+	s.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(d+20, 20)}})
+	s.DragEnd()
+	assert.InDelta(t, 2.0, low, 0.001)
+	assert.InDelta(t, 8.0, high, 0.001)
+
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeyRight})
+	assert.InDelta(t, 2.1, low, 0.001)
+}
+
+func TestRangeSlider_Binding(t *testing.T) {
+	low, high := binding.NewFloat(), binding.NewFloat()
+	_ = high.Set(10)
+	s := NewRangeSliderWithData(0, 10, low, high)
+	waitForBinding()
+	assert.Equal(t, 0.0, s.Low)
+	assert.Equal(t, 10.0, s.High)
+
+	_ = low.Set(3)
+	waitForBinding()
+	assert.Equal(t, 3.0, s.Low)
+
+	s.activeHigh = true
+	s.moveActive(6)
+	waitForBinding()
+	v, _ := high.Get()
+	assert.Equal(t, 6.0, v)
+
+	// values out of range or between steps are written back as the slider shows them
+	s.Step = 1
+	_ = high.Set(12)
+	waitForBinding()
+	assert.Equal(t, 10.0, s.High)
+	v, _ = high.Get()
+	assert.Equal(t, 10.0, v)
+
+	_ = low.Set(4.4)
+	waitForBinding()
+	assert.Equal(t, 4.0, s.Low)
+	v, _ = low.Get()
+	assert.Equal(t, 4.0, v)
+}
@@ -0,0 +1,436 @@
+package widget
+
+import (
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*RichSelect)(nil)
+var _ fyne.Tappable = (*RichSelect)(nil)
+var _ fyne.Focusable = (*RichSelect)(nil)
+var _ fyne.Disableable = (*RichSelect)(nil)
+var _ desktop.Hoverable = (*RichSelect)(nil)
+
+// RichSelectOption is an option of a RichSelect.
+type RichSelectOption struct {
+	Label string
+	// Detail is secondary text shown after the label in the list.
+	Detail string
+	Icon   fyne.Resource
+	// Group is the heading the option is listed under, consecutive options with the same group share one heading.
+	Group string
+}
+
+// RichSelect is a drop down selection whose options can carry icons, secondary text and group headings.
+// The popup list can be filtered by typing and only creates rows for visible options,
+// so it stays responsive with thousands of entries.
+type RichSelect struct {
+	widget.DisableableWidget
+
+	Options     []RichSelectOption
+	PlaceHolder string
+	OnChanged   func(RichSelectOption) `json:"-"`
+
+	selected         int
+	hovered, focused bool
+	popUp            *widget.PopUp
+	list             *richSelectList
+}
+
+// NewRichSelect creates a new selection with the given options and change handler.
+func NewRichSelect(options []RichSelectOption, changed func(RichSelectOption)) *RichSelect {
+	s := &RichSelect{Options: options, OnChanged: changed, PlaceHolder: "(Select one)", selected: -1}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// ClearSelected clears the current selection.
+func (s *RichSelect) ClearSelected() {
+	s.selected = -1
+	s.Refresh()
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (s *RichSelect) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	bg := canvas.NewRectangle(color.Transparent)
+	bg.CornerRadius = theme.InputRadiusSize()
+	bg.StrokeWidth = theme.InputBorderSize()
+	icon := widget.NewIcon(nil)
+	label := widget.NewLabel("")
+	label.Truncation = fyne.TextTruncateEllipsis
+	r := &richSelectRenderer{sel: s, bg: bg, icon: icon, label: label, dropDown: widget.NewIcon(theme.MenuDropDownIcon())}
+	r.Refresh()
+	return r
+}
+
+// FocusGained is called when the selection has been given focus.
+//
+// Implements: fyne.Focusable
+func (s *RichSelect) FocusGained() {
+	s.focused = true
+	s.Refresh()
+}
+
+// FocusLost is called when the selection has had focus removed.
+//
+// Implements: fyne.Focusable
+func (s *RichSelect) FocusLost() {
+	s.focused = false
+	s.Refresh()
+}
+
+// HidePopUp closes the option list if it is open.
+func (s *RichSelect) HidePopUp() {
+	if s.popUp != nil {
+		s.popUp.Hide()
+		s.popUp = nil
+		s.list = nil
+	}
+}
+
+// MouseIn is called when a desktop pointer enters the widget.
+//
+// Implements: desktop.Hoverable
+func (s *RichSelect) MouseIn(*desktop.MouseEvent) {
+	s.hovered = true
+	s.Refresh()
+}
+
+// MouseMoved is called when a desktop pointer hovers over the widget.
+//
+// Implements: desktop.Hoverable
+func (s *RichSelect) MouseMoved(*desktop.MouseEvent) {
+}
+
+// MouseOut is called when a desktop pointer exits the widget.
+//
+// Implements: desktop.Hoverable
+func (s *RichSelect) MouseOut() {
+	s.hovered = false
+	s.Refresh()
+}
+
+// Selected returns the selected option, the second value is false if nothing is selected.
+func (s *RichSelect) Selected() (RichSelectOption, bool) {
+	if s.selected < 0 || s.selected >= len(s.Options) {
+		return RichSelectOption{}, false
+	}
+	return s.Options[s.selected], true
+}
+
+// SelectedIndex returns the index of the selected option, or -1 if nothing is selected.
+func (s *RichSelect) SelectedIndex() int {
+	if s.selected >= len(s.Options) {
+		return -1
+	}
+	return s.selected
+}
+
+// SetSelected selects the first option with the given label.
+func (s *RichSelect) SetSelected(label string) {
+	for i, o := range s.Options {
+		if o.Label == label {
+			s.SetSelectedIndex(i)
+			return
+		}
+	}
+}
+
+// SetSelectedIndex selects the option at the given index, calling OnChanged if it changed.
+func (s *RichSelect) SetSelectedIndex(index int) {
+	if index < 0 || index >= len(s.Options) || index == s.selected {
+		return
+	}
+	s.selected = index
+	s.Refresh()
+	if f := s.OnChanged; f != nil {
+		f(s.Options[index])
+	}
+}
+
+// ShowPopUp opens the option list with its search field focused.
+func (s *RichSelect) ShowPopUp() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(s)
+	if c == nil || s.Disabled() {
+		return
+	}
+
+	s.list = newRichSelectList(s)
+	search := newRichSelectSearch(s.list)
+	content := container.NewBorder(search, nil, nil, nil, s.list)
+	s.popUp = widget.NewPopUp(content, c)
+
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(s).AddXY(0, s.Size().Height)
+	rowHeight := s.list.CreateItem().MinSize().Height + theme.SeparatorThicknessSize()
+	height := fyne.Min(rowHeight*float32(fyne.Min(float32(len(s.list.rows)), 10)), c.Size().Height-pos.Y-theme.Padding()*2)
+	height = fyne.Max(height, rowHeight*2)
+	s.popUp.Resize(fyne.NewSize(fyne.Max(s.Size().Width, 200), search.MinSize().Height+height+theme.Padding()*2))
+	s.popUp.ShowAtPosition(pos)
+	c.Focus(search)
+	if s.selected >= 0 {
+		s.list.highlightOption(s.selected)
+	}
+}
+
+// Tapped opens the option list.
+//
+// Implements: fyne.Tappable
+func (s *RichSelect) Tapped(*fyne.PointEvent) {
+	s.ShowPopUp()
+}
+
+// TypedKey opens the option list when Space, Return or Down is pressed.
+//
+// Implements: fyne.Focusable
+func (s *RichSelect) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeySpace, fyne.KeyReturn, fyne.KeyEnter, fyne.KeyDown:
+		s.ShowPopUp()
+	}
+}
+
+// TypedRune receives text input events when the selection is focused.
+//
+// Implements: fyne.Focusable
+func (s *RichSelect) TypedRune(rune) {
+}
+
+func (s *RichSelect) choose(index int) {
+	s.HidePopUp()
+	s.SetSelectedIndex(index)
+	if c := fyne.CurrentApp().Driver().CanvasForObject(s); c != nil {
+		c.Focus(s)
+	}
+}
+
+type richSelectRenderer struct {
+	sel      *RichSelect
+	bg       *canvas.Rectangle
+	icon     *widget.Icon
+	label    *widget.Label
+	dropDown *widget.Icon
+}
+
+func (r *richSelectRenderer) Destroy() {
+}
+
+func (r *richSelectRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	pad := theme.InnerPadding()
+	iconSize := theme.IconInlineSize()
+	left := pad / 2
+	if r.icon.Visible() {
+		r.icon.Resize(fyne.NewSquareSize(iconSize))
+		r.icon.Move(fyne.NewPos(pad, (size.Height-iconSize)/2))
+		left = pad + iconSize
+	}
+	r.dropDown.Resize(fyne.NewSquareSize(iconSize))
+	r.dropDown.Move(fyne.NewPos(size.Width-iconSize-pad, (size.Height-iconSize)/2))
+	r.label.Move(fyne.NewPos(left, 0))
+	r.label.Resize(fyne.NewSize(size.Width-left-iconSize-pad, size.Height))
+}
+
+func (r *richSelectRenderer) MinSize() fyne.Size {
+	pad := theme.InnerPadding()
+	iconSize := theme.IconInlineSize()
+	label := r.label.MinSize()
+	return fyne.NewSize(pad*2+iconSize*2+label.Width, fyne.Max(label.Height, iconSize+pad*2))
+}
+
+func (r *richSelectRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.bg, r.icon, r.label, r.dropDown}
+}
+
+func (r *richSelectRenderer) Refresh() {
+	s := r.sel
+	if o, ok := s.Selected(); ok {
+		r.label.SetText(o.Label)
+		r.label.Importance = widget.MediumImportance
+		r.icon.SetResource(o.Icon)
+		r.icon.Hidden = o.Icon == nil
+	} else {
+		r.label.SetText(s.PlaceHolder)
+		r.label.Importance = widget.LowImportance
+		r.icon.Hide()
+	}
+	if s.Disabled() {
+		r.label.Importance = widget.LowImportance
+	}
+
+	r.bg.FillColor = theme.Color(theme.ColorNameInputBackground)
+	r.bg.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	switch {
+	case s.Disabled():
+		r.bg.FillColor = theme.Color(theme.ColorNameDisabledButton)
+	case s.focused:
+		r.bg.StrokeColor = theme.Color(theme.ColorNamePrimary)
+	case s.hovered:
+		r.bg.FillColor = theme.Color(theme.ColorNameHover)
+	}
+	r.label.Refresh()
+	r.bg.Refresh()
+	r.Layout(s.Size())
+}
+
+// richSelectRow is a row of the popup list, either a group heading or an option.
+type richSelectRow struct {
+	header string
+	option int
+}
+
+type richSelectList struct {
+	widget.List
+	sel         *RichSelect
+	rows        []richSelectRow
+	highlighted widget.ListItemID
+}
+
+func newRichSelectList(s *RichSelect) *richSelectList {
+	l := &richSelectList{sel: s, highlighted: -1}
+	l.Length = func() int {
+		return len(l.rows)
+	}
+	l.CreateItem = func() fyne.CanvasObject {
+		header := widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+		header.Importance = widget.LowImportance
+		detail := widget.NewLabel("")
+		detail.Importance = widget.LowImportance
+		label := widget.NewLabel("")
+		label.Truncation = fyne.TextTruncateEllipsis
+		option := container.NewBorder(nil, nil, widget.NewIcon(nil), detail, label)
+		return container.NewStack(header, option)
+	}
+	l.UpdateItem = l.updateItem
+	l.OnSelected = func(id widget.ListItemID) {
+		if id < 0 || id >= len(l.rows) {
+			return
+		}
+		if row := l.rows[id]; row.option >= 0 {
+			s.choose(row.option)
+		} else {
+			l.Unselect(id)
+		}
+	}
+	l.ExtendBaseWidget(l)
+	l.filter("")
+	return l
+}
+
+// filter rebuilds the rows for the options that contain the query in their label or detail.
+func (l *richSelectList) filter(query string) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	l.rows = l.rows[:0]
+	group := ""
+	for i, o := range l.sel.Options {
+		if query != "" && !strings.Contains(strings.ToLower(o.Label), query) &&
+			!strings.Contains(strings.ToLower(o.Detail), query) {
+			continue
+		}
+		if o.Group != "" && o.Group != group {
+			l.rows = append(l.rows, richSelectRow{header: o.Group, option: -1})
+		}
+		group = o.Group
+		l.rows = append(l.rows, richSelectRow{option: i})
+	}
+	l.highlighted = -1
+	l.UnselectAll()
+	l.Refresh()
+	l.moveHighlight(1)
+}
+
+func (l *richSelectList) highlightOption(option int) {
+	for id, row := range l.rows {
+		if row.option == option {
+			l.highlighted = id
+			l.ScrollTo(id)
+			l.Refresh()
+			return
+		}
+	}
+}
+
+// moveHighlight moves the keyboard highlight by delta options, skipping group headings.
+func (l *richSelectList) moveHighlight(delta int) {
+	id := l.highlighted
+	for {
+		id += delta
+		if id < 0 || id >= len(l.rows) {
+			return
+		}
+		if l.rows[id].option >= 0 {
+			break
+		}
+	}
+	l.highlighted = id
+	l.ScrollTo(id)
+	l.Refresh()
+}
+
+func (l *richSelectList) updateItem(id widget.ListItemID, obj fyne.CanvasObject) {
+	row := l.rows[id]
+	stack := obj.(*fyne.Container)
+	header := stack.Objects[0].(*widget.Label)
+	option := stack.Objects[1].(*fyne.Container)
+	if row.option < 0 {
+		header.SetText(row.header)
+		header.Show()
+		option.Hide()
+		return
+	}
+
+	header.Hide()
+	option.Show()
+	o := l.sel.Options[row.option]
+	label := option.Objects[0].(*widget.Label)
+	icon := option.Objects[1].(*widget.Icon)
+	detail := option.Objects[2].(*widget.Label)
+	label.SetText(o.Label)
+	label.TextStyle.Bold = id == l.highlighted
+	label.Refresh()
+	detail.SetText(o.Detail)
+	icon.SetResource(o.Icon)
+	icon.Hidden = o.Icon == nil
+}
+
+// richSelectSearch is the filter field at the top of the popup, it also drives keyboard navigation.
+type richSelectSearch struct {
+	widget.Entry
+	list *richSelectList
+}
+
+func newRichSelectSearch(list *richSelectList) *richSelectSearch {
+	s := &richSelectSearch{list: list}
+	s.ExtendBaseWidget(s)
+	s.SetPlaceHolder(i18n.L("Search"))
+	s.OnChanged = list.filter
+	return s
+}
+
+func (s *richSelectSearch) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyDown:
+		s.list.moveHighlight(1)
+	case fyne.KeyUp:
+		s.list.moveHighlight(-1)
+	case fyne.KeyReturn, fyne.KeyEnter:
+		if h := s.list.highlighted; h >= 0 && h < len(s.list.rows) {
+			s.list.sel.choose(s.list.rows[h].option)
+		}
+	case fyne.KeyEscape:
+		s.list.sel.HidePopUp()
+	default:
+		s.Entry.TypedKey(key)
+	}
+}
@@ -0,0 +1,99 @@
+package widget
+
+import (
+	"fmt"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+var richSelectTestOptions = []RichSelectOption{
+	{Label: "Apple", Detail: "Red", Icon: theme.FileImageIcon(), Group: "Fruit"},
+	{Label: "Banana", Detail: "Yellow", Group: "Fruit"},
+	{Label: "Carrot", Detail: "Orange", Group: "Vegetables"},
+	{Label: "Leek", Group: "Vegetables"},
+}
+
+func TestRichSelect_SetSelected(t *testing.T) {
+	var changed RichSelectOption
+	s := NewRichSelect(richSelectTestOptions, func(o RichSelectOption) {
+		changed = o
+	})
+	_, ok := s.Selected()
+	assert.False(t, ok)
+	assert.Equal(t, -1, s.SelectedIndex())
+
+	s.SetSelected("Carrot")
+	assert.Equal(t, 2, s.SelectedIndex())
+	assert.Equal(t, "Carrot", changed.Label)
+
+	s.ClearSelected()
+	assert.Equal(t, -1, s.SelectedIndex())
+}
+
+func TestRichSelect_Groups(t *testing.T) {
+	s := NewRichSelect(richSelectTestOptions, nil)
+	l := newRichSelectList(s)
+	assert.Len(t, l.rows, 6)
+	assert.Equal(t, "Fruit", l.rows[0].header)
+	assert.Equal(t, "Vegetables", l.rows[3].header)
+	assert.Equal(t, 1, l.highlighted, "the first option should be highlighted")
+
+	l.filter("an")
+	assert.Equal(t, []richSelectRow{{header: "Fruit", option: -1}, {option: 1}, {header: "Vegetables", option: -1}, {option: 2}}, l.rows)
+
+	l.filter("yellow")
+	assert.Equal(t, []richSelectRow{{header: "Fruit", option: -1}, {option: 1}}, l.rows)
+}
+
+func TestRichSelect_Keyboard(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	s := NewRichSelect(richSelectTestOptions, nil)
+	w := test.NewWindow(s)
+	w.Resize(fyne.NewSize(300, 400))
+	defer w.Close()
+
+	test.Tap(s)
+	assert.NotNil(t, s.popUp)
+	search := w.Canvas().Focused().(*richSelectSearch)
+
+	test.Type(search, "e")
+	for i := 0; i < 4; i++ { // the last press is ignored at the end of the list
+		search.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	}
+	search.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Nil(t, s.popUp)
+	assert.Equal(t, "Leek", richSelectTestOptions[s.SelectedIndex()].Label)
+
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeySpace})
+	assert.NotNil(t, s.popUp)
+	search = w.Canvas().Focused().(*richSelectSearch)
+	search.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.Nil(t, s.popUp)
+}
+
+func TestRichSelect_ManyOptions(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	options := make([]RichSelectOption, 5000)
+	for i := range options {
+		options[i] = RichSelectOption{Label: fmt.Sprintf("Item %d", i), Group: fmt.Sprintf("Group %d", i/100)}
+	}
+	s := NewRichSelect(options, nil)
+	w := test.NewWindow(s)
+	w.Resize(fyne.NewSize(300, 400))
+	defer w.Close()
+
+	test.Tap(s)
+	assert.Len(t, s.list.rows, 5050)
+	s.list.filter("item 4999")
+	assert.Len(t, s.list.rows, 2)
+	s.list.Select(1)
+	assert.Equal(t, 4999, s.SelectedIndex())
+}
@@ -0,0 +1,449 @@
+package widget
+
+import (
+	"image/color"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*SearchEntry)(nil)
+var _ fyne.Disableable = (*SearchEntry)(nil)
+
+// DefaultSearchDebounce is the delay used by a SearchEntry that has no Debounce set.
+const DefaultSearchDebounce = 300 * time.Millisecond
+
+// how many recent searches a SearchEntry remembers if MaxHistory is not set
+const defaultSearchHistory = 10
+
+// SearchEntry is a search field with a leading search icon and a trailing clear button.
+// OnSearch is called once typing pauses for the Debounce delay, immediately when Return is
+// pressed and with an empty query when the field is cleared by the button or Escape key.
+// If Suggestions is set its results are offered in a popup below the field.
+// The submitted queries are remembered and offered when the empty field is tapped, and Scopes are shown as chips
+// below the field to narrow the search.
+type SearchEntry struct {
+	widget.DisableableWidget
+
+	PlaceHolder string
+	// Debounce is how long typing must pause before OnSearch is called, DefaultSearchDebounce is used if zero.
+	Debounce time.Duration
+
+	OnChanged func(string)       `json:"-"`
+	OnSearch  func(query string) `json:"-"`
+	// OnSubmitted is called when Return is pressed or a suggestion is chosen.
+	OnSubmitted func(query string) `json:"-"`
+	// Suggestions returns the completions to offer for a query, it is called after the debounce delay.
+	Suggestions func(query string) []string `json:"-"`
+
+	// HistoryKey is the key of the app preferences that the recent searches are saved in, if it is set,
+	// so that they are offered again when the app runs next.
+	HistoryKey string
+	// MaxHistory is how many recent searches are remembered, 10 if 0.
+	MaxHistory int
+
+	// Scopes are the parts of the data that can be searched, such as "All", "Artists" and "Albums".
+	// Call Refresh after changing them.
+	Scopes []string
+	// Scope is the chosen one of Scopes, the first if empty. Use SetScope to change it.
+	Scope          string
+	OnScopeChanged func(scope string) `json:"-"`
+
+	field *searchField
+	clear *widget.Button
+
+	timerLock sync.Mutex
+	timer     *time.Timer
+
+	history    []string // if there is no HistoryKey
+	scopeChips []*Chip
+}
+
+// NewSearchEntry creates a new search field that calls the given function with each query.
+func NewSearchEntry(search func(query string)) *SearchEntry {
+	s := &SearchEntry{OnSearch: search, PlaceHolder: i18n.L("Search")}
+	s.ExtendBaseWidget(s)
+	s.field = newSearchField(s)
+	s.clear = widget.NewButtonWithIcon("", theme.ContentClearIcon(), s.Clear)
+	s.clear.Importance = widget.LowImportance
+	s.clear.Hide()
+	return s
+}
+
+// Clear empties the field and searches with an empty query straight away.
+func (s *SearchEntry) Clear() {
+	s.field.HideCompletion()
+	s.field.SetText("")
+	s.search()
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (s *SearchEntry) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	box := canvas.NewRectangle(color.Transparent)
+	box.CornerRadius = theme.InputRadiusSize()
+	border := canvas.NewRectangle(color.Transparent)
+	border.StrokeWidth = theme.InputBorderSize()
+	border.CornerRadius = theme.InputRadiusSize()
+	icon := canvas.NewImageFromResource(theme.SearchIcon())
+	icon.FillMode = canvas.ImageFillContain
+
+	r := &searchEntryRenderer{
+		entry: s, box: box, border: border, icon: icon,
+		field:  container.NewThemeOverride(s.field, &searchFieldTheme{}),
+		scopes: container.NewHBox(),
+	}
+	r.Refresh()
+	return r
+}
+
+// ClearHistory forgets the recent searches.
+func (s *SearchEntry) ClearHistory() {
+	s.setHistory(nil)
+}
+
+// Focus gives keyboard focus to the text field.
+func (s *SearchEntry) Focus() {
+	if c := fyne.CurrentApp().Driver().CanvasForObject(s.field); c != nil {
+		c.Focus(s.field)
+	}
+}
+
+// History returns the recent searches, the latest first.
+func (s *SearchEntry) History() []string {
+	if s.HistoryKey != "" {
+		return fyne.CurrentApp().Preferences().StringList(s.HistoryKey)
+	}
+	return s.history
+}
+
+// SelectedScope returns the chosen one of Scopes, or "" if there are none.
+func (s *SearchEntry) SelectedScope() string {
+	for _, scope := range s.Scopes {
+		if scope == s.Scope {
+			return scope
+		}
+	}
+	if len(s.Scopes) == 0 {
+		return ""
+	}
+	return s.Scopes[0]
+}
+
+// SetScope chooses one of Scopes and searches in it straight away.
+func (s *SearchEntry) SetScope(scope string) {
+	if scope == s.SelectedScope() {
+		return
+	}
+	s.Scope = scope
+	s.Refresh()
+	if f := s.OnScopeChanged; f != nil {
+		f(scope)
+	}
+	s.search()
+}
+
+// SetText sets the query text without waiting for the debounce delay to search.
+func (s *SearchEntry) SetText(text string) {
+	s.field.SetText(text)
+	s.search()
+}
+
+// Text returns the current query text.
+func (s *SearchEntry) Text() string {
+	return s.field.Text
+}
+
+func (s *SearchEntry) changed(text string) {
+	if text == "" {
+		s.clear.Hide()
+	} else {
+		s.clear.Show()
+	}
+	if f := s.OnChanged; f != nil {
+		f(text)
+	}
+
+	if s.field.pause { // a suggestion was chosen
+		s.search()
+		s.submit()
+		return
+	}
+	s.timerLock.Lock()
+	defer s.timerLock.Unlock()
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	delay := s.Debounce
+	if delay <= 0 {
+		delay = DefaultSearchDebounce
+	}
+	s.timer = time.AfterFunc(delay, func() {
+		s.search()
+		s.suggest(text)
+	})
+}
+
+func (s *SearchEntry) search() {
+	s.timerLock.Lock()
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	s.timerLock.Unlock()
+
+	if f := s.OnSearch; f != nil {
+		f(s.field.Text)
+	}
+}
+
+// remember adds a query to the front of the recent searches.
+func (s *SearchEntry) remember(query string) {
+	if query == "" {
+		return
+	}
+	max := s.MaxHistory
+	if max <= 0 {
+		max = defaultSearchHistory
+	}
+	history := []string{query}
+	for _, q := range s.History() {
+		if q != query && len(history) < max {
+			history = append(history, q)
+		}
+	}
+	s.setHistory(history)
+}
+
+func (s *SearchEntry) setHistory(history []string) {
+	if s.HistoryKey != "" {
+		fyne.CurrentApp().Preferences().SetStringList(s.HistoryKey, history)
+		return
+	}
+	s.history = history
+}
+
+// showHistory offers the recent searches below the empty field.
+func (s *SearchEntry) showHistory() {
+	history := s.History()
+	if s.field.Text != "" || len(history) == 0 || fyne.CurrentApp().Driver().CanvasForObject(s.field) == nil {
+		return
+	}
+	s.field.SetOptions(history)
+	s.field.ShowCompletion()
+}
+
+func (s *SearchEntry) submit() {
+	s.remember(s.field.Text)
+	if f := s.OnSubmitted; f != nil {
+		f(s.field.Text)
+	}
+}
+
+func (s *SearchEntry) suggest(query string) {
+	if s.Suggestions == nil || query != s.field.Text {
+		return
+	}
+	options := s.Suggestions(query)
+	// shown under the lock that typing takes to start the timer, so that they are not shown while typing
+	s.timerLock.Lock()
+	defer s.timerLock.Unlock()
+	s.field.SetOptions(options)
+	if len(options) == 0 || query == "" || fyne.CurrentApp().Driver().CanvasForObject(s.field) == nil {
+		s.field.HideCompletion()
+		return
+	}
+	s.field.ShowCompletion()
+}
+
+type searchEntryRenderer struct {
+	entry       *SearchEntry
+	box, border *canvas.Rectangle
+	icon        *canvas.Image
+	field       *container.ThemeOverride
+	scopes      *fyne.Container
+}
+
+func (r *searchEntryRenderer) Destroy() {
+}
+
+func (r *searchEntryRenderer) Layout(size fyne.Size) {
+	if len(r.entry.Scopes) > 0 {
+		scopes := r.scopes.MinSize().Height
+		size.Height -= scopes + theme.Padding()
+		r.scopes.Move(fyne.NewPos(0, size.Height+theme.Padding()))
+		r.scopes.Resize(fyne.NewSize(size.Width, scopes))
+	}
+	r.box.Resize(size)
+	r.border.Resize(size)
+
+	pad := theme.InnerPadding()
+	iconSize := theme.IconInlineSize()
+	r.icon.Resize(fyne.NewSquareSize(iconSize))
+	r.icon.Move(fyne.NewPos(pad, (size.Height-iconSize)/2))
+
+	clearSize := r.entry.clear.MinSize()
+	r.entry.clear.Resize(clearSize)
+	r.entry.clear.Move(fyne.NewPos(size.Width-clearSize.Width-theme.Padding(), (size.Height-clearSize.Height)/2))
+
+	left := pad + iconSize
+	r.field.Move(fyne.NewPos(left, 0))
+	r.field.Resize(fyne.NewSize(size.Width-left-clearSize.Width-theme.Padding(), size.Height))
+}
+
+func (r *searchEntryRenderer) MinSize() fyne.Size {
+	field := r.field.MinSize()
+	clear := r.entry.clear.MinSize()
+	min := fyne.NewSize(theme.InnerPadding()+theme.IconInlineSize()+field.Width+clear.Width+theme.Padding(),
+		fyne.Max(field.Height, clear.Height))
+	if len(r.entry.Scopes) > 0 {
+		scopes := r.scopes.MinSize()
+		min = fyne.NewSize(fyne.Max(min.Width, scopes.Width), min.Height+theme.Padding()+scopes.Height)
+	}
+	return min
+}
+
+func (r *searchEntryRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.box, r.border, r.icon, r.field, r.entry.clear, r.scopes}
+}
+
+func (r *searchEntryRenderer) Refresh() {
+	s := r.entry
+	if s.Disabled() {
+		s.field.Disable()
+		s.clear.Disable()
+	} else {
+		s.field.Enable()
+		s.clear.Enable()
+	}
+	s.field.SetPlaceHolder(s.PlaceHolder)
+	r.refreshScopes()
+
+	r.box.FillColor = theme.Color(theme.ColorNameInputBackground)
+	r.border.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	if s.field.focused {
+		r.border.StrokeColor = theme.Color(theme.ColorNamePrimary)
+	}
+	r.icon.Resource = theme.SearchIcon()
+	if s.Disabled() {
+		r.icon.Resource = theme.NewDisabledResource(theme.SearchIcon())
+	}
+	r.box.Refresh()
+	r.border.Refresh()
+	r.icon.Refresh()
+	r.field.Refresh()
+}
+
+// refreshScopes shows a chip for each of the Scopes, the chosen one selected.
+func (r *searchEntryRenderer) refreshScopes() {
+	s := r.entry
+	for len(s.scopeChips) < len(s.Scopes) {
+		s.scopeChips = append(s.scopeChips, NewChip("", nil))
+	}
+	s.scopeChips = s.scopeChips[:len(s.Scopes)]
+	r.scopes.Objects = make([]fyne.CanvasObject, len(s.scopeChips))
+	selected := s.SelectedScope()
+	for i, chip := range s.scopeChips {
+		scope := s.Scopes[i]
+		chip.Text = scope
+		chip.Selected = scope == selected
+		chip.OnTapped = func() {
+			s.SetScope(scope)
+		}
+		if s.Disabled() {
+			chip.Disable()
+		} else {
+			chip.Enable()
+		}
+		chip.Refresh()
+		r.scopes.Objects[i] = chip
+	}
+	r.scopes.Hidden = len(s.Scopes) == 0
+	r.scopes.Refresh()
+}
+
+// searchField is the borderless text field inside a SearchEntry.
+type searchField struct {
+	CompletionEntry
+	search  *SearchEntry
+	focused bool
+}
+
+func newSearchField(s *SearchEntry) *searchField {
+	f := &searchField{search: s}
+	f.ExtendBaseWidget(f)
+	f.OnChanged = s.changed
+	f.OnSubmitted = func(string) {
+		f.HideCompletion()
+		s.search()
+		s.submit()
+	}
+	return f
+}
+
+func (f *searchField) FocusGained() {
+	f.focused = true
+	f.CompletionEntry.FocusGained()
+	f.search.Refresh()
+}
+
+func (f *searchField) FocusLost() {
+	f.focused = false
+	f.CompletionEntry.FocusLost()
+	f.search.Refresh()
+}
+
+// Tapped offers the recent searches if the field is empty.
+func (f *searchField) Tapped(ev *fyne.PointEvent) {
+	f.CompletionEntry.Tapped(ev)
+	f.search.showHistory()
+}
+
+func (f *searchField) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyEscape:
+		f.search.Clear()
+		return
+	case fyne.KeyDown:
+		if f.Text == "" {
+			f.search.showHistory()
+			return
+		}
+	}
+	f.CompletionEntry.TypedKey(key)
+}
+
+// searchFieldTheme removes the background and border of the inner entry,
+// the SearchEntry draws them around the icon and clear button too.
+type searchFieldTheme struct{}
+
+func (t *searchFieldTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	if n == theme.ColorNameInputBackground {
+		return color.Transparent
+	}
+	return fyne.CurrentApp().Settings().Theme().Color(n, v)
+}
+
+func (t *searchFieldTheme) Font(s fyne.TextStyle) fyne.Resource {
+	return fyne.CurrentApp().Settings().Theme().Font(s)
+}
+
+func (t *searchFieldTheme) Icon(n fyne.ThemeIconName) fyne.Resource {
+	return fyne.CurrentApp().Settings().Theme().Icon(n)
+}
+
+func (t *searchFieldTheme) Size(n fyne.ThemeSizeName) float32 {
+	if n == theme.SizeNameInputBorder {
+		return 0
+	}
+	return fyne.CurrentApp().Settings().Theme().Size(n)
+}
@@ -0,0 +1,201 @@
+package widget
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type searchRecorder struct {
+	sync.Mutex
+	queries []string
+}
+
+func (r *searchRecorder) search(q string) {
+	r.Lock()
+	defer r.Unlock()
+	r.queries = append(r.queries, q)
+}
+
+func (r *searchRecorder) get() []string {
+	r.Lock()
+	defer r.Unlock()
+	return append([]string{}, r.queries...)
+}
+
+func TestSearchEntry_Debounce(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	rec := &searchRecorder{}
+	s := NewSearchEntry(rec.search)
+	s.Debounce = 50 * time.Millisecond
+	w := test.NewWindow(s)
+	defer w.Close()
+
+	test.Type(s.field, "fyne")
+	assert.Empty(t, rec.get())
+	time.Sleep(150 * time.Millisecond)
+	assert.Equal(t, []string{"fyne"}, rec.get())
+	assert.True(t, s.clear.Visible())
+
+	test.Type(s.field, "x")
+	s.field.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, []string{"fyne", "fynex"}, rec.get())
+	time.Sleep(150 * time.Millisecond)
+	assert.Len(t, rec.get(), 2, "submitting should cancel the pending search")
+}
+
+func TestSearchEntry_Clear(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	rec := &searchRecorder{}
+	s := NewSearchEntry(rec.search)
+	w := test.NewWindow(s)
+	defer w.Close()
+
+	s.SetText("query")
+	assert.Equal(t, "query", s.Text())
+	assert.Equal(t, []string{"query"}, rec.get())
+
+	s.field.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.Equal(t, "", s.Text())
+	assert.Equal(t, []string{"query", ""}, rec.get())
+	assert.False(t, s.clear.Visible())
+
+	s.SetText("again")
+	test.Tap(s.clear)
+	assert.Equal(t, "", s.Text())
+}
+
+func TestSearchEntry_Suggestions(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	s := NewSearchEntry(nil)
+	s.Debounce = 100 * time.Millisecond
+	s.Suggestions = func(q string) []string {
+		return []string{q + " widgets", q + " themes"}
+	}
+	w := test.NewWindow(s)
+	w.Resize(fyne.NewSize(300, 300))
+	defer w.Close()
+
+	test.Type(s.field, "fyne")
+	// the suggestions are shown under the timer lock
+	suggested := func() ([]string, *widget.PopUp) {
+		s.timerLock.Lock()
+		defer s.timerLock.Unlock()
+		return s.field.Options, s.field.popupMenu
+	}
+	assert.Eventually(t, func() bool {
+		options, _ := suggested()
+		return len(options) > 0
+	}, time.Second, 10*time.Millisecond)
+	options, popUp := suggested()
+	assert.Equal(t, []string{"fyne widgets", "fyne themes"}, options)
+	require.NotNil(t, popUp)
+	assert.True(t, popUp.Visible())
+}
+
+func TestSearchEntry_Disable(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	s := NewSearchEntry(nil)
+	w := test.NewWindow(s)
+	defer w.Close()
+
+	s.Disable()
+	assert.True(t, s.field.Disabled())
+	s.Enable()
+	assert.False(t, s.field.Disabled())
+}
+
+func TestSearchEntry_OnSubmitted(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	rec := &searchRecorder{}
+	s := NewSearchEntry(nil)
+	s.OnSubmitted = rec.search
+	w := test.NewWindow(s)
+	defer w.Close()
+
+	test.Type(s.field, "fyne")
+	s.field.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, []string{"fyne"}, rec.get())
+
+	s.SetText("query")
+	assert.Equal(t, []string{"fyne"}, rec.get(), "setting the text should not submit it")
+}
+
+func TestSearchEntry_History(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	s := NewSearchEntry(nil)
+	s.HistoryKey = "searches"
+	s.MaxHistory = 2
+	w := test.NewWindow(s)
+	w.Resize(fyne.NewSize(300, 300))
+	defer w.Close()
+
+	for _, q := range []string{"one", "two", "one", "three"} {
+		s.SetText(q)
+		s.field.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	}
+	assert.Equal(t, []string{"three", "one"}, s.History())
+	assert.Equal(t, []string{"three", "one"}, fyne.CurrentApp().Preferences().StringList("searches"))
+
+	s.Clear()
+	test.Tap(s.field)
+	assert.Equal(t, []string{"three", "one"}, s.field.Options)
+	assert.True(t, s.field.popupMenu.Visible())
+
+	w.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	w.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	w.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, "one", s.Text())
+	assert.Equal(t, []string{"one", "three"}, s.History())
+
+	s.ClearHistory()
+	assert.Empty(t, s.History())
+}
+
+func TestSearchEntry_Scopes(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	rec := &searchRecorder{}
+	s := NewSearchEntry(rec.search)
+	oneRow := s.MinSize().Height
+	s.Scopes = []string{"All", "Artists", "Albums"}
+	s.Refresh()
+	var scope string
+	s.OnScopeChanged = func(sc string) {
+		scope = sc
+	}
+	w := test.NewWindow(s)
+	defer w.Close()
+
+	assert.Greater(t, s.MinSize().Height, oneRow)
+	assert.Equal(t, "All", s.SelectedScope())
+	assert.Len(t, s.scopeChips, 3)
+	assert.True(t, s.scopeChips[0].Selected)
+
+	s.SetText("abba")
+	test.Tap(s.scopeChips[2])
+	assert.Equal(t, "Albums", s.SelectedScope())
+	assert.Equal(t, "Albums", scope)
+	assert.True(t, s.scopeChips[2].Selected)
+	assert.False(t, s.scopeChips[0].Selected)
+	assert.Equal(t, []string{"abba", "abba"}, rec.get(), "changing the scope searches again")
+}
@@ -0,0 +1,514 @@
+package widget
+
+import (
+	"image/color"
+	"sort"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*SegmentedControl)(nil)
+var _ fyne.Tappable = (*SegmentedControl)(nil)
+var _ fyne.Focusable = (*SegmentedControl)(nil)
+var _ fyne.Disableable = (*SegmentedControl)(nil)
+var _ desktop.Hoverable = (*SegmentedControl)(nil)
+
+const segmentedAnimationDuration = 150 * time.Millisecond
+
+// SegmentedItem is an option of a SegmentedControl, shown with its text, its icon or both.
+type SegmentedItem struct {
+	Text string
+	Icon fyne.Resource
+}
+
+// SegmentedControl shows options as a row of joined buttons of the same width, as a compact alternative to
+// widget.RadioGroup. One option is selected at a time, and the selection slides to the tapped option, unless
+// MultiSelect is set so that each tap toggles an option.
+type SegmentedControl struct {
+	widget.DisableableWidget
+
+	// Items are the options, call Refresh after changing them.
+	Items []SegmentedItem
+	// MultiSelect lets any number of the options be selected.
+	MultiSelect bool
+
+	// OnChanged is called with the indexes of the selected options when the selection changes.
+	OnChanged func(selected []int) `json:"-"`
+
+	selected []int
+	hovered  int // -1 if the pointer is not over an option
+	current  int // the option that keys act on while focused
+	focused  bool
+}
+
+// NewSegmentedControl creates a new control with an option for each text, with a change handler.
+func NewSegmentedControl(options []string, changed func(selected []int)) *SegmentedControl {
+	items := make([]SegmentedItem, len(options))
+	for i, text := range options {
+		items[i].Text = text
+	}
+	return NewSegmentedControlWithItems(items, changed)
+}
+
+// NewSegmentedControlWithItems creates a new control with options that have icons, with a change handler.
+func NewSegmentedControlWithItems(items []SegmentedItem, changed func(selected []int)) *SegmentedControl {
+	s := &SegmentedControl{Items: items, OnChanged: changed, hovered: -1}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (s *SegmentedControl) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	r := &segmentedControlRenderer{
+		control:   s,
+		track:     canvas.NewRectangle(color.Transparent),
+		indicator: canvas.NewRectangle(color.Transparent),
+		hover:     canvas.NewRectangle(color.Transparent),
+		slide:     -1,
+	}
+	r.Refresh()
+	return r
+}
+
+// FocusGained is called when the control has been given focus.
+//
+// Implements: fyne.Focusable
+func (s *SegmentedControl) FocusGained() {
+	s.focused = true
+	s.Refresh()
+}
+
+// FocusLost is called when the control has had focus removed.
+//
+// Implements: fyne.Focusable
+func (s *SegmentedControl) FocusLost() {
+	s.focused = false
+	s.Refresh()
+}
+
+// MouseIn is called when a desktop pointer enters the widget.
+//
+// Implements: desktop.Hoverable
+func (s *SegmentedControl) MouseIn(ev *desktop.MouseEvent) {
+	s.MouseMoved(ev)
+}
+
+// MouseMoved is called when a desktop pointer hovers over the widget.
+//
+// Implements: desktop.Hoverable
+func (s *SegmentedControl) MouseMoved(ev *desktop.MouseEvent) {
+	if i := s.indexAt(ev.Position); i != s.hovered {
+		s.hovered = i
+		s.Refresh()
+	}
+}
+
+// MouseOut is called when a desktop pointer exits the widget.
+//
+// Implements: desktop.Hoverable
+func (s *SegmentedControl) MouseOut() {
+	s.hovered = -1
+	s.Refresh()
+}
+
+// Selected returns the index of the selected option, or the first one if MultiSelect is set,
+// -1 if none is selected.
+func (s *SegmentedControl) Selected() int {
+	if len(s.selected) == 0 {
+		return -1
+	}
+	return s.selected[0]
+}
+
+// SelectedIndexes returns the indexes of the selected options in order.
+func (s *SegmentedControl) SelectedIndexes() []int {
+	return append([]int{}, s.selected...)
+}
+
+// SetSelected selects an option and unselects the others, -1 unselects them all.
+func (s *SegmentedControl) SetSelected(index int) {
+	if index < 0 || index >= len(s.Items) {
+		s.SetSelectedIndexes(nil)
+		return
+	}
+	s.SetSelectedIndexes([]int{index})
+}
+
+// SetSelectedIndexes selects the options at the indexes and unselects the others. Only the first is selected
+// unless MultiSelect is set.
+func (s *SegmentedControl) SetSelectedIndexes(indexes []int) {
+	var selected []int
+	for _, i := range indexes {
+		if i >= 0 && i < len(s.Items) && !containsInt(selected, i) {
+			selected = append(selected, i)
+		}
+	}
+	if !s.MultiSelect && len(selected) > 1 {
+		selected = selected[:1]
+	}
+	sort.Ints(selected)
+	if intsEqual(selected, s.selected) {
+		return
+	}
+	s.selected = selected
+	s.Refresh()
+	if f := s.OnChanged; f != nil {
+		f(s.SelectedIndexes())
+	}
+}
+
+// Tapped selects the tapped option, or toggles it if MultiSelect is set.
+//
+// Implements: fyne.Tappable
+func (s *SegmentedControl) Tapped(ev *fyne.PointEvent) {
+	if s.Disabled() {
+		return
+	}
+	i := s.indexAt(ev.Position)
+	if i < 0 {
+		return
+	}
+	if !s.focused && !fyne.CurrentDevice().IsMobile() {
+		if c := fyne.CurrentApp().Driver().CanvasForObject(s); c != nil {
+			c.Focus(s)
+		}
+	}
+	s.current = i
+	s.choose(i)
+}
+
+// TypedKey moves between the options with the arrow keys. The selection moves too, unless MultiSelect is set
+// and the space key toggles the option.
+//
+// Implements: fyne.Focusable
+func (s *SegmentedControl) TypedKey(key *fyne.KeyEvent) {
+	if s.Disabled() || len(s.Items) == 0 {
+		return
+	}
+	switch key.Name {
+	case fyne.KeyLeft, fyne.KeyUp:
+		s.move(-1)
+	case fyne.KeyRight, fyne.KeyDown:
+		s.move(1)
+	case fyne.KeySpace:
+		s.choose(s.current)
+	}
+}
+
+// TypedRune receives text input events when the control is focused.
+//
+// Implements: fyne.Focusable
+func (s *SegmentedControl) TypedRune(rune) {
+}
+
+// choose selects an option, or toggles it if MultiSelect is set.
+func (s *SegmentedControl) choose(i int) {
+	if !s.MultiSelect {
+		s.SetSelected(i)
+		return
+	}
+
+	var selected []int
+	for _, j := range s.selected {
+		if j != i {
+			selected = append(selected, j)
+		}
+	}
+	if len(selected) == len(s.selected) {
+		selected = append(selected, i)
+	}
+	s.SetSelectedIndexes(selected)
+}
+
+// indexAt returns the option at a position, -1 if there is none.
+func (s *SegmentedControl) indexAt(pos fyne.Position) int {
+	size := s.Size()
+	if len(s.Items) == 0 || pos.X < 0 || pos.X >= size.Width || pos.Y < 0 || pos.Y >= size.Height {
+		return -1
+	}
+	return int(pos.X / (size.Width / float32(len(s.Items))))
+}
+
+func (s *SegmentedControl) move(delta int) {
+	if !s.MultiSelect && s.Selected() >= 0 {
+		s.current = s.Selected()
+	}
+	s.current = (s.current + delta + len(s.Items)) % len(s.Items)
+	if !s.MultiSelect {
+		s.SetSelected(s.current)
+		return
+	}
+	s.Refresh()
+}
+
+func containsInt(list []int, i int) bool {
+	for _, j := range list {
+		if j == i {
+			return true
+		}
+	}
+	return false
+}
+
+func intsEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type segmentedControlRenderer struct {
+	control *SegmentedControl
+
+	track, indicator, hover *canvas.Rectangle
+	highlights              []*canvas.Rectangle // of the selected options if MultiSelect is set
+	dividers                []*canvas.Line
+	labels                  []*canvas.Text
+	icons                   []*canvas.Image
+
+	slide    float32 // the index that the indicator is at, between two while it slides, -1 if not shown
+	anim     *fyne.Animation
+	selected int
+	hovered  int // the option with the hover or focus highlight, -1 if none
+}
+
+func (r *segmentedControlRenderer) Destroy() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+}
+
+func (r *segmentedControlRenderer) Layout(size fyne.Size) {
+	r.track.Resize(size)
+	n := len(r.control.Items)
+	if n == 0 {
+		return
+	}
+	width := size.Width / float32(n)
+	inset := theme.InputBorderSize() * 2
+	segment := func(i float32) (fyne.Position, fyne.Size) {
+		return fyne.NewPos(width*i+inset, inset), fyne.NewSize(width-inset*2, size.Height-inset*2)
+	}
+
+	if r.slide >= 0 {
+		pos, size := segment(r.slide)
+		r.indicator.Move(pos)
+		r.indicator.Resize(size)
+	}
+	for i, h := range r.highlights {
+		pos, size := segment(float32(i))
+		h.Move(pos)
+		h.Resize(size)
+	}
+	if r.hovered >= 0 && r.hovered < n {
+		pos, size := segment(float32(r.hovered))
+		r.hover.Move(pos)
+		r.hover.Resize(size)
+	}
+
+	pad := theme.Padding()
+	for i, d := range r.dividers {
+		x := width * float32(i+1)
+		d.Position1 = fyne.NewPos(x, pad*2)
+		d.Position2 = fyne.NewPos(x, size.Height-pad*2)
+	}
+
+	iconSize := theme.IconInlineSize()
+	for i := range r.labels {
+		content := r.contentWidth(i)
+		x := width*float32(i) + (width-content)/2
+		if r.icons[i].Visible() {
+			r.icons[i].Resize(fyne.NewSquareSize(iconSize))
+			r.icons[i].Move(fyne.NewPos(x, (size.Height-iconSize)/2))
+			x += iconSize + pad
+		}
+		text := r.labels[i].MinSize()
+		r.labels[i].Resize(text)
+		r.labels[i].Move(fyne.NewPos(x, (size.Height-text.Height)/2))
+	}
+}
+
+func (r *segmentedControlRenderer) MinSize() fyne.Size {
+	width := float32(0)
+	for i := range r.labels {
+		width = fyne.Max(width, r.contentWidth(i))
+	}
+	pad := theme.InnerPadding()
+	height := fyne.Max(theme.IconInlineSize(), theme.TextSize()) + pad*2
+	return fyne.NewSize((width+pad*2)*float32(len(r.labels)), height)
+}
+
+func (r *segmentedControlRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.track, r.hover}
+	for _, d := range r.dividers {
+		objects = append(objects, d)
+	}
+	objects = append(objects, r.indicator)
+	for _, h := range r.highlights {
+		objects = append(objects, h)
+	}
+	for i := range r.labels {
+		objects = append(objects, r.icons[i], r.labels[i])
+	}
+	return objects
+}
+
+func (r *segmentedControlRenderer) Refresh() {
+	s := r.control
+	n := len(s.Items)
+	for len(r.labels) < n {
+		r.labels = append(r.labels, canvas.NewText("", color.Transparent))
+		icon := canvas.NewImageFromResource(nil)
+		icon.FillMode = canvas.ImageFillContain
+		r.icons = append(r.icons, icon)
+		r.highlights = append(r.highlights, canvas.NewRectangle(color.Transparent))
+	}
+	r.labels, r.icons, r.highlights = r.labels[:n], r.icons[:n], r.highlights[:n]
+	for len(r.dividers) < n-1 {
+		r.dividers = append(r.dividers, canvas.NewLine(color.Transparent))
+	}
+	if n > 0 {
+		r.dividers = r.dividers[:n-1]
+	}
+
+	r.updateIndicator()
+	r.updateColors()
+	r.Layout(s.Size())
+	canvas.Refresh(s)
+}
+
+// contentWidth returns the width of the icon and text of an option.
+func (r *segmentedControlRenderer) contentWidth(i int) float32 {
+	item := r.control.Items[i]
+	width := float32(0)
+	if item.Icon != nil {
+		width = theme.IconInlineSize()
+		if item.Text != "" {
+			width += theme.Padding()
+		}
+	}
+	if item.Text != "" {
+		width += fyne.MeasureText(item.Text, theme.TextSize(), fyne.TextStyle{}).Width
+	}
+	return width
+}
+
+// updateIndicator slides the indicator to the selected option, or shows it there if it was hidden.
+func (r *segmentedControlRenderer) updateIndicator() {
+	s := r.control
+	selected := s.Selected()
+	if s.MultiSelect || selected < 0 {
+		if r.anim != nil {
+			r.anim.Stop()
+		}
+		r.slide, r.selected = -1, -1
+		return
+	}
+	if selected == r.selected && r.slide >= 0 {
+		return
+	}
+	r.selected = selected
+	if r.slide < 0 {
+		r.slide = float32(selected)
+		return
+	}
+
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+	start, end := r.slide, float32(selected)
+	r.anim = fyne.NewAnimation(segmentedAnimationDuration, func(done float32) {
+		r.slide = start + (end-start)*done
+		r.Layout(s.Size())
+		canvas.Refresh(r.indicator)
+	})
+	r.anim.Curve = fyne.AnimationEaseInOut
+	r.anim.Start()
+}
+
+func (r *segmentedControlRenderer) updateColors() {
+	s := r.control
+	radius := theme.InputRadiusSize()
+	r.track.FillColor = theme.Color(theme.ColorNameInputBackground)
+	r.track.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	r.track.StrokeWidth = theme.InputBorderSize()
+	r.track.CornerRadius = radius
+
+	selectedColor := theme.Color(theme.ColorNamePrimary)
+	if s.Disabled() {
+		selectedColor = theme.Color(theme.ColorNameDisabledButton)
+	}
+	r.indicator.FillColor = selectedColor
+	r.indicator.CornerRadius = radius
+	r.indicator.Hidden = r.slide < 0
+	for i, h := range r.highlights {
+		h.FillColor = selectedColor
+		h.CornerRadius = radius
+		h.Hidden = !s.MultiSelect || !containsInt(s.selected, i)
+	}
+
+	r.hover.CornerRadius = radius
+	r.hovered = -1
+	switch {
+	case s.Disabled():
+		r.hover.FillColor = color.Transparent
+	case s.focused && s.MultiSelect:
+		// the option that the space key toggles
+		r.hovered, r.hover.FillColor = s.current, theme.Color(theme.ColorNameFocus)
+	case s.hovered >= 0:
+		r.hovered, r.hover.FillColor = s.hovered, theme.Color(theme.ColorNameHover)
+	default:
+		r.hover.FillColor = color.Transparent
+	}
+	if s.focused && !s.Disabled() {
+		r.track.StrokeColor = theme.Color(theme.ColorNamePrimary)
+	}
+
+	for i, d := range r.dividers {
+		d.StrokeColor = theme.Color(theme.ColorNameSeparator)
+		d.StrokeWidth = theme.SeparatorThicknessSize()
+		// no divider is drawn next to the selection
+		d.Hidden = containsInt(s.selected, i) || containsInt(s.selected, i+1)
+	}
+
+	for i, item := range s.Items {
+		on := containsInt(s.selected, i)
+		label := r.labels[i]
+		label.Text = item.Text
+		label.TextSize = theme.TextSize()
+		label.TextStyle.Bold = on
+		switch {
+		case s.Disabled():
+			label.Color = theme.Color(theme.ColorNameDisabled)
+		case on:
+			label.Color = theme.Color(theme.ColorNameForegroundOnPrimary)
+		default:
+			label.Color = theme.Color(theme.ColorNameForeground)
+		}
+
+		icon := item.Icon
+		switch {
+		case icon == nil:
+		case s.Disabled():
+			icon = theme.NewDisabledResource(icon)
+		case on:
+			icon = theme.NewInvertedThemedResource(icon)
+		}
+		r.icons[i].Resource = icon
+		r.icons[i].Hidden = icon == nil
+	}
+	canvas.Refresh(r.track)
+}
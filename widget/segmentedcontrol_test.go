@@ -0,0 +1,96 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentedControl_Select(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	var changed []int
+	s := NewSegmentedControl([]string{"Day", "Week", "Month"}, func(selected []int) {
+		changed = selected
+	})
+	w := test.NewWindow(s)
+	defer w.Close()
+	s.Resize(fyne.NewSize(300, s.MinSize().Height))
+	assert.Equal(t, -1, s.Selected())
+
+	test.TapAt(s, fyne.NewPos(150, 5))
+	assert.Equal(t, 1, s.Selected())
+	assert.Equal(t, []int{1}, changed)
+	test.TapAt(s, fyne.NewPos(250, 5))
+	assert.Equal(t, []int{2}, s.SelectedIndexes())
+
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeyRight})
+	assert.Equal(t, 0, s.Selected(), "the arrow keys wrap around")
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft})
+	assert.Equal(t, 2, s.Selected())
+
+	s.SetSelectedIndexes([]int{1, 0})
+	assert.Equal(t, []int{1}, s.SelectedIndexes(), "only one is selected")
+	s.SetSelected(-1)
+	assert.Equal(t, -1, s.Selected())
+
+	s.Disable()
+	test.TapAt(s, fyne.NewPos(10, 5))
+	assert.Equal(t, -1, s.Selected())
+}
+
+func TestSegmentedControl_MultiSelect(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	s := NewSegmentedControlWithItems([]SegmentedItem{
+		{Icon: theme.ContentCutIcon()}, {Icon: theme.ContentCopyIcon(), Text: "Copy"}, {Text: "Paste"},
+	}, nil)
+	s.MultiSelect = true
+	w := test.NewWindow(s)
+	defer w.Close()
+	s.Resize(fyne.NewSize(300, s.MinSize().Height))
+
+	test.TapAt(s, fyne.NewPos(250, 5))
+	test.TapAt(s, fyne.NewPos(50, 5))
+	assert.Equal(t, []int{0, 2}, s.SelectedIndexes())
+	test.TapAt(s, fyne.NewPos(250, 5))
+	assert.Equal(t, []int{0}, s.SelectedIndexes())
+
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft})
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeySpace})
+	assert.Equal(t, []int{0, 1}, s.SelectedIndexes())
+
+	r := test.WidgetRenderer(s).(*segmentedControlRenderer)
+	assert.False(t, r.highlights[0].Hidden)
+	assert.True(t, r.highlights[2].Hidden)
+	assert.True(t, r.indicator.Hidden)
+	assert.True(t, r.dividers[0].Hidden)
+	assert.True(t, r.icons[2].Hidden)
+}
+
+func TestSegmentedControl_Layout(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	s := NewSegmentedControl([]string{"A", "Longer"}, nil)
+	w := test.NewWindow(s)
+	defer w.Close()
+	s.Resize(fyne.NewSize(200, s.MinSize().Height))
+	s.SetSelected(1)
+
+	r := test.WidgetRenderer(s).(*segmentedControlRenderer)
+	assert.False(t, r.indicator.Hidden)
+	assert.Less(t, float32(100), r.indicator.Position().X, "the indicator is over the second option")
+	assert.Less(t, r.labels[1].Position().X, float32(150))
+
+	s.MouseIn(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(10, 5)}})
+	assert.Equal(t, 0, r.hovered)
+	s.MouseOut()
+	assert.Equal(t, -1, r.hovered)
+}
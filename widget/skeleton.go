@@ -0,0 +1,261 @@
+package widget
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Skeleton)(nil)
+
+const skeletonShimmerDuration = time.Second * 3 / 2
+
+// SkeletonShape is the shape of a Skeleton placeholder.
+type SkeletonShape int
+
+const (
+	// SkeletonText is a block of lines of text, the last shorter than the others.
+	SkeletonText SkeletonShape = iota
+	// SkeletonCircle is a circle, such as in place of an avatar.
+	SkeletonCircle
+	// SkeletonRectangle is a rectangle with rounded corners, such as in place of an image.
+	SkeletonRectangle
+)
+
+// Skeleton is a placeholder in the shape of content that is loading, with a shimmer that shows it is busy.
+// Skeletons can be composed in containers to match a layout, as NewSkeletonCard and NewSkeletonListItem do.
+type Skeleton struct {
+	widget.BaseWidget
+
+	Shape SkeletonShape
+	// Lines is the number of lines of text, 1 if not set.
+	Lines int
+	// Min is the minimum size of a circle or rectangle. For text, its width is the minimum and its height
+	// is that of each line.
+	Min fyne.Size
+}
+
+// NewSkeletonCircle returns a placeholder circle of a diameter.
+func NewSkeletonCircle(diameter float32) *Skeleton {
+	s := &Skeleton{Shape: SkeletonCircle, Min: fyne.NewSquareSize(diameter)}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// NewSkeletonRectangle returns a placeholder rectangle of a minimum size.
+func NewSkeletonRectangle(min fyne.Size) *Skeleton {
+	s := &Skeleton{Shape: SkeletonRectangle, Min: min}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// NewSkeletonText returns a placeholder for lines of text.
+func NewSkeletonText(lines int) *Skeleton {
+	s := &Skeleton{Shape: SkeletonText, Lines: lines}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// NewSkeletonCard returns a placeholder for a card, with an image above a title and two lines of text.
+func NewSkeletonCard() fyne.CanvasObject {
+	image := NewSkeletonRectangle(fyne.NewSize(theme.IconInlineSize()*10, theme.IconInlineSize()*5))
+	title := NewSkeletonText(1)
+	title.Min = fyne.NewSize(0, theme.TextHeadingSize())
+	return container.NewVBox(image, title, NewSkeletonText(2))
+}
+
+// NewSkeletonListItem returns a placeholder for an item of a list, with an avatar beside two lines of text.
+func NewSkeletonListItem() fyne.CanvasObject {
+	avatar := NewSkeletonCircle(theme.IconInlineSize() * 2)
+	return container.NewBorder(nil, nil, container.NewCenter(avatar), nil, NewSkeletonText(2))
+}
+
+// NewSkeletonLoader returns a container showing skeleton until load, run in the background, returns the content.
+func NewSkeletonLoader(skeleton fyne.CanvasObject, load func() fyne.CanvasObject) *fyne.Container {
+	c := container.NewStack(skeleton)
+	go func() {
+		content := load()
+		c.Objects = []fyne.CanvasObject{content}
+		c.Refresh()
+	}()
+	return c
+}
+
+// NewSkeletonLoaderWithData returns a container showing skeleton until the data is true, and content after.
+// It shows skeleton again if the data becomes false.
+func NewSkeletonLoaderWithData(skeleton, content fyne.CanvasObject, loaded binding.Bool) *fyne.Container {
+	c := container.NewStack(skeleton)
+	newBoolBinder(loaded, func(done bool) {
+		shown := skeleton
+		if done {
+			shown = content
+		}
+		if c.Objects[0] == shown {
+			return
+		}
+		c.Objects = []fyne.CanvasObject{shown}
+		c.Refresh()
+	})
+	return c
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (s *Skeleton) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	r := &skeletonRenderer{skeleton: s}
+	r.Refresh()
+	r.shimmer = fyne.NewAnimation(skeletonShimmerDuration, r.animate)
+	r.shimmer.Curve = fyne.AnimationLinear
+	r.shimmer.RepeatCount = fyne.AnimationRepeatForever
+	r.shimmer.Start()
+	return r
+}
+
+func (s *Skeleton) lines() int {
+	if s.Shape != SkeletonText {
+		return 1
+	}
+	if s.Lines < 1 {
+		return 1
+	}
+	return s.Lines
+}
+
+type skeletonRenderer struct {
+	skeleton *Skeleton
+	circle   *canvas.Circle
+	blocks   []*canvas.Rectangle
+	shimmer  *fyne.Animation
+	phase    float32
+}
+
+func (r *skeletonRenderer) Destroy() {
+	if r.shimmer != nil {
+		r.shimmer.Stop()
+	}
+}
+
+func (r *skeletonRenderer) Layout(size fyne.Size) {
+	s := r.skeleton
+	switch s.Shape {
+	case SkeletonCircle:
+		diameter := fyne.Min(size.Width, size.Height)
+		r.circle.Resize(fyne.NewSquareSize(diameter))
+		r.circle.Move(fyne.NewPos((size.Width-diameter)/2, (size.Height-diameter)/2))
+	case SkeletonRectangle:
+		r.blocks[0].Resize(size)
+		r.blocks[0].Move(fyne.NewPos(0, 0))
+	default:
+		pad := theme.InnerPadding()
+		height := r.lineHeight()
+		y := pad
+		for i, b := range r.blocks {
+			width := size.Width - pad*2
+			if i == len(r.blocks)-1 && len(r.blocks) > 1 {
+				width *= .6
+			}
+			b.Resize(fyne.NewSize(width, height))
+			b.Move(fyne.NewPos(pad, y))
+			y += height + theme.LineSpacing()*2
+		}
+	}
+}
+
+func (r *skeletonRenderer) MinSize() fyne.Size {
+	s := r.skeleton
+	if s.Shape != SkeletonText {
+		return s.Min
+	}
+	lines := float32(len(r.blocks))
+	height := r.lineHeight()*lines + theme.LineSpacing()*2*(lines-1) + theme.InnerPadding()*2
+	return fyne.NewSize(fyne.Max(s.Min.Width, theme.IconInlineSize()*4), height)
+}
+
+func (r *skeletonRenderer) Objects() []fyne.CanvasObject {
+	if r.skeleton.Shape == SkeletonCircle {
+		return []fyne.CanvasObject{r.circle}
+	}
+	objects := make([]fyne.CanvasObject, len(r.blocks))
+	for i, b := range r.blocks {
+		objects[i] = b
+	}
+	return objects
+}
+
+func (r *skeletonRenderer) Refresh() {
+	s := r.skeleton
+	if s.Shape == SkeletonCircle && r.circle == nil {
+		r.circle = canvas.NewCircle(color.Transparent)
+	}
+	lines := s.lines()
+	for len(r.blocks) < lines {
+		r.blocks = append(r.blocks, canvas.NewRectangle(color.Transparent))
+	}
+	r.blocks = r.blocks[:lines]
+
+	radius := theme.InputRadiusSize()
+	if s.Shape == SkeletonText {
+		radius = r.lineHeight() / 2
+	}
+	for _, b := range r.blocks {
+		b.CornerRadius = radius
+	}
+	r.updateColors()
+	r.Layout(s.Size())
+	canvas.Refresh(s)
+}
+
+func (r *skeletonRenderer) animate(done float32) {
+	r.phase = done
+	r.updateColors()
+	if r.circle != nil {
+		r.circle.Refresh()
+	}
+	for _, b := range r.blocks {
+		b.Refresh()
+	}
+}
+
+// lineHeight returns the height of a line of text, or that set by Min.
+func (r *skeletonRenderer) lineHeight() float32 {
+	if h := r.skeleton.Min.Height; h > 0 {
+		return h
+	}
+	return theme.TextSize()
+}
+
+// updateColors brightens the shapes in a wave, each line a little after the one above.
+func (r *skeletonRenderer) updateColors() {
+	base := theme.Color(theme.ColorNameDisabledButton)
+	light := theme.Color(theme.ColorNameBackground)
+	wave := func(delay float32) color.Color {
+		t := .5 - .5*math.Cos(2*math.Pi*float64(r.phase-delay))
+		return skeletonMix(base, light, float32(t)*.6)
+	}
+	if r.circle != nil {
+		r.circle.FillColor = wave(0)
+	}
+	for i, b := range r.blocks {
+		b.FillColor = wave(float32(i) * .1)
+	}
+}
+
+// skeletonMix returns the colour a part t, from 0 to 1, of the way from a to b.
+func skeletonMix(a, b color.Color, t float32) color.Color {
+	an := color.NRGBAModel.Convert(a).(color.NRGBA)
+	bn := color.NRGBAModel.Convert(b).(color.NRGBA)
+	mix := func(x, y uint8) uint8 {
+		return uint8(float32(x) + (float32(y)-float32(x))*t)
+	}
+	return color.NRGBA{R: mix(an.R, bn.R), G: mix(an.G, bn.G), B: mix(an.B, bn.B), A: mix(an.A, bn.A)}
+}
@@ -0,0 +1,112 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkeleton_Text(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	s := NewSkeletonText(3)
+	s.Resize(fyne.NewSize(200, s.MinSize().Height))
+	r := test.WidgetRenderer(s).(*skeletonRenderer)
+	assert.Len(t, r.Objects(), 3)
+	full := 200 - theme.InnerPadding()*2
+	assert.Equal(t, full, r.blocks[0].Size().Width)
+	assert.Equal(t, full*.6, r.blocks[2].Size().Width)
+	assert.Less(t, r.blocks[0].Position().Y, r.blocks[1].Position().Y)
+	// the waves of the lines are out of step
+	r.animate(.25)
+	assert.NotEqual(t, r.blocks[0].FillColor, r.blocks[2].FillColor)
+
+	s.Lines = 1
+	s.Refresh()
+	assert.Len(t, r.Objects(), 1)
+	assert.Equal(t, full, r.blocks[0].Size().Width)
+}
+
+func TestSkeleton_Shapes(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	c := NewSkeletonCircle(40)
+	assert.Equal(t, fyne.NewSquareSize(40), c.MinSize())
+	c.Resize(fyne.NewSize(60, 40))
+	r := test.WidgetRenderer(c).(*skeletonRenderer)
+	assert.Equal(t, []fyne.CanvasObject{r.circle}, r.Objects())
+	assert.Equal(t, fyne.NewPos(10, 0), r.circle.Position())
+
+	rect := NewSkeletonRectangle(fyne.NewSize(100, 50))
+	rect.Resize(fyne.NewSize(120, 50))
+	r = test.WidgetRenderer(rect).(*skeletonRenderer)
+	assert.Equal(t, fyne.NewSize(120, 50), r.blocks[0].Size())
+
+	assert.Greater(t, NewSkeletonCard().MinSize().Height, float32(100))
+	assert.NotZero(t, NewSkeletonListItem().MinSize().Width)
+}
+
+func TestSkeleton_Loader(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	skeleton := NewSkeletonListItem()
+	content := newRefreshedLabel("Loaded")
+	ready := make(chan struct{})
+	c := NewSkeletonLoader(skeleton, func() fyne.CanvasObject {
+		<-ready
+		return content
+	})
+	assert.Equal(t, skeleton, c.Objects[0])
+	close(ready)
+	select {
+	case <-content.refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("the content was not shown")
+	}
+	assert.Equal(t, content, c.Objects[0])
+
+	loaded := binding.NewBool()
+	c = NewSkeletonLoaderWithData(skeleton, content, loaded)
+	waitForBinding()
+	assert.Equal(t, skeleton, c.Objects[0])
+	_ = loaded.Set(true)
+	waitForBinding()
+	assert.Equal(t, content, c.Objects[0])
+}
+
+// refreshedLabel is a label that reports being refreshed, which a container does after it shows the label.
+type refreshedLabel struct {
+	widget.Label
+	refreshed chan struct{}
+}
+
+func newRefreshedLabel(text string) *refreshedLabel {
+	l := &refreshedLabel{refreshed: make(chan struct{}, 1)}
+	l.Text = text
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+func (l *refreshedLabel) Refresh() {
+	l.Label.Refresh()
+	select {
+	case l.refreshed <- struct{}{}:
+	default:
+	}
+}
+
+func TestSkeletonMix(t *testing.T) {
+	black, white := color.NRGBA{A: 0xff}, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	assert.Equal(t, black, skeletonMix(black, white, 0))
+	assert.Equal(t, color.NRGBA{R: 0x7f, G: 0x7f, B: 0x7f, A: 0xff}, skeletonMix(black, white, .5))
+}
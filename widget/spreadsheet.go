@@ -0,0 +1,514 @@
+package widget
+
+import (
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Spreadsheet)(nil)
+var _ fyne.Shortcutable = (*Spreadsheet)(nil)
+
+// CellRange describes a rectangular group of cells in a Spreadsheet, both ends inclusive.
+type CellRange struct {
+	Start, End widget.TableCellID
+}
+
+// Contains returns true if the cell id is inside this range.
+func (r CellRange) Contains(id widget.TableCellID) bool {
+	n := r.normalized()
+	return id.Row >= n.Start.Row && id.Row <= n.End.Row && id.Col >= n.Start.Col && id.Col <= n.End.Col
+}
+
+func (r CellRange) normalized() CellRange {
+	if r.Start.Row > r.End.Row {
+		r.Start.Row, r.End.Row = r.End.Row, r.Start.Row
+	}
+	if r.Start.Col > r.End.Col {
+		r.Start.Col, r.End.Col = r.End.Col, r.Start.Col
+	}
+	return r
+}
+
+// Spreadsheet is a grid of editable cells with row and column headers.
+// Cells can be selected individually, by range (shift click) or by tapping a header,
+// ranges can be copied and pasted as tab separated values and the leading rows and
+// columns can be frozen so that they do not scroll.
+//
+// When Formulas is enabled, cells starting with "=" are evaluated. Formulas support
+// numbers, cell references such as B2, the + - * / operators and the SUM, AVERAGE,
+// MIN, MAX and COUNT functions over cells or ranges such as A1:A10.
+type Spreadsheet struct {
+	widget.Table
+
+	// Formulas enables evaluation of cells beginning with "=".
+	Formulas bool
+	// OnCellChanged is called when the user changes the content of a cell, by editing, clearing,
+	// cutting or pasting. It is not called for changes made by SetCell or Paste.
+	OnCellChanged func(id widget.TableCellID, content string) `json:"-"`
+
+	rows, cols int
+	cells      map[widget.TableCellID]string
+	selection  CellRange
+	selected   bool
+	shiftDown  bool
+	editing    *widget.TableCellID
+}
+
+// NewSpreadsheet creates a new spreadsheet with the given number of rows and columns.
+func NewSpreadsheet(rows, cols int) *Spreadsheet {
+	s := &Spreadsheet{rows: rows, cols: cols, cells: make(map[widget.TableCellID]string)}
+	s.Length = func() (int, int) {
+		return s.rows, s.cols
+	}
+	s.CreateCell = func() fyne.CanvasObject {
+		return newSpreadsheetCell(s)
+	}
+	s.UpdateCell = func(id widget.TableCellID, o fyne.CanvasObject) {
+		o.(*spreadsheetCell).update(id)
+	}
+	s.ShowHeaderRow = true
+	s.ShowHeaderColumn = true
+	s.CreateHeader = func() fyne.CanvasObject {
+		return newSpreadsheetHeader(s)
+	}
+	s.UpdateHeader = func(id widget.TableCellID, o fyne.CanvasObject) {
+		o.(*spreadsheetHeader).update(id)
+	}
+	s.OnSelected = func(id widget.TableCellID) {
+		s.selectCell(id, false)
+	}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// Cell returns the raw content of a cell, for formulas this is the formula itself.
+func (s *Spreadsheet) Cell(id widget.TableCellID) string {
+	return s.cells[id]
+}
+
+// SetCell sets the raw content of a cell and refreshes the sheet.
+func (s *Spreadsheet) SetCell(id widget.TableCellID, content string) {
+	s.setCell(id, content)
+	s.Refresh()
+}
+
+// Value returns the text displayed for a cell. If formulas are enabled and the cell
+// contains one, this is the evaluated result or an error code such as "#REF!".
+func (s *Spreadsheet) Value(id widget.TableCellID) string {
+	raw := s.cells[id]
+	if !s.Formulas || !strings.HasPrefix(raw, "=") {
+		return raw
+	}
+
+	v, err := s.evaluate(id, map[widget.TableCellID]bool{})
+	if err != nil {
+		return err.Error()
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// Dimensions returns the number of rows and columns in this spreadsheet.
+func (s *Spreadsheet) Dimensions() (rows, cols int) {
+	return s.rows, s.cols
+}
+
+// SetDimensions changes the number of rows and columns, content outside the new bounds is dropped.
+func (s *Spreadsheet) SetDimensions(rows, cols int) {
+	s.rows, s.cols = rows, cols
+	for id := range s.cells {
+		if id.Row >= rows || id.Col >= cols {
+			delete(s.cells, id)
+		}
+	}
+	s.Refresh()
+}
+
+// SetFrozen keeps the first rows and columns of the sheet in place while the rest scrolls.
+func (s *Spreadsheet) SetFrozen(rows, cols int) {
+	s.StickyRowCount = rows
+	s.StickyColumnCount = cols
+	s.Refresh()
+}
+
+// Selection returns the currently selected range of cells.
+// The second return value is false if nothing is selected.
+func (s *Spreadsheet) Selection() (CellRange, bool) {
+	return s.selection.normalized(), s.selected
+}
+
+// SelectRange selects a rectangular range of cells.
+func (s *Spreadsheet) SelectRange(r CellRange) {
+	s.selection = r
+	s.selected = true
+	s.Refresh()
+}
+
+// CopySelection returns the selected cells as tab separated values.
+func (s *Spreadsheet) CopySelection() string {
+	if !s.selected {
+		return ""
+	}
+
+	r := s.selection.normalized()
+	var b strings.Builder
+	for row := r.Start.Row; row <= r.End.Row; row++ {
+		for col := r.Start.Col; col <= r.End.Col; col++ {
+			if col > r.Start.Col {
+				b.WriteRune('\t')
+			}
+			b.WriteString(s.Value(widget.TableCellID{Row: row, Col: col}))
+		}
+		b.WriteRune('\n')
+	}
+	return b.String()
+}
+
+// Paste inserts tab separated values with the top left value at the start of the selection.
+// Values that would fall outside of the sheet are ignored.
+func (s *Spreadsheet) Paste(tsv string) {
+	s.paste(tsv, s.setCell)
+}
+
+// paste inserts tab separated values like Paste, setting each cell with set.
+func (s *Spreadsheet) paste(tsv string, set func(widget.TableCellID, string) bool) {
+	if !s.selected {
+		return
+	}
+
+	start := s.selection.normalized().Start
+	end := start
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(tsv, "\r\n", "\n"), "\n"), "\n")
+	for r, line := range lines {
+		for c, value := range strings.Split(line, "\t") {
+			id := widget.TableCellID{Row: start.Row + r, Col: start.Col + c}
+			if id.Row >= s.rows || id.Col >= s.cols {
+				continue
+			}
+			set(id, value)
+			if id.Col > end.Col {
+				end.Col = id.Col
+			}
+			end.Row = id.Row
+		}
+	}
+	s.selection = CellRange{Start: start, End: end}
+	s.Refresh()
+}
+
+// TypedKey handles key presses, Return starts editing the selected cell and Delete clears the selection.
+//
+// Implements: fyne.Focusable
+func (s *Spreadsheet) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyReturn, fyne.KeyEnter, fyne.KeyF2:
+		if s.selected {
+			s.startEdit(s.selection.Start)
+		}
+	case fyne.KeyDelete, fyne.KeyBackspace:
+		s.clearSelection()
+	default:
+		s.Table.TypedKey(key)
+	}
+}
+
+// TypedShortcut handles the copy, cut and paste shortcuts for the selected range.
+//
+// Implements: fyne.Shortcutable
+func (s *Spreadsheet) TypedShortcut(shortcut fyne.Shortcut) {
+	switch sh := shortcut.(type) {
+	case *fyne.ShortcutCopy:
+		sh.Clipboard.SetContent(s.CopySelection())
+	case *fyne.ShortcutCut:
+		sh.Clipboard.SetContent(s.CopySelection())
+		s.clearSelection()
+	case *fyne.ShortcutPaste:
+		s.paste(sh.Clipboard.Content(), s.editCell)
+	}
+}
+
+func (s *Spreadsheet) clearSelection() {
+	if !s.selected {
+		return
+	}
+	r := s.selection.normalized()
+	for row := r.Start.Row; row <= r.End.Row; row++ {
+		for col := r.Start.Col; col <= r.End.Col; col++ {
+			s.editCell(widget.TableCellID{Row: row, Col: col}, "")
+		}
+	}
+	s.Refresh()
+}
+
+// cancelEdit stops editing a cell without changing its content.
+func (s *Spreadsheet) cancelEdit(id widget.TableCellID) {
+	if s.editing == nil || *s.editing != id {
+		return
+	}
+	s.endEdit()
+}
+
+func (s *Spreadsheet) commitEdit(id widget.TableCellID, content string) {
+	if s.editing == nil || *s.editing != id {
+		return
+	}
+	s.editCell(id, content)
+	s.endEdit()
+}
+
+func (s *Spreadsheet) endEdit() {
+	s.editing = nil
+	s.Refresh()
+	if c := fyne.CurrentApp().Driver().CanvasForObject(s); c != nil {
+		c.Focus(s)
+	}
+}
+
+func (s *Spreadsheet) evaluate(id widget.TableCellID, visiting map[widget.TableCellID]bool) (float64, error) {
+	if visiting[id] {
+		return 0, errFormulaCycle
+	}
+	visiting[id] = true
+	defer delete(visiting, id)
+
+	return evalFormula(strings.TrimPrefix(s.cells[id], "="), func(ref widget.TableCellID) (float64, bool, error) {
+		if ref.Row < 0 || ref.Col < 0 || ref.Row >= s.rows || ref.Col >= s.cols {
+			return 0, false, errFormulaRef
+		}
+		raw := strings.TrimSpace(s.cells[ref])
+		if strings.HasPrefix(raw, "=") {
+			v, err := s.evaluate(ref, visiting)
+			return v, err == nil, err
+		}
+		if raw == "" {
+			return 0, false, nil
+		}
+		v, err := strconv.ParseFloat(raw, 64)
+		return v, err == nil, nil
+	})
+}
+
+func (s *Spreadsheet) selectCell(id widget.TableCellID, extend bool) {
+	if extend && s.selected {
+		s.selection.End = id
+	} else {
+		s.selection = CellRange{Start: id, End: id}
+	}
+	s.selected = true
+	s.Refresh()
+}
+
+func (s *Spreadsheet) selectHeader(id widget.TableCellID) {
+	switch {
+	case id.Row < 0 && id.Col < 0:
+		s.selection = CellRange{End: widget.TableCellID{Row: s.rows - 1, Col: s.cols - 1}}
+	case id.Row < 0:
+		s.selection = CellRange{Start: widget.TableCellID{Col: id.Col}, End: widget.TableCellID{Row: s.rows - 1, Col: id.Col}}
+	default:
+		s.selection = CellRange{Start: widget.TableCellID{Row: id.Row}, End: widget.TableCellID{Row: id.Row, Col: s.cols - 1}}
+	}
+	s.selected = true
+	s.Refresh()
+}
+
+// editCell sets a cell that the user changed, calling OnCellChanged if its content is different.
+// It returns false if the cell already had that content.
+func (s *Spreadsheet) editCell(id widget.TableCellID, content string) bool {
+	if !s.setCell(id, content) {
+		return false
+	}
+	if f := s.OnCellChanged; f != nil {
+		f(id, content)
+	}
+	return true
+}
+
+// setCell sets the content of a cell, returning false if it already had that content.
+func (s *Spreadsheet) setCell(id widget.TableCellID, content string) bool {
+	if s.cells[id] == content {
+		return false
+	}
+	if content == "" {
+		delete(s.cells, id)
+	} else {
+		s.cells[id] = content
+	}
+	return true
+}
+
+func (s *Spreadsheet) startEdit(id widget.TableCellID) {
+	// blur the table first, its FocusLost refresh would otherwise focus the entry while focus is changing
+	if c := fyne.CurrentApp().Driver().CanvasForObject(s); c != nil {
+		c.Unfocus()
+	}
+	s.editing = &id
+	s.RefreshItem(id)
+}
+
+type spreadsheetCell struct {
+	widget.BaseWidget
+	sheet *Spreadsheet
+	id    widget.TableCellID
+
+	bg    *canvas.Rectangle
+	label *widget.Label
+	entry *spreadsheetEntry
+}
+
+func newSpreadsheetCell(s *Spreadsheet) *spreadsheetCell {
+	c := &spreadsheetCell{sheet: s, bg: canvas.NewRectangle(theme.Color(theme.ColorNameSelection)),
+		label: widget.NewLabel("")}
+	c.label.Truncation = fyne.TextTruncateEllipsis
+	c.entry = newSpreadsheetEntry(c)
+	c.entry.Hide()
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+func (c *spreadsheetCell) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewStack(c.bg, c.label, c.entry))
+}
+
+// DoubleTapped starts editing the cell.
+//
+// Implements: fyne.DoubleTappable
+func (c *spreadsheetCell) DoubleTapped(_ *fyne.PointEvent) {
+	c.sheet.selectCell(c.id, false)
+	c.sheet.startEdit(c.id)
+}
+
+// MouseDown records if shift is pressed so that a tap can extend the selection.
+//
+// Implements: desktop.Mouseable
+func (c *spreadsheetCell) MouseDown(e *desktop.MouseEvent) {
+	c.sheet.shiftDown = e.Modifier&fyne.KeyModifierShift != 0
+}
+
+// MouseUp is called when a mouse button is released over the cell.
+//
+// Implements: desktop.Mouseable
+func (c *spreadsheetCell) MouseUp(_ *desktop.MouseEvent) {
+}
+
+// Tapped selects the cell, or extends the selection if shift is held.
+//
+// Implements: fyne.Tappable
+func (c *spreadsheetCell) Tapped(_ *fyne.PointEvent) {
+	c.sheet.selectCell(c.id, c.sheet.shiftDown)
+	c.sheet.shiftDown = false
+	if cnv := fyne.CurrentApp().Driver().CanvasForObject(c.sheet); cnv != nil {
+		cnv.Focus(c.sheet)
+	}
+}
+
+func (c *spreadsheetCell) update(id widget.TableCellID) {
+	c.id = id
+	c.bg.FillColor = theme.Color(theme.ColorNameSelection)
+	c.bg.Hidden = !c.sheet.selected || !c.sheet.selection.Contains(id)
+	c.bg.Refresh()
+
+	if e := c.sheet.editing; e != nil && *e == id {
+		c.label.Hide()
+		c.entry.SetText(c.sheet.Cell(id))
+		c.entry.Show()
+		if cnv := fyne.CurrentApp().Driver().CanvasForObject(c); cnv != nil {
+			cnv.Focus(c.entry)
+		}
+		return
+	}
+
+	c.entry.Hide()
+	c.label.SetText(c.sheet.Value(id))
+	c.label.Show()
+}
+
+type spreadsheetEntry struct {
+	widget.Entry
+	cell *spreadsheetCell
+}
+
+func newSpreadsheetEntry(c *spreadsheetCell) *spreadsheetEntry {
+	e := &spreadsheetEntry{cell: c}
+	e.OnSubmitted = func(s string) {
+		c.sheet.commitEdit(c.id, s)
+	}
+	e.ExtendBaseWidget(e)
+	return e
+}
+
+// FocusLost commits the edit when the user moves to another object.
+//
+// Implements: fyne.Focusable
+func (e *spreadsheetEntry) FocusLost() {
+	e.Entry.FocusLost()
+	if e.Visible() {
+		e.cell.sheet.commitEdit(e.cell.id, e.Text)
+	}
+}
+
+// TypedKey cancels the edit if Escape is pressed.
+//
+// Implements: fyne.Focusable
+func (e *spreadsheetEntry) TypedKey(key *fyne.KeyEvent) {
+	if key.Name == fyne.KeyEscape {
+		e.cell.sheet.cancelEdit(e.cell.id)
+		return
+	}
+	e.Entry.TypedKey(key)
+}
+
+type spreadsheetHeader struct {
+	widget.BaseWidget
+	sheet *Spreadsheet
+	id    widget.TableCellID
+
+	bg    *canvas.Rectangle
+	label *widget.Label
+}
+
+func newSpreadsheetHeader(s *Spreadsheet) *spreadsheetHeader {
+	h := &spreadsheetHeader{sheet: s, bg: canvas.NewRectangle(theme.Color(theme.ColorNameHover)),
+		label: widget.NewLabelWithStyle("00", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})}
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+func (h *spreadsheetHeader) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewStack(h.bg, h.label))
+}
+
+// Tapped selects the entire row or column of this header.
+//
+// Implements: fyne.Tappable
+func (h *spreadsheetHeader) Tapped(_ *fyne.PointEvent) {
+	h.sheet.selectHeader(h.id)
+}
+
+func (h *spreadsheetHeader) update(id widget.TableCellID) {
+	h.id = id
+	switch {
+	case id.Row < 0 && id.Col < 0:
+		h.label.SetText("")
+	case id.Row < 0:
+		h.label.SetText(columnName(id.Col))
+	default:
+		h.label.SetText(strconv.Itoa(id.Row + 1))
+	}
+
+	highlight := false
+	if h.sheet.selected {
+		r := h.sheet.selection.normalized()
+		highlight = (id.Row < 0 && id.Col >= r.Start.Col && id.Col <= r.End.Col) ||
+			(id.Col < 0 && id.Row >= r.Start.Row && id.Row <= r.End.Row)
+	}
+	h.bg.FillColor = theme.Color(theme.ColorNameHover)
+	h.bg.Hidden = !highlight
+	h.bg.Refresh()
+}
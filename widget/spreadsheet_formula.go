@@ -0,0 +1,326 @@
+package widget
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"fyne.io/fyne/v2/widget"
+)
+
+var (
+	errFormulaSyntax = errors.New("#ERR!")
+	errFormulaRef    = errors.New("#REF!")
+	errFormulaValue  = errors.New("#VALUE!")
+	errFormulaDiv0   = errors.New("#DIV/0!")
+	errFormulaCycle  = errors.New("#CYCLE!")
+	errFormulaName   = errors.New("#NAME?")
+)
+
+// formulaFuncs lists the aggregate functions understood by the formula engine.
+var formulaFuncs = map[string]func([]float64) (float64, error){
+	"SUM": func(v []float64) (float64, error) {
+		sum := 0.0
+		for _, f := range v {
+			sum += f
+		}
+		return sum, nil
+	},
+	"AVERAGE": func(v []float64) (float64, error) {
+		if len(v) == 0 {
+			return 0, errFormulaDiv0
+		}
+		sum := 0.0
+		for _, f := range v {
+			sum += f
+		}
+		return sum / float64(len(v)), nil
+	},
+	"MIN": func(v []float64) (float64, error) {
+		if len(v) == 0 {
+			return 0, nil
+		}
+		min := v[0]
+		for _, f := range v[1:] {
+			if f < min {
+				min = f
+			}
+		}
+		return min, nil
+	},
+	"MAX": func(v []float64) (float64, error) {
+		if len(v) == 0 {
+			return 0, nil
+		}
+		max := v[0]
+		for _, f := range v[1:] {
+			if f > max {
+				max = f
+			}
+		}
+		return max, nil
+	},
+	"COUNT": func(v []float64) (float64, error) {
+		return float64(len(v)), nil
+	},
+}
+
+// columnName returns the spreadsheet style name of a column, such as "A" or "AB".
+func columnName(col int) string {
+	name := []rune{}
+	for col >= 0 {
+		name = append([]rune{'A' + rune(col%26)}, name...)
+		col = col/26 - 1
+	}
+	return string(name)
+}
+
+// parseCellRef parses a reference such as "B12" into a table cell id.
+func parseCellRef(ref string) (widget.TableCellID, bool) {
+	ref = strings.ToUpper(ref)
+	i := 0
+	col := 0
+	for i < len(ref) && ref[i] >= 'A' && ref[i] <= 'Z' {
+		col = col*26 + int(ref[i]-'A') + 1
+		i++
+	}
+	if i == 0 || i == len(ref) {
+		return widget.TableCellID{}, false
+	}
+	row, err := strconv.Atoi(ref[i:])
+	if err != nil || row < 1 {
+		return widget.TableCellID{}, false
+	}
+	return widget.TableCellID{Row: row - 1, Col: col - 1}, true
+}
+
+// formulaParser is a small recursive descent evaluator for spreadsheet formulas.
+// It supports numbers, cell references, the operators + - * / and parentheses
+// as well as the functions listed in formulaFuncs taking cells, ranges or expressions.
+type formulaParser struct {
+	src []rune
+	pos int
+
+	// value resolves a cell reference to its numerical value.
+	// The second return is false if the cell does not contain a number.
+	value func(widget.TableCellID) (float64, bool, error)
+}
+
+func evalFormula(src string, value func(widget.TableCellID) (float64, bool, error)) (float64, error) {
+	p := &formulaParser{src: []rune(src), value: value}
+	v, err := p.expr()
+	if err != nil {
+		return 0, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.src) {
+		return 0, errFormulaSyntax
+	}
+	return v, nil
+}
+
+func (p *formulaParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *formulaParser) peek() rune {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *formulaParser) expr() (float64, error) {
+	left, err := p.term()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		switch p.peek() {
+		case '+':
+			p.pos++
+			right, err := p.term()
+			if err != nil {
+				return 0, err
+			}
+			left += right
+		case '-':
+			p.pos++
+			right, err := p.term()
+			if err != nil {
+				return 0, err
+			}
+			left -= right
+		default:
+			return left, nil
+		}
+	}
+}
+
+func (p *formulaParser) term() (float64, error) {
+	left, err := p.factor()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		switch p.peek() {
+		case '*':
+			p.pos++
+			right, err := p.factor()
+			if err != nil {
+				return 0, err
+			}
+			left *= right
+		case '/':
+			p.pos++
+			right, err := p.factor()
+			if err != nil {
+				return 0, err
+			}
+			if right == 0 {
+				return 0, errFormulaDiv0
+			}
+			left /= right
+		default:
+			return left, nil
+		}
+	}
+}
+
+func (p *formulaParser) factor() (float64, error) {
+	r := p.peek()
+	switch {
+	case r == '-':
+		p.pos++
+		v, err := p.factor()
+		return -v, err
+	case r == '+':
+		p.pos++
+		return p.factor()
+	case r == '(':
+		p.pos++
+		v, err := p.expr()
+		if err != nil {
+			return 0, err
+		}
+		if p.peek() != ')' {
+			return 0, errFormulaSyntax
+		}
+		p.pos++
+		return v, nil
+	case unicode.IsDigit(r) || r == '.':
+		return p.number()
+	case unicode.IsLetter(r):
+		name := p.identifier()
+		if p.peek() == '(' {
+			return p.call(name)
+		}
+		id, ok := parseCellRef(name)
+		if !ok {
+			return 0, errFormulaRef
+		}
+		v, isNum, err := p.value(id)
+		if err != nil {
+			return 0, err
+		}
+		if !isNum {
+			return 0, errFormulaValue
+		}
+		return v, nil
+	}
+	return 0, errFormulaSyntax
+}
+
+func (p *formulaParser) number() (float64, error) {
+	start := p.pos
+	for p.pos < len(p.src) && (unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+		p.pos++
+	}
+	v, err := strconv.ParseFloat(string(p.src[start:p.pos]), 64)
+	if err != nil {
+		return 0, errFormulaSyntax
+	}
+	return v, nil
+}
+
+func (p *formulaParser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.src) && (unicode.IsLetter(p.src[p.pos]) || unicode.IsDigit(p.src[p.pos])) {
+		p.pos++
+	}
+	return strings.ToUpper(string(p.src[start:p.pos]))
+}
+
+func (p *formulaParser) call(name string) (float64, error) {
+	fn, ok := formulaFuncs[name]
+	if !ok {
+		return 0, errFormulaName
+	}
+	p.pos++ // skip '('
+
+	var values []float64
+	if p.peek() == ')' {
+		p.pos++
+		return fn(values)
+	}
+	for {
+		vals, err := p.argument()
+		if err != nil {
+			return 0, err
+		}
+		values = append(values, vals...)
+
+		switch p.peek() {
+		case ',', ';':
+			p.pos++
+		case ')':
+			p.pos++
+			return fn(values)
+		default:
+			return 0, errFormulaSyntax
+		}
+	}
+}
+
+// argument parses a function argument which can be a range like "A1:B4" or any expression.
+// Cells in a range that do not hold a number are ignored, as spreadsheets usually do.
+func (p *formulaParser) argument() ([]float64, error) {
+	start := p.pos
+	if unicode.IsLetter(p.peek()) {
+		first, ok := parseCellRef(p.identifier())
+		if ok && p.peek() == ':' {
+			p.pos++
+			p.skipSpace()
+			last, ok := parseCellRef(p.identifier())
+			if !ok {
+				return nil, errFormulaRef
+			}
+
+			var values []float64
+			r := CellRange{Start: first, End: last}.normalized()
+			for row := r.Start.Row; row <= r.End.Row; row++ {
+				for col := r.Start.Col; col <= r.End.Col; col++ {
+					v, isNum, err := p.value(widget.TableCellID{Row: row, Col: col})
+					if err != nil {
+						return nil, err
+					}
+					if isNum {
+						values = append(values, v)
+					}
+				}
+			}
+			return values, nil
+		}
+		p.pos = start
+	}
+
+	v, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	return []float64{v}, nil
+}
@@ -0,0 +1,113 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpreadsheet_Formulas(t *testing.T) {
+	s := NewSpreadsheet(10, 4)
+	s.SetCell(widget.TableCellID{Row: 0, Col: 0}, "1")
+	s.SetCell(widget.TableCellID{Row: 1, Col: 0}, "2")
+	s.SetCell(widget.TableCellID{Row: 2, Col: 0}, "text")
+	s.SetCell(widget.TableCellID{Row: 3, Col: 0}, "=SUM(A1:A3)")
+
+	assert.Equal(t, "=SUM(A1:A3)", s.Value(widget.TableCellID{Row: 3, Col: 0}))
+	s.Formulas = true
+	assert.Equal(t, "3", s.Value(widget.TableCellID{Row: 3, Col: 0}))
+
+	s.SetCell(widget.TableCellID{Row: 0, Col: 1}, "=AVERAGE(A1, A2) * 2 + (A4 - 1) / 2")
+	assert.Equal(t, "4", s.Value(widget.TableCellID{Row: 0, Col: 1}))
+
+	s.SetCell(widget.TableCellID{Row: 1, Col: 1}, "=A3+1")
+	assert.Equal(t, "#VALUE!", s.Value(widget.TableCellID{Row: 1, Col: 1}))
+	s.SetCell(widget.TableCellID{Row: 1, Col: 1}, "=B3")
+	s.SetCell(widget.TableCellID{Row: 2, Col: 1}, "=B2")
+	assert.Equal(t, "#CYCLE!", s.Value(widget.TableCellID{Row: 1, Col: 1}))
+	s.SetCell(widget.TableCellID{Row: 1, Col: 1}, "=A1/0")
+	assert.Equal(t, "#DIV/0!", s.Value(widget.TableCellID{Row: 1, Col: 1}))
+	s.SetCell(widget.TableCellID{Row: 1, Col: 1}, "=NOPE(A1)")
+	assert.Equal(t, "#NAME?", s.Value(widget.TableCellID{Row: 1, Col: 1}))
+	s.SetCell(widget.TableCellID{Row: 1, Col: 1}, "=Z1")
+	assert.Equal(t, "#REF!", s.Value(widget.TableCellID{Row: 1, Col: 1}))
+}
+
+func TestSpreadsheet_CopyPaste(t *testing.T) {
+	s := NewSpreadsheet(5, 5)
+	s.SetCell(widget.TableCellID{Row: 0, Col: 0}, "a")
+	s.SetCell(widget.TableCellID{Row: 0, Col: 1}, "b")
+	s.SetCell(widget.TableCellID{Row: 1, Col: 1}, "d")
+
+	s.SelectRange(CellRange{Start: widget.TableCellID{Row: 1, Col: 1}, End: widget.TableCellID{Row: 0, Col: 0}})
+	assert.Equal(t, "a\tb\n\td\n", s.CopySelection())
+
+	s.SelectRange(CellRange{Start: widget.TableCellID{Row: 3, Col: 3}, End: widget.TableCellID{Row: 3, Col: 3}})
+	s.Paste("1\t2\t3\n4\t5\t6\n")
+	assert.Equal(t, "1", s.Cell(widget.TableCellID{Row: 3, Col: 3}))
+	assert.Equal(t, "2", s.Cell(widget.TableCellID{Row: 3, Col: 4}))
+	assert.Equal(t, "5", s.Cell(widget.TableCellID{Row: 4, Col: 4}))
+	r, ok := s.Selection()
+	assert.True(t, ok)
+	assert.Equal(t, widget.TableCellID{Row: 4, Col: 4}, r.End)
+}
+
+func TestSpreadsheet_Edit(t *testing.T) {
+	test.NewApp()
+	s := NewSpreadsheet(3, 3)
+	w := test.NewWindow(s)
+	defer w.Close()
+
+	changed := ""
+	s.OnCellChanged = func(_ widget.TableCellID, content string) {
+		changed = content
+	}
+	s.selectCell(widget.TableCellID{Row: 1, Col: 1}, false)
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.NotNil(t, s.editing)
+
+	s.commitEdit(widget.TableCellID{Row: 1, Col: 1}, "hello")
+	assert.Nil(t, s.editing)
+	assert.Equal(t, "hello", s.Cell(widget.TableCellID{Row: 1, Col: 1}))
+	assert.Equal(t, "hello", changed)
+
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDelete})
+	assert.Equal(t, "", s.Cell(widget.TableCellID{Row: 1, Col: 1}))
+	assert.Equal(t, "", changed)
+
+	// changes by code and to the same content are not reported
+	changed = "not called"
+	s.SetCell(widget.TableCellID{Row: 1, Col: 1}, "kept")
+	s.Paste("kept")
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	entry, ok := w.Canvas().Focused().(*spreadsheetEntry)
+	if !assert.True(t, ok, "the cell entry is focused") {
+		return
+	}
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.Nil(t, s.editing)
+	assert.Equal(t, "kept", s.Cell(widget.TableCellID{Row: 1, Col: 1}))
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	s.commitEdit(widget.TableCellID{Row: 1, Col: 1}, "kept")
+	assert.Nil(t, s.editing)
+	s.SelectRange(CellRange{Start: widget.TableCellID{Row: 2, Col: 0}, End: widget.TableCellID{Row: 2, Col: 2}})
+	s.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDelete})
+	assert.Equal(t, "not called", changed)
+}
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "A", columnName(0))
+	assert.Equal(t, "Z", columnName(25))
+	assert.Equal(t, "AA", columnName(26))
+	assert.Equal(t, "AZ", columnName(51))
+
+	id, ok := parseCellRef("ab12")
+	assert.True(t, ok)
+	assert.Equal(t, widget.TableCellID{Row: 11, Col: 27}, id)
+	_, ok = parseCellRef("12")
+	assert.False(t, ok)
+}
@@ -0,0 +1,344 @@
+package widget
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	fynevalidation "fyne.io/fyne/v2/data/validation"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/data/validation"
+	"fyne.io/x/fyne/i18n"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*StructForm)(nil)
+
+// StructFormDateLayout is the format of dates typed into the entries of a StructForm.
+const StructFormDateLayout = "2006-01-02"
+
+var errNotStructPointer = errors.New("a pointer to a struct is required")
+
+// StructForm is a form generated from the fields of a struct, see FormForStruct.
+type StructForm struct {
+	widget.Form
+
+	reloaders []func()
+}
+
+// FormForStruct creates a form with an item for each exported field of the struct pointed to by ptr.
+// The items are bound to the fields, so the struct is updated as the user edits the form.
+//
+// Strings are edited with an Entry, or a Select if they have an `options:"a,b,c"` tag,
+// ints and float64s with a NumericalEntry, bools with a Check and time.Times with a date entry.
+// Struct tags control the items:
+//
+//	form:"Label"                    the label of the item, the field name split into words by default
+//	form:"-"                        leaves the field out
+//	hint:"Text"                     the hint text below the item
+//	widget:"password"               uses a password entry for a string, or "multiline" for a multi-line one
+//	validate:"required,min=3,max=9" the length of a string or range of a number, pattern=regexp must come last
+//
+// If the struct has a `Validate() error` method it is checked after every change,
+// with its error shown at the end of the form, and the form can't be submitted while it fails.
+func FormForStruct(ptr interface{}) (*StructForm, error) {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil, errNotStructPointer
+	}
+	f := &StructForm{}
+	f.ExtendBaseWidget(f)
+
+	var summary *ValidationSummary
+	changed := func() {}
+	if s, ok := ptr.(interface{ Validate() error }); ok {
+		summary = NewValidationSummary()
+		summary.AddRule("", s.Validate)
+		changed = func() {
+			summary.Validate()
+		}
+	}
+
+	s, t := v.Elem(), v.Elem().Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		label := field.Tag.Get("form")
+		if !field.IsExported() || label == "-" {
+			continue
+		}
+		if label == "" {
+			label = splitFieldName(field.Name)
+		}
+		obj, err := f.createInput(s.Field(i), field, changed)
+		if err != nil {
+			return nil, err
+		}
+		item := widget.NewFormItem(label, obj)
+		item.HintText = field.Tag.Get("hint")
+		f.Items = append(f.Items, item)
+	}
+	if summary != nil {
+		f.Items = append(f.Items, widget.NewFormItem("", summary))
+	}
+	return f, nil
+}
+
+// Reload updates the form after the struct has been changed by the app.
+func (f *StructForm) Reload() {
+	for _, r := range f.reloaders {
+		r()
+	}
+}
+
+func (f *StructForm) createInput(v reflect.Value, field reflect.StructField, changed func()) (fyne.CanvasObject, error) {
+	validators, err := parseValidators(field)
+	if err != nil {
+		return nil, err
+	}
+	var validator fyne.StringValidator
+	if len(validators) > 0 {
+		validator = fynevalidation.NewAllStrings(validators...)
+	}
+
+	if field.Type == reflect.TypeOf(time.Time{}) {
+		return f.createDateInput(v, validator, changed), nil
+	}
+
+	switch field.Type.Kind() {
+	case reflect.String:
+		ptr := v.Addr().Convert(reflect.TypeOf((*string)(nil))).Interface().(*string)
+		if options, ok := field.Tag.Lookup("options"); ok {
+			return f.createSelect(ptr, strings.Split(options, ","), changed), nil
+		}
+		var e *widget.Entry
+		switch field.Tag.Get("widget") {
+		case "password":
+			e = widget.NewPasswordEntry()
+		case "multiline":
+			e = widget.NewMultiLineEntry()
+		default:
+			e = widget.NewEntry()
+		}
+		str := binding.BindString(ptr)
+		f.bind(str, changed)
+		data := &structFormString{String: str}
+		e.Bind(data)
+		e.Validator = validator
+		data.release()
+		return e, nil
+	case reflect.Bool:
+		data := binding.BindBool(v.Addr().Convert(reflect.TypeOf((*bool)(nil))).Interface().(*bool))
+		f.bind(data, changed)
+		return widget.NewCheckWithData("", data), nil
+	case reflect.Int:
+		data := binding.BindInt(v.Addr().Convert(reflect.TypeOf((*int)(nil))).Interface().(*int))
+		f.bind(data, changed)
+		e := NewNumericalEntry()
+		e.AllowNegative = true
+		e.Validator = validator
+		e.BindInt(data)
+		return e, nil
+	case reflect.Float64:
+		data := binding.BindFloat(v.Addr().Convert(reflect.TypeOf((*float64)(nil))).Interface().(*float64))
+		f.bind(data, changed)
+		e := NewNumericalEntry()
+		e.AllowFloat = true
+		e.AllowNegative = true
+		e.AllowScientific = true
+		e.Validator = validator
+		e.BindFloat(data)
+		return e, nil
+	}
+	return nil, fmt.Errorf("field %s has unsupported type %s", field.Name, field.Type)
+}
+
+// bind reloads external data with the form and calls changed when the user edits it.
+func (f *StructForm) bind(data interface {
+	binding.DataItem
+	Reload() error
+}, changed func()) {
+	f.reloaders = append(f.reloaders, func() {
+		if err := data.Reload(); err != nil {
+			fyne.LogError("Error reloading data value", err)
+		}
+	})
+	data.AddListener(binding.NewDataListener(changed))
+}
+
+func (f *StructForm) createDateInput(v reflect.Value, validator fyne.StringValidator, changed func()) fyne.CanvasObject {
+	e := widget.NewEntry()
+	e.PlaceHolder = "YYYY-MM-DD"
+	e.Validator = func(text string) error {
+		if text != "" {
+			if _, err := time.Parse(StructFormDateLayout, text); err != nil {
+				return fmt.Errorf(i18n.L("must be a date like %s"), StructFormDateLayout)
+			}
+		}
+		if validator != nil {
+			return validator(text)
+		}
+		return nil
+	}
+	text := func() string {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(StructFormDateLayout)
+	}
+	e.OnChanged = func(text string) {
+		t := time.Time{}
+		if text != "" {
+			var err error
+			if t, err = time.Parse(StructFormDateLayout, text); err != nil {
+				return
+			}
+		}
+		v.Set(reflect.ValueOf(t))
+		changed()
+	}
+
+	var popUp *widget.PopUp
+	e.ActionItem = widget.NewButtonWithIcon("", theme.MoreHorizontalIcon(), func() {
+		c := fyne.CurrentApp().Driver().CanvasForObject(e)
+		if c == nil {
+			return
+		}
+		month := v.Interface().(time.Time)
+		if month.IsZero() {
+			month = time.Now()
+		}
+		popUp = widget.NewPopUp(NewCalendar(month, func(t time.Time) {
+			e.SetText(t.Format(StructFormDateLayout))
+			popUp.Hide()
+		}), c)
+		pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(e)
+		popUp.ShowAtPosition(pos.AddXY(0, e.Size().Height))
+	})
+	f.reloaders = append(f.reloaders, func() {
+		e.SetText(text())
+	})
+	// set without SetText, which would call OnChanged while the listeners of the other items may be running
+	e.Text = text()
+	return e
+}
+
+func (f *StructForm) createSelect(ptr *string, options []string, changed func()) fyne.CanvasObject {
+	s := widget.NewSelect(options, nil)
+	s.Selected = *ptr
+	s.OnChanged = func(value string) {
+		*ptr = value
+		changed()
+	}
+	f.reloaders = append(f.reloaders, func() {
+		s.SetSelected(*ptr)
+	})
+	return s
+}
+
+// parseValidators returns the rules in the validate tag of a field.
+func parseValidators(field reflect.StructField) ([]fyne.StringValidator, error) {
+	tag := field.Tag.Get("validate")
+	if tag == "" {
+		return nil, nil
+	}
+
+	number := field.Type.Kind() == reflect.Int || field.Type.Kind() == reflect.Float64
+	var validators []fyne.StringValidator
+	minLength, maxLength := 0, 0
+	minValue, maxValue := math.Inf(-1), math.Inf(1)
+	hasLength, hasRange := false, false
+	for tag != "" {
+		rule := tag
+		if strings.HasPrefix(rule, "pattern=") {
+			tag = ""
+		} else if i := strings.IndexByte(tag, ','); i >= 0 {
+			rule, tag = tag[:i], tag[i+1:]
+		} else {
+			tag = ""
+		}
+
+		name, value, _ := strings.Cut(rule, "=")
+		switch name {
+		case "required":
+			validators = append(validators, validation.NewRequired())
+		case "pattern":
+			validators = append(validators, fynevalidation.NewRegexp(value, "invalid format"))
+		case "min", "max":
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s for field %s: %w", name, field.Name, err)
+			}
+			switch {
+			case number && name == "min":
+				minValue, hasRange = n, true
+			case number:
+				maxValue, hasRange = n, true
+			case name == "min":
+				minLength, hasLength = int(n), true
+			default:
+				maxLength, hasLength = int(n), true
+			}
+		default:
+			return nil, fmt.Errorf("unknown rule %q for field %s", name, field.Name)
+		}
+	}
+
+	if hasLength {
+		validators = append(validators, validation.NewLength(minLength, maxLength))
+	}
+	if hasRange {
+		validators = append(validators, validation.NewRange(minValue, maxValue))
+	}
+	return validators, nil
+}
+
+// splitFieldName makes a label from a field name, such as "First name" from "FirstName".
+func splitFieldName(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteRune(' ')
+			if i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				r = unicode.ToLower(r)
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// structFormString is the data of a string entry, which holds back the listener added by Entry.Bind until the
+// validator of the entry is set, as Bind sets its own validator after adding the listener that reads it.
+type structFormString struct {
+	binding.String
+	bound   bool
+	pending []binding.DataListener
+}
+
+func (s *structFormString) AddListener(l binding.DataListener) {
+	if !s.bound {
+		s.pending = append(s.pending, l)
+		return
+	}
+	s.String.AddListener(l)
+}
+
+func (s *structFormString) release() {
+	s.bound = true
+	for _, l := range s.pending {
+		s.String.AddListener(l)
+	}
+	s.pending = nil
+}
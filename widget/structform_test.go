@@ -0,0 +1,147 @@
+package widget
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+type structFormUser struct {
+	Name     string `validate:"required,max=10"`
+	Email    string `hint:"Your work address" validate:"pattern=^[^@]+@[^@]+$"`
+	Password string `widget:"password"`
+	Age      int    `validate:"min=18"`
+	Score    float64
+	Admin    bool
+	Role     string `options:"user,editor,admin"`
+	Birthday time.Time
+	Internal string `form:"-"`
+	hidden   string
+}
+
+type structFormRange struct {
+	From, To int
+}
+
+func (r *structFormRange) Validate() error {
+	if r.To < r.From {
+		return errors.New("to must be after from")
+	}
+	return nil
+}
+
+func TestFormForStruct(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	u := &structFormUser{Name: "Jo", Age: 30, Role: "editor"}
+	f, err := FormForStruct(u)
+	assert.NoError(t, err)
+	waitForBinding()
+	w := test.NewWindow(f)
+	defer w.Close()
+
+	if !assert.Len(t, f.Items, 8) {
+		return
+	}
+	assert.Equal(t, "Name", f.Items[0].Text)
+	assert.Equal(t, "Your work address", f.Items[1].HintText)
+	assert.Equal(t, "Birthday", f.Items[7].Text)
+	assert.True(t, f.Items[2].Widget.(*widget.Entry).Password)
+	assert.IsType(t, &NumericalEntry{}, f.Items[3].Widget)
+	assert.IsType(t, &widget.Check{}, f.Items[5].Widget)
+	assert.Equal(t, "editor", f.Items[6].Widget.(*widget.Select).Selected)
+
+	name := f.Items[0].Widget.(*widget.Entry)
+	assert.Equal(t, "Jo", name.Text)
+	withBindings(func() {
+		name.SetText("Joe")
+		f.Items[3].Widget.(*NumericalEntry).SetText("301")
+		test.Tap(f.Items[5].Widget.(*widget.Check))
+		f.Items[6].Widget.(*widget.Select).SetSelected("admin")
+		f.Items[7].Widget.(*widget.Entry).SetText("2000-02-29")
+	})
+	assert.Equal(t, "Joe", u.Name)
+	assert.Equal(t, 301, u.Age)
+	assert.True(t, u.Admin)
+	assert.Equal(t, "admin", u.Role)
+	assert.Equal(t, time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC), u.Birthday)
+
+	u.Name = "Sam"
+	u.Role = "user"
+	f.Reload()
+	waitForBinding()
+	assert.Equal(t, "Sam", name.Text)
+	assert.Equal(t, "user", f.Items[6].Widget.(*widget.Select).Selected)
+}
+
+func TestFormForStruct_Validation(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	u := &structFormUser{Age: 30}
+	f, err := FormForStruct(u)
+	assert.NoError(t, err)
+	waitForBinding()
+
+	assert.Error(t, f.Items[0].Widget.(*widget.Entry).Validate())
+	email := f.Items[1].Widget.(*widget.Entry)
+	withBindings(func() { email.SetText("nobody") })
+	assert.Error(t, email.Validate())
+	withBindings(func() { email.SetText("me@example.com") })
+	assert.NoError(t, email.Validate())
+
+	age := f.Items[3].Widget.(*NumericalEntry)
+	withBindings(func() { age.SetText("12") })
+	assert.Error(t, age.Validate())
+	withBindings(func() { age.SetText("18") })
+	assert.NoError(t, age.Validate())
+
+	date := f.Items[7].Widget.(*widget.Entry)
+	date.SetText("yesterday")
+	assert.Error(t, date.Validate())
+}
+
+func TestFormForStruct_ValidateMethod(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	r := &structFormRange{From: 1, To: 2}
+	f, err := FormForStruct(r)
+	assert.NoError(t, err)
+	waitForBinding()
+	if !assert.Len(t, f.Items, 3) {
+		return
+	}
+	summary := f.Items[2].Widget.(*ValidationSummary)
+	assert.Empty(t, summary.Errors())
+
+	withBindings(func() {
+		f.Items[1].Widget.(*NumericalEntry).SetText("0")
+	})
+	assert.Equal(t, []error{errors.New("to must be after from")}, summary.Errors())
+}
+
+func TestFormForStruct_Errors(t *testing.T) {
+	_, err := FormForStruct(structFormRange{})
+	assert.Error(t, err)
+
+	_, err = FormForStruct(&struct{ Tags []string }{})
+	assert.Error(t, err)
+
+	_, err = FormForStruct(&struct {
+		Name string `validate:"unknown"`
+	}{})
+	assert.Error(t, err)
+}
+
+func TestSplitFieldName(t *testing.T) {
+	assert.Equal(t, "First name", splitFieldName("FirstName"))
+	assert.Equal(t, "URL", splitFieldName("URL"))
+	assert.Equal(t, "Home URL", splitFieldName("HomeURL"))
+	assert.Equal(t, "HTTP server", splitFieldName("HTTPServer"))
+}
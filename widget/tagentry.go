@@ -0,0 +1,376 @@
+package widget
+
+import (
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*TagEntry)(nil)
+var _ fyne.Tappable = (*TagEntry)(nil)
+var _ fyne.Disableable = (*TagEntry)(nil)
+
+// the width that the text field of a TagEntry needs at least after the tags on their row
+const tagEntryFieldMinWidth = 80
+
+// TagEntry is an entry for a list of tags, such as labels or categories. The tags are shown as chips that can be
+// removed inside the entry. A new tag is typed and added with Return or a comma, and the Suggestions that match
+// the typed text are offered to complete it. Backspace in the empty text field removes the last tag.
+type TagEntry struct {
+	widget.DisableableWidget
+
+	// Tags are the tags in the entry, call Refresh after changing them or use SetTags.
+	Tags []string
+	// Suggestions are offered to complete a tag as it is typed, leaving out the ones that are added already.
+	Suggestions []string
+	// OnlySuggestions prevents tags that are not in Suggestions from being added.
+	OnlySuggestions bool
+	PlaceHolder     string
+
+	OnChanged func(tags []string) `json:"-"`
+
+	field  *tagField
+	binder *tagEntryBinder
+}
+
+// NewTagEntry creates a new entry for tags that offers suggestions to complete them.
+func NewTagEntry(suggestions []string) *TagEntry {
+	t := &TagEntry{Suggestions: suggestions}
+	t.ExtendBaseWidget(t)
+	t.field = newTagField(t)
+	return t
+}
+
+// NewTagEntryWithData creates a new entry for tags that is bound to the given data source.
+func NewTagEntryWithData(data binding.StringList, suggestions []string) *TagEntry {
+	t := NewTagEntry(suggestions)
+	t.Bind(data)
+	return t
+}
+
+// AddTag adds a tag after the others, unless it is empty or added already, or not one of Suggestions when
+// OnlySuggestions is set. It returns whether the tag was added.
+func (t *TagEntry) AddTag(tag string) bool {
+	tag = strings.TrimSpace(tag)
+	if tag == "" || t.hasTag(tag) {
+		return false
+	}
+	if t.OnlySuggestions {
+		suggestion, ok := t.suggestion(tag)
+		if !ok || t.hasTag(suggestion) {
+			return false
+		}
+		tag = suggestion
+	}
+
+	tags := make([]string, len(t.Tags), len(t.Tags)+1)
+	copy(tags, t.Tags)
+	t.setTags(append(tags, tag), true)
+	return true
+}
+
+// Bind connects the entry to a data source, changes to either are reflected in the other.
+func (t *TagEntry) Bind(data binding.StringList) {
+	t.Unbind()
+	t.binder = &tagEntryBinder{data: data}
+	t.binder.listener = binding.NewDataListener(func() {
+		tags, err := data.Get()
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		t.setTags(tags, false)
+	})
+	data.AddListener(t.binder.listener)
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (t *TagEntry) CreateRenderer() fyne.WidgetRenderer {
+	t.ExtendBaseWidget(t)
+	box := canvas.NewRectangle(color.Transparent)
+	border := canvas.NewRectangle(color.Transparent)
+	r := &tagEntryRenderer{
+		entry: t, box: box, border: border,
+		field: container.NewThemeOverride(t.field, &searchFieldTheme{}),
+	}
+	r.Refresh()
+	return r
+}
+
+// RemoveTag removes a tag from the entry.
+func (t *TagEntry) RemoveTag(tag string) {
+	tags := make([]string, 0, len(t.Tags))
+	for _, existing := range t.Tags {
+		if existing != tag {
+			tags = append(tags, existing)
+		}
+	}
+	if len(tags) != len(t.Tags) {
+		t.setTags(tags, true)
+	}
+}
+
+// SetTags replaces the tags of the entry.
+func (t *TagEntry) SetTags(tags []string) {
+	t.setTags(tags, true)
+}
+
+// Tapped gives keyboard focus to the text field to type a tag.
+//
+// Implements: fyne.Tappable
+func (t *TagEntry) Tapped(*fyne.PointEvent) {
+	if t.Disabled() {
+		return
+	}
+	if c := fyne.CurrentApp().Driver().CanvasForObject(t.field); c != nil {
+		c.Focus(t.field)
+	}
+}
+
+// Unbind disconnects any configured data source.
+func (t *TagEntry) Unbind() {
+	if t.binder == nil {
+		return
+	}
+	t.binder.data.RemoveListener(t.binder.listener)
+	t.binder = nil
+}
+
+// addTyped adds the tags of the text field, which are separated by commas, and empties it.
+func (t *TagEntry) addTyped(text string) {
+	for _, tag := range strings.Split(text, ",") {
+		t.AddTag(tag)
+	}
+	t.field.HideCompletion()
+	t.field.SetText("")
+}
+
+func (t *TagEntry) hasTag(tag string) bool {
+	for _, existing := range t.Tags {
+		if strings.EqualFold(existing, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TagEntry) setTags(tags []string, notifyBinding bool) {
+	if stringsEqual(tags, t.Tags) {
+		return
+	}
+	t.Tags = tags
+	t.Refresh()
+
+	if notifyBinding && t.binder != nil {
+		if err := t.binder.data.Set(tags); err != nil {
+			fyne.LogError("Error setting current data value", err)
+		}
+	}
+	if f := t.OnChanged; f != nil {
+		f(tags)
+	}
+}
+
+// suggestion returns the one of Suggestions that is a tag, ignoring case.
+func (t *TagEntry) suggestion(tag string) (string, bool) {
+	for _, s := range t.Suggestions {
+		if strings.EqualFold(s, tag) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// updateCandidates offers the Suggestions that are not added yet to complete a tag.
+func (t *TagEntry) updateCandidates() {
+	if len(t.Suggestions) == 0 {
+		t.field.Candidates = nil
+		return
+	}
+	candidates := make([]CompletionItem, 0, len(t.Suggestions))
+	for _, s := range t.Suggestions {
+		if !t.hasTag(s) {
+			candidates = append(candidates, CompletionItem{Text: s})
+		}
+	}
+	t.field.Candidates = candidates
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type tagEntryBinder struct {
+	data     binding.StringList
+	listener binding.DataListener
+}
+
+type tagEntryRenderer struct {
+	entry       *TagEntry
+	box, border *canvas.Rectangle
+	chips       []*Chip
+	field       *container.ThemeOverride
+}
+
+func (r *tagEntryRenderer) Destroy() {
+}
+
+func (r *tagEntryRenderer) Layout(size fyne.Size) {
+	r.box.Resize(size)
+	r.border.Resize(size)
+	r.layoutRows(size.Width, true)
+}
+
+func (r *tagEntryRenderer) MinSize() fyne.Size {
+	width := float32(tagEntryFieldMinWidth)
+	for _, chip := range r.chips {
+		width = fyne.Max(width, chip.MinSize().Width)
+	}
+	pad := theme.Padding()
+	height := r.layoutRows(r.entry.Size().Width, false)
+	return fyne.NewSize(width+pad*2, height)
+}
+
+func (r *tagEntryRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.box, r.border}
+	for _, chip := range r.chips {
+		objects = append(objects, chip)
+	}
+	return append(objects, r.field)
+}
+
+func (r *tagEntryRenderer) Refresh() {
+	t := r.entry
+	for len(r.chips) < len(t.Tags) {
+		r.chips = append(r.chips, NewChip("", nil))
+	}
+	r.chips = r.chips[:len(t.Tags)]
+	for i, tag := range t.Tags {
+		tag := tag
+		chip := r.chips[i]
+		chip.Text = tag
+		chip.OnClosed = func() {
+			t.RemoveTag(tag)
+		}
+		if t.Disabled() {
+			chip.Disable()
+		} else {
+			chip.Enable()
+		}
+		chip.Refresh()
+	}
+	t.updateCandidates()
+
+	if t.Disabled() {
+		t.field.Disable()
+	} else {
+		t.field.Enable()
+	}
+	if len(t.Tags) == 0 {
+		t.field.SetPlaceHolder(t.PlaceHolder)
+	} else {
+		t.field.SetPlaceHolder("")
+	}
+
+	r.box.FillColor = theme.Color(theme.ColorNameInputBackground)
+	r.box.CornerRadius = theme.InputRadiusSize()
+	r.border.StrokeWidth = theme.InputBorderSize()
+	r.border.CornerRadius = theme.InputRadiusSize()
+	r.border.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	if t.field.focused {
+		r.border.StrokeColor = theme.Color(theme.ColorNamePrimary)
+	}
+	r.box.Refresh()
+	r.border.Refresh()
+	r.Layout(t.Size())
+}
+
+// layoutRows flows the chips and then the text field in rows of a width, moving them if place is set,
+// and returns the height of the rows. The text field takes the rest of the last row.
+func (r *tagEntryRenderer) layoutRows(width float32, place bool) float32 {
+	pad := theme.Padding()
+	fieldHeight := r.field.MinSize().Height
+	rowHeight := fieldHeight
+	for _, chip := range r.chips {
+		rowHeight = fyne.Max(rowHeight, chip.MinSize().Height)
+	}
+
+	x, y := pad, float32(0)
+	for _, chip := range r.chips {
+		size := chip.MinSize()
+		if width > 0 && x > pad && x+size.Width > width-pad {
+			x, y = pad, y+rowHeight
+		}
+		if place {
+			chip.Resize(size)
+			chip.Move(fyne.NewPos(x, y+(rowHeight-size.Height)/2))
+		}
+		x += size.Width + pad
+	}
+	if width > 0 && x > pad && x+tagEntryFieldMinWidth > width-pad {
+		x, y = pad, y+rowHeight
+	}
+	if place {
+		r.field.Resize(fyne.NewSize(fyne.Max(width-pad-x, 0), fieldHeight))
+		r.field.Move(fyne.NewPos(x, y+(rowHeight-fieldHeight)/2))
+	}
+	return y + rowHeight
+}
+
+// tagField is the borderless text field of a TagEntry.
+type tagField struct {
+	CompletionEntry
+	tags    *TagEntry
+	focused bool
+}
+
+func newTagField(t *TagEntry) *tagField {
+	f := &tagField{tags: t}
+	f.ExtendBaseWidget(f)
+	f.OnChanged = func(text string) {
+		if strings.ContainsRune(text, ',') {
+			t.addTyped(text)
+		}
+	}
+	f.OnSubmitted = t.addTyped
+	f.OnSelected = func(item CompletionItem) {
+		t.addTyped(item.Text)
+	}
+	return f
+}
+
+func (f *tagField) FocusGained() {
+	f.focused = true
+	f.CompletionEntry.FocusGained()
+	f.tags.Refresh()
+}
+
+func (f *tagField) FocusLost() {
+	f.focused = false
+	f.CompletionEntry.FocusLost()
+	f.tags.Refresh()
+}
+
+func (f *tagField) TypedKey(key *fyne.KeyEvent) {
+	if key.Name == fyne.KeyBackspace && f.Text == "" && len(f.tags.Tags) > 0 {
+		f.tags.RemoveTag(f.tags.Tags[len(f.tags.Tags)-1])
+		return
+	}
+	f.CompletionEntry.TypedKey(key)
+}
@@ -0,0 +1,132 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagEntry_Type(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewTagEntry([]string{"bug", "feature", "documentation"})
+	var changed []string
+	e.OnChanged = func(tags []string) {
+		changed = tags
+	}
+	w := test.NewWindow(e)
+	w.Resize(fyne.NewSize(400, 300))
+	defer w.Close()
+
+	test.Tap(e)
+	assert.Equal(t, e.field, w.Canvas().Focused())
+	test.Type(e.field, "bug,")
+	assert.Equal(t, []string{"bug"}, e.Tags)
+	assert.Equal(t, "", e.field.Text)
+
+	test.Type(e.field, "urgent")
+	e.field.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, []string{"bug", "urgent"}, e.Tags)
+	assert.Equal(t, []string{"bug", "urgent"}, changed)
+
+	test.Type(e.field, "BUG,")
+	assert.Equal(t, []string{"bug", "urgent"}, e.Tags, "a tag is only added once")
+
+	e.field.TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	assert.Equal(t, []string{"bug"}, e.Tags)
+}
+
+func TestTagEntry_Suggestions(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewTagEntry([]string{"bug", "feature", "documentation"})
+	e.SetTags([]string{"bug"})
+	w := test.NewWindow(e)
+	w.Resize(fyne.NewSize(400, 300))
+	defer w.Close()
+	w.Canvas().Focus(e.field)
+
+	test.Type(e.field, "u")
+	assert.Equal(t, []string{"feature", "documentation"}, e.field.Options, "added tags are not offered")
+	assert.True(t, e.field.popupMenu.Visible())
+
+	w.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	w.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, []string{"bug", "feature"}, e.Tags)
+	assert.Equal(t, "", e.field.Text)
+
+	e.OnlySuggestions = true
+	assert.False(t, e.AddTag("urgent"))
+	assert.True(t, e.AddTag("Documentation"))
+	assert.Equal(t, []string{"bug", "feature", "documentation"}, e.Tags)
+}
+
+func TestTagEntry_Remove(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewTagEntry(nil)
+	e.SetTags([]string{"one", "two"})
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	r := test.WidgetRenderer(e).(*tagEntryRenderer)
+	assert.Len(t, r.chips, 2)
+	chip := r.chips[0]
+	test.TapAt(chip, fyne.NewPos(1, 1))
+	assert.Equal(t, []string{"one", "two"}, e.Tags, "only the icon removes the tag")
+	test.TapAt(chip, fyne.NewPos(chip.Size().Width-2, 2))
+	assert.Equal(t, []string{"two"}, e.Tags)
+	assert.Len(t, r.chips, 1)
+
+	e.Disable()
+	test.TapAt(r.chips[0], fyne.NewPos(r.chips[0].Size().Width-2, 2))
+	assert.Equal(t, []string{"two"}, e.Tags)
+}
+
+func TestTagEntry_Bind(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	data := binding.NewStringList()
+	e := NewTagEntryWithData(data, nil)
+	waitForBinding()
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	assert.NoError(t, data.Set([]string{"a", "b"}))
+	waitForBinding()
+	assert.Equal(t, []string{"a", "b"}, e.Tags)
+
+	e.AddTag("c")
+	waitForBinding()
+	tags, _ := data.Get()
+	assert.Equal(t, []string{"a", "b", "c"}, tags)
+
+	e.Unbind()
+	e.RemoveTag("a")
+	tags, _ = data.Get()
+	assert.Equal(t, []string{"a", "b", "c"}, tags)
+}
+
+func TestTagEntry_Layout(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewTagEntry(nil)
+	w := test.NewWindow(e)
+	defer w.Close()
+	e.Resize(fyne.NewSize(200, e.MinSize().Height))
+	oneRow := e.MinSize().Height
+
+	e.SetTags([]string{"first", "second", "third", "fourth"})
+	r := test.WidgetRenderer(e).(*tagEntryRenderer)
+	assert.Greater(t, e.MinSize().Height, oneRow, "the tags wrap to more rows")
+	assert.Equal(t, r.chips[0].Position().Y, r.chips[1].Position().Y)
+	assert.Greater(t, r.chips[3].Position().Y, r.chips[0].Position().Y)
+}
@@ -0,0 +1,138 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+	"fyne.io/x/fyne/task"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*TaskList)(nil)
+
+// TaskList shows the tasks of a task.Runner with their status and progress,
+// and a button to cancel each task that has not finished.
+type TaskList struct {
+	widget.BaseWidget
+	runner *task.Runner
+	list   *widget.List
+}
+
+// NewTaskList creates a list of the tasks of a runner, which updates as tasks are submitted and run.
+func NewTaskList(r *task.Runner) *TaskList {
+	l := &TaskList{runner: r}
+	l.list = widget.NewListWithData(r.Tasks(),
+		func() fyne.CanvasObject {
+			return newTaskRow()
+		},
+		func(item binding.DataItem, obj fyne.CanvasObject) {
+			value, err := item.(binding.Untyped).Get()
+			if err != nil {
+				fyne.LogError("Error getting current data value", err)
+				return
+			}
+			obj.(*taskRow).setTask(value.(*task.Task))
+		})
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (l *TaskList) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(l.list)
+}
+
+// taskRow shows one task, following the changes of its state, status and progress.
+type taskRow struct {
+	widget.BaseWidget
+	task     *task.Task
+	listener binding.DataListener
+
+	name     *widget.Label
+	status   *widget.Label
+	progress *widget.ProgressBar
+	infinite *widget.ProgressBarInfinite
+	cancel   *widget.Button
+}
+
+func newTaskRow() *taskRow {
+	r := &taskRow{name: widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		status: widget.NewLabel(""), progress: widget.NewProgressBar(), infinite: widget.NewProgressBarInfinite()}
+	r.status.Truncation = fyne.TextTruncateEllipsis
+	r.infinite.Stop()
+	r.infinite.Hide()
+	r.cancel = widget.NewButtonWithIcon("", theme.CancelIcon(), func() {
+		if r.task != nil {
+			r.task.Cancel()
+		}
+	})
+	r.cancel.Importance = widget.LowImportance
+	r.listener = binding.NewDataListener(r.update)
+	r.ExtendBaseWidget(r)
+	return r
+}
+
+func (r *taskRow) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewBorder(nil, nil, nil, container.NewCenter(r.cancel),
+		container.NewVBox(r.name, container.NewStack(r.progress, r.infinite), r.status)))
+}
+
+func (r *taskRow) setTask(t *task.Task) {
+	if t == r.task {
+		return
+	}
+	if r.task != nil {
+		r.task.RemoveListener(r.listener)
+		r.task.Progress().RemoveListener(r.listener)
+		r.task.Status().RemoveListener(r.listener)
+	}
+	r.task = t
+	r.name.SetText(t.Name())
+	t.AddListener(r.listener)
+	t.Progress().AddListener(r.listener)
+	t.Status().AddListener(r.listener)
+}
+
+func (r *taskRow) update() {
+	t := r.task
+	if t == nil {
+		return
+	}
+	progress, _ := t.Progress().Get()
+	status, _ := t.Status().Get()
+	state := t.State()
+
+	switch state {
+	case task.Failed:
+		status = t.Err().Error()
+	case task.Cancelled:
+		status = i18n.L("Cancelled")
+	}
+	r.status.SetText(status)
+
+	if state == task.Running && progress < 0 {
+		r.progress.Hide()
+		r.infinite.Show()
+		r.infinite.Start()
+	} else {
+		r.infinite.Stop()
+		r.infinite.Hide()
+		if progress < 0 {
+			progress = 0
+		}
+		r.progress.SetValue(progress)
+		r.progress.Show()
+	}
+
+	if state.Done() {
+		r.cancel.Hide()
+	} else {
+		r.cancel.Show()
+	}
+}
@@ -0,0 +1,75 @@
+package widget
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/x/fyne/task"
+)
+
+func TestTaskList(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	r := task.NewRunner(1)
+	l := NewTaskList(r)
+	waitForBinding()
+	w := test.NewWindow(l)
+	defer w.Close()
+	assert.Equal(t, 0, l.list.Length())
+
+	r.Submit("Upload", func(ctx context.Context, t *task.Task) error { return nil }).Wait()
+	waitForBinding()
+	assert.Equal(t, 1, l.list.Length())
+	r.ClearFinished()
+	waitForBinding()
+	assert.Equal(t, 0, l.list.Length())
+}
+
+func TestTaskList_Row(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	r := task.NewRunner(1)
+	report := make(chan struct{})
+	upload := r.Submit("Upload", func(ctx context.Context, t *task.Task) error {
+		<-report
+		t.SetStatus("photo.jpg")
+		t.SetProgress(.25)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	row := newTaskRow()
+	w := test.NewWindow(row)
+	defer w.Close()
+	row.setTask(upload)
+	waitForBinding()
+	assert.Equal(t, "Upload", row.name.Text)
+	assert.True(t, row.infinite.Visible())
+	assert.True(t, row.cancel.Visible())
+
+	report <- struct{}{}
+	waitForBinding()
+	assert.Equal(t, "photo.jpg", row.status.Text)
+	assert.False(t, row.infinite.Visible())
+	assert.Equal(t, .25, row.progress.Value)
+
+	test.Tap(row.cancel)
+	upload.Wait()
+	waitForBinding()
+	assert.Equal(t, "Cancelled", row.status.Text)
+	assert.False(t, row.cancel.Visible())
+
+	export := r.Submit("Export", func(context.Context, *task.Task) error {
+		return errors.New("disk full")
+	})
+	export.Wait()
+	row.setTask(export)
+	waitForBinding()
+	assert.Equal(t, "Export", row.name.Text)
+	assert.Equal(t, "disk full", row.status.Text)
+}
@@ -0,0 +1,287 @@
+package widget
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/i18n"
+	xtheme "fyne.io/x/fyne/theme"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*ThemeEditor)(nil)
+
+// ThemeEditor is a panel to customize a theme, with a color picker for each theme color, a slider for each size
+// and a preview of some widgets in the edited theme. The colors are edited for the light and dark variants
+// separately, and the edited theme is passed to OnChanged so the app can apply it, and save it with theme.ToJSON
+// of fyne.io/x/fyne/theme.
+type ThemeEditor struct {
+	widget.BaseWidget
+
+	// OnChanged is called with the edited theme after every change.
+	OnChanged func(fyne.Theme) `json:"-"`
+
+	base    fyne.Theme
+	variant fyne.ThemeVariant
+	colors  map[fyne.ThemeVariant]map[fyne.ThemeColorName]color.Color
+	sizes   map[fyne.ThemeSizeName]float32
+
+	buttons map[fyne.ThemeColorName]*ColorButton
+	sliders map[fyne.ThemeSizeName]*widget.Slider
+	values  map[fyne.ThemeSizeName]*widget.Label
+	choice  *widget.RadioGroup
+	preview *container.ThemeOverride
+	shade   *canvas.Rectangle
+}
+
+// NewThemeEditor creates a new theme editor that starts from the given theme, or the default theme if it is nil.
+func NewThemeEditor(base fyne.Theme) *ThemeEditor {
+	if base == nil {
+		base = theme.DefaultTheme()
+	}
+	e := &ThemeEditor{base: base, variant: theme.VariantLight}
+	if app := fyne.CurrentApp(); app != nil {
+		e.variant = app.Settings().ThemeVariant()
+	}
+	e.clear()
+	e.ExtendBaseWidget(e)
+	return e
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (e *ThemeEditor) CreateRenderer() fyne.WidgetRenderer {
+	e.ExtendBaseWidget(e)
+
+	e.buttons = make(map[fyne.ThemeColorName]*ColorButton, len(xtheme.ColorNames))
+	colors := widget.NewForm()
+	for _, name := range xtheme.ColorNames {
+		name := name
+		b := NewColorButton(e.color(name), func(c color.Color) {
+			e.SetColor(name, e.variant, c)
+		})
+		e.buttons[name] = b
+		colors.Append(themeEditorLabel(string(name)), container.NewHBox(b))
+	}
+
+	e.sliders = make(map[fyne.ThemeSizeName]*widget.Slider, len(xtheme.SizeNames))
+	e.values = make(map[fyne.ThemeSizeName]*widget.Label, len(xtheme.SizeNames))
+	sizes := widget.NewForm()
+	for _, name := range xtheme.SizeNames {
+		name := name
+		s := widget.NewSlider(0, themeEditorMaxSize(e.base.Size(name)))
+		s.SetValue(float64(e.size(name)))
+		s.OnChanged = func(v float64) {
+			e.SetSize(name, float32(v))
+		}
+		e.sliders[name] = s
+		e.values[name] = widget.NewLabel(themeEditorFormatSize(e.size(name)))
+		sizes.Append(themeEditorLabel(string(name)), container.NewBorder(nil, nil, nil, e.values[name], s))
+	}
+
+	e.choice = widget.NewRadioGroup([]string{i18n.L("Light"), i18n.L("Dark")}, nil)
+	e.choice.Horizontal = true
+	e.choice.Required = true
+	e.choice.SetSelected(e.variantOption())
+	e.choice.OnChanged = func(selected string) {
+		if selected == i18n.L("Dark") {
+			e.SetVariant(theme.VariantDark)
+		} else {
+			e.SetVariant(theme.VariantLight)
+		}
+	}
+	reset := widget.NewButtonWithIcon(i18n.L("Reset"), theme.ViewRefreshIcon(), e.Reset)
+
+	tabs := container.NewAppTabs(
+		container.NewTabItem(i18n.L("Colors"), container.NewVScroll(colors)),
+		container.NewTabItem(i18n.L("Sizes"), container.NewVScroll(sizes)))
+	editor := container.NewBorder(container.NewBorder(nil, nil, nil, reset, e.choice), nil, nil, nil, tabs)
+
+	e.shade = canvas.NewRectangle(color.Transparent)
+	e.preview = container.NewThemeOverride(container.NewStack(e.shade, container.NewPadded(newThemeEditorSample())),
+		e.previewTheme())
+	e.refreshPreview()
+
+	split := container.NewHSplit(editor, container.NewVScroll(e.preview))
+	return widget.NewSimpleRenderer(split)
+}
+
+// Reset discards all the changes, going back to the theme that the editor started from.
+func (e *ThemeEditor) Reset() {
+	e.clear()
+	e.changed()
+}
+
+// SetColor changes a color of the edited theme for a variant.
+func (e *ThemeEditor) SetColor(name fyne.ThemeColorName, variant fyne.ThemeVariant, c color.Color) {
+	if colorsEqual(e.colors[variant][name], c) {
+		return
+	}
+	if _, ok := e.colors[variant]; !ok {
+		e.colors[variant] = map[fyne.ThemeColorName]color.Color{}
+	}
+	e.colors[variant][name] = c
+	e.changed()
+}
+
+// SetSize changes a size of the edited theme.
+func (e *ThemeEditor) SetSize(name fyne.ThemeSizeName, size float32) {
+	if s, ok := e.sizes[name]; ok && s == size {
+		return
+	}
+	e.sizes[name] = size
+	e.changed()
+}
+
+// SetVariant changes the variant whose colors are edited and previewed.
+func (e *ThemeEditor) SetVariant(variant fyne.ThemeVariant) {
+	if e.variant == variant {
+		return
+	}
+	e.variant = variant
+	e.Refresh()
+}
+
+// Theme returns the edited theme, which is the theme that the editor started from with the changes made to it.
+func (e *ThemeEditor) Theme() fyne.Theme {
+	var opts []xtheme.Option
+	for variant, colors := range e.colors {
+		for name, c := range colors {
+			opts = append(opts, xtheme.WithVariantColor(name, variant, c))
+		}
+	}
+	for name, s := range e.sizes {
+		opts = append(opts, xtheme.WithSize(name, s))
+	}
+	return xtheme.Override(e.base, opts...)
+}
+
+// Variant returns the variant whose colors are edited and previewed.
+func (e *ThemeEditor) Variant() fyne.ThemeVariant {
+	return e.variant
+}
+
+// Refresh updates the pickers, sliders and preview to the edited theme.
+func (e *ThemeEditor) Refresh() {
+	for name, b := range e.buttons {
+		b.Color = e.color(name)
+		b.Refresh()
+	}
+	for name, s := range e.sliders {
+		s.Value = float64(e.size(name))
+		s.Refresh()
+		e.values[name].SetText(themeEditorFormatSize(e.size(name)))
+	}
+	if e.choice != nil {
+		e.choice.Selected = e.variantOption()
+		e.choice.Refresh()
+		e.refreshPreview()
+	}
+	e.BaseWidget.Refresh()
+}
+
+func (e *ThemeEditor) changed() {
+	e.Refresh()
+	if f := e.OnChanged; f != nil {
+		f(e.Theme())
+	}
+}
+
+func (e *ThemeEditor) clear() {
+	e.colors = map[fyne.ThemeVariant]map[fyne.ThemeColorName]color.Color{}
+	e.sizes = map[fyne.ThemeSizeName]float32{}
+}
+
+func (e *ThemeEditor) color(name fyne.ThemeColorName) color.Color {
+	if c, ok := e.colors[e.variant][name]; ok {
+		return c
+	}
+	return e.base.Color(name, e.variant)
+}
+
+func (e *ThemeEditor) previewTheme() fyne.Theme {
+	return &themeEditorPreview{Theme: e.Theme(), variant: e.variant}
+}
+
+func (e *ThemeEditor) refreshPreview() {
+	th := e.previewTheme()
+	e.shade.FillColor = th.Color(theme.ColorNameBackground, e.variant)
+	e.shade.Refresh()
+	e.preview.Theme = th
+	e.preview.Refresh()
+}
+
+func (e *ThemeEditor) variantOption() string {
+	if e.variant == theme.VariantDark {
+		return i18n.L("Dark")
+	}
+	return i18n.L("Light")
+}
+
+func (e *ThemeEditor) size(name fyne.ThemeSizeName) float32 {
+	if s, ok := e.sizes[name]; ok {
+		return s
+	}
+	return e.base.Size(name)
+}
+
+// themeEditorLabel returns the label of a theme color or size, such as "Input background" for "inputBackground".
+func themeEditorLabel(name string) string {
+	label := []rune(splitFieldName(name))
+	if len(label) > 0 {
+		label[0] = unicode.ToUpper(label[0])
+	}
+	return string(label)
+}
+
+// themeEditorMaxSize returns the end of the range of a size slider, four times the size it started from.
+func themeEditorMaxSize(size float32) float64 {
+	return math.Max(8, math.Ceil(float64(size)*4))
+}
+
+func themeEditorFormatSize(size float32) string {
+	return strings.TrimSuffix(strconv.FormatFloat(float64(size), 'f', 1, 32), ".0")
+}
+
+func newThemeEditorSample() fyne.CanvasObject {
+	primary := widget.NewButton(i18n.L("Primary"), func() {})
+	primary.Importance = widget.HighImportance
+	disabled := widget.NewButton(i18n.L("Disabled"), func() {})
+	disabled.Disable()
+	entry := widget.NewEntry()
+	entry.SetPlaceHolder(i18n.L("Search"))
+	check := widget.NewCheck(i18n.L("Check"), nil)
+	check.SetChecked(true)
+	choice := widget.NewSelect([]string{i18n.L("Option")}, nil)
+	choice.SetSelected(i18n.L("Option"))
+	slider := widget.NewSlider(0, 1)
+	slider.Value = .6
+	progress := widget.NewProgressBar()
+	progress.SetValue(.4)
+
+	return container.NewVBox(
+		widget.NewLabel("Aa Bb Cc 0123"),
+		container.NewHBox(widget.NewButtonWithIcon(i18n.L("Button"), theme.HomeIcon(), func() {}), primary, disabled),
+		entry, check, choice, slider, progress,
+		widget.NewSeparator(),
+		widget.NewCard("Aa Bb Cc", "0123", widget.NewIcon(theme.SettingsIcon())))
+}
+
+// themeEditorPreview shows the colors of one variant of a theme, whatever the variant of the app.
+type themeEditorPreview struct {
+	fyne.Theme
+	variant fyne.ThemeVariant
+}
+
+func (t *themeEditorPreview) Color(name fyne.ThemeColorName, _ fyne.ThemeVariant) color.Color {
+	return t.Theme.Color(name, t.variant)
+}
@@ -0,0 +1,77 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+
+	xtheme "fyne.io/x/fyne/theme"
+)
+
+func TestThemeEditor_SetColor(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	var changed fyne.Theme
+	e := NewThemeEditor(xtheme.AdwaitaTheme())
+	e.OnChanged = func(th fyne.Theme) {
+		changed = th
+	}
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	orange := color.NRGBA{R: 0xe6, G: 0x61, A: 0xff}
+	e.SetVariant(theme.VariantLight)
+	e.SetColor(theme.ColorNamePrimary, theme.VariantLight, orange)
+	assert.NotNil(t, changed)
+	assert.Equal(t, orange, changed.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, xtheme.AdwaitaTheme().Color(theme.ColorNamePrimary, theme.VariantDark),
+		changed.Color(theme.ColorNamePrimary, theme.VariantDark))
+	assert.Equal(t, orange, e.buttons[theme.ColorNamePrimary].Color)
+
+	e.SetVariant(theme.VariantDark)
+	assert.Equal(t, xtheme.AdwaitaTheme().Color(theme.ColorNamePrimary, theme.VariantDark),
+		e.buttons[theme.ColorNamePrimary].Color)
+	assert.Equal(t, "Dark", e.choice.Selected)
+
+	e.buttons[theme.ColorNameError].SetColor(orange)
+	assert.Equal(t, orange, e.Theme().Color(theme.ColorNameError, theme.VariantDark))
+	assert.NotEqual(t, orange, e.Theme().Color(theme.ColorNameError, theme.VariantLight))
+}
+
+func TestThemeEditor_SetSize(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewThemeEditor(nil)
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	e.sliders[theme.SizeNameText].SetValue(20)
+	assert.Equal(t, float32(20), e.Theme().Size(theme.SizeNameText))
+	assert.Equal(t, "20", e.values[theme.SizeNameText].Text)
+
+	e.Reset()
+	assert.Equal(t, theme.DefaultTheme().Size(theme.SizeNameText), e.Theme().Size(theme.SizeNameText))
+	assert.Equal(t, float64(theme.DefaultTheme().Size(theme.SizeNameText)), e.sliders[theme.SizeNameText].Value)
+}
+
+func TestThemeEditor_Preview(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	e := NewThemeEditor(nil)
+	w := test.NewWindow(e)
+	defer w.Close()
+
+	e.SetVariant(theme.VariantDark)
+	black := color.NRGBA{A: 0xff}
+	e.SetColor(theme.ColorNameBackground, theme.VariantDark, black)
+	assert.Equal(t, black, e.shade.FillColor)
+	assert.Equal(t, black, e.preview.Theme.Color(theme.ColorNameBackground, theme.VariantLight))
+	assert.Equal(t, "Input background", themeEditorLabel(string(theme.ColorNameInputBackground)))
+}
@@ -0,0 +1,336 @@
+package widget
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Toggle)(nil)
+var _ fyne.Tappable = (*Toggle)(nil)
+var _ fyne.Focusable = (*Toggle)(nil)
+var _ fyne.Disableable = (*Toggle)(nil)
+var _ desktop.Hoverable = (*Toggle)(nil)
+
+const toggleAnimationDuration = 150 * time.Millisecond
+
+// Toggle is an animated on/off switch, as seen in mobile settings screens.
+// Unlike widget.Check it has no text label of its own but can show short labels
+// or icons inside the track for each state.
+type Toggle struct {
+	widget.DisableableWidget
+
+	On        bool
+	OnChanged func(bool) `json:"-"`
+
+	// OnLabel and OffLabel are optional short texts drawn inside the track.
+	OnLabel, OffLabel string
+	// OnIcon and OffIcon are optional icons drawn inside the track, they take precedence over labels.
+	OnIcon, OffIcon fyne.Resource
+
+	hovered, focused bool
+	binder           *toggleBinder
+}
+
+// NewToggle creates a new switch with the specified change handler.
+func NewToggle(changed func(bool)) *Toggle {
+	t := &Toggle{OnChanged: changed}
+	t.ExtendBaseWidget(t)
+	return t
+}
+
+// NewToggleWithData creates a new switch that is bound to the given data source.
+func NewToggleWithData(data binding.Bool) *Toggle {
+	t := NewToggle(nil)
+	t.Bind(data)
+	return t
+}
+
+// Bind connects the switch to a data source, changes to either are reflected in the other.
+func (t *Toggle) Bind(data binding.Bool) {
+	t.Unbind()
+	t.binder = &toggleBinder{data: data}
+	t.binder.listener = binding.NewDataListener(func() {
+		on, err := data.Get()
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		t.setOn(on, false)
+	})
+	data.AddListener(t.binder.listener)
+}
+
+// Unbind disconnects any configured data source.
+func (t *Toggle) Unbind() {
+	if t.binder == nil {
+		return
+	}
+	t.binder.data.RemoveListener(t.binder.listener)
+	t.binder = nil
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (t *Toggle) CreateRenderer() fyne.WidgetRenderer {
+	t.ExtendBaseWidget(t)
+	r := &toggleRenderer{
+		toggle: t,
+		focus:  canvas.NewRectangle(color.Transparent),
+		track:  canvas.NewRectangle(color.Transparent),
+		knob:   canvas.NewCircle(color.Transparent),
+		label:  canvas.NewText("", color.Transparent),
+		icon:   canvas.NewImageFromResource(nil),
+		on:     t.On,
+	}
+	if t.On {
+		r.progress = 1
+	}
+	r.label.TextSize = theme.CaptionTextSize()
+	r.label.TextStyle.Bold = true
+	r.icon.FillMode = canvas.ImageFillContain
+	r.updateColors()
+	return r
+}
+
+// FocusGained is called when the switch has been given focus.
+//
+// Implements: fyne.Focusable
+func (t *Toggle) FocusGained() {
+	t.focused = true
+	t.Refresh()
+}
+
+// FocusLost is called when the switch has had focus removed.
+//
+// Implements: fyne.Focusable
+func (t *Toggle) FocusLost() {
+	t.focused = false
+	t.Refresh()
+}
+
+// MouseIn is called when a desktop pointer enters the widget.
+//
+// Implements: desktop.Hoverable
+func (t *Toggle) MouseIn(*desktop.MouseEvent) {
+	t.hovered = true
+	t.Refresh()
+}
+
+// MouseMoved is called when a desktop pointer hovers over the widget.
+//
+// Implements: desktop.Hoverable
+func (t *Toggle) MouseMoved(*desktop.MouseEvent) {
+}
+
+// MouseOut is called when a desktop pointer exits the widget.
+//
+// Implements: desktop.Hoverable
+func (t *Toggle) MouseOut() {
+	t.hovered = false
+	t.Refresh()
+}
+
+// SetOn sets the state of the switch, calling OnChanged if it changed.
+func (t *Toggle) SetOn(on bool) {
+	t.setOn(on, true)
+}
+
+// Tapped is called when a pointer tapped event is captured and flips the state.
+//
+// Implements: fyne.Tappable
+func (t *Toggle) Tapped(*fyne.PointEvent) {
+	if t.Disabled() {
+		return
+	}
+	if !t.focused && !fyne.CurrentDevice().IsMobile() {
+		if c := fyne.CurrentApp().Driver().CanvasForObject(t); c != nil {
+			c.Focus(t)
+		}
+	}
+	t.SetOn(!t.On)
+}
+
+// TypedKey flips the state when the space key is pressed.
+//
+// Implements: fyne.Focusable
+func (t *Toggle) TypedKey(key *fyne.KeyEvent) {
+	if key.Name == fyne.KeySpace && !t.Disabled() {
+		t.SetOn(!t.On)
+	}
+}
+
+// TypedRune receives text input events when the switch is focused.
+//
+// Implements: fyne.Focusable
+func (t *Toggle) TypedRune(rune) {
+}
+
+func (t *Toggle) setOn(on, notifyBinding bool) {
+	if on == t.On {
+		return
+	}
+	t.On = on
+	t.Refresh()
+
+	if notifyBinding && t.binder != nil {
+		if err := t.binder.data.Set(on); err != nil {
+			fyne.LogError("Error setting current data value", err)
+		}
+	}
+	if f := t.OnChanged; f != nil {
+		f(on)
+	}
+}
+
+type toggleBinder struct {
+	data     binding.Bool
+	listener binding.DataListener
+}
+
+type toggleRenderer struct {
+	toggle *Toggle
+
+	focus, track *canvas.Rectangle
+	knob         *canvas.Circle
+	label        *canvas.Text
+	icon         *canvas.Image
+
+	on       bool
+	progress float32 // 0 is off, 1 is on, values between are animating
+	anim     *fyne.Animation
+}
+
+func (r *toggleRenderer) Destroy() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+}
+
+func (r *toggleRenderer) Layout(size fyne.Size) {
+	pad := theme.InnerPadding() / 2
+	trackSize := r.trackSize()
+	trackPos := fyne.NewPos((size.Width-trackSize.Width)/2, (size.Height-trackSize.Height)/2)
+
+	r.focus.Resize(trackSize.AddWidthHeight(pad, pad))
+	r.focus.Move(trackPos.SubtractXY(pad/2, pad/2))
+	r.focus.CornerRadius = r.focus.Size().Height / 2
+	r.track.Resize(trackSize)
+	r.track.Move(trackPos)
+	r.track.CornerRadius = trackSize.Height / 2
+
+	inset := trackSize.Height / 8
+	knob := trackSize.Height - inset*2
+	travel := trackSize.Width - knob - inset*2
+	r.knob.Resize(fyne.NewSquareSize(knob))
+	r.knob.Move(trackPos.AddXY(inset+travel*r.progress, inset))
+
+	// the label or icon sits on the side of the track not covered by the knob
+	content := fyne.NewSize(travel, knob)
+	contentPos := trackPos.AddXY(inset, inset)
+	if !r.on {
+		contentPos = contentPos.AddXY(knob, 0)
+	}
+	r.icon.Resize(fyne.NewSquareSize(knob * 0.7))
+	r.icon.Move(contentPos.AddXY((content.Width-r.icon.Size().Width)/2, (content.Height-r.icon.Size().Height)/2))
+	textSize := r.label.MinSize()
+	r.label.Resize(textSize)
+	r.label.Move(contentPos.AddXY((content.Width-textSize.Width)/2, (content.Height-textSize.Height)/2))
+}
+
+func (r *toggleRenderer) MinSize() fyne.Size {
+	return r.trackSize().AddWidthHeight(theme.InnerPadding(), theme.InnerPadding())
+}
+
+func (r *toggleRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.focus, r.track, r.label, r.icon, r.knob}
+}
+
+func (r *toggleRenderer) Refresh() {
+	if r.on != r.toggle.On {
+		r.on = r.toggle.On
+		r.animate()
+	}
+	r.updateColors()
+	r.Layout(r.toggle.Size())
+	canvas.Refresh(r.toggle)
+}
+
+func (r *toggleRenderer) animate() {
+	if r.anim != nil {
+		r.anim.Stop()
+	}
+	start := r.progress
+	end := float32(0)
+	if r.on {
+		end = 1
+	}
+	r.anim = fyne.NewAnimation(toggleAnimationDuration, func(done float32) {
+		r.progress = start + (end-start)*done
+		r.Layout(r.toggle.Size())
+		canvas.Refresh(r.knob)
+	})
+	r.anim.Curve = fyne.AnimationEaseInOut
+	r.anim.Start()
+}
+
+func (r *toggleRenderer) trackSize() fyne.Size {
+	height := theme.IconInlineSize() + theme.Padding()
+	return fyne.NewSize(height*1.8, height)
+}
+
+func (r *toggleRenderer) updateColors() {
+	t := r.toggle
+	switch {
+	case t.Disabled():
+		r.track.FillColor = theme.Color(theme.ColorNameDisabledButton)
+		r.knob.FillColor = theme.Color(theme.ColorNameDisabled)
+		r.label.Color = theme.Color(theme.ColorNameDisabled)
+	case t.On:
+		r.track.FillColor = theme.Color(theme.ColorNamePrimary)
+		r.knob.FillColor = theme.Color(theme.ColorNameForegroundOnPrimary)
+		r.label.Color = theme.Color(theme.ColorNameForegroundOnPrimary)
+	default:
+		r.track.FillColor = theme.Color(theme.ColorNameInputBorder)
+		r.knob.FillColor = theme.Color(theme.ColorNameBackground)
+		r.label.Color = theme.Color(theme.ColorNameForeground)
+	}
+
+	switch {
+	case t.focused && !t.Disabled():
+		r.focus.FillColor = theme.Color(theme.ColorNameFocus)
+	case t.hovered && !t.Disabled():
+		r.focus.FillColor = theme.Color(theme.ColorNameHover)
+	default:
+		r.focus.FillColor = color.Transparent
+	}
+
+	text, icon := t.OffLabel, t.OffIcon
+	if t.On {
+		text, icon = t.OnLabel, t.OnIcon
+	}
+	if icon != nil {
+		if t.On {
+			icon = theme.NewInvertedThemedResource(icon)
+		}
+		r.icon.Resource = icon
+		r.icon.Show()
+		r.label.Hide()
+	} else {
+		r.icon.Hide()
+		r.label.Text = text
+		r.label.Show()
+	}
+	r.icon.Refresh()
+	r.label.Refresh()
+	r.track.Refresh()
+	r.knob.Refresh()
+	r.focus.Refresh()
+}
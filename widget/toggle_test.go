@@ -0,0 +1,55 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToggle_Tapped(t *testing.T) {
+	test.NewApp()
+	changed := false
+	toggle := NewToggle(func(on bool) {
+		changed = on
+	})
+	w := test.NewWindow(toggle)
+	defer w.Close()
+
+	test.Tap(toggle)
+	assert.True(t, toggle.On)
+	assert.True(t, changed)
+
+	toggle.TypedKey(&fyne.KeyEvent{Name: fyne.KeySpace})
+	assert.False(t, toggle.On)
+	assert.False(t, changed)
+
+	toggle.Disable()
+	test.Tap(toggle)
+	assert.False(t, toggle.On)
+}
+
+func TestToggle_Binding(t *testing.T) {
+	test.NewApp()
+	data := binding.NewBool()
+	toggle := NewToggleWithData(data)
+	waitForBinding()
+	_ = test.WidgetRenderer(toggle)
+
+	_ = data.Set(true)
+	waitForBinding()
+	assert.True(t, toggle.On)
+
+	toggle.SetOn(false)
+	waitForBinding()
+	v, _ := data.Get()
+	assert.False(t, v)
+
+	toggle.Unbind()
+	_ = data.Set(true)
+	waitForBinding()
+	assert.False(t, toggle.On)
+}
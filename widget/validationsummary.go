@@ -0,0 +1,291 @@
+package widget
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*ValidationSummary)(nil)
+var _ fyne.Validatable = (*ValidationSummary)(nil)
+
+// ErrValidationPending is the state of an async rule that is still being checked.
+var ErrValidationPending = errors.New("checking…")
+
+type validationItem struct {
+	name   string
+	object fyne.Validatable
+	rule   func() error
+	async  bool
+
+	err        error
+	generation int // of the latest async check, to ignore older results
+}
+
+// validationErrors is the combined error of all failing items, one per line.
+type validationErrors []error
+
+func (e validationErrors) Error() string {
+	lines := make([]string, len(e))
+	for i, err := range e {
+		lines[i] = err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ValidationSummary lists the validation errors of a group of objects, such as the entries of a form,
+// and of rules that check several fields together or check asynchronously, like a name being available.
+// It can disable submit buttons until everything is valid.
+//
+// The summary is itself validatable, so appending it as the last item of a widget.Form
+// also disables the form's submit button while anything is invalid.
+type ValidationSummary struct {
+	widget.BaseWidget
+
+	lock      sync.RWMutex
+	items     []*validationItem
+	submits   []fyne.Disableable
+	onChanged func(error)
+	lastErr   string
+}
+
+// NewValidationSummary creates a new summary with nothing to validate.
+func NewValidationSummary() *ValidationSummary {
+	s := &ValidationSummary{}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// Add validates an object, such as an Entry with a Validator, under the given name.
+// Every entry that a rule depends on should be added, even without a Validator,
+// so that the rules are checked again when its text changes.
+// This replaces any callback set with SetOnValidationChanged on the object.
+func (s *ValidationSummary) Add(name string, v fyne.Validatable) {
+	item := &validationItem{name: name, object: v}
+	s.lock.Lock()
+	s.items = append(s.items, item)
+	s.lock.Unlock()
+
+	v.SetOnValidationChanged(func(err error) {
+		s.lock.Lock()
+		item.err = err
+		s.lock.Unlock()
+		s.changed()
+	})
+	if e, ok := v.(*widget.Entry); ok {
+		// entries only report changes of their validation state, rules need every change of text
+		previous := e.OnChanged
+		e.OnChanged = func(text string) {
+			if previous != nil {
+				previous(text)
+			}
+			s.changed()
+		}
+	}
+	err := v.Validate()
+	s.lock.Lock()
+	item.err = err
+	s.lock.Unlock()
+	s.changed()
+}
+
+// AddAsyncRule adds a check that runs in a goroutine each time any object changes,
+// such as asking a server whether a user name is available. It is pending, and so invalid, while it runs.
+func (s *ValidationSummary) AddAsyncRule(name string, check func() error) {
+	s.lock.Lock()
+	s.items = append(s.items, &validationItem{name: name, rule: check, async: true})
+	s.lock.Unlock()
+	s.changed()
+}
+
+// AddRule adds a check of several fields together, such as a password matching its confirmation.
+// It runs each time any object changes.
+func (s *ValidationSummary) AddRule(name string, check func() error) {
+	s.lock.Lock()
+	s.items = append(s.items, &validationItem{name: name, rule: check})
+	s.lock.Unlock()
+	s.changed()
+}
+
+// BindSubmit disables a button, or other object, while anything is invalid.
+func (s *ValidationSummary) BindSubmit(submit fyne.Disableable) {
+	s.lock.Lock()
+	s.submits = append(s.submits, submit)
+	s.lock.Unlock()
+	s.updateSubmits(s.Errors())
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (s *ValidationSummary) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	r := &validationSummaryRenderer{summary: s, rows: container.NewVBox()}
+	r.Refresh()
+	return r
+}
+
+// Errors returns the errors of the invalid objects and rules, each prefixed by its name.
+func (s *ValidationSummary) Errors() []error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	var errs []error
+	for _, item := range s.items {
+		if item.err == nil {
+			continue
+		}
+		if item.name == "" {
+			errs = append(errs, item.err)
+		} else {
+			errs = append(errs, errors.New(item.name+": "+item.err.Error()))
+		}
+	}
+	return errs
+}
+
+// SetOnValidationChanged is intended for parent widgets or containers to hook into the validation.
+// The function might be overwritten by a parent that cares about child validation (e.g. widget.Form).
+//
+// Implements: fyne.Validatable
+func (s *ValidationSummary) SetOnValidationChanged(callback func(error)) {
+	s.lock.Lock()
+	s.onChanged = callback
+	s.lock.Unlock()
+}
+
+// Validate checks every object and rule again, returning all of their errors together or nil if all are valid.
+//
+// Implements: fyne.Validatable
+func (s *ValidationSummary) Validate() error {
+	s.lock.RLock()
+	items := append([]*validationItem(nil), s.items...)
+	s.lock.RUnlock()
+	for _, item := range items {
+		if item.object == nil {
+			continue
+		}
+		err := item.object.Validate()
+		s.lock.Lock()
+		item.err = err
+		s.lock.Unlock()
+	}
+	s.changed()
+
+	if errs := s.Errors(); len(errs) > 0 {
+		return validationErrors(errs)
+	}
+	return nil
+}
+
+// changed checks the rules again, after an object changed, and updates the summary.
+func (s *ValidationSummary) changed() {
+	s.lock.Lock()
+	async := make(map[*validationItem]int)
+	for _, item := range s.items {
+		if item.rule == nil {
+			continue
+		}
+		if !item.async {
+			item.err = item.rule()
+			continue
+		}
+		item.generation++
+		item.err = ErrValidationPending
+		async[item] = item.generation
+	}
+	s.lock.Unlock()
+
+	for item, generation := range async {
+		go s.checkAsync(item, generation)
+	}
+	s.update()
+}
+
+func (s *ValidationSummary) checkAsync(item *validationItem, generation int) {
+	err := item.rule()
+	s.lock.Lock()
+	if generation != item.generation {
+		s.lock.Unlock()
+		return
+	}
+	item.err = err
+	s.lock.Unlock()
+	s.update()
+}
+
+func (s *ValidationSummary) update() {
+	errs := s.Errors()
+	s.updateSubmits(errs)
+
+	var err error
+	text := ""
+	if len(errs) > 0 {
+		err = validationErrors(errs)
+		text = err.Error()
+	}
+	s.lock.Lock()
+	changed := text != s.lastErr
+	s.lastErr = text
+	callback := s.onChanged
+	s.lock.Unlock()
+
+	if changed {
+		s.Refresh()
+		if callback != nil {
+			callback(err)
+		}
+	}
+}
+
+func (s *ValidationSummary) updateSubmits(errs []error) {
+	s.lock.RLock()
+	submits := s.submits
+	s.lock.RUnlock()
+	for _, d := range submits {
+		if len(errs) > 0 {
+			d.Disable()
+		} else {
+			d.Enable()
+		}
+	}
+}
+
+type validationSummaryRenderer struct {
+	summary *ValidationSummary
+	rows    *fyne.Container
+}
+
+func (r *validationSummaryRenderer) Destroy() {
+}
+
+func (r *validationSummaryRenderer) Layout(size fyne.Size) {
+	r.rows.Resize(size)
+}
+
+func (r *validationSummaryRenderer) MinSize() fyne.Size {
+	return r.rows.MinSize()
+}
+
+func (r *validationSummaryRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.rows}
+}
+
+func (r *validationSummaryRenderer) Refresh() {
+	errs := r.summary.Errors()
+	rows := make([]fyne.CanvasObject, len(errs))
+	for i, err := range errs {
+		icon := widget.NewIcon(theme.NewErrorThemedResource(theme.ErrorIcon()))
+		label := widget.NewLabel(err.Error())
+		label.Importance = widget.DangerImportance
+		label.Wrapping = fyne.TextWrapWord
+		rows[i] = container.NewBorder(nil, nil, icon, nil, label)
+	}
+	r.rows.Objects = rows
+	r.rows.Hidden = len(rows) == 0
+	r.rows.Refresh()
+}
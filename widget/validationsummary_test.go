@@ -0,0 +1,108 @@
+package widget
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/x/fyne/data/validation"
+)
+
+func TestValidationSummary_Fields(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	name := widget.NewEntry()
+	name.Validator = validation.NewRequired()
+	password := widget.NewEntry()
+	confirm := widget.NewEntry()
+	submit := widget.NewButton("Submit", nil)
+
+	s := NewValidationSummary()
+	s.Add("Name", name)
+	s.Add("Password", password)
+	s.Add("Confirm", confirm)
+	s.AddRule("Confirm", func() error {
+		if confirm.Text != password.Text {
+			return errors.New("passwords do not match")
+		}
+		return nil
+	})
+	s.BindSubmit(submit)
+	w := test.NewWindow(s)
+	defer w.Close()
+
+	assert.True(t, submit.Disabled())
+	assert.Equal(t, []error{errors.New("Name: required")}, s.Errors())
+
+	test.Type(name, "Ada")
+	assert.Empty(t, s.Errors())
+	assert.False(t, submit.Disabled())
+
+	test.Type(password, "secret")
+	assert.True(t, submit.Disabled())
+	assert.Equal(t, "Confirm: passwords do not match", s.Validate().Error())
+
+	test.Type(confirm, "secret")
+	assert.Empty(t, s.Errors())
+	assert.False(t, submit.Disabled())
+	assert.NoError(t, s.Validate())
+}
+
+func TestValidationSummary_Async(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	results := make(chan error)
+	user := widget.NewEntry()
+	s := NewValidationSummary()
+	s.Add("User", user)
+	s.AddAsyncRule("User", func() error {
+		return <-results
+	})
+
+	assert.Equal(t, []error{errors.New("User: " + ErrValidationPending.Error())}, s.Errors())
+	results <- errors.New("taken")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, []error{errors.New("User: taken")}, s.Errors())
+
+	user.SetText("ada2")
+	results <- nil
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, s.Errors())
+}
+
+func TestValidationSummary_Form(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	password := widget.NewPasswordEntry()
+	confirm := widget.NewPasswordEntry()
+	s := NewValidationSummary()
+	s.AddRule("", func() error {
+		if confirm.Text != password.Text {
+			return errors.New("passwords do not match")
+		}
+		return nil
+	})
+	form := widget.NewForm(widget.NewFormItem("Password", password), widget.NewFormItem("Confirm", confirm),
+		widget.NewFormItem("", s))
+	form.OnSubmit = func() {}
+	w := test.NewWindow(form)
+	defer w.Close()
+
+	password.SetText("secret")
+	s.Validate()
+	assert.Equal(t, []error{errors.New("passwords do not match")}, s.Errors())
+	assert.True(t, s.Visible())
+	r := test.WidgetRenderer(s).(*validationSummaryRenderer)
+	assert.Len(t, r.rows.Objects, 1)
+
+	confirm.SetText("secret")
+	assert.NoError(t, s.Validate())
+	assert.True(t, r.rows.Hidden)
+}
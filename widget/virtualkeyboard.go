@@ -0,0 +1,348 @@
+package widget
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*VirtualKeyboard)(nil)
+var _ fyne.Layout = (*keyboardRowLayout)(nil)
+
+// Special keys that can be used in the rows of a KeyboardLayout.
+const (
+	KeyboardKeyShift     = "{shift}"
+	KeyboardKeyBackspace = "{backspace}"
+	KeyboardKeySpace     = "{space}"
+	KeyboardKeyEnter     = "{enter}"
+	KeyboardKeySymbols   = "{symbols}"
+	KeyboardKeyLetters   = "{letters}"
+)
+
+// KeyboardLayout describes the keys of a VirtualKeyboard.
+// Each row is a list of keys, a key is the text it types or one of the special KeyboardKey values.
+type KeyboardLayout struct {
+	Name string
+	// Letters are the rows of the main page, shift types them in upper case.
+	Letters [][]string
+	// Symbols are the rows of the page of digits and punctuation.
+	Symbols [][]string
+}
+
+var keyboardSymbols = [][]string{
+	strings.Split("1234567890", ""),
+	strings.Split("@#€_&-+()/", ""),
+	append(append([]string{KeyboardKeyLetters}, strings.Split("*\"':;!?", "")...), KeyboardKeyBackspace),
+}
+
+// KeyboardLayoutQWERTY is the US English layout.
+var KeyboardLayoutQWERTY = KeyboardLayout{
+	Name: "QWERTY",
+	Letters: [][]string{
+		strings.Split("qwertyuiop", ""),
+		strings.Split("asdfghjkl", ""),
+		append(append([]string{KeyboardKeyShift}, strings.Split("zxcvbnm", "")...), KeyboardKeyBackspace),
+	},
+	Symbols: keyboardSymbols,
+}
+
+// KeyboardLayoutQWERTZ is the German layout.
+var KeyboardLayoutQWERTZ = KeyboardLayout{
+	Name: "QWERTZ",
+	Letters: [][]string{
+		strings.Split("qwertzuiopü", ""),
+		strings.Split("asdfghjklöä", ""),
+		append(append([]string{KeyboardKeyShift}, strings.Split("yxcvbnmß", "")...), KeyboardKeyBackspace),
+	},
+	Symbols: keyboardSymbols,
+}
+
+// KeyboardLayoutAZERTY is the French layout.
+var KeyboardLayoutAZERTY = KeyboardLayout{
+	Name: "AZERTY",
+	Letters: [][]string{
+		strings.Split("azertyuiop", ""),
+		strings.Split("qsdfghjklm", ""),
+		append(append([]string{KeyboardKeyShift}, strings.Split("wxcvbnéèà", "")...), KeyboardKeyBackspace),
+	},
+	Symbols: keyboardSymbols,
+}
+
+// KeyboardLayouts maps language tags to the layout commonly used for them.
+var KeyboardLayouts = map[string]KeyboardLayout{
+	"en": KeyboardLayoutQWERTY,
+	"de": KeyboardLayoutQWERTZ,
+	"fr": KeyboardLayoutAZERTY,
+}
+
+// KeyboardLayoutForLocale returns the layout for a locale such as "de-CH" or "fr_FR", QWERTY if unknown.
+func KeyboardLayoutForLocale(locale string) KeyboardLayout {
+	lang := strings.ToLower(locale)
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	if l, ok := KeyboardLayouts[lang]; ok {
+		return l
+	}
+	return KeyboardLayoutQWERTY
+}
+
+type keyboardShift int
+
+const (
+	keyboardShiftOff keyboardShift = iota
+	keyboardShiftOnce
+	keyboardShiftLocked
+)
+
+// VirtualKeyboard is an on-screen keyboard for devices without a system one, such as kiosks.
+// Keys are typed into the Target, or the focused object of the attached canvas,
+// and are also passed to OnRune and OnKey.
+// Tapping shift once capitalizes the next letter and tapping it again locks capitals.
+type VirtualKeyboard struct {
+	widget.BaseWidget
+
+	Layout KeyboardLayout
+	// Target receives the typed runes and keys, the focused object of the canvas is used if nil.
+	Target fyne.Focusable
+
+	OnRune func(rune)           `json:"-"`
+	OnKey  func(*fyne.KeyEvent) `json:"-"`
+
+	canvas  fyne.Canvas
+	shift   keyboardShift
+	symbols bool
+}
+
+// NewVirtualKeyboard creates a new keyboard with the given layout.
+func NewVirtualKeyboard(layout KeyboardLayout) *VirtualKeyboard {
+	k := &VirtualKeyboard{Layout: layout}
+	k.ExtendBaseWidget(k)
+	return k
+}
+
+// ShowVirtualKeyboard shows a keyboard along the bottom of the canvas that types into the object
+// focused when it was shown. Tapping outside of the keyboard hides it.
+func ShowVirtualKeyboard(c fyne.Canvas, layout KeyboardLayout) *widget.PopUp {
+	k := NewVirtualKeyboard(layout)
+	k.Target = c.Focused()
+	popUp := widget.NewPopUp(k, c)
+	size := fyne.NewSize(c.Size().Width, k.MinSize().Height)
+	popUp.Resize(size)
+	popUp.ShowAtPosition(fyne.NewPos(0, c.Size().Height-size.Height))
+	return popUp
+}
+
+// Attach makes the keyboard type into the focused object of a canvas,
+// which can be different to the one the keyboard is shown in.
+func (k *VirtualKeyboard) Attach(c fyne.Canvas) {
+	k.canvas = c
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (k *VirtualKeyboard) CreateRenderer() fyne.WidgetRenderer {
+	k.ExtendBaseWidget(k)
+	rows := container.NewGridWithColumns(1)
+	r := &virtualKeyboardRenderer{keyboard: k, rows: rows}
+	r.Refresh()
+	return r
+}
+
+// Press types a key as if it was tapped, it can be text or one of the special KeyboardKey values.
+func (k *VirtualKeyboard) Press(key string) {
+	switch key {
+	case KeyboardKeyShift:
+		switch k.shift {
+		case keyboardShiftOff:
+			k.shift = keyboardShiftOnce
+		case keyboardShiftOnce:
+			k.shift = keyboardShiftLocked
+		default:
+			k.shift = keyboardShiftOff
+		}
+		k.Refresh()
+	case KeyboardKeySymbols, KeyboardKeyLetters:
+		k.symbols = key == KeyboardKeySymbols
+		k.Refresh()
+	case KeyboardKeyBackspace:
+		k.pressKey(fyne.KeyBackspace)
+	case KeyboardKeyEnter:
+		k.pressKey(fyne.KeyReturn)
+	case KeyboardKeySpace:
+		k.pressRune(' ')
+	default:
+		text := key
+		if k.shift != keyboardShiftOff && !k.symbols {
+			text = strings.ToUpper(key)
+		}
+		for _, r := range text {
+			k.pressRune(r)
+		}
+		if k.shift == keyboardShiftOnce {
+			k.shift = keyboardShiftOff
+			k.Refresh()
+		}
+	}
+}
+
+func (k *VirtualKeyboard) pressKey(name fyne.KeyName) {
+	ev := &fyne.KeyEvent{Name: name}
+	if f := k.OnKey; f != nil {
+		f(ev)
+	}
+	if t := k.target(); t != nil {
+		t.TypedKey(ev)
+	}
+}
+
+func (k *VirtualKeyboard) pressRune(r rune) {
+	if f := k.OnRune; f != nil {
+		f(r)
+	}
+	if t := k.target(); t != nil {
+		t.TypedRune(r)
+	}
+}
+
+func (k *VirtualKeyboard) target() fyne.Focusable {
+	if k.Target != nil {
+		return k.Target
+	}
+	c := k.canvas
+	if c == nil {
+		c = fyne.CurrentApp().Driver().CanvasForObject(k)
+	}
+	if c == nil {
+		return nil
+	}
+	return c.Focused()
+}
+
+type virtualKeyboardRenderer struct {
+	keyboard *VirtualKeyboard
+	rows     *fyne.Container
+}
+
+func (r *virtualKeyboardRenderer) Destroy() {
+}
+
+func (r *virtualKeyboardRenderer) Layout(size fyne.Size) {
+	r.rows.Resize(size)
+}
+
+func (r *virtualKeyboardRenderer) MinSize() fyne.Size {
+	return r.rows.MinSize()
+}
+
+func (r *virtualKeyboardRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.rows}
+}
+
+func (r *virtualKeyboardRenderer) Refresh() {
+	k := r.keyboard
+	rows := k.Layout.Letters
+	if k.symbols {
+		rows = k.Layout.Symbols
+	}
+	bottom := []string{KeyboardKeySymbols, ",", KeyboardKeySpace, ".", KeyboardKeyEnter}
+	if k.symbols {
+		bottom[0] = KeyboardKeyLetters
+	}
+
+	r.rows.Objects = nil
+	for _, row := range append(append([][]string{}, rows...), bottom) {
+		r.rows.Add(r.makeRow(row))
+	}
+	r.rows.Refresh()
+}
+
+func (r *virtualKeyboardRenderer) makeRow(keys []string) fyne.CanvasObject {
+	k := r.keyboard
+	weights := make([]float32, len(keys))
+	buttons := make([]fyne.CanvasObject, len(keys))
+	for i, key := range keys {
+		key := key
+		weights[i] = 1
+		b := widget.NewButton(key, func() { k.Press(key) })
+		switch key {
+		case KeyboardKeyShift:
+			b.SetText("")
+			b.SetIcon(theme.MoveUpIcon())
+			weights[i] = 1.5
+			if k.shift == keyboardShiftLocked {
+				b.Importance = widget.HighImportance
+			} else if k.shift == keyboardShiftOnce {
+				b.Importance = widget.MediumImportance
+			} else {
+				b.Importance = widget.LowImportance
+			}
+		case KeyboardKeyBackspace:
+			b.SetText("")
+			b.SetIcon(theme.NavigateBackIcon())
+			weights[i] = 1.5
+		case KeyboardKeySymbols:
+			b.SetText("?123")
+			weights[i] = 1.5
+		case KeyboardKeyLetters:
+			b.SetText("ABC")
+			weights[i] = 1.5
+		case KeyboardKeySpace:
+			b.SetText("")
+			weights[i] = 5
+		case KeyboardKeyEnter:
+			b.SetText("")
+			b.SetIcon(theme.ConfirmIcon())
+			b.Importance = widget.HighImportance
+			weights[i] = 1.5
+		default:
+			if k.shift != keyboardShiftOff && !k.symbols {
+				b.SetText(strings.ToUpper(key))
+			}
+		}
+		buttons[i] = b
+	}
+	return container.New(&keyboardRowLayout{weights: weights}, buttons...)
+}
+
+// keyboardRowLayout sizes keys in proportion to their weights, centring rows with fewer keys.
+// A weight of 1 is the width of a key in a row of ten.
+type keyboardRowLayout struct {
+	weights []float32
+}
+
+func (l *keyboardRowLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	pad := theme.Padding() / 2
+	unit := (size.Width - pad*9) / 10
+	total := float32(0)
+	for _, w := range l.weights {
+		total += w
+	}
+	if total > 10 {
+		unit = (size.Width - pad*float32(len(objects)-1)) / total
+	}
+
+	width := pad * float32(len(objects)-1)
+	for _, w := range l.weights {
+		width += unit * w
+	}
+	x := (size.Width - width) / 2
+	for i, o := range objects {
+		w := unit * l.weights[i]
+		o.Resize(fyne.NewSize(w, size.Height))
+		o.Move(fyne.NewPos(x, 0))
+		x += w + pad
+	}
+}
+
+func (l *keyboardRowLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	key := fyne.NewSize(0, 0)
+	for _, o := range objects {
+		key = key.Max(o.MinSize())
+	}
+	return fyne.NewSize(key.Width*10+theme.Padding()/2*9, key.Height*1.2)
+}
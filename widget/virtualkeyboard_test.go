@@ -0,0 +1,84 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualKeyboard_Typing(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	entry := widget.NewEntry()
+	k := NewVirtualKeyboard(KeyboardLayoutQWERTY)
+	k.Target = entry
+	w := test.NewWindow(container.NewVBox(entry, k))
+	defer w.Close()
+
+	k.Press(KeyboardKeyShift)
+	k.Press("h")
+	k.Press("i")
+	assert.Equal(t, "Hi", entry.Text)
+
+	k.Press(KeyboardKeyShift)
+	k.Press(KeyboardKeyShift)
+	k.Press("o")
+	k.Press("k")
+	assert.Equal(t, "HiOK", entry.Text)
+	k.Press(KeyboardKeyShift)
+
+	k.Press(KeyboardKeySpace)
+	k.Press(KeyboardKeySymbols)
+	k.Press("1")
+	k.Press(KeyboardKeyLetters)
+	k.Press(KeyboardKeyBackspace)
+	assert.Equal(t, "HiOK ", entry.Text)
+
+	submitted := false
+	entry.OnSubmitted = func(string) {
+		submitted = true
+	}
+	k.Press(KeyboardKeyEnter)
+	assert.True(t, submitted)
+}
+
+func TestVirtualKeyboard_Attach(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	entry := widget.NewEntry()
+	w := test.NewWindow(entry)
+	defer w.Close()
+	w.Canvas().Focus(entry)
+
+	k := NewVirtualKeyboard(KeyboardLayoutQWERTZ)
+	k.Attach(w.Canvas())
+	k.Press("ü")
+	assert.Equal(t, "ü", entry.Text)
+
+	w.Resize(fyne.NewSize(400, 400))
+	popUp := ShowVirtualKeyboard(w.Canvas(), KeyboardLayoutAZERTY)
+	assert.True(t, popUp.Visible())
+	popUp.Content.(*VirtualKeyboard).Press("é")
+	assert.Equal(t, "üé", entry.Text)
+}
+
+func TestKeyboardLayoutForLocale(t *testing.T) {
+	assert.Equal(t, "QWERTZ", KeyboardLayoutForLocale("de-CH").Name)
+	assert.Equal(t, "AZERTY", KeyboardLayoutForLocale("fr_FR").Name)
+	assert.Equal(t, "QWERTY", KeyboardLayoutForLocale("xx").Name)
+	assert.Equal(t, "QWERTY", KeyboardLayoutForLocale("").Name)
+}
+
+func TestKeyboardRowLayout(t *testing.T) {
+	a, b := widget.NewButton("a", nil), widget.NewButton("b", nil)
+	l := &keyboardRowLayout{weights: []float32{1, 2}}
+	l.Layout([]fyne.CanvasObject{a, b}, fyne.NewSize(1000, 50))
+	assert.Equal(t, b.Size().Width, a.Size().Width*2)
+	assert.InDelta(t, 1000-(b.Position().X+b.Size().Width), a.Position().X, 0.01)
+}
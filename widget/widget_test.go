@@ -0,0 +1,32 @@
+package widget
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+func waitForBinding() {
+	time.Sleep(time.Millisecond * 100) // data resolves on background thread
+
+	// listeners are called in order on one goroutine, so once a new one is called the changes
+	// that the earlier ones made to widgets are visible to the test
+	done := make(chan struct{})
+	binding.NewBool().AddListener(binding.NewDataListener(func() {
+		close(done)
+	}))
+	<-done
+}
+
+// withBindings calls f on the goroutine that calls data listeners and waits for it and the listeners that
+// it notifies, for changes to a bound widget that would otherwise race with the listener updating the widget.
+func withBindings(f func()) {
+	done := make(chan struct{})
+	binding.NewBool().AddListener(binding.NewDataListener(func() {
+		f()
+		binding.NewBool().AddListener(binding.NewDataListener(func() {
+			close(done)
+		}))
+	}))
+	<-done
+}
@@ -0,0 +1,273 @@
+package wrapper
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*dragSourceObject)(nil)
+var _ fyne.Draggable = (*dragSourceObject)(nil)
+var _ fyne.Widget = (*dropTargetObject)(nil)
+
+// DragSource describes what is dragged from an object made draggable with MakeDragSource.
+type DragSource struct {
+	// Payload returns the data to drag, when a drag starts. Nothing is dragged if it returns nil.
+	Payload func() interface{} `json:"-"`
+	// Ghost returns the object shown under the pointer while dragging.
+	// By default it is a translucent box the size of the dragged object.
+	Ghost func() fyne.CanvasObject `json:"-"`
+	// OnDragEnd is called when the drag ends, with whether a target accepted the payload.
+	OnDragEnd func(dropped bool) `json:"-"`
+}
+
+// DropTarget describes the payloads that an object made a target with MakeDropTarget accepts.
+type DropTarget struct {
+	// Accept reports whether a payload can be dropped on the target, all are accepted if it is nil.
+	// The target is highlighted while a payload is dragged over it, in the error colour if it is rejected.
+	Accept func(payload interface{}) bool `json:"-"`
+	// OnDrop is called when an accepted payload is dropped, at a position relative to the target.
+	OnDrop func(payload interface{}, pos fyne.Position) `json:"-"`
+	// OnDragOver is called as an accepted payload moves over the target, such as to show where it would be inserted.
+	OnDragOver func(payload interface{}, pos fyne.Position) `json:"-"`
+	// OnDragLeave is called when a payload that was over the target leaves it or is dropped.
+	OnDragLeave func() `json:"-"`
+}
+
+var (
+	dropTargetsLock sync.Mutex
+	dropTargets     = make(map[*dropTargetObject]bool)
+)
+
+// MakeDragSource wraps an object so that dragging it carries a payload to drop targets.
+// Taps still reach the object.
+func MakeDragSource(object fyne.CanvasObject, source *DragSource) fyne.CanvasObject {
+	d := &dragSourceObject{object: object, source: source}
+	d.ExtendBaseWidget(d)
+	return d
+}
+
+// MakeDropTarget wraps an object so that payloads dragged from drag sources can be dropped on it.
+// Targets may be nested, the innermost target under the pointer receives the payload.
+func MakeDropTarget(object fyne.CanvasObject, target *DropTarget) fyne.CanvasObject {
+	d := &dropTargetObject{object: object, target: target}
+	d.ExtendBaseWidget(d)
+	return d
+}
+
+// dragSourceObject starts a drag from its object, showing a ghost and tracking the target under the pointer.
+type dragSourceObject struct {
+	widget.BaseWidget
+	object fyne.CanvasObject
+	source *DragSource
+
+	dragging bool
+	payload  interface{}
+	ghost    fyne.CanvasObject
+	layer    *fyne.Container
+	canvas   fyne.Canvas
+	offset   fyne.Position // of the pointer within the ghost
+	over     *dropTargetObject
+	pointer  fyne.Position // absolute
+}
+
+// Content returns the encapsulated object.
+func (d *dragSourceObject) Content() fyne.CanvasObject {
+	return d.object
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (d *dragSourceObject) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(d.object)
+}
+
+// Dragged moves the payload with the pointer, starting the drag on the first event.
+//
+// Implements: fyne.Draggable
+func (d *dragSourceObject) Dragged(e *fyne.DragEvent) {
+	if !d.dragging {
+		if !d.start(e) {
+			return
+		}
+	}
+	d.pointer = e.AbsolutePosition
+	d.ghost.Move(d.pointer.Subtract(d.offset))
+	d.layer.Refresh()
+
+	target := findDropTarget(d.canvas, d.pointer, d)
+	if target != d.over {
+		if d.over != nil {
+			d.over.leave()
+		}
+		d.over = target
+	}
+	if target != nil {
+		target.dragOver(d.payload, d.pointer)
+	}
+}
+
+// DragEnd drops the payload on the target under the pointer, if it accepts it.
+//
+// Implements: fyne.Draggable
+func (d *dragSourceObject) DragEnd() {
+	if !d.dragging {
+		return
+	}
+	d.dragging = false
+	d.canvas.Overlays().Remove(d.layer)
+
+	dropped := false
+	if target := d.over; target != nil {
+		d.over = nil
+		target.leave()
+		if target.accepts(d.payload) {
+			dropped = true
+			if f := target.target.OnDrop; f != nil {
+				f(d.payload, target.relative(d.pointer))
+			}
+		}
+	}
+	d.payload, d.ghost, d.layer = nil, nil, nil
+	if f := d.source.OnDragEnd; f != nil {
+		f(dropped)
+	}
+}
+
+func (d *dragSourceObject) start(e *fyne.DragEvent) bool {
+	if d.source.Payload == nil {
+		return false
+	}
+	d.canvas = fyne.CurrentApp().Driver().CanvasForObject(d)
+	if d.canvas == nil {
+		return false
+	}
+	d.payload = d.source.Payload()
+	if d.payload == nil {
+		return false
+	}
+
+	if d.source.Ghost != nil {
+		d.ghost = d.source.Ghost()
+		d.ghost.Resize(d.ghost.MinSize())
+		size := d.ghost.Size()
+		d.offset = fyne.NewPos(size.Width/2, size.Height/2)
+	} else {
+		d.ghost = newDragGhost(d.Size())
+		// the ghost starts where the object is, following the pointer from the point it was grabbed
+		d.offset = e.Position.Subtract(e.Dragged)
+	}
+	d.layer = container.NewWithoutLayout(d.ghost)
+	d.canvas.Overlays().Add(d.layer)
+	d.dragging = true
+	return true
+}
+
+func newDragGhost(size fyne.Size) fyne.CanvasObject {
+	box := canvas.NewRectangle(theme.Color(theme.ColorNameHover))
+	box.StrokeColor = theme.Color(theme.ColorNamePrimary)
+	box.StrokeWidth = 1
+	box.CornerRadius = theme.InputRadiusSize()
+	box.Resize(size)
+	return box
+}
+
+// dropTargetObject shows its object with a highlight while a payload is dragged over it.
+type dropTargetObject struct {
+	widget.BaseWidget
+	object fyne.CanvasObject
+	target *DropTarget
+
+	highlight *canvas.Rectangle
+}
+
+// Content returns the encapsulated object.
+func (d *dropTargetObject) Content() fyne.CanvasObject {
+	return d.object
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (d *dropTargetObject) CreateRenderer() fyne.WidgetRenderer {
+	d.highlight = canvas.NewRectangle(theme.Color(theme.ColorNameHover))
+	d.highlight.StrokeWidth = 2
+	d.highlight.CornerRadius = theme.InputRadiusSize()
+	d.highlight.Hide()
+	dropTargetsLock.Lock()
+	dropTargets[d] = true
+	dropTargetsLock.Unlock()
+	return &dropTargetRenderer{WidgetRenderer: widget.NewSimpleRenderer(container.NewStack(d.object, d.highlight)),
+		owner: d}
+}
+
+func (d *dropTargetObject) accepts(payload interface{}) bool {
+	return d.target.Accept == nil || d.target.Accept(payload)
+}
+
+func (d *dropTargetObject) dragOver(payload interface{}, pointer fyne.Position) {
+	accepted := d.accepts(payload)
+	if d.highlight != nil {
+		if accepted {
+			d.highlight.StrokeColor = theme.Color(theme.ColorNamePrimary)
+		} else {
+			d.highlight.StrokeColor = theme.Color(theme.ColorNameError)
+		}
+		d.highlight.Show()
+		d.highlight.Refresh()
+	}
+	if f := d.target.OnDragOver; accepted && f != nil {
+		f(payload, d.relative(pointer))
+	}
+}
+
+func (d *dropTargetObject) leave() {
+	if d.highlight != nil {
+		d.highlight.Hide()
+	}
+	if f := d.target.OnDragLeave; f != nil {
+		f()
+	}
+}
+
+func (d *dropTargetObject) relative(pointer fyne.Position) fyne.Position {
+	return pointer.Subtract(fyne.CurrentApp().Driver().AbsolutePositionForObject(d))
+}
+
+type dropTargetRenderer struct {
+	fyne.WidgetRenderer
+	owner *dropTargetObject
+}
+
+func (r *dropTargetRenderer) Destroy() {
+	dropTargetsLock.Lock()
+	delete(dropTargets, r.owner)
+	dropTargetsLock.Unlock()
+	r.WidgetRenderer.Destroy()
+}
+
+// findDropTarget returns the smallest visible target on a canvas under the pointer, other than the source itself.
+func findDropTarget(c fyne.Canvas, pointer fyne.Position, source fyne.CanvasObject) *dropTargetObject {
+	dropTargetsLock.Lock()
+	defer dropTargetsLock.Unlock()
+	d := fyne.CurrentApp().Driver()
+	var found *dropTargetObject
+	for t := range dropTargets {
+		if !t.Visible() || t == source || d.CanvasForObject(t) != c {
+			continue
+		}
+		pos, size := d.AbsolutePositionForObject(t), t.Size()
+		if pointer.X < pos.X || pointer.Y < pos.Y || pointer.X >= pos.X+size.Width || pointer.Y >= pos.Y+size.Height {
+			continue
+		}
+		if found == nil || size.Width*size.Height < found.Size().Width*found.Size().Height {
+			found = t
+		}
+	}
+	return found
+}
@@ -0,0 +1,104 @@
+package wrapper
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+// dragTo drags a source from a point inside it to an absolute position on its canvas, then drops it.
+func dragTo(source fyne.CanvasObject, from, to fyne.Position) {
+	d := source.(fyne.Draggable)
+	start := fyne.CurrentApp().Driver().AbsolutePositionForObject(source)
+	delta := to.Subtract(start.Add(from))
+	d.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: from.Add(delta), AbsolutePosition: to},
+		Dragged: fyne.NewDelta(delta.X, delta.Y)})
+}
+
+func TestDragDrop(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	var dropped interface{}
+	var droppedAt fyne.Position
+	var ended []bool
+	source := MakeDragSource(widget.NewLabel("Card"), &DragSource{
+		Payload:   func() interface{} { return "card-1" },
+		OnDragEnd: func(ok bool) { ended = append(ended, ok) },
+	})
+	over := 0
+	target := MakeDropTarget(widget.NewLabel("Done column"), &DropTarget{
+		OnDrop: func(payload interface{}, pos fyne.Position) {
+			dropped, droppedAt = payload, pos
+		},
+		OnDragOver: func(interface{}, fyne.Position) { over++ },
+	})
+	w := test.NewWindow(container.NewVBox(source, target))
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(200, 200))
+
+	targetPos := fyne.CurrentApp().Driver().AbsolutePositionForObject(target)
+	dragTo(source, fyne.NewPos(5, 5), targetPos.AddXY(10, 8))
+	assert.NotNil(t, w.Canvas().Overlays().Top())
+	assert.True(t, target.(*dropTargetObject).highlight.Visible())
+	assert.Equal(t, 1, over)
+
+	source.(fyne.Draggable).DragEnd()
+	assert.Nil(t, w.Canvas().Overlays().Top())
+	assert.False(t, target.(*dropTargetObject).highlight.Visible())
+	assert.Equal(t, "card-1", dropped)
+	assert.Equal(t, fyne.NewPos(10, 8), droppedAt)
+	assert.Equal(t, []bool{true}, ended)
+
+	// dropped away from any target
+	dropped = nil
+	dragTo(source, fyne.NewPos(5, 5), fyne.NewPos(150, 190))
+	source.(fyne.Draggable).DragEnd()
+	assert.Nil(t, dropped)
+	assert.Equal(t, []bool{true, false}, ended)
+}
+
+func TestDragDrop_Reject(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	dropped := false
+	source := MakeDragSource(widget.NewLabel("File"), &DragSource{Payload: func() interface{} { return 42 }})
+	inner := MakeDropTarget(widget.NewLabel("Images"), &DropTarget{
+		Accept: func(payload interface{}) bool {
+			_, ok := payload.(string)
+			return ok
+		},
+		OnDrop: func(interface{}, fyne.Position) { dropped = true },
+	})
+	outer := MakeDropTarget(container.NewPadded(inner), &DropTarget{})
+	w := test.NewWindow(container.NewVBox(source, outer))
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(inner).AddXY(5, 5)
+	dragTo(source, fyne.NewPos(5, 5), pos)
+	// the innermost target shows it rejects the payload
+	assert.True(t, inner.(*dropTargetObject).highlight.Visible())
+	assert.False(t, outer.(*dropTargetObject).highlight.Visible())
+	source.(fyne.Draggable).DragEnd()
+	assert.False(t, dropped)
+}
+
+func TestDragDrop_NoPayload(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	source := MakeDragSource(widget.NewLabel("Locked"), &DragSource{Payload: func() interface{} { return nil }})
+	w := test.NewWindow(source)
+	defer w.Close()
+
+	dragTo(source, fyne.NewPos(5, 5), fyne.NewPos(20, 20))
+	assert.Nil(t, w.Canvas().Overlays().Top())
+	source.(fyne.Draggable).DragEnd()
+}
@@ -0,0 +1,352 @@
+package wrapper
+
+import (
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	xwidget "fyne.io/x/fyne/widget"
+)
+
+var _ fyne.Widget = (*tooltipObject)(nil)
+var _ desktop.Hoverable = (*tooltipHoverLayer)(nil)
+var _ desktop.Cursorable = (*tooltipHoverLayer)(nil)
+
+const tooltipFocusPollInterval = 100 * time.Millisecond
+
+// DefaultTooltipManager shows the tooltips of MakeTooltip and MakeRichTooltip.
+var DefaultTooltipManager = NewTooltipManager()
+
+// Tooltip is the content of a tooltip: text with an optional icon and shortcut hint, or any rich content.
+type Tooltip struct {
+	// Text may have several lines separated by "\n".
+	Text string
+	Icon fyne.Resource
+	// Shortcut is shown after the text as a hint of the keys for the same action.
+	Shortcut fyne.KeyboardShortcut
+	// Content replaces the text, icon and shortcut when set.
+	Content fyne.CanvasObject
+}
+
+// TooltipManager shows the tooltip of a wrapped object when the mouse rests over it or it gains keyboard focus.
+// Tooltips are drawn on a layer added to the window content with AddTooltipLayer.
+type TooltipManager struct {
+	// ShowDelay is how long the mouse rests over an object before its tooltip shows.
+	ShowDelay time.Duration
+	// HideDelay is how long a tooltip stays after the mouse leaves its object.
+	HideDelay time.Duration
+
+	lock      sync.Mutex
+	layers    map[fyne.Canvas]*fyne.Container
+	timer     *time.Timer
+	shown     *tooltipObject
+	focusable map[*tooltipObject]bool
+	focused   map[fyne.Canvas]fyne.Focusable
+	byFocus   bool // whether the shown tooltip is for a focused object rather than the mouse
+	stopPoll  chan struct{}
+}
+
+// NewTooltipManager creates a new manager that shows tooltips after half a second.
+func NewTooltipManager() *TooltipManager {
+	return &TooltipManager{ShowDelay: 500 * time.Millisecond, HideDelay: 100 * time.Millisecond,
+		layers: make(map[fyne.Canvas]*fyne.Container), focusable: make(map[*tooltipObject]bool),
+		focused: make(map[fyne.Canvas]fyne.Focusable)}
+}
+
+// AddTooltipLayer returns the content of a canvas with a layer above it for the tooltips of DefaultTooltipManager.
+// It should be set as the content of the window, or of a pop up, with the canvas it is shown on.
+func AddTooltipLayer(content fyne.CanvasObject, c fyne.Canvas) fyne.CanvasObject {
+	return DefaultTooltipManager.AddTooltipLayer(content, c)
+}
+
+// MakeTooltip wraps an object to show a text tooltip when the mouse rests over it.
+func MakeTooltip(object fyne.CanvasObject, text string) fyne.CanvasObject {
+	return DefaultTooltipManager.Wrap(object, &Tooltip{Text: text})
+}
+
+// MakeRichTooltip wraps an object to show a tooltip with an icon, shortcut hint or any content.
+func MakeRichTooltip(object fyne.CanvasObject, tip *Tooltip) fyne.CanvasObject {
+	return DefaultTooltipManager.Wrap(object, tip)
+}
+
+// AddTooltipLayer returns the content of a canvas with a layer above it for the tooltips of this manager.
+func (m *TooltipManager) AddTooltipLayer(content fyne.CanvasObject, c fyne.Canvas) fyne.CanvasObject {
+	layer := container.NewWithoutLayout()
+	m.lock.Lock()
+	m.layers[c] = layer
+	m.lock.Unlock()
+	return container.NewStack(content, layer)
+}
+
+// Hide removes any tooltip that is shown.
+func (m *TooltipManager) Hide() {
+	m.lock.Lock()
+	m.stopTimer()
+	m.hide()
+	m.lock.Unlock()
+}
+
+// Wrap returns an object that shows a tooltip when the mouse rests over object or it gains keyboard focus.
+func (m *TooltipManager) Wrap(object fyne.CanvasObject, tip *Tooltip) fyne.CanvasObject {
+	t := &tooltipObject{object: object, tip: tip, manager: m}
+	t.ExtendBaseWidget(t)
+	return t
+}
+
+func (m *TooltipManager) mouseIn(t *tooltipObject, pos fyne.Position) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.stopTimer()
+	if m.shown != nil {
+		// moving between objects shows the next tooltip straight away
+		m.show(t, pos, false)
+		return
+	}
+	m.timer = time.AfterFunc(m.ShowDelay, func() {
+		m.lock.Lock()
+		m.show(t, t.pointer, false)
+		m.lock.Unlock()
+	})
+}
+
+func (m *TooltipManager) mouseOut(t *tooltipObject) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.stopTimer()
+	if m.shown != t {
+		return
+	}
+	m.timer = time.AfterFunc(m.HideDelay, func() {
+		m.lock.Lock()
+		if m.shown == t {
+			m.hide()
+		}
+		m.lock.Unlock()
+	})
+}
+
+// show places the tooltip of t below pos, in canvas coordinates, keeping it inside the canvas.
+// The lock must be held.
+func (m *TooltipManager) show(t *tooltipObject, pos fyne.Position, byFocus bool) {
+	c := fyne.CurrentApp().Driver().CanvasForObject(t)
+	layer := m.layers[c]
+	if layer == nil {
+		m.hide()
+		return
+	}
+	m.hide()
+	m.shown = t
+	m.byFocus = byFocus
+
+	card := newTooltipCard(t.tip)
+	size := card.MinSize()
+	card.Resize(size)
+	bounds := c.Size()
+	pad := theme.Padding()
+	below := pos.Y + pad*4 // clear of the mouse pointer
+	if below+size.Height > bounds.Height {
+		below = pos.Y - size.Height - pad
+	}
+	x := fyne.Max(pad, fyne.Min(pos.X, bounds.Width-size.Width-pad))
+	card.Move(fyne.NewPos(x, fyne.Max(0, below)))
+	layer.Objects = []fyne.CanvasObject{card}
+	layer.Refresh()
+}
+
+// hide removes the shown tooltip, the lock must be held.
+func (m *TooltipManager) hide() {
+	if m.shown == nil {
+		return
+	}
+	m.shown = nil
+	for _, layer := range m.layers {
+		if len(layer.Objects) > 0 {
+			layer.Objects = nil
+			layer.Refresh()
+		}
+	}
+}
+
+func (m *TooltipManager) stopTimer() {
+	if m.timer != nil {
+		m.timer.Stop()
+		m.timer = nil
+	}
+}
+
+// watchFocus starts checking for wrapped objects gaining keyboard focus, as Fyne has no focus change events.
+func (m *TooltipManager) watchFocus(t *tooltipObject) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.focusable[t] = true
+	if m.stopPoll != nil {
+		return
+	}
+	m.stopPoll = make(chan struct{})
+	go m.pollFocus(m.stopPoll)
+}
+
+func (m *TooltipManager) unwatchFocus(t *tooltipObject) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.focusable, t)
+	if len(m.focusable) == 0 && m.stopPoll != nil {
+		close(m.stopPoll)
+		m.stopPoll = nil
+	}
+}
+
+func (m *TooltipManager) pollFocus(stop chan struct{}) {
+	ticker := time.NewTicker(tooltipFocusPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			m.checkFocus()
+		}
+	}
+}
+
+func (m *TooltipManager) checkFocus() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for c := range m.layers {
+		focused := c.Focused()
+		if focused == m.focused[c] {
+			continue
+		}
+		m.focused[c] = focused
+		if m.shown != nil && m.byFocus {
+			m.hide()
+		}
+		for t := range m.focusable {
+			if f, ok := t.object.(fyne.Focusable); ok && focused != nil && f == focused {
+				// below the object, as there is no pointer to place it by
+				pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(t)
+				m.show(t, pos.AddXY(0, t.Size().Height-theme.Padding()*4), true)
+				break
+			}
+		}
+	}
+}
+
+// tooltipObject shows its object, with a hover layer on top to detect the mouse resting over it.
+type tooltipObject struct {
+	widget.BaseWidget
+	object  fyne.CanvasObject
+	tip     *Tooltip
+	manager *TooltipManager
+	pointer fyne.Position // the last position of the mouse, in canvas coordinates
+}
+
+// Content returns the encapsulated object.
+func (t *tooltipObject) Content() fyne.CanvasObject {
+	return t.object
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (t *tooltipObject) CreateRenderer() fyne.WidgetRenderer {
+	layer := &tooltipHoverLayer{owner: t}
+	layer.ExtendBaseWidget(layer)
+	if _, ok := t.object.(fyne.Focusable); ok {
+		t.manager.watchFocus(t)
+	}
+	return &tooltipObjectRenderer{WidgetRenderer: widget.NewSimpleRenderer(container.NewStack(t.object, layer)),
+		owner: t}
+}
+
+type tooltipObjectRenderer struct {
+	fyne.WidgetRenderer
+	owner *tooltipObject
+}
+
+func (r *tooltipObjectRenderer) Destroy() {
+	r.owner.manager.unwatchFocus(r.owner)
+	r.WidgetRenderer.Destroy()
+}
+
+// tooltipHoverLayer covers the wrapped object to receive hover events, passing them on to the object.
+// It does not handle taps so they reach the object below.
+type tooltipHoverLayer struct {
+	widget.BaseWidget
+	owner *tooltipObject
+}
+
+func (l *tooltipHoverLayer) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewWithoutLayout())
+}
+
+// Cursor returns the cursor of the wrapped object.
+//
+// Implements: desktop.Cursorable
+func (l *tooltipHoverLayer) Cursor() desktop.Cursor {
+	if c, ok := l.owner.object.(desktop.Cursorable); ok {
+		return c.Cursor()
+	}
+	return desktop.DefaultCursor
+}
+
+// MouseIn is called when the mouse enters the wrapped object.
+//
+// Implements: desktop.Hoverable
+func (l *tooltipHoverLayer) MouseIn(e *desktop.MouseEvent) {
+	if o, ok := l.owner.object.(desktop.Hoverable); ok {
+		o.MouseIn(e)
+	}
+	l.owner.pointer = e.AbsolutePosition
+	l.owner.manager.mouseIn(l.owner, e.AbsolutePosition)
+}
+
+// MouseMoved is called when the mouse moves over the wrapped object.
+//
+// Implements: desktop.Hoverable
+func (l *tooltipHoverLayer) MouseMoved(e *desktop.MouseEvent) {
+	if o, ok := l.owner.object.(desktop.Hoverable); ok {
+		o.MouseMoved(e)
+	}
+	l.owner.pointer = e.AbsolutePosition
+}
+
+// MouseOut is called when the mouse leaves the wrapped object.
+//
+// Implements: desktop.Hoverable
+func (l *tooltipHoverLayer) MouseOut() {
+	if o, ok := l.owner.object.(desktop.Hoverable); ok {
+		o.MouseOut()
+	}
+	l.owner.manager.mouseOut(l.owner)
+}
+
+// newTooltipCard creates the box that shows the content of a tooltip.
+func newTooltipCard(tip *Tooltip) fyne.CanvasObject {
+	bg := canvas.NewRectangle(theme.Color(theme.ColorNameOverlayBackground))
+	bg.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+	bg.StrokeWidth = 1
+	bg.CornerRadius = theme.InputRadiusSize()
+
+	content := tip.Content
+	if content == nil {
+		row := container.NewHBox()
+		if tip.Icon != nil {
+			row.Add(widget.NewIcon(tip.Icon))
+		}
+		row.Add(widget.NewLabel(tip.Text))
+		if tip.Shortcut != nil {
+			hint := widget.NewLabel(xwidget.ShortcutLabel(tip.Shortcut))
+			hint.Importance = widget.LowImportance
+			row.Add(hint)
+		}
+		content = row
+	}
+	return container.NewStack(bg, container.NewPadded(content))
+}
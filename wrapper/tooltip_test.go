@@ -0,0 +1,99 @@
+package wrapper
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTooltipManager() *TooltipManager {
+	m := NewTooltipManager()
+	m.ShowDelay = 10 * time.Millisecond
+	m.HideDelay = 10 * time.Millisecond
+	return m
+}
+
+func TestTooltip_Hover(t *testing.T) {
+	app := test.NewApp()
+	defer test.NewApp()
+	win := app.NewWindow("Test")
+	defer win.Close()
+
+	m := newTestTooltipManager()
+	tapped := false
+	button := widget.NewButton("Save", func() { tapped = true })
+	wrapped := m.Wrap(button, &Tooltip{Text: "Save the file"})
+	win.SetContent(m.AddTooltipLayer(container.NewVBox(wrapped, widget.NewLabel("Other")), win.Canvas()))
+	win.Resize(fyne.NewSize(200, 200))
+	layer := m.layers[win.Canvas()]
+
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(wrapped).AddXY(5, 5)
+	test.MoveMouse(win.Canvas(), pos)
+	assert.Empty(t, layer.Objects)
+	assert.Eventually(t, func() bool {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		return len(layer.Objects) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Greater(t, layer.Objects[0].Position().Y, pos.Y)
+
+	// taps still reach the wrapped object
+	test.TapCanvas(win.Canvas(), pos)
+	assert.True(t, tapped)
+
+	test.MoveMouse(win.Canvas(), fyne.NewPos(190, 190))
+	assert.Eventually(t, func() bool {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		return len(layer.Objects) == 0
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestTooltip_Focus(t *testing.T) {
+	app := test.NewApp()
+	defer test.NewApp()
+	win := app.NewWindow("Test")
+	defer win.Close()
+
+	m := newTestTooltipManager()
+	entry := widget.NewEntry()
+	win.SetContent(m.AddTooltipLayer(container.NewVBox(m.Wrap(entry, &Tooltip{Text: "Your name"})), win.Canvas()))
+	win.Resize(fyne.NewSize(200, 200))
+	layer := m.layers[win.Canvas()]
+
+	win.Canvas().Focus(entry)
+	assert.Eventually(t, func() bool {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		return len(layer.Objects) == 1 && m.byFocus
+	}, time.Second, 10*time.Millisecond)
+
+	win.Canvas().Unfocus()
+	assert.Eventually(t, func() bool {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		return len(layer.Objects) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTooltip_Card(t *testing.T) {
+	test.NewApp()
+	defer test.NewApp()
+
+	save := &desktop.CustomShortcut{KeyName: fyne.KeyS, Modifier: fyne.KeyModifierControl}
+	card := newTooltipCard(&Tooltip{Text: "Save", Shortcut: save}).(*fyne.Container)
+	row := card.Objects[1].(*fyne.Container).Objects[0].(*fyne.Container)
+	assert.Len(t, row.Objects, 2)
+	assert.Equal(t, "Save", row.Objects[0].(*widget.Label).Text)
+	assert.Contains(t, row.Objects[1].(*widget.Label).Text, "S")
+
+	rich := widget.NewLabel("Rich")
+	card = newTooltipCard(&Tooltip{Text: "ignored", Content: rich}).(*fyne.Container)
+	assert.Equal(t, rich, card.Objects[1].(*fyne.Container).Objects[0])
+}